@@ -16,10 +16,13 @@ package contextionary
 import (
 	"context"
 	"fmt"
+	"path"
+	"time"
 
 	pb "github.com/semi-technologies/contextionary/contextionary"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/monitoring"
 	"github.com/semi-technologies/weaviate/usecases/traverser"
 	"github.com/semi-technologies/weaviate/usecases/vectorizer"
 	"google.golang.org/grpc"
@@ -34,7 +37,8 @@ type Client struct {
 
 // NewClient from gRPC discovery url to connect to a remote contextionary service
 func NewClient(uri string) (*Client, error) {
-	conn, err := grpc.Dial(uri, grpc.WithInsecure())
+	conn, err := grpc.Dial(uri, grpc.WithInsecure(),
+		grpc.WithUnaryInterceptor(observeDuration))
 	if err != nil {
 		return nil, fmt.Errorf("couldn't connect to remote contextionary gRPC server: %s", err)
 	}
@@ -45,6 +49,17 @@ func NewClient(uri string) (*Client, error) {
 	}, nil
 }
 
+// observeDuration of every request to the contextionary, the operation is the
+// name of the gRPC method, such as "VectorForCorpi"
+func observeDuration(ctx context.Context, method string, req, reply interface{},
+	cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	before := time.Now()
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	monitoring.GetMetrics().VectorizerDuration.WithLabelValues(path.Base(method)).
+		Observe(time.Since(before).Seconds())
+	return err
+}
+
 // IsStopWord returns true if the given word is a stopword, errors on connection errors
 func (c *Client) IsStopWord(ctx context.Context, word string) (bool, error) {
 	res, err := c.grpcClient.IsWordStopword(ctx, &pb.Word{Word: word})
@@ -65,7 +80,7 @@ func (c *Client) IsWordPresent(ctx context.Context, word string) (bool, error) {
 	return res.Present, nil
 }
 
-//SafeGetSimilarWordsWithCertainty will alwasy return a list words - unless there is a network error
+// SafeGetSimilarWordsWithCertainty will alwasy return a list words - unless there is a network error
 func (c *Client) SafeGetSimilarWordsWithCertainty(ctx context.Context, word string, certainty float32) ([]string, error) {
 	res, err := c.grpcClient.SafeGetSimilarWordsWithCertainty(ctx, &pb.SimilarWordsParams{Word: word, Certainty: certainty})
 	if err != nil {
@@ -0,0 +1,101 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package httpvectorizer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Client posts a corpus to an HTTP endpoint, such as a self-hosted
+// transformer model, and reads back the vector. It backs the vectorizer
+// "text2vec-http".
+//
+// The endpoint receives a JSON body of the form {"text": "..."} and must
+// answer with {"vector": [...]}.
+type Client struct {
+	url        string
+	httpClient *http.Client
+}
+
+// New client for the vectorizer endpoint at url
+func New(url string) *Client {
+	return &Client{
+		url:        url,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+type vectorRequest struct {
+	Text string `json:"text"`
+}
+
+type vectorResponse struct {
+	Vector []float32 `json:"vector"`
+	Error  string    `json:"error"`
+}
+
+// VectorForCorpi builds a single vector for all corpi. Overrides are specific
+// to the contextionary and are ignored.
+func (c *Client) VectorForCorpi(ctx context.Context, corpi []string,
+	overrides map[string]string) ([]float32, error) {
+	body, err := json.Marshal(vectorRequest{Text: strings.Join(corpi, " ")})
+	if err != nil {
+		return nil, fmt.Errorf("marshal request: %v", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("create request: %v", err)
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("send request to http vectorizer: %v", err)
+	}
+	defer res.Body.Close()
+
+	resBody, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response of http vectorizer: %v", err)
+	}
+
+	var parsed vectorResponse
+	if err := json.Unmarshal(resBody, &parsed); err != nil {
+		if res.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("http vectorizer responded with status %d: %s",
+				res.StatusCode, resBody)
+		}
+		return nil, fmt.Errorf("unmarshal response of http vectorizer: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("http vectorizer responded with status %d: %s",
+			res.StatusCode, parsed.Error)
+	}
+
+	if len(parsed.Vector) == 0 {
+		return nil, fmt.Errorf("http vectorizer responded without a vector")
+	}
+
+	return parsed.Vector, nil
+}
@@ -0,0 +1,71 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package httpvectorizer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient(t *testing.T) {
+	t.Run("a vector is read back for the corpus", func(t *testing.T) {
+		var received vectorRequest
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			require.Nil(t, json.NewDecoder(r.Body).Decode(&received))
+			w.Write([]byte(`{"vector": [0.1, 0.2, 0.3]}`))
+		}))
+		defer server.Close()
+
+		res, err := New(server.URL).VectorForCorpi(context.Background(),
+			[]string{"car brand", "best brand"}, nil)
+
+		require.Nil(t, err)
+		assert.Equal(t, []float32{0.1, 0.2, 0.3}, res)
+		assert.Equal(t, "car brand best brand", received.Text)
+	})
+
+	t.Run("the endpoint responds with an error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"error": "model not loaded"}`))
+		}))
+		defer server.Close()
+
+		_, err := New(server.URL).VectorForCorpi(context.Background(),
+			[]string{"car"}, nil)
+
+		require.NotNil(t, err)
+		assert.Equal(t, "http vectorizer responded with status 500: model not loaded",
+			err.Error())
+	})
+
+	t.Run("the endpoint responds without a vector", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{}`))
+		}))
+		defer server.Close()
+
+		_, err := New(server.URL).VectorForCorpi(context.Background(),
+			[]string{"car"}, nil)
+
+		assert.NotNil(t, err)
+	})
+}
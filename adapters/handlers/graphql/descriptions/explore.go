@@ -26,4 +26,9 @@ const (
 	ClassName            = "Name of the Class"
 	Beacon               = "Concept identifier in the beacon format, such as weaviate://<hostname>/<kind>/id"
 	Distance             = "Normalized Distance between the result item and the search vector. Normalized to be between 0 (identical vectors) and 1 (perfect opposite)."
+	NearVector           = "Search for objects close to the provided vector instead of vectorizing search terms"
+	Vector               = "The search vector, it must have the same length as the vectors of the searched objects"
+	NearObject           = "Search for objects close to an existing object, the existing object itself is not part of the results"
+	ID                   = "The id of an existing object"
+	MovementObjects      = "Existing objects, referenced by id or beacon, whose vectors are used for the movement"
 )
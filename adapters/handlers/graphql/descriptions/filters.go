@@ -99,3 +99,10 @@ const NetworkTimeout = "Specify the time in seconds after which an unresolved re
 // Pagination filter elements
 const First = "Show the first x results (pagination option)"
 const After = "Show the results after the first x results (pagination option)"
+const Offset = "Skip the first x results (pagination option)"
+
+// Sort filter elements
+const Sort = "Sort the results by one or more properties, later entries are only considered if the values of the previous ones are equal. Cannot be combined with vector searches or a cursor (after)"
+const SortPath = "The property to sort by, such as [\"price\"]. Use [\"_created\"] or [\"_updated\"] to sort by the creation or update time"
+const SortOrder = "The sort order, defaults to asc. Results without a value come last regardless of the order"
+const AfterID = "Show the results after the object with this id, the results are ordered by id. Use the id of the last result of the previous page to continue from there (pagination option)"
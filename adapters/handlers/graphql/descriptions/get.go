@@ -25,6 +25,14 @@ const GetThingsActionsObj = "An object used to get %ss on a local Weaviate"
 
 const GetClassUUID = "The UUID of a Thing or Action, assigned by its local Weaviate"
 
+const GetClassScore = "The relevance score of a bm25 or hybrid search, higher is more relevant. Not set for other searches"
+
+const KeywordRanking = "Rank the results by their BM25 relevance for keywords in the text and string properties"
+const KeywordRankingQuery = "The keywords to search for. Text properties match single words, string properties need to match a whitespace-separated term exactly, such as a product code"
+const KeywordRankingProperties = "The text or string properties to search in, defaults to all indexed text and string properties"
+const Hybrid = "Rank the results by a fusion of the BM25 relevance and the vector certainty of the query, the fused score is exposed as _score"
+const HybridAlpha = "The weight of the vector certainty between 0 and 1, the BM25 relevance is weighted by 1-alpha. 1 is a pure vector search, 0 a pure keyword search. Defaults to 0.75"
+
 // Network
 const NetworkGet = "Get Things or Actions from a Weaviate in a network"
 const NetworkGetObj = "An object used to Get Things or Actions from a Weaviate in a network"
@@ -15,16 +15,33 @@ package common_filters
 
 import "github.com/semi-technologies/weaviate/usecases/traverser"
 
-// ExtractExplore arguments, such as "concepts", "moveTo", "moveAwayFrom",
-// "limit", etc.
+// ExtractExplore arguments, such as "concepts", "nearVector", "moveTo",
+// "moveAwayFrom", "limit", etc.
 func ExtractExplore(source map[string]interface{}) traverser.ExploreParams {
 	var args traverser.ExploreParams
 
-	// keywords is a required argument, so we don't need to check for its existing
-	keywords := source["concepts"].([]interface{})
-	args.Values = make([]string, len(keywords), len(keywords))
-	for i, value := range keywords {
-		args.Values[i] = value.(string)
+	// keywords is an optional arg if a nearVector is set instead, the
+	// traverser validates that exactly one of them is present
+	keywords, ok := source["concepts"].([]interface{})
+	if ok {
+		args.Values = make([]string, len(keywords), len(keywords))
+		for i, value := range keywords {
+			args.Values[i] = value.(string)
+		}
+	}
+
+	// nearVector is an optional arg, so it could be nil
+	nearVector, ok := source["nearVector"]
+	if ok {
+		p := ExtractNearVector(nearVector.(map[string]interface{}))
+		args.NearVector = &p
+	}
+
+	// nearObject is an optional arg, so it could be nil
+	nearObject, ok := source["nearObject"]
+	if ok {
+		p := ExtractNearObject(nearObject.(map[string]interface{}))
+		args.NearObject = &p
 	}
 
 	// limit is an optional arg, so it could be nil
@@ -35,6 +52,12 @@ func ExtractExplore(source map[string]interface{}) traverser.ExploreParams {
 		args.Limit = limit.(int)
 	}
 
+	// offset is an optional arg, so it could be nil
+	offset, ok := source["offset"]
+	if ok {
+		args.Offset = offset.(int)
+	}
+
 	certainty, ok := source["certainty"]
 	if ok {
 		args.Certainty = certainty.(float64)
@@ -63,16 +86,41 @@ func ExtractExplore(source map[string]interface{}) traverser.ExploreParams {
 
 func extractMovement(input interface{}) traverser.ExploreMove {
 	// the type is fixed through gql config, no need to catch incorrect type
-	// assumption, all fields are required so we don't need to check for their
-	// presence
+	// assumption, only the force is required, concepts and objects are optional
 	moveToMap := input.(map[string]interface{})
 	res := traverser.ExploreMove{}
 	res.Force = float32(moveToMap["force"].(float64))
 
-	keywords := moveToMap["concepts"].([]interface{})
-	res.Values = make([]string, len(keywords), len(keywords))
-	for i, value := range keywords {
-		res.Values[i] = value.(string)
+	keywords, ok := moveToMap["concepts"].([]interface{})
+	if ok {
+		res.Values = make([]string, len(keywords), len(keywords))
+		for i, value := range keywords {
+			res.Values[i] = value.(string)
+		}
+	}
+
+	objects, ok := moveToMap["objects"].([]interface{})
+	if ok {
+		res.Objects = make([]traverser.ObjectMove, len(objects), len(objects))
+		for i, value := range objects {
+			res.Objects[i] = extractObjectMove(value.(map[string]interface{}))
+		}
+	}
+
+	return res
+}
+
+func extractObjectMove(input map[string]interface{}) traverser.ObjectMove {
+	var res traverser.ObjectMove
+
+	id, ok := input["id"]
+	if ok {
+		res.ID = id.(string)
+	}
+
+	beacon, ok := input["beacon"]
+	if ok {
+		res.Beacon = beacon.(string)
 	}
 
 	return res
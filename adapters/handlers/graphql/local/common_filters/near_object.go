@@ -0,0 +1,40 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package common_filters
+
+import "github.com/semi-technologies/weaviate/usecases/traverser"
+
+// ExtractNearObject arguments, such as "id", "beacon" and "certainty"
+func ExtractNearObject(source map[string]interface{}) traverser.NearObjectParams {
+	var args traverser.NearObjectParams
+
+	// id and beacon are optional individually, the traverser validates that
+	// exactly one of them is set
+	id, ok := source["id"]
+	if ok {
+		args.ID = id.(string)
+	}
+
+	beacon, ok := source["beacon"]
+	if ok {
+		args.Beacon = beacon.(string)
+	}
+
+	certainty, ok := source["certainty"]
+	if ok {
+		args.Certainty = certainty.(float64)
+	}
+
+	return args
+}
@@ -35,12 +35,32 @@ func Build() *graphql.Field {
 			},
 			"concepts": &graphql.ArgumentConfig{
 				Description: descriptions.Keywords,
-				Type:        graphql.NewNonNull(graphql.NewList(graphql.String)),
+				Type:        graphql.NewList(graphql.String),
+			},
+			"nearObject": &graphql.ArgumentConfig{
+				Description: descriptions.NearObject,
+				Type: graphql.NewInputObject(
+					graphql.InputObjectConfig{
+						Name:   "ExploreNearObjectInpObj",
+						Fields: nearObjectInp(),
+					}),
+			},
+			"nearVector": &graphql.ArgumentConfig{
+				Description: descriptions.NearVector,
+				Type: graphql.NewInputObject(
+					graphql.InputObjectConfig{
+						Name:   "ExploreNearVectorInpObj",
+						Fields: nearVectorInp(),
+					}),
 			},
 			"limit": &graphql.ArgumentConfig{
 				Type:        graphql.Int,
 				Description: descriptions.Limit,
 			},
+			"offset": &graphql.ArgumentConfig{
+				Type:        graphql.Int,
+				Description: descriptions.Offset,
+			},
 			"certainty": &graphql.ArgumentConfig{
 				Type:        graphql.Float,
 				Description: descriptions.Certainty,
@@ -50,7 +70,7 @@ func Build() *graphql.Field {
 				Type: graphql.NewInputObject(
 					graphql.InputObjectConfig{
 						Name:   "ExploreMoveTo",
-						Fields: movementInp(),
+						Fields: movementInp("ExploreMoveTo"),
 					}),
 			},
 			"moveAwayFrom": &graphql.ArgumentConfig{
@@ -58,7 +78,7 @@ func Build() *graphql.Field {
 				Type: graphql.NewInputObject(
 					graphql.InputObjectConfig{
 						Name:   "ExploreMoveAwayFrom",
-						Fields: movementInp(),
+						Fields: movementInp("ExploreMoveAwayFrom"),
 					}),
 			},
 		},
@@ -119,11 +139,49 @@ func exploreObject() *graphql.Object {
 	return graphql.NewObject(getLocalExploreFieldsObject)
 }
 
-func movementInp() graphql.InputObjectConfigFieldMap {
+func nearVectorInp() graphql.InputObjectConfigFieldMap {
+	return graphql.InputObjectConfigFieldMap{
+		"vector": &graphql.InputObjectFieldConfig{
+			Description: descriptions.Vector,
+			Type:        graphql.NewNonNull(graphql.NewList(graphql.Float)),
+		},
+		"certainty": &graphql.InputObjectFieldConfig{
+			Description: descriptions.Certainty,
+			Type:        graphql.Float,
+		},
+	}
+}
+
+func nearObjectInp() graphql.InputObjectConfigFieldMap {
+	return graphql.InputObjectConfigFieldMap{
+		"id": &graphql.InputObjectFieldConfig{
+			Description: descriptions.ID,
+			Type:        graphql.String,
+		},
+		"beacon": &graphql.InputObjectFieldConfig{
+			Description: descriptions.Beacon,
+			Type:        graphql.String,
+		},
+		"certainty": &graphql.InputObjectFieldConfig{
+			Description: descriptions.Certainty,
+			Type:        graphql.Float,
+		},
+	}
+}
+
+func movementInp(prefix string) graphql.InputObjectConfigFieldMap {
 	return graphql.InputObjectConfigFieldMap{
 		"concepts": &graphql.InputObjectFieldConfig{
 			Description: descriptions.Keywords,
-			Type:        graphql.NewNonNull(graphql.NewList(graphql.String)),
+			Type:        graphql.NewList(graphql.String),
+		},
+		"objects": &graphql.InputObjectFieldConfig{
+			Description: descriptions.MovementObjects,
+			Type: graphql.NewList(graphql.NewInputObject(
+				graphql.InputObjectConfig{
+					Name:   fmt.Sprintf("%sObjects", prefix),
+					Fields: movementObjectsInp(),
+				})),
 		},
 		"force": &graphql.InputObjectFieldConfig{
 			Description: descriptions.Force,
@@ -131,3 +189,16 @@ func movementInp() graphql.InputObjectConfigFieldMap {
 		},
 	}
 }
+
+func movementObjectsInp() graphql.InputObjectConfigFieldMap {
+	return graphql.InputObjectConfigFieldMap{
+		"id": &graphql.InputObjectFieldConfig{
+			Description: descriptions.ID,
+			Type:        graphql.String,
+		},
+		"beacon": &graphql.InputObjectFieldConfig{
+			Description: descriptions.Beacon,
+			Type:        graphql.String,
+		},
+	}
+}
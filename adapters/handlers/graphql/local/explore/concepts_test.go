@@ -102,6 +102,37 @@ func Test_ResolveExplore(t *testing.T) {
 			}},
 		},
 
+		testCase{
+			name: "with optional limit and offset set",
+			query: `
+			{
+					Explore(
+					concepts: ["car", "best brand"], limit: 17, offset: 5) {
+							beacon className
+				}
+			}`,
+			expectedParamsToTraverser: traverser.ExploreParams{
+				Values: []string{"car", "best brand"},
+				Limit:  17,
+				Offset: 5,
+			},
+			resolverReturn: []search.Result{
+				search.Result{
+					Beacon:    "weaviate://localhost/things/some-uuid",
+					ClassName: "bestClass",
+				},
+			},
+			expectedResults: []result{{
+				pathToField: []string{"Explore"},
+				expectedValue: []interface{}{
+					map[string]interface{}{
+						"beacon":    "weaviate://localhost/things/some-uuid",
+						"className": "bestClass",
+					},
+				},
+			}},
+		},
+
 		testCase{
 			name: "with moveTo set",
 			query: `
@@ -189,6 +220,77 @@ func Test_ResolveExplore(t *testing.T) {
 				},
 			}},
 		},
+
+		testCase{
+			name: "with a nearVector instead of concepts",
+			query: `
+			{
+					Explore(nearVector: {vector: [0.1, 0.2, 0.3], certainty: 0.7}) {
+							beacon className
+				}
+			}`,
+			expectedParamsToTraverser: traverser.ExploreParams{
+				NearVector: &traverser.NearVectorParams{
+					Vector:    []float32{0.1, 0.2, 0.3},
+					Certainty: 0.7,
+				},
+			},
+			resolverReturn: []search.Result{
+				search.Result{
+					Beacon:    "weaviate://localhost/things/some-uuid",
+					ClassName: "bestClass",
+				},
+			},
+			expectedResults: []result{{
+				pathToField: []string{"Explore"},
+				expectedValue: []interface{}{
+					map[string]interface{}{
+						"beacon":    "weaviate://localhost/things/some-uuid",
+						"className": "bestClass",
+					},
+				},
+			}},
+		},
+
+		testCase{
+			name: "with a nearObject and a movement to another object",
+			query: `
+			{
+					Explore(nearObject: {id: "e9c12c22-766f-4bde-b140-d4cf8fd6e041"},
+						moveTo: {
+							force: 0.3,
+							objects: [{beacon: "weaviate://localhost/things/1e6b7d0a-4fd2-4d0b-9a0a-e9c6e4e1e69d"}]
+						}) {
+							beacon className
+				}
+			}`,
+			expectedParamsToTraverser: traverser.ExploreParams{
+				NearObject: &traverser.NearObjectParams{
+					ID: "e9c12c22-766f-4bde-b140-d4cf8fd6e041",
+				},
+				MoveTo: traverser.ExploreMove{
+					Force: 0.3,
+					Objects: []traverser.ObjectMove{{
+						Beacon: "weaviate://localhost/things/1e6b7d0a-4fd2-4d0b-9a0a-e9c6e4e1e69d",
+					}},
+				},
+			},
+			resolverReturn: []search.Result{
+				search.Result{
+					Beacon:    "weaviate://localhost/things/some-uuid",
+					ClassName: "bestClass",
+				},
+			},
+			expectedResults: []result{{
+				pathToField: []string{"Explore"},
+				expectedValue: []interface{}{
+					map[string]interface{}{
+						"beacon":    "weaviate://localhost/things/some-uuid",
+						"className": "bestClass",
+					},
+				},
+			}},
+		},
 	}
 
 	tests.AssertExtraction(t)
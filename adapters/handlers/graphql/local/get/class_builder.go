@@ -126,6 +126,11 @@ func (b *classBuilder) classObject(kindName string, class *models.Class) *graphq
 				Type:        graphql.String,
 			}
 
+			classProperties["_score"] = &graphql.Field{
+				Description: descriptions.GetClassScore,
+				Type:        graphql.Float,
+			}
+
 			for _, property := range class.Properties {
 				propertyType, err := b.schema.FindPropertyDataType(property.DataType)
 				if err != nil {
@@ -45,7 +45,7 @@ func exploreFields(prefix string) graphql.InputObjectConfigFieldMap {
 			Type: graphql.NewInputObject(
 				graphql.InputObjectConfig{
 					Name:   fmt.Sprintf("%sMoveTo", prefix),
-					Fields: movementInp(),
+					Fields: movementInp(fmt.Sprintf("%sMoveTo", prefix)),
 				}),
 		},
 		"certainty": &graphql.InputObjectFieldConfig{
@@ -57,17 +57,25 @@ func exploreFields(prefix string) graphql.InputObjectConfigFieldMap {
 			Type: graphql.NewInputObject(
 				graphql.InputObjectConfig{
 					Name:   fmt.Sprintf("%sMoveAwayFrom", prefix),
-					Fields: movementInp(),
+					Fields: movementInp(fmt.Sprintf("%sMoveAwayFrom", prefix)),
 				}),
 		},
 	}
 }
 
-func movementInp() graphql.InputObjectConfigFieldMap {
+func movementInp(prefix string) graphql.InputObjectConfigFieldMap {
 	return graphql.InputObjectConfigFieldMap{
 		"concepts": &graphql.InputObjectFieldConfig{
 			Description: descriptions.Keywords,
-			Type:        graphql.NewNonNull(graphql.NewList(graphql.String)),
+			Type:        graphql.NewList(graphql.String),
+		},
+		"objects": &graphql.InputObjectFieldConfig{
+			Description: descriptions.MovementObjects,
+			Type: graphql.NewList(graphql.NewInputObject(
+				graphql.InputObjectConfig{
+					Name:   fmt.Sprintf("%sObjects", prefix),
+					Fields: movementObjectsInp(),
+				})),
 		},
 		"force": &graphql.InputObjectFieldConfig{
 			Description: descriptions.Force,
@@ -75,3 +83,16 @@ func movementInp() graphql.InputObjectConfigFieldMap {
 		},
 	}
 }
+
+func movementObjectsInp() graphql.InputObjectConfigFieldMap {
+	return graphql.InputObjectConfigFieldMap{
+		"id": &graphql.InputObjectFieldConfig{
+			Description: descriptions.ID,
+			Type:        graphql.String,
+		},
+		"beacon": &graphql.InputObjectFieldConfig{
+			Description: descriptions.Beacon,
+			Type:        graphql.String,
+		},
+	}
+}
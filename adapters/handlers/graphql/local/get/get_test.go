@@ -21,6 +21,7 @@ import (
 	test_helper "github.com/semi-technologies/weaviate/adapters/handlers/graphql/test/helper"
 	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/schema/kind"
 	"github.com/semi-technologies/weaviate/usecases/traverser"
 	"github.com/stretchr/testify/assert"
@@ -288,6 +289,80 @@ func TestExtractPhoneNumberField(t *testing.T) {
 	}
 }
 
+func TestNearVector(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver(emptyPeers())
+
+	query := `{ Get { Things { SomeThing(nearVector: {
+								vector: [0.123, 0.984],
+								certainty: 0.4
+							}) { intField } } } }`
+
+	expectedParams := traverser.GetParams{
+		Kind:       kind.Thing,
+		ClassName:  "SomeThing",
+		Properties: []traverser.SelectProperty{{Name: "intField", IsPrimitive: true}},
+		NearVector: &traverser.NearVectorParams{
+			Vector:    []float32{0.123, 0.984},
+			Certainty: 0.4,
+		},
+	}
+
+	resolver.On("GetClass", expectedParams).
+		Return([]interface{}{}, nil).Once()
+
+	resolver.AssertResolve(t, query)
+}
+
+func TestNearObject(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver(emptyPeers())
+
+	t.Run("with an id", func(t *testing.T) {
+		query := `{ Get { Things { SomeThing(nearObject: {
+								id: "e9c12c22-766f-4bde-b140-d4cf8fd6e041",
+								certainty: 0.7
+							}) { intField } } } }`
+
+		expectedParams := traverser.GetParams{
+			Kind:       kind.Thing,
+			ClassName:  "SomeThing",
+			Properties: []traverser.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			NearObject: &traverser.NearObjectParams{
+				ID:        "e9c12c22-766f-4bde-b140-d4cf8fd6e041",
+				Certainty: 0.7,
+			},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("with a beacon", func(t *testing.T) {
+		query := `{ Get { Actions { SomeAction(nearObject: {
+								beacon: "weaviate://localhost/actions/e9c12c22-766f-4bde-b140-d4cf8fd6e041"
+							}) { intField } } } }`
+
+		expectedParams := traverser.GetParams{
+			Kind:       kind.Action,
+			ClassName:  "SomeAction",
+			Properties: []traverser.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			NearObject: &traverser.NearObjectParams{
+				Beacon: "weaviate://localhost/actions/e9c12c22-766f-4bde-b140-d4cf8fd6e041",
+			},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+}
+
 func TestExploreRanker(t *testing.T) {
 	t.Parallel()
 
@@ -329,6 +404,46 @@ func TestExploreRanker(t *testing.T) {
 		resolver.AssertResolve(t, query)
 	})
 
+	t.Run("for things with movements to objects", func(t *testing.T) {
+		query := `{ Get { Things { SomeThing(explore: {
+                concepts: ["c1"],
+								moveTo: {
+									concepts:["positive"],
+									objects: [{id: "e9c12c22-766f-4bde-b140-d4cf8fd6e041"}],
+									force: 0.5
+								},
+								moveAwayFrom: {
+									objects: [{beacon: "weaviate://localhost/things/1e6b7d0a-4fd2-4d0b-9a0a-e9c6e4e1e69d"}],
+									force: 0.25
+								}
+        			}) { intField } } } }`
+
+		expectedParams := traverser.GetParams{
+			Kind:       kind.Thing,
+			ClassName:  "SomeThing",
+			Properties: []traverser.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			Explore: &traverser.ExploreParams{
+				Values: []string{"c1"},
+				MoveTo: traverser.ExploreMove{
+					Values:  []string{"positive"},
+					Objects: []traverser.ObjectMove{{ID: "e9c12c22-766f-4bde-b140-d4cf8fd6e041"}},
+					Force:   0.5,
+				},
+				MoveAwayFrom: traverser.ExploreMove{
+					Objects: []traverser.ObjectMove{
+						{Beacon: "weaviate://localhost/things/1e6b7d0a-4fd2-4d0b-9a0a-e9c6e4e1e69d"},
+					},
+					Force: 0.25,
+				},
+			},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
 	t.Run("for things with optional certainty set", func(t *testing.T) {
 		query := `{ Get { Things { SomeThing(explore: {
                 concepts: ["c1", "c2", "c3"],
@@ -389,6 +504,197 @@ func TestExtractPagination(t *testing.T) {
 	resolver.AssertResolve(t, query)
 }
 
+func TestExtractPaginationWithOffset(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver(emptyPeers())
+
+	expectedParams := traverser.GetParams{
+		Kind:       kind.Action,
+		ClassName:  "SomeAction",
+		Properties: []traverser.SelectProperty{{Name: "intField", IsPrimitive: true}},
+		Pagination: &filters.Pagination{
+			Limit:  10,
+			Offset: 20,
+		},
+	}
+
+	resolver.On("GetClass", expectedParams).
+		Return(test_helper.EmptyList(), nil).Once()
+
+	query := "{ Get { Actions { SomeAction(limit: 10, offset: 20) { intField } } } }"
+	resolver.AssertResolve(t, query)
+}
+
+func TestExtractPaginationWithCursor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("with a cursor", func(t *testing.T) {
+		resolver := newMockResolver(emptyPeers())
+
+		expectedParams := traverser.GetParams{
+			Kind:       kind.Action,
+			ClassName:  "SomeAction",
+			Properties: []traverser.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			Pagination: &filters.Pagination{
+				Limit: 10,
+				After: "e5dc4a4c-ef0f-3aed-89a3-a73435c6bbcf",
+			},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return(test_helper.EmptyList(), nil).Once()
+
+		query := `{ Get { Actions { SomeAction(limit: 10, after: "e5dc4a4c-ef0f-3aed-89a3-a73435c6bbcf") { intField } } } }`
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("with a cursor and an offset", func(t *testing.T) {
+		resolver := newMockResolver(emptyPeers())
+
+		query := `{ Get { Actions { SomeAction(offset: 10, after: "e5dc4a4c-ef0f-3aed-89a3-a73435c6bbcf") { intField } } } }`
+		resolver.AssertFailToResolve(t, query)
+	})
+}
+
+func TestExtractSort(t *testing.T) {
+	t.Parallel()
+
+	t.Run("combined with where and pagination", func(t *testing.T) {
+		resolver := newMockResolver(emptyPeers())
+
+		expectedParams := traverser.GetParams{
+			Kind:       kind.Action,
+			ClassName:  "SomeAction",
+			Properties: []traverser.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			Pagination: &filters.Pagination{
+				Limit:  10,
+				Offset: 5,
+			},
+			Filters: &filters.LocalFilter{Root: &filters.Clause{
+				Operator: filters.OperatorGreaterThan,
+				On: &filters.Path{
+					Class:    schema.AssertValidClassName("SomeAction"),
+					Property: schema.AssertValidPropertyName("intField"),
+				},
+				Value: &filters.Value{
+					Value: 3,
+					Type:  schema.DataTypeInt,
+				},
+			}},
+			Sort: []filters.Sort{
+				{Path: []string{"intField"}, Order: filters.SortOrderDesc},
+				{Path: []string{"_created"}, Order: filters.SortOrderAsc},
+			},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return(test_helper.EmptyList(), nil).Once()
+
+		query := `{ Get { Actions { SomeAction(
+			limit: 10, offset: 5,
+			where: {path: ["intField"], operator: GreaterThan, valueInt: 3},
+			sort: [{path: ["intField"], order: desc}, {path: ["_created"]}]
+		) { intField } } } }`
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("with an invalid order", func(t *testing.T) {
+		resolver := newMockResolver(emptyPeers())
+
+		query := `{ Get { Actions { SomeAction(sort: [{path: ["intField"], order: sideways}]) { intField } } } }`
+		resolver.AssertFailToResolve(t, query)
+	})
+}
+
+func TestKeywordRanking(t *testing.T) {
+	t.Parallel()
+
+	t.Run("with bm25 combined with where", func(t *testing.T) {
+		resolver := newMockResolver(emptyPeers())
+
+		expectedParams := traverser.GetParams{
+			Kind:      kind.Action,
+			ClassName: "SomeAction",
+			Properties: []traverser.SelectProperty{
+				{Name: "intField", IsPrimitive: true},
+				{Name: "_score", IsPrimitive: true},
+			},
+			Filters: &filters.LocalFilter{Root: &filters.Clause{
+				Operator: filters.OperatorGreaterThan,
+				On: &filters.Path{
+					Class:    schema.AssertValidClassName("SomeAction"),
+					Property: schema.AssertValidPropertyName("intField"),
+				},
+				Value: &filters.Value{
+					Value: 3,
+					Type:  schema.DataTypeInt,
+				},
+			}},
+			KeywordRanking: &traverser.KeywordRankingParams{
+				Query:      "XJ-100 hammer",
+				Properties: []string{"name", "description"},
+			},
+		}
+
+		resolverReturn := []interface{}{
+			map[string]interface{}{"intField": 7, "_score": 1.7},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return(resolverReturn, nil).Once()
+
+		query := `{ Get { Actions { SomeAction(
+			where: {path: ["intField"], operator: GreaterThan, valueInt: 3},
+			bm25: {query: "XJ-100 hammer", properties: ["name", "description"]}
+		) { intField _score } } } }`
+		result := resolver.AssertResolve(t, query)
+
+		assert.Equal(t, map[string]interface{}{"intField": 7, "_score": 1.7},
+			result.Get("Get", "Actions", "SomeAction").Result.([]interface{})[0])
+	})
+
+	t.Run("with hybrid and the default alpha", func(t *testing.T) {
+		resolver := newMockResolver(emptyPeers())
+
+		expectedParams := traverser.GetParams{
+			Kind:       kind.Action,
+			ClassName:  "SomeAction",
+			Properties: []traverser.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			Hybrid: &traverser.HybridParams{
+				Query: "XJ-100 hammer",
+				Alpha: 0.75,
+			},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return(test_helper.EmptyList(), nil).Once()
+
+		query := `{ Get { Actions { SomeAction(hybrid: {query: "XJ-100 hammer"}) { intField } } } }`
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("with hybrid and an explicit alpha", func(t *testing.T) {
+		resolver := newMockResolver(emptyPeers())
+
+		expectedParams := traverser.GetParams{
+			Kind:       kind.Action,
+			ClassName:  "SomeAction",
+			Properties: []traverser.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			Hybrid: &traverser.HybridParams{
+				Query: "XJ-100 hammer",
+				Alpha: 0.2,
+			},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return(test_helper.EmptyList(), nil).Once()
+
+		query := `{ Get { Actions { SomeAction(hybrid: {query: "XJ-100 hammer", alpha: 0.2}) { intField } } } }`
+		resolver.AssertResolve(t, query)
+	})
+}
+
 func TestExtractGroupParams(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,123 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package get
+
+import (
+	"fmt"
+
+	"github.com/graphql-go/graphql"
+	"github.com/semi-technologies/weaviate/adapters/handlers/graphql/descriptions"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+)
+
+// defaultHybridAlpha favors the vector certainty, while still allowing exact
+// keyword matches to move up
+const defaultHybridAlpha = 0.75
+
+func keywordRankingArgument(kindName, className string) *graphql.ArgumentConfig {
+	prefix := fmt.Sprintf("Get%ss%s", kindName, className)
+	return &graphql.ArgumentConfig{
+		Description: descriptions.KeywordRanking,
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name: fmt.Sprintf("%sBm25InpObj", prefix),
+				Fields: graphql.InputObjectConfigFieldMap{
+					"query": &graphql.InputObjectFieldConfig{
+						Description: descriptions.KeywordRankingQuery,
+						Type:        graphql.NewNonNull(graphql.String),
+					},
+					"properties": &graphql.InputObjectFieldConfig{
+						Description: descriptions.KeywordRankingProperties,
+						Type:        graphql.NewList(graphql.String),
+					},
+				},
+				Description: descriptions.KeywordRanking,
+			},
+		),
+	}
+}
+
+func hybridArgument(kindName, className string) *graphql.ArgumentConfig {
+	prefix := fmt.Sprintf("Get%ss%s", kindName, className)
+	return &graphql.ArgumentConfig{
+		Description: descriptions.Hybrid,
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name: fmt.Sprintf("%sHybridInpObj", prefix),
+				Fields: graphql.InputObjectConfigFieldMap{
+					"query": &graphql.InputObjectFieldConfig{
+						Description: descriptions.KeywordRankingQuery,
+						Type:        graphql.NewNonNull(graphql.String),
+					},
+					"alpha": &graphql.InputObjectFieldConfig{
+						Description:  descriptions.HybridAlpha,
+						Type:         graphql.Float,
+						DefaultValue: defaultHybridAlpha,
+					},
+					"properties": &graphql.InputObjectFieldConfig{
+						Description: descriptions.KeywordRankingProperties,
+						Type:        graphql.NewList(graphql.String),
+					},
+				},
+				Description: descriptions.Hybrid,
+			},
+		),
+	}
+}
+
+func extractKeywordRanking(args map[string]interface{}) *traverser.KeywordRankingParams {
+	bm25, ok := args["bm25"]
+	if !ok {
+		return nil
+	}
+
+	asMap := bm25.(map[string]interface{}) // guaranteed by graphql
+	return &traverser.KeywordRankingParams{
+		Query:      asMap["query"].(string),
+		Properties: extractStringList(asMap["properties"]),
+	}
+}
+
+func extractHybrid(args map[string]interface{}) *traverser.HybridParams {
+	hybrid, ok := args["hybrid"]
+	if !ok {
+		return nil
+	}
+
+	asMap := hybrid.(map[string]interface{}) // guaranteed by graphql
+	alpha := defaultHybridAlpha
+	if a, ok := asMap["alpha"].(float64); ok {
+		alpha = a
+	}
+
+	return &traverser.HybridParams{
+		Query:      asMap["query"].(string),
+		Alpha:      alpha,
+		Properties: extractStringList(asMap["properties"]),
+	}
+}
+
+func extractStringList(in interface{}) []string {
+	list, ok := in.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	out := make([]string, len(list))
+	for i, elem := range list {
+		out[i] = elem.(string)
+	}
+
+	return out
+}
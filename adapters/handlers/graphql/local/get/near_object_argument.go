@@ -0,0 +1,52 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package get
+
+import (
+	"fmt"
+
+	"github.com/graphql-go/graphql"
+	"github.com/semi-technologies/weaviate/adapters/handlers/graphql/descriptions"
+)
+
+func nearObjectArgument(kindName, className string) *graphql.ArgumentConfig {
+	prefix := fmt.Sprintf("Get%ss%s", kindName, className)
+	return &graphql.ArgumentConfig{
+		Description: descriptions.NearObject,
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name:        fmt.Sprintf("%sNearObjectInpObj", prefix),
+				Fields:      nearObjectFields(),
+				Description: descriptions.NearObject,
+			},
+		),
+	}
+}
+
+func nearObjectFields() graphql.InputObjectConfigFieldMap {
+	return graphql.InputObjectConfigFieldMap{
+		"id": &graphql.InputObjectFieldConfig{
+			Description: descriptions.ID,
+			Type:        graphql.String,
+		},
+		"beacon": &graphql.InputObjectFieldConfig{
+			Description: descriptions.Beacon,
+			Type:        graphql.String,
+		},
+		"certainty": &graphql.InputObjectFieldConfig{
+			Description: descriptions.Certainty,
+			Type:        graphql.Float,
+		},
+	}
+}
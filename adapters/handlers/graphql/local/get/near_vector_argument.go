@@ -0,0 +1,48 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package get
+
+import (
+	"fmt"
+
+	"github.com/graphql-go/graphql"
+	"github.com/semi-technologies/weaviate/adapters/handlers/graphql/descriptions"
+)
+
+func nearVectorArgument(kindName, className string) *graphql.ArgumentConfig {
+	prefix := fmt.Sprintf("Get%ss%s", kindName, className)
+	return &graphql.ArgumentConfig{
+		Description: descriptions.NearVector,
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name:        fmt.Sprintf("%sNearVectorInpObj", prefix),
+				Fields:      nearVectorFields(),
+				Description: descriptions.NearVector,
+			},
+		),
+	}
+}
+
+func nearVectorFields() graphql.InputObjectConfigFieldMap {
+	return graphql.InputObjectConfigFieldMap{
+		"vector": &graphql.InputObjectFieldConfig{
+			Description: descriptions.Vector,
+			Type:        graphql.NewNonNull(graphql.NewList(graphql.Float)),
+		},
+		"certainty": &graphql.InputObjectFieldConfig{
+			Description: descriptions.Certainty,
+			Type:        graphql.Float,
+		},
+	}
+}
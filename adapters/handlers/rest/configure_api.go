@@ -25,17 +25,23 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	"github.com/semi-technologies/weaviate/adapters/clients/contextionary"
+	"github.com/semi-technologies/weaviate/adapters/clients/httpvectorizer"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/state"
 	"github.com/semi-technologies/weaviate/adapters/locks"
+	"github.com/semi-technologies/weaviate/adapters/repos/db"
 	"github.com/semi-technologies/weaviate/adapters/repos/esvector"
 	"github.com/semi-technologies/weaviate/adapters/repos/etcd"
+	"github.com/semi-technologies/weaviate/adapters/repos/filesystem"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization/rbac"
+	"github.com/semi-technologies/weaviate/usecases/backup"
 	"github.com/semi-technologies/weaviate/usecases/classification"
 	"github.com/semi-technologies/weaviate/usecases/config"
 	"github.com/semi-technologies/weaviate/usecases/kinds"
 	"github.com/semi-technologies/weaviate/usecases/network/common/peers"
+	"github.com/semi-technologies/weaviate/usecases/revectorization"
 	schemaUC "github.com/semi-technologies/weaviate/usecases/schema"
 	"github.com/semi-technologies/weaviate/usecases/schema/migrate"
 	"github.com/semi-technologies/weaviate/usecases/telemetry"
@@ -56,6 +62,9 @@ type vectorRepo interface {
 	kinds.BatchVectorRepo
 	traverser.VectorSearcher
 	classification.VectorRepo
+	revectorization.VectorRepo
+	ClassSearch(ctx context.Context, params traverser.GetParams) ([]search.Result, error)
+	KeywordClassSearch(ctx context.Context, params traverser.GetParams) ([]search.Result, error)
 	SetSchemaGetter(schemaUC.SchemaGetter)
 	WaitForStartup(time.Duration) error
 }
@@ -69,6 +78,7 @@ type vectorizer interface {
 type explorer interface {
 	GetClass(ctx context.Context, params traverser.GetParams) ([]interface{}, error)
 	Concepts(ctx context.Context, params traverser.ExploreParams) ([]search.Result, error)
+	FindObject(ctx context.Context, id, beacon string) (*search.Result, error)
 }
 
 func configureAPI(api *operations.WeaviateAPI) http.Handler {
@@ -79,9 +89,19 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	api.JSONConsumer = runtime.JSONConsumer()
 
 	api.OidcAuth = func(token string, scopes []string) (*models.Principal, error) {
+		// api keys can also be sent as a bearer token, so a known key takes
+		// precedence over validating the token with the oidc provider
+		if principal, ok := appState.APIKey.Lookup(token); ok {
+			return principal, nil
+		}
+
 		return appState.OIDC.ValidateAndExtract(token, scopes)
 	}
 
+	api.XAPIKeyAuth = func(token string) (*models.Principal, error) {
+		return appState.APIKey.ValidateAndExtract(token, nil)
+	}
+
 	api.Logger = func(msg string, args ...interface{}) {
 		appState.Logger.WithField("action", "restapi_management").Infof(msg, args...)
 	}
@@ -92,17 +112,40 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	var migrator migrate.Migrator
 	var explorer explorer
 
-	repo := esvector.NewRepo(esClient, appState.Logger, nil,
-		appState.ServerConfig.Config.VectorIndex.DenormalizationDepth,
-		appState.ServerConfig.Config.VectorIndex.SupernodeThreshold,
-		*appState.ServerConfig.Config.VectorIndex.NumberOfShards,     // guaranteed not to be nil as there are defaults
-		*appState.ServerConfig.Config.VectorIndex.AutoExpandReplicas, // guaranteed not to be nil as there are defaults
-	)
-	vectorMigrator = esvector.NewMigrator(repo)
-	vectorRepo = repo
+	shutdown := func() {}
+
+	if appState.ServerConfig.Config.Database.Embedded() {
+		repo, err := db.New(appState.ServerConfig.Config.Database.DataPath,
+			appState.Logger, nil)
+		if err != nil {
+			appState.Logger.
+				WithField("action", "startup").WithError(err).
+				Fatal("could not open embedded db")
+			os.Exit(1)
+		}
+		vectorMigrator = db.NewMigrator(repo)
+		vectorRepo = repo
+		shutdown = func() {
+			if err := repo.Shutdown(context.Background()); err != nil {
+				appState.Logger.
+					WithField("action", "shutdown").WithError(err).
+					Error("could not close embedded db")
+			}
+		}
+	} else {
+		repo := esvector.NewRepo(esClient, appState.Logger, nil,
+			appState.ServerConfig.Config.VectorIndex.DenormalizationDepth,
+			appState.ServerConfig.Config.VectorIndex.SupernodeThreshold,
+			*appState.ServerConfig.Config.VectorIndex.NumberOfShards,     // guaranteed not to be nil as there are defaults
+			*appState.ServerConfig.Config.VectorIndex.AutoExpandReplicas, // guaranteed not to be nil as there are defaults
+		)
+		vectorMigrator = esvector.NewMigrator(repo)
+		vectorRepo = repo
+	}
 	migrator = vectorMigrator
-	vectorizer = libvectorizer.New(appState.Contextionary, nil)
-	explorer = traverser.NewExplorer(repo, vectorizer, libvectorizer.NormalizedDistance, appState.Logger)
+	modules := vectorizerModules(appState)
+	vectorizer = libvectorizer.New(modules, nil)
+	explorer = traverser.NewExplorer(vectorRepo, vectorizer, libvectorizer.NormalizedDistance, appState.Logger)
 
 	schemaRepo := etcd.NewSchemaRepo(etcdClient)
 	classifierRepo := etcd.NewClassificationRepo(etcdClient)
@@ -117,6 +160,7 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	}
 
 	vectorRepo.SetSchemaGetter(schemaManager)
+	schemaManager.SetVectorizers(vectorizerNames(modules))
 	vectorizer.SetIndexChecker(schemaManager)
 
 	err = vectorRepo.WaitForStartup(2 * time.Minute)
@@ -124,7 +168,7 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		appState.Logger.
 			WithError(err).
 			WithField("action", "startup").WithError(err).
-			Fatal("esvector didn't start up")
+			Fatal("vector repo didn't start up")
 		os.Exit(1)
 	}
 	// vectorRepo.InitCacheIndexing(
@@ -145,7 +189,38 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		appState.Logger, appState.Authorizer, vectorizer,
 		vectorRepo, explorer, schemaManager)
 
-	classifier := classification.New(schemaManager, classifierRepo, vectorRepo, appState.Authorizer)
+	classifier := classification.New(schemaManager, classifierRepo, vectorRepo, appState.Authorizer,
+		appState.Contextionary)
+	classifier.SetRunConfig(appState.ServerConfig.Config.Classification)
+	markInterruptedClassifications(appState, classifier)
+
+	revectorizer := revectorization.New(schemaManager, vectorizer, vectorRepo,
+		appState.Authorizer)
+
+	if auditLogger := configureAuditLog(appState); auditLogger != nil {
+		kindsManager.SetAuditor(auditLogger)
+		batchKindsManager.SetAuditor(auditLogger)
+		schemaManager.SetAuditor(auditLogger)
+		classifier.SetAuditor(auditLogger)
+		revectorizer.SetAuditor(auditLogger)
+	}
+
+	var backupStore backup.Store
+	if path := appState.ServerConfig.Config.Backup.Path; path != "" {
+		backupStore = filesystem.NewBackupStore(path)
+	}
+	backupManager := backup.New(backupStore, schemaManager, vectorRepo, classifierRepo,
+		appState.Locks, appState.Authorizer, appState.Logger)
+
+	rbacAuthorizer, _ := appState.Authorizer.(*rbac.Authorizer)
+	roleManager := rbac.NewManager(etcd.NewRoleRepo(etcdClient), rbacAuthorizer,
+		appState.Authorizer)
+	if err := roleManager.Init(context.Background()); err != nil {
+		appState.Logger.
+			WithField("action", "startup").WithError(err).
+			Fatal("could not load roles")
+		os.Exit(1)
+	}
 
 	updateSchemaCallback := makeUpdateSchemaCall(appState.Logger, appState, kindsTraverser)
 	schemaManager.RegisterSchemaUpdateCallback(updateSchemaCallback)
@@ -166,8 +241,12 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	setupGraphQLHandlers(api, appState.TelemetryLogger, appState)
 	setupMiscHandlers(api, appState.TelemetryLogger, appState.ServerConfig, appState.Network, schemaManager, appState.Contextionary)
 	setupClassificationHandlers(api, appState.TelemetryLogger, classifier)
+	setupRevectorizationHandlers(api, appState.TelemetryLogger, revectorizer)
+	setupBackupHandlers(api, appState.TelemetryLogger, backupManager)
+	setupRoleHandlers(api, appState.TelemetryLogger, roleManager)
+	setupMaintenanceHandlers(api, appState.TelemetryLogger, kindsManager)
 
-	api.ServerShutdown = func() {}
+	api.ServerShutdown = shutdown
 	configureServer = makeConfigureServer(appState)
 	setupMiddlewares := makeSetupMiddlewares(appState)
 	setupGlobalMiddleware := makeSetupGlobalMiddleware(appState)
@@ -207,11 +286,12 @@ func startupRoutine() (*state.State, *clientv3.Client, *elasticsearch.Client) {
 		Debug("config loaded")
 
 	appState.OIDC = configureOIDC(appState)
+	appState.APIKey = configureAPIKey(appState)
 	appState.AnonymousAccess = configureAnonymousAccess(appState)
 	appState.Authorizer = configureAuthorizer(appState)
 
 	logger.WithField("action", "startup").WithField("startup_time_left", timeTillDeadline(ctx)).
-		Debug("configured OIDC and anonymous access client")
+		Debug("configured OIDC, api key and anonymous access client")
 
 	appState.Network = connectToNetwork(logger, appState.ServerConfig.Config)
 	logger.WithField("action", "startup").WithField("startup_time_left", timeTillDeadline(ctx)).
@@ -239,16 +319,21 @@ func startupRoutine() (*state.State, *clientv3.Client, *elasticsearch.Client) {
 	logger.WithField("action", "startup").WithField("startup_time_left", timeTillDeadline(ctx)).
 		Debug("created etcd client")
 
-	esClient, err := elasticsearch.NewClient(elasticsearch.Config{
-		Addresses: []string{serverConfig.Config.VectorIndex.URL},
-	})
-	if err != nil {
-		logger.WithField("action", "startup").
-			WithError(err).Error("cannot create es client for vector index")
-		logger.Exit(1)
+	// the embedded db does not require any outside services
+	var esClient *elasticsearch.Client
+	if !serverConfig.Config.Database.Embedded() {
+		esClient, err = elasticsearch.NewClient(elasticsearch.Config{
+			Addresses: []string{serverConfig.Config.VectorIndex.URL},
+			Transport: esvector.NewInstrumentedTransport(http.DefaultTransport),
+		})
+		if err != nil {
+			logger.WithField("action", "startup").
+				WithError(err).Error("cannot create es client for vector index")
+			logger.Exit(1)
+		}
+		logger.WithField("action", "startup").WithField("startup_time_left", timeTillDeadline(ctx)).
+			Debug("created es client for vector index")
 	}
-	logger.WithField("action", "startup").WithField("startup_time_left", timeTillDeadline(ctx)).
-		Debug("created es client for vector index")
 
 	appState.TelemetryLogger = configureTelemetry(appState, etcdClient, logger)
 
@@ -286,6 +371,29 @@ func startupRoutine() (*state.State, *clientv3.Client, *elasticsearch.Client) {
 	return appState, etcdClient, esClient
 }
 
+// vectorizerModules by the vectorizer name classes select in their schema,
+// the contextionary is always present, all other modules only if configured
+func vectorizerModules(appState *state.State) map[string]libvectorizer.Module {
+	modules := map[string]libvectorizer.Module{
+		models.ClassVectorizerContextionary: appState.Contextionary,
+	}
+
+	if url := appState.ServerConfig.Config.Text2VecHTTP.URL; url != "" {
+		modules[models.ClassVectorizerText2vecHTTP] = httpvectorizer.New(url)
+	}
+
+	return modules
+}
+
+func vectorizerNames(modules map[string]libvectorizer.Module) []string {
+	names := make([]string, 0, len(modules))
+	for name := range modules {
+		names = append(names, name)
+	}
+
+	return names
+}
+
 func configureTelemetry(appState *state.State, etcdClient *clientv3.Client,
 	logger logrus.FieldLogger) *telemetry.RequestsLog {
 	// Extract environment variables needed for logging
@@ -24,9 +24,12 @@ import (
 	"github.com/semi-technologies/weaviate/adapters/handlers/graphql"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/state"
 	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/audit"
 	"github.com/semi-technologies/weaviate/usecases/auth/authentication/anonymous"
+	"github.com/semi-technologies/weaviate/usecases/auth/authentication/apikey"
 	"github.com/semi-technologies/weaviate/usecases/auth/authentication/oidc"
 	"github.com/semi-technologies/weaviate/usecases/auth/authorization"
+	"github.com/semi-technologies/weaviate/usecases/classification"
 	"github.com/semi-technologies/weaviate/usecases/config"
 	"github.com/semi-technologies/weaviate/usecases/network"
 	libnetworkFake "github.com/semi-technologies/weaviate/usecases/network/fake"
@@ -96,6 +99,22 @@ func configureOIDC(appState *state.State) *oidc.Client {
 	return c
 }
 
+// configureAPIKey will always be called, even if api keys are disabled, this
+// way requests with an api key can be denied with a valuable error message. If
+// a keys file is configured, it is watched for changes for the lifetime of
+// the process.
+func configureAPIKey(appState *state.State) *apikey.Client {
+	c, err := apikey.New(appState.ServerConfig.Config, appState.Logger)
+	if err != nil {
+		appState.Logger.WithField("action", "apikey_init").WithError(err).Fatal("apikey client could not start up")
+		os.Exit(1)
+	}
+
+	go c.WatchKeysFile(context.Background(), 10*time.Second)
+
+	return c
+}
+
 // configureAnonymousAccess will always be called, even if anonymous access is
 // disabled. In this case the middleware provided by this client will block
 // anonymous requests
@@ -103,6 +122,43 @@ func configureAnonymousAccess(appState *state.State) *anonymous.Client {
 	return anonymous.New(appState.ServerConfig.Config)
 }
 
+// configureAuditLog returns nil if auditing is disabled
+func configureAuditLog(appState *state.State) *audit.Logger {
+	cfg := appState.ServerConfig.Config.Audit
+	if !cfg.Enabled {
+		return nil
+	}
+
+	var sink audit.Sink
+	switch cfg.Sink {
+	case config.AuditSinkStdout:
+		sink = audit.NewWriterSink(os.Stdout)
+	default:
+		fileSink, err := audit.NewFileSink(cfg.File.Path,
+			int64(cfg.File.MaxSizeMB)*1024*1024, cfg.File.MaxBackups)
+		if err != nil {
+			appState.Logger.WithField("action", "audit_init").WithError(err).Fatal("audit log could not start up")
+			os.Exit(1)
+		}
+		sink = fileSink
+	}
+
+	return audit.New(sink, appState.Logger)
+}
+
+// markInterruptedClassifications marks the classifications which were still
+// running when weaviate was stopped, they can't be resumed
+func markInterruptedClassifications(appState *state.State,
+	classifier *classification.Classifier) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := classifier.MarkInterrupted(ctx); err != nil {
+		appState.Logger.WithField("action", "startup").WithError(err).
+			Error("could not mark interrupted classifications")
+	}
+}
+
 func configureAuthorizer(appState *state.State) authorization.Authorizer {
 	return authorization.New(appState.ServerConfig.Config)
 }
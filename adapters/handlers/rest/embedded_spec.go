@@ -147,6 +147,15 @@ func init() {
           {
             "$ref": "#/parameters/CommonLimitParameterQuery"
           },
+          {
+            "$ref": "#/parameters/CommonOffsetParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonAfterParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonSortParameterQuery"
+          },
           {
             "$ref": "#/parameters/CommonMetaParameterQuery"
           }
@@ -170,6 +179,12 @@ func init() {
           "404": {
             "description": "Successful query result but no resource was found."
           },
+          "422": {
+            "description": "Invalid paging parameters.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -236,6 +251,59 @@ func init() {
         ]
       }
     },
+    "/actions/export": {
+      "get": {
+        "description": "Streams all Actions of a class as newline-delimited JSON, one Action per line. Each line contains the schema, the timestamps, the vector weights and the stored vector of the Action, so that the export can be imported again as-is through the batch endpoint.",
+        "produces": [
+          "application/x-ndjson"
+        ],
+        "tags": [
+          "actions"
+        ],
+        "summary": "Export all Actions of a class.",
+        "operationId": "actions.export",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the class to export.",
+            "name": "class",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response, the body contains one Action per line."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "There is no Action class with this name.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
+      }
+    },
     "/actions/validate": {
       "post": {
         "description": "Validate an Action's schema and meta-data. It has to be based on a schema, which is related to the given Action to be accepted by this validation.",
@@ -436,6 +504,12 @@ func init() {
           "404": {
             "description": "Successful query result but no resource was found."
           },
+          "409": {
+            "description": "The object is still referenced through a reference property with the onDelete policy 'restrict'.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -707,56 +781,30 @@ func init() {
         ]
       }
     },
-    "/batching/actions": {
+    "/backups": {
       "post": {
-        "description": "Register new Actions in bulk. Given meta-data and schema values are validated.",
+        "description": "Start a backup of the schema, all objects with their vectors and the classification records to the backup directory. Backups run in the background, use GET /backups/{id} to retrieve the status of your backup.",
         "tags": [
-          "batching",
-          "actions"
+          "backups"
         ],
-        "summary": "Creates new Actions based on an Action template as a batch.",
-        "operationId": "batching.actions.create",
+        "summary": "Starts a backup.",
+        "operationId": "backups.create",
         "parameters": [
           {
+            "description": "parameters to start a backup",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "object",
-              "properties": {
-                "actions": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/Action"
-                  }
-                },
-                "fields": {
-                  "description": "Define which fields need to be returned. Default value is ALL",
-                  "type": "array",
-                  "items": {
-                    "type": "string",
-                    "default": "ALL",
-                    "enum": [
-                      "ALL",
-                      "class",
-                      "schema",
-                      "id",
-                      "creationTimeUnix"
-                    ]
-                  }
-                }
-              }
+              "$ref": "#/definitions/Backup"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Request succeeded, see response body to get detailed information about each batched item.",
+          "201": {
+            "description": "Successfully started backup.",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/ActionsGetResponse"
-              }
+              "$ref": "#/definitions/Backup"
             }
           },
           "401": {
@@ -769,7 +817,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid backup, for example an unknown class or an id which is already in use.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -781,44 +829,136 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.backups.create"
         ]
       }
     },
-    "/batching/references": {
+    "/backups/{id}": {
+      "get": {
+        "description": "Get the status and progress of a previously started backup",
+        "tags": [
+          "backups"
+        ],
+        "summary": "View a previously started backup",
+        "operationId": "backups.get",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "backup id",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Found the backup, returned as body",
+            "schema": {
+              "$ref": "#/definitions/Backup"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Backup does not exist"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.backups.get"
+        ]
+      }
+    },
+    "/backups/{id}/restore": {
+      "get": {
+        "description": "Get the status and progress of the restore of a backup",
+        "tags": [
+          "backups"
+        ],
+        "summary": "View the restore of a backup",
+        "operationId": "backups.restore.get",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "backup id",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Found the restore, returned as body",
+            "schema": {
+              "$ref": "#/definitions/BackupRestore"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Backup does not exist or was never restored"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.backups.restore.get"
+        ]
+      },
       "post": {
-        "description": "Register cross-references between any class items (things or actions) in bulk.",
+        "description": "Restore a completed backup into this instance. None of the restored classes may be present in the schema. Restores run in the background, use GET /backups/{id}/restore to retrieve the status of your restore.",
         "tags": [
-          "batching",
-          "references"
+          "backups"
         ],
-        "summary": "Creates new Cross-References between arbitrary classes in bulk.",
-        "operationId": "batching.references.create",
+        "summary": "Starts a restore of a backup.",
+        "operationId": "backups.restore",
         "parameters": [
           {
-            "description": "A list of references to be batched. The ideal size depends on the used database connector. Please see the documentation of the used connector for help",
+            "type": "string",
+            "description": "backup id",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "parameters to restore the backup",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/BatchReference"
-              }
+              "$ref": "#/definitions/BackupRestore"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Request Successful. Warning: A successful request does not guarantuee that every batched reference was successfully created. Inspect the response body to see which references succeeded and which failed.",
+          "201": {
+            "description": "Successfully started restore.",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/BatchReferenceResponse"
-              }
+              "$ref": "#/definitions/BackupRestore"
             }
           },
           "401": {
@@ -830,8 +970,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Not Found - Backup does not exist"
+          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid restore, for example a class which is already present in the schema.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -843,22 +986,20 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.backups.restore"
         ]
       }
     },
-    "/batching/things": {
+    "/batching/actions": {
       "post": {
-        "description": "Register new Things in bulk. Provided meta-data and schema values are validated.",
+        "description": "Register new Actions in bulk. Given meta-data and schema values are validated.",
         "tags": [
           "batching",
-          "things"
+          "actions"
         ],
-        "summary": "Creates new Things based on a Thing template as a batch.",
-        "operationId": "batching.things.create",
+        "summary": "Creates new Actions based on an Action template as a batch.",
+        "operationId": "batching.actions.create",
         "parameters": [
           {
             "name": "body",
@@ -867,6 +1008,12 @@ func init() {
             "schema": {
               "type": "object",
               "properties": {
+                "actions": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Action"
+                  }
+                },
                 "fields": {
                   "description": "Define which fields need to be returned. Default value is ALL",
                   "type": "array",
@@ -881,12 +1028,6 @@ func init() {
                       "creationTimeUnix"
                     ]
                   }
-                },
-                "things": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/Thing"
-                  }
                 }
               }
             }
@@ -898,7 +1039,7 @@ func init() {
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/ThingsGetResponse"
+                "$ref": "#/definitions/ActionsGetResponse"
               }
             }
           },
@@ -929,36 +1070,30 @@ func init() {
         "x-serviceIds": [
           "weaviate.local.add"
         ]
-      }
-    },
-    "/c11y/concepts/{concept}": {
-      "get": {
-        "description": "Checks if a concept is part of the contextionary. Concepts should be concatenated as described here: https://github.com/semi-technologies/weaviate/blob/master/docs/en/use/ontology-schema.md#camelcase",
+      },
+      "delete": {
+        "description": "Delete all Actions of a class which match the where filter. Use dryRun to find out how many actions would be deleted.",
         "tags": [
-          "contextionary-API"
+          "batching",
+          "actions"
         ],
-        "summary": "Checks if a concept is part of the contextionary.",
-        "operationId": "c11y.concepts",
+        "summary": "Deletes Actions matching a filter as a batch.",
+        "operationId": "batching.actions.delete",
         "parameters": [
           {
-            "type": "string",
-            "description": "CamelCase list of words to validate.",
-            "name": "concept",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BatchDelete"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful response.",
+            "description": "Request succeeded, see response body to get detailed information about the deleted actions.",
             "schema": {
-              "$ref": "#/definitions/C11yWordsResponse"
-            }
-          },
-          "400": {
-            "description": "Incorrect request",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/BatchDeleteResponse"
             }
           },
           "401": {
@@ -970,89 +1105,57 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous, e.g. the class doesn't exist or the filter is invalid.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
-          },
-          "501": {
-            "description": "Not (yet) implemented."
           }
         },
         "x-serviceIds": [
-          "weaviate.c11y.words.get"
+          "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/c11y/corpus": {
-      "post": {
-        "description": "Analyzes a sentence based on the contextionary",
+      },
+      "patch": {
+        "description": "Merge partial Actions into existing Actions in bulk. Every Action must contain its id and class, the class is immutable. Provided schema values are validated and Actions are only revectorized if a value which is part of their vector changes.",
         "tags": [
-          "contextionary-API"
+          "batching",
+          "actions"
         ],
-        "summary": "Checks if a word or wordString is part of the contextionary.",
-        "operationId": "c11y.corpus.get",
+        "summary": "Updates Actions by merging partial Actions as a batch.",
+        "operationId": "batching.actions.merge",
         "parameters": [
           {
-            "description": "A text corpus",
-            "name": "corpus",
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "description": "The text corpus.",
               "type": "object",
               "properties": {
-                "corpus": {
-                  "type": "string",
-                  "example": "In certain latitudes there comes a span of time approaching and following the summer solstice, some weeks in all, when the twilights turn long and blue."
+                "actions": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Action"
+                  }
                 }
               }
             }
           }
         ],
-        "responses": {
-          "501": {
-            "description": "Not (yet) implemented."
-          }
-        },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.c11y.corpus.get"
-        ]
-      }
-    },
-    "/c11y/extensions/": {
-      "post": {
-        "description": "Extend the contextionary with your own custom concepts",
-        "tags": [
-          "contextionary-API"
-        ],
-        "summary": "Extend the contextionary with custom concepts",
-        "operationId": "c11y.extensions",
-        "parameters": [
-          {
-            "description": "Description and definition of the concept to extend the contextionary with",
-            "name": "extension",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/C11yExtension"
-            }
-          }
-        ],
         "responses": {
           "200": {
-            "description": "Successfully extended the contextionary with the custom cocnept",
-            "schema": {
-              "$ref": "#/definitions/C11yExtension"
-            }
-          },
-          "400": {
-            "description": "Incorrect request",
+            "description": "Request succeeded, see response body to get detailed information about each merged Action.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ActionsGetResponse"
+              }
             }
           },
           "401": {
@@ -1064,49 +1167,55 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
-          },
-          "501": {
-            "description": "Not (yet) implemented."
           }
         },
         "x-serviceIds": [
-          "weaviate.extensions.post"
+          "weaviate.local.manipulate"
         ]
       }
     },
-    "/c11y/words/{words}": {
-      "get": {
-        "description": "Checks if a word or wordString is part of the contextionary. Words should be concatenated as described here: https://github.com/semi-technologies/weaviate/blob/master/docs/en/use/ontology-schema.md#camelcase",
+    "/batching/references": {
+      "post": {
+        "description": "Register cross-references between any class items (things or actions) in bulk.",
         "tags": [
-          "contextionary-API"
+          "batching",
+          "references"
         ],
-        "summary": "Checks if a word or wordString is part of the contextionary.",
-        "operationId": "c11y.words",
+        "summary": "Creates new Cross-References between arbitrary classes in bulk.",
+        "operationId": "batching.references.create",
         "parameters": [
           {
-            "type": "string",
-            "description": "CamelCase list of words to validate.",
-            "name": "words",
-            "in": "path",
-            "required": true
+            "description": "A list of references to be batched. The ideal size depends on the used database connector. Please see the documentation of the used connector for help",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchReference"
+              }
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/C11yWordsResponse"
-            }
-          },
-          "400": {
-            "description": "Incorrect request",
+            "description": "Request Successful. Warning: A successful request does not guarantuee that every batched reference was successfully created. Inspect the response body to see which references succeeded and which failed.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchReferenceResponse"
+              }
             }
           },
           "401": {
@@ -1118,53 +1227,76 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
-          },
-          "501": {
-            "description": "Not (yet) implemented."
           }
         },
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.c11y.words.get"
+          "weaviate.local.add"
         ]
       }
     },
-    "/classifications/": {
+    "/batching/things": {
       "post": {
-        "description": "Trigger a classification based on the specified params. Classifications will run in the background, use GET /classifications/\u003cid\u003e to retrieve the status of your classificaiton.",
+        "description": "Register new Things in bulk. Provided meta-data and schema values are validated.",
         "tags": [
-          "classifications"
+          "batching",
+          "things"
         ],
-        "summary": "Starts a classification.",
-        "operationId": "classifications.post",
+        "summary": "Creates new Things based on a Thing template as a batch.",
+        "operationId": "batching.things.create",
         "parameters": [
           {
-            "description": "parameters to start a classification",
-            "name": "params",
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Classification"
+              "type": "object",
+              "properties": {
+                "fields": {
+                  "description": "Define which fields need to be returned. Default value is ALL",
+                  "type": "array",
+                  "items": {
+                    "type": "string",
+                    "default": "ALL",
+                    "enum": [
+                      "ALL",
+                      "class",
+                      "schema",
+                      "id",
+                      "creationTimeUnix"
+                    ]
+                  }
+                },
+                "things": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Thing"
+                  }
+                }
+              }
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Successfully started classification.",
-            "schema": {
-              "$ref": "#/definitions/Classification"
-            }
-          },
-          "400": {
-            "description": "Incorrect request",
+          "200": {
+            "description": "Request succeeded, see response body to get detailed information about each batched item.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ThingsGetResponse"
+              }
             }
           },
           "401": {
@@ -1176,6 +1308,12 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -1183,33 +1321,35 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.classifications.post"
+          "weaviate.local.add"
         ]
-      }
-    },
-    "/classifications/{id}": {
-      "get": {
-        "description": "Get status, results and metadata of a previously created classification",
+      },
+      "delete": {
+        "description": "Delete all Things of a class which match the where filter. Use dryRun to find out how many things would be deleted.",
         "tags": [
-          "classifications"
+          "batching",
+          "things"
         ],
-        "summary": "View previously created classification",
-        "operationId": "classifications.get",
+        "summary": "Deletes Things matching a filter as a batch.",
+        "operationId": "batching.things.delete",
         "parameters": [
           {
-            "type": "string",
-            "description": "classification id",
-            "name": "id",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BatchDelete"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Found the classification, returned as body",
+            "description": "Request succeeded, see response body to get detailed information about the deleted things.",
             "schema": {
-              "$ref": "#/definitions/Classification"
+              "$ref": "#/definitions/BatchDeleteResponse"
             }
           },
           "401": {
@@ -1221,8 +1361,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Not Found - Classification does not exist"
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous, e.g. the class doesn't exist or the filter is invalid.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -1232,34 +1375,43 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.classifications.get"
+          "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/graphql": {
-      "post": {
-        "description": "Get an object based on GraphQL",
+      },
+      "patch": {
+        "description": "Merge partial Things into existing Things in bulk. Every Thing must contain its id and class, the class is immutable. Provided schema values are validated and Things are only revectorized if a value which is part of their vector changes.",
         "tags": [
-          "graphql"
+          "batching",
+          "things"
         ],
-        "summary": "Get a response based on GraphQL",
-        "operationId": "graphql.post",
+        "summary": "Updates Things by merging partial Things as a batch.",
+        "operationId": "batching.things.merge",
         "parameters": [
           {
-            "description": "The GraphQL query request parameters.",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/GraphQLQuery"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Successful query (with select).",
+              "type": "object",
+              "properties": {
+                "things": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Thing"
+                  }
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Request succeeded, see response body to get detailed information about each merged Thing.",
             "schema": {
-              "$ref": "#/definitions/GraphQLResponse"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ThingsGetResponse"
+              }
             }
           },
           "401": {
@@ -1272,7 +1424,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1284,40 +1436,39 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query",
-          "weaviate.local.query.meta",
-          "weaviate.network.query",
-          "weaviate.network.query.meta"
+          "weaviate.local.manipulate"
         ]
       }
     },
-    "/graphql/batch": {
-      "post": {
-        "description": "Perform a batched GraphQL query",
+    "/c11y/concepts/{concept}": {
+      "get": {
+        "description": "Checks if a concept is part of the contextionary. Concepts should be concatenated as described here: https://github.com/semi-technologies/weaviate/blob/master/docs/en/use/ontology-schema.md#camelcase",
         "tags": [
-          "graphql"
+          "contextionary-API"
         ],
-        "summary": "Get a response based on GraphQL.",
-        "operationId": "graphql.batch",
+        "summary": "Checks if a concept is part of the contextionary.",
+        "operationId": "c11y.concepts",
         "parameters": [
           {
-            "description": "The GraphQL queries.",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/GraphQLQueries"
-            }
+            "type": "string",
+            "description": "CamelCase list of words to validate.",
+            "name": "concept",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful query (with select).",
+            "description": "Successful response.",
             "schema": {
-              "$ref": "#/definitions/GraphQLResponses"
+              "$ref": "#/definitions/C11yWordsResponse"
+            }
+          },
+          "400": {
+            "description": "Incorrect request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -1329,42 +1480,89 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+          "501": {
+            "description": "Not (yet) implemented."
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.c11y.words.get"
+        ]
+      }
+    },
+    "/c11y/corpus": {
+      "post": {
+        "description": "Analyzes a sentence based on the contextionary",
+        "tags": [
+          "contextionary-API"
+        ],
+        "summary": "Checks if a word or wordString is part of the contextionary.",
+        "operationId": "c11y.corpus.get",
+        "parameters": [
+          {
+            "description": "A text corpus",
+            "name": "corpus",
+            "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "description": "The text corpus.",
+              "type": "object",
+              "properties": {
+                "corpus": {
+                  "type": "string",
+                  "example": "In certain latitudes there comes a span of time approaching and following the summer solstice, some weeks in all, when the twilights turn long and blue."
+                }
+              }
             }
           }
+        ],
+        "responses": {
+          "501": {
+            "description": "Not (yet) implemented."
+          }
         },
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query",
-          "weaviate.local.query.meta",
-          "weaviate.network.query",
-          "weaviate.network.query.meta"
+          "weaviate.c11y.corpus.get"
         ]
       }
     },
-    "/meta": {
-      "get": {
-        "description": "Gives meta information about the server and can be used to provide information to another Weaviate instance that wants to interact with the current instance.",
+    "/c11y/extensions/": {
+      "post": {
+        "description": "Extend the contextionary with your own custom concepts",
         "tags": [
-          "meta"
+          "contextionary-API"
+        ],
+        "summary": "Extend the contextionary with custom concepts",
+        "operationId": "c11y.extensions",
+        "parameters": [
+          {
+            "description": "Description and definition of the concept to extend the contextionary with",
+            "name": "extension",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/C11yExtension"
+            }
+          }
         ],
-        "summary": "Returns meta information of the current Weaviate instance.",
-        "operationId": "meta.get",
         "responses": {
           "200": {
-            "description": "Successful response.",
+            "description": "Successfully extended the contextionary with the custom cocnept",
             "schema": {
-              "$ref": "#/definitions/Meta"
+              "$ref": "#/definitions/C11yExtension"
+            }
+          },
+          "400": {
+            "description": "Incorrect request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -1381,101 +1579,106 @@ func init() {
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
+          },
+          "501": {
+            "description": "Not (yet) implemented."
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.extensions.post"
         ]
       }
     },
-    "/p2p/genesis": {
-      "put": {
-        "security": [],
-        "description": "Receive an update from the Genesis server.",
+    "/c11y/words/{words}": {
+      "get": {
+        "description": "Checks if a word or wordString is part of the contextionary. Words should be concatenated as described here: https://github.com/semi-technologies/weaviate/blob/master/docs/en/use/ontology-schema.md#camelcase",
         "tags": [
-          "P2P"
+          "contextionary-API"
         ],
-        "operationId": "p2p.genesis_update",
+        "summary": "Checks if a word or wordString is part of the contextionary.",
+        "operationId": "c11y.words",
         "parameters": [
           {
-            "name": "peers",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/PeerUpdateList"
-            }
+            "type": "string",
+            "description": "CamelCase list of words to validate.",
+            "name": "words",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Alive and kicking!"
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/C11yWordsResponse"
+            }
+          },
+          "400": {
+            "description": "Incorrect request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "401": {
-            "description": "Unauthorized update."
+            "description": "Unauthorized or invalid credentials."
           },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+          "403": {
+            "description": "Forbidden",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
-          }
-        },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.network.query.meta"
-        ]
-      }
-    },
-    "/p2p/health": {
-      "get": {
-        "security": [],
-        "description": "Check if a peer is alive and healthy.",
-        "tags": [
-          "P2P"
-        ],
-        "summary": "Check if a peer is alive.",
-        "operationId": "p2p.health",
-        "responses": {
-          "200": {
-            "description": "Alive and kicking!"
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
+          },
+          "501": {
+            "description": "Not (yet) implemented."
           }
         },
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.network.query.meta"
+          "weaviate.c11y.words.get"
         ]
       }
     },
-    "/schema": {
+    "/classifications/": {
       "get": {
+        "description": "List classifications, most recently started first. Only classifications of classes the user is allowed to view are listed.",
         "tags": [
-          "schema"
+          "classifications"
+        ],
+        "summary": "List classifications.",
+        "operationId": "classifications.list",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "only list classifications of this class",
+            "name": "class",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "running",
+              "completed",
+              "failed",
+              "cancelled",
+              "interrupted"
+            ],
+            "type": "string",
+            "description": "only list classifications with this status",
+            "name": "status",
+            "in": "query"
+          }
         ],
-        "summary": "Dump the current the database schema.",
-        "operationId": "schema.dump",
         "responses": {
           "200": {
-            "description": "Successfully dumped the database schema.",
+            "description": "Successful response.",
             "schema": {
-              "type": "object",
-              "properties": {
-                "actions": {
-                  "$ref": "#/definitions/Schema"
-                },
-                "things": {
-                  "$ref": "#/definitions/Schema"
-                }
-              }
+              "$ref": "#/definitions/ClassificationsListResponse"
             }
           },
           "401": {
@@ -1495,32 +1698,38 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.classifications.list"
         ]
-      }
-    },
-    "/schema/actions": {
+      },
       "post": {
+        "description": "Trigger a classification based on the specified params. Classifications will run in the background, use GET /classifications/\u003cid\u003e to retrieve the status of your classificaiton.",
         "tags": [
-          "schema"
+          "classifications"
         ],
-        "summary": "Create a new Action class in the ontology.",
-        "operationId": "schema.actions.create",
+        "summary": "Starts a classification.",
+        "operationId": "classifications.post",
         "parameters": [
           {
-            "name": "actionClass",
-            "in": "body",
+            "description": "parameters to start a classification",
+            "name": "params",
+            "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/Classification"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Added the new Action class to the ontology.",
+          "201": {
+            "description": "Successfully started classification.",
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/Classification"
+            }
+          },
+          "400": {
+            "description": "Incorrect request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -1532,12 +1741,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Invalid Action class",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -1546,36 +1749,33 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.add.meta"
+          "weaviate.classifications.post"
         ]
       }
     },
-    "/schema/actions/{className}": {
-      "put": {
+    "/classifications/{id}": {
+      "get": {
+        "description": "Get status, results and metadata of a previously created classification",
         "tags": [
-          "schema"
+          "classifications"
         ],
-        "summary": "Rename, or replace the keywords of the Action.",
-        "operationId": "schema.actions.update",
+        "summary": "View previously created classification",
+        "operationId": "classifications.get",
         "parameters": [
           {
             "type": "string",
-            "name": "className",
+            "description": "classification id",
+            "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Changes applied."
+            "description": "Found the classification, returned as body",
+            "schema": {
+              "$ref": "#/definitions/Classification"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -1586,11 +1786,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Invalid update.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "404": {
+            "description": "Not Found - Classification does not exist"
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -1600,31 +1797,30 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.classifications.get"
         ]
       },
       "delete": {
+        "description": "Cancel a running classification. The classification stops after the object it is currently classifying, objects which have already been classified keep their references.",
         "tags": [
-          "schema"
+          "classifications"
         ],
-        "summary": "Remove an Action class (and all data in the instances) from the ontology.",
-        "operationId": "schema.actions.delete",
+        "summary": "Cancel a running classification",
+        "operationId": "classifications.cancel",
         "parameters": [
           {
             "type": "string",
-            "name": "className",
+            "description": "classification id",
+            "name": "id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Removed the Action class from the ontology."
-          },
-          "400": {
-            "description": "Could not delete the Action class.",
+          "202": {
+            "description": "Cancellation requested, the classification is returned as body",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/Classification"
             }
           },
           "401": {
@@ -1636,6 +1832,15 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Not Found - Classification does not exist"
+          },
+          "409": {
+            "description": "The classification is not running",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -1644,38 +1849,34 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.classifications.cancel"
         ]
       }
     },
-    "/schema/actions/{className}/properties": {
+    "/graphql": {
       "post": {
+        "description": "Get an object based on GraphQL",
         "tags": [
-          "schema"
+          "graphql"
         ],
-        "summary": "Add a property to an Action class.",
-        "operationId": "schema.actions.properties.add",
+        "summary": "Get a response based on GraphQL",
+        "operationId": "graphql.post",
         "parameters": [
           {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
+            "description": "The GraphQL query request parameters.",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Property"
+              "$ref": "#/definitions/GraphQLQuery"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Added the property.",
+            "description": "Successful query (with select).",
             "schema": {
-              "$ref": "#/definitions/Property"
+              "$ref": "#/definitions/GraphQLResponse"
             }
           },
           "401": {
@@ -1688,7 +1889,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Invalid property.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1700,43 +1901,41 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.local.query",
+          "weaviate.local.query.meta",
+          "weaviate.network.query",
+          "weaviate.network.query.meta"
         ]
       }
     },
-    "/schema/actions/{className}/properties/{propertyName}": {
-      "put": {
+    "/graphql/batch": {
+      "post": {
+        "description": "Perform a batched GraphQL query",
         "tags": [
-          "schema"
+          "graphql"
         ],
-        "summary": "Rename, or replace the keywords of the property.",
-        "operationId": "schema.actions.properties.update",
+        "summary": "Get a response based on GraphQL.",
+        "operationId": "graphql.batch",
         "parameters": [
           {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
+            "description": "The GraphQL queries.",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Property"
+              "$ref": "#/definitions/GraphQLQueries"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Changes applied."
+            "description": "Successful query (with select).",
+            "schema": {
+              "$ref": "#/definitions/GraphQLResponses"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -1748,7 +1947,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Invalid update.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1760,33 +1959,40 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.local.query",
+          "weaviate.local.query.meta",
+          "weaviate.network.query",
+          "weaviate.network.query.meta"
         ]
-      },
-      "delete": {
+      }
+    },
+    "/maintenance/dangling-references": {
+      "post": {
+        "description": "Scans the objects for references to objects which don't exist (anymore), e.g. because they were deleted before references were removed on deletion. The dangling references are reported and, if requested, removed from the objects.",
         "tags": [
-          "schema"
+          "maintenance"
         ],
-        "summary": "Remove a property from an Action class.",
-        "operationId": "schema.actions.properties.delete",
+        "summary": "Find and repair references to objects which don't exist",
+        "operationId": "maintenance.danglingReferences",
         "parameters": [
           {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/DanglingReferencesCheck"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Removed the property from the ontology."
+            "description": "The scan finished, see the response body for the dangling references.",
+            "schema": {
+              "$ref": "#/definitions/DanglingReferencesReport"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -1797,6 +2003,12 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous, e.g. the class doesn't exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -1805,32 +2017,23 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.local.manipulate"
         ]
       }
     },
-    "/schema/things": {
-      "post": {
+    "/meta": {
+      "get": {
+        "description": "Gives meta information about the server and can be used to provide information to another Weaviate instance that wants to interact with the current instance.",
         "tags": [
-          "schema"
-        ],
-        "summary": "Create a new Thing class in the ontology.",
-        "operationId": "schema.things.create",
-        "parameters": [
-          {
-            "name": "thingClass",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
-          }
+          "meta"
         ],
+        "summary": "Returns meta information of the current Weaviate instance.",
+        "operationId": "meta.get",
         "responses": {
           "200": {
-            "description": "Added the new Thing class to the ontology.",
+            "description": "Successful response.",
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/Meta"
             }
           },
           "401": {
@@ -1842,12 +2045,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Invalid Thing class.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -1855,51 +2052,64 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add.meta"
+          "weaviate.local.query.meta"
         ]
       }
     },
-    "/schema/things/{className}": {
+    "/p2p/genesis": {
       "put": {
+        "security": [],
+        "description": "Receive an update from the Genesis server.",
         "tags": [
-          "schema"
+          "P2P"
         ],
-        "summary": "Rename, or replace the keywords of the Thing.",
-        "operationId": "schema.things.update",
+        "operationId": "p2p.genesis_update",
         "parameters": [
           {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
+            "name": "peers",
             "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/PeerUpdateList"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Changes applied."
+            "description": "Alive and kicking!"
           },
           "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "Unauthorized update."
           },
-          "422": {
-            "description": "Invalid update.",
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.network.query.meta"
+        ]
+      }
+    },
+    "/p2p/health": {
+      "get": {
+        "security": [],
+        "description": "Check if a peer is alive and healthy.",
+        "tags": [
+          "P2P"
+        ],
+        "summary": "Check if a peer is alive.",
+        "operationId": "p2p.health",
+        "responses": {
+          "200": {
+            "description": "Alive and kicking!"
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -1908,32 +2118,29 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.network.query.meta"
         ]
-      },
-      "delete": {
+      }
+    },
+    "/roles": {
+      "get": {
+        "description": "Lists the roles from the configuration file as well as those created through the api.",
         "tags": [
-          "schema"
-        ],
-        "summary": "Remove a Thing class (and all data in the instances) from the ontology.",
-        "operationId": "schema.things.delete",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          }
+          "roles"
         ],
+        "summary": "List all roles",
+        "operationId": "roles.list",
         "responses": {
           "200": {
-            "description": "Removed the Thing class from the ontology."
-          },
-          "400": {
-            "description": "Could not delete the Thing class.",
+            "description": "All roles.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Role"
+              }
             }
           },
           "401": {
@@ -1945,6 +2152,12 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "422": {
+            "description": "Invalid role or rbac authorization is not enabled.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -1953,38 +2166,31 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.roles.list"
         ]
-      }
-    },
-    "/schema/things/{className}/properties": {
+      },
       "post": {
+        "description": "Creates a role, which takes effect immediately.",
         "tags": [
-          "schema"
+          "roles"
         ],
-        "summary": "Add a property to a Thing class.",
-        "operationId": "schema.things.properties.add",
+        "summary": "Create a role",
+        "operationId": "roles.create",
         "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Property"
+              "$ref": "#/definitions/Role"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Added the property.",
+          "201": {
+            "description": "Role created.",
             "schema": {
-              "$ref": "#/definitions/Property"
+              "$ref": "#/definitions/Role"
             }
           },
           "401": {
@@ -1997,7 +2203,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Invalid property.",
+            "description": "Invalid role or rbac authorization is not enabled.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2010,27 +2216,75 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.roles.create"
         ]
       }
     },
-    "/schema/things/{className}/properties/{propertyName}": {
-      "put": {
+    "/roles/{name}": {
+      "get": {
+        "description": "Get a single role by its name.",
         "tags": [
-          "schema"
+          "roles"
         ],
-        "summary": "Rename, or replace the keywords of the property.",
-        "operationId": "schema.things.properties.update",
+        "summary": "Get a role",
+        "operationId": "roles.get",
         "parameters": [
           {
             "type": "string",
-            "name": "className",
+            "description": "name of the role",
+            "name": "name",
             "in": "path",
             "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Found the role, returned as body.",
+            "schema": {
+              "$ref": "#/definitions/Role"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Role does not exist"
+          },
+          "422": {
+            "description": "Invalid role or rbac authorization is not enabled.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.roles.get"
+        ]
+      },
+      "put": {
+        "description": "Replaces the permissions and bindings of a role created through the api.",
+        "tags": [
+          "roles"
+        ],
+        "summary": "Update a role",
+        "operationId": "roles.update",
+        "parameters": [
           {
             "type": "string",
-            "name": "propertyName",
+            "description": "name of the role",
+            "name": "name",
             "in": "path",
             "required": true
           },
@@ -2039,13 +2293,16 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Property"
+              "$ref": "#/definitions/Role"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Changes applied."
+            "description": "Role updated.",
+            "schema": {
+              "$ref": "#/definitions/Role"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2056,8 +2313,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Not Found - Role does not exist"
+          },
           "422": {
-            "description": "Invalid update.",
+            "description": "Invalid role or rbac authorization is not enabled.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2070,32 +2330,28 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.roles.update"
         ]
       },
       "delete": {
+        "description": "Deletes a role created through the api, which takes effect immediately.",
         "tags": [
-          "schema"
+          "roles"
         ],
-        "summary": "Remove a property from a Thing class.",
-        "operationId": "schema.things.properties.delete",
+        "summary": "Delete a role",
+        "operationId": "roles.delete",
         "parameters": [
           {
             "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "propertyName",
+            "description": "name of the role",
+            "name": "name",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Removed the property from the ontology."
+          "204": {
+            "description": "Role deleted."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2106,6 +2362,15 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Not Found - Role does not exist"
+          },
+          "422": {
+            "description": "Invalid role or rbac authorization is not enabled.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -2114,31 +2379,30 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.roles.delete"
         ]
       }
     },
-    "/things": {
+    "/schema": {
       "get": {
-        "description": "Lists all Things in reverse order of creation, owned by the user that belongs to the used token.",
         "tags": [
-          "things"
-        ],
-        "summary": "Get a list of Things.",
-        "operationId": "things.list",
-        "parameters": [
-          {
-            "$ref": "#/parameters/CommonLimitParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonMetaParameterQuery"
-          }
+          "schema"
         ],
+        "summary": "Dump the current the database schema.",
+        "operationId": "schema.dump",
         "responses": {
           "200": {
-            "description": "Successful response.",
+            "description": "Successfully dumped the database schema.",
             "schema": {
-              "$ref": "#/definitions/ThingsListResponse"
+              "type": "object",
+              "properties": {
+                "actions": {
+                  "$ref": "#/definitions/Schema"
+                },
+                "things": {
+                  "$ref": "#/definitions/Schema"
+                }
+              }
             }
           },
           "401": {
@@ -2150,9 +2414,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -2160,34 +2421,33 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query"
+          "weaviate.local.query.meta"
         ]
-      },
+      }
+    },
+    "/schema/actions": {
       "post": {
-        "description": "Registers a new Thing. Given meta-data and schema values are validated.",
         "tags": [
-          "things"
+          "schema"
         ],
-        "summary": "Create a new Thing based on a Thing template.",
-        "operationId": "things.create",
+        "summary": "Create a new Action class in the ontology.",
+        "operationId": "schema.actions.create",
         "parameters": [
           {
-            "name": "body",
+            "name": "actionClass",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Thing"
+              "$ref": "#/definitions/Class"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Thing created.",
+            "description": "Added the new Action class to the ontology.",
             "schema": {
-              "$ref": "#/definitions/Thing"
+              "$ref": "#/definitions/Class"
             }
           },
           "401": {
@@ -2200,7 +2460,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid Action class",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2212,34 +2472,37 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.local.add.meta"
         ]
       }
     },
-    "/things/validate": {
-      "post": {
-        "description": "Validate a Thing's schema and meta-data. It has to be based on a schema, which is related to the given Thing to be accepted by this validation.",
+    "/schema/actions/{className}": {
+      "put": {
         "tags": [
-          "things"
+          "schema"
         ],
-        "summary": "Validate Things schema.",
-        "operationId": "things.validate",
+        "summary": "Rename, or replace the keywords of the Action.",
+        "operationId": "schema.actions.update",
         "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Thing"
+              "$ref": "#/definitions/Class"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully validated."
+            "description": "Changes applied."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2251,7 +2514,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid update.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2263,39 +2526,32 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.local.manipulate.meta"
         ]
-      }
-    },
-    "/things/{id}": {
-      "get": {
-        "description": "Returns a particular Thing data.",
+      },
+      "delete": {
         "tags": [
-          "things"
+          "schema"
         ],
-        "summary": "Get a Thing based on its UUID.",
-        "operationId": "things.get",
+        "summary": "Remove an Action class (and all data in the instances) from the ontology.",
+        "operationId": "schema.actions.delete",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Thing.",
-            "name": "id",
+            "name": "className",
             "in": "path",
             "required": true
-          },
-          {
-            "$ref": "#/parameters/CommonMetaParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful response.",
+            "description": "Removed the Action class from the ontology."
+          },
+          "400": {
+            "description": "Could not delete the Action class.",
             "schema": {
-              "$ref": "#/definitions/Thing"
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -2307,9 +2563,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -2317,25 +2570,22 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query"
+          "weaviate.local.manipulate.meta"
         ]
-      },
-      "put": {
-        "description": "Updates a Thing's data. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+      }
+    },
+    "/schema/actions/{className}/properties": {
+      "post": {
         "tags": [
-          "things"
+          "schema"
         ],
-        "summary": "Update a Thing based on its UUID.",
-        "operationId": "things.update",
+        "summary": "Add a property to an Action class.",
+        "operationId": "schema.actions.properties.add",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Thing.",
-            "name": "id",
+            "name": "className",
             "in": "path",
             "required": true
           },
@@ -2344,15 +2594,15 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Thing"
+              "$ref": "#/definitions/Property"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully received.",
+            "description": "Added the property.",
             "schema": {
-              "$ref": "#/definitions/Thing"
+              "$ref": "#/definitions/Property"
             }
           },
           "401": {
@@ -2364,11 +2614,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid property.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2380,32 +2627,43 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.manipulate.meta"
         ]
-      },
-      "delete": {
-        "description": "Deletes a Thing from the system. All Actions pointing to this Thing, where the Thing is the object of the Action, are also being deleted.",
+      }
+    },
+    "/schema/actions/{className}/properties/{propertyName}": {
+      "put": {
         "tags": [
-          "things"
+          "schema"
         ],
-        "summary": "Delete a Thing based on its UUID.",
-        "operationId": "things.delete",
+        "summary": "Rename, or replace the keywords of the property.",
+        "operationId": "schema.actions.properties.update",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Thing.",
-            "name": "id",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "propertyName",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Property"
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
+          "200": {
+            "description": "Changes applied."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2416,8 +2674,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
+          "422": {
+            "description": "Invalid update.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -2426,43 +2687,33 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.manipulate.meta"
         ]
       },
-      "patch": {
-        "description": "Updates a Thing's data. This method supports patch semantics. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+      "delete": {
         "tags": [
-          "things"
+          "schema"
         ],
-        "summary": "Update a Thing based on its UUID (using patch semantics).",
-        "operationId": "things.patch",
+        "summary": "Remove a property from an Action class.",
+        "operationId": "schema.actions.properties.delete",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Thing.",
-            "name": "id",
+            "name": "className",
             "in": "path",
             "required": true
           },
           {
-            "description": "RFC 7396-style patch, the body contains the thing object to merge into the existing thing object.",
-            "name": "body",
-            "in": "body",
-            "schema": {
-              "$ref": "#/definitions/Thing"
-            }
+            "type": "string",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully applied. No content returned"
-          },
-          "400": {
-            "description": "The patch-JSON is malformed."
+          "200": {
+            "description": "Removed the property from the ontology."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2473,15 +2724,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
-          "422": {
-            "description": "The patch-JSON is valid but unprocessable.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -2489,49 +2731,41 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.manipulate.meta"
         ]
       }
     },
-    "/things/{id}/references/{propertyName}": {
-      "put": {
-        "description": "Replace all references to a class-property.",
+    "/schema/actions/{className}/revectorize": {
+      "post": {
+        "description": "Start a revectorization which recomputes the vectors of all actions of the class, or of those matching the where filter, with the current vectorizer settings and stores them in batches. The revectorization runs in the background, use GET /schema/revectorizations/{id} to retrieve its progress.",
         "tags": [
-          "things"
+          "schema"
         ],
-        "summary": "Replace all references to a class-property.",
-        "operationId": "things.references.update",
+        "summary": "Recompute the vectors of the Actions of a class.",
+        "operationId": "schema.actions.revectorize",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Thing.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Thing.",
-            "name": "propertyName",
+            "name": "className",
             "in": "path",
             "required": true
           },
           {
+            "description": "optional where filter to limit the revectorized actions",
             "name": "body",
             "in": "body",
-            "required": true,
             "schema": {
-              "$ref": "#/definitions/MultipleRef"
+              "$ref": "#/definitions/Revectorization"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successfully replaced all the references (success is based on the behavior of the datastore)."
+          "202": {
+            "description": "Successfully started the revectorization.",
+            "schema": {
+              "$ref": "#/definitions/Revectorization"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2542,8 +2776,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "409": {
+            "description": "A revectorization of the class is already running",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "Invalid revectorization, e.g. the class does not exist or has no vectorizer",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2555,47 +2795,28 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.manipulate.meta"
         ]
-      },
-      "post": {
-        "description": "Add a single reference to a class-property when cardinality is set to 'hasMany'.",
+      }
+    },
+    "/schema/reindexes": {
+      "get": {
+        "description": "List the reindex jobs which were started by renaming a class or property or by dropping a property. Jobs are kept in memory, so only the jobs started since the last restart are listed.",
         "tags": [
-          "things"
-        ],
-        "summary": "Add a single reference to a class-property when cardinality is set to 'hasMany'.",
-        "operationId": "things.references.create",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Thing.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Thing.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/SingleRef"
-            }
-          }
+          "schema"
         ],
+        "summary": "List reindex jobs",
+        "operationId": "schema.reindexes.list",
         "responses": {
           "200": {
-            "description": "Successfully added the reference."
+            "description": "Successfully listed the reindex jobs.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/SchemaReindex"
+              }
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2606,12 +2827,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -2619,47 +2834,35 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query.meta"
         ]
-      },
-      "delete": {
-        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
+      }
+    },
+    "/schema/reindexes/{id}": {
+      "get": {
+        "description": "Get the status and progress of a reindex job",
         "tags": [
-          "things"
+          "schema"
         ],
-        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
-        "operationId": "things.references.delete",
+        "summary": "View a reindex job",
+        "operationId": "schema.reindexes.get",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "Unique ID of the Thing.",
+            "description": "reindex job id",
             "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Thing.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/SingleRef"
-            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
+          "200": {
+            "description": "Found the reindex job, returned as body",
+            "schema": {
+              "$ref": "#/definitions/SchemaReindex"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2671,10 +2874,7 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "Not Found - Reindex job does not exist"
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -2683,1333 +2883,4651 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query.meta"
         ]
       }
-    }
-  },
-  "definitions": {
-    "Action": {
-      "type": "object",
-      "properties": {
-        "class": {
-          "description": "Type of the Action, defined in the schema.",
-          "type": "string"
-        },
-        "creationTimeUnix": {
-          "description": "Timestamp of creation of this Action in milliseconds since epoch UTC.",
-          "type": "integer",
-          "format": "int64"
-        },
-        "id": {
-          "description": "ID of the Action.",
-          "type": "string",
-          "format": "uuid"
-        },
-        "lastUpdateTimeUnix": {
-          "description": "Timestamp of the last update made to the Action since epoch UTC.",
-          "type": "integer",
-          "format": "int64"
-        },
-        "meta": {
-          "$ref": "#/definitions/ObjectMeta"
-        },
-        "schema": {
-          "$ref": "#/definitions/PropertySchema"
-        },
-        "vectorWeights": {
-          "$ref": "#/definitions/VectorWeights"
-        }
-      }
     },
-    "ActionsGetResponse": {
-      "type": "object",
-      "allOf": [
-        {
-          "$ref": "#/definitions/Action"
-        },
-        {
-          "properties": {
-            "result": {
-              "description": "Results for this specific Action.",
-              "format": "object",
-              "properties": {
-                "errors": {
-                  "$ref": "#/definitions/ErrorResponse"
-                },
-                "status": {
-                  "type": "string",
-                  "default": "SUCCESS",
-                  "enum": [
-                    "SUCCESS",
-                    "PENDING",
-                    "FAILED"
-                  ]
-                }
+    "/schema/revectorizations": {
+      "get": {
+        "description": "List the revectorizations, most recently started first. Revectorizations are kept in memory, so only the revectorizations started since the last restart are listed, of which at most the 100 most recent finished ones are kept. Only revectorizations of classes the user is allowed to view are listed.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "List revectorizations",
+        "operationId": "schema.revectorizations.list",
+        "responses": {
+          "200": {
+            "description": "Successfully listed the revectorizations.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Revectorization"
               }
             }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        }
-      ]
-    },
-    "ActionsListResponse": {
-      "description": "List of Actions.",
-      "type": "object",
-      "properties": {
-        "actions": {
-          "description": "The actual list of Actions.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Action"
-          }
-        },
-        "totalResults": {
-          "description": "The total number of Actions for the query. The number of items in a response may be smaller due to paging.",
-          "type": "integer",
-          "format": "int64"
-        }
-      }
-    },
-    "BatchReference": {
-      "properties": {
-        "from": {
-          "description": "Long-form beacon-style URI to identify the source of the cross-ref including the property name. Should be in the form of weaviate://localhost/\u003ckinds\u003e/\u003cuuid\u003e/\u003cclassName\u003e/\u003cpropertyName\u003e, where \u003ckinds\u003e must be one of 'actions', 'things' and \u003cclassName\u003e and \u003cpropertyName\u003e must represent the cross-ref property of source class to be used.",
-          "type": "string",
-          "format": "uri",
-          "example": "weaviate://localhost/things/Zoo/a5d09582-4239-4702-81c9-92a6e0122bb4/hasAnimals"
         },
-        "to": {
-          "description": "Short-form URI to point to the cross-ref. Should be in the form of weaviate://localhost/things/\u003cuuid\u003e for the example of a local cross-ref to a thing",
-          "type": "string",
-          "format": "uri",
-          "example": "weaviate://localhost/things/97525810-a9a5-4eb0-858a-71449aeb007f"
-        }
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
       }
     },
-    "BatchReferenceResponse": {
-      "type": "object",
-      "allOf": [
-        {
-          "$ref": "#/definitions/BatchReference"
-        },
-        {
-          "properties": {
-            "result": {
-              "description": "Results for this specific reference.",
-              "format": "object",
-              "properties": {
-                "errors": {
-                  "$ref": "#/definitions/ErrorResponse"
-                },
-                "status": {
-                  "type": "string",
-                  "default": "SUCCESS",
-                  "enum": [
-                    "SUCCESS",
-                    "PENDING",
-                    "FAILED"
-                  ]
-                }
-              }
+    "/schema/revectorizations/{id}": {
+      "get": {
+        "description": "Get the status and progress of a revectorization.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Get a revectorization",
+        "operationId": "schema.revectorizations.get",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "revectorization id",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Found the revectorization, returned as body",
+            "schema": {
+              "$ref": "#/definitions/Revectorization"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Revectorization does not exist"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
-      ]
-    },
-    "C11yExtension": {
-      "description": "A resource describing an extension to the contextinoary, containing both the identifier and the definition of the extension",
-      "properties": {
-        "concept": {
-          "description": "The new concept you want to extend. Must be an all-lowercase single word, or a space delimited compound word. Examples: 'foobarium', 'my custom concept'",
-          "type": "string",
-          "example": "foobarium"
         },
-        "definition": {
-          "description": "A list of space-delimited words or a sentence describing what the custom concept is about. Avoid using the custom concept itself. An Example definition for the custom concept 'foobarium': would be 'a naturally occourring element which can only be seen by programmers'",
-          "type": "string"
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      },
+      "delete": {
+        "description": "Cancel a running revectorization. Objects which have already been revectorized keep their new vectors, all other objects keep their previous vectors.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Cancel a running revectorization",
+        "operationId": "schema.revectorizations.cancel",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "revectorization id",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Cancellation requested, the revectorization is returned as body",
+            "schema": {
+              "$ref": "#/definitions/Revectorization"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Revectorization does not exist"
+          },
+          "409": {
+            "description": "The revectorization is not running",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "weight": {
-          "description": "Weight of the definition of the new concept where 1='override existing definition entirely' and 0='ignore custom definition'. Note that if the custom concept is not present in the contextionary yet, the weight cannot be less than 1.",
-          "type": "number",
-          "format": "float"
-        }
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
-    "C11yNearestNeighbors": {
-      "description": "C11y function to show the nearest neighbors to a word.",
-      "type": "array",
-      "items": {
-        "type": "object",
-        "properties": {
-          "distance": {
-            "type": "number",
-            "format": "float"
-          },
-          "word": {
-            "type": "string"
-          }
-        }
-      }
-    },
-    "C11yVector": {
-      "description": "A Vector in the Contextionary",
-      "type": "array",
-      "items": {
-        "type": "number",
-        "format": "float",
-        "maximum": 300,
-        "minimum": 300
-      }
-    },
-    "C11yVectorBasedQuestion": {
-      "description": "Receive question based on array of classes, properties and values.",
-      "type": "array",
-      "items": {
-        "type": "object",
-        "properties": {
-          "classProps": {
-            "description": "Vectorized properties.",
-            "type": "array",
-            "maxItems": 300,
-            "minItems": 300,
-            "items": {
-              "type": "object",
-              "properties": {
-                "propsVectors": {
-                  "type": "array",
-                  "items": {
-                    "type": "number",
-                    "format": "float"
-                  }
-                },
-                "value": {
-                  "description": "String with valuename.",
-                  "type": "string"
-                }
-              }
+    "/schema/things": {
+      "post": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Create a new Thing class in the ontology.",
+        "operationId": "schema.things.create",
+        "parameters": [
+          {
+            "name": "thingClass",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Added the new Thing class to the ontology.",
+            "schema": {
+              "$ref": "#/definitions/Class"
             }
           },
-          "classVectors": {
-            "description": "Vectorized classname.",
-            "type": "array",
-            "maxItems": 300,
-            "minItems": 300,
-            "items": {
-              "type": "number",
-              "format": "float"
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Thing class.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
+        },
+        "x-serviceIds": [
+          "weaviate.local.add.meta"
+        ]
       }
     },
-    "C11yWordsResponse": {
-      "description": "An array of available words and contexts.",
-      "properties": {
-        "concatenatedWord": {
-          "description": "Weighted results for all words",
-          "type": "object",
-          "properties": {
-            "concatenatedNearestNeighbors": {
-              "$ref": "#/definitions/C11yNearestNeighbors"
-            },
-            "concatenatedVector": {
-              "$ref": "#/definitions/C11yVector"
-            },
-            "concatenatedWord": {
-              "type": "string"
-            },
-            "singleWords": {
-              "type": "array",
-              "items": {
-                "format": "string"
-              }
+    "/schema/things/{className}": {
+      "put": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Rename, or replace the keywords of the Thing.",
+        "operationId": "schema.things.update",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/Class"
             }
           }
-        },
-        "individualWords": {
-          "description": "Weighted results for per individual word",
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "inC11y": {
-                "type": "boolean"
-              },
-              "info": {
-                "type": "object",
-                "properties": {
-                  "nearestNeighbors": {
-                    "$ref": "#/definitions/C11yNearestNeighbors"
-                  },
-                  "vector": {
-                    "$ref": "#/definitions/C11yVector"
-                  }
-                }
-              },
-              "word": {
-                "type": "string"
-              }
+        ],
+        "responses": {
+          "200": {
+            "description": "Changes applied."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid update.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
-      }
-    },
-    "Class": {
-      "type": "object",
-      "properties": {
-        "class": {
-          "description": "Name of the class as URI relative to the schema URL.",
-          "type": "string"
-        },
-        "description": {
-          "description": "Description of the class.",
-          "type": "string"
-        },
-        "keywords": {
-          "$ref": "#/definitions/Keywords"
         },
-        "properties": {
-          "description": "The properties of the class.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Property"
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      },
+      "delete": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Remove a Thing class (and all data in the instances) from the ontology.",
+        "operationId": "schema.things.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Removed the Thing class from the ontology."
+          },
+          "400": {
+            "description": "Could not delete the Thing class.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "vectorizeClassName": {
-          "description": "Set this to true if the object vector should include the class name in calculating the overall vector position",
-          "type": "boolean",
-          "x-nullable": true
-        }
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
-    "Classification": {
-      "description": "Manage classifications, trigger them and view status of past classifications.",
-      "type": "object",
-      "properties": {
-        "basedOnProperties": {
-          "description": "base the text-based classification on these fields (of type text)",
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/schema/things/{className}/properties": {
+      "post": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Add a property to a Thing class.",
+        "operationId": "schema.things.properties.add",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
           },
-          "example": [
-            "description"
-          ]
-        },
-        "class": {
-          "description": "class (name) which is used in this classification",
-          "type": "string",
-          "example": "City"
-        },
-        "classifyProperties": {
-          "description": "which ref-property to set as part of the classification",
-          "type": "array",
-          "items": {
-            "type": "string"
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Property"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Added the property.",
+            "schema": {
+              "$ref": "#/definitions/Property"
+            }
           },
-          "example": [
-            "inCountry"
-          ]
-        },
-        "error": {
-          "description": "error message if status == failed",
-          "type": "string",
-          "default": "",
-          "example": "classify xzy: something went wrong"
-        },
-        "id": {
-          "description": "ID to uniquely identify this classification run",
-          "type": "string",
-          "format": "uuid",
-          "example": "ee722219-b8ec-4db1-8f8d-5150bb1a9e0c"
-        },
-        "k": {
-          "description": "k-value when using k-Neareast-Neighbor",
-          "type": "integer",
-          "format": "int32",
-          "default": 3,
-          "example": 3
-        },
-        "meta": {
-          "description": "additional meta information about the classification",
-          "type": "object",
-          "$ref": "#/definitions/ClassificationMeta"
-        },
-        "sourceWhere": {
-          "description": "limit the objects to be classified",
-          "type": "object",
-          "$ref": "#/definitions/WhereFilter"
-        },
-        "status": {
-          "description": "status of this classification",
-          "type": "string",
-          "enum": [
-            "running",
-            "completed",
-            "failed"
-          ],
-          "example": "running"
-        },
-        "targetWhere": {
-          "description": "Limit the possible sources when using an algorithm which doesn't really on trainig data, e.g. 'contextual'. When using an algorithm with a training set, such as 'knn', limit the training set instead",
-          "type": "object",
-          "$ref": "#/definitions/WhereFilter"
-        },
-        "trainingSetWhere": {
-          "description": "Limit the training objects to be considered during the classification. Can only be used on types with explicit training sets, such as 'knn'",
-          "type": "object",
-          "$ref": "#/definitions/WhereFilter"
-        },
-        "type": {
-          "description": "which algorythim to use for classifications",
-          "type": "string",
-          "default": "knn",
-          "enum": [
-            "knn",
-            "contextual"
-          ],
-          "example": "knn"
-        }
-      }
-    },
-    "ClassificationMeta": {
-      "description": "Additional information to a specific classification",
-      "type": "object",
-      "properties": {
-        "completed": {
-          "description": "time when this classification finished",
-          "type": "string",
-          "format": "date-time",
-          "example": "2017-07-21T17:32:28Z"
-        },
-        "count": {
-          "description": "number of objects which were taken into consideration for classification",
-          "type": "integer",
-          "example": 147
-        },
-        "countFailed": {
-          "description": "number of objects which could not be classified - see error message for details",
-          "type": "integer",
-          "example": 7
-        },
-        "countSucceeded": {
-          "description": "number of objects successfully classified",
-          "type": "integer",
-          "example": 140
-        },
-        "started": {
-          "description": "time when this classification was started",
-          "type": "string",
-          "format": "date-time",
-          "example": "2017-07-21T17:32:28Z"
-        }
-      }
-    },
-    "ErrorResponse": {
-      "description": "An error response given by Weaviate end-points.",
-      "type": "object",
-      "properties": {
-        "error": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "message": {
-                "type": "string"
-              }
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid property.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
-      }
-    },
-    "GeoCoordinates": {
-      "properties": {
-        "latitude": {
-          "description": "The latitude of the point on earth in decimal form",
-          "type": "number",
-          "format": "float"
         },
-        "longitude": {
-          "description": "The longitude of the point on earth in decimal form",
-          "type": "number",
-          "format": "float"
-        }
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
-    "GraphQLError": {
-      "description": "An error response caused by a GraphQL query.",
-      "properties": {
-        "locations": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "column": {
-                "type": "integer",
-                "format": "int64"
-              },
-              "line": {
-                "type": "integer",
-                "format": "int64"
-              }
+    "/schema/things/{className}/properties/{propertyName}": {
+      "put": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Rename, or replace the keywords of the property.",
+        "operationId": "schema.things.properties.update",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Property"
             }
           }
-        },
-        "message": {
-          "type": "string"
-        },
-        "path": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        }
-      }
-    },
-    "GraphQLQueries": {
-      "description": "A list of GraphQL queries.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/GraphQLQuery"
-      }
-    },
-    "GraphQLQuery": {
-      "description": "GraphQL query based on: http://facebook.github.io/graphql/.",
-      "type": "object",
-      "properties": {
-        "operationName": {
-          "description": "The name of the operation if multiple exist in the query.",
-          "type": "string"
-        },
-        "query": {
-          "description": "Query based on GraphQL syntax.",
-          "type": "string"
-        },
-        "variables": {
-          "description": "Additional variables for the query.",
-          "type": "object"
-        }
-      }
-    },
-    "GraphQLResponse": {
-      "description": "GraphQL based response: http://facebook.github.io/graphql/.",
-      "properties": {
-        "data": {
-          "description": "GraphQL data object.",
-          "type": "object",
-          "additionalProperties": {
-            "$ref": "#/definitions/JsonObject"
+        ],
+        "responses": {
+          "200": {
+            "description": "Changes applied."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid update.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "errors": {
-          "description": "Array with errors.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/GraphQLError"
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      },
+      "delete": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Remove a property from a Thing class.",
+        "operationId": "schema.things.properties.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
           }
-        }
-      }
-    },
-    "GraphQLResponses": {
-      "description": "A list of GraphQL responses.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/GraphQLResponse"
-      }
-    },
-    "JsonObject": {
-      "description": "JSON object value.",
-      "type": "object"
-    },
-    "Keywords": {
-      "description": "Describes a class or property using multiple weighted words.",
-      "type": "array",
-      "items": {
-        "type": "object",
-        "properties": {
-          "keyword": {
-            "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "Removed the property from the ontology."
           },
-          "weight": {
-            "type": "number",
-            "format": "float"
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        }
-      }
-    },
-    "Link": {
-      "type": "object",
-      "properties": {
-        "documentationHref": {
-          "description": "weaviate documentation about this resource group",
-          "type": "string"
-        },
-        "href": {
-          "description": "target of the link",
-          "type": "string"
-        },
-        "name": {
-          "description": "human readable name of the resource group",
-          "type": "string"
-        },
-        "rel": {
-          "description": "relationship if both resources are related, e.g. 'next', 'previous', 'parent', etc.",
-          "type": "string"
-        }
-      }
-    },
-    "Meta": {
-      "description": "Contains meta information of the current Weaviate instance.",
-      "type": "object",
-      "properties": {
-        "contextionaryVersion": {
-          "description": "Version of the contextionary service connected to weaviate",
-          "type": "string"
-        },
-        "contextionaryWordCount": {
-          "description": "Number of total words in the contextionary",
-          "type": "number",
-          "format": "int"
-        },
-        "hostname": {
-          "description": "The url of the host.",
-          "type": "string",
-          "format": "url"
-        },
-        "version": {
-          "description": "Version of weaviate you are currently running",
-          "type": "string"
-        }
-      }
-    },
-    "MultipleRef": {
-      "description": "Multiple instances of references to other objects.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/SingleRef"
-      }
-    },
-    "ObjectMeta": {
-      "description": "Additional Meta information about a single thing/action object.",
-      "properties": {
-        "classification": {
-          "description": "If this object was subject of a classificiation, additional meta info about this classification is available here",
-          "$ref": "#/definitions/ObjectMetaClassification"
         },
-        "vector": {
-          "description": "This object's position in the Contextionary vector space",
-          "$ref": "#/definitions/C11yVector"
-        }
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
-    "ObjectMetaClassification": {
-      "description": "This meta field contains additional info about the classification which affected this object",
-      "properties": {
-        "basedOn": {
-          "description": "The (primitive) field(s) which were used as a basis for classification. For example, if the type of classification is \"knn\" with k=3, the 3 nearest neighbors - based on these fields - were considered for the classification.",
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/schema/things/{className}/revectorize": {
+      "post": {
+        "description": "Start a revectorization which recomputes the vectors of all things of the class, or of those matching the where filter, with the current vectorizer settings and stores them in batches. The revectorization runs in the background, use GET /schema/revectorizations/{id} to retrieve its progress.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Recompute the vectors of the Things of a class.",
+        "operationId": "schema.things.revectorize",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "optional where filter to limit the revectorized things",
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/Revectorization"
+            }
           }
-        },
-        "classifiedFields": {
-          "description": "The (reference) fields which were classified as part of this classification. Note that this might contain fewere entries than \"scope\", if one of the fields was already set prior to the classification, for example",
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "202": {
+            "description": "Successfully started the revectorization.",
+            "schema": {
+              "$ref": "#/definitions/Revectorization"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "A revectorization of the class is already running",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid revectorization, e.g. the class does not exist or has no vectorizer",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "completed": {
-          "description": "Timestamp when this particular object was classified. This is usually sooner than the overall completion time of the classification, as the overall completion time will only be set once every object has been classified.",
-          "type": "string",
-          "format": "date-time"
-        },
-        "id": {
-          "description": "unique identifier of the classification run",
-          "type": "string",
-          "format": "uuid"
-        },
-        "scope": {
-          "description": "The properties in scope of the classification. Note that this doesn't mean that these fields were necessarily classified, this only means that those fields were in scope of the classificiation. See \"classifiedFields\" for details.",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        }
-      }
-    },
-    "PatchDocumentAction": {
-      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
-      "required": [
-        "op",
-        "path"
-      ],
-      "properties": {
-        "from": {
-          "description": "A string containing a JSON Pointer value.",
-          "type": "string"
-        },
-        "merge": {
-          "$ref": "#/definitions/Thing"
-        },
-        "op": {
-          "description": "The operation to be performed.",
-          "type": "string",
-          "enum": [
-            "add",
-            "remove",
-            "replace",
-            "move",
-            "copy",
-            "test"
-          ]
-        },
-        "path": {
-          "description": "A JSON-Pointer.",
-          "type": "string"
-        },
-        "value": {
-          "description": "The value to be used within the operations.",
-          "type": "object"
-        }
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
-    "PatchDocumentThing": {
-      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
-      "required": [
-        "op",
-        "path"
-      ],
-      "properties": {
-        "from": {
-          "description": "A string containing a JSON Pointer value.",
-          "type": "string"
-        },
-        "merge": {
-          "$ref": "#/definitions/Thing"
-        },
-        "op": {
-          "description": "The operation to be performed.",
-          "type": "string",
-          "enum": [
-            "add",
-            "remove",
-            "replace",
-            "move",
-            "copy",
-            "test"
-          ]
+    "/things": {
+      "get": {
+        "description": "Lists all Things in reverse order of creation, owned by the user that belongs to the used token.",
+        "tags": [
+          "things"
+        ],
+        "summary": "Get a list of Things.",
+        "operationId": "things.list",
+        "parameters": [
+          {
+            "$ref": "#/parameters/CommonLimitParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonOffsetParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonAfterParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonSortParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonMetaParameterQuery"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/ThingsListResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "Invalid paging parameters.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "path": {
-          "description": "A JSON-Pointer.",
-          "type": "string"
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
+      },
+      "post": {
+        "description": "Registers a new Thing. Given meta-data and schema values are validated.",
+        "tags": [
+          "things"
+        ],
+        "summary": "Create a new Thing based on a Thing template.",
+        "operationId": "things.create",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Thing"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Thing created.",
+            "schema": {
+              "$ref": "#/definitions/Thing"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "value": {
-          "description": "The value to be used within the operations.",
-          "type": "object"
-        }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.add"
+        ]
       }
     },
-    "PeerUpdate": {
-      "description": "A single peer in the network.",
-      "properties": {
-        "id": {
-          "description": "The session ID of the peer.",
-          "type": "string",
-          "format": "uuid"
-        },
-        "name": {
-          "description": "Human readable name.",
-          "type": "string"
-        },
-        "schemaHash": {
-          "description": "The latest known hash of the peer's schema.",
-          "type": "string"
+    "/things/export": {
+      "get": {
+        "description": "Streams all Things of a class as newline-delimited JSON, one Thing per line. Each line contains the schema, the timestamps, the vector weights and the stored vector of the Thing, so that the export can be imported again as-is through the batch endpoint.",
+        "produces": [
+          "application/x-ndjson"
+        ],
+        "tags": [
+          "things"
+        ],
+        "summary": "Export all Things of a class.",
+        "operationId": "things.export",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the class to export.",
+            "name": "class",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response, the body contains one Thing per line."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "There is no Thing class with this name.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "uri": {
-          "description": "The location where the peer is exposed to the internet.",
-          "type": "string",
-          "format": "uri"
-        }
-      }
-    },
-    "PeerUpdateList": {
-      "description": "List of known peers.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/PeerUpdate"
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
       }
     },
-    "PhoneNumber": {
-      "properties": {
-        "countryCode": {
-          "description": "Read-only. The numerical country code (e.g. 49)",
-          "type": "number",
-          "format": "uint64"
-        },
-        "defaultCountry": {
-          "description": "Optional. The ISO 3166-1 alpha-2 country code. This is used to figure out the correct countryCode and international format if only a national number (e.g. 0123 4567) is provided",
-          "type": "string"
-        },
-        "input": {
-          "description": "The raw input as the phone number is present in your raw data set. It will be parsed into the standardized formats if valid.",
-          "type": "string"
-        },
-        "internationalFormatted": {
-          "description": "Read-only. Parsed result in the international format (e.g. +49 123 ...)",
-          "type": "string"
-        },
-        "national": {
-          "description": "Read-only. The numerical representation of the national part",
-          "type": "number",
-          "format": "uint64"
-        },
-        "nationalFormatted": {
-          "description": "Read-only. Parsed result in the national format (e.g. 0123 456789)",
-          "type": "string"
-        },
-        "valid": {
-          "description": "Read-only. Indicates whether the parsed number is a valid phone number",
-          "type": "boolean"
-        }
-      }
-    },
-    "Principal": {
-      "type": "object",
-      "properties": {
-        "groups": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/things/validate": {
+      "post": {
+        "description": "Validate a Thing's schema and meta-data. It has to be based on a schema, which is related to the given Thing to be accepted by this validation.",
+        "tags": [
+          "things"
+        ],
+        "summary": "Validate Things schema.",
+        "operationId": "things.validate",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Thing"
+            }
           }
-        },
-        "username": {
-          "description": "The username that was extracted either from the authentication information",
-          "type": "string"
-        }
-      }
-    },
-    "Property": {
-      "type": "object",
-      "properties": {
-        "cardinality": {
-          "description": "The cardinality of this property. If you want to store more than one value in a property, set this to 'many'. Defaults to 'atMostOne'. Note that by default properties can be empty in Weaviate.",
-          "type": "string",
-          "default": "atMostOne",
-          "enum": [
-            "atMostOne",
-            "many"
-          ]
-        },
-        "dataType": {
-          "description": "Can be a reference to another type when it starts with a capital (for example Person), otherwise \"string\" or \"int\".",
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully validated."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "description": {
-          "description": "Description of the property.",
-          "type": "string"
-        },
-        "index": {
-          "description": "Optional. By default each property is fully indexed both for full-text, as well as vector-search. You can ignore properties in searches by explicitly setting index to false. Not set is the same as true",
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "keywords": {
-          "$ref": "#/definitions/Keywords"
-        },
-        "name": {
-          "description": "Name of the property as URI relative to the schema URL.",
-          "type": "string"
-        },
-        "vectorizePropertyName": {
-          "description": "Set this to true if the object vector should include this property's name in calculating the overall vector position. If set to false (default), only the property value will be used.",
-          "type": "boolean"
-        }
-      }
-    },
-    "PropertySchema": {
-      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
-      "type": "object"
-    },
-    "ReferenceMeta": {
-      "description": "Additional Meta information about this particular reference.",
-      "properties": {
-        "classification": {
-          "description": "If a property was set through a classification, this meta field contains additional info",
-          "$ref": "#/definitions/ReferenceMetaClassification"
-        }
-      }
-    },
-    "ReferenceMetaClassification": {
-      "description": "This meta field contains additional info about the classified reference property",
-      "properties": {
-        "losingDistance": {
-          "description": "Mean distance of all neighbors from the losing group. Optional. If k equals the size of the winning group, there is no losing group.",
-          "type": "number",
-          "format": "float32",
-          "x-nullable": true
-        },
-        "winningDistance": {
-          "description": "Mean distance of all neighbors from the winning group",
-          "type": "number",
-          "format": "float32"
-        }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
       }
     },
-    "Schema": {
-      "description": "Definitions of semantic schemas (also see: https://github.com/semi-technologies/weaviate-semantic-schemas).",
-      "type": "object",
-      "properties": {
-        "classes": {
-          "description": "Semantic classes that are available.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Class"
+    "/things/{id}": {
+      "get": {
+        "description": "Returns a particular Thing data.",
+        "tags": [
+          "things"
+        ],
+        "summary": "Get a Thing based on its UUID.",
+        "operationId": "things.get",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Thing.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "$ref": "#/parameters/CommonMetaParameterQuery"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/Thing"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "maintainer": {
-          "description": "Email of the maintainer.",
-          "type": "string",
-          "format": "email"
-        },
-        "name": {
-          "description": "Name of the schema.",
-          "type": "string"
-        },
-        "type": {
-          "description": "Type of schema, should be \"thing\" or \"action\".",
-          "type": "string",
-          "enum": [
-            "thing",
-            "action"
-          ]
-        }
-      }
-    },
-    "SchemaHistory": {
-      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
-      "type": "object"
-    },
-    "SingleRef": {
-      "description": "Either set beacon (direct reference) or set class and schema (concept reference)",
-      "properties": {
-        "beacon": {
-          "description": "If using a direct reference, specify the URI to point to the cross-ref here. Should be in the form of weaviate://localhost/things/\u003cuuid\u003e for the example of a local cross-ref to a thing",
-          "type": "string",
-          "format": "uri"
-        },
-        "class": {
-          "description": "If using a concept reference (rather than a direct reference), specify the desired class name here",
-          "type": "string",
-          "format": "uri"
-        },
-        "href": {
-          "description": "If using a direct reference, this read-only fields provides a link to the refernced resource. If 'origin' is globally configured, an absolute URI is shown - a relative URI otherwise.",
-          "type": "string",
-          "format": "uri"
-        },
-        "meta": {
-          "description": "Additional Meta information about this particular reference. Only shown if meta==true.",
-          "$ref": "#/definitions/ReferenceMeta"
-        },
-        "schema": {
-          "description": "If using a concept reference (rather than a direct reference), specify the desired properties here",
-          "$ref": "#/definitions/PropertySchema"
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
+      },
+      "put": {
+        "description": "Updates a Thing's data. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+        "tags": [
+          "things"
+        ],
+        "summary": "Update a Thing based on its UUID.",
+        "operationId": "things.update",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Thing.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Thing"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully received.",
+            "schema": {
+              "$ref": "#/definitions/Thing"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "delete": {
+        "description": "Deletes a Thing from the system. All Actions pointing to this Thing, where the Thing is the object of the Action, are also being deleted.",
+        "tags": [
+          "things"
+        ],
+        "summary": "Delete a Thing based on its UUID.",
+        "operationId": "things.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Thing.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "409": {
+            "description": "The object is still referenced through a reference property with the onDelete policy 'restrict'.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "patch": {
+        "description": "Updates a Thing's data. This method supports patch semantics. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+        "tags": [
+          "things"
+        ],
+        "summary": "Update a Thing based on its UUID (using patch semantics).",
+        "operationId": "things.patch",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Thing.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "RFC 7396-style patch, the body contains the thing object to merge into the existing thing object.",
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/Thing"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully applied. No content returned"
+          },
+          "400": {
+            "description": "The patch-JSON is malformed."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "The patch-JSON is valid but unprocessable.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      }
+    },
+    "/things/{id}/references/{propertyName}": {
+      "put": {
+        "description": "Replace all references to a class-property.",
+        "tags": [
+          "things"
+        ],
+        "summary": "Replace all references to a class-property.",
+        "operationId": "things.references.update",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Thing.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Thing.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/MultipleRef"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully replaced all the references (success is based on the behavior of the datastore)."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "post": {
+        "description": "Add a single reference to a class-property when cardinality is set to 'hasMany'.",
+        "tags": [
+          "things"
+        ],
+        "summary": "Add a single reference to a class-property when cardinality is set to 'hasMany'.",
+        "operationId": "things.references.create",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Thing.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Thing.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SingleRef"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully added the reference."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "delete": {
+        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "tags": [
+          "things"
+        ],
+        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "operationId": "things.references.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Thing.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Thing.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SingleRef"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      }
+    }
+  },
+  "definitions": {
+    "Action": {
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "Type of the Action, defined in the schema.",
+          "type": "string"
+        },
+        "creationTimeUnix": {
+          "description": "Timestamp of creation of this Action in milliseconds since epoch UTC.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "id": {
+          "description": "ID of the Action.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "lastUpdateTimeUnix": {
+          "description": "Timestamp of the last update made to the Action since epoch UTC.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "meta": {
+          "$ref": "#/definitions/ObjectMeta"
+        },
+        "schema": {
+          "$ref": "#/definitions/PropertySchema"
+        },
+        "vector": {
+          "$ref": "#/definitions/Vector"
+        },
+        "vectorWeights": {
+          "$ref": "#/definitions/VectorWeights"
+        }
+      }
+    },
+    "ActionsGetResponse": {
+      "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/Action"
+        },
+        {
+          "properties": {
+            "result": {
+              "description": "Results for this specific Action.",
+              "format": "object",
+              "properties": {
+                "errors": {
+                  "$ref": "#/definitions/ErrorResponse"
+                },
+                "status": {
+                  "type": "string",
+                  "default": "SUCCESS",
+                  "enum": [
+                    "SUCCESS",
+                    "PENDING",
+                    "FAILED"
+                  ]
+                }
+              }
+            }
+          }
+        }
+      ]
+    },
+    "ActionsListResponse": {
+      "description": "List of Actions.",
+      "type": "object",
+      "properties": {
+        "actions": {
+          "description": "The actual list of Actions.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Action"
+          }
+        },
+        "continuationToken": {
+          "description": "Set if more Actions might be available. Pass it as the 'after' parameter to retrieve the next page.",
+          "type": "string"
+        },
+        "totalResults": {
+          "description": "The number of Actions in this page of the list, which only contains Actions of classes the principal may list.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "Backup": {
+      "description": "A backup of the schema, all objects with their vectors and the classification records, written to the backup directory of weaviate.",
+      "type": "object",
+      "properties": {
+        "classes": {
+          "description": "The classes which are part of this backup.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "readOnly": true
+        },
+        "error": {
+          "description": "error message if status == failed",
+          "type": "string",
+          "default": "",
+          "readOnly": true,
+          "example": "write objects: disk full"
+        },
+        "exclude": {
+          "description": "Back up all classes but these. Cannot be combined with include.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "Article"
+          ]
+        },
+        "id": {
+          "description": "ID to uniquely identify this backup, it is used as the name of the backup's directory. Generated if not set.",
+          "type": "string",
+          "pattern": "^[a-zA-Z0-9_-]+$",
+          "example": "nightly-2019-11-20"
+        },
+        "include": {
+          "description": "Only back up these classes. All classes are backed up if neither include nor exclude are set.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "City",
+            "Country"
+          ]
+        },
+        "meta": {
+          "description": "progress of the backup",
+          "type": "object",
+          "$ref": "#/definitions/BackupMeta",
+          "readOnly": true
+        },
+        "status": {
+          "description": "status of this backup",
+          "type": "string",
+          "enum": [
+            "running",
+            "completed",
+            "failed"
+          ],
+          "readOnly": true,
+          "example": "running"
+        },
+        "version": {
+          "description": "Version of the archive format of this backup.",
+          "type": "integer",
+          "readOnly": true,
+          "example": 1
+        }
+      }
+    },
+    "BackupMeta": {
+      "description": "Progress of a backup or restore",
+      "type": "object",
+      "properties": {
+        "completed": {
+          "description": "time when the backup or restore finished",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        },
+        "count": {
+          "description": "number of objects written so far",
+          "type": "integer",
+          "example": 147
+        },
+        "started": {
+          "description": "time when the backup or restore was started",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        }
+      }
+    },
+    "BackupRestore": {
+      "description": "Restore of a backup into this instance. The restored classes must not be present in the schema yet.",
+      "type": "object",
+      "properties": {
+        "backupId": {
+          "description": "ID of the backup which is restored",
+          "type": "string",
+          "readOnly": true,
+          "example": "nightly-2019-11-20"
+        },
+        "classes": {
+          "description": "The classes which are restored.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "readOnly": true
+        },
+        "error": {
+          "description": "error message if status == failed",
+          "type": "string",
+          "default": "",
+          "readOnly": true,
+          "example": "restore class 'City': class already exists"
+        },
+        "exclude": {
+          "description": "Restore all classes of the backup but these. Cannot be combined with include.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "Article"
+          ]
+        },
+        "include": {
+          "description": "Only restore these classes of the backup. All classes of the backup are restored if neither include nor exclude are set.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "City",
+            "Country"
+          ]
+        },
+        "meta": {
+          "description": "progress of the restore",
+          "type": "object",
+          "$ref": "#/definitions/BackupMeta",
+          "readOnly": true
+        },
+        "status": {
+          "description": "status of this restore",
+          "type": "string",
+          "enum": [
+            "running",
+            "completed",
+            "failed"
+          ],
+          "readOnly": true,
+          "example": "running"
+        }
+      }
+    },
+    "BatchDelete": {
+      "description": "Delete all objects of a class matching a where filter in a single request",
+      "type": "object",
+      "required": [
+        "class",
+        "where"
+      ],
+      "properties": {
+        "class": {
+          "description": "class of the objects to be deleted",
+          "type": "string",
+          "example": "City"
+        },
+        "deleteReferences": {
+          "description": "Deprecated, has no effect. The references to the deleted objects are always handled according to the onDelete policy of their property, like for single deletes.",
+          "type": "boolean",
+          "default": false
+        },
+        "dryRun": {
+          "description": "only count and, in verbose mode, list the matching objects without deleting them",
+          "type": "boolean",
+          "default": false
+        },
+        "output": {
+          "description": "minimal only lists the objects which could not be deleted, verbose lists all matching objects",
+          "type": "string",
+          "default": "minimal",
+          "enum": [
+            "minimal",
+            "verbose"
+          ]
+        },
+        "where": {
+          "description": "filter the objects to be deleted, it is required so that a class can't be emptied by accident",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
+        }
+      }
+    },
+    "BatchDeleteResponse": {
+      "description": "Outcome of a batch delete",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "class of the deleted objects",
+          "type": "string",
+          "example": "City"
+        },
+        "dryRun": {
+          "description": "whether the objects were only counted, but not deleted",
+          "type": "boolean"
+        },
+        "failed": {
+          "description": "number of objects which could not be deleted",
+          "type": "integer",
+          "example": 0
+        },
+        "matches": {
+          "description": "number of objects matching the filter",
+          "type": "integer",
+          "example": 147
+        },
+        "objects": {
+          "description": "the objects which could not be deleted or, in verbose mode, all matching objects, but at most 1000 objects",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BatchDeleteResult"
+          }
+        },
+        "referencesFailed": {
+          "description": "number of referencing objects which could not be updated, they still reference deleted objects",
+          "type": "integer",
+          "example": 0
+        },
+        "referencesRemoved": {
+          "description": "number of references to the deleted objects which were removed according to the onDelete policy of their property",
+          "type": "integer",
+          "example": 12
+        },
+        "successful": {
+          "description": "number of objects which were deleted",
+          "type": "integer",
+          "example": 147
+        }
+      }
+    },
+    "BatchDeleteResult": {
+      "description": "Outcome of a single object of a batch delete",
+      "type": "object",
+      "properties": {
+        "errors": {
+          "$ref": "#/definitions/ErrorResponse"
+        },
+        "id": {
+          "description": "ID of the object",
+          "type": "string",
+          "format": "uuid"
+        },
+        "status": {
+          "type": "string",
+          "default": "SUCCESS",
+          "enum": [
+            "SUCCESS",
+            "DRYRUN",
+            "FAILED"
+          ]
+        }
+      }
+    },
+    "BatchReference": {
+      "properties": {
+        "from": {
+          "description": "Long-form beacon-style URI to identify the source of the cross-ref including the property name. Should be in the form of weaviate://localhost/\u003ckinds\u003e/\u003cuuid\u003e/\u003cclassName\u003e/\u003cpropertyName\u003e, where \u003ckinds\u003e must be one of 'actions', 'things' and \u003cclassName\u003e and \u003cpropertyName\u003e must represent the cross-ref property of source class to be used.",
+          "type": "string",
+          "format": "uri",
+          "example": "weaviate://localhost/things/Zoo/a5d09582-4239-4702-81c9-92a6e0122bb4/hasAnimals"
+        },
+        "to": {
+          "description": "Short-form URI to point to the cross-ref. Should be in the form of weaviate://localhost/things/\u003cuuid\u003e for the example of a local cross-ref to a thing",
+          "type": "string",
+          "format": "uri",
+          "example": "weaviate://localhost/things/97525810-a9a5-4eb0-858a-71449aeb007f"
+        }
+      }
+    },
+    "BatchReferenceResponse": {
+      "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/BatchReference"
+        },
+        {
+          "properties": {
+            "result": {
+              "description": "Results for this specific reference.",
+              "format": "object",
+              "properties": {
+                "errors": {
+                  "$ref": "#/definitions/ErrorResponse"
+                },
+                "status": {
+                  "type": "string",
+                  "default": "SUCCESS",
+                  "enum": [
+                    "SUCCESS",
+                    "PENDING",
+                    "FAILED"
+                  ]
+                }
+              }
+            }
+          }
+        }
+      ]
+    },
+    "C11yExtension": {
+      "description": "A resource describing an extension to the contextinoary, containing both the identifier and the definition of the extension",
+      "properties": {
+        "concept": {
+          "description": "The new concept you want to extend. Must be an all-lowercase single word, or a space delimited compound word. Examples: 'foobarium', 'my custom concept'",
+          "type": "string",
+          "example": "foobarium"
+        },
+        "definition": {
+          "description": "A list of space-delimited words or a sentence describing what the custom concept is about. Avoid using the custom concept itself. An Example definition for the custom concept 'foobarium': would be 'a naturally occourring element which can only be seen by programmers'",
+          "type": "string"
+        },
+        "weight": {
+          "description": "Weight of the definition of the new concept where 1='override existing definition entirely' and 0='ignore custom definition'. Note that if the custom concept is not present in the contextionary yet, the weight cannot be less than 1.",
+          "type": "number",
+          "format": "float"
+        }
+      }
+    },
+    "C11yNearestNeighbors": {
+      "description": "C11y function to show the nearest neighbors to a word.",
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "distance": {
+            "type": "number",
+            "format": "float"
+          },
+          "word": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "C11yVector": {
+      "description": "A Vector in the Contextionary",
+      "type": "array",
+      "items": {
+        "type": "number",
+        "format": "float",
+        "maximum": 300,
+        "minimum": 300
+      }
+    },
+    "C11yVectorBasedQuestion": {
+      "description": "Receive question based on array of classes, properties and values.",
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "classProps": {
+            "description": "Vectorized properties.",
+            "type": "array",
+            "maxItems": 300,
+            "minItems": 300,
+            "items": {
+              "type": "object",
+              "properties": {
+                "propsVectors": {
+                  "type": "array",
+                  "items": {
+                    "type": "number",
+                    "format": "float"
+                  }
+                },
+                "value": {
+                  "description": "String with valuename.",
+                  "type": "string"
+                }
+              }
+            }
+          },
+          "classVectors": {
+            "description": "Vectorized classname.",
+            "type": "array",
+            "maxItems": 300,
+            "minItems": 300,
+            "items": {
+              "type": "number",
+              "format": "float"
+            }
+          }
+        }
+      }
+    },
+    "C11yWordsResponse": {
+      "description": "An array of available words and contexts.",
+      "properties": {
+        "concatenatedWord": {
+          "description": "Weighted results for all words",
+          "type": "object",
+          "properties": {
+            "concatenatedNearestNeighbors": {
+              "$ref": "#/definitions/C11yNearestNeighbors"
+            },
+            "concatenatedVector": {
+              "$ref": "#/definitions/C11yVector"
+            },
+            "concatenatedWord": {
+              "type": "string"
+            },
+            "singleWords": {
+              "type": "array",
+              "items": {
+                "format": "string"
+              }
+            }
+          }
+        },
+        "individualWords": {
+          "description": "Weighted results for per individual word",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "inC11y": {
+                "type": "boolean"
+              },
+              "info": {
+                "type": "object",
+                "properties": {
+                  "nearestNeighbors": {
+                    "$ref": "#/definitions/C11yNearestNeighbors"
+                  },
+                  "vector": {
+                    "$ref": "#/definitions/C11yVector"
+                  }
+                }
+              },
+              "word": {
+                "type": "string"
+              }
+            }
+          }
+        }
+      }
+    },
+    "Class": {
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "Name of the class as URI relative to the schema URL.",
+          "type": "string"
+        },
+        "description": {
+          "description": "Description of the class.",
+          "type": "string"
+        },
+        "keywords": {
+          "$ref": "#/definitions/Keywords"
+        },
+        "properties": {
+          "description": "The properties of the class.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Property"
+          }
+        },
+        "vectorIndexConfig": {
+          "$ref": "#/definitions/VectorIndexConfig"
+        },
+        "vectorizeClassName": {
+          "description": "Set this to true if the object vector should include the class name in calculating the overall vector position",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "vectorizer": {
+          "description": "Specify how the vectors for this class should be determined. The default 'contextionary' computes them from the class and property names and values. With 'text2vec-http' the same corpus is posted to the HTTP vectorizer endpoint configured in weaviate, which answers with the vector. With 'none' no vectors are computed, the contextionary is not required for this class and vectors can only be provided at import.",
+          "type": "string",
+          "enum": [
+            "contextionary",
+            "text2vec-http",
+            "none"
+          ]
+        }
+      }
+    },
+    "Classification": {
+      "description": "Manage classifications, trigger them and view status of past classifications.",
+      "type": "object",
+      "properties": {
+        "basedOnProperties": {
+          "description": "base the text-based classification on these fields (of type text)",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "description"
+          ]
+        },
+        "class": {
+          "description": "class (name) which is used in this classification",
+          "type": "string",
+          "example": "City"
+        },
+        "classifyProperties": {
+          "description": "which ref-property to set as part of the classification",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "inCountry"
+          ]
+        },
+        "dryRun": {
+          "description": "If true, the references are computed, but not stored. The computed references are listed in predictions instead.",
+          "type": "boolean",
+          "default": false
+        },
+        "error": {
+          "description": "error message if status == failed",
+          "type": "string",
+          "default": "",
+          "example": "classify xzy: something went wrong"
+        },
+        "evaluate": {
+          "description": "Only for type 'knn'. If true, no objects are classified. Instead the classification is evaluated with a k-fold cross-validation over the training set, the results are listed in evaluation.",
+          "type": "boolean",
+          "default": false
+        },
+        "evaluation": {
+          "description": "The results of an evaluation",
+          "$ref": "#/definitions/ClassificationEvaluation",
+          "readOnly": true
+        },
+        "evaluationFolds": {
+          "description": "Only if evaluate is set. The number of folds the training set is split into, each fold is classified based on all other folds.",
+          "type": "integer",
+          "default": 5,
+          "example": 5
+        },
+        "id": {
+          "description": "ID to uniquely identify this classification run",
+          "type": "string",
+          "format": "uuid",
+          "example": "ee722219-b8ec-4db1-8f8d-5150bb1a9e0c"
+        },
+        "informationGainCutoffPercentile": {
+          "description": "Only for type 'contextual'. Words with an information gain below this percentile of the object's words are ignored. The information gain measures how well a word tells the possible targets apart.",
+          "type": "integer",
+          "default": 50,
+          "example": 50
+        },
+        "informationGainMaximumBoost": {
+          "description": "Only for type 'contextual'. The word with the highest information gain is weighted this many times as much as a word without any information gain.",
+          "type": "integer",
+          "default": 3,
+          "example": 3
+        },
+        "k": {
+          "description": "k-value when using k-Neareast-Neighbor",
+          "type": "integer",
+          "format": "int32",
+          "default": 3,
+          "example": 3
+        },
+        "meta": {
+          "description": "additional meta information about the classification",
+          "type": "object",
+          "$ref": "#/definitions/ClassificationMeta"
+        },
+        "minimumCertainty": {
+          "description": "Only for type 'contextual'. A property is left unclassified if the certainty of the closest target is below this value. Certainty ranges from 0 to 1.",
+          "type": "number",
+          "format": "float64",
+          "default": 0,
+          "example": 0.6
+        },
+        "minimumUsableWords": {
+          "description": "Only for type 'contextual'. The cut-offs never leave fewer words than this, if the text contains as many known words.",
+          "type": "integer",
+          "default": 3,
+          "example": 3
+        },
+        "predictions": {
+          "description": "The references computed by a dry run. At most the configured maximum number of predictions (1000 by default) is listed, the counts in meta include all objects.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ClassificationPrediction"
+          },
+          "readOnly": true
+        },
+        "sourceWhere": {
+          "description": "limit the objects to be classified",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
+        },
+        "status": {
+          "description": "status of this classification. A classification is interrupted if weaviate was restarted while it was running.",
+          "type": "string",
+          "enum": [
+            "running",
+            "completed",
+            "failed",
+            "cancelled",
+            "interrupted"
+          ],
+          "example": "running"
+        },
+        "targetWhere": {
+          "description": "Limit the possible sources when using an algorithm which doesn't really on trainig data, e.g. 'contextual'. When using an algorithm with a training set, such as 'knn', limit the training set instead",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
+        },
+        "tfidfCutoffPercentile": {
+          "description": "Only for type 'contextual'. Words of an object with a TF-IDF score below this percentile of the object's words are ignored.",
+          "type": "integer",
+          "default": 80,
+          "example": 80
+        },
+        "timeout": {
+          "description": "maximum duration of the classification in seconds, it fails once the timeout is exceeded",
+          "type": "integer",
+          "format": "int64",
+          "default": 30,
+          "example": 300
+        },
+        "trainingSetWhere": {
+          "description": "Limit the training objects to be considered during the classification. Can only be used on types with explicit training sets, such as 'knn'",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
+        },
+        "type": {
+          "description": "which algorythim to use for classifications",
+          "type": "string",
+          "default": "knn",
+          "enum": [
+            "knn",
+            "contextual"
+          ],
+          "example": "knn"
+        }
+      }
+    },
+    "ClassificationConfusionMatrixEntry": {
+      "description": "The number of objects of a property with the actual target which were predicted as the predicted target",
+      "type": "object",
+      "properties": {
+        "actual": {
+          "description": "The beacon of the actual target",
+          "type": "string",
+          "format": "uri"
+        },
+        "count": {
+          "description": "The number of objects",
+          "type": "integer"
+        },
+        "predicted": {
+          "description": "The beacon of the predicted target",
+          "type": "string",
+          "format": "uri"
+        },
+        "property": {
+          "description": "The classified property",
+          "type": "string",
+          "example": "inCountry"
+        }
+      }
+    },
+    "ClassificationEvaluation": {
+      "description": "The result of a k-fold cross-validation of a classification over its training set",
+      "type": "object",
+      "properties": {
+        "accuracy": {
+          "description": "The share of all predicted references which match the actual reference",
+          "type": "number",
+          "format": "float32",
+          "example": 0.85
+        },
+        "confusionMatrix": {
+          "description": "How often each actual target was predicted as each target, entries which never occurred are omitted",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ClassificationConfusionMatrixEntry"
+          }
+        },
+        "count": {
+          "description": "The number of objects of the training set",
+          "type": "integer",
+          "example": 200
+        },
+        "folds": {
+          "description": "The number of folds the training set was split into",
+          "type": "integer",
+          "example": 5
+        },
+        "labels": {
+          "description": "Precision and recall per property and target",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ClassificationEvaluationLabel"
+          }
+        }
+      }
+    },
+    "ClassificationEvaluationLabel": {
+      "description": "The precision and recall of a single target of a classified property",
+      "type": "object",
+      "properties": {
+        "beacon": {
+          "description": "The beacon of the target",
+          "type": "string",
+          "format": "uri"
+        },
+        "precision": {
+          "description": "The share of the predictions of this target which were correct",
+          "type": "number",
+          "format": "float32"
+        },
+        "property": {
+          "description": "The classified property",
+          "type": "string",
+          "example": "inCountry"
+        },
+        "recall": {
+          "description": "The share of the objects with this target which were predicted correctly",
+          "type": "number",
+          "format": "float32"
+        },
+        "support": {
+          "description": "The number of objects which actually reference this target",
+          "type": "integer"
+        }
+      }
+    },
+    "ClassificationMeta": {
+      "description": "Additional information to a specific classification",
+      "type": "object",
+      "properties": {
+        "completed": {
+          "description": "time when this classification finished",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        },
+        "count": {
+          "description": "number of objects which were taken into consideration for classification",
+          "type": "integer",
+          "example": 147
+        },
+        "countFailed": {
+          "description": "number of objects which could not be classified - see error message for details",
+          "type": "integer",
+          "example": 7
+        },
+        "countSucceeded": {
+          "description": "number of objects successfully classified",
+          "type": "integer",
+          "example": 140
+        },
+        "countUnclassified": {
+          "description": "number of objects which were left unclassified, as no target reached the minimum certainty",
+          "type": "integer",
+          "example": 3
+        },
+        "estimatedCompletion": {
+          "description": "estimated time when this classification will finish, based on the progress so far",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:35:12Z"
+        },
+        "processed": {
+          "description": "number of objects which have been processed so far",
+          "type": "integer",
+          "example": 147
+        },
+        "started": {
+          "description": "time when this classification was started",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        },
+        "total": {
+          "description": "number of objects to be classified, known once the classification has started processing",
+          "type": "integer",
+          "example": 200
+        }
+      }
+    },
+    "ClassificationPrediction": {
+      "description": "A reference which would have been set by a classification",
+      "type": "object",
+      "properties": {
+        "beacon": {
+          "description": "The beacon of the reference, not set for primitive properties",
+          "type": "string",
+          "format": "uri"
+        },
+        "id": {
+          "description": "ID of the object which would have been classified",
+          "type": "string",
+          "format": "uuid"
+        },
+        "property": {
+          "description": "The classified property",
+          "type": "string",
+          "example": "inCountry"
+        },
+        "value": {
+          "description": "The value of a classified primitive property"
+        },
+        "winningDistance": {
+          "description": "The distance of the winning reference, see the reference meta of the respective classification type",
+          "type": "number",
+          "format": "float32"
+        }
+      }
+    },
+    "ClassificationsListResponse": {
+      "description": "List of classifications.",
+      "type": "object",
+      "properties": {
+        "classifications": {
+          "description": "The actual list of classifications.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Classification"
+          }
+        },
+        "totalResults": {
+          "description": "The total number of classifications in the list.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "DanglingReference": {
+      "description": "A reference to an object which doesn't exist",
+      "type": "object",
+      "properties": {
+        "beacon": {
+          "description": "the dangling reference",
+          "type": "string",
+          "format": "uri"
+        },
+        "class": {
+          "description": "class of the referencing object",
+          "type": "string"
+        },
+        "id": {
+          "description": "id of the referencing object",
+          "type": "string",
+          "format": "uuid"
+        },
+        "kind": {
+          "description": "kind of the referencing object",
+          "type": "string",
+          "enum": [
+            "thing",
+            "action"
+          ]
+        },
+        "property": {
+          "description": "reference property which contains the reference",
+          "type": "string"
+        }
+      }
+    },
+    "DanglingReferencesCheck": {
+      "description": "Scan the objects for references to objects which don't exist (anymore) and optionally remove them.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "only scan the objects of this class, all classes with reference properties are scanned if not set",
+          "type": "string",
+          "example": "City"
+        },
+        "repair": {
+          "description": "remove the dangling references from the objects instead of only reporting them",
+          "type": "boolean",
+          "default": false
+        }
+      }
+    },
+    "DanglingReferencesReport": {
+      "description": "The dangling references which were found and, if repaired, how many objects were updated.",
+      "type": "object",
+      "properties": {
+        "danglingReferences": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/DanglingReference"
+          }
+        },
+        "objectsFailed": {
+          "description": "number of objects which could not be stored without their dangling references",
+          "type": "integer",
+          "format": "int64"
+        },
+        "objectsRepaired": {
+          "description": "number of objects which were stored without their dangling references",
+          "type": "integer",
+          "format": "int64"
+        },
+        "objectsScanned": {
+          "description": "number of objects which were scanned",
+          "type": "integer",
+          "format": "int64"
+        },
+        "repair": {
+          "description": "whether the dangling references were removed",
+          "type": "boolean"
+        }
+      }
+    },
+    "ErrorResponse": {
+      "description": "An error response given by Weaviate end-points.",
+      "type": "object",
+      "properties": {
+        "error": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "message": {
+                "type": "string"
+              }
+            }
+          }
+        }
+      }
+    },
+    "GeoCoordinates": {
+      "properties": {
+        "latitude": {
+          "description": "The latitude of the point on earth in decimal form",
+          "type": "number",
+          "format": "float"
+        },
+        "longitude": {
+          "description": "The longitude of the point on earth in decimal form",
+          "type": "number",
+          "format": "float"
+        }
+      }
+    },
+    "GraphQLError": {
+      "description": "An error response caused by a GraphQL query.",
+      "properties": {
+        "locations": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "column": {
+                "type": "integer",
+                "format": "int64"
+              },
+              "line": {
+                "type": "integer",
+                "format": "int64"
+              }
+            }
+          }
+        },
+        "message": {
+          "type": "string"
+        },
+        "path": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "GraphQLQueries": {
+      "description": "A list of GraphQL queries.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/GraphQLQuery"
+      }
+    },
+    "GraphQLQuery": {
+      "description": "GraphQL query based on: http://facebook.github.io/graphql/.",
+      "type": "object",
+      "properties": {
+        "operationName": {
+          "description": "The name of the operation if multiple exist in the query.",
+          "type": "string"
+        },
+        "query": {
+          "description": "Query based on GraphQL syntax.",
+          "type": "string"
+        },
+        "variables": {
+          "description": "Additional variables for the query.",
+          "type": "object"
+        }
+      }
+    },
+    "GraphQLResponse": {
+      "description": "GraphQL based response: http://facebook.github.io/graphql/.",
+      "properties": {
+        "data": {
+          "description": "GraphQL data object.",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/JsonObject"
+          }
+        },
+        "errors": {
+          "description": "Array with errors.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/GraphQLError"
+          }
+        }
+      }
+    },
+    "GraphQLResponses": {
+      "description": "A list of GraphQL responses.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/GraphQLResponse"
+      }
+    },
+    "JsonObject": {
+      "description": "JSON object value.",
+      "type": "object"
+    },
+    "Keywords": {
+      "description": "Describes a class or property using multiple weighted words.",
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "keyword": {
+            "type": "string"
+          },
+          "weight": {
+            "type": "number",
+            "format": "float"
+          }
+        }
+      }
+    },
+    "Link": {
+      "type": "object",
+      "properties": {
+        "documentationHref": {
+          "description": "weaviate documentation about this resource group",
+          "type": "string"
+        },
+        "href": {
+          "description": "target of the link",
+          "type": "string"
+        },
+        "name": {
+          "description": "human readable name of the resource group",
+          "type": "string"
+        },
+        "rel": {
+          "description": "relationship if both resources are related, e.g. 'next', 'previous', 'parent', etc.",
+          "type": "string"
+        }
+      }
+    },
+    "Meta": {
+      "description": "Contains meta information of the current Weaviate instance.",
+      "type": "object",
+      "properties": {
+        "contextionaryVersion": {
+          "description": "Version of the contextionary service connected to weaviate",
+          "type": "string"
+        },
+        "contextionaryWordCount": {
+          "description": "Number of total words in the contextionary",
+          "type": "number",
+          "format": "int"
+        },
+        "hostname": {
+          "description": "The url of the host.",
+          "type": "string",
+          "format": "url"
+        },
+        "version": {
+          "description": "Version of weaviate you are currently running",
+          "type": "string"
+        }
+      }
+    },
+    "MultipleRef": {
+      "description": "Multiple instances of references to other objects.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/SingleRef"
+      }
+    },
+    "ObjectMeta": {
+      "description": "Additional Meta information about a single thing/action object.",
+      "properties": {
+        "classification": {
+          "description": "If this object was subject of a classificiation, additional meta info about this classification is available here",
+          "$ref": "#/definitions/ObjectMetaClassification"
+        },
+        "vector": {
+          "description": "This object's position in the Contextionary vector space",
+          "$ref": "#/definitions/C11yVector"
+        }
+      }
+    },
+    "ObjectMetaClassification": {
+      "description": "This meta field contains additional info about the classification which affected this object",
+      "properties": {
+        "basedOn": {
+          "description": "The (primitive) field(s) which were used as a basis for classification. For example, if the type of classification is \"knn\" with k=3, the 3 nearest neighbors - based on these fields - were considered for the classification.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "classifiedFields": {
+          "description": "The (reference) fields which were classified as part of this classification. Note that this might contain fewere entries than \"scope\", if one of the fields was already set prior to the classification, for example",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "completed": {
+          "description": "Timestamp when this particular object was classified. This is usually sooner than the overall completion time of the classification, as the overall completion time will only be set once every object has been classified.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "description": "unique identifier of the classification run",
+          "type": "string",
+          "format": "uuid"
+        },
+        "properties": {
+          "description": "How confident the classification of each classified field is, only set for type \"knn\". References carry their distances in their own meta as well, primitive fields can only be described here.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ObjectMetaClassificationProperty"
+          }
+        },
+        "scope": {
+          "description": "The properties in scope of the classification. Note that this doesn't mean that these fields were necessarily classified, this only means that those fields were in scope of the classificiation. See \"classifiedFields\" for details.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "ObjectMetaClassificationProperty": {
+      "description": "The confidence of the classification of a single field",
+      "properties": {
+        "confidence": {
+          "description": "The share of the neighbors which voted for the winning value or reference",
+          "type": "number",
+          "format": "float32"
+        },
+        "losingDistance": {
+          "description": "Mean distance of all neighbors from the losing group. Optional. If k equals the size of the winning group, there is no losing group.",
+          "type": "number",
+          "format": "float32",
+          "x-nullable": true
+        },
+        "name": {
+          "description": "The name of the classified field",
+          "type": "string"
+        },
+        "winningDistance": {
+          "description": "Mean distance of all neighbors from the winning group",
+          "type": "number",
+          "format": "float32"
+        }
+      }
+    },
+    "PatchDocumentAction": {
+      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
+      "required": [
+        "op",
+        "path"
+      ],
+      "properties": {
+        "from": {
+          "description": "A string containing a JSON Pointer value.",
+          "type": "string"
+        },
+        "merge": {
+          "$ref": "#/definitions/Thing"
+        },
+        "op": {
+          "description": "The operation to be performed.",
+          "type": "string",
+          "enum": [
+            "add",
+            "remove",
+            "replace",
+            "move",
+            "copy",
+            "test"
+          ]
+        },
+        "path": {
+          "description": "A JSON-Pointer.",
+          "type": "string"
+        },
+        "value": {
+          "description": "The value to be used within the operations.",
+          "type": "object"
+        }
+      }
+    },
+    "PatchDocumentThing": {
+      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
+      "required": [
+        "op",
+        "path"
+      ],
+      "properties": {
+        "from": {
+          "description": "A string containing a JSON Pointer value.",
+          "type": "string"
+        },
+        "merge": {
+          "$ref": "#/definitions/Thing"
+        },
+        "op": {
+          "description": "The operation to be performed.",
+          "type": "string",
+          "enum": [
+            "add",
+            "remove",
+            "replace",
+            "move",
+            "copy",
+            "test"
+          ]
+        },
+        "path": {
+          "description": "A JSON-Pointer.",
+          "type": "string"
+        },
+        "value": {
+          "description": "The value to be used within the operations.",
+          "type": "object"
+        }
+      }
+    },
+    "PeerUpdate": {
+      "description": "A single peer in the network.",
+      "properties": {
+        "id": {
+          "description": "The session ID of the peer.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "name": {
+          "description": "Human readable name.",
+          "type": "string"
+        },
+        "schemaHash": {
+          "description": "The latest known hash of the peer's schema.",
+          "type": "string"
+        },
+        "uri": {
+          "description": "The location where the peer is exposed to the internet.",
+          "type": "string",
+          "format": "uri"
+        }
+      }
+    },
+    "PeerUpdateList": {
+      "description": "List of known peers.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/PeerUpdate"
+      }
+    },
+    "PhoneNumber": {
+      "properties": {
+        "countryCode": {
+          "description": "Read-only. The numerical country code (e.g. 49)",
+          "type": "number",
+          "format": "uint64"
+        },
+        "defaultCountry": {
+          "description": "Optional. The ISO 3166-1 alpha-2 country code. This is used to figure out the correct countryCode and international format if only a national number (e.g. 0123 4567) is provided",
+          "type": "string"
+        },
+        "input": {
+          "description": "The raw input as the phone number is present in your raw data set. It will be parsed into the standardized formats if valid.",
+          "type": "string"
+        },
+        "internationalFormatted": {
+          "description": "Read-only. Parsed result in the international format (e.g. +49 123 ...)",
+          "type": "string"
+        },
+        "national": {
+          "description": "Read-only. The numerical representation of the national part",
+          "type": "number",
+          "format": "uint64"
+        },
+        "nationalFormatted": {
+          "description": "Read-only. Parsed result in the national format (e.g. 0123 456789)",
+          "type": "string"
+        },
+        "valid": {
+          "description": "Read-only. Indicates whether the parsed number is a valid phone number",
+          "type": "boolean"
+        }
+      }
+    },
+    "Principal": {
+      "type": "object",
+      "properties": {
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "username": {
+          "description": "The username that was extracted either from the authentication information",
+          "type": "string"
+        }
+      }
+    },
+    "Property": {
+      "type": "object",
+      "properties": {
+        "cardinality": {
+          "description": "The cardinality of this property. If you want to store more than one value in a property, set this to 'many'. Defaults to 'atMostOne'. Note that by default properties can be empty in Weaviate.",
+          "type": "string",
+          "default": "atMostOne",
+          "enum": [
+            "atMostOne",
+            "many"
+          ]
+        },
+        "dataType": {
+          "description": "Can be a reference to another type when it starts with a capital (for example Person), otherwise \"string\" or \"int\".",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "description": {
+          "description": "Description of the property.",
+          "type": "string"
+        },
+        "index": {
+          "description": "Optional. By default each property is fully indexed both for full-text, as well as vector-search. You can ignore properties in searches by explicitly setting index to false. Not set is the same as true",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "keywords": {
+          "$ref": "#/definitions/Keywords"
+        },
+        "name": {
+          "description": "Name of the property as URI relative to the schema URL.",
+          "type": "string"
+        },
+        "onDelete": {
+          "description": "Only for reference properties. What happens to the references in this property when a referenced object is deleted: 'restrict' prevents the deletion of the referenced object, 'cascade' deletes the referencing object as well and 'setNull' removes the reference. Not set is the same as setNull.",
+          "type": "string",
+          "enum": [
+            "restrict",
+            "cascade",
+            "setNull"
+          ]
+        },
+        "vectorizePropertyName": {
+          "description": "Set this to true if the object vector should include this property's name in calculating the overall vector position. If set to false (default), only the property value will be used.",
+          "type": "boolean"
+        }
+      }
+    },
+    "PropertySchema": {
+      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
+      "type": "object"
+    },
+    "ReferenceMeta": {
+      "description": "Additional Meta information about this particular reference.",
+      "properties": {
+        "classification": {
+          "description": "If a property was set through a classification, this meta field contains additional info",
+          "$ref": "#/definitions/ReferenceMetaClassification"
+        }
+      }
+    },
+    "ReferenceMetaClassification": {
+      "description": "This meta field contains additional info about the classified reference property",
+      "properties": {
+        "basedOnWords": {
+          "description": "The words of the basedOnProperties which the classification was based on, only set for type 'contextual'.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ReferenceMetaClassificationWord"
+          }
+        },
+        "losingDistance": {
+          "description": "Mean distance of all neighbors from the losing group. Optional. If k equals the size of the winning group, there is no losing group.",
+          "type": "number",
+          "format": "float32",
+          "x-nullable": true
+        },
+        "winningDistance": {
+          "description": "Mean distance of all neighbors from the winning group",
+          "type": "number",
+          "format": "float32"
+        }
+      }
+    },
+    "ReferenceMetaClassificationWord": {
+      "description": "A word which a contextual classification was based on",
+      "properties": {
+        "weight": {
+          "description": "The weight of the word's vector in the vector which was used for the classification, based on its TF-IDF score and its information gain",
+          "type": "number",
+          "format": "float32"
+        },
+        "word": {
+          "description": "The word as it occurred in the text, lowercased",
+          "type": "string"
+        }
+      }
+    },
+    "Revectorization": {
+      "description": "A background job which recomputes the vectors of the objects of a class with the current vectorizer settings of the class, e.g. after vectorizeClassName, vectorizePropertyName or the indexing of a property was changed or the contextionary was extended.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "name of the revectorized class",
+          "type": "string",
+          "readOnly": true,
+          "example": "City"
+        },
+        "error": {
+          "description": "error message if status == failed",
+          "type": "string",
+          "default": "",
+          "readOnly": true,
+          "example": "store vectors: timeout"
+        },
+        "id": {
+          "description": "ID to uniquely identify this revectorization",
+          "type": "string",
+          "format": "uuid",
+          "readOnly": true,
+          "example": "ee722219-b8ec-4db1-8f8d-5150bb1a9e0c"
+        },
+        "kind": {
+          "description": "kind of the revectorized class",
+          "type": "string",
+          "enum": [
+            "thing",
+            "action"
+          ],
+          "readOnly": true,
+          "example": "thing"
+        },
+        "meta": {
+          "description": "progress of the revectorization",
+          "type": "object",
+          "$ref": "#/definitions/RevectorizationMeta",
+          "readOnly": true
+        },
+        "status": {
+          "description": "status of this revectorization",
+          "type": "string",
+          "enum": [
+            "running",
+            "completed",
+            "failed",
+            "cancelled"
+          ],
+          "readOnly": true,
+          "example": "running"
+        },
+        "where": {
+          "description": "limit the objects to be revectorized using a filter, all objects of the class are revectorized if not set",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
+        }
+      }
+    },
+    "RevectorizationMeta": {
+      "description": "Progress of a revectorization",
+      "type": "object",
+      "properties": {
+        "completed": {
+          "description": "time when the revectorization finished",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        },
+        "count": {
+          "description": "number of objects processed so far",
+          "type": "integer",
+          "example": 147
+        },
+        "countFailed": {
+          "description": "number of objects which could not be vectorized or stored, they keep their previous vector",
+          "type": "integer",
+          "example": 7
+        },
+        "countSucceeded": {
+          "description": "number of objects which were stored with a new vector",
+          "type": "integer",
+          "example": 140
+        },
+        "started": {
+          "description": "time when the revectorization was started",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        }
+      }
+    },
+    "Role": {
+      "description": "A role grants verbs on resources to the users and groups bound to it. Roles are only evaluated if rbac authorization is enabled.",
+      "type": "object",
+      "properties": {
+        "groups": {
+          "description": "groups (as set in the groups claim of the OIDC token) the role is bound to",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "data-team"
+          ]
+        },
+        "name": {
+          "description": "unique name of the role, may only contain letters, numbers, dashes and underscores",
+          "type": "string",
+          "example": "importer"
+        },
+        "permissions": {
+          "description": "the permissions granted by this role",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/RolePermission"
+          }
+        },
+        "static": {
+          "description": "roles defined in the configuration file are static and can't be changed through the api",
+          "type": "boolean",
+          "readOnly": true
+        },
+        "users": {
+          "description": "usernames the role is bound to, unauthenticated requests use the username 'anonymous'",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "alice"
+          ]
+        }
+      }
+    },
+    "RolePermission": {
+      "description": "Grants all of the verbs on all of the resources. A '*' matches any verb. Resources are patterns, a '*' matches a single segment of a resource, a trailing '/*' matches the resource itself and everything below it.",
+      "type": "object",
+      "properties": {
+        "resources": {
+          "description": "resource patterns such as things/*, schema/things, traversal/* or classifications/*",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "things/*",
+            "batch/*"
+          ]
+        },
+        "verbs": {
+          "description": "verbs such as get, list, create, update, delete or validate",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "create",
+            "update"
+          ]
+        }
+      }
+    },
+    "Schema": {
+      "description": "Definitions of semantic schemas (also see: https://github.com/semi-technologies/weaviate-semantic-schemas).",
+      "type": "object",
+      "properties": {
+        "classes": {
+          "description": "Semantic classes that are available.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Class"
+          }
+        },
+        "maintainer": {
+          "description": "Email of the maintainer.",
+          "type": "string",
+          "format": "email"
+        },
+        "name": {
+          "description": "Name of the schema.",
+          "type": "string"
+        },
+        "type": {
+          "description": "Type of schema, should be \"thing\" or \"action\".",
+          "type": "string",
+          "enum": [
+            "thing",
+            "action"
+          ]
+        }
+      }
+    },
+    "SchemaHistory": {
+      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
+      "type": "object"
+    },
+    "SchemaReindex": {
+      "description": "A background job which copies all objects of a class into a new index of the vector index, so that a class or property can be renamed or a property can be dropped while the class stays available for reads and writes.",
+      "type": "object",
+      "properties": {
+        "className": {
+          "description": "name of the reindexed class after the schema change",
+          "type": "string",
+          "readOnly": true,
+          "example": "City"
+        },
+        "error": {
+          "description": "error message if status == failed",
+          "type": "string",
+          "default": "",
+          "readOnly": true,
+          "example": "copy objects: bulk request: timeout"
+        },
+        "from": {
+          "description": "previous name of the renamed class or property or the name of the dropped property",
+          "type": "string",
+          "readOnly": true,
+          "example": "populationSize"
+        },
+        "id": {
+          "description": "ID to uniquely identify this reindex job",
+          "type": "string",
+          "format": "uuid",
+          "readOnly": true,
+          "example": "ee722219-b8ec-4db1-8f8d-5150bb1a9e0c"
+        },
+        "kind": {
+          "description": "kind of the reindexed class",
+          "type": "string",
+          "enum": [
+            "thing",
+            "action"
+          ],
+          "readOnly": true,
+          "example": "thing"
+        },
+        "meta": {
+          "description": "progress of the reindex job",
+          "type": "object",
+          "$ref": "#/definitions/SchemaReindexMeta",
+          "readOnly": true
+        },
+        "operation": {
+          "description": "the schema change which required the reindex",
+          "type": "string",
+          "enum": [
+            "renameClass",
+            "renameProperty",
+            "dropProperty"
+          ],
+          "readOnly": true,
+          "example": "renameProperty"
+        },
+        "sourceIndex": {
+          "description": "index the objects are copied from",
+          "type": "string",
+          "readOnly": true,
+          "example": "class_thing_city"
+        },
+        "status": {
+          "description": "status of this reindex job",
+          "type": "string",
+          "enum": [
+            "running",
+            "completed",
+            "failed"
+          ],
+          "readOnly": true,
+          "example": "running"
+        },
+        "targetIndex": {
+          "description": "index the objects are copied to, it replaces the source index once all objects are copied",
+          "type": "string",
+          "readOnly": true,
+          "example": "v2_class_thing_city"
+        },
+        "to": {
+          "description": "new name of the renamed class or property, empty when a property is dropped",
+          "type": "string",
+          "readOnly": true,
+          "example": "population"
+        }
+      }
+    },
+    "SchemaReindexMeta": {
+      "description": "Progress of a reindex job",
+      "type": "object",
+      "properties": {
+        "completed": {
+          "description": "time when the reindex job finished",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        },
+        "count": {
+          "description": "number of objects copied so far, objects which were written to during the reindex are copied again and counted again",
+          "type": "integer",
+          "example": 147
+        },
+        "started": {
+          "description": "time when the reindex job was started",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        }
+      }
+    },
+    "SingleRef": {
+      "description": "Either set beacon (direct reference) or set class and schema (concept reference)",
+      "properties": {
+        "beacon": {
+          "description": "If using a direct reference, specify the URI to point to the cross-ref here. Should be in the form of weaviate://localhost/things/\u003cuuid\u003e for the example of a local cross-ref to a thing",
+          "type": "string",
+          "format": "uri"
+        },
+        "class": {
+          "description": "If using a concept reference (rather than a direct reference), specify the desired class name here",
+          "type": "string",
+          "format": "uri"
+        },
+        "href": {
+          "description": "If using a direct reference, this read-only fields provides a link to the refernced resource. If 'origin' is globally configured, an absolute URI is shown - a relative URI otherwise.",
+          "type": "string",
+          "format": "uri"
+        },
+        "meta": {
+          "description": "Additional Meta information about this particular reference. Only shown if meta==true.",
+          "$ref": "#/definitions/ReferenceMeta"
+        },
+        "schema": {
+          "description": "If using a concept reference (rather than a direct reference), specify the desired properties here",
+          "$ref": "#/definitions/PropertySchema"
+        }
+      }
+    },
+    "Thing": {
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "Class of the Thing, defined in the schema.",
+          "type": "string"
+        },
+        "creationTimeUnix": {
+          "description": "Timestamp of creation of this Thing in milliseconds since epoch UTC.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "id": {
+          "description": "ID of the Thing.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "lastUpdateTimeUnix": {
+          "description": "Timestamp of the last Thing update in milliseconds since epoch UTC.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "meta": {
+          "$ref": "#/definitions/ObjectMeta"
+        },
+        "schema": {
+          "$ref": "#/definitions/PropertySchema"
+        },
+        "vector": {
+          "$ref": "#/definitions/Vector"
+        },
+        "vectorWeights": {
+          "$ref": "#/definitions/VectorWeights"
+        }
+      }
+    },
+    "ThingsGetResponse": {
+      "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/Thing"
+        },
+        {
+          "properties": {
+            "result": {
+              "description": "Results for this specific Thing.",
+              "format": "object",
+              "properties": {
+                "errors": {
+                  "$ref": "#/definitions/ErrorResponse"
+                },
+                "status": {
+                  "type": "string",
+                  "default": "SUCCESS",
+                  "enum": [
+                    "SUCCESS",
+                    "PENDING",
+                    "FAILED"
+                  ]
+                }
+              }
+            }
+          }
+        }
+      ]
+    },
+    "ThingsListResponse": {
+      "description": "List of Things.",
+      "type": "object",
+      "properties": {
+        "continuationToken": {
+          "description": "Set if more Things might be available. Pass it as the 'after' parameter to retrieve the next page.",
+          "type": "string"
+        },
+        "things": {
+          "description": "The actual list of Things.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Thing"
+          }
+        },
+        "totalResults": {
+          "description": "The number of Things in this page of the list, which only contains Things of classes the principal may list.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "Vector": {
+      "description": "A vector representation of the object. If provided at import, it is used as-is instead of a vector computed by the vectorizer of the class.",
+      "type": "array",
+      "items": {
+        "type": "number",
+        "format": "float"
+      }
+    },
+    "VectorIndexConfig": {
+      "description": "Tunes the approximate nearest neighbor (HNSW) index of a class. Unset values fall back to the defaults. The config cannot be changed once the class has been created.",
+      "type": "object",
+      "properties": {
+        "ef": {
+          "description": "Size of the dynamic candidate list when searching. Higher values lead to a better recall at the cost of slower searches.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "efConstruction": {
+          "description": "Size of the dynamic candidate list when inserting new objects. Higher values lead to a better index quality at the cost of slower imports.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "maxConnections": {
+          "description": "Maximum number of connections per object and layer in the index graph. The lowest layer allows for twice as many connections.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "VectorWeights": {
+      "description": "Allow custom overrides of vector weights as math expressions. E.g. \"pancake\": \"7\" will set the weight for the word pancake to 7 in the vectorization, whereas \"w * 3\" would triple the originally calculated word. This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value (string/string) object.",
+      "type": "object"
+    },
+    "WhereFilter": {
+      "description": "Filter search results using a where filter",
+      "type": "object",
+      "properties": {
+        "operands": {
+          "description": "combine multiple where filters, requires 'And' or 'Or' operator",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/WhereFilter"
+          }
+        },
+        "operator": {
+          "description": "operator to use",
+          "type": "string",
+          "enum": [
+            "And",
+            "Or",
+            "Equal",
+            "Like",
+            "Not",
+            "NotEqual",
+            "GreaterThan",
+            "GreaterThanEqual",
+            "LessThan",
+            "LessThanEqual",
+            "WithinGeoRange"
+          ],
+          "example": "GreaterThanEqual"
+        },
+        "path": {
+          "description": "path to the property currently being filtered",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "inCity",
+            "City",
+            "name"
+          ]
+        },
+        "valueBoolean": {
+          "description": "value as boolean",
+          "type": "boolean",
+          "x-nullable": true,
+          "example": false
+        },
+        "valueDate": {
+          "description": "value as date (as string)",
+          "type": "string",
+          "x-nullable": true,
+          "example": "TODO"
+        },
+        "valueGeoRange": {
+          "description": "value as geo coordinates and distance",
+          "type": "object",
+          "x-nullable": true,
+          "$ref": "#/definitions/WhereFilterGeoRange"
+        },
+        "valueInt": {
+          "description": "value as integer",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true,
+          "example": 2000
+        },
+        "valueNumber": {
+          "description": "value as number/float",
+          "type": "number",
+          "format": "float64",
+          "x-nullable": true,
+          "example": 3.14
+        },
+        "valueString": {
+          "description": "value as string",
+          "type": "string",
+          "x-nullable": true,
+          "example": "my search term"
+        },
+        "valueText": {
+          "description": "value as text (on text props)",
+          "type": "string",
+          "x-nullable": true,
+          "example": "my search term"
+        }
+      }
+    },
+    "WhereFilterGeoRange": {
+      "description": "filter within a distance of a georange",
+      "type": "object",
+      "properties": {
+        "distance": {
+          "type": "object",
+          "properties": {
+            "max": {
+              "type": "number",
+              "format": "float64"
+            }
+          }
+        },
+        "geoCoordinates": {
+          "$ref": "#/definitions/GeoCoordinates"
+        }
+      }
+    }
+  },
+  "parameters": {
+    "CommonAfterParameterQuery": {
+      "type": "string",
+      "description": "Only return items after this cursor, such as the continuationToken of a previous page. Items are ordered by their id. Cannot be combined with 'offset'.",
+      "name": "after",
+      "in": "query"
+    },
+    "CommonLimitParameterQuery": {
+      "type": "integer",
+      "format": "int64",
+      "description": "The maximum number of items to be returned per page. Default value is set in Weaviate config.",
+      "name": "limit",
+      "in": "query"
+    },
+    "CommonMetaParameterQuery": {
+      "type": "boolean",
+      "description": "Should additional meta information (e.g. about classified properties) be included? Defaults to false.",
+      "name": "meta",
+      "in": "query"
+    },
+    "CommonOffsetParameterQuery": {
+      "type": "integer",
+      "format": "int64",
+      "description": "The number of items to skip. Cannot be combined with 'after'.",
+      "name": "offset",
+      "in": "query"
+    },
+    "CommonSortParameterQuery": {
+      "type": "string",
+      "description": "Comma-separated list of properties to sort the items by, each optionally followed by ':asc' or ':desc', such as 'price:desc,_created'. Primitive properties other than text, geoCoordinates and phoneNumber can be used, as well as '_created' and '_updated'. The order defaults to ascending. Items without a value come last. Cannot be combined with 'after'.",
+      "name": "sort",
+      "in": "query"
+    }
+  },
+  "securityDefinitions": {
+    "oidc": {
+      "description": "OIDC (OpenConnect ID - based on OAuth2)",
+      "type": "oauth2",
+      "flow": "implicit",
+      "authorizationUrl": "http://to-be-configured-in-the-application-config"
+    },
+    "xApiKey": {
+      "description": "Static api key, can alternatively be sent as a bearer token",
+      "type": "apiKey",
+      "name": "X-Api-Key",
+      "in": "header"
+    }
+  },
+  "security": [
+    {},
+    {
+      "oidc": []
+    },
+    {
+      "xApiKey": []
+    }
+  ],
+  "tags": [
+    {
+      "name": "actions"
+    },
+    {
+      "description": "These operations allow to execute batch requests for Things and Actions. Mostly used for importing large datasets.",
+      "name": "batching"
+    },
+    {
+      "name": "graphql"
+    },
+    {
+      "name": "meta"
+    },
+    {
+      "name": "P2P"
+    },
+    {
+      "name": "things"
+    },
+    {
+      "description": "All functions related to the Contextionary.",
+      "name": "contextionary-API"
+    },
+    {
+      "description": "These operations enable manipulation of the schema in Weaviate schema.",
+      "name": "schema"
+    },
+    {
+      "description": "These operations back up the schema, objects and vectors to the filesystem and restore them.",
+      "name": "backups"
+    },
+    {
+      "description": "These operations manage the roles used by the rbac authorization.",
+      "name": "roles"
+    },
+    {
+      "description": "These operations find and repair inconsistencies in the stored objects.",
+      "name": "maintenance"
+    }
+  ],
+  "externalDocs": {
+    "url": "https://github.com/semi-technologies/weaviate"
+  }
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/yaml",
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "https"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "Open Source Smart Graph (GraphQL/RESTful/P2P)",
+    "title": "Weaviate",
+    "contact": {
+      "name": "Weaviate",
+      "url": "https://github.com/semi-technologies",
+      "email": "hello@semi.technology"
+    },
+    "version": "0.22.6"
+  },
+  "basePath": "/v1",
+  "paths": {
+    "/": {
+      "get": {
+        "description": "Home. Discover the REST API",
+        "operationId": "weaviate.root",
+        "responses": {
+          "200": {
+            "description": "Weaviate is alive and ready to serve content",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "links": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Link"
+                  }
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/.well-known/live": {
+      "get": {
+        "description": "Determines whether the application is alive. Can be used for kubernetes liveness probe",
+        "operationId": "weaviate.wellknown.liveness",
+        "responses": {
+          "200": {
+            "description": "The application is able to respond to HTTP requests"
+          }
         }
       }
     },
-    "Thing": {
-      "type": "object",
-      "properties": {
-        "class": {
-          "description": "Class of the Thing, defined in the schema.",
-          "type": "string"
+    "/.well-known/openid-configuration": {
+      "get": {
+        "description": "OIDC Discovery page, redirects to the token issuer if one is configured",
+        "tags": [
+          "well-known",
+          "oidc",
+          "discovery"
+        ],
+        "summary": "OIDC discovery information if OIDC auth is enabled",
+        "responses": {
+          "200": {
+            "description": "Successful response, inspect body",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "clientId": {
+                  "description": "OAuth Client ID",
+                  "type": "string"
+                },
+                "href": {
+                  "description": "The Location to redirect to",
+                  "type": "string"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "Not found, no oidc provider present"
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
+      }
+    },
+    "/.well-known/ready": {
+      "get": {
+        "description": "Determines whether the application is ready to receive traffic. Can be used for kubernetes readiness probe.",
+        "operationId": "weaviate.wellknown.readiness",
+        "responses": {
+          "200": {
+            "description": "The application has completed its start-up routine and is ready to accept traffic."
+          },
+          "503": {
+            "description": "The application is currently not able to serve traffic. If other horizontal replicas of weaviate are available and they are capable of receving traffic, all traffic should be redirected there instead."
+          }
+        }
+      }
+    },
+    "/actions": {
+      "get": {
+        "description": "Lists all Actions in reverse order of creation, owned by the user that belongs to the used token.",
+        "tags": [
+          "actions"
+        ],
+        "summary": "Get a list of Actions.",
+        "operationId": "actions.list",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The maximum number of items to be returned per page. Default value is set in Weaviate config.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The number of items to skip. Cannot be combined with 'after'.",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only return items after this cursor, such as the continuationToken of a previous page. Items are ordered by their id. Cannot be combined with 'offset'.",
+            "name": "after",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Comma-separated list of properties to sort the items by, each optionally followed by ':asc' or ':desc', such as 'price:desc,_created'. Primitive properties other than text, geoCoordinates and phoneNumber can be used, as well as '_created' and '_updated'. The order defaults to ascending. Items without a value come last. Cannot be combined with 'after'.",
+            "name": "sort",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Should additional meta information (e.g. about classified properties) be included? Defaults to false.",
+            "name": "meta",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/ActionsListResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "Invalid paging parameters.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
+      },
+      "post": {
+        "description": "Registers a new Action. Provided meta-data and schema values are validated.",
+        "tags": [
+          "actions"
+        ],
+        "summary": "Create Actions between two Things (object and subject).",
+        "operationId": "actions.create",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Action"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Action created.",
+            "schema": {
+              "$ref": "#/definitions/Action"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.add"
+        ]
+      }
+    },
+    "/actions/export": {
+      "get": {
+        "description": "Streams all Actions of a class as newline-delimited JSON, one Action per line. Each line contains the schema, the timestamps, the vector weights and the stored vector of the Action, so that the export can be imported again as-is through the batch endpoint.",
+        "produces": [
+          "application/x-ndjson"
+        ],
+        "tags": [
+          "actions"
+        ],
+        "summary": "Export all Actions of a class.",
+        "operationId": "actions.export",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the class to export.",
+            "name": "class",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response, the body contains one Action per line."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "There is no Action class with this name.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "creationTimeUnix": {
-          "description": "Timestamp of creation of this Thing in milliseconds since epoch UTC.",
-          "type": "integer",
-          "format": "int64"
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
+      }
+    },
+    "/actions/validate": {
+      "post": {
+        "description": "Validate an Action's schema and meta-data. It has to be based on a schema, which is related to the given Action to be accepted by this validation.",
+        "tags": [
+          "actions"
+        ],
+        "summary": "Validate an Action based on a schema.",
+        "operationId": "actions.validate",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Action"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully validated."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "id": {
-          "description": "ID of the Thing.",
-          "type": "string",
-          "format": "uuid"
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      }
+    },
+    "/actions/{id}": {
+      "get": {
+        "description": "Lists Actions.",
+        "tags": [
+          "actions"
+        ],
+        "summary": "Get a specific Action based on its UUID and a Thing UUID. Also available as Websocket bus.",
+        "operationId": "actions.get",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Action.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "description": "Should additional meta information (e.g. about classified properties) be included? Defaults to false.",
+            "name": "meta",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/Action"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "lastUpdateTimeUnix": {
-          "description": "Timestamp of the last Thing update in milliseconds since epoch UTC.",
-          "type": "integer",
-          "format": "int64"
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
+      },
+      "put": {
+        "description": "Updates an Action's data. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+        "tags": [
+          "actions"
+        ],
+        "summary": "Update an Action based on its UUID.",
+        "operationId": "actions.update",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Action.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Action"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully received.",
+            "schema": {
+              "$ref": "#/definitions/Action"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "meta": {
-          "$ref": "#/definitions/ObjectMeta"
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "delete": {
+        "description": "Deletes an Action from the system.",
+        "tags": [
+          "actions"
+        ],
+        "summary": "Delete an Action based on its UUID.",
+        "operationId": "actions.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Thing.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "409": {
+            "description": "The object is still referenced through a reference property with the onDelete policy 'restrict'.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "schema": {
-          "$ref": "#/definitions/PropertySchema"
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "patch": {
+        "description": "Updates an Action. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+        "tags": [
+          "actions"
+        ],
+        "summary": "Update an Action based on its UUID (using patch semantics).",
+        "operationId": "actions.patch",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Action.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "RFC 7396-style patch, the body contains the action object to merge into the existing action object.",
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/Action"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully applied. No content provided."
+          },
+          "400": {
+            "description": "The patch-JSON is malformed."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "The patch-JSON is valid but unprocessable.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "vectorWeights": {
-          "$ref": "#/definitions/VectorWeights"
-        }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
       }
     },
-    "ThingsGetResponse": {
-      "type": "object",
-      "allOf": [
-        {
-          "$ref": "#/definitions/Thing"
+    "/actions/{id}/references/{propertyName}": {
+      "put": {
+        "description": "Replace all references to a class-property.",
+        "tags": [
+          "actions"
+        ],
+        "summary": "Replace all references to a class-property.",
+        "operationId": "actions.references.update",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Action.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Action.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/MultipleRef"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully replaced all the references."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        {
-          "properties": {
-            "result": {
-              "description": "Results for this specific Thing.",
-              "format": "object",
-              "properties": {
-                "errors": {
-                  "$ref": "#/definitions/ErrorResponse"
-                },
-                "status": {
-                  "type": "string",
-                  "default": "SUCCESS",
-                  "enum": [
-                    "SUCCESS",
-                    "PENDING",
-                    "FAILED"
-                  ]
-                }
-              }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "post": {
+        "description": "Add a single reference to a class-property when cardinality is set to 'hasMany'.",
+        "tags": [
+          "actions"
+        ],
+        "summary": "Add a single reference to a class-property when cardinality is set to 'hasMany'.",
+        "operationId": "actions.references.create",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Action.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Action.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SingleRef"
             }
           }
-        }
-      ]
-    },
-    "ThingsListResponse": {
-      "description": "List of Things.",
-      "type": "object",
-      "properties": {
-        "things": {
-          "description": "The actual list of Things.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Thing"
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully added the reference."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "totalResults": {
-          "description": "The total number of Things for the query. The number of items in a response may be smaller due to paging.",
-          "type": "integer",
-          "format": "int64"
-        }
-      }
-    },
-    "VectorWeights": {
-      "description": "Allow custom overrides of vector weights as math expressions. E.g. \"pancake\": \"7\" will set the weight for the word pancake to 7 in the vectorization, whereas \"w * 3\" would triple the originally calculated word. This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value (string/string) object.",
-      "type": "object"
-    },
-    "WhereFilter": {
-      "description": "Filter search results using a where filter",
-      "type": "object",
-      "properties": {
-        "operands": {
-          "description": "combine multiple where filters, requires 'And' or 'Or' operator",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/WhereFilter"
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "delete": {
+        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "tags": [
+          "actions"
+        ],
+        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "operationId": "actions.references.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Action.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Action.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SingleRef"
+            }
           }
-        },
-        "operator": {
-          "description": "operator to use",
-          "type": "string",
-          "enum": [
-            "And",
-            "Or",
-            "Equal",
-            "Like",
-            "Not",
-            "NotEqual",
-            "GreaterThan",
-            "GreaterThanEqual",
-            "LessThan",
-            "LessThanEqual",
-            "WithinGeoRange"
-          ],
-          "example": "GreaterThanEqual"
-        },
-        "path": {
-          "description": "path to the property currently being filtered",
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully deleted."
           },
-          "example": [
-            "inCity",
-            "City",
-            "name"
-          ]
-        },
-        "valueBoolean": {
-          "description": "value as boolean",
-          "type": "boolean",
-          "x-nullable": true,
-          "example": false
-        },
-        "valueDate": {
-          "description": "value as date (as string)",
-          "type": "string",
-          "x-nullable": true,
-          "example": "TODO"
-        },
-        "valueGeoRange": {
-          "description": "value as geo coordinates and distance",
-          "type": "object",
-          "x-nullable": true,
-          "$ref": "#/definitions/WhereFilterGeoRange"
-        },
-        "valueInt": {
-          "description": "value as integer",
-          "type": "integer",
-          "format": "int64",
-          "x-nullable": true,
-          "example": 2000
-        },
-        "valueNumber": {
-          "description": "value as number/float",
-          "type": "number",
-          "format": "float64",
-          "x-nullable": true,
-          "example": 3.14
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "valueString": {
-          "description": "value as string",
-          "type": "string",
-          "x-nullable": true,
-          "example": "my search term"
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      }
+    },
+    "/backups": {
+      "post": {
+        "description": "Start a backup of the schema, all objects with their vectors and the classification records to the backup directory. Backups run in the background, use GET /backups/{id} to retrieve the status of your backup.",
+        "tags": [
+          "backups"
+        ],
+        "summary": "Starts a backup.",
+        "operationId": "backups.create",
+        "parameters": [
+          {
+            "description": "parameters to start a backup",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Backup"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Successfully started backup.",
+            "schema": {
+              "$ref": "#/definitions/Backup"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup, for example an unknown class or an id which is already in use.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "valueText": {
-          "description": "value as text (on text props)",
-          "type": "string",
-          "x-nullable": true,
-          "example": "my search term"
-        }
+        "x-serviceIds": [
+          "weaviate.backups.create"
+        ]
       }
     },
-    "WhereFilterGeoRange": {
-      "description": "filter within a distance of a georange",
-      "type": "object",
-      "properties": {
-        "distance": {
-          "type": "object",
-          "properties": {
-            "max": {
-              "type": "number",
-              "format": "float64"
+    "/backups/{id}": {
+      "get": {
+        "description": "Get the status and progress of a previously started backup",
+        "tags": [
+          "backups"
+        ],
+        "summary": "View a previously started backup",
+        "operationId": "backups.get",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "backup id",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Found the backup, returned as body",
+            "schema": {
+              "$ref": "#/definitions/Backup"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Backup does not exist"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
-        "geoCoordinates": {
-          "$ref": "#/definitions/GeoCoordinates"
-        }
+        "x-serviceIds": [
+          "weaviate.backups.get"
+        ]
       }
-    }
-  },
-  "parameters": {
-    "CommonLimitParameterQuery": {
-      "type": "integer",
-      "format": "int64",
-      "description": "The maximum number of items to be returned per page. Default value is set in Weaviate config.",
-      "name": "limit",
-      "in": "query"
-    },
-    "CommonMetaParameterQuery": {
-      "type": "boolean",
-      "description": "Should additional meta information (e.g. about classified properties) be included? Defaults to false.",
-      "name": "meta",
-      "in": "query"
-    }
-  },
-  "securityDefinitions": {
-    "oidc": {
-      "description": "OIDC (OpenConnect ID - based on OAuth2)",
-      "type": "oauth2",
-      "flow": "implicit",
-      "authorizationUrl": "http://to-be-configured-in-the-application-config"
-    }
-  },
-  "security": [
-    {},
-    {
-      "oidc": []
-    }
-  ],
-  "tags": [
-    {
-      "name": "actions"
-    },
-    {
-      "description": "These operations allow to execute batch requests for Things and Actions. Mostly used for importing large datasets.",
-      "name": "batching"
-    },
-    {
-      "name": "graphql"
-    },
-    {
-      "name": "meta"
-    },
-    {
-      "name": "P2P"
-    },
-    {
-      "name": "things"
-    },
-    {
-      "description": "All functions related to the Contextionary.",
-      "name": "contextionary-API"
-    },
-    {
-      "description": "These operations enable manipulation of the schema in Weaviate schema.",
-      "name": "schema"
-    }
-  ],
-  "externalDocs": {
-    "url": "https://github.com/semi-technologies/weaviate"
-  }
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/yaml",
-    "application/json"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "https"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "Open Source Smart Graph (GraphQL/RESTful/P2P)",
-    "title": "Weaviate",
-    "contact": {
-      "name": "Weaviate",
-      "url": "https://github.com/semi-technologies",
-      "email": "hello@semi.technology"
     },
-    "version": "0.22.6"
-  },
-  "basePath": "/v1",
-  "paths": {
-    "/": {
+    "/backups/{id}/restore": {
       "get": {
-        "description": "Home. Discover the REST API",
-        "operationId": "weaviate.root",
+        "description": "Get the status and progress of the restore of a backup",
+        "tags": [
+          "backups"
+        ],
+        "summary": "View the restore of a backup",
+        "operationId": "backups.restore.get",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "backup id",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
           "200": {
-            "description": "Weaviate is alive and ready to serve content",
+            "description": "Found the restore, returned as body",
+            "schema": {
+              "$ref": "#/definitions/BackupRestore"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Backup does not exist or was never restored"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.backups.restore.get"
+        ]
+      },
+      "post": {
+        "description": "Restore a completed backup into this instance. None of the restored classes may be present in the schema. Restores run in the background, use GET /backups/{id}/restore to retrieve the status of your restore.",
+        "tags": [
+          "backups"
+        ],
+        "summary": "Starts a restore of a backup.",
+        "operationId": "backups.restore",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "backup id",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "parameters to restore the backup",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BackupRestore"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Successfully started restore.",
+            "schema": {
+              "$ref": "#/definitions/BackupRestore"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Backup does not exist"
+          },
+          "422": {
+            "description": "Invalid restore, for example a class which is already present in the schema.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.backups.restore"
+        ]
+      }
+    },
+    "/batching/actions": {
+      "post": {
+        "description": "Register new Actions in bulk. Given meta-data and schema values are validated.",
+        "tags": [
+          "batching",
+          "actions"
+        ],
+        "summary": "Creates new Actions based on an Action template as a batch.",
+        "operationId": "batching.actions.create",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
             "schema": {
               "type": "object",
               "properties": {
-                "links": {
+                "actions": {
                   "type": "array",
                   "items": {
-                    "$ref": "#/definitions/Link"
+                    "$ref": "#/definitions/Action"
+                  }
+                },
+                "fields": {
+                  "description": "Define which fields need to be returned. Default value is ALL",
+                  "type": "array",
+                  "items": {
+                    "type": "string",
+                    "default": "ALL",
+                    "enum": [
+                      "ALL",
+                      "class",
+                      "schema",
+                      "id",
+                      "creationTimeUnix"
+                    ]
                   }
                 }
               }
             }
           }
-        }
-      }
-    },
-    "/.well-known/live": {
-      "get": {
-        "description": "Determines whether the application is alive. Can be used for kubernetes liveness probe",
-        "operationId": "weaviate.wellknown.liveness",
+        ],
         "responses": {
           "200": {
-            "description": "The application is able to respond to HTTP requests"
+            "description": "Request succeeded, see response body to get detailed information about each batched item.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ActionsGetResponse"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        }
-      }
-    },
-    "/.well-known/openid-configuration": {
-      "get": {
-        "description": "OIDC Discovery page, redirects to the token issuer if one is configured",
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.add"
+        ]
+      },
+      "delete": {
+        "description": "Delete all Actions of a class which match the where filter. Use dryRun to find out how many actions would be deleted.",
         "tags": [
-          "well-known",
-          "oidc",
-          "discovery"
+          "batching",
+          "actions"
+        ],
+        "summary": "Deletes Actions matching a filter as a batch.",
+        "operationId": "batching.actions.delete",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BatchDelete"
+            }
+          }
         ],
-        "summary": "OIDC discovery information if OIDC auth is enabled",
         "responses": {
           "200": {
-            "description": "Successful response, inspect body",
+            "description": "Request succeeded, see response body to get detailed information about the deleted actions.",
+            "schema": {
+              "$ref": "#/definitions/BatchDeleteResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous, e.g. the class doesn't exist or the filter is invalid.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "patch": {
+        "description": "Merge partial Actions into existing Actions in bulk. Every Action must contain its id and class, the class is immutable. Provided schema values are validated and Actions are only revectorized if a value which is part of their vector changes.",
+        "tags": [
+          "batching",
+          "actions"
+        ],
+        "summary": "Updates Actions by merging partial Actions as a batch.",
+        "operationId": "batching.actions.merge",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
             "schema": {
               "type": "object",
               "properties": {
-                "clientId": {
-                  "description": "OAuth Client ID",
-                  "type": "string"
-                },
-                "href": {
-                  "description": "The Location to redirect to",
-                  "type": "string"
+                "actions": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Action"
+                  }
                 }
               }
             }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Request succeeded, see response body to get detailed information about each merged Action.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ActionsGetResponse"
+              }
+            }
           },
-          "404": {
-            "description": "Not found, no oidc provider present"
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
       }
     },
-    "/.well-known/ready": {
-      "get": {
-        "description": "Determines whether the application is ready to receive traffic. Can be used for kubernetes readiness probe.",
-        "operationId": "weaviate.wellknown.readiness",
+    "/batching/references": {
+      "post": {
+        "description": "Register cross-references between any class items (things or actions) in bulk.",
+        "tags": [
+          "batching",
+          "references"
+        ],
+        "summary": "Creates new Cross-References between arbitrary classes in bulk.",
+        "operationId": "batching.references.create",
+        "parameters": [
+          {
+            "description": "A list of references to be batched. The ideal size depends on the used database connector. Please see the documentation of the used connector for help",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchReference"
+              }
+            }
+          }
+        ],
         "responses": {
           "200": {
-            "description": "The application has completed its start-up routine and is ready to accept traffic."
+            "description": "Request Successful. Warning: A successful request does not guarantuee that every batched reference was successfully created. Inspect the response body to see which references succeeded and which failed.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchReferenceResponse"
+              }
+            }
           },
-          "503": {
-            "description": "The application is currently not able to serve traffic. If other horizontal replicas of weaviate are available and they are capable of receving traffic, all traffic should be redirected there instead."
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.add"
+        ]
       }
-    },
-    "/actions": {
-      "get": {
-        "description": "Lists all Actions in reverse order of creation, owned by the user that belongs to the used token.",
+    },
+    "/batching/things": {
+      "post": {
+        "description": "Register new Things in bulk. Provided meta-data and schema values are validated.",
         "tags": [
-          "actions"
+          "batching",
+          "things"
         ],
-        "summary": "Get a list of Actions.",
-        "operationId": "actions.list",
+        "summary": "Creates new Things based on a Thing template as a batch.",
+        "operationId": "batching.things.create",
         "parameters": [
           {
-            "type": "integer",
-            "format": "int64",
-            "description": "The maximum number of items to be returned per page. Default value is set in Weaviate config.",
-            "name": "limit",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "description": "Should additional meta information (e.g. about classified properties) be included? Defaults to false.",
-            "name": "meta",
-            "in": "query"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "fields": {
+                  "description": "Define which fields need to be returned. Default value is ALL",
+                  "type": "array",
+                  "items": {
+                    "type": "string",
+                    "default": "ALL",
+                    "enum": [
+                      "ALL",
+                      "class",
+                      "schema",
+                      "id",
+                      "creationTimeUnix"
+                    ]
+                  }
+                },
+                "things": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Thing"
+                  }
+                }
+              }
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful response.",
+            "description": "Request succeeded, see response body to get detailed information about each batched item.",
             "schema": {
-              "$ref": "#/definitions/ActionsListResponse"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ThingsGetResponse"
+              }
             }
           },
           "401": {
@@ -4021,8 +7539,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -4034,31 +7555,32 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query"
+          "weaviate.local.add"
         ]
       },
-      "post": {
-        "description": "Registers a new Action. Provided meta-data and schema values are validated.",
+      "delete": {
+        "description": "Delete all Things of a class which match the where filter. Use dryRun to find out how many things would be deleted.",
         "tags": [
-          "actions"
+          "batching",
+          "things"
         ],
-        "summary": "Create Actions between two Things (object and subject).",
-        "operationId": "actions.create",
+        "summary": "Deletes Things matching a filter as a batch.",
+        "operationId": "batching.things.delete",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Action"
+              "$ref": "#/definitions/BatchDelete"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Action created.",
+            "description": "Request succeeded, see response body to get detailed information about the deleted things.",
             "schema": {
-              "$ref": "#/definitions/Action"
+              "$ref": "#/definitions/BatchDeleteResponse"
             }
           },
           "401": {
@@ -4071,7 +7593,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous, e.g. the class doesn't exist or the filter is invalid.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -4083,34 +7605,45 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/actions/validate": {
-      "post": {
-        "description": "Validate an Action's schema and meta-data. It has to be based on a schema, which is related to the given Action to be accepted by this validation.",
+      },
+      "patch": {
+        "description": "Merge partial Things into existing Things in bulk. Every Thing must contain its id and class, the class is immutable. Provided schema values are validated and Things are only revectorized if a value which is part of their vector changes.",
         "tags": [
-          "actions"
+          "batching",
+          "things"
         ],
-        "summary": "Validate an Action based on a schema.",
-        "operationId": "actions.validate",
+        "summary": "Updates Things by merging partial Things as a batch.",
+        "operationId": "batching.things.merge",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Action"
+              "type": "object",
+              "properties": {
+                "things": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Thing"
+                  }
+                }
+              }
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully validated."
+            "description": "Request succeeded, see response body to get detailed information about each merged Thing.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ThingsGetResponse"
+              }
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -4122,7 +7655,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -4134,42 +7667,39 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.local.manipulate"
         ]
       }
     },
-    "/actions/{id}": {
+    "/c11y/concepts/{concept}": {
       "get": {
-        "description": "Lists Actions.",
+        "description": "Checks if a concept is part of the contextionary. Concepts should be concatenated as described here: https://github.com/semi-technologies/weaviate/blob/master/docs/en/use/ontology-schema.md#camelcase",
         "tags": [
-          "actions"
+          "contextionary-API"
         ],
-        "summary": "Get a specific Action based on its UUID and a Thing UUID. Also available as Websocket bus.",
-        "operationId": "actions.get",
+        "summary": "Checks if a concept is part of the contextionary.",
+        "operationId": "c11y.concepts",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Action.",
-            "name": "id",
+            "description": "CamelCase list of words to validate.",
+            "name": "concept",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "boolean",
-            "description": "Should additional meta information (e.g. about classified properties) be included? Defaults to false.",
-            "name": "meta",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Successful response.",
             "schema": {
-              "$ref": "#/definitions/Action"
+              "$ref": "#/definitions/C11yWordsResponse"
+            }
+          },
+          "400": {
+            "description": "Incorrect request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -4181,68 +7711,96 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
+          },
+          "501": {
+            "description": "Not (yet) implemented."
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.c11y.words.get"
+        ]
+      }
+    },
+    "/c11y/corpus": {
+      "post": {
+        "description": "Analyzes a sentence based on the contextionary",
+        "tags": [
+          "contextionary-API"
+        ],
+        "summary": "Checks if a word or wordString is part of the contextionary.",
+        "operationId": "c11y.corpus.get",
+        "parameters": [
+          {
+            "description": "A text corpus",
+            "name": "corpus",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "description": "The text corpus.",
+              "type": "object",
+              "properties": {
+                "corpus": {
+                  "type": "string",
+                  "example": "In certain latitudes there comes a span of time approaching and following the summer solstice, some weeks in all, when the twilights turn long and blue."
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "501": {
+            "description": "Not (yet) implemented."
           }
         },
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query"
+          "weaviate.c11y.corpus.get"
         ]
-      },
-      "put": {
-        "description": "Updates an Action's data. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+      }
+    },
+    "/c11y/extensions/": {
+      "post": {
+        "description": "Extend the contextionary with your own custom concepts",
         "tags": [
-          "actions"
+          "contextionary-API"
         ],
-        "summary": "Update an Action based on its UUID.",
-        "operationId": "actions.update",
+        "summary": "Extend the contextionary with custom concepts",
+        "operationId": "c11y.extensions",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Action.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
+            "description": "Description and definition of the concept to extend the contextionary with",
+            "name": "extension",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Action"
+              "$ref": "#/definitions/C11yExtension"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully received.",
+            "description": "Successfully extended the contextionary with the custom cocnept",
             "schema": {
-              "$ref": "#/definitions/Action"
+              "$ref": "#/definitions/C11yExtension"
             }
           },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
+          "400": {
+            "description": "Incorrect request",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
+          "401": {
+            "description": "Unauthorized or invalid credentials."
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+          "403": {
+            "description": "Forbidden",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -4252,34 +7810,45 @@ func init() {
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
+          },
+          "501": {
+            "description": "Not (yet) implemented."
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.extensions.post"
         ]
-      },
-      "delete": {
-        "description": "Deletes an Action from the system.",
+      }
+    },
+    "/c11y/words/{words}": {
+      "get": {
+        "description": "Checks if a word or wordString is part of the contextionary. Words should be concatenated as described here: https://github.com/semi-technologies/weaviate/blob/master/docs/en/use/ontology-schema.md#camelcase",
         "tags": [
-          "actions"
+          "contextionary-API"
         ],
-        "summary": "Delete an Action based on its UUID.",
-        "operationId": "actions.delete",
+        "summary": "Checks if a word or wordString is part of the contextionary.",
+        "operationId": "c11y.words",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Thing.",
-            "name": "id",
+            "description": "CamelCase list of words to validate.",
+            "name": "words",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/C11yWordsResponse"
+            }
+          },
+          "400": {
+            "description": "Incorrect request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -4290,53 +7859,58 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
+          },
+          "501": {
+            "description": "Not (yet) implemented."
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.c11y.words.get"
         ]
-      },
-      "patch": {
-        "description": "Updates an Action. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+      }
+    },
+    "/classifications/": {
+      "get": {
+        "description": "List classifications, most recently started first. Only classifications of classes the user is allowed to view are listed.",
         "tags": [
-          "actions"
+          "classifications"
         ],
-        "summary": "Update an Action based on its UUID (using patch semantics).",
-        "operationId": "actions.patch",
+        "summary": "List classifications.",
+        "operationId": "classifications.list",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Action.",
-            "name": "id",
-            "in": "path",
-            "required": true
+            "description": "only list classifications of this class",
+            "name": "class",
+            "in": "query"
           },
           {
-            "description": "RFC 7396-style patch, the body contains the action object to merge into the existing action object.",
-            "name": "body",
-            "in": "body",
-            "schema": {
-              "$ref": "#/definitions/Action"
-            }
+            "enum": [
+              "running",
+              "completed",
+              "failed",
+              "cancelled",
+              "interrupted"
+            ],
+            "type": "string",
+            "description": "only list classifications with this status",
+            "name": "status",
+            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully applied. No content provided."
-          },
-          "400": {
-            "description": "The patch-JSON is malformed."
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/ClassificationsListResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -4347,15 +7921,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
-          "422": {
-            "description": "The patch-JSON is valid but unprocessable.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -4363,49 +7928,40 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.classifications.list"
         ]
-      }
-    },
-    "/actions/{id}/references/{propertyName}": {
-      "put": {
-        "description": "Replace all references to a class-property.",
+      },
+      "post": {
+        "description": "Trigger a classification based on the specified params. Classifications will run in the background, use GET /classifications/\u003cid\u003e to retrieve the status of your classificaiton.",
         "tags": [
-          "actions"
+          "classifications"
         ],
-        "summary": "Replace all references to a class-property.",
-        "operationId": "actions.references.update",
+        "summary": "Starts a classification.",
+        "operationId": "classifications.post",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Action.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Action.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
+            "description": "parameters to start a classification",
+            "name": "params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/MultipleRef"
+              "$ref": "#/definitions/Classification"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successfully replaced all the references."
+          "201": {
+            "description": "Successfully started classification.",
+            "schema": {
+              "$ref": "#/definitions/Classification"
+            }
+          },
+          "400": {
+            "description": "Incorrect request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -4416,12 +7972,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -4429,47 +7979,34 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.classifications.post"
         ]
-      },
-      "post": {
-        "description": "Add a single reference to a class-property when cardinality is set to 'hasMany'.",
+      }
+    },
+    "/classifications/{id}": {
+      "get": {
+        "description": "Get status, results and metadata of a previously created classification",
         "tags": [
-          "actions"
+          "classifications"
         ],
-        "summary": "Add a single reference to a class-property when cardinality is set to 'hasMany'.",
-        "operationId": "actions.references.create",
+        "summary": "View previously created classification",
+        "operationId": "classifications.get",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Action.",
+            "description": "classification id",
             "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Action.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/SingleRef"
-            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully added the reference."
+            "description": "Found the classification, returned as body",
+            "schema": {
+              "$ref": "#/definitions/Classification"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -4480,11 +8017,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "404": {
+            "description": "Not Found - Classification does not exist"
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -4493,47 +8027,32 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.classifications.get"
         ]
       },
       "delete": {
-        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "description": "Cancel a running classification. The classification stops after the object it is currently classifying, objects which have already been classified keep their references.",
         "tags": [
-          "actions"
+          "classifications"
         ],
-        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
-        "operationId": "actions.references.delete",
+        "summary": "Cancel a running classification",
+        "operationId": "classifications.cancel",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Action.",
+            "description": "classification id",
             "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Action.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/SingleRef"
-            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
+          "202": {
+            "description": "Cancellation requested, the classification is returned as body",
+            "schema": {
+              "$ref": "#/definitions/Classification"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -4545,7 +8064,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found.",
+            "description": "Not Found - Classification does not exist"
+          },
+          "409": {
+            "description": "The classification is not running",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -4557,63 +8079,35 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.classifications.cancel"
         ]
       }
     },
-    "/batching/actions": {
-      "post": {
-        "description": "Register new Actions in bulk. Given meta-data and schema values are validated.",
-        "tags": [
-          "batching",
-          "actions"
-        ],
-        "summary": "Creates new Actions based on an Action template as a batch.",
-        "operationId": "batching.actions.create",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "object",
-              "properties": {
-                "actions": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/Action"
-                  }
-                },
-                "fields": {
-                  "description": "Define which fields need to be returned. Default value is ALL",
-                  "type": "array",
-                  "items": {
-                    "type": "string",
-                    "default": "ALL",
-                    "enum": [
-                      "ALL",
-                      "class",
-                      "schema",
-                      "id",
-                      "creationTimeUnix"
-                    ]
-                  }
-                }
-              }
+    "/graphql": {
+      "post": {
+        "description": "Get an object based on GraphQL",
+        "tags": [
+          "graphql"
+        ],
+        "summary": "Get a response based on GraphQL",
+        "operationId": "graphql.post",
+        "parameters": [
+          {
+            "description": "The GraphQL query request parameters.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/GraphQLQuery"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Request succeeded, see response body to get detailed information about each batched item.",
+            "description": "Successful query (with select).",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/ActionsGetResponse"
-              }
+              "$ref": "#/definitions/GraphQLResponse"
             }
           },
           "401": {
@@ -4641,41 +8135,37 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.local.query",
+          "weaviate.local.query.meta",
+          "weaviate.network.query",
+          "weaviate.network.query.meta"
         ]
       }
     },
-    "/batching/references": {
+    "/graphql/batch": {
       "post": {
-        "description": "Register cross-references between any class items (things or actions) in bulk.",
+        "description": "Perform a batched GraphQL query",
         "tags": [
-          "batching",
-          "references"
+          "graphql"
         ],
-        "summary": "Creates new Cross-References between arbitrary classes in bulk.",
-        "operationId": "batching.references.create",
+        "summary": "Get a response based on GraphQL.",
+        "operationId": "graphql.batch",
         "parameters": [
           {
-            "description": "A list of references to be batched. The ideal size depends on the used database connector. Please see the documentation of the used connector for help",
+            "description": "The GraphQL queries.",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/BatchReference"
-              }
+              "$ref": "#/definitions/GraphQLQueries"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Request Successful. Warning: A successful request does not guarantuee that every batched reference was successfully created. Inspect the response body to see which references succeeded and which failed.",
+            "description": "Successful query (with select).",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/BatchReferenceResponse"
-              }
+              "$ref": "#/definitions/GraphQLResponses"
             }
           },
           "401": {
@@ -4703,60 +8193,36 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.local.query",
+          "weaviate.local.query.meta",
+          "weaviate.network.query",
+          "weaviate.network.query.meta"
         ]
       }
     },
-    "/batching/things": {
+    "/maintenance/dangling-references": {
       "post": {
-        "description": "Register new Things in bulk. Provided meta-data and schema values are validated.",
+        "description": "Scans the objects for references to objects which don't exist (anymore), e.g. because they were deleted before references were removed on deletion. The dangling references are reported and, if requested, removed from the objects.",
         "tags": [
-          "batching",
-          "things"
+          "maintenance"
         ],
-        "summary": "Creates new Things based on a Thing template as a batch.",
-        "operationId": "batching.things.create",
+        "summary": "Find and repair references to objects which don't exist",
+        "operationId": "maintenance.danglingReferences",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "object",
-              "properties": {
-                "fields": {
-                  "description": "Define which fields need to be returned. Default value is ALL",
-                  "type": "array",
-                  "items": {
-                    "type": "string",
-                    "default": "ALL",
-                    "enum": [
-                      "ALL",
-                      "class",
-                      "schema",
-                      "id",
-                      "creationTimeUnix"
-                    ]
-                  }
-                },
-                "things": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/Thing"
-                  }
-                }
-              }
+              "$ref": "#/definitions/DanglingReferencesCheck"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Request succeeded, see response body to get detailed information about each batched item.",
+            "description": "The scan finished, see the response body for the dangling references.",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/ThingsGetResponse"
-              }
+              "$ref": "#/definitions/DanglingReferencesReport"
             }
           },
           "401": {
@@ -4769,7 +8235,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous, e.g. the class doesn't exist.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -4781,41 +8247,24 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.local.manipulate"
         ]
       }
     },
-    "/c11y/concepts/{concept}": {
+    "/meta": {
       "get": {
-        "description": "Checks if a concept is part of the contextionary. Concepts should be concatenated as described here: https://github.com/semi-technologies/weaviate/blob/master/docs/en/use/ontology-schema.md#camelcase",
+        "description": "Gives meta information about the server and can be used to provide information to another Weaviate instance that wants to interact with the current instance.",
         "tags": [
-          "contextionary-API"
-        ],
-        "summary": "Checks if a concept is part of the contextionary.",
-        "operationId": "c11y.concepts",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "CamelCase list of words to validate.",
-            "name": "concept",
-            "in": "path",
-            "required": true
-          }
+          "meta"
         ],
+        "summary": "Returns meta information of the current Weaviate instance.",
+        "operationId": "meta.get",
         "responses": {
           "200": {
             "description": "Successful response.",
             "schema": {
-              "$ref": "#/definitions/C11yWordsResponse"
-            }
-          },
-          "400": {
-            "description": "Incorrect request",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/Meta"
             }
           },
           "401": {
@@ -4832,138 +8281,97 @@ func init() {
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
-          },
-          "501": {
-            "description": "Not (yet) implemented."
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.c11y.words.get"
+          "weaviate.local.query.meta"
         ]
       }
     },
-    "/c11y/corpus": {
-      "post": {
-        "description": "Analyzes a sentence based on the contextionary",
+    "/p2p/genesis": {
+      "put": {
+        "security": [],
+        "description": "Receive an update from the Genesis server.",
         "tags": [
-          "contextionary-API"
+          "P2P"
         ],
-        "summary": "Checks if a word or wordString is part of the contextionary.",
-        "operationId": "c11y.corpus.get",
+        "operationId": "p2p.genesis_update",
         "parameters": [
           {
-            "description": "A text corpus",
-            "name": "corpus",
+            "name": "peers",
             "in": "body",
             "required": true,
             "schema": {
-              "description": "The text corpus.",
-              "type": "object",
-              "properties": {
-                "corpus": {
-                  "type": "string",
-                  "example": "In certain latitudes there comes a span of time approaching and following the summer solstice, some weeks in all, when the twilights turn long and blue."
-                }
-              }
+              "$ref": "#/definitions/PeerUpdateList"
             }
           }
         ],
         "responses": {
-          "501": {
-            "description": "Not (yet) implemented."
+          "200": {
+            "description": "Alive and kicking!"
+          },
+          "401": {
+            "description": "Unauthorized update."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.c11y.corpus.get"
+          "weaviate.network.query.meta"
         ]
       }
     },
-    "/c11y/extensions/": {
-      "post": {
-        "description": "Extend the contextionary with your own custom concepts",
+    "/p2p/health": {
+      "get": {
+        "security": [],
+        "description": "Check if a peer is alive and healthy.",
         "tags": [
-          "contextionary-API"
-        ],
-        "summary": "Extend the contextionary with custom concepts",
-        "operationId": "c11y.extensions",
-        "parameters": [
-          {
-            "description": "Description and definition of the concept to extend the contextionary with",
-            "name": "extension",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/C11yExtension"
-            }
-          }
+          "P2P"
         ],
+        "summary": "Check if a peer is alive.",
+        "operationId": "p2p.health",
         "responses": {
           "200": {
-            "description": "Successfully extended the contextionary with the custom cocnept",
-            "schema": {
-              "$ref": "#/definitions/C11yExtension"
-            }
-          },
-          "400": {
-            "description": "Incorrect request",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "Alive and kicking!"
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
-          },
-          "501": {
-            "description": "Not (yet) implemented."
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.extensions.post"
+          "weaviate.network.query.meta"
         ]
       }
     },
-    "/c11y/words/{words}": {
+    "/roles": {
       "get": {
-        "description": "Checks if a word or wordString is part of the contextionary. Words should be concatenated as described here: https://github.com/semi-technologies/weaviate/blob/master/docs/en/use/ontology-schema.md#camelcase",
+        "description": "Lists the roles from the configuration file as well as those created through the api.",
         "tags": [
-          "contextionary-API"
-        ],
-        "summary": "Checks if a word or wordString is part of the contextionary.",
-        "operationId": "c11y.words",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "CamelCase list of words to validate.",
-            "name": "words",
-            "in": "path",
-            "required": true
-          }
+          "roles"
         ],
+        "summary": "List all roles",
+        "operationId": "roles.list",
         "responses": {
           "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/C11yWordsResponse"
-            }
-          },
-          "400": {
-            "description": "Incorrect request",
+            "description": "All roles.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Role"
+              }
             }
           },
           "401": {
@@ -4975,53 +8383,45 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "422": {
+            "description": "Invalid role or rbac authorization is not enabled.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
-          },
-          "501": {
-            "description": "Not (yet) implemented."
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.c11y.words.get"
+          "weaviate.roles.list"
         ]
-      }
-    },
-    "/classifications/": {
+      },
       "post": {
-        "description": "Trigger a classification based on the specified params. Classifications will run in the background, use GET /classifications/\u003cid\u003e to retrieve the status of your classificaiton.",
+        "description": "Creates a role, which takes effect immediately.",
         "tags": [
-          "classifications"
+          "roles"
         ],
-        "summary": "Starts a classification.",
-        "operationId": "classifications.post",
+        "summary": "Create a role",
+        "operationId": "roles.create",
         "parameters": [
           {
-            "description": "parameters to start a classification",
-            "name": "params",
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Classification"
+              "$ref": "#/definitions/Role"
             }
           }
         ],
         "responses": {
           "201": {
-            "description": "Successfully started classification.",
-            "schema": {
-              "$ref": "#/definitions/Classification"
-            }
-          },
-          "400": {
-            "description": "Incorrect request",
+            "description": "Role created.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/Role"
             }
           },
           "401": {
@@ -5033,6 +8433,12 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "422": {
+            "description": "Invalid role or rbac authorization is not enabled.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -5041,32 +8447,32 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.classifications.post"
+          "weaviate.roles.create"
         ]
       }
     },
-    "/classifications/{id}": {
+    "/roles/{name}": {
       "get": {
-        "description": "Get status, results and metadata of a previously created classification",
+        "description": "Get a single role by its name.",
         "tags": [
-          "classifications"
+          "roles"
         ],
-        "summary": "View previously created classification",
-        "operationId": "classifications.get",
+        "summary": "Get a role",
+        "operationId": "roles.get",
         "parameters": [
           {
             "type": "string",
-            "description": "classification id",
-            "name": "id",
+            "description": "name of the role",
+            "name": "name",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Found the classification, returned as body",
+            "description": "Found the role, returned as body.",
             "schema": {
-              "$ref": "#/definitions/Classification"
+              "$ref": "#/definitions/Role"
             }
           },
           "401": {
@@ -5079,7 +8485,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Not Found - Classification does not exist"
+            "description": "Not Found - Role does not exist"
+          },
+          "422": {
+            "description": "Invalid role or rbac authorization is not enabled.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -5089,34 +8501,38 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.classifications.get"
+          "weaviate.roles.get"
         ]
-      }
-    },
-    "/graphql": {
-      "post": {
-        "description": "Get an object based on GraphQL",
+      },
+      "put": {
+        "description": "Replaces the permissions and bindings of a role created through the api.",
         "tags": [
-          "graphql"
+          "roles"
         ],
-        "summary": "Get a response based on GraphQL",
-        "operationId": "graphql.post",
+        "summary": "Update a role",
+        "operationId": "roles.update",
         "parameters": [
           {
-            "description": "The GraphQL query request parameters.",
+            "type": "string",
+            "description": "name of the role",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/GraphQLQuery"
+              "$ref": "#/definitions/Role"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful query (with select).",
+            "description": "Role updated.",
             "schema": {
-              "$ref": "#/definitions/GraphQLResponse"
+              "$ref": "#/definitions/Role"
             }
           },
           "401": {
@@ -5128,8 +8544,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Not Found - Role does not exist"
+          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid role or rbac authorization is not enabled.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -5141,41 +8560,29 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query",
-          "weaviate.local.query.meta",
-          "weaviate.network.query",
-          "weaviate.network.query.meta"
+          "weaviate.roles.update"
         ]
-      }
-    },
-    "/graphql/batch": {
-      "post": {
-        "description": "Perform a batched GraphQL query",
+      },
+      "delete": {
+        "description": "Deletes a role created through the api, which takes effect immediately.",
         "tags": [
-          "graphql"
+          "roles"
         ],
-        "summary": "Get a response based on GraphQL.",
-        "operationId": "graphql.batch",
+        "summary": "Delete a role",
+        "operationId": "roles.delete",
         "parameters": [
           {
-            "description": "The GraphQL queries.",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/GraphQLQueries"
-            }
+            "type": "string",
+            "description": "name of the role",
+            "name": "name",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successful query (with select).",
-            "schema": {
-              "$ref": "#/definitions/GraphQLResponses"
-            }
+          "204": {
+            "description": "Role deleted."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -5186,8 +8593,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Not Found - Role does not exist"
+          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid role or rbac authorization is not enabled.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -5199,29 +8609,31 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query",
-          "weaviate.local.query.meta",
-          "weaviate.network.query",
-          "weaviate.network.query.meta"
+          "weaviate.roles.delete"
         ]
       }
     },
-    "/meta": {
+    "/schema": {
       "get": {
-        "description": "Gives meta information about the server and can be used to provide information to another Weaviate instance that wants to interact with the current instance.",
         "tags": [
-          "meta"
+          "schema"
         ],
-        "summary": "Returns meta information of the current Weaviate instance.",
-        "operationId": "meta.get",
+        "summary": "Dump the current the database schema.",
+        "operationId": "schema.dump",
         "responses": {
           "200": {
-            "description": "Successful response.",
+            "description": "Successfully dumped the database schema.",
             "schema": {
-              "$ref": "#/definitions/Meta"
+              "type": "object",
+              "properties": {
+                "actions": {
+                  "$ref": "#/definitions/Schema"
+                },
+                "things": {
+                  "$ref": "#/definitions/Schema"
+                }
+              }
             }
           },
           "401": {
@@ -5240,37 +8652,49 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
           "weaviate.local.query.meta"
         ]
       }
     },
-    "/p2p/genesis": {
-      "put": {
-        "security": [],
-        "description": "Receive an update from the Genesis server.",
+    "/schema/actions": {
+      "post": {
         "tags": [
-          "P2P"
+          "schema"
         ],
-        "operationId": "p2p.genesis_update",
+        "summary": "Create a new Action class in the ontology.",
+        "operationId": "schema.actions.create",
         "parameters": [
           {
-            "name": "peers",
+            "name": "actionClass",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/PeerUpdateList"
+              "$ref": "#/definitions/Class"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Alive and kicking!"
+            "description": "Added the new Action class to the ontology.",
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
           },
           "401": {
-            "description": "Unauthorized update."
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Action class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -5279,25 +8703,52 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.network.query.meta"
+          "weaviate.local.add.meta"
         ]
       }
     },
-    "/p2p/health": {
-      "get": {
-        "security": [],
-        "description": "Check if a peer is alive and healthy.",
+    "/schema/actions/{className}": {
+      "put": {
         "tags": [
-          "P2P"
+          "schema"
+        ],
+        "summary": "Rename, or replace the keywords of the Action.",
+        "operationId": "schema.actions.update",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
+          }
         ],
-        "summary": "Check if a peer is alive.",
-        "operationId": "p2p.health",
         "responses": {
           "200": {
-            "description": "Alive and kicking!"
+            "description": "Changes applied."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid update.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -5306,33 +8757,32 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.network.query.meta"
+          "weaviate.local.manipulate.meta"
         ]
-      }
-    },
-    "/schema": {
-      "get": {
+      },
+      "delete": {
         "tags": [
           "schema"
         ],
-        "summary": "Dump the current the database schema.",
-        "operationId": "schema.dump",
+        "summary": "Remove an Action class (and all data in the instances) from the ontology.",
+        "operationId": "schema.actions.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
           "200": {
-            "description": "Successfully dumped the database schema.",
+            "description": "Removed the Action class from the ontology."
+          },
+          "400": {
+            "description": "Could not delete the Action class.",
             "schema": {
-              "type": "object",
-              "properties": {
-                "actions": {
-                  "$ref": "#/definitions/Schema"
-                },
-                "things": {
-                  "$ref": "#/definitions/Schema"
-                }
-              }
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -5352,32 +8802,38 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.local.manipulate.meta"
         ]
       }
     },
-    "/schema/actions": {
+    "/schema/actions/{className}/properties": {
       "post": {
         "tags": [
           "schema"
-        ],
-        "summary": "Create a new Action class in the ontology.",
-        "operationId": "schema.actions.create",
+        ],
+        "summary": "Add a property to an Action class.",
+        "operationId": "schema.actions.properties.add",
         "parameters": [
           {
-            "name": "actionClass",
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/Property"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Added the new Action class to the ontology.",
+            "description": "Added the property.",
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/Property"
             }
           },
           "401": {
@@ -5390,7 +8846,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Invalid Action class",
+            "description": "Invalid property.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -5403,17 +8859,17 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.add.meta"
+          "weaviate.local.manipulate.meta"
         ]
       }
     },
-    "/schema/actions/{className}": {
+    "/schema/actions/{className}/properties/{propertyName}": {
       "put": {
         "tags": [
           "schema"
         ],
-        "summary": "Rename, or replace the keywords of the Action.",
-        "operationId": "schema.actions.update",
+        "summary": "Rename, or replace the keywords of the property.",
+        "operationId": "schema.actions.properties.update",
         "parameters": [
           {
             "type": "string",
@@ -5421,12 +8877,18 @@ func init() {
             "in": "path",
             "required": true
           },
+          {
+            "type": "string",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/Property"
             }
           }
         ],
@@ -5464,25 +8926,25 @@ func init() {
         "tags": [
           "schema"
         ],
-        "summary": "Remove an Action class (and all data in the instances) from the ontology.",
-        "operationId": "schema.actions.delete",
+        "summary": "Remove a property from an Action class.",
+        "operationId": "schema.actions.properties.delete",
         "parameters": [
           {
             "type": "string",
             "name": "className",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Removed the Action class from the ontology."
-          },
-          "400": {
-            "description": "Could not delete the Action class.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "Removed the property from the ontology."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -5505,13 +8967,14 @@ func init() {
         ]
       }
     },
-    "/schema/actions/{className}/properties": {
+    "/schema/actions/{className}/revectorize": {
       "post": {
+        "description": "Start a revectorization which recomputes the vectors of all actions of the class, or of those matching the where filter, with the current vectorizer settings and stores them in batches. The revectorization runs in the background, use GET /schema/revectorizations/{id} to retrieve its progress.",
         "tags": [
           "schema"
         ],
-        "summary": "Add a property to an Action class.",
-        "operationId": "schema.actions.properties.add",
+        "summary": "Recompute the vectors of the Actions of a class.",
+        "operationId": "schema.actions.revectorize",
         "parameters": [
           {
             "type": "string",
@@ -5520,19 +8983,19 @@ func init() {
             "required": true
           },
           {
+            "description": "optional where filter to limit the revectorized actions",
             "name": "body",
             "in": "body",
-            "required": true,
             "schema": {
-              "$ref": "#/definitions/Property"
+              "$ref": "#/definitions/Revectorization"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Added the property.",
+          "202": {
+            "description": "Successfully started the revectorization.",
             "schema": {
-              "$ref": "#/definitions/Property"
+              "$ref": "#/definitions/Revectorization"
             }
           },
           "401": {
@@ -5544,8 +9007,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "409": {
+            "description": "A revectorization of the class is already running",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Invalid property.",
+            "description": "Invalid revectorization, e.g. the class does not exist or has no vectorizer",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -5562,38 +9031,111 @@ func init() {
         ]
       }
     },
-    "/schema/actions/{className}/properties/{propertyName}": {
-      "put": {
+    "/schema/reindexes": {
+      "get": {
+        "description": "List the reindex jobs which were started by renaming a class or property or by dropping a property. Jobs are kept in memory, so only the jobs started since the last restart are listed.",
         "tags": [
           "schema"
         ],
-        "summary": "Rename, or replace the keywords of the property.",
-        "operationId": "schema.actions.properties.update",
+        "summary": "List reindex jobs",
+        "operationId": "schema.reindexes.list",
+        "responses": {
+          "200": {
+            "description": "Successfully listed the reindex jobs.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/SchemaReindex"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      }
+    },
+    "/schema/reindexes/{id}": {
+      "get": {
+        "description": "Get the status and progress of a reindex job",
+        "tags": [
+          "schema"
+        ],
+        "summary": "View a reindex job",
+        "operationId": "schema.reindexes.get",
         "parameters": [
           {
             "type": "string",
-            "name": "className",
+            "format": "uuid",
+            "description": "reindex job id",
+            "name": "id",
             "in": "path",
             "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Found the reindex job, returned as body",
+            "schema": {
+              "$ref": "#/definitions/SchemaReindex"
+            }
           },
-          {
-            "type": "string",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
+          "401": {
+            "description": "Unauthorized or invalid credentials."
           },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
+          "403": {
+            "description": "Forbidden",
             "schema": {
-              "$ref": "#/definitions/Property"
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Reindex job does not exist"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
+        },
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      }
+    },
+    "/schema/revectorizations": {
+      "get": {
+        "description": "List the revectorizations, most recently started first. Revectorizations are kept in memory, so only the revectorizations started since the last restart are listed, of which at most the 100 most recent finished ones are kept. Only revectorizations of classes the user is allowed to view are listed.",
+        "tags": [
+          "schema"
         ],
+        "summary": "List revectorizations",
+        "operationId": "schema.revectorizations.list",
         "responses": {
           "200": {
-            "description": "Changes applied."
+            "description": "Successfully listed the revectorizations.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Revectorization"
+              }
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -5604,12 +9146,55 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Invalid update.",
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      }
+    },
+    "/schema/revectorizations/{id}": {
+      "get": {
+        "description": "Get the status and progress of a revectorization.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Get a revectorization",
+        "operationId": "schema.revectorizations.get",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "revectorization id",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Found the revectorization, returned as body",
+            "schema": {
+              "$ref": "#/definitions/Revectorization"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Not Found - Revectorization does not exist"
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -5618,32 +9203,32 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.local.query.meta"
         ]
       },
       "delete": {
+        "description": "Cancel a running revectorization. Objects which have already been revectorized keep their new vectors, all other objects keep their previous vectors.",
         "tags": [
           "schema"
         ],
-        "summary": "Remove a property from an Action class.",
-        "operationId": "schema.actions.properties.delete",
+        "summary": "Cancel a running revectorization",
+        "operationId": "schema.revectorizations.cancel",
         "parameters": [
           {
             "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "propertyName",
+            "format": "uuid",
+            "description": "revectorization id",
+            "name": "id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Removed the property from the ontology."
+          "202": {
+            "description": "Cancellation requested, the revectorization is returned as body",
+            "schema": {
+              "$ref": "#/definitions/Revectorization"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -5654,6 +9239,15 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Not Found - Revectorization does not exist"
+          },
+          "409": {
+            "description": "The revectorization is not running",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -5951,8 +9545,60 @@ func init() {
           }
         ],
         "responses": {
-          "200": {
-            "description": "Removed the property from the ontology."
+          "200": {
+            "description": "Removed the property from the ontology."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/schema/things/{className}/revectorize": {
+      "post": {
+        "description": "Start a revectorization which recomputes the vectors of all things of the class, or of those matching the where filter, with the current vectorizer settings and stores them in batches. The revectorization runs in the background, use GET /schema/revectorizations/{id} to retrieve its progress.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Recompute the vectors of the Things of a class.",
+        "operationId": "schema.things.revectorize",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "optional where filter to limit the revectorized things",
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/Revectorization"
+            }
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Successfully started the revectorization.",
+            "schema": {
+              "$ref": "#/definitions/Revectorization"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -5963,6 +9609,18 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "409": {
+            "description": "A revectorization of the class is already running",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid revectorization, e.g. the class does not exist or has no vectorizer",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -5991,6 +9649,25 @@ func init() {
             "name": "limit",
             "in": "query"
           },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The number of items to skip. Cannot be combined with 'after'.",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only return items after this cursor, such as the continuationToken of a previous page. Items are ordered by their id. Cannot be combined with 'offset'.",
+            "name": "after",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Comma-separated list of properties to sort the items by, each optionally followed by ':asc' or ':desc', such as 'price:desc,_created'. Primitive properties other than text, geoCoordinates and phoneNumber can be used, as well as '_created' and '_updated'. The order defaults to ascending. Items without a value come last. Cannot be combined with 'after'.",
+            "name": "sort",
+            "in": "query"
+          },
           {
             "type": "boolean",
             "description": "Should additional meta information (e.g. about classified properties) be included? Defaults to false.",
@@ -6017,6 +9694,12 @@ func init() {
           "404": {
             "description": "Successful query result but no resource was found."
           },
+          "422": {
+            "description": "Invalid paging parameters.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -6083,6 +9766,59 @@ func init() {
         ]
       }
     },
+    "/things/export": {
+      "get": {
+        "description": "Streams all Things of a class as newline-delimited JSON, one Thing per line. Each line contains the schema, the timestamps, the vector weights and the stored vector of the Thing, so that the export can be imported again as-is through the batch endpoint.",
+        "produces": [
+          "application/x-ndjson"
+        ],
+        "tags": [
+          "things"
+        ],
+        "summary": "Export all Things of a class.",
+        "operationId": "things.export",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the class to export.",
+            "name": "class",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response, the body contains one Thing per line."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "There is no Thing class with this name.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
+      }
+    },
     "/things/validate": {
       "post": {
         "description": "Validate a Thing's schema and meta-data. It has to be based on a schema, which is related to the given Thing to be accepted by this validation.",
@@ -6286,6 +10022,12 @@ func init() {
           "404": {
             "description": "Successful query result but no resource was found."
           },
+          "409": {
+            "description": "The object is still referenced through a reference property with the onDelete policy 'restrict'.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -6587,56 +10329,331 @@ func init() {
         "schema": {
           "$ref": "#/definitions/PropertySchema"
         },
-        "vectorWeights": {
-          "$ref": "#/definitions/VectorWeights"
+        "vector": {
+          "$ref": "#/definitions/Vector"
+        },
+        "vectorWeights": {
+          "$ref": "#/definitions/VectorWeights"
+        }
+      }
+    },
+    "ActionsGetResponse": {
+      "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/Action"
+        },
+        {
+          "properties": {
+            "result": {
+              "description": "Results for this specific Action.",
+              "format": "object",
+              "properties": {
+                "errors": {
+                  "$ref": "#/definitions/ErrorResponse"
+                },
+                "status": {
+                  "type": "string",
+                  "default": "SUCCESS",
+                  "enum": [
+                    "SUCCESS",
+                    "PENDING",
+                    "FAILED"
+                  ]
+                }
+              }
+            }
+          }
+        }
+      ]
+    },
+    "ActionsListResponse": {
+      "description": "List of Actions.",
+      "type": "object",
+      "properties": {
+        "actions": {
+          "description": "The actual list of Actions.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Action"
+          }
+        },
+        "continuationToken": {
+          "description": "Set if more Actions might be available. Pass it as the 'after' parameter to retrieve the next page.",
+          "type": "string"
+        },
+        "totalResults": {
+          "description": "The number of Actions in this page of the list, which only contains Actions of classes the principal may list.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "Backup": {
+      "description": "A backup of the schema, all objects with their vectors and the classification records, written to the backup directory of weaviate.",
+      "type": "object",
+      "properties": {
+        "classes": {
+          "description": "The classes which are part of this backup.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "readOnly": true
+        },
+        "error": {
+          "description": "error message if status == failed",
+          "type": "string",
+          "default": "",
+          "readOnly": true,
+          "example": "write objects: disk full"
+        },
+        "exclude": {
+          "description": "Back up all classes but these. Cannot be combined with include.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "Article"
+          ]
+        },
+        "id": {
+          "description": "ID to uniquely identify this backup, it is used as the name of the backup's directory. Generated if not set.",
+          "type": "string",
+          "pattern": "^[a-zA-Z0-9_-]+$",
+          "example": "nightly-2019-11-20"
+        },
+        "include": {
+          "description": "Only back up these classes. All classes are backed up if neither include nor exclude are set.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "City",
+            "Country"
+          ]
+        },
+        "meta": {
+          "description": "progress of the backup",
+          "type": "object",
+          "$ref": "#/definitions/BackupMeta",
+          "readOnly": true
+        },
+        "status": {
+          "description": "status of this backup",
+          "type": "string",
+          "enum": [
+            "running",
+            "completed",
+            "failed"
+          ],
+          "readOnly": true,
+          "example": "running"
+        },
+        "version": {
+          "description": "Version of the archive format of this backup.",
+          "type": "integer",
+          "readOnly": true,
+          "example": 1
+        }
+      }
+    },
+    "BackupMeta": {
+      "description": "Progress of a backup or restore",
+      "type": "object",
+      "properties": {
+        "completed": {
+          "description": "time when the backup or restore finished",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        },
+        "count": {
+          "description": "number of objects written so far",
+          "type": "integer",
+          "example": 147
+        },
+        "started": {
+          "description": "time when the backup or restore was started",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        }
+      }
+    },
+    "BackupRestore": {
+      "description": "Restore of a backup into this instance. The restored classes must not be present in the schema yet.",
+      "type": "object",
+      "properties": {
+        "backupId": {
+          "description": "ID of the backup which is restored",
+          "type": "string",
+          "readOnly": true,
+          "example": "nightly-2019-11-20"
+        },
+        "classes": {
+          "description": "The classes which are restored.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "readOnly": true
+        },
+        "error": {
+          "description": "error message if status == failed",
+          "type": "string",
+          "default": "",
+          "readOnly": true,
+          "example": "restore class 'City': class already exists"
+        },
+        "exclude": {
+          "description": "Restore all classes of the backup but these. Cannot be combined with include.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "Article"
+          ]
+        },
+        "include": {
+          "description": "Only restore these classes of the backup. All classes of the backup are restored if neither include nor exclude are set.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "City",
+            "Country"
+          ]
+        },
+        "meta": {
+          "description": "progress of the restore",
+          "type": "object",
+          "$ref": "#/definitions/BackupMeta",
+          "readOnly": true
+        },
+        "status": {
+          "description": "status of this restore",
+          "type": "string",
+          "enum": [
+            "running",
+            "completed",
+            "failed"
+          ],
+          "readOnly": true,
+          "example": "running"
+        }
+      }
+    },
+    "BatchDelete": {
+      "description": "Delete all objects of a class matching a where filter in a single request",
+      "type": "object",
+      "required": [
+        "class",
+        "where"
+      ],
+      "properties": {
+        "class": {
+          "description": "class of the objects to be deleted",
+          "type": "string",
+          "example": "City"
+        },
+        "deleteReferences": {
+          "description": "Deprecated, has no effect. The references to the deleted objects are always handled according to the onDelete policy of their property, like for single deletes.",
+          "type": "boolean",
+          "default": false
+        },
+        "dryRun": {
+          "description": "only count and, in verbose mode, list the matching objects without deleting them",
+          "type": "boolean",
+          "default": false
+        },
+        "output": {
+          "description": "minimal only lists the objects which could not be deleted, verbose lists all matching objects",
+          "type": "string",
+          "default": "minimal",
+          "enum": [
+            "minimal",
+            "verbose"
+          ]
+        },
+        "where": {
+          "description": "filter the objects to be deleted, it is required so that a class can't be emptied by accident",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
         }
       }
     },
-    "ActionsGetResponse": {
+    "BatchDeleteResponse": {
+      "description": "Outcome of a batch delete",
       "type": "object",
-      "allOf": [
-        {
-          "$ref": "#/definitions/Action"
+      "properties": {
+        "class": {
+          "description": "class of the deleted objects",
+          "type": "string",
+          "example": "City"
         },
-        {
-          "properties": {
-            "result": {
-              "description": "Results for this specific Action.",
-              "format": "object",
-              "properties": {
-                "errors": {
-                  "$ref": "#/definitions/ErrorResponse"
-                },
-                "status": {
-                  "type": "string",
-                  "default": "SUCCESS",
-                  "enum": [
-                    "SUCCESS",
-                    "PENDING",
-                    "FAILED"
-                  ]
-                }
-              }
-            }
+        "dryRun": {
+          "description": "whether the objects were only counted, but not deleted",
+          "type": "boolean"
+        },
+        "failed": {
+          "description": "number of objects which could not be deleted",
+          "type": "integer",
+          "example": 0
+        },
+        "matches": {
+          "description": "number of objects matching the filter",
+          "type": "integer",
+          "example": 147
+        },
+        "objects": {
+          "description": "the objects which could not be deleted or, in verbose mode, all matching objects, but at most 1000 objects",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BatchDeleteResult"
           }
+        },
+        "referencesFailed": {
+          "description": "number of referencing objects which could not be updated, they still reference deleted objects",
+          "type": "integer",
+          "example": 0
+        },
+        "referencesRemoved": {
+          "description": "number of references to the deleted objects which were removed according to the onDelete policy of their property",
+          "type": "integer",
+          "example": 12
+        },
+        "successful": {
+          "description": "number of objects which were deleted",
+          "type": "integer",
+          "example": 147
         }
-      ]
+      }
     },
-    "ActionsListResponse": {
-      "description": "List of Actions.",
+    "BatchDeleteResult": {
+      "description": "Outcome of a single object of a batch delete",
       "type": "object",
       "properties": {
-        "actions": {
-          "description": "The actual list of Actions.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Action"
-          }
+        "errors": {
+          "$ref": "#/definitions/ErrorResponse"
         },
-        "totalResults": {
-          "description": "The total number of Actions for the query. The number of items in a response may be smaller due to paging.",
-          "type": "integer",
-          "format": "int64"
+        "id": {
+          "description": "ID of the object",
+          "type": "string",
+          "format": "uuid"
+        },
+        "status": {
+          "type": "string",
+          "default": "SUCCESS",
+          "enum": [
+            "SUCCESS",
+            "DRYRUN",
+            "FAILED"
+          ]
         }
       }
     },
@@ -6845,10 +10862,22 @@ func init() {
             "$ref": "#/definitions/Property"
           }
         },
+        "vectorIndexConfig": {
+          "$ref": "#/definitions/VectorIndexConfig"
+        },
         "vectorizeClassName": {
           "description": "Set this to true if the object vector should include the class name in calculating the overall vector position",
           "type": "boolean",
           "x-nullable": true
+        },
+        "vectorizer": {
+          "description": "Specify how the vectors for this class should be determined. The default 'contextionary' computes them from the class and property names and values. With 'text2vec-http' the same corpus is posted to the HTTP vectorizer endpoint configured in weaviate, which answers with the vector. With 'none' no vectors are computed, the contextionary is not required for this class and vectors can only be provided at import.",
+          "type": "string",
+          "enum": [
+            "contextionary",
+            "text2vec-http",
+            "none"
+          ]
         }
       }
     },
@@ -6881,18 +10910,51 @@ func init() {
             "inCountry"
           ]
         },
+        "dryRun": {
+          "description": "If true, the references are computed, but not stored. The computed references are listed in predictions instead.",
+          "type": "boolean",
+          "default": false
+        },
         "error": {
           "description": "error message if status == failed",
           "type": "string",
           "default": "",
           "example": "classify xzy: something went wrong"
         },
+        "evaluate": {
+          "description": "Only for type 'knn'. If true, no objects are classified. Instead the classification is evaluated with a k-fold cross-validation over the training set, the results are listed in evaluation.",
+          "type": "boolean",
+          "default": false
+        },
+        "evaluation": {
+          "description": "The results of an evaluation",
+          "$ref": "#/definitions/ClassificationEvaluation",
+          "readOnly": true
+        },
+        "evaluationFolds": {
+          "description": "Only if evaluate is set. The number of folds the training set is split into, each fold is classified based on all other folds.",
+          "type": "integer",
+          "default": 5,
+          "example": 5
+        },
         "id": {
           "description": "ID to uniquely identify this classification run",
           "type": "string",
           "format": "uuid",
           "example": "ee722219-b8ec-4db1-8f8d-5150bb1a9e0c"
         },
+        "informationGainCutoffPercentile": {
+          "description": "Only for type 'contextual'. Words with an information gain below this percentile of the object's words are ignored. The information gain measures how well a word tells the possible targets apart.",
+          "type": "integer",
+          "default": 50,
+          "example": 50
+        },
+        "informationGainMaximumBoost": {
+          "description": "Only for type 'contextual'. The word with the highest information gain is weighted this many times as much as a word without any information gain.",
+          "type": "integer",
+          "default": 3,
+          "example": 3
+        },
         "k": {
           "description": "k-value when using k-Neareast-Neighbor",
           "type": "integer",
@@ -6905,18 +10967,41 @@ func init() {
           "type": "object",
           "$ref": "#/definitions/ClassificationMeta"
         },
+        "minimumCertainty": {
+          "description": "Only for type 'contextual'. A property is left unclassified if the certainty of the closest target is below this value. Certainty ranges from 0 to 1.",
+          "type": "number",
+          "format": "float64",
+          "default": 0,
+          "example": 0.6
+        },
+        "minimumUsableWords": {
+          "description": "Only for type 'contextual'. The cut-offs never leave fewer words than this, if the text contains as many known words.",
+          "type": "integer",
+          "default": 3,
+          "example": 3
+        },
+        "predictions": {
+          "description": "The references computed by a dry run. At most the configured maximum number of predictions (1000 by default) is listed, the counts in meta include all objects.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ClassificationPrediction"
+          },
+          "readOnly": true
+        },
         "sourceWhere": {
           "description": "limit the objects to be classified",
           "type": "object",
           "$ref": "#/definitions/WhereFilter"
         },
         "status": {
-          "description": "status of this classification",
+          "description": "status of this classification. A classification is interrupted if weaviate was restarted while it was running.",
           "type": "string",
           "enum": [
             "running",
             "completed",
-            "failed"
+            "failed",
+            "cancelled",
+            "interrupted"
           ],
           "example": "running"
         },
@@ -6925,6 +11010,19 @@ func init() {
           "type": "object",
           "$ref": "#/definitions/WhereFilter"
         },
+        "tfidfCutoffPercentile": {
+          "description": "Only for type 'contextual'. Words of an object with a TF-IDF score below this percentile of the object's words are ignored.",
+          "type": "integer",
+          "default": 80,
+          "example": 80
+        },
+        "timeout": {
+          "description": "maximum duration of the classification in seconds, it fails once the timeout is exceeded",
+          "type": "integer",
+          "format": "int64",
+          "default": 30,
+          "example": 300
+        },
         "trainingSetWhere": {
           "description": "Limit the training objects to be considered during the classification. Can only be used on types with explicit training sets, such as 'knn'",
           "type": "object",
@@ -6942,6 +11040,97 @@ func init() {
         }
       }
     },
+    "ClassificationConfusionMatrixEntry": {
+      "description": "The number of objects of a property with the actual target which were predicted as the predicted target",
+      "type": "object",
+      "properties": {
+        "actual": {
+          "description": "The beacon of the actual target",
+          "type": "string",
+          "format": "uri"
+        },
+        "count": {
+          "description": "The number of objects",
+          "type": "integer"
+        },
+        "predicted": {
+          "description": "The beacon of the predicted target",
+          "type": "string",
+          "format": "uri"
+        },
+        "property": {
+          "description": "The classified property",
+          "type": "string",
+          "example": "inCountry"
+        }
+      }
+    },
+    "ClassificationEvaluation": {
+      "description": "The result of a k-fold cross-validation of a classification over its training set",
+      "type": "object",
+      "properties": {
+        "accuracy": {
+          "description": "The share of all predicted references which match the actual reference",
+          "type": "number",
+          "format": "float32",
+          "example": 0.85
+        },
+        "confusionMatrix": {
+          "description": "How often each actual target was predicted as each target, entries which never occurred are omitted",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ClassificationConfusionMatrixEntry"
+          }
+        },
+        "count": {
+          "description": "The number of objects of the training set",
+          "type": "integer",
+          "example": 200
+        },
+        "folds": {
+          "description": "The number of folds the training set was split into",
+          "type": "integer",
+          "example": 5
+        },
+        "labels": {
+          "description": "Precision and recall per property and target",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ClassificationEvaluationLabel"
+          }
+        }
+      }
+    },
+    "ClassificationEvaluationLabel": {
+      "description": "The precision and recall of a single target of a classified property",
+      "type": "object",
+      "properties": {
+        "beacon": {
+          "description": "The beacon of the target",
+          "type": "string",
+          "format": "uri"
+        },
+        "precision": {
+          "description": "The share of the predictions of this target which were correct",
+          "type": "number",
+          "format": "float32"
+        },
+        "property": {
+          "description": "The classified property",
+          "type": "string",
+          "example": "inCountry"
+        },
+        "recall": {
+          "description": "The share of the objects with this target which were predicted correctly",
+          "type": "number",
+          "format": "float32"
+        },
+        "support": {
+          "description": "The number of objects which actually reference this target",
+          "type": "integer"
+        }
+      }
+    },
     "ClassificationMeta": {
       "description": "Additional information to a specific classification",
       "type": "object",
@@ -6967,11 +11156,158 @@ func init() {
           "type": "integer",
           "example": 140
         },
+        "countUnclassified": {
+          "description": "number of objects which were left unclassified, as no target reached the minimum certainty",
+          "type": "integer",
+          "example": 3
+        },
+        "estimatedCompletion": {
+          "description": "estimated time when this classification will finish, based on the progress so far",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:35:12Z"
+        },
+        "processed": {
+          "description": "number of objects which have been processed so far",
+          "type": "integer",
+          "example": 147
+        },
         "started": {
           "description": "time when this classification was started",
           "type": "string",
           "format": "date-time",
           "example": "2017-07-21T17:32:28Z"
+        },
+        "total": {
+          "description": "number of objects to be classified, known once the classification has started processing",
+          "type": "integer",
+          "example": 200
+        }
+      }
+    },
+    "ClassificationPrediction": {
+      "description": "A reference which would have been set by a classification",
+      "type": "object",
+      "properties": {
+        "beacon": {
+          "description": "The beacon of the reference, not set for primitive properties",
+          "type": "string",
+          "format": "uri"
+        },
+        "id": {
+          "description": "ID of the object which would have been classified",
+          "type": "string",
+          "format": "uuid"
+        },
+        "property": {
+          "description": "The classified property",
+          "type": "string",
+          "example": "inCountry"
+        },
+        "value": {
+          "description": "The value of a classified primitive property"
+        },
+        "winningDistance": {
+          "description": "The distance of the winning reference, see the reference meta of the respective classification type",
+          "type": "number",
+          "format": "float32"
+        }
+      }
+    },
+    "ClassificationsListResponse": {
+      "description": "List of classifications.",
+      "type": "object",
+      "properties": {
+        "classifications": {
+          "description": "The actual list of classifications.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Classification"
+          }
+        },
+        "totalResults": {
+          "description": "The total number of classifications in the list.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "DanglingReference": {
+      "description": "A reference to an object which doesn't exist",
+      "type": "object",
+      "properties": {
+        "beacon": {
+          "description": "the dangling reference",
+          "type": "string",
+          "format": "uri"
+        },
+        "class": {
+          "description": "class of the referencing object",
+          "type": "string"
+        },
+        "id": {
+          "description": "id of the referencing object",
+          "type": "string",
+          "format": "uuid"
+        },
+        "kind": {
+          "description": "kind of the referencing object",
+          "type": "string",
+          "enum": [
+            "thing",
+            "action"
+          ]
+        },
+        "property": {
+          "description": "reference property which contains the reference",
+          "type": "string"
+        }
+      }
+    },
+    "DanglingReferencesCheck": {
+      "description": "Scan the objects for references to objects which don't exist (anymore) and optionally remove them.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "only scan the objects of this class, all classes with reference properties are scanned if not set",
+          "type": "string",
+          "example": "City"
+        },
+        "repair": {
+          "description": "remove the dangling references from the objects instead of only reporting them",
+          "type": "boolean",
+          "default": false
+        }
+      }
+    },
+    "DanglingReferencesReport": {
+      "description": "The dangling references which were found and, if repaired, how many objects were updated.",
+      "type": "object",
+      "properties": {
+        "danglingReferences": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/DanglingReference"
+          }
+        },
+        "objectsFailed": {
+          "description": "number of objects which could not be stored without their dangling references",
+          "type": "integer",
+          "format": "int64"
+        },
+        "objectsRepaired": {
+          "description": "number of objects which were stored without their dangling references",
+          "type": "integer",
+          "format": "int64"
+        },
+        "objectsScanned": {
+          "description": "number of objects which were scanned",
+          "type": "integer",
+          "format": "int64"
+        },
+        "repair": {
+          "description": "whether the dangling references were removed",
+          "type": "boolean"
         }
       }
     },
@@ -7199,6 +11535,13 @@ func init() {
           "type": "string",
           "format": "uuid"
         },
+        "properties": {
+          "description": "How confident the classification of each classified field is, only set for type \"knn\". References carry their distances in their own meta as well, primitive fields can only be described here.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ObjectMetaClassificationProperty"
+          }
+        },
         "scope": {
           "description": "The properties in scope of the classification. Note that this doesn't mean that these fields were necessarily classified, this only means that those fields were in scope of the classificiation. See \"classifiedFields\" for details.",
           "type": "array",
@@ -7208,6 +11551,31 @@ func init() {
         }
       }
     },
+    "ObjectMetaClassificationProperty": {
+      "description": "The confidence of the classification of a single field",
+      "properties": {
+        "confidence": {
+          "description": "The share of the neighbors which voted for the winning value or reference",
+          "type": "number",
+          "format": "float32"
+        },
+        "losingDistance": {
+          "description": "Mean distance of all neighbors from the losing group. Optional. If k equals the size of the winning group, there is no losing group.",
+          "type": "number",
+          "format": "float32",
+          "x-nullable": true
+        },
+        "name": {
+          "description": "The name of the classified field",
+          "type": "string"
+        },
+        "winningDistance": {
+          "description": "Mean distance of all neighbors from the winning group",
+          "type": "number",
+          "format": "float32"
+        }
+      }
+    },
     "PatchDocumentAction": {
       "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
       "required": [
@@ -7394,6 +11762,15 @@ func init() {
           "description": "Name of the property as URI relative to the schema URL.",
           "type": "string"
         },
+        "onDelete": {
+          "description": "Only for reference properties. What happens to the references in this property when a referenced object is deleted: 'restrict' prevents the deletion of the referenced object, 'cascade' deletes the referencing object as well and 'setNull' removes the reference. Not set is the same as setNull.",
+          "type": "string",
+          "enum": [
+            "restrict",
+            "cascade",
+            "setNull"
+          ]
+        },
         "vectorizePropertyName": {
           "description": "Set this to true if the object vector should include this property's name in calculating the overall vector position. If set to false (default), only the property value will be used.",
           "type": "boolean"
@@ -7416,6 +11793,13 @@ func init() {
     "ReferenceMetaClassification": {
       "description": "This meta field contains additional info about the classified reference property",
       "properties": {
+        "basedOnWords": {
+          "description": "The words of the basedOnProperties which the classification was based on, only set for type 'contextual'.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ReferenceMetaClassificationWord"
+          }
+        },
         "losingDistance": {
           "description": "Mean distance of all neighbors from the losing group. Optional. If k equals the size of the winning group, there is no losing group.",
           "type": "number",
@@ -7429,6 +11813,183 @@ func init() {
         }
       }
     },
+    "ReferenceMetaClassificationWord": {
+      "description": "A word which a contextual classification was based on",
+      "properties": {
+        "weight": {
+          "description": "The weight of the word's vector in the vector which was used for the classification, based on its TF-IDF score and its information gain",
+          "type": "number",
+          "format": "float32"
+        },
+        "word": {
+          "description": "The word as it occurred in the text, lowercased",
+          "type": "string"
+        }
+      }
+    },
+    "Revectorization": {
+      "description": "A background job which recomputes the vectors of the objects of a class with the current vectorizer settings of the class, e.g. after vectorizeClassName, vectorizePropertyName or the indexing of a property was changed or the contextionary was extended.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "name of the revectorized class",
+          "type": "string",
+          "readOnly": true,
+          "example": "City"
+        },
+        "error": {
+          "description": "error message if status == failed",
+          "type": "string",
+          "default": "",
+          "readOnly": true,
+          "example": "store vectors: timeout"
+        },
+        "id": {
+          "description": "ID to uniquely identify this revectorization",
+          "type": "string",
+          "format": "uuid",
+          "readOnly": true,
+          "example": "ee722219-b8ec-4db1-8f8d-5150bb1a9e0c"
+        },
+        "kind": {
+          "description": "kind of the revectorized class",
+          "type": "string",
+          "enum": [
+            "thing",
+            "action"
+          ],
+          "readOnly": true,
+          "example": "thing"
+        },
+        "meta": {
+          "description": "progress of the revectorization",
+          "type": "object",
+          "$ref": "#/definitions/RevectorizationMeta",
+          "readOnly": true
+        },
+        "status": {
+          "description": "status of this revectorization",
+          "type": "string",
+          "enum": [
+            "running",
+            "completed",
+            "failed",
+            "cancelled"
+          ],
+          "readOnly": true,
+          "example": "running"
+        },
+        "where": {
+          "description": "limit the objects to be revectorized using a filter, all objects of the class are revectorized if not set",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
+        }
+      }
+    },
+    "RevectorizationMeta": {
+      "description": "Progress of a revectorization",
+      "type": "object",
+      "properties": {
+        "completed": {
+          "description": "time when the revectorization finished",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        },
+        "count": {
+          "description": "number of objects processed so far",
+          "type": "integer",
+          "example": 147
+        },
+        "countFailed": {
+          "description": "number of objects which could not be vectorized or stored, they keep their previous vector",
+          "type": "integer",
+          "example": 7
+        },
+        "countSucceeded": {
+          "description": "number of objects which were stored with a new vector",
+          "type": "integer",
+          "example": 140
+        },
+        "started": {
+          "description": "time when the revectorization was started",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        }
+      }
+    },
+    "Role": {
+      "description": "A role grants verbs on resources to the users and groups bound to it. Roles are only evaluated if rbac authorization is enabled.",
+      "type": "object",
+      "properties": {
+        "groups": {
+          "description": "groups (as set in the groups claim of the OIDC token) the role is bound to",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "data-team"
+          ]
+        },
+        "name": {
+          "description": "unique name of the role, may only contain letters, numbers, dashes and underscores",
+          "type": "string",
+          "example": "importer"
+        },
+        "permissions": {
+          "description": "the permissions granted by this role",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/RolePermission"
+          }
+        },
+        "static": {
+          "description": "roles defined in the configuration file are static and can't be changed through the api",
+          "type": "boolean",
+          "readOnly": true
+        },
+        "users": {
+          "description": "usernames the role is bound to, unauthenticated requests use the username 'anonymous'",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "alice"
+          ]
+        }
+      }
+    },
+    "RolePermission": {
+      "description": "Grants all of the verbs on all of the resources. A '*' matches any verb. Resources are patterns, a '*' matches a single segment of a resource, a trailing '/*' matches the resource itself and everything below it.",
+      "type": "object",
+      "properties": {
+        "resources": {
+          "description": "resource patterns such as things/*, schema/things, traversal/* or classifications/*",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "things/*",
+            "batch/*"
+          ]
+        },
+        "verbs": {
+          "description": "verbs such as get, list, create, update, delete or validate",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "create",
+            "update"
+          ]
+        }
+      }
+    },
     "Schema": {
       "description": "Definitions of semantic schemas (also see: https://github.com/semi-technologies/weaviate-semantic-schemas).",
       "type": "object",
@@ -7463,6 +12024,117 @@ func init() {
       "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
       "type": "object"
     },
+    "SchemaReindex": {
+      "description": "A background job which copies all objects of a class into a new index of the vector index, so that a class or property can be renamed or a property can be dropped while the class stays available for reads and writes.",
+      "type": "object",
+      "properties": {
+        "className": {
+          "description": "name of the reindexed class after the schema change",
+          "type": "string",
+          "readOnly": true,
+          "example": "City"
+        },
+        "error": {
+          "description": "error message if status == failed",
+          "type": "string",
+          "default": "",
+          "readOnly": true,
+          "example": "copy objects: bulk request: timeout"
+        },
+        "from": {
+          "description": "previous name of the renamed class or property or the name of the dropped property",
+          "type": "string",
+          "readOnly": true,
+          "example": "populationSize"
+        },
+        "id": {
+          "description": "ID to uniquely identify this reindex job",
+          "type": "string",
+          "format": "uuid",
+          "readOnly": true,
+          "example": "ee722219-b8ec-4db1-8f8d-5150bb1a9e0c"
+        },
+        "kind": {
+          "description": "kind of the reindexed class",
+          "type": "string",
+          "enum": [
+            "thing",
+            "action"
+          ],
+          "readOnly": true,
+          "example": "thing"
+        },
+        "meta": {
+          "description": "progress of the reindex job",
+          "type": "object",
+          "$ref": "#/definitions/SchemaReindexMeta",
+          "readOnly": true
+        },
+        "operation": {
+          "description": "the schema change which required the reindex",
+          "type": "string",
+          "enum": [
+            "renameClass",
+            "renameProperty",
+            "dropProperty"
+          ],
+          "readOnly": true,
+          "example": "renameProperty"
+        },
+        "sourceIndex": {
+          "description": "index the objects are copied from",
+          "type": "string",
+          "readOnly": true,
+          "example": "class_thing_city"
+        },
+        "status": {
+          "description": "status of this reindex job",
+          "type": "string",
+          "enum": [
+            "running",
+            "completed",
+            "failed"
+          ],
+          "readOnly": true,
+          "example": "running"
+        },
+        "targetIndex": {
+          "description": "index the objects are copied to, it replaces the source index once all objects are copied",
+          "type": "string",
+          "readOnly": true,
+          "example": "v2_class_thing_city"
+        },
+        "to": {
+          "description": "new name of the renamed class or property, empty when a property is dropped",
+          "type": "string",
+          "readOnly": true,
+          "example": "population"
+        }
+      }
+    },
+    "SchemaReindexMeta": {
+      "description": "Progress of a reindex job",
+      "type": "object",
+      "properties": {
+        "completed": {
+          "description": "time when the reindex job finished",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        },
+        "count": {
+          "description": "number of objects copied so far, objects which were written to during the reindex are copied again and counted again",
+          "type": "integer",
+          "example": 147
+        },
+        "started": {
+          "description": "time when the reindex job was started",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        }
+      }
+    },
     "SingleRef": {
       "description": "Either set beacon (direct reference) or set class and schema (concept reference)",
       "properties": {
@@ -7519,6 +12191,9 @@ func init() {
         "schema": {
           "$ref": "#/definitions/PropertySchema"
         },
+        "vector": {
+          "$ref": "#/definitions/Vector"
+        },
         "vectorWeights": {
           "$ref": "#/definitions/VectorWeights"
         }
@@ -7558,6 +12233,10 @@ func init() {
       "description": "List of Things.",
       "type": "object",
       "properties": {
+        "continuationToken": {
+          "description": "Set if more Things might be available. Pass it as the 'after' parameter to retrieve the next page.",
+          "type": "string"
+        },
         "things": {
           "description": "The actual list of Things.",
           "type": "array",
@@ -7566,7 +12245,36 @@ func init() {
           }
         },
         "totalResults": {
-          "description": "The total number of Things for the query. The number of items in a response may be smaller due to paging.",
+          "description": "The number of Things in this page of the list, which only contains Things of classes the principal may list.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "Vector": {
+      "description": "A vector representation of the object. If provided at import, it is used as-is instead of a vector computed by the vectorizer of the class.",
+      "type": "array",
+      "items": {
+        "type": "number",
+        "format": "float"
+      }
+    },
+    "VectorIndexConfig": {
+      "description": "Tunes the approximate nearest neighbor (HNSW) index of a class. Unset values fall back to the defaults. The config cannot be changed once the class has been created.",
+      "type": "object",
+      "properties": {
+        "ef": {
+          "description": "Size of the dynamic candidate list when searching. Higher values lead to a better recall at the cost of slower searches.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "efConstruction": {
+          "description": "Size of the dynamic candidate list when inserting new objects. Higher values lead to a better index quality at the cost of slower imports.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "maxConnections": {
+          "description": "Maximum number of connections per object and layer in the index graph. The lowest layer allows for twice as many connections.",
           "type": "integer",
           "format": "int64"
         }
@@ -7683,6 +12391,12 @@ func init() {
     }
   },
   "parameters": {
+    "CommonAfterParameterQuery": {
+      "type": "string",
+      "description": "Only return items after this cursor, such as the continuationToken of a previous page. Items are ordered by their id. Cannot be combined with 'offset'.",
+      "name": "after",
+      "in": "query"
+    },
     "CommonLimitParameterQuery": {
       "type": "integer",
       "format": "int64",
@@ -7695,6 +12409,19 @@ func init() {
       "description": "Should additional meta information (e.g. about classified properties) be included? Defaults to false.",
       "name": "meta",
       "in": "query"
+    },
+    "CommonOffsetParameterQuery": {
+      "type": "integer",
+      "format": "int64",
+      "description": "The number of items to skip. Cannot be combined with 'after'.",
+      "name": "offset",
+      "in": "query"
+    },
+    "CommonSortParameterQuery": {
+      "type": "string",
+      "description": "Comma-separated list of properties to sort the items by, each optionally followed by ':asc' or ':desc', such as 'price:desc,_created'. Primitive properties other than text, geoCoordinates and phoneNumber can be used, as well as '_created' and '_updated'. The order defaults to ascending. Items without a value come last. Cannot be combined with 'after'.",
+      "name": "sort",
+      "in": "query"
     }
   },
   "securityDefinitions": {
@@ -7703,12 +12430,21 @@ func init() {
       "type": "oauth2",
       "flow": "implicit",
       "authorizationUrl": "http://to-be-configured-in-the-application-config"
+    },
+    "xApiKey": {
+      "description": "Static api key, can alternatively be sent as a bearer token",
+      "type": "apiKey",
+      "name": "X-Api-Key",
+      "in": "header"
     }
   },
   "security": [
     {},
     {
       "oidc": []
+    },
+    {
+      "xApiKey": []
     }
   ],
   "tags": [
@@ -7738,6 +12474,18 @@ func init() {
     {
       "description": "These operations enable manipulation of the schema in Weaviate schema.",
       "name": "schema"
+    },
+    {
+      "description": "These operations back up the schema, objects and vectors to the filesystem and restore them.",
+      "name": "backups"
+    },
+    {
+      "description": "These operations manage the roles used by the rbac authorization.",
+      "name": "roles"
+    },
+    {
+      "description": "These operations find and repair inconsistencies in the stored objects.",
+      "name": "maintenance"
     }
   ],
   "externalDocs": {
@@ -0,0 +1,106 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package rest
+
+import (
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/backups"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization/errors"
+	"github.com/semi-technologies/weaviate/usecases/backup"
+	"github.com/semi-technologies/weaviate/usecases/telemetry"
+)
+
+func setupBackupHandlers(api *operations.WeaviateAPI,
+	requestsLog *telemetry.RequestsLog, manager *backup.Manager) {
+
+	api.BackupsBackupsCreateHandler = backups.BackupsCreateHandlerFunc(
+		func(params backups.BackupsCreateParams, principal *models.Principal) middleware.Responder {
+			res, err := manager.Backup(params.HTTPRequest.Context(), principal, *params.Body)
+			if err != nil {
+				switch err.(type) {
+				case errors.Forbidden:
+					return backups.NewBackupsCreateForbidden().WithPayload(errPayloadFromSingleErr(err))
+				case backup.ErrInvalidUserInput:
+					return backups.NewBackupsCreateUnprocessableEntity().WithPayload(errPayloadFromSingleErr(err))
+				default:
+					return backups.NewBackupsCreateInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+				}
+			}
+
+			return backups.NewBackupsCreateCreated().WithPayload(res)
+		},
+	)
+
+	api.BackupsBackupsGetHandler = backups.BackupsGetHandlerFunc(
+		func(params backups.BackupsGetParams, principal *models.Principal) middleware.Responder {
+			res, err := manager.Get(params.HTTPRequest.Context(), principal, params.ID)
+			if err != nil {
+				switch err.(type) {
+				case errors.Forbidden:
+					return backups.NewBackupsGetForbidden().WithPayload(errPayloadFromSingleErr(err))
+				default:
+					return backups.NewBackupsGetInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+				}
+			}
+
+			if res == nil {
+				return backups.NewBackupsGetNotFound()
+			}
+
+			return backups.NewBackupsGetOK().WithPayload(res)
+		},
+	)
+
+	api.BackupsBackupsRestoreHandler = backups.BackupsRestoreHandlerFunc(
+		func(params backups.BackupsRestoreParams, principal *models.Principal) middleware.Responder {
+			res, err := manager.Restore(params.HTTPRequest.Context(), principal, params.ID, *params.Body)
+			if err != nil {
+				switch err.(type) {
+				case errors.Forbidden:
+					return backups.NewBackupsRestoreForbidden().WithPayload(errPayloadFromSingleErr(err))
+				case backup.ErrNotFound:
+					return backups.NewBackupsRestoreNotFound()
+				case backup.ErrInvalidUserInput:
+					return backups.NewBackupsRestoreUnprocessableEntity().WithPayload(errPayloadFromSingleErr(err))
+				default:
+					return backups.NewBackupsRestoreInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+				}
+			}
+
+			return backups.NewBackupsRestoreCreated().WithPayload(res)
+		},
+	)
+
+	api.BackupsBackupsRestoreGetHandler = backups.BackupsRestoreGetHandlerFunc(
+		func(params backups.BackupsRestoreGetParams, principal *models.Principal) middleware.Responder {
+			res, err := manager.GetRestore(params.HTTPRequest.Context(), principal, params.ID)
+			if err != nil {
+				switch err.(type) {
+				case errors.Forbidden:
+					return backups.NewBackupsRestoreGetForbidden().WithPayload(errPayloadFromSingleErr(err))
+				default:
+					return backups.NewBackupsRestoreGetInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+				}
+			}
+
+			if res == nil {
+				return backups.NewBackupsRestoreGetNotFound()
+			}
+
+			return backups.NewBackupsRestoreGetOK().WithPayload(res)
+		},
+	)
+}
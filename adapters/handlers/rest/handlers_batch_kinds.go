@@ -170,6 +170,136 @@ func (h *batchKindHandlers) referencesResponse(input kinds.BatchReferences) []*m
 	return response
 }
 
+func (h *batchKindHandlers) mergeThings(params batching.BatchingThingsMergeParams,
+	principal *models.Principal) middleware.Responder {
+	things, err := h.manager.MergeThings(params.HTTPRequest.Context(), principal,
+		params.Body.Things)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return batching.NewBatchingThingsMergeForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrInvalidUserInput:
+			return batching.NewBatchingThingsMergeUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return batching.NewBatchingThingsMergeInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	for range params.Body.Things {
+		h.telemetryLogAsync(telemetry.TypeREST, telemetry.LocalManipulate)
+	}
+	return batching.NewBatchingThingsMergeOK().
+		WithPayload(h.thingsResponse(things))
+}
+
+func (h *batchKindHandlers) mergeActions(params batching.BatchingActionsMergeParams,
+	principal *models.Principal) middleware.Responder {
+	actions, err := h.manager.MergeActions(params.HTTPRequest.Context(), principal,
+		params.Body.Actions)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return batching.NewBatchingActionsMergeForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrInvalidUserInput:
+			return batching.NewBatchingActionsMergeUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return batching.NewBatchingActionsMergeInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	for range params.Body.Actions {
+		h.telemetryLogAsync(telemetry.TypeREST, telemetry.LocalManipulate)
+	}
+	return batching.NewBatchingActionsMergeOK().
+		WithPayload(h.actionsResponse(actions))
+}
+
+func (h *batchKindHandlers) deleteThings(params batching.BatchingThingsDeleteParams,
+	principal *models.Principal) middleware.Responder {
+	res, err := h.manager.DeleteThings(params.HTTPRequest.Context(), principal, params.Body)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return batching.NewBatchingThingsDeleteForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrInvalidUserInput:
+			return batching.NewBatchingThingsDeleteUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return batching.NewBatchingThingsDeleteInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.telemetryLogAsync(telemetry.TypeREST, telemetry.LocalManipulate)
+	return batching.NewBatchingThingsDeleteOK().
+		WithPayload(h.deleteResponse(res))
+}
+
+func (h *batchKindHandlers) deleteActions(params batching.BatchingActionsDeleteParams,
+	principal *models.Principal) middleware.Responder {
+	res, err := h.manager.DeleteActions(params.HTTPRequest.Context(), principal, params.Body)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return batching.NewBatchingActionsDeleteForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrInvalidUserInput:
+			return batching.NewBatchingActionsDeleteUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return batching.NewBatchingActionsDeleteInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.telemetryLogAsync(telemetry.TypeREST, telemetry.LocalManipulate)
+	return batching.NewBatchingActionsDeleteOK().
+		WithPayload(h.deleteResponse(res))
+}
+
+// deleteResponse lists the objects the use case kept in the result, which are
+// the objects which could not be deleted and, if the verbose output was
+// requested, the others
+func (h *batchKindHandlers) deleteResponse(input *kinds.BatchDeleteResult) *models.BatchDeleteResponse {
+	response := &models.BatchDeleteResponse{
+		Class:             input.ClassName,
+		DryRun:            input.DryRun,
+		Matches:           input.Matches,
+		Successful:        input.Successful,
+		Failed:            input.Failed,
+		ReferencesRemoved: input.ReferencesRemoved,
+		ReferencesFailed:  input.ReferencesFailed,
+		Objects:           make([]*models.BatchDeleteResult, len(input.Objects)),
+	}
+
+	for i, object := range input.Objects {
+		status := models.BatchDeleteResultStatusSUCCESS
+		var errorResponse *models.ErrorResponse
+		switch {
+		case object.Err != nil:
+			status = models.BatchDeleteResultStatusFAILED
+			errorResponse = errPayloadFromSingleErr(object.Err)
+		case input.DryRun:
+			status = models.BatchDeleteResultStatusDRYRUN
+		}
+
+		response.Objects[i] = &models.BatchDeleteResult{
+			ID:     object.UUID,
+			Status: &status,
+			Errors: errorResponse,
+		}
+	}
+
+	return response
+}
+
 func setupKindBatchHandlers(api *operations.WeaviateAPI, requestsLog *telemetry.RequestsLog, manager *kinds.BatchManager) {
 	h := &batchKindHandlers{manager, requestsLog}
 
@@ -179,6 +309,14 @@ func setupKindBatchHandlers(api *operations.WeaviateAPI, requestsLog *telemetry.
 		BatchingActionsCreateHandlerFunc(h.addActions)
 	api.BatchingBatchingReferencesCreateHandler = batching.
 		BatchingReferencesCreateHandlerFunc(h.addReferences)
+	api.BatchingBatchingThingsDeleteHandler = batching.
+		BatchingThingsDeleteHandlerFunc(h.deleteThings)
+	api.BatchingBatchingActionsDeleteHandler = batching.
+		BatchingActionsDeleteHandlerFunc(h.deleteActions)
+	api.BatchingBatchingThingsMergeHandler = batching.
+		BatchingThingsMergeHandlerFunc(h.mergeThings)
+	api.BatchingBatchingActionsMergeHandler = batching.
+		BatchingActionsMergeHandlerFunc(h.mergeActions)
 }
 
 func (h *batchKindHandlers) telemetryLogAsync(requestType, identifier string) {
@@ -19,6 +19,7 @@ import (
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/classifications"
 	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization/errors"
 	"github.com/semi-technologies/weaviate/usecases/classification"
 	"github.com/semi-technologies/weaviate/usecases/telemetry"
 )
@@ -42,6 +43,58 @@ func setupClassificationHandlers(api *operations.WeaviateAPI,
 		},
 	)
 
+	api.ClassificationsClassificationsListHandler = classifications.ClassificationsListHandlerFunc(
+		func(params classifications.ClassificationsListParams, principal *models.Principal) middleware.Responder {
+			var filter classification.ListFilter
+			if params.Class != nil {
+				filter.Class = *params.Class
+			}
+			if params.Status != nil {
+				filter.Status = *params.Status
+			}
+
+			res, err := classifier.List(params.HTTPRequest.Context(), principal, filter)
+			if err != nil {
+				switch err.(type) {
+				case errors.Forbidden:
+					return classifications.NewClassificationsListForbidden().WithPayload(errPayloadFromSingleErr(err))
+				default:
+					return classifications.NewClassificationsListInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+				}
+			}
+
+			list := make([]*models.Classification, len(res))
+			for i := range res {
+				list[i] = &res[i]
+			}
+
+			return classifications.NewClassificationsListOK().WithPayload(&models.ClassificationsListResponse{
+				Classifications: list,
+				TotalResults:    int64(len(list)),
+			})
+		},
+	)
+
+	api.ClassificationsClassificationsCancelHandler = classifications.ClassificationsCancelHandlerFunc(
+		func(params classifications.ClassificationsCancelParams, principal *models.Principal) middleware.Responder {
+			res, err := classifier.Cancel(params.HTTPRequest.Context(), principal, strfmt.UUID(params.ID))
+			if err != nil {
+				switch err.(type) {
+				case errors.Forbidden:
+					return classifications.NewClassificationsCancelForbidden().WithPayload(errPayloadFromSingleErr(err))
+				case classification.ErrNotFound:
+					return classifications.NewClassificationsCancelNotFound()
+				case classification.ErrNotRunning:
+					return classifications.NewClassificationsCancelConflict().WithPayload(errPayloadFromSingleErr(err))
+				default:
+					return classifications.NewClassificationsCancelInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+				}
+			}
+
+			return classifications.NewClassificationsCancelAccepted().WithPayload(res)
+		},
+	)
+
 	api.ClassificationsClassificationsPostHandler = classifications.ClassificationsPostHandlerFunc(
 		func(params classifications.ClassificationsPostParams, principal *models.Principal) middleware.Responder {
 
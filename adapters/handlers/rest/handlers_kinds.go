@@ -16,7 +16,9 @@ package rest
 import (
 	"context"
 	"fmt"
+	"net/http"
 
+	"github.com/go-openapi/runtime"
 	middleware "github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations"
@@ -47,8 +49,10 @@ type kindsManager interface {
 	ValidateAction(context.Context, *models.Principal, *models.Action) error
 	GetThing(context.Context, *models.Principal, strfmt.UUID, bool) (*models.Thing, error)
 	GetAction(context.Context, *models.Principal, strfmt.UUID, bool) (*models.Action, error)
-	GetThings(context.Context, *models.Principal, *int64, bool) ([]*models.Thing, error)
-	GetActions(context.Context, *models.Principal, *int64, bool) ([]*models.Action, error)
+	GetThings(context.Context, *models.Principal, *int64, *int64, strfmt.UUID, string, bool) (*models.ThingsListResponse, error)
+	GetActions(context.Context, *models.Principal, *int64, *int64, strfmt.UUID, string, bool) (*models.ActionsListResponse, error)
+	ExportThings(context.Context, *models.Principal, string, func(*models.Thing) error) error
+	ExportActions(context.Context, *models.Principal, string, func(*models.Action) error) error
 	UpdateThing(context.Context, *models.Principal, strfmt.UUID, *models.Thing) (*models.Thing, error)
 	UpdateAction(context.Context, *models.Principal, strfmt.UUID, *models.Action) (*models.Action, error)
 	MergeThing(context.Context, *models.Principal, strfmt.UUID, *models.Thing) error
@@ -211,60 +215,136 @@ func (h *kindHandlers) getAction(params actions.ActionsGetParams,
 
 func (h *kindHandlers) getThings(params things.ThingsListParams,
 	principal *models.Principal) middleware.Responder {
-	list, err := h.manager.GetThings(params.HTTPRequest.Context(), principal, params.Limit, derefBool(params.Meta))
+	list, err := h.manager.GetThings(params.HTTPRequest.Context(), principal, params.Offset,
+		params.Limit, strfmt.UUID(derefString(params.After)), derefString(params.Sort),
+		derefBool(params.Meta))
 	if err != nil {
 		switch err.(type) {
 		case errors.Forbidden:
 			return things.NewThingsListForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrInvalidUserInput:
+			return things.NewThingsListUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
 		default:
 			return things.NewThingsListInternalServerError().
 				WithPayload(errPayloadFromSingleErr(err))
 		}
 	}
 
-	for i, thing := range list {
+	for i, thing := range list.Things {
 		schemaMap, ok := thing.Schema.(map[string]interface{})
 		if ok {
-			list[i].Schema = h.extendSchemaWithAPILinks(schemaMap)
+			list.Things[i].Schema = h.extendSchemaWithAPILinks(schemaMap)
 		}
 	}
 
 	h.telemetryLogAsync(telemetry.TypeREST, telemetry.LocalQuery)
-	return things.NewThingsListOK().
-		WithPayload(&models.ThingsListResponse{
-			Things:       list,
-			TotalResults: int64(len(list)),
-		})
+	return things.NewThingsListOK().WithPayload(list)
 }
 
 func (h *kindHandlers) getActions(params actions.ActionsListParams,
 	principal *models.Principal) middleware.Responder {
-	list, err := h.manager.GetActions(params.HTTPRequest.Context(), principal, params.Limit, derefBool(params.Meta))
+	list, err := h.manager.GetActions(params.HTTPRequest.Context(), principal, params.Offset,
+		params.Limit, strfmt.UUID(derefString(params.After)), derefString(params.Sort),
+		derefBool(params.Meta))
 	if err != nil {
 		switch err.(type) {
 		case errors.Forbidden:
 			return actions.NewActionsListForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrInvalidUserInput:
+			return actions.NewActionsListUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
 		default:
 			return actions.NewActionsListInternalServerError().
 				WithPayload(errPayloadFromSingleErr(err))
 		}
 	}
 
-	for i, action := range list {
+	for i, action := range list.Actions {
 		schemaMap, ok := action.Schema.(map[string]interface{})
 		if ok {
-			list[i].Schema = h.extendSchemaWithAPILinks(schemaMap)
+			list.Actions[i].Schema = h.extendSchemaWithAPILinks(schemaMap)
 		}
 	}
 
 	h.telemetryLogAsync(telemetry.TypeREST, telemetry.LocalQuery)
-	return actions.NewActionsListOK().
-		WithPayload(&models.ActionsListResponse{
-			Actions:      list,
-			TotalResults: int64(len(list)),
-		})
+	return actions.NewActionsListOK().WithPayload(list)
+}
+
+// exportThings streams the things as they are read from the repo. Errors
+// which occur before the first thing was written are reported as usual,
+// afterwards the status is already sent and the stream is cut off instead.
+func (h *kindHandlers) exportThings(params things.ThingsExportParams,
+	principal *models.Principal) middleware.Responder {
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, p runtime.Producer) {
+		w := newNDJSONWriter(rw)
+		err := h.manager.ExportThings(params.HTTPRequest.Context(), principal, params.Class,
+			func(thing *models.Thing) error {
+				return w.write(thing)
+			})
+		if err == nil {
+			w.finish()
+			h.telemetryLogAsync(telemetry.TypeREST, telemetry.LocalQuery)
+			return
+		}
+
+		if w.started {
+			return
+		}
+
+		var res middleware.Responder
+		switch err.(type) {
+		case errors.Forbidden:
+			res = things.NewThingsExportForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrNotFound:
+			res = things.NewThingsExportNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			res = things.NewThingsExportInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		res.WriteResponse(rw, p)
+	})
+}
+
+// exportActions streams the actions as they are read from the repo. Errors
+// which occur before the first action was written are reported as usual,
+// afterwards the status is already sent and the stream is cut off instead.
+func (h *kindHandlers) exportActions(params actions.ActionsExportParams,
+	principal *models.Principal) middleware.Responder {
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, p runtime.Producer) {
+		w := newNDJSONWriter(rw)
+		err := h.manager.ExportActions(params.HTTPRequest.Context(), principal, params.Class,
+			func(action *models.Action) error {
+				return w.write(action)
+			})
+		if err == nil {
+			w.finish()
+			h.telemetryLogAsync(telemetry.TypeREST, telemetry.LocalQuery)
+			return
+		}
+
+		if w.started {
+			return
+		}
+
+		var res middleware.Responder
+		switch err.(type) {
+		case errors.Forbidden:
+			res = actions.NewActionsExportForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case kinds.ErrNotFound:
+			res = actions.NewActionsExportNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			res = actions.NewActionsExportInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		res.WriteResponse(rw, p)
+	})
 }
 
 func (h *kindHandlers) updateThing(params things.ThingsUpdateParams,
@@ -329,6 +409,9 @@ func (h *kindHandlers) deleteThing(params things.ThingsDeleteParams,
 				WithPayload(errPayloadFromSingleErr(err))
 		case kinds.ErrNotFound:
 			return things.NewThingsDeleteNotFound()
+		case kinds.ErrConflict:
+			return things.NewThingsDeleteConflict().
+				WithPayload(errPayloadFromSingleErr(err))
 		default:
 			return things.NewThingsDeleteInternalServerError().
 				WithPayload(errPayloadFromSingleErr(err))
@@ -349,6 +432,9 @@ func (h *kindHandlers) deleteAction(params actions.ActionsDeleteParams,
 				WithPayload(errPayloadFromSingleErr(err))
 		case kinds.ErrNotFound:
 			return actions.NewActionsDeleteNotFound()
+		case kinds.ErrConflict:
+			return actions.NewActionsDeleteConflict().
+				WithPayload(errPayloadFromSingleErr(err))
 		default:
 			return actions.NewActionsDeleteInternalServerError().
 				WithPayload(errPayloadFromSingleErr(err))
@@ -542,6 +628,8 @@ func setupKindHandlers(api *operations.WeaviateAPI, requestsLog *telemetry.Reque
 		ThingsDeleteHandlerFunc(h.deleteThing)
 	api.ThingsThingsListHandler = things.
 		ThingsListHandlerFunc(h.getThings)
+	api.ThingsThingsExportHandler = things.
+		ThingsExportHandlerFunc(h.exportThings)
 	api.ThingsThingsUpdateHandler = things.
 		ThingsUpdateHandlerFunc(h.updateThing)
 	api.ThingsThingsPatchHandler = things.
@@ -563,6 +651,8 @@ func setupKindHandlers(api *operations.WeaviateAPI, requestsLog *telemetry.Reque
 		ActionsDeleteHandlerFunc(h.deleteAction)
 	api.ActionsActionsListHandler = actions.
 		ActionsListHandlerFunc(h.getActions)
+	api.ActionsActionsExportHandler = actions.
+		ActionsExportHandlerFunc(h.exportActions)
 	api.ActionsActionsUpdateHandler = actions.
 		ActionsUpdateHandlerFunc(h.updateAction)
 	api.ActionsActionsPatchHandler = actions.
@@ -590,6 +680,14 @@ func derefBool(in *bool) bool {
 	return *in
 }
 
+func derefString(in *string) string {
+	if in == nil {
+		return ""
+	}
+
+	return *in
+}
+
 func (h *kindHandlers) extendSchemaWithAPILinks(schema map[string]interface{}) map[string]interface{} {
 	if schema == nil {
 		return schema
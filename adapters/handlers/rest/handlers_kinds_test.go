@@ -15,14 +15,17 @@ package rest
 
 import (
 	"context"
+	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/actions"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/things"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -672,6 +675,86 @@ func TestEnrichObjectsWithLinks(t *testing.T) {
 
 }
 
+func TestExportKinds(t *testing.T) {
+	t.Run("exporting things writes one thing per line", func(t *testing.T) {
+		fakeManager := &fakeManager{
+			getThingsReturn: []*models.Thing{
+				&models.Thing{
+					Class: "Foo", ID: "85f78e29-5937-4390-a121-5379f262b4e5",
+					Vector: models.Vector{1, 2},
+				},
+				&models.Thing{
+					Class: "Foo", ID: "cb5ec3b1-6c4a-4a8c-9f2f-6f2c7b0f6a21",
+					Vector: models.Vector{3, 4},
+				},
+			},
+		}
+		h := &kindHandlers{manager: fakeManager, requestsLog: &fakeRequestLog{}}
+		res := h.exportThings(things.ThingsExportParams{
+			HTTPRequest: httptest.NewRequest("GET", "/v1/things/export?class=Foo", nil),
+			Class:       "Foo",
+		}, nil)
+
+		rec := httptest.NewRecorder()
+		res.WriteResponse(rec, runtime.JSONProducer())
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "application/x-ndjson", rec.Header().Get("Content-Type"))
+		expected := `{"class":"Foo","id":"85f78e29-5937-4390-a121-5379f262b4e5","vector":[1,2]}` + "\n" +
+			`{"class":"Foo","id":"cb5ec3b1-6c4a-4a8c-9f2f-6f2c7b0f6a21","vector":[3,4]}` + "\n"
+		assert.Equal(t, expected, rec.Body.String())
+	})
+
+	t.Run("exporting an empty class", func(t *testing.T) {
+		h := &kindHandlers{manager: &fakeManager{}, requestsLog: &fakeRequestLog{}}
+		res := h.exportActions(actions.ActionsExportParams{
+			HTTPRequest: httptest.NewRequest("GET", "/v1/actions/export?class=Foo", nil),
+			Class:       "Foo",
+		}, nil)
+
+		rec := httptest.NewRecorder()
+		res.WriteResponse(rec, runtime.JSONProducer())
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "", rec.Body.String())
+	})
+
+	t.Run("an error before the first line is sent as an error response", func(t *testing.T) {
+		fakeManager := &fakeManager{
+			exportErr: kinds.NewErrNotFound("no thing class with name 'Foo'"),
+		}
+		h := &kindHandlers{manager: fakeManager, requestsLog: &fakeRequestLog{}}
+		res := h.exportThings(things.ThingsExportParams{
+			HTTPRequest: httptest.NewRequest("GET", "/v1/things/export?class=Foo", nil),
+			Class:       "Foo",
+		}, nil)
+
+		rec := httptest.NewRecorder()
+		res.WriteResponse(rec, runtime.JSONProducer())
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.Contains(t, rec.Body.String(), "no thing class with name 'Foo'")
+	})
+
+	t.Run("an error after the first line cuts off the stream", func(t *testing.T) {
+		fakeManager := &fakeManager{
+			getActionsReturn: []*models.Action{&models.Action{Class: "Foo"}},
+			exportErr:        kinds.NewErrInternal("connection lost"),
+		}
+		h := &kindHandlers{manager: fakeManager, requestsLog: &fakeRequestLog{}}
+		res := h.exportActions(actions.ActionsExportParams{
+			HTTPRequest: httptest.NewRequest("GET", "/v1/actions/export?class=Foo", nil),
+			Class:       "Foo",
+		}, nil)
+
+		rec := httptest.NewRecorder()
+		res.WriteResponse(rec, runtime.JSONProducer())
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `{"class":"Foo"}`+"\n", rec.Body.String())
+	})
+}
+
 type fakeManager struct {
 	getThingReturn     *models.Thing
 	getActionReturn    *models.Action
@@ -681,6 +764,7 @@ type fakeManager struct {
 	getActionsReturn   []*models.Action
 	updateThingReturn  *models.Thing
 	updateActionReturn *models.Action
+	exportErr          error
 }
 
 func (f *fakeManager) AddThing(_ context.Context, _ *models.Principal, thing *models.Thing) (*models.Thing, error) {
@@ -707,12 +791,14 @@ func (f *fakeManager) GetAction(_ context.Context, _ *models.Principal, _ strfmt
 	return f.getActionReturn, nil
 }
 
-func (f *fakeManager) GetThings(_ context.Context, _ *models.Principal, _ *int64, _ bool) ([]*models.Thing, error) {
-	return f.getThingsReturn, nil
+func (f *fakeManager) GetThings(_ context.Context, _ *models.Principal, _, _ *int64,
+	_ strfmt.UUID, _ string, _ bool) (*models.ThingsListResponse, error) {
+	return &models.ThingsListResponse{Things: f.getThingsReturn}, nil
 }
 
-func (f *fakeManager) GetActions(_ context.Context, _ *models.Principal, _ *int64, _ bool) ([]*models.Action, error) {
-	return f.getActionsReturn, nil
+func (f *fakeManager) GetActions(_ context.Context, _ *models.Principal, _, _ *int64,
+	_ strfmt.UUID, _ string, _ bool) (*models.ActionsListResponse, error) {
+	return &models.ActionsListResponse{Actions: f.getActionsReturn}, nil
 }
 
 func (f *fakeManager) UpdateThing(_ context.Context, _ *models.Principal, _ strfmt.UUID, thing *models.Thing) (*models.Thing, error) {
@@ -763,6 +849,28 @@ func (f *fakeManager) DeleteActionReference(_ context.Context, _ *models.Princip
 	panic("not implemented") // TODO: Implement
 }
 
+func (f *fakeManager) ExportThings(_ context.Context, _ *models.Principal, _ string,
+	fn func(*models.Thing) error) error {
+	for _, thing := range f.getThingsReturn {
+		if err := fn(thing); err != nil {
+			return err
+		}
+	}
+
+	return f.exportErr
+}
+
+func (f *fakeManager) ExportActions(_ context.Context, _ *models.Principal, _ string,
+	fn func(*models.Action) error) error {
+	for _, action := range f.getActionsReturn {
+		if err := fn(action); err != nil {
+			return err
+		}
+	}
+
+	return f.exportErr
+}
+
 type fakeRequestLog struct{}
 
 func (f *fakeRequestLog) Register(_ string, _ string) {}
@@ -0,0 +1,46 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package rest
+
+import (
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/maintenance"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization/errors"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+	"github.com/semi-technologies/weaviate/usecases/telemetry"
+)
+
+func setupMaintenanceHandlers(api *operations.WeaviateAPI,
+	requestsLog *telemetry.RequestsLog, manager *kinds.Manager) {
+
+	api.MaintenanceMaintenanceDanglingReferencesHandler = maintenance.MaintenanceDanglingReferencesHandlerFunc(
+		func(params maintenance.MaintenanceDanglingReferencesParams, principal *models.Principal) middleware.Responder {
+			res, err := manager.CheckDanglingReferences(params.HTTPRequest.Context(), principal, params.Body)
+			if err != nil {
+				switch err.(type) {
+				case errors.Forbidden:
+					return maintenance.NewMaintenanceDanglingReferencesForbidden().WithPayload(errPayloadFromSingleErr(err))
+				case kinds.ErrInvalidUserInput:
+					return maintenance.NewMaintenanceDanglingReferencesUnprocessableEntity().WithPayload(errPayloadFromSingleErr(err))
+				default:
+					return maintenance.NewMaintenanceDanglingReferencesInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+				}
+			}
+
+			return maintenance.NewMaintenanceDanglingReferencesOK().WithPayload(res)
+		},
+	)
+}
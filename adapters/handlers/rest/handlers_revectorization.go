@@ -0,0 +1,135 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package rest
+
+import (
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/schema"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization/errors"
+	"github.com/semi-technologies/weaviate/usecases/revectorization"
+	"github.com/semi-technologies/weaviate/usecases/telemetry"
+)
+
+func setupRevectorizationHandlers(api *operations.WeaviateAPI,
+	requestsLog *telemetry.RequestsLog, revectorizer *revectorization.Revectorizer) {
+
+	api.SchemaSchemaThingsRevectorizeHandler = schema.SchemaThingsRevectorizeHandlerFunc(
+		func(params schema.SchemaThingsRevectorizeParams, principal *models.Principal) middleware.Responder {
+			var body models.Revectorization
+			if params.Body != nil {
+				body = *params.Body
+			}
+
+			res, err := revectorizer.Start(params.HTTPRequest.Context(), principal,
+				kind.Thing, params.ClassName, body)
+			if err != nil {
+				switch err.(type) {
+				case errors.Forbidden:
+					return schema.NewSchemaThingsRevectorizeForbidden().WithPayload(errPayloadFromSingleErr(err))
+				case revectorization.ErrInvalidUserInput:
+					return schema.NewSchemaThingsRevectorizeUnprocessableEntity().WithPayload(errPayloadFromSingleErr(err))
+				case revectorization.ErrAlreadyRunning:
+					return schema.NewSchemaThingsRevectorizeConflict().WithPayload(errPayloadFromSingleErr(err))
+				default:
+					return schema.NewSchemaThingsRevectorizeInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+				}
+			}
+
+			return schema.NewSchemaThingsRevectorizeAccepted().WithPayload(res)
+		},
+	)
+
+	api.SchemaSchemaActionsRevectorizeHandler = schema.SchemaActionsRevectorizeHandlerFunc(
+		func(params schema.SchemaActionsRevectorizeParams, principal *models.Principal) middleware.Responder {
+			var body models.Revectorization
+			if params.Body != nil {
+				body = *params.Body
+			}
+
+			res, err := revectorizer.Start(params.HTTPRequest.Context(), principal,
+				kind.Action, params.ClassName, body)
+			if err != nil {
+				switch err.(type) {
+				case errors.Forbidden:
+					return schema.NewSchemaActionsRevectorizeForbidden().WithPayload(errPayloadFromSingleErr(err))
+				case revectorization.ErrInvalidUserInput:
+					return schema.NewSchemaActionsRevectorizeUnprocessableEntity().WithPayload(errPayloadFromSingleErr(err))
+				case revectorization.ErrAlreadyRunning:
+					return schema.NewSchemaActionsRevectorizeConflict().WithPayload(errPayloadFromSingleErr(err))
+				default:
+					return schema.NewSchemaActionsRevectorizeInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+				}
+			}
+
+			return schema.NewSchemaActionsRevectorizeAccepted().WithPayload(res)
+		},
+	)
+
+	api.SchemaSchemaRevectorizationsListHandler = schema.SchemaRevectorizationsListHandlerFunc(
+		func(params schema.SchemaRevectorizationsListParams, principal *models.Principal) middleware.Responder {
+			res, err := revectorizer.List(params.HTTPRequest.Context(), principal)
+			if err != nil {
+				switch err.(type) {
+				case errors.Forbidden:
+					return schema.NewSchemaRevectorizationsListForbidden().WithPayload(errPayloadFromSingleErr(err))
+				default:
+					return schema.NewSchemaRevectorizationsListInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+				}
+			}
+
+			return schema.NewSchemaRevectorizationsListOK().WithPayload(res)
+		},
+	)
+
+	api.SchemaSchemaRevectorizationsGetHandler = schema.SchemaRevectorizationsGetHandlerFunc(
+		func(params schema.SchemaRevectorizationsGetParams, principal *models.Principal) middleware.Responder {
+			res, err := revectorizer.Get(params.HTTPRequest.Context(), principal, params.ID)
+			if err != nil {
+				switch err.(type) {
+				case errors.Forbidden:
+					return schema.NewSchemaRevectorizationsGetForbidden().WithPayload(errPayloadFromSingleErr(err))
+				case revectorization.ErrNotFound:
+					return schema.NewSchemaRevectorizationsGetNotFound()
+				default:
+					return schema.NewSchemaRevectorizationsGetInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+				}
+			}
+
+			return schema.NewSchemaRevectorizationsGetOK().WithPayload(res)
+		},
+	)
+
+	api.SchemaSchemaRevectorizationsCancelHandler = schema.SchemaRevectorizationsCancelHandlerFunc(
+		func(params schema.SchemaRevectorizationsCancelParams, principal *models.Principal) middleware.Responder {
+			res, err := revectorizer.Cancel(params.HTTPRequest.Context(), principal, params.ID)
+			if err != nil {
+				switch err.(type) {
+				case errors.Forbidden:
+					return schema.NewSchemaRevectorizationsCancelForbidden().WithPayload(errPayloadFromSingleErr(err))
+				case revectorization.ErrNotFound:
+					return schema.NewSchemaRevectorizationsCancelNotFound()
+				case revectorization.ErrNotRunning:
+					return schema.NewSchemaRevectorizationsCancelConflict().WithPayload(errPayloadFromSingleErr(err))
+				default:
+					return schema.NewSchemaRevectorizationsCancelInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+				}
+			}
+
+			return schema.NewSchemaRevectorizationsCancelAccepted().WithPayload(res)
+		},
+	)
+}
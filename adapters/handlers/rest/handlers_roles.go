@@ -0,0 +1,126 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package rest
+
+import (
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/roles"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization/errors"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization/rbac"
+	"github.com/semi-technologies/weaviate/usecases/telemetry"
+)
+
+func setupRoleHandlers(api *operations.WeaviateAPI,
+	requestsLog *telemetry.RequestsLog, manager *rbac.Manager) {
+
+	api.RolesRolesListHandler = roles.RolesListHandlerFunc(
+		func(params roles.RolesListParams, principal *models.Principal) middleware.Responder {
+			res, err := manager.List(params.HTTPRequest.Context(), principal)
+			if err != nil {
+				switch err.(type) {
+				case errors.Forbidden:
+					return roles.NewRolesListForbidden().WithPayload(errPayloadFromSingleErr(err))
+				case rbac.ErrInvalidUserInput:
+					return roles.NewRolesListUnprocessableEntity().WithPayload(errPayloadFromSingleErr(err))
+				default:
+					return roles.NewRolesListInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+				}
+			}
+
+			return roles.NewRolesListOK().WithPayload(res)
+		},
+	)
+
+	api.RolesRolesCreateHandler = roles.RolesCreateHandlerFunc(
+		func(params roles.RolesCreateParams, principal *models.Principal) middleware.Responder {
+			res, err := manager.Add(params.HTTPRequest.Context(), principal, params.Body)
+			if err != nil {
+				switch err.(type) {
+				case errors.Forbidden:
+					return roles.NewRolesCreateForbidden().WithPayload(errPayloadFromSingleErr(err))
+				case rbac.ErrInvalidUserInput:
+					return roles.NewRolesCreateUnprocessableEntity().WithPayload(errPayloadFromSingleErr(err))
+				default:
+					return roles.NewRolesCreateInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+				}
+			}
+
+			return roles.NewRolesCreateCreated().WithPayload(res)
+		},
+	)
+
+	api.RolesRolesGetHandler = roles.RolesGetHandlerFunc(
+		func(params roles.RolesGetParams, principal *models.Principal) middleware.Responder {
+			res, err := manager.Get(params.HTTPRequest.Context(), principal, params.Name)
+			if err != nil {
+				switch err.(type) {
+				case errors.Forbidden:
+					return roles.NewRolesGetForbidden().WithPayload(errPayloadFromSingleErr(err))
+				case rbac.ErrInvalidUserInput:
+					return roles.NewRolesGetUnprocessableEntity().WithPayload(errPayloadFromSingleErr(err))
+				default:
+					return roles.NewRolesGetInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+				}
+			}
+
+			if res == nil {
+				return roles.NewRolesGetNotFound()
+			}
+
+			return roles.NewRolesGetOK().WithPayload(res)
+		},
+	)
+
+	api.RolesRolesUpdateHandler = roles.RolesUpdateHandlerFunc(
+		func(params roles.RolesUpdateParams, principal *models.Principal) middleware.Responder {
+			res, err := manager.Update(params.HTTPRequest.Context(), principal, params.Name, params.Body)
+			if err != nil {
+				switch err.(type) {
+				case errors.Forbidden:
+					return roles.NewRolesUpdateForbidden().WithPayload(errPayloadFromSingleErr(err))
+				case rbac.ErrNotFound:
+					return roles.NewRolesUpdateNotFound()
+				case rbac.ErrInvalidUserInput:
+					return roles.NewRolesUpdateUnprocessableEntity().WithPayload(errPayloadFromSingleErr(err))
+				default:
+					return roles.NewRolesUpdateInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+				}
+			}
+
+			return roles.NewRolesUpdateOK().WithPayload(res)
+		},
+	)
+
+	api.RolesRolesDeleteHandler = roles.RolesDeleteHandlerFunc(
+		func(params roles.RolesDeleteParams, principal *models.Principal) middleware.Responder {
+			err := manager.Delete(params.HTTPRequest.Context(), principal, params.Name)
+			if err != nil {
+				switch err.(type) {
+				case errors.Forbidden:
+					return roles.NewRolesDeleteForbidden().WithPayload(errPayloadFromSingleErr(err))
+				case rbac.ErrNotFound:
+					return roles.NewRolesDeleteNotFound()
+				case rbac.ErrInvalidUserInput:
+					return roles.NewRolesDeleteUnprocessableEntity().WithPayload(errPayloadFromSingleErr(err))
+				default:
+					return roles.NewRolesDeleteInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+				}
+			}
+
+			return roles.NewRolesDeleteNoContent()
+		},
+	)
+}
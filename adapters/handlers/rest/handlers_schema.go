@@ -164,6 +164,44 @@ func (s *schemaHandlers) getSchema(params schema.SchemaDumpParams, principal *mo
 	return schema.NewSchemaDumpOK().WithPayload(payload)
 }
 
+func (s *schemaHandlers) listReindexes(params schema.SchemaReindexesListParams,
+	principal *models.Principal) middleware.Responder {
+	res, err := s.manager.GetReindexes(params.HTTPRequest.Context(), principal)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaReindexesListForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaReindexesListInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaReindexesListOK().WithPayload(res)
+}
+
+func (s *schemaHandlers) getReindex(params schema.SchemaReindexesGetParams,
+	principal *models.Principal) middleware.Responder {
+	res, err := s.manager.GetReindex(params.HTTPRequest.Context(), principal, params.ID)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaReindexesGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaReindexesGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	if res == nil {
+		return schema.NewSchemaReindexesGetNotFound()
+	}
+
+	return schema.NewSchemaReindexesGetOK().WithPayload(res)
+}
+
 func (s *schemaHandlers) addThing(params schema.SchemaThingsCreateParams, principal *models.Principal) middleware.Responder {
 	err := s.manager.AddThing(params.HTTPRequest.Context(), principal, params.ThingClass)
 	if err != nil {
@@ -301,6 +339,11 @@ func setupSchemaHandlers(api *operations.WeaviateAPI, requestsLog *telemetry.Req
 
 	api.SchemaSchemaDumpHandler = schema.
 		SchemaDumpHandlerFunc(h.getSchema)
+
+	api.SchemaSchemaReindexesListHandler = schema.
+		SchemaReindexesListHandlerFunc(h.listReindexes)
+	api.SchemaSchemaReindexesGetHandler = schema.
+		SchemaReindexesGetHandlerFunc(h.getReindex)
 }
 
 type unlocker interface {
@@ -14,7 +14,9 @@
 package rest
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
 
 	"github.com/semi-technologies/weaviate/entities/models"
 )
@@ -39,3 +41,41 @@ func errPayloadFromSingleErr(err error) *models.ErrorResponse {
 		Message: fmt.Sprintf("%s", err),
 	}}}
 }
+
+// ndjsonWriter writes one json document per line. The status is only sent
+// with the first document, so that the response can still be replaced by an
+// error response until then.
+type ndjsonWriter struct {
+	rw      http.ResponseWriter
+	encoder *json.Encoder
+	started bool
+}
+
+func newNDJSONWriter(rw http.ResponseWriter) *ndjsonWriter {
+	return &ndjsonWriter{rw: rw, encoder: json.NewEncoder(rw)}
+}
+
+func (w *ndjsonWriter) start() {
+	if w.started {
+		return
+	}
+
+	w.rw.Header().Set("Content-Type", "application/x-ndjson")
+	w.rw.WriteHeader(http.StatusOK)
+	w.started = true
+}
+
+// write appends the document as a single line, json.Encoder terminates each
+// document with a newline
+func (w *ndjsonWriter) write(doc interface{}) error {
+	w.start()
+	return w.encoder.Encode(doc)
+}
+
+// finish sends the status for an empty stream and flushes what was written
+func (w *ndjsonWriter) finish() {
+	w.start()
+	if flusher, ok := w.rw.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
@@ -15,10 +15,15 @@ package rest
 
 import (
 	"net/http"
+	"strconv"
+	"time"
 
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rs/cors"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/state"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/swagger_middleware"
+	"github.com/semi-technologies/weaviate/usecases/monitoring"
 	"github.com/sirupsen/logrus"
 )
 
@@ -35,7 +40,7 @@ func makeSetupMiddlewares(appState *state.State) func(http.Handler) http.Handler
 				handler.ServeHTTP(w, r)
 				return
 			}
-			appState.AnonymousAccess.Middleware(handler).ServeHTTP(w, r)
+			addRESTMetrics(appState.AnonymousAccess.Middleware(handler)).ServeHTTP(w, r)
 		})
 	}
 
@@ -70,6 +75,7 @@ func makeSetupGlobalMiddleware(appState *state.State) func(http.Handler) http.Ha
 		handler = addPreflight(handler)
 		handler = addLiveAndReadyness(handler)
 		handler = addHandleRoot(handler)
+		handler = makeAddMetricsEndpoint(appState)(handler)
 
 		return handler
 	}
@@ -118,3 +124,60 @@ func addLiveAndReadyness(next http.Handler) http.Handler {
 		next.ServeHTTP(w, r)
 	})
 }
+
+// makeAddMetricsEndpoint serves the prometheus metrics at /metrics if
+// monitoring is enabled
+func makeAddMetricsEndpoint(appState *state.State) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if !appState.ServerConfig.Config.Monitoring.Enabled {
+			return next
+		}
+
+		metricsHandler := promhttp.Handler()
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/metrics" {
+				metricsHandler.ServeHTTP(w, r)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// addRESTMetrics observes the duration of every routed request, the
+// operation is the id of the matched swagger operation
+func addRESTMetrics(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		before := time.Now()
+		sw := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(sw, r)
+
+		operation := "unknown"
+		if route := middleware.MatchedRouteFrom(r); route != nil && route.Operation != nil {
+			operation = route.Operation.ID
+		}
+
+		monitoring.GetMetrics().RESTRequestDuration.
+			WithLabelValues(operation, r.Method, strconv.Itoa(sw.status)).
+			Observe(time.Since(before).Seconds())
+	})
+}
+
+// statusRecorder remembers the status code written by the wrapped handler
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (s *statusRecorder) WriteHeader(status int) {
+	s.status = status
+	s.ResponseWriter.WriteHeader(status)
+}
+
+// Flush is required for streamed responses, such as the ndjson export
+func (s *statusRecorder) Flush() {
+	if f, ok := s.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
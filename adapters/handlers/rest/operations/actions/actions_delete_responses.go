@@ -142,6 +142,50 @@ func (o *ActionsDeleteNotFound) WriteResponse(rw http.ResponseWriter, producer r
 	rw.WriteHeader(404)
 }
 
+// ActionsDeleteConflictCode is the HTTP code returned for type ActionsDeleteConflict
+const ActionsDeleteConflictCode int = 409
+
+/*ActionsDeleteConflict The object is still referenced through a reference property with the onDelete policy 'restrict'.
+
+swagger:response actionsDeleteConflict
+*/
+type ActionsDeleteConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewActionsDeleteConflict creates ActionsDeleteConflict with default headers values
+func NewActionsDeleteConflict() *ActionsDeleteConflict {
+
+	return &ActionsDeleteConflict{}
+}
+
+// WithPayload adds the payload to the actions delete conflict response
+func (o *ActionsDeleteConflict) WithPayload(payload *models.ErrorResponse) *ActionsDeleteConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the actions delete conflict response
+func (o *ActionsDeleteConflict) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ActionsDeleteConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ActionsDeleteInternalServerErrorCode is the HTTP code returned for type ActionsDeleteInternalServerError
 const ActionsDeleteInternalServerErrorCode int = 500
 
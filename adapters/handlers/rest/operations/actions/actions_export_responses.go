@@ -0,0 +1,207 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package actions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// ActionsExportOKCode is the HTTP code returned for type ActionsExportOK
+const ActionsExportOKCode int = 200
+
+/*ActionsExportOK Successful response, the body contains one Action per line.
+
+swagger:response actionsExportOK
+*/
+type ActionsExportOK struct {
+}
+
+// NewActionsExportOK creates ActionsExportOK with default headers values
+func NewActionsExportOK() *ActionsExportOK {
+
+	return &ActionsExportOK{}
+}
+
+// WriteResponse to the client
+func (o *ActionsExportOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(200)
+}
+
+// ActionsExportUnauthorizedCode is the HTTP code returned for type ActionsExportUnauthorized
+const ActionsExportUnauthorizedCode int = 401
+
+/*ActionsExportUnauthorized Unauthorized or invalid credentials.
+
+swagger:response actionsExportUnauthorized
+*/
+type ActionsExportUnauthorized struct {
+}
+
+// NewActionsExportUnauthorized creates ActionsExportUnauthorized with default headers values
+func NewActionsExportUnauthorized() *ActionsExportUnauthorized {
+
+	return &ActionsExportUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *ActionsExportUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// ActionsExportForbiddenCode is the HTTP code returned for type ActionsExportForbidden
+const ActionsExportForbiddenCode int = 403
+
+/*ActionsExportForbidden Forbidden
+
+swagger:response actionsExportForbidden
+*/
+type ActionsExportForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewActionsExportForbidden creates ActionsExportForbidden with default headers values
+func NewActionsExportForbidden() *ActionsExportForbidden {
+
+	return &ActionsExportForbidden{}
+}
+
+// WithPayload adds the payload to the actions export forbidden response
+func (o *ActionsExportForbidden) WithPayload(payload *models.ErrorResponse) *ActionsExportForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the actions export forbidden response
+func (o *ActionsExportForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ActionsExportForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ActionsExportNotFoundCode is the HTTP code returned for type ActionsExportNotFound
+const ActionsExportNotFoundCode int = 404
+
+/*ActionsExportNotFound There is no Action class with this name.
+
+swagger:response actionsExportNotFound
+*/
+type ActionsExportNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewActionsExportNotFound creates ActionsExportNotFound with default headers values
+func NewActionsExportNotFound() *ActionsExportNotFound {
+
+	return &ActionsExportNotFound{}
+}
+
+// WithPayload adds the payload to the actions export not found response
+func (o *ActionsExportNotFound) WithPayload(payload *models.ErrorResponse) *ActionsExportNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the actions export not found response
+func (o *ActionsExportNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ActionsExportNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ActionsExportInternalServerErrorCode is the HTTP code returned for type ActionsExportInternalServerError
+const ActionsExportInternalServerErrorCode int = 500
+
+/*ActionsExportInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response actionsExportInternalServerError
+*/
+type ActionsExportInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewActionsExportInternalServerError creates ActionsExportInternalServerError with default headers values
+func NewActionsExportInternalServerError() *ActionsExportInternalServerError {
+
+	return &ActionsExportInternalServerError{}
+}
+
+// WithPayload adds the payload to the actions export internal server error response
+func (o *ActionsExportInternalServerError) WithPayload(payload *models.ErrorResponse) *ActionsExportInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the actions export internal server error response
+func (o *ActionsExportInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ActionsExportInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
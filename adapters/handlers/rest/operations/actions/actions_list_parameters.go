@@ -45,6 +45,10 @@ type ActionsListParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*Only return items after this cursor, such as the continuationToken of a previous page. Items are ordered by their id. Cannot be combined with 'offset'.
+	  In: query
+	*/
+	After *string
 	/*The maximum number of items to be returned per page. Default value is set in Weaviate config.
 	  In: query
 	*/
@@ -53,6 +57,14 @@ type ActionsListParams struct {
 	  In: query
 	*/
 	Meta *bool
+	/*The number of items to skip. Cannot be combined with 'after'.
+	  In: query
+	*/
+	Offset *int64
+	/*Comma-separated list of properties to sort the items by, each optionally followed by ':asc' or ':desc', such as 'price:desc,_created'. Primitive properties other than text, geoCoordinates and phoneNumber can be used, as well as '_created' and '_updated'. The order defaults to ascending. Items without a value come last. Cannot be combined with 'after'.
+	  In: query
+	*/
+	Sort *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -66,6 +78,11 @@ func (o *ActionsListParams) BindRequest(r *http.Request, route *middleware.Match
 
 	qs := runtime.Values(r.URL.Query())
 
+	qAfter, qhkAfter, _ := qs.GetOK("after")
+	if err := o.bindAfter(qAfter, qhkAfter, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qLimit, qhkLimit, _ := qs.GetOK("limit")
 	if err := o.bindLimit(qLimit, qhkLimit, route.Formats); err != nil {
 		res = append(res, err)
@@ -76,12 +93,40 @@ func (o *ActionsListParams) BindRequest(r *http.Request, route *middleware.Match
 		res = append(res, err)
 	}
 
+	qOffset, qhkOffset, _ := qs.GetOK("offset")
+	if err := o.bindOffset(qOffset, qhkOffset, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSort, qhkSort, _ := qs.GetOK("sort")
+	if err := o.bindSort(qSort, qhkSort, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+// bindAfter binds and validates parameter After from query.
+func (o *ActionsListParams) bindAfter(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.After = &raw
+
+	return nil
+}
+
 // bindLimit binds and validates parameter Limit from query.
 func (o *ActionsListParams) bindLimit(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -125,3 +170,43 @@ func (o *ActionsListParams) bindMeta(rawData []string, hasKey bool, formats strf
 
 	return nil
 }
+
+// bindOffset binds and validates parameter Offset from query.
+func (o *ActionsListParams) bindOffset(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("offset", "query", "int64", raw)
+	}
+	o.Offset = &value
+
+	return nil
+}
+
+// bindSort binds and validates parameter Sort from query.
+func (o *ActionsListParams) bindSort(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Sort = &raw
+
+	return nil
+}
@@ -162,6 +162,50 @@ func (o *ActionsListNotFound) WriteResponse(rw http.ResponseWriter, producer run
 	rw.WriteHeader(404)
 }
 
+// ActionsListUnprocessableEntityCode is the HTTP code returned for type ActionsListUnprocessableEntity
+const ActionsListUnprocessableEntityCode int = 422
+
+/*ActionsListUnprocessableEntity Invalid paging parameters.
+
+swagger:response actionsListUnprocessableEntity
+*/
+type ActionsListUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewActionsListUnprocessableEntity creates ActionsListUnprocessableEntity with default headers values
+func NewActionsListUnprocessableEntity() *ActionsListUnprocessableEntity {
+
+	return &ActionsListUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the actions list unprocessable entity response
+func (o *ActionsListUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *ActionsListUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the actions list unprocessable entity response
+func (o *ActionsListUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ActionsListUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ActionsListInternalServerErrorCode is the HTTP code returned for type ActionsListInternalServerError
 const ActionsListInternalServerErrorCode int = 500
 
@@ -28,8 +28,11 @@ import (
 
 // ActionsListURL generates an URL for the actions list operation
 type ActionsListURL struct {
-	Limit *int64
-	Meta  *bool
+	After  *string
+	Limit  *int64
+	Meta   *bool
+	Offset *int64
+	Sort   *string
 
 	_basePath string
 	// avoid unkeyed usage
@@ -65,6 +68,14 @@ func (o *ActionsListURL) Build() (*url.URL, error) {
 
 	qs := make(url.Values)
 
+	var afterQ string
+	if o.After != nil {
+		afterQ = *o.After
+	}
+	if afterQ != "" {
+		qs.Set("after", afterQ)
+	}
+
 	var limitQ string
 	if o.Limit != nil {
 		limitQ = swag.FormatInt64(*o.Limit)
@@ -81,6 +92,22 @@ func (o *ActionsListURL) Build() (*url.URL, error) {
 		qs.Set("meta", metaQ)
 	}
 
+	var offsetQ string
+	if o.Offset != nil {
+		offsetQ = swag.FormatInt64(*o.Offset)
+	}
+	if offsetQ != "" {
+		qs.Set("offset", offsetQ)
+	}
+
+	var sortQ string
+	if o.Sort != nil {
+		sortQ = *o.Sort
+	}
+	if sortQ != "" {
+		qs.Set("sort", sortQ)
+	}
+
 	_result.RawQuery = qs.Encode()
 
 	return &_result, nil
@@ -0,0 +1,227 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package backups
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// BackupsCreateCreatedCode is the HTTP code returned for type BackupsCreateCreated
+const BackupsCreateCreatedCode int = 201
+
+/*BackupsCreateCreated Successfully started backup.
+
+swagger:response backupsCreateCreated
+*/
+type BackupsCreateCreated struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Backup `json:"body,omitempty"`
+}
+
+// NewBackupsCreateCreated creates BackupsCreateCreated with default headers values
+func NewBackupsCreateCreated() *BackupsCreateCreated {
+
+	return &BackupsCreateCreated{}
+}
+
+// WithPayload adds the payload to the backups create created response
+func (o *BackupsCreateCreated) WithPayload(payload *models.Backup) *BackupsCreateCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the backups create created response
+func (o *BackupsCreateCreated) SetPayload(payload *models.Backup) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BackupsCreateCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(201)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// BackupsCreateUnauthorizedCode is the HTTP code returned for type BackupsCreateUnauthorized
+const BackupsCreateUnauthorizedCode int = 401
+
+/*BackupsCreateUnauthorized Unauthorized or invalid credentials.
+
+swagger:response backupsCreateUnauthorized
+*/
+type BackupsCreateUnauthorized struct {
+}
+
+// NewBackupsCreateUnauthorized creates BackupsCreateUnauthorized with default headers values
+func NewBackupsCreateUnauthorized() *BackupsCreateUnauthorized {
+
+	return &BackupsCreateUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *BackupsCreateUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// BackupsCreateForbiddenCode is the HTTP code returned for type BackupsCreateForbidden
+const BackupsCreateForbiddenCode int = 403
+
+/*BackupsCreateForbidden Forbidden
+
+swagger:response backupsCreateForbidden
+*/
+type BackupsCreateForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBackupsCreateForbidden creates BackupsCreateForbidden with default headers values
+func NewBackupsCreateForbidden() *BackupsCreateForbidden {
+
+	return &BackupsCreateForbidden{}
+}
+
+// WithPayload adds the payload to the backups create forbidden response
+func (o *BackupsCreateForbidden) WithPayload(payload *models.ErrorResponse) *BackupsCreateForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the backups create forbidden response
+func (o *BackupsCreateForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BackupsCreateForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// BackupsCreateUnprocessableEntityCode is the HTTP code returned for type BackupsCreateUnprocessableEntity
+const BackupsCreateUnprocessableEntityCode int = 422
+
+/*BackupsCreateUnprocessableEntity Invalid backup, for example an unknown class or an id which is already in use.
+
+swagger:response backupsCreateUnprocessableEntity
+*/
+type BackupsCreateUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBackupsCreateUnprocessableEntity creates BackupsCreateUnprocessableEntity with default headers values
+func NewBackupsCreateUnprocessableEntity() *BackupsCreateUnprocessableEntity {
+
+	return &BackupsCreateUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the backups create unprocessable entity response
+func (o *BackupsCreateUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *BackupsCreateUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the backups create unprocessable entity response
+func (o *BackupsCreateUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BackupsCreateUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// BackupsCreateInternalServerErrorCode is the HTTP code returned for type BackupsCreateInternalServerError
+const BackupsCreateInternalServerErrorCode int = 500
+
+/*BackupsCreateInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response backupsCreateInternalServerError
+*/
+type BackupsCreateInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBackupsCreateInternalServerError creates BackupsCreateInternalServerError with default headers values
+func NewBackupsCreateInternalServerError() *BackupsCreateInternalServerError {
+
+	return &BackupsCreateInternalServerError{}
+}
+
+// WithPayload adds the payload to the backups create internal server error response
+func (o *BackupsCreateInternalServerError) WithPayload(payload *models.ErrorResponse) *BackupsCreateInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the backups create internal server error response
+func (o *BackupsCreateInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BackupsCreateInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,207 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package backups
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// BackupsGetOKCode is the HTTP code returned for type BackupsGetOK
+const BackupsGetOKCode int = 200
+
+/*BackupsGetOK Found the backup, returned as body
+
+swagger:response backupsGetOK
+*/
+type BackupsGetOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Backup `json:"body,omitempty"`
+}
+
+// NewBackupsGetOK creates BackupsGetOK with default headers values
+func NewBackupsGetOK() *BackupsGetOK {
+
+	return &BackupsGetOK{}
+}
+
+// WithPayload adds the payload to the backups get o k response
+func (o *BackupsGetOK) WithPayload(payload *models.Backup) *BackupsGetOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the backups get o k response
+func (o *BackupsGetOK) SetPayload(payload *models.Backup) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BackupsGetOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// BackupsGetUnauthorizedCode is the HTTP code returned for type BackupsGetUnauthorized
+const BackupsGetUnauthorizedCode int = 401
+
+/*BackupsGetUnauthorized Unauthorized or invalid credentials.
+
+swagger:response backupsGetUnauthorized
+*/
+type BackupsGetUnauthorized struct {
+}
+
+// NewBackupsGetUnauthorized creates BackupsGetUnauthorized with default headers values
+func NewBackupsGetUnauthorized() *BackupsGetUnauthorized {
+
+	return &BackupsGetUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *BackupsGetUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// BackupsGetForbiddenCode is the HTTP code returned for type BackupsGetForbidden
+const BackupsGetForbiddenCode int = 403
+
+/*BackupsGetForbidden Forbidden
+
+swagger:response backupsGetForbidden
+*/
+type BackupsGetForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBackupsGetForbidden creates BackupsGetForbidden with default headers values
+func NewBackupsGetForbidden() *BackupsGetForbidden {
+
+	return &BackupsGetForbidden{}
+}
+
+// WithPayload adds the payload to the backups get forbidden response
+func (o *BackupsGetForbidden) WithPayload(payload *models.ErrorResponse) *BackupsGetForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the backups get forbidden response
+func (o *BackupsGetForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BackupsGetForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// BackupsGetNotFoundCode is the HTTP code returned for type BackupsGetNotFound
+const BackupsGetNotFoundCode int = 404
+
+/*BackupsGetNotFound Not Found - Backup does not exist
+
+swagger:response backupsGetNotFound
+*/
+type BackupsGetNotFound struct {
+}
+
+// NewBackupsGetNotFound creates BackupsGetNotFound with default headers values
+func NewBackupsGetNotFound() *BackupsGetNotFound {
+
+	return &BackupsGetNotFound{}
+}
+
+// WriteResponse to the client
+func (o *BackupsGetNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(404)
+}
+
+// BackupsGetInternalServerErrorCode is the HTTP code returned for type BackupsGetInternalServerError
+const BackupsGetInternalServerErrorCode int = 500
+
+/*BackupsGetInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response backupsGetInternalServerError
+*/
+type BackupsGetInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBackupsGetInternalServerError creates BackupsGetInternalServerError with default headers values
+func NewBackupsGetInternalServerError() *BackupsGetInternalServerError {
+
+	return &BackupsGetInternalServerError{}
+}
+
+// WithPayload adds the payload to the backups get internal server error response
+func (o *BackupsGetInternalServerError) WithPayload(payload *models.ErrorResponse) *BackupsGetInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the backups get internal server error response
+func (o *BackupsGetInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BackupsGetInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
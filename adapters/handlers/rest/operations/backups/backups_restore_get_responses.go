@@ -0,0 +1,207 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package backups
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// BackupsRestoreGetOKCode is the HTTP code returned for type BackupsRestoreGetOK
+const BackupsRestoreGetOKCode int = 200
+
+/*BackupsRestoreGetOK Found the restore, returned as body
+
+swagger:response backupsRestoreGetOK
+*/
+type BackupsRestoreGetOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.BackupRestore `json:"body,omitempty"`
+}
+
+// NewBackupsRestoreGetOK creates BackupsRestoreGetOK with default headers values
+func NewBackupsRestoreGetOK() *BackupsRestoreGetOK {
+
+	return &BackupsRestoreGetOK{}
+}
+
+// WithPayload adds the payload to the backups restore get o k response
+func (o *BackupsRestoreGetOK) WithPayload(payload *models.BackupRestore) *BackupsRestoreGetOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the backups restore get o k response
+func (o *BackupsRestoreGetOK) SetPayload(payload *models.BackupRestore) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BackupsRestoreGetOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// BackupsRestoreGetUnauthorizedCode is the HTTP code returned for type BackupsRestoreGetUnauthorized
+const BackupsRestoreGetUnauthorizedCode int = 401
+
+/*BackupsRestoreGetUnauthorized Unauthorized or invalid credentials.
+
+swagger:response backupsRestoreGetUnauthorized
+*/
+type BackupsRestoreGetUnauthorized struct {
+}
+
+// NewBackupsRestoreGetUnauthorized creates BackupsRestoreGetUnauthorized with default headers values
+func NewBackupsRestoreGetUnauthorized() *BackupsRestoreGetUnauthorized {
+
+	return &BackupsRestoreGetUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *BackupsRestoreGetUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// BackupsRestoreGetForbiddenCode is the HTTP code returned for type BackupsRestoreGetForbidden
+const BackupsRestoreGetForbiddenCode int = 403
+
+/*BackupsRestoreGetForbidden Forbidden
+
+swagger:response backupsRestoreGetForbidden
+*/
+type BackupsRestoreGetForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBackupsRestoreGetForbidden creates BackupsRestoreGetForbidden with default headers values
+func NewBackupsRestoreGetForbidden() *BackupsRestoreGetForbidden {
+
+	return &BackupsRestoreGetForbidden{}
+}
+
+// WithPayload adds the payload to the backups restore get forbidden response
+func (o *BackupsRestoreGetForbidden) WithPayload(payload *models.ErrorResponse) *BackupsRestoreGetForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the backups restore get forbidden response
+func (o *BackupsRestoreGetForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BackupsRestoreGetForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// BackupsRestoreGetNotFoundCode is the HTTP code returned for type BackupsRestoreGetNotFound
+const BackupsRestoreGetNotFoundCode int = 404
+
+/*BackupsRestoreGetNotFound Not Found - Backup does not exist or was never restored
+
+swagger:response backupsRestoreGetNotFound
+*/
+type BackupsRestoreGetNotFound struct {
+}
+
+// NewBackupsRestoreGetNotFound creates BackupsRestoreGetNotFound with default headers values
+func NewBackupsRestoreGetNotFound() *BackupsRestoreGetNotFound {
+
+	return &BackupsRestoreGetNotFound{}
+}
+
+// WriteResponse to the client
+func (o *BackupsRestoreGetNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(404)
+}
+
+// BackupsRestoreGetInternalServerErrorCode is the HTTP code returned for type BackupsRestoreGetInternalServerError
+const BackupsRestoreGetInternalServerErrorCode int = 500
+
+/*BackupsRestoreGetInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response backupsRestoreGetInternalServerError
+*/
+type BackupsRestoreGetInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBackupsRestoreGetInternalServerError creates BackupsRestoreGetInternalServerError with default headers values
+func NewBackupsRestoreGetInternalServerError() *BackupsRestoreGetInternalServerError {
+
+	return &BackupsRestoreGetInternalServerError{}
+}
+
+// WithPayload adds the payload to the backups restore get internal server error response
+func (o *BackupsRestoreGetInternalServerError) WithPayload(payload *models.ErrorResponse) *BackupsRestoreGetInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the backups restore get internal server error response
+func (o *BackupsRestoreGetInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BackupsRestoreGetInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,88 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package batching
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// BatchingActionsDeleteHandlerFunc turns a function with the right signature into a batching actions delete handler
+type BatchingActionsDeleteHandlerFunc func(BatchingActionsDeleteParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn BatchingActionsDeleteHandlerFunc) Handle(params BatchingActionsDeleteParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// BatchingActionsDeleteHandler interface for that can handle valid batching actions delete params
+type BatchingActionsDeleteHandler interface {
+	Handle(BatchingActionsDeleteParams, *models.Principal) middleware.Responder
+}
+
+// NewBatchingActionsDelete creates a new http.Handler for the batching actions delete operation
+func NewBatchingActionsDelete(ctx *middleware.Context, handler BatchingActionsDeleteHandler) *BatchingActionsDelete {
+	return &BatchingActionsDelete{Context: ctx, Handler: handler}
+}
+
+/*BatchingActionsDelete swagger:route DELETE /batching/actions batching actions batchingActionsDelete
+
+Deletes Actions matching a filter as a batch.
+
+Delete all Actions of a class which match the where filter. Use dryRun to find out how many actions would be deleted.
+
+*/
+type BatchingActionsDelete struct {
+	Context *middleware.Context
+	Handler BatchingActionsDeleteHandler
+}
+
+func (o *BatchingActionsDelete) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewBatchingActionsDeleteParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,227 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package batching
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// BatchingActionsDeleteOKCode is the HTTP code returned for type BatchingActionsDeleteOK
+const BatchingActionsDeleteOKCode int = 200
+
+/*BatchingActionsDeleteOK Request succeeded, see response body to get detailed information about the deleted actions.
+
+swagger:response batchingActionsDeleteOK
+*/
+type BatchingActionsDeleteOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.BatchDeleteResponse `json:"body,omitempty"`
+}
+
+// NewBatchingActionsDeleteOK creates BatchingActionsDeleteOK with default headers values
+func NewBatchingActionsDeleteOK() *BatchingActionsDeleteOK {
+
+	return &BatchingActionsDeleteOK{}
+}
+
+// WithPayload adds the payload to the batching actions delete o k response
+func (o *BatchingActionsDeleteOK) WithPayload(payload *models.BatchDeleteResponse) *BatchingActionsDeleteOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the batching actions delete o k response
+func (o *BatchingActionsDeleteOK) SetPayload(payload *models.BatchDeleteResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BatchingActionsDeleteOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// BatchingActionsDeleteUnauthorizedCode is the HTTP code returned for type BatchingActionsDeleteUnauthorized
+const BatchingActionsDeleteUnauthorizedCode int = 401
+
+/*BatchingActionsDeleteUnauthorized Unauthorized or invalid credentials.
+
+swagger:response batchingActionsDeleteUnauthorized
+*/
+type BatchingActionsDeleteUnauthorized struct {
+}
+
+// NewBatchingActionsDeleteUnauthorized creates BatchingActionsDeleteUnauthorized with default headers values
+func NewBatchingActionsDeleteUnauthorized() *BatchingActionsDeleteUnauthorized {
+
+	return &BatchingActionsDeleteUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *BatchingActionsDeleteUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// BatchingActionsDeleteForbiddenCode is the HTTP code returned for type BatchingActionsDeleteForbidden
+const BatchingActionsDeleteForbiddenCode int = 403
+
+/*BatchingActionsDeleteForbidden Forbidden
+
+swagger:response batchingActionsDeleteForbidden
+*/
+type BatchingActionsDeleteForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBatchingActionsDeleteForbidden creates BatchingActionsDeleteForbidden with default headers values
+func NewBatchingActionsDeleteForbidden() *BatchingActionsDeleteForbidden {
+
+	return &BatchingActionsDeleteForbidden{}
+}
+
+// WithPayload adds the payload to the batching actions delete forbidden response
+func (o *BatchingActionsDeleteForbidden) WithPayload(payload *models.ErrorResponse) *BatchingActionsDeleteForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the batching actions delete forbidden response
+func (o *BatchingActionsDeleteForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BatchingActionsDeleteForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// BatchingActionsDeleteUnprocessableEntityCode is the HTTP code returned for type BatchingActionsDeleteUnprocessableEntity
+const BatchingActionsDeleteUnprocessableEntityCode int = 422
+
+/*BatchingActionsDeleteUnprocessableEntity Request body is well-formed (i.e., syntactically correct), but semantically erroneous, e.g. the class doesn't exist or the filter is invalid.
+
+swagger:response batchingActionsDeleteUnprocessableEntity
+*/
+type BatchingActionsDeleteUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBatchingActionsDeleteUnprocessableEntity creates BatchingActionsDeleteUnprocessableEntity with default headers values
+func NewBatchingActionsDeleteUnprocessableEntity() *BatchingActionsDeleteUnprocessableEntity {
+
+	return &BatchingActionsDeleteUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the batching actions delete unprocessable entity response
+func (o *BatchingActionsDeleteUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *BatchingActionsDeleteUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the batching actions delete unprocessable entity response
+func (o *BatchingActionsDeleteUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BatchingActionsDeleteUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// BatchingActionsDeleteInternalServerErrorCode is the HTTP code returned for type BatchingActionsDeleteInternalServerError
+const BatchingActionsDeleteInternalServerErrorCode int = 500
+
+/*BatchingActionsDeleteInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response batchingActionsDeleteInternalServerError
+*/
+type BatchingActionsDeleteInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBatchingActionsDeleteInternalServerError creates BatchingActionsDeleteInternalServerError with default headers values
+func NewBatchingActionsDeleteInternalServerError() *BatchingActionsDeleteInternalServerError {
+
+	return &BatchingActionsDeleteInternalServerError{}
+}
+
+// WithPayload adds the payload to the batching actions delete internal server error response
+func (o *BatchingActionsDeleteInternalServerError) WithPayload(payload *models.ErrorResponse) *BatchingActionsDeleteInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the batching actions delete internal server error response
+func (o *BatchingActionsDeleteInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BatchingActionsDeleteInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
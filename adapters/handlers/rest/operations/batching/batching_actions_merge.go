@@ -0,0 +1,157 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package batching
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+	"strconv"
+
+	errors "github.com/go-openapi/errors"
+	middleware "github.com/go-openapi/runtime/middleware"
+	strfmt "github.com/go-openapi/strfmt"
+	swag "github.com/go-openapi/swag"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// BatchingActionsMergeHandlerFunc turns a function with the right signature into a batching actions merge handler
+type BatchingActionsMergeHandlerFunc func(BatchingActionsMergeParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn BatchingActionsMergeHandlerFunc) Handle(params BatchingActionsMergeParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// BatchingActionsMergeHandler interface for that can handle valid batching actions merge params
+type BatchingActionsMergeHandler interface {
+	Handle(BatchingActionsMergeParams, *models.Principal) middleware.Responder
+}
+
+// NewBatchingActionsMerge creates a new http.Handler for the batching actions merge operation
+func NewBatchingActionsMerge(ctx *middleware.Context, handler BatchingActionsMergeHandler) *BatchingActionsMerge {
+	return &BatchingActionsMerge{Context: ctx, Handler: handler}
+}
+
+/*BatchingActionsMerge swagger:route PATCH /batching/actions batching actions batchingActionsMerge
+
+Updates Actions by merging partial Actions as a batch.
+
+Merge partial Actions into existing Actions in bulk. Every Action must contain its id and class, the class is immutable. Provided schema values are validated and Actions are only revectorized if a value which is part of their vector changes.
+
+*/
+type BatchingActionsMerge struct {
+	Context *middleware.Context
+	Handler BatchingActionsMergeHandler
+}
+
+func (o *BatchingActionsMerge) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewBatchingActionsMergeParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// BatchingActionsMergeBody batching actions merge body
+// swagger:model BatchingActionsMergeBody
+type BatchingActionsMergeBody struct {
+
+	// actions
+	Actions []*models.Action `json:"actions"`
+}
+
+// Validate validates this batching actions merge body
+func (o *BatchingActionsMergeBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateActions(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *BatchingActionsMergeBody) validateActions(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Actions) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(o.Actions); i++ {
+		if swag.IsZero(o.Actions[i]) { // not required
+			continue
+		}
+
+		if o.Actions[i] != nil {
+			if err := o.Actions[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("body" + "." + "actions" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *BatchingActionsMergeBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *BatchingActionsMergeBody) UnmarshalBinary(b []byte) error {
+	var res BatchingActionsMergeBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
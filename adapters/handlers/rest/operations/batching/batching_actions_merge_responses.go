@@ -0,0 +1,230 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package batching
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// BatchingActionsMergeOKCode is the HTTP code returned for type BatchingActionsMergeOK
+const BatchingActionsMergeOKCode int = 200
+
+/*BatchingActionsMergeOK Request succeeded, see response body to get detailed information about each merged Action.
+
+swagger:response batchingActionsMergeOK
+*/
+type BatchingActionsMergeOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.ActionsGetResponse `json:"body,omitempty"`
+}
+
+// NewBatchingActionsMergeOK creates BatchingActionsMergeOK with default headers values
+func NewBatchingActionsMergeOK() *BatchingActionsMergeOK {
+
+	return &BatchingActionsMergeOK{}
+}
+
+// WithPayload adds the payload to the batching actions merge o k response
+func (o *BatchingActionsMergeOK) WithPayload(payload []*models.ActionsGetResponse) *BatchingActionsMergeOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the batching actions merge o k response
+func (o *BatchingActionsMergeOK) SetPayload(payload []*models.ActionsGetResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BatchingActionsMergeOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.ActionsGetResponse, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// BatchingActionsMergeUnauthorizedCode is the HTTP code returned for type BatchingActionsMergeUnauthorized
+const BatchingActionsMergeUnauthorizedCode int = 401
+
+/*BatchingActionsMergeUnauthorized Unauthorized or invalid credentials.
+
+swagger:response batchingActionsMergeUnauthorized
+*/
+type BatchingActionsMergeUnauthorized struct {
+}
+
+// NewBatchingActionsMergeUnauthorized creates BatchingActionsMergeUnauthorized with default headers values
+func NewBatchingActionsMergeUnauthorized() *BatchingActionsMergeUnauthorized {
+
+	return &BatchingActionsMergeUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *BatchingActionsMergeUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// BatchingActionsMergeForbiddenCode is the HTTP code returned for type BatchingActionsMergeForbidden
+const BatchingActionsMergeForbiddenCode int = 403
+
+/*BatchingActionsMergeForbidden Forbidden
+
+swagger:response batchingActionsMergeForbidden
+*/
+type BatchingActionsMergeForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBatchingActionsMergeForbidden creates BatchingActionsMergeForbidden with default headers values
+func NewBatchingActionsMergeForbidden() *BatchingActionsMergeForbidden {
+
+	return &BatchingActionsMergeForbidden{}
+}
+
+// WithPayload adds the payload to the batching actions merge forbidden response
+func (o *BatchingActionsMergeForbidden) WithPayload(payload *models.ErrorResponse) *BatchingActionsMergeForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the batching actions merge forbidden response
+func (o *BatchingActionsMergeForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BatchingActionsMergeForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// BatchingActionsMergeUnprocessableEntityCode is the HTTP code returned for type BatchingActionsMergeUnprocessableEntity
+const BatchingActionsMergeUnprocessableEntityCode int = 422
+
+/*BatchingActionsMergeUnprocessableEntity Request body is well-formed (i.e., syntactically correct), but semantically erroneous.
+
+swagger:response batchingActionsMergeUnprocessableEntity
+*/
+type BatchingActionsMergeUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBatchingActionsMergeUnprocessableEntity creates BatchingActionsMergeUnprocessableEntity with default headers values
+func NewBatchingActionsMergeUnprocessableEntity() *BatchingActionsMergeUnprocessableEntity {
+
+	return &BatchingActionsMergeUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the batching actions merge unprocessable entity response
+func (o *BatchingActionsMergeUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *BatchingActionsMergeUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the batching actions merge unprocessable entity response
+func (o *BatchingActionsMergeUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BatchingActionsMergeUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// BatchingActionsMergeInternalServerErrorCode is the HTTP code returned for type BatchingActionsMergeInternalServerError
+const BatchingActionsMergeInternalServerErrorCode int = 500
+
+/*BatchingActionsMergeInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response batchingActionsMergeInternalServerError
+*/
+type BatchingActionsMergeInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBatchingActionsMergeInternalServerError creates BatchingActionsMergeInternalServerError with default headers values
+func NewBatchingActionsMergeInternalServerError() *BatchingActionsMergeInternalServerError {
+
+	return &BatchingActionsMergeInternalServerError{}
+}
+
+// WithPayload adds the payload to the batching actions merge internal server error response
+func (o *BatchingActionsMergeInternalServerError) WithPayload(payload *models.ErrorResponse) *BatchingActionsMergeInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the batching actions merge internal server error response
+func (o *BatchingActionsMergeInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BatchingActionsMergeInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
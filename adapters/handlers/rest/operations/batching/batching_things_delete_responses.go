@@ -0,0 +1,227 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package batching
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// BatchingThingsDeleteOKCode is the HTTP code returned for type BatchingThingsDeleteOK
+const BatchingThingsDeleteOKCode int = 200
+
+/*BatchingThingsDeleteOK Request succeeded, see response body to get detailed information about the deleted things.
+
+swagger:response batchingThingsDeleteOK
+*/
+type BatchingThingsDeleteOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.BatchDeleteResponse `json:"body,omitempty"`
+}
+
+// NewBatchingThingsDeleteOK creates BatchingThingsDeleteOK with default headers values
+func NewBatchingThingsDeleteOK() *BatchingThingsDeleteOK {
+
+	return &BatchingThingsDeleteOK{}
+}
+
+// WithPayload adds the payload to the batching things delete o k response
+func (o *BatchingThingsDeleteOK) WithPayload(payload *models.BatchDeleteResponse) *BatchingThingsDeleteOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the batching things delete o k response
+func (o *BatchingThingsDeleteOK) SetPayload(payload *models.BatchDeleteResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BatchingThingsDeleteOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// BatchingThingsDeleteUnauthorizedCode is the HTTP code returned for type BatchingThingsDeleteUnauthorized
+const BatchingThingsDeleteUnauthorizedCode int = 401
+
+/*BatchingThingsDeleteUnauthorized Unauthorized or invalid credentials.
+
+swagger:response batchingThingsDeleteUnauthorized
+*/
+type BatchingThingsDeleteUnauthorized struct {
+}
+
+// NewBatchingThingsDeleteUnauthorized creates BatchingThingsDeleteUnauthorized with default headers values
+func NewBatchingThingsDeleteUnauthorized() *BatchingThingsDeleteUnauthorized {
+
+	return &BatchingThingsDeleteUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *BatchingThingsDeleteUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// BatchingThingsDeleteForbiddenCode is the HTTP code returned for type BatchingThingsDeleteForbidden
+const BatchingThingsDeleteForbiddenCode int = 403
+
+/*BatchingThingsDeleteForbidden Forbidden
+
+swagger:response batchingThingsDeleteForbidden
+*/
+type BatchingThingsDeleteForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBatchingThingsDeleteForbidden creates BatchingThingsDeleteForbidden with default headers values
+func NewBatchingThingsDeleteForbidden() *BatchingThingsDeleteForbidden {
+
+	return &BatchingThingsDeleteForbidden{}
+}
+
+// WithPayload adds the payload to the batching things delete forbidden response
+func (o *BatchingThingsDeleteForbidden) WithPayload(payload *models.ErrorResponse) *BatchingThingsDeleteForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the batching things delete forbidden response
+func (o *BatchingThingsDeleteForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BatchingThingsDeleteForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// BatchingThingsDeleteUnprocessableEntityCode is the HTTP code returned for type BatchingThingsDeleteUnprocessableEntity
+const BatchingThingsDeleteUnprocessableEntityCode int = 422
+
+/*BatchingThingsDeleteUnprocessableEntity Request body is well-formed (i.e., syntactically correct), but semantically erroneous, e.g. the class doesn't exist or the filter is invalid.
+
+swagger:response batchingThingsDeleteUnprocessableEntity
+*/
+type BatchingThingsDeleteUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBatchingThingsDeleteUnprocessableEntity creates BatchingThingsDeleteUnprocessableEntity with default headers values
+func NewBatchingThingsDeleteUnprocessableEntity() *BatchingThingsDeleteUnprocessableEntity {
+
+	return &BatchingThingsDeleteUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the batching things delete unprocessable entity response
+func (o *BatchingThingsDeleteUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *BatchingThingsDeleteUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the batching things delete unprocessable entity response
+func (o *BatchingThingsDeleteUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BatchingThingsDeleteUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// BatchingThingsDeleteInternalServerErrorCode is the HTTP code returned for type BatchingThingsDeleteInternalServerError
+const BatchingThingsDeleteInternalServerErrorCode int = 500
+
+/*BatchingThingsDeleteInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response batchingThingsDeleteInternalServerError
+*/
+type BatchingThingsDeleteInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBatchingThingsDeleteInternalServerError creates BatchingThingsDeleteInternalServerError with default headers values
+func NewBatchingThingsDeleteInternalServerError() *BatchingThingsDeleteInternalServerError {
+
+	return &BatchingThingsDeleteInternalServerError{}
+}
+
+// WithPayload adds the payload to the batching things delete internal server error response
+func (o *BatchingThingsDeleteInternalServerError) WithPayload(payload *models.ErrorResponse) *BatchingThingsDeleteInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the batching things delete internal server error response
+func (o *BatchingThingsDeleteInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BatchingThingsDeleteInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,157 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package batching
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+	"strconv"
+
+	errors "github.com/go-openapi/errors"
+	middleware "github.com/go-openapi/runtime/middleware"
+	strfmt "github.com/go-openapi/strfmt"
+	swag "github.com/go-openapi/swag"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// BatchingThingsMergeHandlerFunc turns a function with the right signature into a batching things merge handler
+type BatchingThingsMergeHandlerFunc func(BatchingThingsMergeParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn BatchingThingsMergeHandlerFunc) Handle(params BatchingThingsMergeParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// BatchingThingsMergeHandler interface for that can handle valid batching things merge params
+type BatchingThingsMergeHandler interface {
+	Handle(BatchingThingsMergeParams, *models.Principal) middleware.Responder
+}
+
+// NewBatchingThingsMerge creates a new http.Handler for the batching things merge operation
+func NewBatchingThingsMerge(ctx *middleware.Context, handler BatchingThingsMergeHandler) *BatchingThingsMerge {
+	return &BatchingThingsMerge{Context: ctx, Handler: handler}
+}
+
+/*BatchingThingsMerge swagger:route PATCH /batching/things batching things batchingThingsMerge
+
+Updates Things by merging partial Things as a batch.
+
+Merge partial Things into existing Things in bulk. Every Thing must contain its id and class, the class is immutable. Provided schema values are validated and Things are only revectorized if a value which is part of their vector changes.
+
+*/
+type BatchingThingsMerge struct {
+	Context *middleware.Context
+	Handler BatchingThingsMergeHandler
+}
+
+func (o *BatchingThingsMerge) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewBatchingThingsMergeParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// BatchingThingsMergeBody batching things merge body
+// swagger:model BatchingThingsMergeBody
+type BatchingThingsMergeBody struct {
+
+	// things
+	Things []*models.Thing `json:"things"`
+}
+
+// Validate validates this batching things merge body
+func (o *BatchingThingsMergeBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateThings(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *BatchingThingsMergeBody) validateThings(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Things) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(o.Things); i++ {
+		if swag.IsZero(o.Things[i]) { // not required
+			continue
+		}
+
+		if o.Things[i] != nil {
+			if err := o.Things[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("body" + "." + "things" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *BatchingThingsMergeBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *BatchingThingsMergeBody) UnmarshalBinary(b []byte) error {
+	var res BatchingThingsMergeBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
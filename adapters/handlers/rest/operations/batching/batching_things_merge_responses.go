@@ -0,0 +1,230 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package batching
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// BatchingThingsMergeOKCode is the HTTP code returned for type BatchingThingsMergeOK
+const BatchingThingsMergeOKCode int = 200
+
+/*BatchingThingsMergeOK Request succeeded, see response body to get detailed information about each merged Thing.
+
+swagger:response batchingThingsMergeOK
+*/
+type BatchingThingsMergeOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.ThingsGetResponse `json:"body,omitempty"`
+}
+
+// NewBatchingThingsMergeOK creates BatchingThingsMergeOK with default headers values
+func NewBatchingThingsMergeOK() *BatchingThingsMergeOK {
+
+	return &BatchingThingsMergeOK{}
+}
+
+// WithPayload adds the payload to the batching things merge o k response
+func (o *BatchingThingsMergeOK) WithPayload(payload []*models.ThingsGetResponse) *BatchingThingsMergeOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the batching things merge o k response
+func (o *BatchingThingsMergeOK) SetPayload(payload []*models.ThingsGetResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BatchingThingsMergeOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.ThingsGetResponse, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// BatchingThingsMergeUnauthorizedCode is the HTTP code returned for type BatchingThingsMergeUnauthorized
+const BatchingThingsMergeUnauthorizedCode int = 401
+
+/*BatchingThingsMergeUnauthorized Unauthorized or invalid credentials.
+
+swagger:response batchingThingsMergeUnauthorized
+*/
+type BatchingThingsMergeUnauthorized struct {
+}
+
+// NewBatchingThingsMergeUnauthorized creates BatchingThingsMergeUnauthorized with default headers values
+func NewBatchingThingsMergeUnauthorized() *BatchingThingsMergeUnauthorized {
+
+	return &BatchingThingsMergeUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *BatchingThingsMergeUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// BatchingThingsMergeForbiddenCode is the HTTP code returned for type BatchingThingsMergeForbidden
+const BatchingThingsMergeForbiddenCode int = 403
+
+/*BatchingThingsMergeForbidden Forbidden
+
+swagger:response batchingThingsMergeForbidden
+*/
+type BatchingThingsMergeForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBatchingThingsMergeForbidden creates BatchingThingsMergeForbidden with default headers values
+func NewBatchingThingsMergeForbidden() *BatchingThingsMergeForbidden {
+
+	return &BatchingThingsMergeForbidden{}
+}
+
+// WithPayload adds the payload to the batching things merge forbidden response
+func (o *BatchingThingsMergeForbidden) WithPayload(payload *models.ErrorResponse) *BatchingThingsMergeForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the batching things merge forbidden response
+func (o *BatchingThingsMergeForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BatchingThingsMergeForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// BatchingThingsMergeUnprocessableEntityCode is the HTTP code returned for type BatchingThingsMergeUnprocessableEntity
+const BatchingThingsMergeUnprocessableEntityCode int = 422
+
+/*BatchingThingsMergeUnprocessableEntity Request body is well-formed (i.e., syntactically correct), but semantically erroneous.
+
+swagger:response batchingThingsMergeUnprocessableEntity
+*/
+type BatchingThingsMergeUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBatchingThingsMergeUnprocessableEntity creates BatchingThingsMergeUnprocessableEntity with default headers values
+func NewBatchingThingsMergeUnprocessableEntity() *BatchingThingsMergeUnprocessableEntity {
+
+	return &BatchingThingsMergeUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the batching things merge unprocessable entity response
+func (o *BatchingThingsMergeUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *BatchingThingsMergeUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the batching things merge unprocessable entity response
+func (o *BatchingThingsMergeUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BatchingThingsMergeUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// BatchingThingsMergeInternalServerErrorCode is the HTTP code returned for type BatchingThingsMergeInternalServerError
+const BatchingThingsMergeInternalServerErrorCode int = 500
+
+/*BatchingThingsMergeInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response batchingThingsMergeInternalServerError
+*/
+type BatchingThingsMergeInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBatchingThingsMergeInternalServerError creates BatchingThingsMergeInternalServerError with default headers values
+func NewBatchingThingsMergeInternalServerError() *BatchingThingsMergeInternalServerError {
+
+	return &BatchingThingsMergeInternalServerError{}
+}
+
+// WithPayload adds the payload to the batching things merge internal server error response
+func (o *BatchingThingsMergeInternalServerError) WithPayload(payload *models.ErrorResponse) *BatchingThingsMergeInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the batching things merge internal server error response
+func (o *BatchingThingsMergeInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BatchingThingsMergeInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,251 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package classifications
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// ClassificationsCancelAcceptedCode is the HTTP code returned for type ClassificationsCancelAccepted
+const ClassificationsCancelAcceptedCode int = 202
+
+/*ClassificationsCancelAccepted Cancellation requested, the classification is returned as body
+
+swagger:response classificationsCancelAccepted
+*/
+type ClassificationsCancelAccepted struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Classification `json:"body,omitempty"`
+}
+
+// NewClassificationsCancelAccepted creates ClassificationsCancelAccepted with default headers values
+func NewClassificationsCancelAccepted() *ClassificationsCancelAccepted {
+
+	return &ClassificationsCancelAccepted{}
+}
+
+// WithPayload adds the payload to the classifications cancel accepted response
+func (o *ClassificationsCancelAccepted) WithPayload(payload *models.Classification) *ClassificationsCancelAccepted {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the classifications cancel accepted response
+func (o *ClassificationsCancelAccepted) SetPayload(payload *models.Classification) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ClassificationsCancelAccepted) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(202)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ClassificationsCancelUnauthorizedCode is the HTTP code returned for type ClassificationsCancelUnauthorized
+const ClassificationsCancelUnauthorizedCode int = 401
+
+/*ClassificationsCancelUnauthorized Unauthorized or invalid credentials.
+
+swagger:response classificationsCancelUnauthorized
+*/
+type ClassificationsCancelUnauthorized struct {
+}
+
+// NewClassificationsCancelUnauthorized creates ClassificationsCancelUnauthorized with default headers values
+func NewClassificationsCancelUnauthorized() *ClassificationsCancelUnauthorized {
+
+	return &ClassificationsCancelUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *ClassificationsCancelUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// ClassificationsCancelForbiddenCode is the HTTP code returned for type ClassificationsCancelForbidden
+const ClassificationsCancelForbiddenCode int = 403
+
+/*ClassificationsCancelForbidden Forbidden
+
+swagger:response classificationsCancelForbidden
+*/
+type ClassificationsCancelForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewClassificationsCancelForbidden creates ClassificationsCancelForbidden with default headers values
+func NewClassificationsCancelForbidden() *ClassificationsCancelForbidden {
+
+	return &ClassificationsCancelForbidden{}
+}
+
+// WithPayload adds the payload to the classifications cancel forbidden response
+func (o *ClassificationsCancelForbidden) WithPayload(payload *models.ErrorResponse) *ClassificationsCancelForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the classifications cancel forbidden response
+func (o *ClassificationsCancelForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ClassificationsCancelForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ClassificationsCancelNotFoundCode is the HTTP code returned for type ClassificationsCancelNotFound
+const ClassificationsCancelNotFoundCode int = 404
+
+/*ClassificationsCancelNotFound Not Found - Classification does not exist
+
+swagger:response classificationsCancelNotFound
+*/
+type ClassificationsCancelNotFound struct {
+}
+
+// NewClassificationsCancelNotFound creates ClassificationsCancelNotFound with default headers values
+func NewClassificationsCancelNotFound() *ClassificationsCancelNotFound {
+
+	return &ClassificationsCancelNotFound{}
+}
+
+// WriteResponse to the client
+func (o *ClassificationsCancelNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(404)
+}
+
+// ClassificationsCancelConflictCode is the HTTP code returned for type ClassificationsCancelConflict
+const ClassificationsCancelConflictCode int = 409
+
+/*ClassificationsCancelConflict The classification is not running
+
+swagger:response classificationsCancelConflict
+*/
+type ClassificationsCancelConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewClassificationsCancelConflict creates ClassificationsCancelConflict with default headers values
+func NewClassificationsCancelConflict() *ClassificationsCancelConflict {
+
+	return &ClassificationsCancelConflict{}
+}
+
+// WithPayload adds the payload to the classifications cancel conflict response
+func (o *ClassificationsCancelConflict) WithPayload(payload *models.ErrorResponse) *ClassificationsCancelConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the classifications cancel conflict response
+func (o *ClassificationsCancelConflict) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ClassificationsCancelConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ClassificationsCancelInternalServerErrorCode is the HTTP code returned for type ClassificationsCancelInternalServerError
+const ClassificationsCancelInternalServerErrorCode int = 500
+
+/*ClassificationsCancelInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response classificationsCancelInternalServerError
+*/
+type ClassificationsCancelInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewClassificationsCancelInternalServerError creates ClassificationsCancelInternalServerError with default headers values
+func NewClassificationsCancelInternalServerError() *ClassificationsCancelInternalServerError {
+
+	return &ClassificationsCancelInternalServerError{}
+}
+
+// WithPayload adds the payload to the classifications cancel internal server error response
+func (o *ClassificationsCancelInternalServerError) WithPayload(payload *models.ErrorResponse) *ClassificationsCancelInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the classifications cancel internal server error response
+func (o *ClassificationsCancelInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ClassificationsCancelInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,183 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package classifications
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// ClassificationsListOKCode is the HTTP code returned for type ClassificationsListOK
+const ClassificationsListOKCode int = 200
+
+/*ClassificationsListOK Successful response.
+
+swagger:response classificationsListOK
+*/
+type ClassificationsListOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ClassificationsListResponse `json:"body,omitempty"`
+}
+
+// NewClassificationsListOK creates ClassificationsListOK with default headers values
+func NewClassificationsListOK() *ClassificationsListOK {
+
+	return &ClassificationsListOK{}
+}
+
+// WithPayload adds the payload to the classifications list o k response
+func (o *ClassificationsListOK) WithPayload(payload *models.ClassificationsListResponse) *ClassificationsListOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the classifications list o k response
+func (o *ClassificationsListOK) SetPayload(payload *models.ClassificationsListResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ClassificationsListOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ClassificationsListUnauthorizedCode is the HTTP code returned for type ClassificationsListUnauthorized
+const ClassificationsListUnauthorizedCode int = 401
+
+/*ClassificationsListUnauthorized Unauthorized or invalid credentials.
+
+swagger:response classificationsListUnauthorized
+*/
+type ClassificationsListUnauthorized struct {
+}
+
+// NewClassificationsListUnauthorized creates ClassificationsListUnauthorized with default headers values
+func NewClassificationsListUnauthorized() *ClassificationsListUnauthorized {
+
+	return &ClassificationsListUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *ClassificationsListUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// ClassificationsListForbiddenCode is the HTTP code returned for type ClassificationsListForbidden
+const ClassificationsListForbiddenCode int = 403
+
+/*ClassificationsListForbidden Forbidden
+
+swagger:response classificationsListForbidden
+*/
+type ClassificationsListForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewClassificationsListForbidden creates ClassificationsListForbidden with default headers values
+func NewClassificationsListForbidden() *ClassificationsListForbidden {
+
+	return &ClassificationsListForbidden{}
+}
+
+// WithPayload adds the payload to the classifications list forbidden response
+func (o *ClassificationsListForbidden) WithPayload(payload *models.ErrorResponse) *ClassificationsListForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the classifications list forbidden response
+func (o *ClassificationsListForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ClassificationsListForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ClassificationsListInternalServerErrorCode is the HTTP code returned for type ClassificationsListInternalServerError
+const ClassificationsListInternalServerErrorCode int = 500
+
+/*ClassificationsListInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response classificationsListInternalServerError
+*/
+type ClassificationsListInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewClassificationsListInternalServerError creates ClassificationsListInternalServerError with default headers values
+func NewClassificationsListInternalServerError() *ClassificationsListInternalServerError {
+
+	return &ClassificationsListInternalServerError{}
+}
+
+// WithPayload adds the payload to the classifications list internal server error response
+func (o *ClassificationsListInternalServerError) WithPayload(payload *models.ErrorResponse) *ClassificationsListInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the classifications list internal server error response
+func (o *ClassificationsListInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ClassificationsListInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
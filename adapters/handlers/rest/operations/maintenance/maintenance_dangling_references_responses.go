@@ -0,0 +1,227 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package maintenance
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// MaintenanceDanglingReferencesOKCode is the HTTP code returned for type MaintenanceDanglingReferencesOK
+const MaintenanceDanglingReferencesOKCode int = 200
+
+/*MaintenanceDanglingReferencesOK The scan finished, see the response body for the dangling references.
+
+swagger:response maintenanceDanglingReferencesOK
+*/
+type MaintenanceDanglingReferencesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.DanglingReferencesReport `json:"body,omitempty"`
+}
+
+// NewMaintenanceDanglingReferencesOK creates MaintenanceDanglingReferencesOK with default headers values
+func NewMaintenanceDanglingReferencesOK() *MaintenanceDanglingReferencesOK {
+
+	return &MaintenanceDanglingReferencesOK{}
+}
+
+// WithPayload adds the payload to the maintenance dangling references o k response
+func (o *MaintenanceDanglingReferencesOK) WithPayload(payload *models.DanglingReferencesReport) *MaintenanceDanglingReferencesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the maintenance dangling references o k response
+func (o *MaintenanceDanglingReferencesOK) SetPayload(payload *models.DanglingReferencesReport) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *MaintenanceDanglingReferencesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// MaintenanceDanglingReferencesUnauthorizedCode is the HTTP code returned for type MaintenanceDanglingReferencesUnauthorized
+const MaintenanceDanglingReferencesUnauthorizedCode int = 401
+
+/*MaintenanceDanglingReferencesUnauthorized Unauthorized or invalid credentials.
+
+swagger:response maintenanceDanglingReferencesUnauthorized
+*/
+type MaintenanceDanglingReferencesUnauthorized struct {
+}
+
+// NewMaintenanceDanglingReferencesUnauthorized creates MaintenanceDanglingReferencesUnauthorized with default headers values
+func NewMaintenanceDanglingReferencesUnauthorized() *MaintenanceDanglingReferencesUnauthorized {
+
+	return &MaintenanceDanglingReferencesUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *MaintenanceDanglingReferencesUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// MaintenanceDanglingReferencesForbiddenCode is the HTTP code returned for type MaintenanceDanglingReferencesForbidden
+const MaintenanceDanglingReferencesForbiddenCode int = 403
+
+/*MaintenanceDanglingReferencesForbidden Forbidden
+
+swagger:response maintenanceDanglingReferencesForbidden
+*/
+type MaintenanceDanglingReferencesForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewMaintenanceDanglingReferencesForbidden creates MaintenanceDanglingReferencesForbidden with default headers values
+func NewMaintenanceDanglingReferencesForbidden() *MaintenanceDanglingReferencesForbidden {
+
+	return &MaintenanceDanglingReferencesForbidden{}
+}
+
+// WithPayload adds the payload to the maintenance dangling references forbidden response
+func (o *MaintenanceDanglingReferencesForbidden) WithPayload(payload *models.ErrorResponse) *MaintenanceDanglingReferencesForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the maintenance dangling references forbidden response
+func (o *MaintenanceDanglingReferencesForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *MaintenanceDanglingReferencesForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// MaintenanceDanglingReferencesUnprocessableEntityCode is the HTTP code returned for type MaintenanceDanglingReferencesUnprocessableEntity
+const MaintenanceDanglingReferencesUnprocessableEntityCode int = 422
+
+/*MaintenanceDanglingReferencesUnprocessableEntity Request body is well-formed (i.e., syntactically correct), but semantically erroneous, e.g. the class doesn't exist.
+
+swagger:response maintenanceDanglingReferencesUnprocessableEntity
+*/
+type MaintenanceDanglingReferencesUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewMaintenanceDanglingReferencesUnprocessableEntity creates MaintenanceDanglingReferencesUnprocessableEntity with default headers values
+func NewMaintenanceDanglingReferencesUnprocessableEntity() *MaintenanceDanglingReferencesUnprocessableEntity {
+
+	return &MaintenanceDanglingReferencesUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the maintenance dangling references unprocessable entity response
+func (o *MaintenanceDanglingReferencesUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *MaintenanceDanglingReferencesUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the maintenance dangling references unprocessable entity response
+func (o *MaintenanceDanglingReferencesUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *MaintenanceDanglingReferencesUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// MaintenanceDanglingReferencesInternalServerErrorCode is the HTTP code returned for type MaintenanceDanglingReferencesInternalServerError
+const MaintenanceDanglingReferencesInternalServerErrorCode int = 500
+
+/*MaintenanceDanglingReferencesInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response maintenanceDanglingReferencesInternalServerError
+*/
+type MaintenanceDanglingReferencesInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewMaintenanceDanglingReferencesInternalServerError creates MaintenanceDanglingReferencesInternalServerError with default headers values
+func NewMaintenanceDanglingReferencesInternalServerError() *MaintenanceDanglingReferencesInternalServerError {
+
+	return &MaintenanceDanglingReferencesInternalServerError{}
+}
+
+// WithPayload adds the payload to the maintenance dangling references internal server error response
+func (o *MaintenanceDanglingReferencesInternalServerError) WithPayload(payload *models.ErrorResponse) *MaintenanceDanglingReferencesInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the maintenance dangling references internal server error response
+func (o *MaintenanceDanglingReferencesInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *MaintenanceDanglingReferencesInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
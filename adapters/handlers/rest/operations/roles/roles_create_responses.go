@@ -0,0 +1,227 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package roles
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// RolesCreateCreatedCode is the HTTP code returned for type RolesCreateCreated
+const RolesCreateCreatedCode int = 201
+
+/*RolesCreateCreated Role created.
+
+swagger:response rolesCreateCreated
+*/
+type RolesCreateCreated struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Role `json:"body,omitempty"`
+}
+
+// NewRolesCreateCreated creates RolesCreateCreated with default headers values
+func NewRolesCreateCreated() *RolesCreateCreated {
+
+	return &RolesCreateCreated{}
+}
+
+// WithPayload adds the payload to the roles create created response
+func (o *RolesCreateCreated) WithPayload(payload *models.Role) *RolesCreateCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the roles create created response
+func (o *RolesCreateCreated) SetPayload(payload *models.Role) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RolesCreateCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(201)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RolesCreateUnauthorizedCode is the HTTP code returned for type RolesCreateUnauthorized
+const RolesCreateUnauthorizedCode int = 401
+
+/*RolesCreateUnauthorized Unauthorized or invalid credentials.
+
+swagger:response rolesCreateUnauthorized
+*/
+type RolesCreateUnauthorized struct {
+}
+
+// NewRolesCreateUnauthorized creates RolesCreateUnauthorized with default headers values
+func NewRolesCreateUnauthorized() *RolesCreateUnauthorized {
+
+	return &RolesCreateUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *RolesCreateUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// RolesCreateForbiddenCode is the HTTP code returned for type RolesCreateForbidden
+const RolesCreateForbiddenCode int = 403
+
+/*RolesCreateForbidden Forbidden
+
+swagger:response rolesCreateForbidden
+*/
+type RolesCreateForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewRolesCreateForbidden creates RolesCreateForbidden with default headers values
+func NewRolesCreateForbidden() *RolesCreateForbidden {
+
+	return &RolesCreateForbidden{}
+}
+
+// WithPayload adds the payload to the roles create forbidden response
+func (o *RolesCreateForbidden) WithPayload(payload *models.ErrorResponse) *RolesCreateForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the roles create forbidden response
+func (o *RolesCreateForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RolesCreateForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RolesCreateUnprocessableEntityCode is the HTTP code returned for type RolesCreateUnprocessableEntity
+const RolesCreateUnprocessableEntityCode int = 422
+
+/*RolesCreateUnprocessableEntity Invalid role or rbac authorization is not enabled.
+
+swagger:response rolesCreateUnprocessableEntity
+*/
+type RolesCreateUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewRolesCreateUnprocessableEntity creates RolesCreateUnprocessableEntity with default headers values
+func NewRolesCreateUnprocessableEntity() *RolesCreateUnprocessableEntity {
+
+	return &RolesCreateUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the roles create unprocessable entity response
+func (o *RolesCreateUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *RolesCreateUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the roles create unprocessable entity response
+func (o *RolesCreateUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RolesCreateUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RolesCreateInternalServerErrorCode is the HTTP code returned for type RolesCreateInternalServerError
+const RolesCreateInternalServerErrorCode int = 500
+
+/*RolesCreateInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response rolesCreateInternalServerError
+*/
+type RolesCreateInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewRolesCreateInternalServerError creates RolesCreateInternalServerError with default headers values
+func NewRolesCreateInternalServerError() *RolesCreateInternalServerError {
+
+	return &RolesCreateInternalServerError{}
+}
+
+// WithPayload adds the payload to the roles create internal server error response
+func (o *RolesCreateInternalServerError) WithPayload(payload *models.ErrorResponse) *RolesCreateInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the roles create internal server error response
+func (o *RolesCreateInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RolesCreateInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
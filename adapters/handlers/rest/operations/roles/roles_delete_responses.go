@@ -0,0 +1,231 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package roles
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// RolesDeleteNoContentCode is the HTTP code returned for type RolesDeleteNoContent
+const RolesDeleteNoContentCode int = 204
+
+/*RolesDeleteNoContent Role deleted.
+
+swagger:response rolesDeleteNoContent
+*/
+type RolesDeleteNoContent struct {
+}
+
+// NewRolesDeleteNoContent creates RolesDeleteNoContent with default headers values
+func NewRolesDeleteNoContent() *RolesDeleteNoContent {
+
+	return &RolesDeleteNoContent{}
+}
+
+// WriteResponse to the client
+func (o *RolesDeleteNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+// RolesDeleteUnauthorizedCode is the HTTP code returned for type RolesDeleteUnauthorized
+const RolesDeleteUnauthorizedCode int = 401
+
+/*RolesDeleteUnauthorized Unauthorized or invalid credentials.
+
+swagger:response rolesDeleteUnauthorized
+*/
+type RolesDeleteUnauthorized struct {
+}
+
+// NewRolesDeleteUnauthorized creates RolesDeleteUnauthorized with default headers values
+func NewRolesDeleteUnauthorized() *RolesDeleteUnauthorized {
+
+	return &RolesDeleteUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *RolesDeleteUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// RolesDeleteForbiddenCode is the HTTP code returned for type RolesDeleteForbidden
+const RolesDeleteForbiddenCode int = 403
+
+/*RolesDeleteForbidden Forbidden
+
+swagger:response rolesDeleteForbidden
+*/
+type RolesDeleteForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewRolesDeleteForbidden creates RolesDeleteForbidden with default headers values
+func NewRolesDeleteForbidden() *RolesDeleteForbidden {
+
+	return &RolesDeleteForbidden{}
+}
+
+// WithPayload adds the payload to the roles delete forbidden response
+func (o *RolesDeleteForbidden) WithPayload(payload *models.ErrorResponse) *RolesDeleteForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the roles delete forbidden response
+func (o *RolesDeleteForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RolesDeleteForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RolesDeleteNotFoundCode is the HTTP code returned for type RolesDeleteNotFound
+const RolesDeleteNotFoundCode int = 404
+
+/*RolesDeleteNotFound Not Found - Role does not exist
+
+swagger:response rolesDeleteNotFound
+*/
+type RolesDeleteNotFound struct {
+}
+
+// NewRolesDeleteNotFound creates RolesDeleteNotFound with default headers values
+func NewRolesDeleteNotFound() *RolesDeleteNotFound {
+
+	return &RolesDeleteNotFound{}
+}
+
+// WriteResponse to the client
+func (o *RolesDeleteNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(404)
+}
+
+// RolesDeleteUnprocessableEntityCode is the HTTP code returned for type RolesDeleteUnprocessableEntity
+const RolesDeleteUnprocessableEntityCode int = 422
+
+/*RolesDeleteUnprocessableEntity Invalid role or rbac authorization is not enabled.
+
+swagger:response rolesDeleteUnprocessableEntity
+*/
+type RolesDeleteUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewRolesDeleteUnprocessableEntity creates RolesDeleteUnprocessableEntity with default headers values
+func NewRolesDeleteUnprocessableEntity() *RolesDeleteUnprocessableEntity {
+
+	return &RolesDeleteUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the roles delete unprocessable entity response
+func (o *RolesDeleteUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *RolesDeleteUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the roles delete unprocessable entity response
+func (o *RolesDeleteUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RolesDeleteUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RolesDeleteInternalServerErrorCode is the HTTP code returned for type RolesDeleteInternalServerError
+const RolesDeleteInternalServerErrorCode int = 500
+
+/*RolesDeleteInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response rolesDeleteInternalServerError
+*/
+type RolesDeleteInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewRolesDeleteInternalServerError creates RolesDeleteInternalServerError with default headers values
+func NewRolesDeleteInternalServerError() *RolesDeleteInternalServerError {
+
+	return &RolesDeleteInternalServerError{}
+}
+
+// WithPayload adds the payload to the roles delete internal server error response
+func (o *RolesDeleteInternalServerError) WithPayload(payload *models.ErrorResponse) *RolesDeleteInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the roles delete internal server error response
+func (o *RolesDeleteInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RolesDeleteInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,251 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package roles
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// RolesGetOKCode is the HTTP code returned for type RolesGetOK
+const RolesGetOKCode int = 200
+
+/*RolesGetOK Found the role, returned as body.
+
+swagger:response rolesGetOK
+*/
+type RolesGetOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Role `json:"body,omitempty"`
+}
+
+// NewRolesGetOK creates RolesGetOK with default headers values
+func NewRolesGetOK() *RolesGetOK {
+
+	return &RolesGetOK{}
+}
+
+// WithPayload adds the payload to the roles get o k response
+func (o *RolesGetOK) WithPayload(payload *models.Role) *RolesGetOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the roles get o k response
+func (o *RolesGetOK) SetPayload(payload *models.Role) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RolesGetOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RolesGetUnauthorizedCode is the HTTP code returned for type RolesGetUnauthorized
+const RolesGetUnauthorizedCode int = 401
+
+/*RolesGetUnauthorized Unauthorized or invalid credentials.
+
+swagger:response rolesGetUnauthorized
+*/
+type RolesGetUnauthorized struct {
+}
+
+// NewRolesGetUnauthorized creates RolesGetUnauthorized with default headers values
+func NewRolesGetUnauthorized() *RolesGetUnauthorized {
+
+	return &RolesGetUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *RolesGetUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// RolesGetForbiddenCode is the HTTP code returned for type RolesGetForbidden
+const RolesGetForbiddenCode int = 403
+
+/*RolesGetForbidden Forbidden
+
+swagger:response rolesGetForbidden
+*/
+type RolesGetForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewRolesGetForbidden creates RolesGetForbidden with default headers values
+func NewRolesGetForbidden() *RolesGetForbidden {
+
+	return &RolesGetForbidden{}
+}
+
+// WithPayload adds the payload to the roles get forbidden response
+func (o *RolesGetForbidden) WithPayload(payload *models.ErrorResponse) *RolesGetForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the roles get forbidden response
+func (o *RolesGetForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RolesGetForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RolesGetNotFoundCode is the HTTP code returned for type RolesGetNotFound
+const RolesGetNotFoundCode int = 404
+
+/*RolesGetNotFound Not Found - Role does not exist
+
+swagger:response rolesGetNotFound
+*/
+type RolesGetNotFound struct {
+}
+
+// NewRolesGetNotFound creates RolesGetNotFound with default headers values
+func NewRolesGetNotFound() *RolesGetNotFound {
+
+	return &RolesGetNotFound{}
+}
+
+// WriteResponse to the client
+func (o *RolesGetNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(404)
+}
+
+// RolesGetUnprocessableEntityCode is the HTTP code returned for type RolesGetUnprocessableEntity
+const RolesGetUnprocessableEntityCode int = 422
+
+/*RolesGetUnprocessableEntity Invalid role or rbac authorization is not enabled.
+
+swagger:response rolesGetUnprocessableEntity
+*/
+type RolesGetUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewRolesGetUnprocessableEntity creates RolesGetUnprocessableEntity with default headers values
+func NewRolesGetUnprocessableEntity() *RolesGetUnprocessableEntity {
+
+	return &RolesGetUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the roles get unprocessable entity response
+func (o *RolesGetUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *RolesGetUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the roles get unprocessable entity response
+func (o *RolesGetUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RolesGetUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RolesGetInternalServerErrorCode is the HTTP code returned for type RolesGetInternalServerError
+const RolesGetInternalServerErrorCode int = 500
+
+/*RolesGetInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response rolesGetInternalServerError
+*/
+type RolesGetInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewRolesGetInternalServerError creates RolesGetInternalServerError with default headers values
+func NewRolesGetInternalServerError() *RolesGetInternalServerError {
+
+	return &RolesGetInternalServerError{}
+}
+
+// WithPayload adds the payload to the roles get internal server error response
+func (o *RolesGetInternalServerError) WithPayload(payload *models.ErrorResponse) *RolesGetInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the roles get internal server error response
+func (o *RolesGetInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RolesGetInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
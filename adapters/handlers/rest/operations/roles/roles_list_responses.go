@@ -0,0 +1,230 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package roles
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// RolesListOKCode is the HTTP code returned for type RolesListOK
+const RolesListOKCode int = 200
+
+/*RolesListOK All roles.
+
+swagger:response rolesListOK
+*/
+type RolesListOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.Role `json:"body,omitempty"`
+}
+
+// NewRolesListOK creates RolesListOK with default headers values
+func NewRolesListOK() *RolesListOK {
+
+	return &RolesListOK{}
+}
+
+// WithPayload adds the payload to the roles list o k response
+func (o *RolesListOK) WithPayload(payload []*models.Role) *RolesListOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the roles list o k response
+func (o *RolesListOK) SetPayload(payload []*models.Role) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RolesListOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.Role, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// RolesListUnauthorizedCode is the HTTP code returned for type RolesListUnauthorized
+const RolesListUnauthorizedCode int = 401
+
+/*RolesListUnauthorized Unauthorized or invalid credentials.
+
+swagger:response rolesListUnauthorized
+*/
+type RolesListUnauthorized struct {
+}
+
+// NewRolesListUnauthorized creates RolesListUnauthorized with default headers values
+func NewRolesListUnauthorized() *RolesListUnauthorized {
+
+	return &RolesListUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *RolesListUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// RolesListForbiddenCode is the HTTP code returned for type RolesListForbidden
+const RolesListForbiddenCode int = 403
+
+/*RolesListForbidden Forbidden
+
+swagger:response rolesListForbidden
+*/
+type RolesListForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewRolesListForbidden creates RolesListForbidden with default headers values
+func NewRolesListForbidden() *RolesListForbidden {
+
+	return &RolesListForbidden{}
+}
+
+// WithPayload adds the payload to the roles list forbidden response
+func (o *RolesListForbidden) WithPayload(payload *models.ErrorResponse) *RolesListForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the roles list forbidden response
+func (o *RolesListForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RolesListForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RolesListUnprocessableEntityCode is the HTTP code returned for type RolesListUnprocessableEntity
+const RolesListUnprocessableEntityCode int = 422
+
+/*RolesListUnprocessableEntity Invalid role or rbac authorization is not enabled.
+
+swagger:response rolesListUnprocessableEntity
+*/
+type RolesListUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewRolesListUnprocessableEntity creates RolesListUnprocessableEntity with default headers values
+func NewRolesListUnprocessableEntity() *RolesListUnprocessableEntity {
+
+	return &RolesListUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the roles list unprocessable entity response
+func (o *RolesListUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *RolesListUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the roles list unprocessable entity response
+func (o *RolesListUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RolesListUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RolesListInternalServerErrorCode is the HTTP code returned for type RolesListInternalServerError
+const RolesListInternalServerErrorCode int = 500
+
+/*RolesListInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response rolesListInternalServerError
+*/
+type RolesListInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewRolesListInternalServerError creates RolesListInternalServerError with default headers values
+func NewRolesListInternalServerError() *RolesListInternalServerError {
+
+	return &RolesListInternalServerError{}
+}
+
+// WithPayload adds the payload to the roles list internal server error response
+func (o *RolesListInternalServerError) WithPayload(payload *models.ErrorResponse) *RolesListInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the roles list internal server error response
+func (o *RolesListInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RolesListInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
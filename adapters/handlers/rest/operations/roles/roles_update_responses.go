@@ -0,0 +1,251 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package roles
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// RolesUpdateOKCode is the HTTP code returned for type RolesUpdateOK
+const RolesUpdateOKCode int = 200
+
+/*RolesUpdateOK Role updated.
+
+swagger:response rolesUpdateOK
+*/
+type RolesUpdateOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Role `json:"body,omitempty"`
+}
+
+// NewRolesUpdateOK creates RolesUpdateOK with default headers values
+func NewRolesUpdateOK() *RolesUpdateOK {
+
+	return &RolesUpdateOK{}
+}
+
+// WithPayload adds the payload to the roles update o k response
+func (o *RolesUpdateOK) WithPayload(payload *models.Role) *RolesUpdateOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the roles update o k response
+func (o *RolesUpdateOK) SetPayload(payload *models.Role) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RolesUpdateOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RolesUpdateUnauthorizedCode is the HTTP code returned for type RolesUpdateUnauthorized
+const RolesUpdateUnauthorizedCode int = 401
+
+/*RolesUpdateUnauthorized Unauthorized or invalid credentials.
+
+swagger:response rolesUpdateUnauthorized
+*/
+type RolesUpdateUnauthorized struct {
+}
+
+// NewRolesUpdateUnauthorized creates RolesUpdateUnauthorized with default headers values
+func NewRolesUpdateUnauthorized() *RolesUpdateUnauthorized {
+
+	return &RolesUpdateUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *RolesUpdateUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// RolesUpdateForbiddenCode is the HTTP code returned for type RolesUpdateForbidden
+const RolesUpdateForbiddenCode int = 403
+
+/*RolesUpdateForbidden Forbidden
+
+swagger:response rolesUpdateForbidden
+*/
+type RolesUpdateForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewRolesUpdateForbidden creates RolesUpdateForbidden with default headers values
+func NewRolesUpdateForbidden() *RolesUpdateForbidden {
+
+	return &RolesUpdateForbidden{}
+}
+
+// WithPayload adds the payload to the roles update forbidden response
+func (o *RolesUpdateForbidden) WithPayload(payload *models.ErrorResponse) *RolesUpdateForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the roles update forbidden response
+func (o *RolesUpdateForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RolesUpdateForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RolesUpdateNotFoundCode is the HTTP code returned for type RolesUpdateNotFound
+const RolesUpdateNotFoundCode int = 404
+
+/*RolesUpdateNotFound Not Found - Role does not exist
+
+swagger:response rolesUpdateNotFound
+*/
+type RolesUpdateNotFound struct {
+}
+
+// NewRolesUpdateNotFound creates RolesUpdateNotFound with default headers values
+func NewRolesUpdateNotFound() *RolesUpdateNotFound {
+
+	return &RolesUpdateNotFound{}
+}
+
+// WriteResponse to the client
+func (o *RolesUpdateNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(404)
+}
+
+// RolesUpdateUnprocessableEntityCode is the HTTP code returned for type RolesUpdateUnprocessableEntity
+const RolesUpdateUnprocessableEntityCode int = 422
+
+/*RolesUpdateUnprocessableEntity Invalid role or rbac authorization is not enabled.
+
+swagger:response rolesUpdateUnprocessableEntity
+*/
+type RolesUpdateUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewRolesUpdateUnprocessableEntity creates RolesUpdateUnprocessableEntity with default headers values
+func NewRolesUpdateUnprocessableEntity() *RolesUpdateUnprocessableEntity {
+
+	return &RolesUpdateUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the roles update unprocessable entity response
+func (o *RolesUpdateUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *RolesUpdateUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the roles update unprocessable entity response
+func (o *RolesUpdateUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RolesUpdateUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RolesUpdateInternalServerErrorCode is the HTTP code returned for type RolesUpdateInternalServerError
+const RolesUpdateInternalServerErrorCode int = 500
+
+/*RolesUpdateInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response rolesUpdateInternalServerError
+*/
+type RolesUpdateInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewRolesUpdateInternalServerError creates RolesUpdateInternalServerError with default headers values
+func NewRolesUpdateInternalServerError() *RolesUpdateInternalServerError {
+
+	return &RolesUpdateInternalServerError{}
+}
+
+// WithPayload adds the payload to the roles update internal server error response
+func (o *RolesUpdateInternalServerError) WithPayload(payload *models.ErrorResponse) *RolesUpdateInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the roles update internal server error response
+func (o *RolesUpdateInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RolesUpdateInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
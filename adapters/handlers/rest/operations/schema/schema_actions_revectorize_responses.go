@@ -0,0 +1,271 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// SchemaActionsRevectorizeAcceptedCode is the HTTP code returned for type SchemaActionsRevectorizeAccepted
+const SchemaActionsRevectorizeAcceptedCode int = 202
+
+/*SchemaActionsRevectorizeAccepted Successfully started the revectorization.
+
+swagger:response schemaActionsRevectorizeAccepted
+*/
+type SchemaActionsRevectorizeAccepted struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Revectorization `json:"body,omitempty"`
+}
+
+// NewSchemaActionsRevectorizeAccepted creates SchemaActionsRevectorizeAccepted with default headers values
+func NewSchemaActionsRevectorizeAccepted() *SchemaActionsRevectorizeAccepted {
+
+	return &SchemaActionsRevectorizeAccepted{}
+}
+
+// WithPayload adds the payload to the schema actions revectorize accepted response
+func (o *SchemaActionsRevectorizeAccepted) WithPayload(payload *models.Revectorization) *SchemaActionsRevectorizeAccepted {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema actions revectorize accepted response
+func (o *SchemaActionsRevectorizeAccepted) SetPayload(payload *models.Revectorization) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaActionsRevectorizeAccepted) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(202)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaActionsRevectorizeUnauthorizedCode is the HTTP code returned for type SchemaActionsRevectorizeUnauthorized
+const SchemaActionsRevectorizeUnauthorizedCode int = 401
+
+/*SchemaActionsRevectorizeUnauthorized Unauthorized or invalid credentials.
+
+swagger:response schemaActionsRevectorizeUnauthorized
+*/
+type SchemaActionsRevectorizeUnauthorized struct {
+}
+
+// NewSchemaActionsRevectorizeUnauthorized creates SchemaActionsRevectorizeUnauthorized with default headers values
+func NewSchemaActionsRevectorizeUnauthorized() *SchemaActionsRevectorizeUnauthorized {
+
+	return &SchemaActionsRevectorizeUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *SchemaActionsRevectorizeUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// SchemaActionsRevectorizeForbiddenCode is the HTTP code returned for type SchemaActionsRevectorizeForbidden
+const SchemaActionsRevectorizeForbiddenCode int = 403
+
+/*SchemaActionsRevectorizeForbidden Forbidden
+
+swagger:response schemaActionsRevectorizeForbidden
+*/
+type SchemaActionsRevectorizeForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaActionsRevectorizeForbidden creates SchemaActionsRevectorizeForbidden with default headers values
+func NewSchemaActionsRevectorizeForbidden() *SchemaActionsRevectorizeForbidden {
+
+	return &SchemaActionsRevectorizeForbidden{}
+}
+
+// WithPayload adds the payload to the schema actions revectorize forbidden response
+func (o *SchemaActionsRevectorizeForbidden) WithPayload(payload *models.ErrorResponse) *SchemaActionsRevectorizeForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema actions revectorize forbidden response
+func (o *SchemaActionsRevectorizeForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaActionsRevectorizeForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaActionsRevectorizeConflictCode is the HTTP code returned for type SchemaActionsRevectorizeConflict
+const SchemaActionsRevectorizeConflictCode int = 409
+
+/*SchemaActionsRevectorizeConflict A revectorization of the class is already running
+
+swagger:response schemaActionsRevectorizeConflict
+*/
+type SchemaActionsRevectorizeConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaActionsRevectorizeConflict creates SchemaActionsRevectorizeConflict with default headers values
+func NewSchemaActionsRevectorizeConflict() *SchemaActionsRevectorizeConflict {
+
+	return &SchemaActionsRevectorizeConflict{}
+}
+
+// WithPayload adds the payload to the schema actions revectorize conflict response
+func (o *SchemaActionsRevectorizeConflict) WithPayload(payload *models.ErrorResponse) *SchemaActionsRevectorizeConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema actions revectorize conflict response
+func (o *SchemaActionsRevectorizeConflict) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaActionsRevectorizeConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaActionsRevectorizeUnprocessableEntityCode is the HTTP code returned for type SchemaActionsRevectorizeUnprocessableEntity
+const SchemaActionsRevectorizeUnprocessableEntityCode int = 422
+
+/*SchemaActionsRevectorizeUnprocessableEntity Invalid revectorization, e.g. the class does not exist or has no vectorizer
+
+swagger:response schemaActionsRevectorizeUnprocessableEntity
+*/
+type SchemaActionsRevectorizeUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaActionsRevectorizeUnprocessableEntity creates SchemaActionsRevectorizeUnprocessableEntity with default headers values
+func NewSchemaActionsRevectorizeUnprocessableEntity() *SchemaActionsRevectorizeUnprocessableEntity {
+
+	return &SchemaActionsRevectorizeUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the schema actions revectorize unprocessable entity response
+func (o *SchemaActionsRevectorizeUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *SchemaActionsRevectorizeUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema actions revectorize unprocessable entity response
+func (o *SchemaActionsRevectorizeUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaActionsRevectorizeUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaActionsRevectorizeInternalServerErrorCode is the HTTP code returned for type SchemaActionsRevectorizeInternalServerError
+const SchemaActionsRevectorizeInternalServerErrorCode int = 500
+
+/*SchemaActionsRevectorizeInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response schemaActionsRevectorizeInternalServerError
+*/
+type SchemaActionsRevectorizeInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaActionsRevectorizeInternalServerError creates SchemaActionsRevectorizeInternalServerError with default headers values
+func NewSchemaActionsRevectorizeInternalServerError() *SchemaActionsRevectorizeInternalServerError {
+
+	return &SchemaActionsRevectorizeInternalServerError{}
+}
+
+// WithPayload adds the payload to the schema actions revectorize internal server error response
+func (o *SchemaActionsRevectorizeInternalServerError) WithPayload(payload *models.ErrorResponse) *SchemaActionsRevectorizeInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema actions revectorize internal server error response
+func (o *SchemaActionsRevectorizeInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaActionsRevectorizeInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,207 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// SchemaReindexesGetOKCode is the HTTP code returned for type SchemaReindexesGetOK
+const SchemaReindexesGetOKCode int = 200
+
+/*SchemaReindexesGetOK Found the reindex job, returned as body
+
+swagger:response schemaReindexesGetOK
+*/
+type SchemaReindexesGetOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.SchemaReindex `json:"body,omitempty"`
+}
+
+// NewSchemaReindexesGetOK creates SchemaReindexesGetOK with default headers values
+func NewSchemaReindexesGetOK() *SchemaReindexesGetOK {
+
+	return &SchemaReindexesGetOK{}
+}
+
+// WithPayload adds the payload to the schema reindexes get o k response
+func (o *SchemaReindexesGetOK) WithPayload(payload *models.SchemaReindex) *SchemaReindexesGetOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema reindexes get o k response
+func (o *SchemaReindexesGetOK) SetPayload(payload *models.SchemaReindex) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaReindexesGetOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaReindexesGetUnauthorizedCode is the HTTP code returned for type SchemaReindexesGetUnauthorized
+const SchemaReindexesGetUnauthorizedCode int = 401
+
+/*SchemaReindexesGetUnauthorized Unauthorized or invalid credentials.
+
+swagger:response schemaReindexesGetUnauthorized
+*/
+type SchemaReindexesGetUnauthorized struct {
+}
+
+// NewSchemaReindexesGetUnauthorized creates SchemaReindexesGetUnauthorized with default headers values
+func NewSchemaReindexesGetUnauthorized() *SchemaReindexesGetUnauthorized {
+
+	return &SchemaReindexesGetUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *SchemaReindexesGetUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// SchemaReindexesGetForbiddenCode is the HTTP code returned for type SchemaReindexesGetForbidden
+const SchemaReindexesGetForbiddenCode int = 403
+
+/*SchemaReindexesGetForbidden Forbidden
+
+swagger:response schemaReindexesGetForbidden
+*/
+type SchemaReindexesGetForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaReindexesGetForbidden creates SchemaReindexesGetForbidden with default headers values
+func NewSchemaReindexesGetForbidden() *SchemaReindexesGetForbidden {
+
+	return &SchemaReindexesGetForbidden{}
+}
+
+// WithPayload adds the payload to the schema reindexes get forbidden response
+func (o *SchemaReindexesGetForbidden) WithPayload(payload *models.ErrorResponse) *SchemaReindexesGetForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema reindexes get forbidden response
+func (o *SchemaReindexesGetForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaReindexesGetForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaReindexesGetNotFoundCode is the HTTP code returned for type SchemaReindexesGetNotFound
+const SchemaReindexesGetNotFoundCode int = 404
+
+/*SchemaReindexesGetNotFound Not Found - Reindex job does not exist
+
+swagger:response schemaReindexesGetNotFound
+*/
+type SchemaReindexesGetNotFound struct {
+}
+
+// NewSchemaReindexesGetNotFound creates SchemaReindexesGetNotFound with default headers values
+func NewSchemaReindexesGetNotFound() *SchemaReindexesGetNotFound {
+
+	return &SchemaReindexesGetNotFound{}
+}
+
+// WriteResponse to the client
+func (o *SchemaReindexesGetNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(404)
+}
+
+// SchemaReindexesGetInternalServerErrorCode is the HTTP code returned for type SchemaReindexesGetInternalServerError
+const SchemaReindexesGetInternalServerErrorCode int = 500
+
+/*SchemaReindexesGetInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response schemaReindexesGetInternalServerError
+*/
+type SchemaReindexesGetInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaReindexesGetInternalServerError creates SchemaReindexesGetInternalServerError with default headers values
+func NewSchemaReindexesGetInternalServerError() *SchemaReindexesGetInternalServerError {
+
+	return &SchemaReindexesGetInternalServerError{}
+}
+
+// WithPayload adds the payload to the schema reindexes get internal server error response
+func (o *SchemaReindexesGetInternalServerError) WithPayload(payload *models.ErrorResponse) *SchemaReindexesGetInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema reindexes get internal server error response
+func (o *SchemaReindexesGetInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaReindexesGetInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
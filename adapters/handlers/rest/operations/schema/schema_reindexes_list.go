@@ -0,0 +1,88 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// SchemaReindexesListHandlerFunc turns a function with the right signature into a schema reindexes list handler
+type SchemaReindexesListHandlerFunc func(SchemaReindexesListParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn SchemaReindexesListHandlerFunc) Handle(params SchemaReindexesListParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// SchemaReindexesListHandler interface for that can handle valid schema reindexes list params
+type SchemaReindexesListHandler interface {
+	Handle(SchemaReindexesListParams, *models.Principal) middleware.Responder
+}
+
+// NewSchemaReindexesList creates a new http.Handler for the schema reindexes list operation
+func NewSchemaReindexesList(ctx *middleware.Context, handler SchemaReindexesListHandler) *SchemaReindexesList {
+	return &SchemaReindexesList{Context: ctx, Handler: handler}
+}
+
+/*SchemaReindexesList swagger:route GET /schema/reindexes schema schemaReindexesList
+
+List reindex jobs
+
+List the reindex jobs which were started by renaming a class or property or by dropping a property. Jobs are kept in memory, so only the jobs started since the last restart are listed.
+
+*/
+type SchemaReindexesList struct {
+	Context *middleware.Context
+	Handler SchemaReindexesListHandler
+}
+
+func (o *SchemaReindexesList) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewSchemaReindexesListParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
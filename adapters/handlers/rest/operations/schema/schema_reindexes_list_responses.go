@@ -0,0 +1,186 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// SchemaReindexesListOKCode is the HTTP code returned for type SchemaReindexesListOK
+const SchemaReindexesListOKCode int = 200
+
+/*SchemaReindexesListOK Successfully listed the reindex jobs.
+
+swagger:response schemaReindexesListOK
+*/
+type SchemaReindexesListOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.SchemaReindex `json:"body,omitempty"`
+}
+
+// NewSchemaReindexesListOK creates SchemaReindexesListOK with default headers values
+func NewSchemaReindexesListOK() *SchemaReindexesListOK {
+
+	return &SchemaReindexesListOK{}
+}
+
+// WithPayload adds the payload to the schema reindexes list o k response
+func (o *SchemaReindexesListOK) WithPayload(payload []*models.SchemaReindex) *SchemaReindexesListOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema reindexes list o k response
+func (o *SchemaReindexesListOK) SetPayload(payload []*models.SchemaReindex) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaReindexesListOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.SchemaReindex, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// SchemaReindexesListUnauthorizedCode is the HTTP code returned for type SchemaReindexesListUnauthorized
+const SchemaReindexesListUnauthorizedCode int = 401
+
+/*SchemaReindexesListUnauthorized Unauthorized or invalid credentials.
+
+swagger:response schemaReindexesListUnauthorized
+*/
+type SchemaReindexesListUnauthorized struct {
+}
+
+// NewSchemaReindexesListUnauthorized creates SchemaReindexesListUnauthorized with default headers values
+func NewSchemaReindexesListUnauthorized() *SchemaReindexesListUnauthorized {
+
+	return &SchemaReindexesListUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *SchemaReindexesListUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// SchemaReindexesListForbiddenCode is the HTTP code returned for type SchemaReindexesListForbidden
+const SchemaReindexesListForbiddenCode int = 403
+
+/*SchemaReindexesListForbidden Forbidden
+
+swagger:response schemaReindexesListForbidden
+*/
+type SchemaReindexesListForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaReindexesListForbidden creates SchemaReindexesListForbidden with default headers values
+func NewSchemaReindexesListForbidden() *SchemaReindexesListForbidden {
+
+	return &SchemaReindexesListForbidden{}
+}
+
+// WithPayload adds the payload to the schema reindexes list forbidden response
+func (o *SchemaReindexesListForbidden) WithPayload(payload *models.ErrorResponse) *SchemaReindexesListForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema reindexes list forbidden response
+func (o *SchemaReindexesListForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaReindexesListForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaReindexesListInternalServerErrorCode is the HTTP code returned for type SchemaReindexesListInternalServerError
+const SchemaReindexesListInternalServerErrorCode int = 500
+
+/*SchemaReindexesListInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response schemaReindexesListInternalServerError
+*/
+type SchemaReindexesListInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaReindexesListInternalServerError creates SchemaReindexesListInternalServerError with default headers values
+func NewSchemaReindexesListInternalServerError() *SchemaReindexesListInternalServerError {
+
+	return &SchemaReindexesListInternalServerError{}
+}
+
+// WithPayload adds the payload to the schema reindexes list internal server error response
+func (o *SchemaReindexesListInternalServerError) WithPayload(payload *models.ErrorResponse) *SchemaReindexesListInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema reindexes list internal server error response
+func (o *SchemaReindexesListInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaReindexesListInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,88 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// SchemaRevectorizationsCancelHandlerFunc turns a function with the right signature into a schema revectorizations cancel handler
+type SchemaRevectorizationsCancelHandlerFunc func(SchemaRevectorizationsCancelParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn SchemaRevectorizationsCancelHandlerFunc) Handle(params SchemaRevectorizationsCancelParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// SchemaRevectorizationsCancelHandler interface for that can handle valid schema revectorizations cancel params
+type SchemaRevectorizationsCancelHandler interface {
+	Handle(SchemaRevectorizationsCancelParams, *models.Principal) middleware.Responder
+}
+
+// NewSchemaRevectorizationsCancel creates a new http.Handler for the schema revectorizations cancel operation
+func NewSchemaRevectorizationsCancel(ctx *middleware.Context, handler SchemaRevectorizationsCancelHandler) *SchemaRevectorizationsCancel {
+	return &SchemaRevectorizationsCancel{Context: ctx, Handler: handler}
+}
+
+/*SchemaRevectorizationsCancel swagger:route DELETE /schema/revectorizations/{id} schema schemaRevectorizationsCancel
+
+Cancel a running revectorization
+
+Cancel a running revectorization. Objects which have already been revectorized keep their new vectors, all other objects keep their previous vectors.
+
+*/
+type SchemaRevectorizationsCancel struct {
+	Context *middleware.Context
+	Handler SchemaRevectorizationsCancelHandler
+}
+
+func (o *SchemaRevectorizationsCancel) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewSchemaRevectorizationsCancelParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
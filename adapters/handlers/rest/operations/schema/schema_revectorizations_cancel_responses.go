@@ -0,0 +1,251 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// SchemaRevectorizationsCancelAcceptedCode is the HTTP code returned for type SchemaRevectorizationsCancelAccepted
+const SchemaRevectorizationsCancelAcceptedCode int = 202
+
+/*SchemaRevectorizationsCancelAccepted Cancellation requested, the revectorization is returned as body
+
+swagger:response schemaRevectorizationsCancelAccepted
+*/
+type SchemaRevectorizationsCancelAccepted struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Revectorization `json:"body,omitempty"`
+}
+
+// NewSchemaRevectorizationsCancelAccepted creates SchemaRevectorizationsCancelAccepted with default headers values
+func NewSchemaRevectorizationsCancelAccepted() *SchemaRevectorizationsCancelAccepted {
+
+	return &SchemaRevectorizationsCancelAccepted{}
+}
+
+// WithPayload adds the payload to the schema revectorizations cancel accepted response
+func (o *SchemaRevectorizationsCancelAccepted) WithPayload(payload *models.Revectorization) *SchemaRevectorizationsCancelAccepted {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema revectorizations cancel accepted response
+func (o *SchemaRevectorizationsCancelAccepted) SetPayload(payload *models.Revectorization) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaRevectorizationsCancelAccepted) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(202)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaRevectorizationsCancelUnauthorizedCode is the HTTP code returned for type SchemaRevectorizationsCancelUnauthorized
+const SchemaRevectorizationsCancelUnauthorizedCode int = 401
+
+/*SchemaRevectorizationsCancelUnauthorized Unauthorized or invalid credentials.
+
+swagger:response schemaRevectorizationsCancelUnauthorized
+*/
+type SchemaRevectorizationsCancelUnauthorized struct {
+}
+
+// NewSchemaRevectorizationsCancelUnauthorized creates SchemaRevectorizationsCancelUnauthorized with default headers values
+func NewSchemaRevectorizationsCancelUnauthorized() *SchemaRevectorizationsCancelUnauthorized {
+
+	return &SchemaRevectorizationsCancelUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *SchemaRevectorizationsCancelUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// SchemaRevectorizationsCancelForbiddenCode is the HTTP code returned for type SchemaRevectorizationsCancelForbidden
+const SchemaRevectorizationsCancelForbiddenCode int = 403
+
+/*SchemaRevectorizationsCancelForbidden Forbidden
+
+swagger:response schemaRevectorizationsCancelForbidden
+*/
+type SchemaRevectorizationsCancelForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaRevectorizationsCancelForbidden creates SchemaRevectorizationsCancelForbidden with default headers values
+func NewSchemaRevectorizationsCancelForbidden() *SchemaRevectorizationsCancelForbidden {
+
+	return &SchemaRevectorizationsCancelForbidden{}
+}
+
+// WithPayload adds the payload to the schema revectorizations cancel forbidden response
+func (o *SchemaRevectorizationsCancelForbidden) WithPayload(payload *models.ErrorResponse) *SchemaRevectorizationsCancelForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema revectorizations cancel forbidden response
+func (o *SchemaRevectorizationsCancelForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaRevectorizationsCancelForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaRevectorizationsCancelNotFoundCode is the HTTP code returned for type SchemaRevectorizationsCancelNotFound
+const SchemaRevectorizationsCancelNotFoundCode int = 404
+
+/*SchemaRevectorizationsCancelNotFound Not Found - Revectorization does not exist
+
+swagger:response schemaRevectorizationsCancelNotFound
+*/
+type SchemaRevectorizationsCancelNotFound struct {
+}
+
+// NewSchemaRevectorizationsCancelNotFound creates SchemaRevectorizationsCancelNotFound with default headers values
+func NewSchemaRevectorizationsCancelNotFound() *SchemaRevectorizationsCancelNotFound {
+
+	return &SchemaRevectorizationsCancelNotFound{}
+}
+
+// WriteResponse to the client
+func (o *SchemaRevectorizationsCancelNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(404)
+}
+
+// SchemaRevectorizationsCancelConflictCode is the HTTP code returned for type SchemaRevectorizationsCancelConflict
+const SchemaRevectorizationsCancelConflictCode int = 409
+
+/*SchemaRevectorizationsCancelConflict The revectorization is not running
+
+swagger:response schemaRevectorizationsCancelConflict
+*/
+type SchemaRevectorizationsCancelConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaRevectorizationsCancelConflict creates SchemaRevectorizationsCancelConflict with default headers values
+func NewSchemaRevectorizationsCancelConflict() *SchemaRevectorizationsCancelConflict {
+
+	return &SchemaRevectorizationsCancelConflict{}
+}
+
+// WithPayload adds the payload to the schema revectorizations cancel conflict response
+func (o *SchemaRevectorizationsCancelConflict) WithPayload(payload *models.ErrorResponse) *SchemaRevectorizationsCancelConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema revectorizations cancel conflict response
+func (o *SchemaRevectorizationsCancelConflict) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaRevectorizationsCancelConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaRevectorizationsCancelInternalServerErrorCode is the HTTP code returned for type SchemaRevectorizationsCancelInternalServerError
+const SchemaRevectorizationsCancelInternalServerErrorCode int = 500
+
+/*SchemaRevectorizationsCancelInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response schemaRevectorizationsCancelInternalServerError
+*/
+type SchemaRevectorizationsCancelInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaRevectorizationsCancelInternalServerError creates SchemaRevectorizationsCancelInternalServerError with default headers values
+func NewSchemaRevectorizationsCancelInternalServerError() *SchemaRevectorizationsCancelInternalServerError {
+
+	return &SchemaRevectorizationsCancelInternalServerError{}
+}
+
+// WithPayload adds the payload to the schema revectorizations cancel internal server error response
+func (o *SchemaRevectorizationsCancelInternalServerError) WithPayload(payload *models.ErrorResponse) *SchemaRevectorizationsCancelInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema revectorizations cancel internal server error response
+func (o *SchemaRevectorizationsCancelInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaRevectorizationsCancelInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
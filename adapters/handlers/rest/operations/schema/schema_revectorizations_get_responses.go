@@ -0,0 +1,207 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// SchemaRevectorizationsGetOKCode is the HTTP code returned for type SchemaRevectorizationsGetOK
+const SchemaRevectorizationsGetOKCode int = 200
+
+/*SchemaRevectorizationsGetOK Found the revectorization, returned as body
+
+swagger:response schemaRevectorizationsGetOK
+*/
+type SchemaRevectorizationsGetOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Revectorization `json:"body,omitempty"`
+}
+
+// NewSchemaRevectorizationsGetOK creates SchemaRevectorizationsGetOK with default headers values
+func NewSchemaRevectorizationsGetOK() *SchemaRevectorizationsGetOK {
+
+	return &SchemaRevectorizationsGetOK{}
+}
+
+// WithPayload adds the payload to the schema revectorizations get o k response
+func (o *SchemaRevectorizationsGetOK) WithPayload(payload *models.Revectorization) *SchemaRevectorizationsGetOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema revectorizations get o k response
+func (o *SchemaRevectorizationsGetOK) SetPayload(payload *models.Revectorization) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaRevectorizationsGetOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaRevectorizationsGetUnauthorizedCode is the HTTP code returned for type SchemaRevectorizationsGetUnauthorized
+const SchemaRevectorizationsGetUnauthorizedCode int = 401
+
+/*SchemaRevectorizationsGetUnauthorized Unauthorized or invalid credentials.
+
+swagger:response schemaRevectorizationsGetUnauthorized
+*/
+type SchemaRevectorizationsGetUnauthorized struct {
+}
+
+// NewSchemaRevectorizationsGetUnauthorized creates SchemaRevectorizationsGetUnauthorized with default headers values
+func NewSchemaRevectorizationsGetUnauthorized() *SchemaRevectorizationsGetUnauthorized {
+
+	return &SchemaRevectorizationsGetUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *SchemaRevectorizationsGetUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// SchemaRevectorizationsGetForbiddenCode is the HTTP code returned for type SchemaRevectorizationsGetForbidden
+const SchemaRevectorizationsGetForbiddenCode int = 403
+
+/*SchemaRevectorizationsGetForbidden Forbidden
+
+swagger:response schemaRevectorizationsGetForbidden
+*/
+type SchemaRevectorizationsGetForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaRevectorizationsGetForbidden creates SchemaRevectorizationsGetForbidden with default headers values
+func NewSchemaRevectorizationsGetForbidden() *SchemaRevectorizationsGetForbidden {
+
+	return &SchemaRevectorizationsGetForbidden{}
+}
+
+// WithPayload adds the payload to the schema revectorizations get forbidden response
+func (o *SchemaRevectorizationsGetForbidden) WithPayload(payload *models.ErrorResponse) *SchemaRevectorizationsGetForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema revectorizations get forbidden response
+func (o *SchemaRevectorizationsGetForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaRevectorizationsGetForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaRevectorizationsGetNotFoundCode is the HTTP code returned for type SchemaRevectorizationsGetNotFound
+const SchemaRevectorizationsGetNotFoundCode int = 404
+
+/*SchemaRevectorizationsGetNotFound Not Found - Revectorization does not exist
+
+swagger:response schemaRevectorizationsGetNotFound
+*/
+type SchemaRevectorizationsGetNotFound struct {
+}
+
+// NewSchemaRevectorizationsGetNotFound creates SchemaRevectorizationsGetNotFound with default headers values
+func NewSchemaRevectorizationsGetNotFound() *SchemaRevectorizationsGetNotFound {
+
+	return &SchemaRevectorizationsGetNotFound{}
+}
+
+// WriteResponse to the client
+func (o *SchemaRevectorizationsGetNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(404)
+}
+
+// SchemaRevectorizationsGetInternalServerErrorCode is the HTTP code returned for type SchemaRevectorizationsGetInternalServerError
+const SchemaRevectorizationsGetInternalServerErrorCode int = 500
+
+/*SchemaRevectorizationsGetInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response schemaRevectorizationsGetInternalServerError
+*/
+type SchemaRevectorizationsGetInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaRevectorizationsGetInternalServerError creates SchemaRevectorizationsGetInternalServerError with default headers values
+func NewSchemaRevectorizationsGetInternalServerError() *SchemaRevectorizationsGetInternalServerError {
+
+	return &SchemaRevectorizationsGetInternalServerError{}
+}
+
+// WithPayload adds the payload to the schema revectorizations get internal server error response
+func (o *SchemaRevectorizationsGetInternalServerError) WithPayload(payload *models.ErrorResponse) *SchemaRevectorizationsGetInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema revectorizations get internal server error response
+func (o *SchemaRevectorizationsGetInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaRevectorizationsGetInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
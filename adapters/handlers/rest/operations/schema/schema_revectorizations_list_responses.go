@@ -0,0 +1,186 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// SchemaRevectorizationsListOKCode is the HTTP code returned for type SchemaRevectorizationsListOK
+const SchemaRevectorizationsListOKCode int = 200
+
+/*SchemaRevectorizationsListOK Successfully listed the revectorizations.
+
+swagger:response schemaRevectorizationsListOK
+*/
+type SchemaRevectorizationsListOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.Revectorization `json:"body,omitempty"`
+}
+
+// NewSchemaRevectorizationsListOK creates SchemaRevectorizationsListOK with default headers values
+func NewSchemaRevectorizationsListOK() *SchemaRevectorizationsListOK {
+
+	return &SchemaRevectorizationsListOK{}
+}
+
+// WithPayload adds the payload to the schema revectorizations list o k response
+func (o *SchemaRevectorizationsListOK) WithPayload(payload []*models.Revectorization) *SchemaRevectorizationsListOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema revectorizations list o k response
+func (o *SchemaRevectorizationsListOK) SetPayload(payload []*models.Revectorization) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaRevectorizationsListOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.Revectorization, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// SchemaRevectorizationsListUnauthorizedCode is the HTTP code returned for type SchemaRevectorizationsListUnauthorized
+const SchemaRevectorizationsListUnauthorizedCode int = 401
+
+/*SchemaRevectorizationsListUnauthorized Unauthorized or invalid credentials.
+
+swagger:response schemaRevectorizationsListUnauthorized
+*/
+type SchemaRevectorizationsListUnauthorized struct {
+}
+
+// NewSchemaRevectorizationsListUnauthorized creates SchemaRevectorizationsListUnauthorized with default headers values
+func NewSchemaRevectorizationsListUnauthorized() *SchemaRevectorizationsListUnauthorized {
+
+	return &SchemaRevectorizationsListUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *SchemaRevectorizationsListUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// SchemaRevectorizationsListForbiddenCode is the HTTP code returned for type SchemaRevectorizationsListForbidden
+const SchemaRevectorizationsListForbiddenCode int = 403
+
+/*SchemaRevectorizationsListForbidden Forbidden
+
+swagger:response schemaRevectorizationsListForbidden
+*/
+type SchemaRevectorizationsListForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaRevectorizationsListForbidden creates SchemaRevectorizationsListForbidden with default headers values
+func NewSchemaRevectorizationsListForbidden() *SchemaRevectorizationsListForbidden {
+
+	return &SchemaRevectorizationsListForbidden{}
+}
+
+// WithPayload adds the payload to the schema revectorizations list forbidden response
+func (o *SchemaRevectorizationsListForbidden) WithPayload(payload *models.ErrorResponse) *SchemaRevectorizationsListForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema revectorizations list forbidden response
+func (o *SchemaRevectorizationsListForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaRevectorizationsListForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaRevectorizationsListInternalServerErrorCode is the HTTP code returned for type SchemaRevectorizationsListInternalServerError
+const SchemaRevectorizationsListInternalServerErrorCode int = 500
+
+/*SchemaRevectorizationsListInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response schemaRevectorizationsListInternalServerError
+*/
+type SchemaRevectorizationsListInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaRevectorizationsListInternalServerError creates SchemaRevectorizationsListInternalServerError with default headers values
+func NewSchemaRevectorizationsListInternalServerError() *SchemaRevectorizationsListInternalServerError {
+
+	return &SchemaRevectorizationsListInternalServerError{}
+}
+
+// WithPayload adds the payload to the schema revectorizations list internal server error response
+func (o *SchemaRevectorizationsListInternalServerError) WithPayload(payload *models.ErrorResponse) *SchemaRevectorizationsListInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema revectorizations list internal server error response
+func (o *SchemaRevectorizationsListInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaRevectorizationsListInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,271 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// SchemaThingsRevectorizeAcceptedCode is the HTTP code returned for type SchemaThingsRevectorizeAccepted
+const SchemaThingsRevectorizeAcceptedCode int = 202
+
+/*SchemaThingsRevectorizeAccepted Successfully started the revectorization.
+
+swagger:response schemaThingsRevectorizeAccepted
+*/
+type SchemaThingsRevectorizeAccepted struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Revectorization `json:"body,omitempty"`
+}
+
+// NewSchemaThingsRevectorizeAccepted creates SchemaThingsRevectorizeAccepted with default headers values
+func NewSchemaThingsRevectorizeAccepted() *SchemaThingsRevectorizeAccepted {
+
+	return &SchemaThingsRevectorizeAccepted{}
+}
+
+// WithPayload adds the payload to the schema things revectorize accepted response
+func (o *SchemaThingsRevectorizeAccepted) WithPayload(payload *models.Revectorization) *SchemaThingsRevectorizeAccepted {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema things revectorize accepted response
+func (o *SchemaThingsRevectorizeAccepted) SetPayload(payload *models.Revectorization) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaThingsRevectorizeAccepted) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(202)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaThingsRevectorizeUnauthorizedCode is the HTTP code returned for type SchemaThingsRevectorizeUnauthorized
+const SchemaThingsRevectorizeUnauthorizedCode int = 401
+
+/*SchemaThingsRevectorizeUnauthorized Unauthorized or invalid credentials.
+
+swagger:response schemaThingsRevectorizeUnauthorized
+*/
+type SchemaThingsRevectorizeUnauthorized struct {
+}
+
+// NewSchemaThingsRevectorizeUnauthorized creates SchemaThingsRevectorizeUnauthorized with default headers values
+func NewSchemaThingsRevectorizeUnauthorized() *SchemaThingsRevectorizeUnauthorized {
+
+	return &SchemaThingsRevectorizeUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *SchemaThingsRevectorizeUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// SchemaThingsRevectorizeForbiddenCode is the HTTP code returned for type SchemaThingsRevectorizeForbidden
+const SchemaThingsRevectorizeForbiddenCode int = 403
+
+/*SchemaThingsRevectorizeForbidden Forbidden
+
+swagger:response schemaThingsRevectorizeForbidden
+*/
+type SchemaThingsRevectorizeForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaThingsRevectorizeForbidden creates SchemaThingsRevectorizeForbidden with default headers values
+func NewSchemaThingsRevectorizeForbidden() *SchemaThingsRevectorizeForbidden {
+
+	return &SchemaThingsRevectorizeForbidden{}
+}
+
+// WithPayload adds the payload to the schema things revectorize forbidden response
+func (o *SchemaThingsRevectorizeForbidden) WithPayload(payload *models.ErrorResponse) *SchemaThingsRevectorizeForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema things revectorize forbidden response
+func (o *SchemaThingsRevectorizeForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaThingsRevectorizeForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaThingsRevectorizeConflictCode is the HTTP code returned for type SchemaThingsRevectorizeConflict
+const SchemaThingsRevectorizeConflictCode int = 409
+
+/*SchemaThingsRevectorizeConflict A revectorization of the class is already running
+
+swagger:response schemaThingsRevectorizeConflict
+*/
+type SchemaThingsRevectorizeConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaThingsRevectorizeConflict creates SchemaThingsRevectorizeConflict with default headers values
+func NewSchemaThingsRevectorizeConflict() *SchemaThingsRevectorizeConflict {
+
+	return &SchemaThingsRevectorizeConflict{}
+}
+
+// WithPayload adds the payload to the schema things revectorize conflict response
+func (o *SchemaThingsRevectorizeConflict) WithPayload(payload *models.ErrorResponse) *SchemaThingsRevectorizeConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema things revectorize conflict response
+func (o *SchemaThingsRevectorizeConflict) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaThingsRevectorizeConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaThingsRevectorizeUnprocessableEntityCode is the HTTP code returned for type SchemaThingsRevectorizeUnprocessableEntity
+const SchemaThingsRevectorizeUnprocessableEntityCode int = 422
+
+/*SchemaThingsRevectorizeUnprocessableEntity Invalid revectorization, e.g. the class does not exist or has no vectorizer
+
+swagger:response schemaThingsRevectorizeUnprocessableEntity
+*/
+type SchemaThingsRevectorizeUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaThingsRevectorizeUnprocessableEntity creates SchemaThingsRevectorizeUnprocessableEntity with default headers values
+func NewSchemaThingsRevectorizeUnprocessableEntity() *SchemaThingsRevectorizeUnprocessableEntity {
+
+	return &SchemaThingsRevectorizeUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the schema things revectorize unprocessable entity response
+func (o *SchemaThingsRevectorizeUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *SchemaThingsRevectorizeUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema things revectorize unprocessable entity response
+func (o *SchemaThingsRevectorizeUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaThingsRevectorizeUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaThingsRevectorizeInternalServerErrorCode is the HTTP code returned for type SchemaThingsRevectorizeInternalServerError
+const SchemaThingsRevectorizeInternalServerErrorCode int = 500
+
+/*SchemaThingsRevectorizeInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response schemaThingsRevectorizeInternalServerError
+*/
+type SchemaThingsRevectorizeInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaThingsRevectorizeInternalServerError creates SchemaThingsRevectorizeInternalServerError with default headers values
+func NewSchemaThingsRevectorizeInternalServerError() *SchemaThingsRevectorizeInternalServerError {
+
+	return &SchemaThingsRevectorizeInternalServerError{}
+}
+
+// WithPayload adds the payload to the schema things revectorize internal server error response
+func (o *SchemaThingsRevectorizeInternalServerError) WithPayload(payload *models.ErrorResponse) *SchemaThingsRevectorizeInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema things revectorize internal server error response
+func (o *SchemaThingsRevectorizeInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaThingsRevectorizeInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -142,6 +142,50 @@ func (o *ThingsDeleteNotFound) WriteResponse(rw http.ResponseWriter, producer ru
 	rw.WriteHeader(404)
 }
 
+// ThingsDeleteConflictCode is the HTTP code returned for type ThingsDeleteConflict
+const ThingsDeleteConflictCode int = 409
+
+/*ThingsDeleteConflict The object is still referenced through a reference property with the onDelete policy 'restrict'.
+
+swagger:response thingsDeleteConflict
+*/
+type ThingsDeleteConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewThingsDeleteConflict creates ThingsDeleteConflict with default headers values
+func NewThingsDeleteConflict() *ThingsDeleteConflict {
+
+	return &ThingsDeleteConflict{}
+}
+
+// WithPayload adds the payload to the things delete conflict response
+func (o *ThingsDeleteConflict) WithPayload(payload *models.ErrorResponse) *ThingsDeleteConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the things delete conflict response
+func (o *ThingsDeleteConflict) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ThingsDeleteConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ThingsDeleteInternalServerErrorCode is the HTTP code returned for type ThingsDeleteInternalServerError
 const ThingsDeleteInternalServerErrorCode int = 500
 
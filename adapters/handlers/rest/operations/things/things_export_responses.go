@@ -0,0 +1,207 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package things
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// ThingsExportOKCode is the HTTP code returned for type ThingsExportOK
+const ThingsExportOKCode int = 200
+
+/*ThingsExportOK Successful response, the body contains one Thing per line.
+
+swagger:response thingsExportOK
+*/
+type ThingsExportOK struct {
+}
+
+// NewThingsExportOK creates ThingsExportOK with default headers values
+func NewThingsExportOK() *ThingsExportOK {
+
+	return &ThingsExportOK{}
+}
+
+// WriteResponse to the client
+func (o *ThingsExportOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(200)
+}
+
+// ThingsExportUnauthorizedCode is the HTTP code returned for type ThingsExportUnauthorized
+const ThingsExportUnauthorizedCode int = 401
+
+/*ThingsExportUnauthorized Unauthorized or invalid credentials.
+
+swagger:response thingsExportUnauthorized
+*/
+type ThingsExportUnauthorized struct {
+}
+
+// NewThingsExportUnauthorized creates ThingsExportUnauthorized with default headers values
+func NewThingsExportUnauthorized() *ThingsExportUnauthorized {
+
+	return &ThingsExportUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *ThingsExportUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// ThingsExportForbiddenCode is the HTTP code returned for type ThingsExportForbidden
+const ThingsExportForbiddenCode int = 403
+
+/*ThingsExportForbidden Forbidden
+
+swagger:response thingsExportForbidden
+*/
+type ThingsExportForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewThingsExportForbidden creates ThingsExportForbidden with default headers values
+func NewThingsExportForbidden() *ThingsExportForbidden {
+
+	return &ThingsExportForbidden{}
+}
+
+// WithPayload adds the payload to the things export forbidden response
+func (o *ThingsExportForbidden) WithPayload(payload *models.ErrorResponse) *ThingsExportForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the things export forbidden response
+func (o *ThingsExportForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ThingsExportForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ThingsExportNotFoundCode is the HTTP code returned for type ThingsExportNotFound
+const ThingsExportNotFoundCode int = 404
+
+/*ThingsExportNotFound There is no Thing class with this name.
+
+swagger:response thingsExportNotFound
+*/
+type ThingsExportNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewThingsExportNotFound creates ThingsExportNotFound with default headers values
+func NewThingsExportNotFound() *ThingsExportNotFound {
+
+	return &ThingsExportNotFound{}
+}
+
+// WithPayload adds the payload to the things export not found response
+func (o *ThingsExportNotFound) WithPayload(payload *models.ErrorResponse) *ThingsExportNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the things export not found response
+func (o *ThingsExportNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ThingsExportNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ThingsExportInternalServerErrorCode is the HTTP code returned for type ThingsExportInternalServerError
+const ThingsExportInternalServerErrorCode int = 500
+
+/*ThingsExportInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response thingsExportInternalServerError
+*/
+type ThingsExportInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewThingsExportInternalServerError creates ThingsExportInternalServerError with default headers values
+func NewThingsExportInternalServerError() *ThingsExportInternalServerError {
+
+	return &ThingsExportInternalServerError{}
+}
+
+// WithPayload adds the payload to the things export internal server error response
+func (o *ThingsExportInternalServerError) WithPayload(payload *models.ErrorResponse) *ThingsExportInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the things export internal server error response
+func (o *ThingsExportInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ThingsExportInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
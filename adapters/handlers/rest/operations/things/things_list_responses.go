@@ -162,6 +162,50 @@ func (o *ThingsListNotFound) WriteResponse(rw http.ResponseWriter, producer runt
 	rw.WriteHeader(404)
 }
 
+// ThingsListUnprocessableEntityCode is the HTTP code returned for type ThingsListUnprocessableEntity
+const ThingsListUnprocessableEntityCode int = 422
+
+/*ThingsListUnprocessableEntity Invalid paging parameters.
+
+swagger:response thingsListUnprocessableEntity
+*/
+type ThingsListUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewThingsListUnprocessableEntity creates ThingsListUnprocessableEntity with default headers values
+func NewThingsListUnprocessableEntity() *ThingsListUnprocessableEntity {
+
+	return &ThingsListUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the things list unprocessable entity response
+func (o *ThingsListUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *ThingsListUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the things list unprocessable entity response
+func (o *ThingsListUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ThingsListUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ThingsListInternalServerErrorCode is the HTTP code returned for type ThingsListInternalServerError
 const ThingsListInternalServerErrorCode int = 500
 
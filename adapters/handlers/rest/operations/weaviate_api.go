@@ -34,12 +34,15 @@ import (
 	"github.com/go-openapi/swag"
 
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/actions"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/backups"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/batching"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/classifications"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/contextionary_api"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/graphql"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/maintenance"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/meta"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/p2_p"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/roles"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/schema"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/things"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/well_known"
@@ -74,6 +77,9 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		ActionsActionsDeleteHandler: actions.ActionsDeleteHandlerFunc(func(params actions.ActionsDeleteParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation ActionsActionsDelete has not yet been implemented")
 		}),
+		ActionsActionsExportHandler: actions.ActionsExportHandlerFunc(func(params actions.ActionsExportParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation ActionsActionsExport has not yet been implemented")
+		}),
 		ActionsActionsGetHandler: actions.ActionsGetHandlerFunc(func(params actions.ActionsGetParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation ActionsActionsGet has not yet been implemented")
 		}),
@@ -98,15 +104,39 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		ActionsActionsValidateHandler: actions.ActionsValidateHandlerFunc(func(params actions.ActionsValidateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation ActionsActionsValidate has not yet been implemented")
 		}),
+		BackupsBackupsCreateHandler: backups.BackupsCreateHandlerFunc(func(params backups.BackupsCreateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation BackupsBackupsCreate has not yet been implemented")
+		}),
+		BackupsBackupsGetHandler: backups.BackupsGetHandlerFunc(func(params backups.BackupsGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation BackupsBackupsGet has not yet been implemented")
+		}),
+		BackupsBackupsRestoreHandler: backups.BackupsRestoreHandlerFunc(func(params backups.BackupsRestoreParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation BackupsBackupsRestore has not yet been implemented")
+		}),
+		BackupsBackupsRestoreGetHandler: backups.BackupsRestoreGetHandlerFunc(func(params backups.BackupsRestoreGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation BackupsBackupsRestoreGet has not yet been implemented")
+		}),
 		BatchingBatchingActionsCreateHandler: batching.BatchingActionsCreateHandlerFunc(func(params batching.BatchingActionsCreateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation BatchingBatchingActionsCreate has not yet been implemented")
 		}),
+		BatchingBatchingActionsDeleteHandler: batching.BatchingActionsDeleteHandlerFunc(func(params batching.BatchingActionsDeleteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation BatchingBatchingActionsDelete has not yet been implemented")
+		}),
+		BatchingBatchingActionsMergeHandler: batching.BatchingActionsMergeHandlerFunc(func(params batching.BatchingActionsMergeParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation BatchingBatchingActionsMerge has not yet been implemented")
+		}),
 		BatchingBatchingReferencesCreateHandler: batching.BatchingReferencesCreateHandlerFunc(func(params batching.BatchingReferencesCreateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation BatchingBatchingReferencesCreate has not yet been implemented")
 		}),
 		BatchingBatchingThingsCreateHandler: batching.BatchingThingsCreateHandlerFunc(func(params batching.BatchingThingsCreateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation BatchingBatchingThingsCreate has not yet been implemented")
 		}),
+		BatchingBatchingThingsDeleteHandler: batching.BatchingThingsDeleteHandlerFunc(func(params batching.BatchingThingsDeleteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation BatchingBatchingThingsDelete has not yet been implemented")
+		}),
+		BatchingBatchingThingsMergeHandler: batching.BatchingThingsMergeHandlerFunc(func(params batching.BatchingThingsMergeParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation BatchingBatchingThingsMerge has not yet been implemented")
+		}),
 		ContextionaryAPIC11yConceptsHandler: contextionary_api.C11yConceptsHandlerFunc(func(params contextionary_api.C11yConceptsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation ContextionaryAPIC11yConcepts has not yet been implemented")
 		}),
@@ -119,9 +149,15 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		ContextionaryAPIC11yWordsHandler: contextionary_api.C11yWordsHandlerFunc(func(params contextionary_api.C11yWordsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation ContextionaryAPIC11yWords has not yet been implemented")
 		}),
+		ClassificationsClassificationsCancelHandler: classifications.ClassificationsCancelHandlerFunc(func(params classifications.ClassificationsCancelParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation ClassificationsClassificationsCancel has not yet been implemented")
+		}),
 		ClassificationsClassificationsGetHandler: classifications.ClassificationsGetHandlerFunc(func(params classifications.ClassificationsGetParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation ClassificationsClassificationsGet has not yet been implemented")
 		}),
+		ClassificationsClassificationsListHandler: classifications.ClassificationsListHandlerFunc(func(params classifications.ClassificationsListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation ClassificationsClassificationsList has not yet been implemented")
+		}),
 		ClassificationsClassificationsPostHandler: classifications.ClassificationsPostHandlerFunc(func(params classifications.ClassificationsPostParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation ClassificationsClassificationsPost has not yet been implemented")
 		}),
@@ -131,6 +167,9 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		GraphqlGraphqlPostHandler: graphql.GraphqlPostHandlerFunc(func(params graphql.GraphqlPostParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation GraphqlGraphqlPost has not yet been implemented")
 		}),
+		MaintenanceMaintenanceDanglingReferencesHandler: maintenance.MaintenanceDanglingReferencesHandlerFunc(func(params maintenance.MaintenanceDanglingReferencesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation MaintenanceMaintenanceDanglingReferences has not yet been implemented")
+		}),
 		MetaMetaGetHandler: meta.MetaGetHandlerFunc(func(params meta.MetaGetParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation MetaMetaGet has not yet been implemented")
 		}),
@@ -140,6 +179,21 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		P2pP2pHealthHandler: p2_p.P2pHealthHandlerFunc(func(params p2_p.P2pHealthParams) middleware.Responder {
 			return middleware.NotImplemented("operation P2pP2pHealth has not yet been implemented")
 		}),
+		RolesRolesCreateHandler: roles.RolesCreateHandlerFunc(func(params roles.RolesCreateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation RolesRolesCreate has not yet been implemented")
+		}),
+		RolesRolesDeleteHandler: roles.RolesDeleteHandlerFunc(func(params roles.RolesDeleteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation RolesRolesDelete has not yet been implemented")
+		}),
+		RolesRolesGetHandler: roles.RolesGetHandlerFunc(func(params roles.RolesGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation RolesRolesGet has not yet been implemented")
+		}),
+		RolesRolesListHandler: roles.RolesListHandlerFunc(func(params roles.RolesListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation RolesRolesList has not yet been implemented")
+		}),
+		RolesRolesUpdateHandler: roles.RolesUpdateHandlerFunc(func(params roles.RolesUpdateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation RolesRolesUpdate has not yet been implemented")
+		}),
 		SchemaSchemaActionsCreateHandler: schema.SchemaActionsCreateHandlerFunc(func(params schema.SchemaActionsCreateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation SchemaSchemaActionsCreate has not yet been implemented")
 		}),
@@ -155,12 +209,30 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		SchemaSchemaActionsPropertiesUpdateHandler: schema.SchemaActionsPropertiesUpdateHandlerFunc(func(params schema.SchemaActionsPropertiesUpdateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation SchemaSchemaActionsPropertiesUpdate has not yet been implemented")
 		}),
+		SchemaSchemaActionsRevectorizeHandler: schema.SchemaActionsRevectorizeHandlerFunc(func(params schema.SchemaActionsRevectorizeParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation SchemaSchemaActionsRevectorize has not yet been implemented")
+		}),
 		SchemaSchemaActionsUpdateHandler: schema.SchemaActionsUpdateHandlerFunc(func(params schema.SchemaActionsUpdateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation SchemaSchemaActionsUpdate has not yet been implemented")
 		}),
 		SchemaSchemaDumpHandler: schema.SchemaDumpHandlerFunc(func(params schema.SchemaDumpParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation SchemaSchemaDump has not yet been implemented")
 		}),
+		SchemaSchemaReindexesGetHandler: schema.SchemaReindexesGetHandlerFunc(func(params schema.SchemaReindexesGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation SchemaSchemaReindexesGet has not yet been implemented")
+		}),
+		SchemaSchemaReindexesListHandler: schema.SchemaReindexesListHandlerFunc(func(params schema.SchemaReindexesListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation SchemaSchemaReindexesList has not yet been implemented")
+		}),
+		SchemaSchemaRevectorizationsCancelHandler: schema.SchemaRevectorizationsCancelHandlerFunc(func(params schema.SchemaRevectorizationsCancelParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation SchemaSchemaRevectorizationsCancel has not yet been implemented")
+		}),
+		SchemaSchemaRevectorizationsGetHandler: schema.SchemaRevectorizationsGetHandlerFunc(func(params schema.SchemaRevectorizationsGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation SchemaSchemaRevectorizationsGet has not yet been implemented")
+		}),
+		SchemaSchemaRevectorizationsListHandler: schema.SchemaRevectorizationsListHandlerFunc(func(params schema.SchemaRevectorizationsListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation SchemaSchemaRevectorizationsList has not yet been implemented")
+		}),
 		SchemaSchemaThingsCreateHandler: schema.SchemaThingsCreateHandlerFunc(func(params schema.SchemaThingsCreateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation SchemaSchemaThingsCreate has not yet been implemented")
 		}),
@@ -176,6 +248,9 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		SchemaSchemaThingsPropertiesUpdateHandler: schema.SchemaThingsPropertiesUpdateHandlerFunc(func(params schema.SchemaThingsPropertiesUpdateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation SchemaSchemaThingsPropertiesUpdate has not yet been implemented")
 		}),
+		SchemaSchemaThingsRevectorizeHandler: schema.SchemaThingsRevectorizeHandlerFunc(func(params schema.SchemaThingsRevectorizeParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation SchemaSchemaThingsRevectorize has not yet been implemented")
+		}),
 		SchemaSchemaThingsUpdateHandler: schema.SchemaThingsUpdateHandlerFunc(func(params schema.SchemaThingsUpdateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation SchemaSchemaThingsUpdate has not yet been implemented")
 		}),
@@ -185,6 +260,9 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		ThingsThingsDeleteHandler: things.ThingsDeleteHandlerFunc(func(params things.ThingsDeleteParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation ThingsThingsDelete has not yet been implemented")
 		}),
+		ThingsThingsExportHandler: things.ThingsExportHandlerFunc(func(params things.ThingsExportParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation ThingsThingsExport has not yet been implemented")
+		}),
 		ThingsThingsGetHandler: things.ThingsGetHandlerFunc(func(params things.ThingsGetParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation ThingsThingsGet has not yet been implemented")
 		}),
@@ -223,6 +301,11 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 			return nil, errors.NotImplemented("oauth2 bearer auth (oidc) has not yet been implemented")
 		},
 
+		// Applies when the "X-Api-Key" header is set
+		XAPIKeyAuth: func(token string) (*models.Principal, error) {
+			return nil, errors.NotImplemented("api key auth (xApiKey) X-Api-Key from header param [X-Api-Key] has not yet been implemented")
+		},
+
 		// default authorizer is authorized meaning no requests are blocked
 		APIAuthorizer: security.Authorized(),
 	}
@@ -262,6 +345,10 @@ type WeaviateAPI struct {
 	// it performs authentication based on an oauth2 bearer token provided in the request
 	OidcAuth func(string, []string) (*models.Principal, error)
 
+	// XAPIKeyAuth registers a function that takes a token and returns a principal
+	// it performs authentication based on an api key X-Api-Key provided in the header
+	XAPIKeyAuth func(string) (*models.Principal, error)
+
 	// APIAuthorizer provides access control (ACL/RBAC/ABAC) by providing access to the request and authenticated principal
 	APIAuthorizer runtime.Authorizer
 
@@ -271,6 +358,8 @@ type WeaviateAPI struct {
 	ActionsActionsCreateHandler actions.ActionsCreateHandler
 	// ActionsActionsDeleteHandler sets the operation handler for the actions delete operation
 	ActionsActionsDeleteHandler actions.ActionsDeleteHandler
+	// ActionsActionsExportHandler sets the operation handler for the actions export operation
+	ActionsActionsExportHandler actions.ActionsExportHandler
 	// ActionsActionsGetHandler sets the operation handler for the actions get operation
 	ActionsActionsGetHandler actions.ActionsGetHandler
 	// ActionsActionsListHandler sets the operation handler for the actions list operation
@@ -287,12 +376,28 @@ type WeaviateAPI struct {
 	ActionsActionsUpdateHandler actions.ActionsUpdateHandler
 	// ActionsActionsValidateHandler sets the operation handler for the actions validate operation
 	ActionsActionsValidateHandler actions.ActionsValidateHandler
+	// BackupsBackupsCreateHandler sets the operation handler for the backups create operation
+	BackupsBackupsCreateHandler backups.BackupsCreateHandler
+	// BackupsBackupsGetHandler sets the operation handler for the backups get operation
+	BackupsBackupsGetHandler backups.BackupsGetHandler
+	// BackupsBackupsRestoreHandler sets the operation handler for the backups restore operation
+	BackupsBackupsRestoreHandler backups.BackupsRestoreHandler
+	// BackupsBackupsRestoreGetHandler sets the operation handler for the backups restore get operation
+	BackupsBackupsRestoreGetHandler backups.BackupsRestoreGetHandler
 	// BatchingBatchingActionsCreateHandler sets the operation handler for the batching actions create operation
 	BatchingBatchingActionsCreateHandler batching.BatchingActionsCreateHandler
+	// BatchingBatchingActionsDeleteHandler sets the operation handler for the batching actions delete operation
+	BatchingBatchingActionsDeleteHandler batching.BatchingActionsDeleteHandler
+	// BatchingBatchingActionsMergeHandler sets the operation handler for the batching actions merge operation
+	BatchingBatchingActionsMergeHandler batching.BatchingActionsMergeHandler
 	// BatchingBatchingReferencesCreateHandler sets the operation handler for the batching references create operation
 	BatchingBatchingReferencesCreateHandler batching.BatchingReferencesCreateHandler
 	// BatchingBatchingThingsCreateHandler sets the operation handler for the batching things create operation
 	BatchingBatchingThingsCreateHandler batching.BatchingThingsCreateHandler
+	// BatchingBatchingThingsDeleteHandler sets the operation handler for the batching things delete operation
+	BatchingBatchingThingsDeleteHandler batching.BatchingThingsDeleteHandler
+	// BatchingBatchingThingsMergeHandler sets the operation handler for the batching things merge operation
+	BatchingBatchingThingsMergeHandler batching.BatchingThingsMergeHandler
 	// ContextionaryAPIC11yConceptsHandler sets the operation handler for the c11y concepts operation
 	ContextionaryAPIC11yConceptsHandler contextionary_api.C11yConceptsHandler
 	// ContextionaryAPIC11yCorpusGetHandler sets the operation handler for the c11y corpus get operation
@@ -301,20 +406,36 @@ type WeaviateAPI struct {
 	ContextionaryAPIC11yExtensionsHandler contextionary_api.C11yExtensionsHandler
 	// ContextionaryAPIC11yWordsHandler sets the operation handler for the c11y words operation
 	ContextionaryAPIC11yWordsHandler contextionary_api.C11yWordsHandler
+	// ClassificationsClassificationsCancelHandler sets the operation handler for the classifications cancel operation
+	ClassificationsClassificationsCancelHandler classifications.ClassificationsCancelHandler
 	// ClassificationsClassificationsGetHandler sets the operation handler for the classifications get operation
 	ClassificationsClassificationsGetHandler classifications.ClassificationsGetHandler
+	// ClassificationsClassificationsListHandler sets the operation handler for the classifications list operation
+	ClassificationsClassificationsListHandler classifications.ClassificationsListHandler
 	// ClassificationsClassificationsPostHandler sets the operation handler for the classifications post operation
 	ClassificationsClassificationsPostHandler classifications.ClassificationsPostHandler
 	// GraphqlGraphqlBatchHandler sets the operation handler for the graphql batch operation
 	GraphqlGraphqlBatchHandler graphql.GraphqlBatchHandler
 	// GraphqlGraphqlPostHandler sets the operation handler for the graphql post operation
 	GraphqlGraphqlPostHandler graphql.GraphqlPostHandler
+	// MaintenanceMaintenanceDanglingReferencesHandler sets the operation handler for the maintenance dangling references operation
+	MaintenanceMaintenanceDanglingReferencesHandler maintenance.MaintenanceDanglingReferencesHandler
 	// MetaMetaGetHandler sets the operation handler for the meta get operation
 	MetaMetaGetHandler meta.MetaGetHandler
 	// P2pP2pGenesisUpdateHandler sets the operation handler for the p2p genesis update operation
 	P2pP2pGenesisUpdateHandler p2_p.P2pGenesisUpdateHandler
 	// P2pP2pHealthHandler sets the operation handler for the p2p health operation
 	P2pP2pHealthHandler p2_p.P2pHealthHandler
+	// RolesRolesCreateHandler sets the operation handler for the roles create operation
+	RolesRolesCreateHandler roles.RolesCreateHandler
+	// RolesRolesDeleteHandler sets the operation handler for the roles delete operation
+	RolesRolesDeleteHandler roles.RolesDeleteHandler
+	// RolesRolesGetHandler sets the operation handler for the roles get operation
+	RolesRolesGetHandler roles.RolesGetHandler
+	// RolesRolesListHandler sets the operation handler for the roles list operation
+	RolesRolesListHandler roles.RolesListHandler
+	// RolesRolesUpdateHandler sets the operation handler for the roles update operation
+	RolesRolesUpdateHandler roles.RolesUpdateHandler
 	// SchemaSchemaActionsCreateHandler sets the operation handler for the schema actions create operation
 	SchemaSchemaActionsCreateHandler schema.SchemaActionsCreateHandler
 	// SchemaSchemaActionsDeleteHandler sets the operation handler for the schema actions delete operation
@@ -325,10 +446,22 @@ type WeaviateAPI struct {
 	SchemaSchemaActionsPropertiesDeleteHandler schema.SchemaActionsPropertiesDeleteHandler
 	// SchemaSchemaActionsPropertiesUpdateHandler sets the operation handler for the schema actions properties update operation
 	SchemaSchemaActionsPropertiesUpdateHandler schema.SchemaActionsPropertiesUpdateHandler
+	// SchemaSchemaActionsRevectorizeHandler sets the operation handler for the schema actions revectorize operation
+	SchemaSchemaActionsRevectorizeHandler schema.SchemaActionsRevectorizeHandler
 	// SchemaSchemaActionsUpdateHandler sets the operation handler for the schema actions update operation
 	SchemaSchemaActionsUpdateHandler schema.SchemaActionsUpdateHandler
 	// SchemaSchemaDumpHandler sets the operation handler for the schema dump operation
 	SchemaSchemaDumpHandler schema.SchemaDumpHandler
+	// SchemaSchemaReindexesGetHandler sets the operation handler for the schema reindexes get operation
+	SchemaSchemaReindexesGetHandler schema.SchemaReindexesGetHandler
+	// SchemaSchemaReindexesListHandler sets the operation handler for the schema reindexes list operation
+	SchemaSchemaReindexesListHandler schema.SchemaReindexesListHandler
+	// SchemaSchemaRevectorizationsCancelHandler sets the operation handler for the schema revectorizations cancel operation
+	SchemaSchemaRevectorizationsCancelHandler schema.SchemaRevectorizationsCancelHandler
+	// SchemaSchemaRevectorizationsGetHandler sets the operation handler for the schema revectorizations get operation
+	SchemaSchemaRevectorizationsGetHandler schema.SchemaRevectorizationsGetHandler
+	// SchemaSchemaRevectorizationsListHandler sets the operation handler for the schema revectorizations list operation
+	SchemaSchemaRevectorizationsListHandler schema.SchemaRevectorizationsListHandler
 	// SchemaSchemaThingsCreateHandler sets the operation handler for the schema things create operation
 	SchemaSchemaThingsCreateHandler schema.SchemaThingsCreateHandler
 	// SchemaSchemaThingsDeleteHandler sets the operation handler for the schema things delete operation
@@ -339,12 +472,16 @@ type WeaviateAPI struct {
 	SchemaSchemaThingsPropertiesDeleteHandler schema.SchemaThingsPropertiesDeleteHandler
 	// SchemaSchemaThingsPropertiesUpdateHandler sets the operation handler for the schema things properties update operation
 	SchemaSchemaThingsPropertiesUpdateHandler schema.SchemaThingsPropertiesUpdateHandler
+	// SchemaSchemaThingsRevectorizeHandler sets the operation handler for the schema things revectorize operation
+	SchemaSchemaThingsRevectorizeHandler schema.SchemaThingsRevectorizeHandler
 	// SchemaSchemaThingsUpdateHandler sets the operation handler for the schema things update operation
 	SchemaSchemaThingsUpdateHandler schema.SchemaThingsUpdateHandler
 	// ThingsThingsCreateHandler sets the operation handler for the things create operation
 	ThingsThingsCreateHandler things.ThingsCreateHandler
 	// ThingsThingsDeleteHandler sets the operation handler for the things delete operation
 	ThingsThingsDeleteHandler things.ThingsDeleteHandler
+	// ThingsThingsExportHandler sets the operation handler for the things export operation
+	ThingsThingsExportHandler things.ThingsExportHandler
 	// ThingsThingsGetHandler sets the operation handler for the things get operation
 	ThingsThingsGetHandler things.ThingsGetHandler
 	// ThingsThingsListHandler sets the operation handler for the things list operation
@@ -438,6 +575,10 @@ func (o *WeaviateAPI) Validate() error {
 		unregistered = append(unregistered, "OidcAuth")
 	}
 
+	if o.XAPIKeyAuth == nil {
+		unregistered = append(unregistered, "XAPIKeyAuth")
+	}
+
 	if o.WellKnownGetWellKnownOpenidConfigurationHandler == nil {
 		unregistered = append(unregistered, "well_known.GetWellKnownOpenidConfigurationHandler")
 	}
@@ -450,6 +591,10 @@ func (o *WeaviateAPI) Validate() error {
 		unregistered = append(unregistered, "actions.ActionsDeleteHandler")
 	}
 
+	if o.ActionsActionsExportHandler == nil {
+		unregistered = append(unregistered, "actions.ActionsExportHandler")
+	}
+
 	if o.ActionsActionsGetHandler == nil {
 		unregistered = append(unregistered, "actions.ActionsGetHandler")
 	}
@@ -482,10 +627,34 @@ func (o *WeaviateAPI) Validate() error {
 		unregistered = append(unregistered, "actions.ActionsValidateHandler")
 	}
 
+	if o.BackupsBackupsCreateHandler == nil {
+		unregistered = append(unregistered, "backups.BackupsCreateHandler")
+	}
+
+	if o.BackupsBackupsGetHandler == nil {
+		unregistered = append(unregistered, "backups.BackupsGetHandler")
+	}
+
+	if o.BackupsBackupsRestoreHandler == nil {
+		unregistered = append(unregistered, "backups.BackupsRestoreHandler")
+	}
+
+	if o.BackupsBackupsRestoreGetHandler == nil {
+		unregistered = append(unregistered, "backups.BackupsRestoreGetHandler")
+	}
+
 	if o.BatchingBatchingActionsCreateHandler == nil {
 		unregistered = append(unregistered, "batching.BatchingActionsCreateHandler")
 	}
 
+	if o.BatchingBatchingActionsDeleteHandler == nil {
+		unregistered = append(unregistered, "batching.BatchingActionsDeleteHandler")
+	}
+
+	if o.BatchingBatchingActionsMergeHandler == nil {
+		unregistered = append(unregistered, "batching.BatchingActionsMergeHandler")
+	}
+
 	if o.BatchingBatchingReferencesCreateHandler == nil {
 		unregistered = append(unregistered, "batching.BatchingReferencesCreateHandler")
 	}
@@ -494,6 +663,14 @@ func (o *WeaviateAPI) Validate() error {
 		unregistered = append(unregistered, "batching.BatchingThingsCreateHandler")
 	}
 
+	if o.BatchingBatchingThingsDeleteHandler == nil {
+		unregistered = append(unregistered, "batching.BatchingThingsDeleteHandler")
+	}
+
+	if o.BatchingBatchingThingsMergeHandler == nil {
+		unregistered = append(unregistered, "batching.BatchingThingsMergeHandler")
+	}
+
 	if o.ContextionaryAPIC11yConceptsHandler == nil {
 		unregistered = append(unregistered, "contextionary_api.C11yConceptsHandler")
 	}
@@ -510,10 +687,18 @@ func (o *WeaviateAPI) Validate() error {
 		unregistered = append(unregistered, "contextionary_api.C11yWordsHandler")
 	}
 
+	if o.ClassificationsClassificationsCancelHandler == nil {
+		unregistered = append(unregistered, "classifications.ClassificationsCancelHandler")
+	}
+
 	if o.ClassificationsClassificationsGetHandler == nil {
 		unregistered = append(unregistered, "classifications.ClassificationsGetHandler")
 	}
 
+	if o.ClassificationsClassificationsListHandler == nil {
+		unregistered = append(unregistered, "classifications.ClassificationsListHandler")
+	}
+
 	if o.ClassificationsClassificationsPostHandler == nil {
 		unregistered = append(unregistered, "classifications.ClassificationsPostHandler")
 	}
@@ -526,6 +711,10 @@ func (o *WeaviateAPI) Validate() error {
 		unregistered = append(unregistered, "graphql.GraphqlPostHandler")
 	}
 
+	if o.MaintenanceMaintenanceDanglingReferencesHandler == nil {
+		unregistered = append(unregistered, "maintenance.MaintenanceDanglingReferencesHandler")
+	}
+
 	if o.MetaMetaGetHandler == nil {
 		unregistered = append(unregistered, "meta.MetaGetHandler")
 	}
@@ -538,6 +727,26 @@ func (o *WeaviateAPI) Validate() error {
 		unregistered = append(unregistered, "p2_p.P2pHealthHandler")
 	}
 
+	if o.RolesRolesCreateHandler == nil {
+		unregistered = append(unregistered, "roles.RolesCreateHandler")
+	}
+
+	if o.RolesRolesDeleteHandler == nil {
+		unregistered = append(unregistered, "roles.RolesDeleteHandler")
+	}
+
+	if o.RolesRolesGetHandler == nil {
+		unregistered = append(unregistered, "roles.RolesGetHandler")
+	}
+
+	if o.RolesRolesListHandler == nil {
+		unregistered = append(unregistered, "roles.RolesListHandler")
+	}
+
+	if o.RolesRolesUpdateHandler == nil {
+		unregistered = append(unregistered, "roles.RolesUpdateHandler")
+	}
+
 	if o.SchemaSchemaActionsCreateHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaActionsCreateHandler")
 	}
@@ -558,6 +767,10 @@ func (o *WeaviateAPI) Validate() error {
 		unregistered = append(unregistered, "schema.SchemaActionsPropertiesUpdateHandler")
 	}
 
+	if o.SchemaSchemaActionsRevectorizeHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaActionsRevectorizeHandler")
+	}
+
 	if o.SchemaSchemaActionsUpdateHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaActionsUpdateHandler")
 	}
@@ -566,6 +779,26 @@ func (o *WeaviateAPI) Validate() error {
 		unregistered = append(unregistered, "schema.SchemaDumpHandler")
 	}
 
+	if o.SchemaSchemaReindexesGetHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaReindexesGetHandler")
+	}
+
+	if o.SchemaSchemaReindexesListHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaReindexesListHandler")
+	}
+
+	if o.SchemaSchemaRevectorizationsCancelHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaRevectorizationsCancelHandler")
+	}
+
+	if o.SchemaSchemaRevectorizationsGetHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaRevectorizationsGetHandler")
+	}
+
+	if o.SchemaSchemaRevectorizationsListHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaRevectorizationsListHandler")
+	}
+
 	if o.SchemaSchemaThingsCreateHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaThingsCreateHandler")
 	}
@@ -586,6 +819,10 @@ func (o *WeaviateAPI) Validate() error {
 		unregistered = append(unregistered, "schema.SchemaThingsPropertiesUpdateHandler")
 	}
 
+	if o.SchemaSchemaThingsRevectorizeHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaThingsRevectorizeHandler")
+	}
+
 	if o.SchemaSchemaThingsUpdateHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaThingsUpdateHandler")
 	}
@@ -598,6 +835,10 @@ func (o *WeaviateAPI) Validate() error {
 		unregistered = append(unregistered, "things.ThingsDeleteHandler")
 	}
 
+	if o.ThingsThingsExportHandler == nil {
+		unregistered = append(unregistered, "things.ThingsExportHandler")
+	}
+
 	if o.ThingsThingsGetHandler == nil {
 		unregistered = append(unregistered, "things.ThingsGetHandler")
 	}
@@ -667,6 +908,13 @@ func (o *WeaviateAPI) AuthenticatorsFor(schemes map[string]spec.SecurityScheme)
 				return o.OidcAuth(token, scopes)
 			})
 
+		case "xApiKey":
+
+			scheme := schemes[name]
+			result[name] = o.APIKeyAuthenticator(scheme.Name, scheme.In, func(token string) (interface{}, error) {
+				return o.XAPIKeyAuth(token)
+			})
+
 		}
 	}
 	return result
@@ -713,6 +961,9 @@ func (o *WeaviateAPI) ProducersFor(mediaTypes []string) map[string]runtime.Produ
 		case "application/json":
 			result["application/json"] = o.JSONProducer
 
+		case "application/x-ndjson":
+			result["application/x-ndjson"] = o.JSONProducer
+
 		}
 
 		if p, ok := o.customProducers[mt]; ok {
@@ -770,6 +1021,11 @@ func (o *WeaviateAPI) initHandlerCache() {
 	}
 	o.handlers["DELETE"]["/actions/{id}"] = actions.NewActionsDelete(o.context, o.ActionsActionsDeleteHandler)
 
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/actions/export"] = actions.NewActionsExport(o.context, o.ActionsActionsExportHandler)
+
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -810,11 +1066,41 @@ func (o *WeaviateAPI) initHandlerCache() {
 	}
 	o.handlers["POST"]["/actions/validate"] = actions.NewActionsValidate(o.context, o.ActionsActionsValidateHandler)
 
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/backups"] = backups.NewBackupsCreate(o.context, o.BackupsBackupsCreateHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/backups/{id}"] = backups.NewBackupsGet(o.context, o.BackupsBackupsGetHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/backups/{id}/restore"] = backups.NewBackupsRestore(o.context, o.BackupsBackupsRestoreHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/backups/{id}/restore"] = backups.NewBackupsRestoreGet(o.context, o.BackupsBackupsRestoreGetHandler)
+
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/batching/actions"] = batching.NewBatchingActionsCreate(o.context, o.BatchingBatchingActionsCreateHandler)
 
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/batching/actions"] = batching.NewBatchingActionsDelete(o.context, o.BatchingBatchingActionsDeleteHandler)
+
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
+	o.handlers["PATCH"]["/batching/actions"] = batching.NewBatchingActionsMerge(o.context, o.BatchingBatchingActionsMergeHandler)
+
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -825,6 +1111,16 @@ func (o *WeaviateAPI) initHandlerCache() {
 	}
 	o.handlers["POST"]["/batching/things"] = batching.NewBatchingThingsCreate(o.context, o.BatchingBatchingThingsCreateHandler)
 
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/batching/things"] = batching.NewBatchingThingsDelete(o.context, o.BatchingBatchingThingsDeleteHandler)
+
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
+	o.handlers["PATCH"]["/batching/things"] = batching.NewBatchingThingsMerge(o.context, o.BatchingBatchingThingsMergeHandler)
+
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -845,11 +1141,21 @@ func (o *WeaviateAPI) initHandlerCache() {
 	}
 	o.handlers["GET"]["/c11y/words/{words}"] = contextionary_api.NewC11yWords(o.context, o.ContextionaryAPIC11yWordsHandler)
 
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/classifications/{id}"] = classifications.NewClassificationsCancel(o.context, o.ClassificationsClassificationsCancelHandler)
+
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/classifications/{id}"] = classifications.NewClassificationsGet(o.context, o.ClassificationsClassificationsGetHandler)
 
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/classifications"] = classifications.NewClassificationsList(o.context, o.ClassificationsClassificationsListHandler)
+
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -865,6 +1171,11 @@ func (o *WeaviateAPI) initHandlerCache() {
 	}
 	o.handlers["POST"]["/graphql"] = graphql.NewGraphqlPost(o.context, o.GraphqlGraphqlPostHandler)
 
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/maintenance/dangling-references"] = maintenance.NewMaintenanceDanglingReferences(o.context, o.MaintenanceMaintenanceDanglingReferencesHandler)
+
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -880,6 +1191,31 @@ func (o *WeaviateAPI) initHandlerCache() {
 	}
 	o.handlers["GET"]["/p2p/health"] = p2_p.NewP2pHealth(o.context, o.P2pP2pHealthHandler)
 
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/roles"] = roles.NewRolesCreate(o.context, o.RolesRolesCreateHandler)
+
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/roles/{name}"] = roles.NewRolesDelete(o.context, o.RolesRolesDeleteHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/roles/{name}"] = roles.NewRolesGet(o.context, o.RolesRolesGetHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/roles"] = roles.NewRolesList(o.context, o.RolesRolesListHandler)
+
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/roles/{name}"] = roles.NewRolesUpdate(o.context, o.RolesRolesUpdateHandler)
+
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -905,6 +1241,11 @@ func (o *WeaviateAPI) initHandlerCache() {
 	}
 	o.handlers["PUT"]["/schema/actions/{className}/properties/{propertyName}"] = schema.NewSchemaActionsPropertiesUpdate(o.context, o.SchemaSchemaActionsPropertiesUpdateHandler)
 
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/actions/{className}/revectorize"] = schema.NewSchemaActionsRevectorize(o.context, o.SchemaSchemaActionsRevectorizeHandler)
+
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -915,6 +1256,31 @@ func (o *WeaviateAPI) initHandlerCache() {
 	}
 	o.handlers["GET"]["/schema"] = schema.NewSchemaDump(o.context, o.SchemaSchemaDumpHandler)
 
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/reindexes/{id}"] = schema.NewSchemaReindexesGet(o.context, o.SchemaSchemaReindexesGetHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/reindexes"] = schema.NewSchemaReindexesList(o.context, o.SchemaSchemaReindexesListHandler)
+
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/schema/revectorizations/{id}"] = schema.NewSchemaRevectorizationsCancel(o.context, o.SchemaSchemaRevectorizationsCancelHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/revectorizations/{id}"] = schema.NewSchemaRevectorizationsGet(o.context, o.SchemaSchemaRevectorizationsGetHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/revectorizations"] = schema.NewSchemaRevectorizationsList(o.context, o.SchemaSchemaRevectorizationsListHandler)
+
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -940,6 +1306,11 @@ func (o *WeaviateAPI) initHandlerCache() {
 	}
 	o.handlers["PUT"]["/schema/things/{className}/properties/{propertyName}"] = schema.NewSchemaThingsPropertiesUpdate(o.context, o.SchemaSchemaThingsPropertiesUpdateHandler)
 
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/things/{className}/revectorize"] = schema.NewSchemaThingsRevectorize(o.context, o.SchemaSchemaThingsRevectorizeHandler)
+
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -955,6 +1326,11 @@ func (o *WeaviateAPI) initHandlerCache() {
 	}
 	o.handlers["DELETE"]["/things/{id}"] = things.NewThingsDelete(o.context, o.ThingsThingsDeleteHandler)
 
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/things/export"] = things.NewThingsExport(o.context, o.ThingsThingsExportHandler)
+
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -19,6 +19,7 @@ import (
 	"github.com/semi-technologies/weaviate/adapters/handlers/graphql"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/usecases/auth/authentication/anonymous"
+	"github.com/semi-technologies/weaviate/usecases/auth/authentication/apikey"
 	"github.com/semi-technologies/weaviate/usecases/auth/authentication/oidc"
 	"github.com/semi-technologies/weaviate/usecases/auth/authorization"
 	"github.com/semi-technologies/weaviate/usecases/config"
@@ -36,6 +37,7 @@ type State struct {
 	Network          network.Network
 	OIDC             *oidc.Client
 	AnonymousAccess  *anonymous.Client
+	APIKey           *apikey.Client
 	Authorizer       authorization.Authorizer
 	ServerConfig     *config.WeaviateConfig
 	Locks            locks.ConnectorSchemaLock
@@ -0,0 +1,394 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/semi-technologies/weaviate/entities/aggregation"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	bolt "go.etcd.io/bbolt"
+)
+
+const defaultGroupLimit = 100
+
+// Aggregate computes the requested aggregations in memory over all objects
+// of the class matching the filters
+func (d *DB) Aggregate(ctx context.Context, params traverser.AggregateParams) (*aggregation.Result, error) {
+	if params.GroupBy != nil && len(params.GroupBy.Slice()) > 1 {
+		return nil, fmt.Errorf("grouping by cross-refs not supported yet")
+	}
+
+	var matches []scoredObject
+	err := d.db.View(func(tx *bolt.Tx) error {
+		var err error
+		matches, err = d.findMatches(ctx, tx, searchParams{
+			kind:      params.Kind,
+			className: params.ClassName.String(),
+			limit:     -1,
+			filters:   params.Filters,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("aggregate: %v", err)
+	}
+
+	objects := make([]*storageObject, len(matches))
+	for i, match := range matches {
+		objects[i] = match.obj
+	}
+
+	if params.GroupBy == nil {
+		group, err := d.aggregateGroup(params, objects)
+		if err != nil {
+			return nil, fmt.Errorf("aggregate: %v", err)
+		}
+
+		return &aggregation.Result{Groups: []aggregation.Group{group}}, nil
+	}
+
+	groups, err := d.aggregateGroups(params, objects)
+	if err != nil {
+		return nil, fmt.Errorf("aggregate: %v", err)
+	}
+
+	return &aggregation.Result{Groups: groups}, nil
+}
+
+type objectGroup struct {
+	value   interface{}
+	objects []*storageObject
+}
+
+func (d *DB) aggregateGroups(params traverser.AggregateParams,
+	objects []*storageObject) ([]aggregation.Group, error) {
+	path := params.GroupBy.Slice()
+	propName := params.GroupBy.Property.String()
+
+	var groups []*objectGroup
+	byKey := map[string]*objectGroup{}
+	for _, obj := range objects {
+		value, ok := obj.Properties[propName]
+		if !ok || value == nil {
+			continue
+		}
+
+		if _, isRef := value.([]interface{}); isRef {
+			return nil, fmt.Errorf("grouping by cross-refs not supported yet")
+		}
+
+		key := fmt.Sprintf("%T:%v", value, value)
+		group, ok := byKey[key]
+		if !ok {
+			group = &objectGroup{value: value}
+			byKey[key] = group
+			groups = append(groups, group)
+		}
+		group.objects = append(group.objects, obj)
+	}
+
+	sort.SliceStable(groups, func(a, b int) bool {
+		return len(groups[a].objects) > len(groups[b].objects)
+	})
+
+	limit := defaultGroupLimit
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	if len(groups) > limit {
+		groups = groups[:limit]
+	}
+
+	out := make([]aggregation.Group, len(groups))
+	for i, group := range groups {
+		res, err := d.aggregateGroup(params, group.objects)
+		if err != nil {
+			return nil, fmt.Errorf("group %d: %v", i, err)
+		}
+
+		res.GroupedBy = &aggregation.GroupedBy{
+			Path:  path,
+			Value: group.value,
+		}
+		out[i] = res
+	}
+
+	return out, nil
+}
+
+func (d *DB) aggregateGroup(params traverser.AggregateParams,
+	objects []*storageObject) (aggregation.Group, error) {
+	group := aggregation.Group{
+		Properties: map[string]aggregation.Property{},
+		Count:      len(objects),
+	}
+
+	for _, prop := range params.Properties {
+		res, ok, err := d.aggregateProperty(params.ClassName.String(), prop, objects)
+		if err != nil {
+			return group, fmt.Errorf("property %s: %v", prop.Name, err)
+		}
+
+		if !ok {
+			continue
+		}
+
+		group.Properties[prop.Name.String()] = res
+	}
+
+	return group, nil
+}
+
+// aggregateProperty returns false if no aggregation could be computed for
+// the property, e.g. because only aggregators were requested that are
+// handled outside of the repo (type, pointingTo)
+func (d *DB) aggregateProperty(className string, prop traverser.AggregateProperty,
+	objects []*storageObject) (aggregation.Property, bool, error) {
+	dt, _ := d.propDataType(className, prop.Name.String())
+	if dt == nil {
+		return aggregation.Property{}, false, fmt.Errorf("property not found in schema")
+	}
+
+	if !dt.IsPrimitive() {
+		return aggregation.Property{}, false, nil
+	}
+
+	values := make([]interface{}, 0, len(objects))
+	for _, obj := range objects {
+		value, ok := obj.Properties[prop.Name.String()]
+		if !ok || value == nil {
+			continue
+		}
+
+		values = append(values, value)
+	}
+
+	switch dt.AsPrimitive() {
+	case schema.DataTypeInt, schema.DataTypeNumber:
+		return aggregateNumerical(prop.Aggregators, values)
+	case schema.DataTypeBoolean:
+		return aggregateBoolean(prop.Aggregators, values)
+	case schema.DataTypeString, schema.DataTypeText, schema.DataTypeDate:
+		return aggregateText(prop.Aggregators, values)
+	default:
+		return aggregation.Property{}, false, nil
+	}
+}
+
+func aggregateNumerical(aggs []traverser.Aggregator,
+	values []interface{}) (aggregation.Property, bool, error) {
+	numbers := make([]float64, len(values))
+	for i, value := range values {
+		number, ok := toFloat64(value)
+		if !ok {
+			return aggregation.Property{}, false,
+				fmt.Errorf("expected numerical value, got %T", value)
+		}
+		numbers[i] = number
+	}
+	sort.Float64s(numbers)
+
+	res := map[string]float64{}
+	for _, agg := range aggs {
+		switch agg {
+		case traverser.CountAggregator:
+			res[agg.String()] = float64(len(numbers))
+		case traverser.SumAggregator:
+			res[agg.String()] = roundDecimals(sum(numbers))
+		}
+
+		if len(numbers) == 0 {
+			// all other aggregations are undefined without any values
+			continue
+		}
+
+		switch agg {
+		case traverser.MeanAggregator:
+			res[agg.String()] = roundDecimals(sum(numbers) / float64(len(numbers)))
+		case traverser.MinimumAggregator:
+			res[agg.String()] = roundDecimals(numbers[0])
+		case traverser.MaximumAggregator:
+			res[agg.String()] = roundDecimals(numbers[len(numbers)-1])
+		case traverser.MedianAggregator:
+			res[agg.String()] = roundDecimals(median(numbers))
+		case traverser.ModeAggregator:
+			res[agg.String()] = roundDecimals(mode(numbers))
+		}
+	}
+
+	if len(res) == 0 {
+		return aggregation.Property{}, false, nil
+	}
+
+	return aggregation.Property{
+		Type:                  aggregation.PropertyTypeNumerical,
+		NumericalAggregations: res,
+	}, true, nil
+}
+
+func sum(numbers []float64) float64 {
+	var out float64
+	for _, number := range numbers {
+		out += number
+	}
+
+	return out
+}
+
+// median of an already sorted list
+func median(numbers []float64) float64 {
+	middle := len(numbers) / 2
+	if len(numbers)%2 == 1 {
+		return numbers[middle]
+	}
+
+	return (numbers[middle-1] + numbers[middle]) / 2
+}
+
+// mode of an already sorted list, on a tie the smallest value wins
+func mode(numbers []float64) float64 {
+	var (
+		best      float64
+		bestCount int
+		current   float64
+		count     int
+	)
+
+	for i, number := range numbers {
+		if i == 0 || number != current {
+			current = number
+			count = 0
+		}
+
+		count++
+		if count > bestCount {
+			best = current
+			bestCount = count
+		}
+	}
+
+	return best
+}
+
+func aggregateBoolean(aggs []traverser.Aggregator,
+	values []interface{}) (aggregation.Property, bool, error) {
+	if !hasAnyAggregator(aggs, traverser.CountAggregator, traverser.TotalTrueAggregator,
+		traverser.TotalFalseAggregator, traverser.PercentageTrueAggregator,
+		traverser.PercentageFalseAggregator) {
+		return aggregation.Property{}, false, nil
+	}
+
+	var agg aggregation.Boolean
+	for _, value := range values {
+		b, ok := value.(bool)
+		if !ok {
+			return aggregation.Property{}, false,
+				fmt.Errorf("expected boolean value, got %T", value)
+		}
+
+		if b {
+			agg.TotalTrue++
+		} else {
+			agg.TotalFalse++
+		}
+	}
+
+	agg.Count = agg.TotalTrue + agg.TotalFalse
+	if agg.Count > 0 {
+		agg.PercentageTrue = roundDecimals(float64(agg.TotalTrue) / float64(agg.Count))
+		agg.PercentageFalse = roundDecimals(float64(agg.TotalFalse) / float64(agg.Count))
+	}
+
+	return aggregation.Property{
+		Type:               aggregation.PropertyTypeBoolean,
+		BooleanAggregation: agg,
+	}, true, nil
+}
+
+func aggregateText(aggs []traverser.Aggregator,
+	values []interface{}) (aggregation.Property, bool, error) {
+	limit := -1
+	for _, agg := range aggs {
+		if agg.Type == traverser.NewTopOccurrencesAggregator(nil).Type {
+			limit = 5
+			if agg.Limit != nil {
+				limit = *agg.Limit
+			}
+		}
+	}
+
+	if limit < 0 && !hasAnyAggregator(aggs, traverser.CountAggregator) {
+		return aggregation.Property{}, false, nil
+	}
+
+	occurrences := map[string]int{}
+	for _, value := range values {
+		s, ok := value.(string)
+		if !ok {
+			return aggregation.Property{}, false,
+				fmt.Errorf("expected string value, got %T", value)
+		}
+		occurrences[s]++
+	}
+
+	items := make([]aggregation.TextOccurrence, 0, len(occurrences))
+	for value, occurs := range occurrences {
+		items = append(items, aggregation.TextOccurrence{Value: value, Occurs: occurs})
+	}
+
+	sort.Slice(items, func(a, b int) bool {
+		if items[a].Occurs != items[b].Occurs {
+			return items[a].Occurs > items[b].Occurs
+		}
+
+		return items[a].Value < items[b].Value
+	})
+
+	if limit < 0 {
+		items = nil
+	} else if len(items) > limit {
+		items = items[:limit]
+	}
+
+	return aggregation.Property{
+		Type: aggregation.PropertyTypeText,
+		TextAggregation: aggregation.Text{
+			Items: items,
+			Count: len(values),
+		},
+	}, true, nil
+}
+
+func hasAnyAggregator(aggs []traverser.Aggregator, wanted ...traverser.Aggregator) bool {
+	for _, agg := range aggs {
+		for _, w := range wanted {
+			if agg.Type == w.Type {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func roundDecimals(in float64) float64 {
+	multiplier := math.Pow(10, 5)
+	return math.Round(in*multiplier) / multiplier
+}
@@ -0,0 +1,164 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/aggregation"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregations(t *testing.T) {
+	repo, cleanup := newTestDB(t)
+	defer cleanup()
+
+	t.Run("ungrouped", func(t *testing.T) {
+		params := traverser.AggregateParams{
+			Kind:             kind.Thing,
+			ClassName:        "Product",
+			IncludeMetaCount: true,
+			Properties: []traverser.AggregateProperty{
+				traverser.AggregateProperty{
+					Name: "price",
+					Aggregators: []traverser.Aggregator{
+						traverser.MeanAggregator,
+						traverser.MaximumAggregator,
+						traverser.MinimumAggregator,
+						traverser.SumAggregator,
+						traverser.ModeAggregator,
+						traverser.MedianAggregator,
+						traverser.CountAggregator,
+						traverser.TypeAggregator, // ignored in the repo
+					},
+				},
+				traverser.AggregateProperty{
+					Name: "available",
+					Aggregators: []traverser.Aggregator{
+						traverser.TotalTrueAggregator,
+					},
+				},
+				traverser.AggregateProperty{
+					Name: "name",
+					Aggregators: []traverser.Aggregator{
+						traverser.NewTopOccurrencesAggregator(ptInt(2)),
+					},
+				},
+			},
+		}
+
+		res, err := repo.Aggregate(context.Background(), params)
+		require.Nil(t, err)
+
+		expected := &aggregation.Result{
+			Groups: []aggregation.Group{
+				aggregation.Group{
+					Count: 3,
+					Properties: map[string]aggregation.Property{
+						"price": aggregation.Property{
+							Type: aggregation.PropertyTypeNumerical,
+							NumericalAggregations: map[string]float64{
+								"mean":    23.33333,
+								"maximum": 30,
+								"minimum": 10,
+								"sum":     70,
+								"mode":    30,
+								"median":  30,
+								"count":   3,
+							},
+						},
+						"available": aggregation.Property{
+							Type: aggregation.PropertyTypeBoolean,
+							BooleanAggregation: aggregation.Boolean{
+								Count:           3,
+								TotalTrue:       2,
+								TotalFalse:      1,
+								PercentageTrue:  0.66667,
+								PercentageFalse: 0.33333,
+							},
+						},
+						"name": aggregation.Property{
+							Type: aggregation.PropertyTypeText,
+							TextAggregation: aggregation.Text{
+								Count: 3,
+								Items: []aggregation.TextOccurrence{
+									aggregation.TextOccurrence{Value: "anvil", Occurs: 1},
+									aggregation.TextOccurrence{Value: "magnet", Occurs: 1},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		assert.Equal(t, expected, res)
+	})
+
+	t.Run("grouped by price", func(t *testing.T) {
+		params := traverser.AggregateParams{
+			Kind:      kind.Thing,
+			ClassName: "Product",
+			GroupBy:   &filters.Path{Class: "Product", Property: "price"},
+			Properties: []traverser.AggregateProperty{
+				traverser.AggregateProperty{
+					Name:        "available",
+					Aggregators: []traverser.Aggregator{traverser.TotalTrueAggregator},
+				},
+			},
+		}
+
+		res, err := repo.Aggregate(context.Background(), params)
+		require.Nil(t, err)
+		require.Len(t, res.Groups, 2)
+
+		assert.Equal(t, 2, res.Groups[0].Count)
+		assert.Equal(t, &aggregation.GroupedBy{Path: []string{"price"}, Value: 30.0},
+			res.Groups[0].GroupedBy)
+		assert.Equal(t, 1, res.Groups[0].Properties["available"].BooleanAggregation.TotalTrue)
+
+		assert.Equal(t, 1, res.Groups[1].Count)
+		assert.Equal(t, &aggregation.GroupedBy{Path: []string{"price"}, Value: 10.0},
+			res.Groups[1].GroupedBy)
+	})
+
+	t.Run("with a filter", func(t *testing.T) {
+		params := traverser.AggregateParams{
+			Kind:      kind.Thing,
+			ClassName: "Product",
+			Filters:   filterEqual("available", schema.DataTypeBoolean, true),
+			Properties: []traverser.AggregateProperty{
+				traverser.AggregateProperty{
+					Name:        "price",
+					Aggregators: []traverser.Aggregator{traverser.SumAggregator},
+				},
+			},
+		}
+
+		res, err := repo.Aggregate(context.Background(), params)
+		require.Nil(t, err)
+		require.Len(t, res.Groups, 1)
+		assert.Equal(t, 40.0, res.Groups[0].Properties["price"].NumericalAggregations["sum"])
+	})
+}
+
+func ptInt(in int) *int {
+	return &in
+}
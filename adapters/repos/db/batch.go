@@ -0,0 +1,124 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+	bolt "go.etcd.io/bbolt"
+)
+
+// BatchPutThings writes all things without a previous error in a single
+// transaction. Errors on individual items are recorded on the item itself.
+func (d *DB) BatchPutThings(ctx context.Context, batch kinds.BatchThings) (kinds.BatchThings, error) {
+	err := d.db.Update(func(tx *bolt.Tx) error {
+		for i, item := range batch {
+			if item.Err != nil {
+				// ignore concepts that already have an error
+				continue
+			}
+
+			thing := item.Thing
+			obj, err := newStorageObject(kind.Thing, thing.ID, thing.Class,
+				thing.Schema, thing.Meta, thing.VectorWeights, item.Vector,
+				thing.CreationTimeUnix, thing.LastUpdateTimeUnix)
+			if err == nil {
+				err = d.putObject(tx, obj)
+			}
+
+			if err != nil {
+				batch[i].Err = err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("batch put things: %v", err)
+	}
+
+	return batch, nil
+}
+
+// BatchPutActions writes all actions without a previous error in a single
+// transaction. Errors on individual items are recorded on the item itself.
+func (d *DB) BatchPutActions(ctx context.Context, batch kinds.BatchActions) (kinds.BatchActions, error) {
+	err := d.db.Update(func(tx *bolt.Tx) error {
+		for i, item := range batch {
+			if item.Err != nil {
+				// ignore concepts that already have an error
+				continue
+			}
+
+			action := item.Action
+			obj, err := newStorageObject(kind.Action, action.ID, action.Class,
+				action.Schema, action.Meta, action.VectorWeights, item.Vector,
+				action.CreationTimeUnix, action.LastUpdateTimeUnix)
+			if err == nil {
+				err = d.putObject(tx, obj)
+			}
+
+			if err != nil {
+				batch[i].Err = err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("batch put actions: %v", err)
+	}
+
+	return batch, nil
+}
+
+// BatchDeleteObjects deletes the objects of the class in a single
+// transaction, objects which don't exist or belong to another class are
+// marked as failed
+func (d *DB) BatchDeleteObjects(ctx context.Context, k kind.Kind, className string,
+	batch kinds.BatchDeleteObjects) (kinds.BatchDeleteObjects, error) {
+	err := d.db.Update(func(tx *bolt.Tx) error {
+		for i, single := range batch {
+			if single.Err != nil {
+				continue
+			}
+
+			obj, err := d.objectByID(tx, single.UUID)
+			if err != nil {
+				return err
+			}
+
+			if obj == nil || obj.Kind != k || obj.ClassName != className {
+				batch[i].Err = fmt.Errorf("%s '%s' of class '%s' not found",
+					k.Name(), single.UUID, className)
+				continue
+			}
+
+			d.deleteFromVectorIndexOnCommit(tx, obj)
+			if err := d.deleteObject(tx, obj); err != nil {
+				return fmt.Errorf("object %s: %v", obj.ID, err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("batch delete: %v", err)
+	}
+
+	return batch, nil
+}
@@ -0,0 +1,209 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/semi-technologies/weaviate/entities/schema"
+	bolt "go.etcd.io/bbolt"
+)
+
+// the default BM25 parameters, k1 limits the impact of a term occurring
+// many times, b controls how much longer fields are penalized
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// bm25Doc holds the term frequencies of the query terms and the field length
+// for each searched property of a single object
+type bm25Doc struct {
+	obj     *storageObject
+	freqs   map[string]map[string]int
+	lengths map[string]int
+}
+
+// bm25Stats are the corpus-wide statistics of each searched property
+type bm25Stats struct {
+	docCount    map[string]int
+	totalLength map[string]int
+	docFreq     map[string]map[string]int
+}
+
+func newBM25Stats() *bm25Stats {
+	return &bm25Stats{
+		docCount:    map[string]int{},
+		totalLength: map[string]int{},
+		docFreq:     map[string]map[string]int{},
+	}
+}
+
+func (s *bm25Stats) add(doc bm25Doc) {
+	for prop, length := range doc.lengths {
+		s.docCount[prop]++
+		s.totalLength[prop] += length
+	}
+
+	for prop, freqs := range doc.freqs {
+		if s.docFreq[prop] == nil {
+			s.docFreq[prop] = map[string]int{}
+		}
+
+		for term := range freqs {
+			s.docFreq[prop][term]++
+		}
+	}
+}
+
+// score sums up the BM25 scores of all query terms in all properties
+func (s *bm25Stats) score(doc bm25Doc) float64 {
+	var score float64
+	for prop, freqs := range doc.freqs {
+		n := float64(s.docCount[prop])
+		avgLength := float64(s.totalLength[prop]) / n
+		length := float64(doc.lengths[prop])
+
+		for term, freq := range freqs {
+			df := float64(s.docFreq[prop][term])
+			idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+			tf := float64(freq)
+			score += idf * tf * (bm25K1 + 1) /
+				(tf + bm25K1*(1-bm25B+bm25B*length/avgLength))
+		}
+	}
+
+	return score
+}
+
+// findKeywordMatches ranks all objects matching the filters by their BM25
+// score for the query. The statistics are built from all objects of the
+// class, so that filters don't change the relevance of a term. Objects
+// without any of the query terms are not part of the results.
+func (d *DB) findKeywordMatches(ctx context.Context, tx *bolt.Tx,
+	params searchParams) ([]scoredObject, error) {
+	query := strings.Fields(params.keywordRanking.Query)
+	stats := newBM25Stats()
+	var docs []bm25Doc
+	m := newMatcher(d, tx)
+
+	err := d.iterate(tx, params.kind, params.className, nil,
+		func(obj *storageObject) (bool, error) {
+			if err := ctx.Err(); err != nil {
+				return false, err
+			}
+
+			doc := d.newBM25Doc(obj, params.keywordRanking.Properties, query)
+			stats.add(doc)
+
+			if len(doc.freqs) == 0 {
+				return true, nil
+			}
+
+			ok, err := m.matches(obj, params.filters)
+			if err != nil {
+				return false, err
+			}
+
+			if ok {
+				docs = append(docs, doc)
+			}
+
+			return true, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	matches := make([]scoredObject, len(docs))
+	for i, doc := range docs {
+		matches[i] = scoredObject{obj: doc.obj, score: float32(stats.score(doc))}
+	}
+
+	// objects are iterated in id order, a stable sort keeps the id as the
+	// tie breaker
+	sort.SliceStable(matches, func(a, b int) bool {
+		return matches[a].score > matches[b].score
+	})
+
+	if params.offset >= len(matches) {
+		return nil, nil
+	}
+	matches = matches[params.offset:]
+
+	if params.limit >= 0 && len(matches) > params.limit {
+		matches = matches[:params.limit]
+	}
+
+	return matches, nil
+}
+
+// newBM25Doc tokenizes the searched properties of the object. Text is split
+// into lowercased words, a string is a single token which needs to match one
+// of the whitespace-separated query terms exactly, such as a product code.
+func (d *DB) newBM25Doc(obj *storageObject, props []string, query []string) bm25Doc {
+	doc := bm25Doc{
+		obj:     obj,
+		freqs:   map[string]map[string]int{},
+		lengths: map[string]int{},
+	}
+
+	for _, prop := range props {
+		value, ok := obj.Properties[prop].(string)
+		if !ok {
+			continue
+		}
+
+		dt, _ := d.propDataType(obj.ClassName, prop)
+		if dt == nil || !dt.IsPrimitive() {
+			continue
+		}
+
+		var tokens, terms []string
+		switch dt.AsPrimitive() {
+		case schema.DataTypeText:
+			tokens = tokenizeText(value)
+			terms = tokenizeText(strings.Join(query, " "))
+		case schema.DataTypeString:
+			tokens = []string{value}
+			terms = query
+		default:
+			continue
+		}
+
+		doc.lengths[prop] = len(tokens)
+		for _, term := range terms {
+			freq := 0
+			for _, token := range tokens {
+				if token == term {
+					freq++
+				}
+			}
+
+			if freq == 0 {
+				continue
+			}
+
+			if doc.freqs[prop] == nil {
+				doc.freqs[prop] = map[string]int{}
+			}
+			doc.freqs[prop][term] = freq
+		}
+	}
+
+	return doc
+}
@@ -0,0 +1,325 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/classification"
+	bolt "go.etcd.io/bbolt"
+)
+
+const maxUnclassified = 9999
+
+// GetUnclassified returns the objects of the class which have none of the
+// specified properties set and match the (optional) filter. Without
+// pagination at most maxUnclassified objects are supported. With pagination
+// only objects with an id greater than the cursor are returned, in the order
+// of their ids.
+func (d *DB) GetUnclassified(ctx context.Context, k kind.Kind,
+	class string, properties []string, filter *filters.LocalFilter,
+	pagination *filters.Pagination) ([]search.Result, error) {
+	var out []search.Result
+	err := d.db.View(func(tx *bolt.Tx) error {
+		resolver := newResolver(d, tx)
+
+		return d.iterateUnclassified(ctx, tx, k, class, properties, filter,
+			func(obj *storageObject) (bool, error) {
+				if pagination != nil && pagination.After != "" &&
+					string(obj.ID) <= string(pagination.After) {
+					return true, nil
+				}
+
+				if pagination == nil && len(out) >= maxUnclassified {
+					return false, fmt.Errorf("found more than %d unclassified items, "+
+						"current supported maximum is %d", maxUnclassified, maxUnclassified)
+				}
+
+				res, err := resolver.result(obj, nil, false)
+				if err != nil {
+					return false, err
+				}
+
+				out = append(out, res)
+				return pagination == nil || len(out) < pagination.Limit, nil
+			})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get unclassified: %v", err)
+	}
+
+	return out, nil
+}
+
+// CountUnclassified returns the number of objects GetUnclassified would
+// return across all pages
+func (d *DB) CountUnclassified(ctx context.Context, k kind.Kind,
+	class string, properties []string, filter *filters.LocalFilter) (int64, error) {
+	var count int64
+	err := d.db.View(func(tx *bolt.Tx) error {
+		return d.iterateUnclassified(ctx, tx, k, class, properties, filter,
+			func(obj *storageObject) (bool, error) {
+				count++
+				return true, nil
+			})
+	})
+	if err != nil {
+		return 0, fmt.Errorf("count unclassified: %v", err)
+	}
+
+	return count, nil
+}
+
+// GetClassified returns a page of the objects of the class which have all of
+// the specified properties set and match the (optional) filter. The objects
+// are returned in the order of their ids, only objects with an id greater
+// than the cursor are returned.
+func (d *DB) GetClassified(ctx context.Context, k kind.Kind,
+	class string, properties []string, filter *filters.LocalFilter,
+	pagination *filters.Pagination) ([]search.Result, error) {
+	var out []search.Result
+	err := d.db.View(func(tx *bolt.Tx) error {
+		resolver := newResolver(d, tx)
+		m := newMatcher(d, tx)
+
+		return d.iterate(tx, k, class, filter, func(obj *storageObject) (bool, error) {
+			if err := ctx.Err(); err != nil {
+				return false, err
+			}
+
+			if pagination.After != "" && string(obj.ID) <= string(pagination.After) {
+				return true, nil
+			}
+
+			if !hasAllProperties(obj, properties) {
+				return true, nil
+			}
+
+			ok, err := m.matches(obj, filter)
+			if err != nil {
+				return false, err
+			}
+
+			if !ok {
+				return true, nil
+			}
+
+			res, err := resolver.result(obj, nil, false)
+			if err != nil {
+				return false, err
+			}
+
+			out = append(out, res)
+			return len(out) < pagination.Limit, nil
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get classified: %v", err)
+	}
+
+	return out, nil
+}
+
+// iterateUnclassified calls fn for every object in the order of their ids
+// which has none of the properties set and matches the filter
+func (d *DB) iterateUnclassified(ctx context.Context, tx *bolt.Tx, k kind.Kind,
+	class string, properties []string, filter *filters.LocalFilter,
+	fn func(obj *storageObject) (bool, error)) error {
+	m := newMatcher(d, tx)
+
+	return d.iterate(tx, k, class, filter, func(obj *storageObject) (bool, error) {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+
+		if hasAnyProperty(obj, properties) {
+			return true, nil
+		}
+
+		ok, err := m.matches(obj, filter)
+		if err != nil {
+			return false, err
+		}
+
+		if !ok {
+			return true, nil
+		}
+
+		return fn(obj)
+	})
+}
+
+func hasAnyProperty(obj *storageObject, properties []string) bool {
+	for _, prop := range properties {
+		if value, ok := obj.Properties[prop]; ok && value != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasAllProperties(obj *storageObject, properties []string) bool {
+	for _, prop := range properties {
+		if value, ok := obj.Properties[prop]; !ok || value == nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// AggregateNeighbors looks at the k nearest objects of the class which have
+// all of the specified (reference) properties set. For each property the
+// most common beacon among those neighbors wins.
+func (d *DB) AggregateNeighbors(ctx context.Context, vector []float32,
+	k kind.Kind, class string, properties []string, limit int,
+	filter *filters.LocalFilter) ([]classification.NeighborRef, error) {
+	index, err := d.vectorIndex(k, class)
+	if err != nil {
+		return nil, fmt.Errorf("aggregate neighbors: %v", err)
+	}
+
+	var neighbors []scoredObject
+	err = d.db.View(func(tx *bolt.Tx) error {
+		allow, err := d.matchingIDs(ctx, tx, k, class, filter, func(obj *storageObject) bool {
+			return hasAllProperties(obj, properties)
+		})
+		if err != nil {
+			return err
+		}
+
+		neighbors, err = d.nearestInIndex(tx, index, vector, limit, allow)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("aggregate neighbors: %v", err)
+	}
+
+	out, err := aggregateRefNeighbors(neighbors, properties)
+	if err != nil {
+		return nil, fmt.Errorf("aggregate neighbors: %v", err)
+	}
+
+	return out, nil
+}
+
+// aggregateRefNeighbors votes for the most common beacon of each reference
+// property or the most common value of each primitive property
+func aggregateRefNeighbors(neighbors []scoredObject,
+	properties []string) ([]classification.NeighborRef, error) {
+	var out []classification.NeighborRef
+	for _, prop := range properties {
+		// structure is [beacon][]distance, primitive values are aggregated by
+		// their string representation instead of the beacon
+		beacons := map[string][]float32{}
+		values := map[string]interface{}{}
+		for _, neighbor := range neighbors {
+			value := neighbor.obj.Properties[prop]
+			if _, isRef := value.([]interface{}); !isRef {
+				representation := fmt.Sprint(value)
+				values[representation] = value
+				beacons[representation] = append(beacons[representation], neighbor.dist)
+				continue
+			}
+
+			refs := neighbor.obj.beacons(prop)
+			if len(refs) != 1 {
+				return nil, fmt.Errorf("prop %s: expected refs to have len 1, got %d",
+					prop, len(refs))
+			}
+
+			beacons[refs[0]] = append(beacons[refs[0]], neighbor.dist)
+		}
+
+		if len(beacons) == 0 {
+			continue
+		}
+
+		winningBeacon := winningBeacon(beacons)
+		winning, losing := winningAndLosingDistances(beacons, winningBeacon)
+		ref := classification.NeighborRef{
+			Count:           len(beacons[winningBeacon]),
+			Total:           len(neighbors),
+			Property:        prop,
+			WinningDistance: winning,
+			LosingDistance:  losing,
+		}
+		if value, ok := values[winningBeacon]; ok {
+			ref.Value = value
+		} else {
+			ref.Beacon = strfmt.URI(winningBeacon)
+		}
+		out = append(out, ref)
+	}
+
+	return out, nil
+}
+
+// winningBeacon is the beacon with the most occurrences, ties are broken
+// alphabetically to keep the results stable
+func winningBeacon(beacons map[string][]float32) string {
+	candidates := make([]string, 0, len(beacons))
+	for beacon := range beacons {
+		candidates = append(candidates, beacon)
+	}
+	sort.Strings(candidates)
+
+	var winner string
+	var winningCount int
+	for _, beacon := range candidates {
+		if len(beacons[beacon]) > winningCount {
+			winner = beacon
+			winningCount = len(beacons[beacon])
+		}
+	}
+
+	return winner
+}
+
+func winningAndLosingDistances(beacons map[string][]float32, winner string) (float32, *float32) {
+	var winningDistances []float32
+	var losingDistances []float32
+
+	for beacon, distances := range beacons {
+		if beacon == winner {
+			winningDistances = distances
+		} else {
+			losingDistances = append(losingDistances, distances...)
+		}
+	}
+
+	var losingDistance *float32
+	if len(losingDistances) > 0 {
+		d := mean(losingDistances)
+		losingDistance = &d
+	}
+
+	return mean(winningDistances), losingDistance
+}
+
+func mean(in []float32) float32 {
+	total := float32(0)
+	for _, v := range in {
+		total += v
+	}
+
+	return total / float32(len(in))
+}
@@ -0,0 +1,152 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassifications(t *testing.T) {
+	repo, cleanup := newTestDB(t)
+	defer cleanup()
+
+	otherCompany := strfmt.UUID("22222222-2222-2222-2222-222222222222")
+	unclassified := strfmt.UUID("dddddddd-dddd-dddd-dddd-dddddddddddd")
+
+	t.Run("importing additional objects", func(t *testing.T) {
+		err := repo.PutThing(context.Background(), &models.Thing{
+			ID:     otherCompany,
+			Class:  "Company",
+			Schema: map[string]interface{}{"name": "Globex"},
+		}, []float32{0, 0, 1})
+		require.Nil(t, err)
+
+		// move the magnet to the other company
+		err = repo.PutThing(context.Background(), &models.Thing{
+			ID:    productC,
+			Class: "Product",
+			Schema: map[string]interface{}{
+				"name": "magnet",
+				"ofCompany": models.MultipleRef{
+					&models.SingleRef{
+						Beacon: strfmt.URI("weaviate://localhost/things/" + otherCompany),
+					},
+				},
+			},
+		}, []float32{0, 1, 0})
+		require.Nil(t, err)
+
+		err = repo.PutThing(context.Background(), &models.Thing{
+			ID:     unclassified,
+			Class:  "Product",
+			Schema: map[string]interface{}{"name": "drill"},
+		}, []float32{1, 0.1, 0})
+		require.Nil(t, err)
+	})
+
+	t.Run("getting unclassified objects", func(t *testing.T) {
+		res, err := repo.GetUnclassified(context.Background(), kind.Thing,
+			"Product", []string{"ofCompany"}, nil, nil)
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, unclassified, res[0].ID)
+		assert.Equal(t, []float32{1, 0.1, 0}, res[0].Vector)
+	})
+
+	t.Run("paging through unclassified objects", func(t *testing.T) {
+		pagination := &filters.Pagination{Limit: 1}
+		res, err := repo.GetUnclassified(context.Background(), kind.Thing,
+			"Product", []string{"ofCompany"}, nil, pagination)
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, unclassified, res[0].ID)
+
+		pagination.After = res[0].ID
+		res, err = repo.GetUnclassified(context.Background(), kind.Thing,
+			"Product", []string{"ofCompany"}, nil, pagination)
+		require.Nil(t, err)
+		assert.Len(t, res, 0)
+	})
+
+	t.Run("paging through classified objects", func(t *testing.T) {
+		pagination := &filters.Pagination{Limit: 2}
+		res, err := repo.GetClassified(context.Background(), kind.Thing,
+			"Product", []string{"ofCompany"}, nil, pagination)
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+		assert.True(t, res[0].ID < res[1].ID, "sorted by id")
+		refs, ok := res[0].Schema.(map[string]interface{})["ofCompany"].(models.MultipleRef)
+		require.True(t, ok, "refs are not resolved")
+		assert.Len(t, refs, 1)
+		assert.NotNil(t, res[0].Vector)
+
+		pagination.After = res[1].ID
+		res, err = repo.GetClassified(context.Background(), kind.Thing,
+			"Product", []string{"ofCompany"}, nil, pagination)
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.NotEqual(t, unclassified, res[0].ID)
+	})
+
+	t.Run("counting unclassified objects", func(t *testing.T) {
+		count, err := repo.CountUnclassified(context.Background(), kind.Thing,
+			"Product", []string{"ofCompany"}, nil)
+		require.Nil(t, err)
+		assert.Equal(t, int64(1), count)
+	})
+
+	t.Run("aggregating the neighbors", func(t *testing.T) {
+		res, err := repo.AggregateNeighbors(context.Background(), []float32{1, 0.1, 0},
+			kind.Thing, "Product", []string{"ofCompany"}, 3, nil)
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+
+		assert.Equal(t, "ofCompany", res[0].Property)
+		assert.Equal(t, strfmt.URI("weaviate://localhost/things/"+companyID), res[0].Beacon)
+		assert.Equal(t, 2, res[0].Count)
+		require.NotNil(t, res[0].LosingDistance)
+		assert.True(t, res[0].WinningDistance < *res[0].LosingDistance)
+	})
+
+	t.Run("aggregating a primitive property of the neighbors", func(t *testing.T) {
+		res, err := repo.AggregateNeighbors(context.Background(), []float32{1, 0.1, 0},
+			kind.Thing, "Product", []string{"name"}, 1, nil)
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+
+		assert.Equal(t, "name", res[0].Property)
+		assert.Equal(t, "drill", res[0].Value)
+		assert.Equal(t, strfmt.URI(""), res[0].Beacon)
+		assert.Equal(t, 1, res[0].Count)
+		assert.Equal(t, 1, res[0].Total)
+	})
+
+	t.Run("aggregating the neighbors with a smaller k", func(t *testing.T) {
+		res, err := repo.AggregateNeighbors(context.Background(), []float32{1, 0.1, 0},
+			kind.Thing, "Product", []string{"ofCompany"}, 1, nil)
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+
+		assert.Equal(t, 1, res[0].Count)
+		assert.Nil(t, res[0].LosingDistance)
+	})
+}
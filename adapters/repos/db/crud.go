@@ -0,0 +1,244 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	bolt "go.etcd.io/bbolt"
+)
+
+// PutThing idempotently adds a Thing with its vector representation
+func (d *DB) PutThing(ctx context.Context,
+	object *models.Thing, vector []float32) error {
+	obj, err := newStorageObject(kind.Thing, object.ID, object.Class,
+		object.Schema, object.Meta, object.VectorWeights, vector,
+		object.CreationTimeUnix, object.LastUpdateTimeUnix)
+	if err != nil {
+		return fmt.Errorf("put thing: %v", err)
+	}
+
+	if err := d.db.Update(func(tx *bolt.Tx) error {
+		return d.putObject(tx, obj)
+	}); err != nil {
+		return fmt.Errorf("put thing: %v", err)
+	}
+
+	return nil
+}
+
+// PutAction idempotently adds a Action with its vector representation
+func (d *DB) PutAction(ctx context.Context,
+	object *models.Action, vector []float32) error {
+	obj, err := newStorageObject(kind.Action, object.ID, object.Class,
+		object.Schema, object.Meta, object.VectorWeights, vector,
+		object.CreationTimeUnix, object.LastUpdateTimeUnix)
+	if err != nil {
+		return fmt.Errorf("put action: %v", err)
+	}
+
+	if err := d.db.Update(func(tx *bolt.Tx) error {
+		return d.putObject(tx, obj)
+	}); err != nil {
+		return fmt.Errorf("put action: %v", err)
+	}
+
+	return nil
+}
+
+// putObject writes the object itself, the class membership and the inverted
+// index entries. If a previous version exists its index entries are removed
+// first. The vector index is updated once the transaction is committed.
+func (d *DB) putObject(tx *bolt.Tx, obj *storageObject) error {
+	previous, err := d.objectByID(tx, obj.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := d.updateVectorIndexOnCommit(tx, previous, obj); err != nil {
+		return err
+	}
+
+	if previous != nil {
+		if err := d.deleteObject(tx, previous); err != nil {
+			return fmt.Errorf("remove previous version: %v", err)
+		}
+	}
+
+	data, err := obj.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("marshal object: %v", err)
+	}
+
+	if err := tx.Bucket(ObjectsBucket).Put([]byte(obj.ID), data); err != nil {
+		return fmt.Errorf("put object: %v", err)
+	}
+
+	classBucket, err := tx.Bucket(ClassesBucket).
+		CreateBucketIfNotExists(classBucketName(obj.Kind, obj.ClassName))
+	if err != nil {
+		return fmt.Errorf("class bucket: %v", err)
+	}
+
+	if err := classBucket.Put([]byte(obj.ID), []byte{}); err != nil {
+		return fmt.Errorf("class bucket: %v", err)
+	}
+
+	return d.addToInvertedIndex(tx, obj)
+}
+
+func (d *DB) deleteObject(tx *bolt.Tx, obj *storageObject) error {
+	if err := d.deleteFromInvertedIndex(tx, obj); err != nil {
+		return err
+	}
+
+	classBucket := tx.Bucket(ClassesBucket).Bucket(classBucketName(obj.Kind, obj.ClassName))
+	if classBucket != nil {
+		if err := classBucket.Delete([]byte(obj.ID)); err != nil {
+			return fmt.Errorf("class bucket: %v", err)
+		}
+	}
+
+	return tx.Bucket(ObjectsBucket).Delete([]byte(obj.ID))
+}
+
+func (d *DB) objectByID(tx *bolt.Tx, id strfmt.UUID) (*storageObject, error) {
+	data := tx.Bucket(ObjectsBucket).Get([]byte(id))
+	if data == nil {
+		return nil, nil
+	}
+
+	return parseStorageObject(data)
+}
+
+func (d *DB) DeleteThing(ctx context.Context, className string, id strfmt.UUID) error {
+	if err := d.deleteByID(kind.Thing, className, id); err != nil {
+		return fmt.Errorf("delete thing: %v", err)
+	}
+
+	return nil
+}
+
+func (d *DB) DeleteAction(ctx context.Context, className string, id strfmt.UUID) error {
+	if err := d.deleteByID(kind.Action, className, id); err != nil {
+		return fmt.Errorf("delete action: %v", err)
+	}
+
+	return nil
+}
+
+func (d *DB) deleteByID(k kind.Kind, className string, id strfmt.UUID) error {
+	return d.db.Update(func(tx *bolt.Tx) error {
+		obj, err := d.objectByID(tx, id)
+		if err != nil {
+			return err
+		}
+
+		if obj == nil || obj.Kind != k || obj.ClassName != className {
+			return fmt.Errorf("%s '%s' of class '%s' not found", k.Name(), id, className)
+		}
+
+		d.deleteFromVectorIndexOnCommit(tx, obj)
+		return d.deleteObject(tx, obj)
+	})
+}
+
+// ThingByID extracts the one result matching the ID. Returns nil on no results
+// (without errors)
+func (d *DB) ThingByID(ctx context.Context, id strfmt.UUID,
+	props traverser.SelectProperties, meta bool) (*search.Result, error) {
+	return d.byKindAndID(kind.Thing, id, props, meta)
+}
+
+// ActionByID extracts the one result matching the ID. Returns nil on no results
+// (without errors)
+func (d *DB) ActionByID(ctx context.Context, id strfmt.UUID,
+	props traverser.SelectProperties, meta bool) (*search.Result, error) {
+	return d.byKindAndID(kind.Action, id, props, meta)
+}
+
+func (d *DB) byKindAndID(k kind.Kind, id strfmt.UUID,
+	props traverser.SelectProperties, meta bool) (*search.Result, error) {
+	var out *search.Result
+
+	err := d.db.View(func(tx *bolt.Tx) error {
+		obj, err := d.objectByID(tx, id)
+		if err != nil {
+			return err
+		}
+
+		if obj == nil || obj.Kind != k {
+			return nil
+		}
+
+		res, err := newResolver(d, tx).result(obj, props, meta)
+		if err != nil {
+			return err
+		}
+
+		out = &res
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s by id: %v", k.Name(), err)
+	}
+
+	return out, nil
+}
+
+// Exists checks if an object with the id exists (regardless of its kind)
+func (d *DB) Exists(ctx context.Context, id strfmt.UUID) (bool, error) {
+	var ok bool
+	err := d.db.View(func(tx *bolt.Tx) error {
+		ok = tx.Bucket(ObjectsBucket).Get([]byte(id)) != nil
+		return nil
+	})
+
+	return ok, err
+}
+
+// ThingSearch searches for all things with optional filters without vector scoring
+func (d *DB) ThingSearch(ctx context.Context, pagination *filters.Pagination,
+	sort []filters.Sort, filters *filters.LocalFilter, meta bool) (search.Results, error) {
+	return d.search(ctx, searchParams{
+		kind:    kind.Thing,
+		offset:  offsetFromPagination(pagination),
+		limit:   limitFromPagination(pagination),
+		after:   afterFromPagination(pagination),
+		sort:    sort,
+		filters: filters,
+		meta:    meta,
+	})
+}
+
+// ActionSearch searches for all actions with optional filters without vector scoring
+func (d *DB) ActionSearch(ctx context.Context, pagination *filters.Pagination,
+	sort []filters.Sort, filters *filters.LocalFilter, meta bool) (search.Results, error) {
+	return d.search(ctx, searchParams{
+		kind:    kind.Action,
+		offset:  offsetFromPagination(pagination),
+		limit:   limitFromPagination(pagination),
+		after:   afterFromPagination(pagination),
+		sort:    sort,
+		filters: filters,
+		meta:    meta,
+	})
+}
@@ -0,0 +1,152 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSchemaGetter struct {
+	schema schema.Schema
+}
+
+func (f *fakeSchemaGetter) GetSchemaSkipAuth() schema.Schema {
+	return f.schema
+}
+
+var (
+	companyID = strfmt.UUID("11111111-1111-1111-1111-111111111111")
+	productA  = strfmt.UUID("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa")
+	productB  = strfmt.UUID("bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb")
+	productC  = strfmt.UUID("cccccccc-cccc-cccc-cccc-cccccccccccc")
+)
+
+func testSchema() schema.Schema {
+	return schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{
+				&models.Class{
+					Class: "Company",
+					Properties: []*models.Property{
+						&models.Property{
+							Name:     "name",
+							DataType: []string{string(schema.DataTypeString)},
+						},
+					},
+				},
+				&models.Class{
+					Class: "Product",
+					Properties: []*models.Property{
+						&models.Property{
+							Name:     "name",
+							DataType: []string{string(schema.DataTypeString)},
+						},
+						&models.Property{
+							Name:     "description",
+							DataType: []string{string(schema.DataTypeText)},
+						},
+						&models.Property{
+							Name:     "price",
+							DataType: []string{string(schema.DataTypeNumber)},
+						},
+						&models.Property{
+							Name:     "available",
+							DataType: []string{string(schema.DataTypeBoolean)},
+						},
+						&models.Property{
+							Name:     "ofCompany",
+							DataType: []string{"Company"},
+						},
+					},
+				},
+			},
+		},
+		Actions: &models.Schema{},
+	}
+}
+
+// newTestDB creates a db in a temporary directory which contains a company
+// and three products. The returned func cleans up all resources.
+func newTestDB(t *testing.T) (*DB, func()) {
+	dir, err := ioutil.TempDir("", "weaviate-db-test")
+	require.Nil(t, err)
+
+	logger, _ := test.NewNullLogger()
+	sg := &fakeSchemaGetter{schema: testSchema()}
+	repo, err := New(dir, logger, sg)
+	require.Nil(t, err)
+
+	migrator := NewMigrator(repo)
+	for _, class := range sg.schema.Things.Classes {
+		require.Nil(t, migrator.AddClass(context.Background(), kind.Thing, class))
+	}
+
+	cleanup := func() {
+		repo.Shutdown(context.Background())
+		os.RemoveAll(dir)
+	}
+
+	company := &models.Thing{
+		ID:    companyID,
+		Class: "Company",
+		Schema: map[string]interface{}{
+			"name": "Acme",
+		},
+	}
+	require.Nil(t, repo.PutThing(context.Background(), company, []float32{1, 0, 0}))
+
+	products := []struct {
+		id          strfmt.UUID
+		name        string
+		description string
+		price       float64
+		available   bool
+		vector      []float32
+	}{
+		{productA, "anvil", "a very heavy anvil", 10, true, []float32{1, 0, 0}},
+		{productB, "rocket", "a fast rocket", 30, false, []float32{0.9, 0.1, 0}},
+		{productC, "magnet", "a heavy magnet", 30, true, []float32{0, 1, 0}},
+	}
+
+	for _, p := range products {
+		thing := &models.Thing{
+			ID:    p.id,
+			Class: "Product",
+			Schema: map[string]interface{}{
+				"name":        p.name,
+				"description": p.description,
+				"price":       p.price,
+				"available":   p.available,
+				"ofCompany": models.MultipleRef{
+					&models.SingleRef{
+						Beacon: strfmt.URI("weaviate://localhost/things/" + companyID),
+					},
+				},
+			},
+		}
+		require.Nil(t, repo.PutThing(context.Background(), thing, p.vector))
+	}
+
+	return repo, cleanup
+}
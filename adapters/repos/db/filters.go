@@ -0,0 +1,502 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/crossref"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	bolt "go.etcd.io/bbolt"
+)
+
+// matcher evaluates filters against stored objects. It is bound to a single
+// read transaction, so that references can be resolved consistently.
+type matcher struct {
+	db *DB
+	tx *bolt.Tx
+}
+
+func newMatcher(db *DB, tx *bolt.Tx) *matcher {
+	return &matcher{db: db, tx: tx}
+}
+
+// matches returns true if no filter is set or the object matches the filter
+func (m *matcher) matches(obj *storageObject, f *filters.LocalFilter) (bool, error) {
+	if f == nil || f.Root == nil {
+		return true, nil
+	}
+
+	return m.matchesClause(obj, f.Root)
+}
+
+func (m *matcher) matchesClause(obj *storageObject, clause *filters.Clause) (bool, error) {
+	switch clause.Operator {
+	case filters.OperatorAnd:
+		for i := range clause.Operands {
+			ok, err := m.matchesClause(obj, &clause.Operands[i])
+			if err != nil || !ok {
+				return false, err
+			}
+		}
+		return true, nil
+	case filters.OperatorOr:
+		for i := range clause.Operands {
+			ok, err := m.matchesClause(obj, &clause.Operands[i])
+			if err != nil || ok {
+				return ok, err
+			}
+		}
+		return false, nil
+	case filters.OperatorNot:
+		for i := range clause.Operands {
+			ok, err := m.matchesClause(obj, &clause.Operands[i])
+			if err != nil || ok {
+				return false, err
+			}
+		}
+		return true, nil
+	default:
+		return m.matchesValueClause(obj, clause)
+	}
+}
+
+func (m *matcher) matchesValueClause(obj *storageObject, clause *filters.Clause) (bool, error) {
+	if clause.On == nil {
+		return false, fmt.Errorf("operator %s requires a path", clause.Operator.Name())
+	}
+
+	if clause.On.Child != nil {
+		ok, err := m.matchesRefClause(obj, clause)
+		if err != nil {
+			return false, err
+		}
+
+		if clause.Operator == filters.OperatorNotEqual {
+			// in line with the esvector repo a negation on a nested path negates
+			// the whole sub query
+			return !ok, nil
+		}
+
+		return ok, nil
+	}
+
+	propName := clause.On.Property.String()
+	if propName == "uuid" {
+		return compareStrings(clause.Operator, obj.ID.String(), clause.Value.Value)
+	}
+
+	if clause.Operator == filters.OperatorWithinGeoRange {
+		return matchesGeoRange(obj.Properties[propName], clause.Value)
+	}
+
+	dt, _ := m.db.propDataType(obj.ClassName, propName)
+	if dt != nil && dt.IsReference() {
+		return matchesRefCount(len(obj.beacons(propName)), clause)
+	}
+
+	var primitive schema.DataType
+	if dt != nil {
+		primitive = dt.AsPrimitive()
+	}
+
+	value, ok := obj.Properties[propName]
+	if !ok {
+		// a missing value can never match, unless the operator is negating
+		return clause.Operator == filters.OperatorNotEqual, nil
+	}
+
+	return matchesPrimitive(primitive, value, clause)
+}
+
+// matchesRefClause resolves all references of the first path segment and
+// checks whether at least one of the targets matches the remaining path
+func (m *matcher) matchesRefClause(obj *storageObject, clause *filters.Clause) (bool, error) {
+	inner := *clause
+	inner.On = clause.On.Child
+	if inner.Operator == filters.OperatorNotEqual {
+		inner.Operator = filters.OperatorEqual
+	}
+
+	for _, beacon := range obj.beacons(clause.On.Property.String()) {
+		target, err := m.db.resolveBeacon(m.tx, beacon)
+		if err != nil {
+			return false, err
+		}
+
+		if target == nil || target.ClassName != inner.On.Class.String() {
+			continue
+		}
+
+		ok, err := m.matchesValueClause(target, &inner)
+		if err != nil {
+			return false, err
+		}
+
+		if ok {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func matchesRefCount(count int, clause *filters.Clause) (bool, error) {
+	if clause.Value.Type != schema.DataTypeInt {
+		return false,
+			fmt.Errorf("reference count filters require a value of type int, got: %v", clause.Value.Type)
+	}
+
+	expected, ok := toFloat64(clause.Value.Value)
+	if !ok {
+		return false, fmt.Errorf("reference count filters require a value of type int, got: %T",
+			clause.Value.Value)
+	}
+
+	return compareFloats(clause.Operator, float64(count), expected)
+}
+
+func matchesPrimitive(dt schema.DataType, value interface{}, clause *filters.Clause) (bool, error) {
+	switch typed := value.(type) {
+	case string:
+		if dt == schema.DataTypeDate {
+			return compareDates(clause.Operator, typed, clause.Value.Value)
+		}
+
+		if dt == schema.DataTypeText {
+			return compareText(clause.Operator, typed, clause.Value.Value)
+		}
+
+		return compareStrings(clause.Operator, typed, clause.Value.Value)
+	case float64:
+		expected, ok := toFloat64(clause.Value.Value)
+		if !ok {
+			return false, nil
+		}
+		return compareFloats(clause.Operator, typed, expected)
+	case bool:
+		expected, ok := clause.Value.Value.(bool)
+		if !ok {
+			return false, nil
+		}
+		return compareBools(clause.Operator, typed, expected)
+	case map[string]interface{}:
+		// phone numbers can be filtered by their input
+		if input, ok := typed["input"].(string); ok {
+			return compareStrings(clause.Operator, input, clause.Value.Value)
+		}
+		return false, nil
+	default:
+		return false, nil
+	}
+}
+
+func compareStrings(op filters.Operator, actual string, expectedUntyped interface{}) (bool, error) {
+	expected, ok := expectedUntyped.(string)
+	if !ok {
+		if uuid, isUUID := expectedUntyped.(strfmt.UUID); isUUID {
+			expected = uuid.String()
+		} else {
+			return false, nil
+		}
+	}
+
+	switch op {
+	case filters.OperatorEqual:
+		return actual == expected, nil
+	case filters.OperatorNotEqual:
+		return actual != expected, nil
+	case filters.OperatorGreaterThan:
+		return actual > expected, nil
+	case filters.OperatorGreaterThanEqual:
+		return actual >= expected, nil
+	case filters.OperatorLessThan:
+		return actual < expected, nil
+	case filters.OperatorLessThanEqual:
+		return actual <= expected, nil
+	case filters.OperatorLike:
+		return matchesWildcard(expected, actual)
+	default:
+		return false, fmt.Errorf("unsupported operator %s on string", op.Name())
+	}
+}
+
+// compareText mirrors the behavior of a full-text field, i.e. Equal matches
+// if any of the words in the value is contained and Like matches if any of
+// the contained words matches the pattern
+func compareText(op filters.Operator, actual string, expectedUntyped interface{}) (bool, error) {
+	expected, ok := expectedUntyped.(string)
+	if !ok {
+		return false, nil
+	}
+
+	actualTokens := tokenizeText(actual)
+	switch op {
+	case filters.OperatorEqual, filters.OperatorNotEqual:
+		match := containsAnyToken(actualTokens, tokenizeText(expected))
+		if op == filters.OperatorNotEqual {
+			return !match, nil
+		}
+		return match, nil
+	case filters.OperatorLike:
+		for _, token := range actualTokens {
+			ok, err := matchesWildcard(strings.ToLower(expected), token)
+			if err != nil || ok {
+				return ok, err
+			}
+		}
+		return false, nil
+	default:
+		return compareStrings(op, actual, expected)
+	}
+}
+
+func containsAnyToken(haystack, needles []string) bool {
+	for _, needle := range needles {
+		for _, token := range haystack {
+			if needle == token {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func compareFloats(op filters.Operator, actual, expected float64) (bool, error) {
+	switch op {
+	case filters.OperatorEqual:
+		return actual == expected, nil
+	case filters.OperatorNotEqual:
+		return actual != expected, nil
+	case filters.OperatorGreaterThan:
+		return actual > expected, nil
+	case filters.OperatorGreaterThanEqual:
+		return actual >= expected, nil
+	case filters.OperatorLessThan:
+		return actual < expected, nil
+	case filters.OperatorLessThanEqual:
+		return actual <= expected, nil
+	default:
+		return false, fmt.Errorf("unsupported operator %s on number", op.Name())
+	}
+}
+
+func compareBools(op filters.Operator, actual, expected bool) (bool, error) {
+	switch op {
+	case filters.OperatorEqual:
+		return actual == expected, nil
+	case filters.OperatorNotEqual:
+		return actual != expected, nil
+	default:
+		return false, fmt.Errorf("unsupported operator %s on boolean", op.Name())
+	}
+}
+
+func compareDates(op filters.Operator, actualString string, expectedUntyped interface{}) (bool, error) {
+	actual, err := time.Parse(time.RFC3339, actualString)
+	if err != nil {
+		return compareStrings(op, actualString, expectedUntyped)
+	}
+
+	var expected time.Time
+	switch typed := expectedUntyped.(type) {
+	case time.Time:
+		expected = typed
+	case string:
+		expected, err = time.Parse(time.RFC3339, typed)
+		if err != nil {
+			return false, fmt.Errorf("parse date '%s': %v", typed, err)
+		}
+	default:
+		return false, nil
+	}
+
+	return compareFloats(op, float64(actual.UnixNano()), float64(expected.UnixNano()))
+}
+
+// matchesWildcard supports the same wildcards as the esvector Like operator,
+// i.e. "*" for any number of characters and "?" for a single character
+func matchesWildcard(pattern, value string) (bool, error) {
+	var expr strings.Builder
+	expr.WriteString("^")
+	for _, r := range pattern {
+		switch r {
+		case '*':
+			expr.WriteString(".*")
+		case '?':
+			expr.WriteString(".")
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	expr.WriteString("$")
+
+	re, err := regexp.Compile(expr.String())
+	if err != nil {
+		return false, fmt.Errorf("like: invalid pattern '%s': %v", pattern, err)
+	}
+
+	return re.MatchString(value), nil
+}
+
+func matchesGeoRange(value interface{}, filterValue *filters.Value) (bool, error) {
+	geoRange, ok := filterValue.Value.(filters.GeoRange)
+	if !ok {
+		return false, fmt.Errorf("got WithinGeoRange operator, but value was not a GeoRange")
+	}
+
+	asMap, ok := value.(map[string]interface{})
+	if !ok {
+		return false, nil
+	}
+
+	lat, latOK := toFloat64(asMap["lat"])
+	lon, lonOK := toFloat64(asMap["lon"])
+	if !latOK || !lonOK {
+		return false, nil
+	}
+
+	dist := haversineDistance(float64(geoRange.Latitude), float64(geoRange.Longitude), lat, lon)
+	return dist <= float64(geoRange.Distance), nil
+}
+
+const earthRadiusMeters = 6371008.8
+
+// haversineDistance between two coordinates in meters
+func haversineDistance(lat1, lon1, lat2, lon2 float64) float64 {
+	toRad := func(deg float64) float64 { return deg * math.Pi / 180 }
+
+	dLat := toRad(lat2 - lat1)
+	dLon := toRad(lon2 - lon1)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRad(lat1))*math.Cos(toRad(lat2))*math.Sin(dLon/2)*math.Sin(dLon/2)
+
+	return 2 * earthRadiusMeters * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+}
+
+// candidates uses the inverted index to narrow down the ids that could
+// possibly match the filter. A nil result (without an error) means the
+// filter cannot be answered from the index and all objects of the class need
+// to be scanned. The candidates are always a superset of the actual matches,
+// every candidate still needs to be checked with the matcher.
+func (d *DB) candidates(tx *bolt.Tx, k kind.Kind, className string,
+	f *filters.LocalFilter) map[strfmt.UUID]struct{} {
+	if f == nil || f.Root == nil {
+		return nil
+	}
+
+	return d.candidatesFromClause(tx, k, className, f.Root)
+}
+
+func (d *DB) candidatesFromClause(tx *bolt.Tx, k kind.Kind, className string,
+	clause *filters.Clause) map[strfmt.UUID]struct{} {
+	switch clause.Operator {
+	case filters.OperatorAnd:
+		var out map[strfmt.UUID]struct{}
+		for i := range clause.Operands {
+			ids := d.candidatesFromClause(tx, k, className, &clause.Operands[i])
+			if ids == nil {
+				continue
+			}
+
+			if out == nil {
+				out = ids
+				continue
+			}
+
+			for id := range out {
+				if _, ok := ids[id]; !ok {
+					delete(out, id)
+				}
+			}
+		}
+		return out
+	case filters.OperatorOr:
+		out := map[strfmt.UUID]struct{}{}
+		for i := range clause.Operands {
+			ids := d.candidatesFromClause(tx, k, className, &clause.Operands[i])
+			if ids == nil {
+				// at least one branch requires a full scan
+				return nil
+			}
+
+			for id := range ids {
+				out[id] = struct{}{}
+			}
+		}
+		return out
+	case filters.OperatorEqual:
+		if clause.On == nil || clause.On.Child != nil {
+			return nil
+		}
+
+		dt, ok := d.indexedProps(className)[clause.On.Property.String()]
+		if !ok || dt == schema.DataTypeDate {
+			// dates can be written with different precisions or time zones, so an
+			// exact token lookup would not be reliable
+			return nil
+		}
+
+		tokens, ok := indexTokens(dt, clause.Value.Value)
+		if !ok {
+			return nil
+		}
+
+		out := map[strfmt.UUID]struct{}{}
+		for _, token := range tokens {
+			ids, ok := d.idsForToken(tx, k, className, clause.On.Property.String(), token)
+			if !ok {
+				return nil
+			}
+
+			for id := range ids {
+				out[id] = struct{}{}
+			}
+		}
+		return out
+	default:
+		return nil
+	}
+}
+
+func (d *DB) resolveBeacon(tx *bolt.Tx, beacon string) (*storageObject, error) {
+	ref, err := crossref.Parse(beacon)
+	if err != nil {
+		return nil, fmt.Errorf("resolve beacon: %v", err)
+	}
+
+	if !ref.Local {
+		// network refs can't be resolved by the db
+		return nil, nil
+	}
+
+	obj, err := d.objectByID(tx, ref.TargetID)
+	if err != nil {
+		return nil, err
+	}
+
+	if obj == nil || obj.Kind != ref.Kind {
+		return nil, nil
+	}
+
+	return obj, nil
+}
@@ -0,0 +1,237 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	bolt "go.etcd.io/bbolt"
+)
+
+// the separator between the token and the id in an inverted index key. As
+// neither valid utf-8 tokens nor uuids can contain a null byte, a token can
+// never be confused with the prefix of a longer token.
+const invertedKeySeparator = byte(0)
+
+func classBucketName(k kind.Kind, className string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", k.Name(), className))
+}
+
+// tokenizeText splits a text prop into lowercased words
+func tokenizeText(in string) []string {
+	return strings.FieldsFunc(strings.ToLower(in), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
+
+// indexTokens returns the tokens that should be written to the inverted index
+// for the given (normalized) value. The second return value is false if the
+// data type isn't suitable for the inverted index, e.g. geo coordinates or
+// references
+func indexTokens(dt schema.DataType, value interface{}) ([]string, bool) {
+	switch dt {
+	case schema.DataTypeString, schema.DataTypeDate:
+		asString, ok := value.(string)
+		if !ok {
+			return nil, false
+		}
+		return []string{asString}, true
+	case schema.DataTypeText:
+		asString, ok := value.(string)
+		if !ok {
+			return nil, false
+		}
+		return tokenizeText(asString), true
+	case schema.DataTypeInt, schema.DataTypeNumber:
+		asFloat, ok := toFloat64(value)
+		if !ok {
+			return nil, false
+		}
+		return []string{strconv.FormatFloat(asFloat, 'f', -1, 64)}, true
+	case schema.DataTypeBoolean:
+		asBool, ok := value.(bool)
+		if !ok {
+			return nil, false
+		}
+		return []string{strconv.FormatBool(asBool)}, true
+	default:
+		return nil, false
+	}
+}
+
+// indexedProps returns the primitive props of the class which are indexed,
+// i.e. the index was not explicitly turned off
+func (d *DB) indexedProps(className string) map[string]schema.DataType {
+	out := map[string]schema.DataType{}
+	if d.schemaGetter == nil {
+		return out
+	}
+
+	s := d.schemaGetter.GetSchemaSkipAuth()
+	class := s.FindClassByName(schema.ClassName(className))
+	if class == nil {
+		return out
+	}
+
+	for _, prop := range class.Properties {
+		if prop.Index != nil && *prop.Index == false {
+			continue
+		}
+
+		dt, err := s.FindPropertyDataType(prop.DataType)
+		if err != nil || !dt.IsPrimitive() {
+			continue
+		}
+
+		out[prop.Name] = dt.AsPrimitive()
+	}
+
+	return out
+}
+
+func (d *DB) propDataType(className string, propName string) (schema.PropertyDataType, *models.Property) {
+	if d.schemaGetter == nil {
+		return nil, nil
+	}
+
+	s := d.schemaGetter.GetSchemaSkipAuth()
+	class := s.FindClassByName(schema.ClassName(className))
+	if class == nil {
+		return nil, nil
+	}
+
+	prop, err := schema.GetPropertyByName(class, propName)
+	if err != nil {
+		return nil, nil
+	}
+
+	dt, err := s.FindPropertyDataType(prop.DataType)
+	if err != nil {
+		return nil, prop
+	}
+
+	return dt, prop
+}
+
+func invertedKey(token string, id strfmt.UUID) []byte {
+	key := make([]byte, 0, len(token)+1+len(id))
+	key = append(key, []byte(token)...)
+	key = append(key, invertedKeySeparator)
+	key = append(key, []byte(id)...)
+	return key
+}
+
+func invertedPrefix(token string) []byte {
+	return append([]byte(token), invertedKeySeparator)
+}
+
+func (d *DB) addToInvertedIndex(tx *bolt.Tx, obj *storageObject) error {
+	return d.updateInvertedIndex(tx, obj, func(b *bolt.Bucket, key []byte) error {
+		return b.Put(key, []byte{})
+	})
+}
+
+func (d *DB) deleteFromInvertedIndex(tx *bolt.Tx, obj *storageObject) error {
+	return d.updateInvertedIndex(tx, obj, func(b *bolt.Bucket, key []byte) error {
+		return b.Delete(key)
+	})
+}
+
+func (d *DB) updateInvertedIndex(tx *bolt.Tx, obj *storageObject,
+	update func(b *bolt.Bucket, key []byte) error) error {
+	classBucket, err := tx.Bucket(InvertedBucket).
+		CreateBucketIfNotExists(classBucketName(obj.Kind, obj.ClassName))
+	if err != nil {
+		return fmt.Errorf("inverted index: %v", err)
+	}
+
+	for propName, dt := range d.indexedProps(obj.ClassName) {
+		value, ok := obj.Properties[propName]
+		if !ok {
+			continue
+		}
+
+		tokens, ok := indexTokens(dt, value)
+		if !ok {
+			continue
+		}
+
+		propBucket, err := classBucket.CreateBucketIfNotExists([]byte(propName))
+		if err != nil {
+			return fmt.Errorf("inverted index: prop '%s': %v", propName, err)
+		}
+
+		for _, token := range tokens {
+			if err := update(propBucket, invertedKey(token, obj.ID)); err != nil {
+				return fmt.Errorf("inverted index: prop '%s': %v", propName, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// idsForToken returns all ids of the class which contain the exact token in
+// the specified prop. The second return value is false if the prop is not
+// indexed at all, so the caller can fall back to a full scan.
+func (d *DB) idsForToken(tx *bolt.Tx, k kind.Kind, className, propName,
+	token string) (map[strfmt.UUID]struct{}, bool) {
+	if _, ok := d.indexedProps(className)[propName]; !ok {
+		return nil, false
+	}
+
+	out := map[strfmt.UUID]struct{}{}
+	classBucket := tx.Bucket(InvertedBucket).Bucket(classBucketName(k, className))
+	if classBucket == nil {
+		return out, true
+	}
+
+	propBucket := classBucket.Bucket([]byte(propName))
+	if propBucket == nil {
+		return out, true
+	}
+
+	prefix := invertedPrefix(token)
+	c := propBucket.Cursor()
+	for key, _ := c.Seek(prefix); key != nil && bytes.HasPrefix(key, prefix); key, _ = c.Next() {
+		out[strfmt.UUID(key[len(prefix):])] = struct{}{}
+	}
+
+	return out, true
+}
+
+func toFloat64(in interface{}) (float64, bool) {
+	switch v := in.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	default:
+		return 0, false
+	}
+}
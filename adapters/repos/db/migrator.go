@@ -0,0 +1,168 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	bolt "go.etcd.io/bbolt"
+)
+
+// Migrator is a wrapper around a "primitive" db.DB which implements the
+// migrate.Migrator interface
+type Migrator struct {
+	db *DB
+}
+
+// NewMigrator from db.DB to implement migrate.Migrator interface
+func NewMigrator(db *DB) *Migrator {
+	return &Migrator{db: db}
+}
+
+// AddClass creates the buckets for the class membership and the inverted
+// index
+func (m *Migrator) AddClass(ctx context.Context, kind kind.Kind, class *models.Class) error {
+	err := m.db.db.Update(func(tx *bolt.Tx) error {
+		name := classBucketName(kind, class.Class)
+		if _, err := tx.Bucket(ClassesBucket).CreateBucketIfNotExists(name); err != nil {
+			return err
+		}
+
+		_, err := tx.Bucket(InvertedBucket).CreateBucketIfNotExists(name)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("add class %s: %v", class.Class, err)
+	}
+
+	return nil
+}
+
+// DropClass deletes all objects of the class as well as the class specific
+// buckets
+func (m *Migrator) DropClass(ctx context.Context, kind kind.Kind, className string) error {
+	err := m.db.db.Update(func(tx *bolt.Tx) error {
+		name := classBucketName(kind, className)
+		classBucket := tx.Bucket(ClassesBucket).Bucket(name)
+		if classBucket != nil {
+			objects := tx.Bucket(ObjectsBucket)
+			err := classBucket.ForEach(func(id, _ []byte) error {
+				return objects.Delete(id)
+			})
+			if err != nil {
+				return fmt.Errorf("delete objects: %v", err)
+			}
+
+			if err := tx.Bucket(ClassesBucket).DeleteBucket(name); err != nil {
+				return fmt.Errorf("delete class bucket: %v", err)
+			}
+		}
+
+		tx.OnCommit(func() {
+			m.db.vectors.set(kind, className, nil)
+		})
+
+		if tx.Bucket(InvertedBucket).Bucket(name) != nil {
+			if err := tx.Bucket(InvertedBucket).DeleteBucket(name); err != nil {
+				return fmt.Errorf("delete inverted index: %v", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("drop class %s: %v", className, err)
+	}
+
+	return nil
+}
+
+// UpdateClass does nothing if the keywords should be changed and errors if
+// the className should be changed - the class name is part of every bucket
+// name and therefore immutable
+func (m *Migrator) UpdateClass(ctx context.Context, kind kind.Kind, className string, newClassName *string, newKeywords *models.Keywords) error {
+	if newClassName != nil {
+		return fmt.Errorf("embedded db does not support renaming of classes")
+	}
+
+	return nil
+}
+
+// AddProperty has no effect, the inverted index for the new prop is built up
+// as objects containing the prop are imported
+func (m *Migrator) AddProperty(ctx context.Context, kind kind.Kind, className string, prop *models.Property) error {
+	return nil
+}
+
+// DropProperty removes the property from all objects of the class and
+// deletes its inverted index
+func (m *Migrator) DropProperty(ctx context.Context, kind kind.Kind, className string, propertyName string) error {
+	err := m.db.db.Update(func(tx *bolt.Tx) error {
+		name := classBucketName(kind, className)
+		classBucket := tx.Bucket(ClassesBucket).Bucket(name)
+		if classBucket != nil {
+			objects := tx.Bucket(ObjectsBucket)
+			err := m.db.forEachID(tx, classBucket, func(obj *storageObject) (bool, error) {
+				if _, ok := obj.Properties[propertyName]; !ok {
+					return true, nil
+				}
+
+				delete(obj.Properties, propertyName)
+				data, err := obj.MarshalBinary()
+				if err != nil {
+					return false, err
+				}
+
+				return true, objects.Put([]byte(obj.ID), data)
+			})
+			if err != nil {
+				return fmt.Errorf("delete prop from objects: %v", err)
+			}
+		}
+
+		invertedBucket := tx.Bucket(InvertedBucket).Bucket(name)
+		if invertedBucket != nil && invertedBucket.Bucket([]byte(propertyName)) != nil {
+			if err := invertedBucket.DeleteBucket([]byte(propertyName)); err != nil {
+				return fmt.Errorf("delete inverted index: %v", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("drop property %s of class %s: %v", propertyName, className, err)
+	}
+
+	return nil
+}
+
+// UpdateProperty will do nothing if keywords should be updated and error if a
+// name should be updated. Property names must be immutable, since they are
+// part of the inverted index.
+func (m *Migrator) UpdateProperty(ctx context.Context, kind kind.Kind, className string, propName string, newName *string, newKeywords *models.Keywords) error {
+	if newName != nil {
+		return fmt.Errorf("embedded db does not support renaming of properties")
+	}
+
+	return nil
+}
+
+// UpdatePropertyAddDataType is ignored, references are resolved at query
+// time, so no migration is required
+func (m *Migrator) UpdatePropertyAddDataType(ctx context.Context, kind kind.Kind, className string, propName string, newDataType string) error {
+	return nil
+}
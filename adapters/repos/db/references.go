@@ -0,0 +1,181 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+	bolt "go.etcd.io/bbolt"
+)
+
+func (d *DB) AddReference(ctx context.Context, k kind.Kind, source strfmt.UUID,
+	refProp string, ref *models.SingleRef) error {
+	err := d.db.Update(func(tx *bolt.Tx) error {
+		return d.addReference(tx, k, source, refProp, ref)
+	})
+	if err != nil {
+		return fmt.Errorf("add reference: %v", err)
+	}
+
+	return nil
+}
+
+func (d *DB) addReference(tx *bolt.Tx, k kind.Kind, source strfmt.UUID,
+	refProp string, ref *models.SingleRef) error {
+	obj, err := d.objectByID(tx, source)
+	if err != nil {
+		return err
+	}
+
+	if obj == nil || obj.Kind != k {
+		return fmt.Errorf("source not found")
+	}
+
+	refMap, err := refToMap(ref)
+	if err != nil {
+		return err
+	}
+
+	existing, _ := obj.Properties[refProp].([]interface{})
+	obj.Properties[refProp] = append(existing, refMap)
+
+	return d.putObject(tx, obj)
+}
+
+// AddBatchReferences adds all references which do not have an error yet in a
+// single transaction. Errors on individual items are recorded on the item
+// itself.
+func (d *DB) AddBatchReferences(ctx context.Context, list kinds.BatchReferences) (kinds.BatchReferences, error) {
+	err := d.db.Update(func(tx *bolt.Tx) error {
+		for i, single := range list {
+			if single.Err != nil {
+				// ignore concepts that already have an error
+				continue
+			}
+
+			err := d.addReference(tx, single.From.Kind, single.From.TargetID,
+				single.From.Property.String(), single.To.SingleRef())
+			if err != nil {
+				list[i].Err = err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("batch add reference: %v", err)
+	}
+
+	return list, nil
+}
+
+// Merge updates the primitive props (and vector) of an existing object and
+// appends the references
+func (d *DB) Merge(ctx context.Context, merge kinds.MergeDocument) error {
+	err := d.db.Update(func(tx *bolt.Tx) error {
+		return d.merge(tx, merge)
+	})
+	if err != nil {
+		return fmt.Errorf("merge: %v", err)
+	}
+
+	return nil
+}
+
+// BatchMerge applies all merges without a previous error in a single
+// transaction. Errors on individual merges are recorded on the item itself.
+func (d *DB) BatchMerge(ctx context.Context,
+	batch kinds.BatchMergeDocuments) (kinds.BatchMergeDocuments, error) {
+	err := d.db.Update(func(tx *bolt.Tx) error {
+		for i, item := range batch {
+			if item.Err != nil {
+				// ignore merges that already have an error
+				continue
+			}
+
+			if err := d.merge(tx, item.Merge); err != nil {
+				batch[i].Err = err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("batch merge: %v", err)
+	}
+
+	return batch, nil
+}
+
+func (d *DB) merge(tx *bolt.Tx, merge kinds.MergeDocument) error {
+	obj, err := d.objectByID(tx, merge.ID)
+	if err != nil {
+		return err
+	}
+
+	if obj == nil || obj.Kind != merge.Kind {
+		return fmt.Errorf("%s '%s' not found", merge.Kind.Name(), merge.ID)
+	}
+
+	if len(merge.PrimitiveSchema) > 0 {
+		props, err := normalizeProperties(merge.PrimitiveSchema)
+		if err != nil {
+			return err
+		}
+
+		for key, value := range props {
+			obj.Properties[key] = value
+		}
+
+		obj.Updated = merge.UpdateTime
+	}
+
+	if len(merge.Vector) > 0 {
+		obj.Vector = merge.Vector
+	}
+
+	for _, ref := range merge.References {
+		refMap, err := refToMap(ref.To.SingleRef())
+		if err != nil {
+			return err
+		}
+
+		prop := ref.From.Property.String()
+		existing, _ := obj.Properties[prop].([]interface{})
+		obj.Properties[prop] = append(existing, refMap)
+	}
+
+	return d.putObject(tx, obj)
+}
+
+// refToMap brings a single ref into the same untyped form a stored ref has
+func refToMap(ref *models.SingleRef) (map[string]interface{}, error) {
+	bytes, err := json.Marshal(ref)
+	if err != nil {
+		return nil, fmt.Errorf("marshal ref: %v", err)
+	}
+
+	var out map[string]interface{}
+	if err := json.Unmarshal(bytes, &out); err != nil {
+		return nil, fmt.Errorf("unmarshal ref: %v", err)
+	}
+
+	return out, nil
+}
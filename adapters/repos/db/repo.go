@@ -0,0 +1,119 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Package db is an embedded, disk-backed alternative to the esvector repo.
+// Objects, their vectors and an inverted index of their primitive properties
+// are stored in a local bolt database, so that weaviate can run without an
+// external Elasticsearch cluster.
+package db
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"time"
+
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	schemaUC "github.com/semi-technologies/weaviate/usecases/schema"
+	"github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+)
+
+const dbFileName = "weaviate.db"
+
+var (
+	// ObjectsBucket contains all objects (regardless of kind and class) keyed
+	// by their uuid
+	ObjectsBucket = []byte("objects")
+
+	// ClassesBucket contains one nested bucket per kind and class, which in
+	// turn contains the ids of all objects of this class
+	ClassesBucket = []byte("classes")
+
+	// InvertedBucket contains one nested bucket per kind, class and property
+	// mapping the indexed tokens to the ids of matching objects
+	InvertedBucket = []byte("inverted")
+)
+
+// DB stores and retrieves objects and their vectors in an embedded bolt
+// database
+type DB struct {
+	db           *bolt.DB
+	rootPath     string
+	logger       logrus.FieldLogger
+	schemaGetter schemaUC.SchemaGetter
+	vectors      *vectorIndexes
+}
+
+type schemaRefFinder interface {
+	Find(className schema.ClassName) []filters.Path
+}
+
+// New creates (or opens) the embedded database in the specified directory
+func New(rootPath string, logger logrus.FieldLogger,
+	schemaGetter schemaUC.SchemaGetter) (*DB, error) {
+	if err := os.MkdirAll(rootPath, 0777); err != nil {
+		return nil, fmt.Errorf("create root path '%s': %v", rootPath, err)
+	}
+
+	boltdb, err := bolt.Open(path.Join(rootPath, dbFileName), 0600,
+		&bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("open bolt db at '%s': %v", rootPath, err)
+	}
+
+	err = boltdb.Update(func(tx *bolt.Tx) error {
+		for _, b := range [][]byte{ObjectsBucket, ClassesBucket, InvertedBucket} {
+			if _, err := tx.CreateBucketIfNotExists(b); err != nil {
+				return fmt.Errorf("create bucket '%s': %v", string(b), err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		boltdb.Close()
+		return nil, fmt.Errorf("init buckets: %v", err)
+	}
+
+	return &DB{
+		db:           boltdb,
+		rootPath:     rootPath,
+		logger:       logger,
+		schemaGetter: schemaGetter,
+		vectors:      newVectorIndexes(),
+	}, nil
+}
+
+func (d *DB) SetSchemaGetter(sg schemaUC.SchemaGetter) {
+	d.schemaGetter = sg
+}
+
+// SetSchemaRefFinder exists for parity with the esvector repo. As references
+// are resolved at query time, there are no denormalized copies which would
+// need to be updated when a referenced object changes
+func (d *DB) SetSchemaRefFinder(srf schemaRefFinder) {
+}
+
+// WaitForStartup returns immediately, as the embedded db is ready as soon as
+// it was opened
+func (d *DB) WaitForStartup(maxWaitTime time.Duration) error {
+	return nil
+}
+
+// Shutdown closes the underlying bolt db, the DB cannot be used afterwards
+func (d *DB) Shutdown(ctx context.Context) error {
+	return d.db.Close()
+}
@@ -0,0 +1,205 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	bolt "go.etcd.io/bbolt"
+)
+
+// resolver turns storage objects into search results. As opposed to the
+// esvector repo, references are not denormalized at import time, but
+// resolved lazily from the same transaction, so they are always up to date.
+type resolver struct {
+	db *DB
+	tx *bolt.Tx
+}
+
+func newResolver(db *DB, tx *bolt.Tx) *resolver {
+	return &resolver{db: db, tx: tx}
+}
+
+func (r *resolver) result(obj *storageObject, properties traverser.SelectProperties,
+	meta bool) (search.Result, error) {
+	res := obj.searchResult()
+
+	schema, err := r.parseSchema(obj, properties, meta)
+	if err != nil {
+		return res, fmt.Errorf("parse schema: %v", err)
+	}
+	res.Schema = schema
+
+	if meta {
+		objectMeta := &models.ObjectMeta{}
+		if obj.Meta != nil {
+			metaCopy := *obj.Meta
+			objectMeta = &metaCopy
+		}
+		objectMeta.Vector = obj.Vector
+		res.Meta = objectMeta
+	}
+
+	return res, nil
+}
+
+// parseSchema lightly parses the schema, while most fields stay untyped, those
+// with special meaning, such as GeoCoordinates are marshalled into their
+// required types. Refs are resolved if they are part of the select properties.
+func (r *resolver) parseSchema(obj *storageObject, properties traverser.SelectProperties,
+	meta bool) (map[string]interface{}, error) {
+	output := map[string]interface{}{
+		"uuid": obj.ID.String(),
+	}
+
+	for key, value := range obj.Properties {
+		switch typed := value.(type) {
+		case map[string]interface{}:
+			parsed, err := parseMapProp(typed)
+			if err != nil {
+				return output, fmt.Errorf("prop '%s': %v", key, err)
+			}
+
+			output[key] = parsed
+
+		case []interface{}:
+			// must be a ref
+			if !properties.HasRefs() {
+				// the user isn't interested in resolving any refs, therefore simply
+				// return the unresolved beacon
+				refs, err := parseUnresolvedRefs(typed, meta)
+				if err != nil {
+					return output, fmt.Errorf("prop '%s': %v", key, err)
+				}
+
+				output[key] = refs
+				continue
+			}
+
+			// ref keys are uppercased in the desired response
+			refKey := uppercaseFirstLetter(key)
+			selectProp := properties.FindProperty(refKey)
+			if selectProp == nil {
+				// user is not interested in this prop
+				continue
+			}
+
+			resolved, err := r.resolveRefs(obj.beacons(key), *selectProp)
+			if err != nil {
+				return output, fmt.Errorf("prop '%s': %v", key, err)
+			}
+
+			if len(resolved) > 0 {
+				output[refKey] = resolved
+			}
+
+		default:
+			// anything else remains unchanged
+			output[key] = value
+		}
+	}
+
+	return output, nil
+}
+
+func (r *resolver) resolveRefs(beacons []string,
+	selectProp traverser.SelectProperty) ([]interface{}, error) {
+	var out []interface{}
+	for _, selectClass := range selectProp.Refs {
+		for _, beacon := range beacons {
+			target, err := r.db.resolveBeacon(r.tx, beacon)
+			if err != nil {
+				return nil, err
+			}
+
+			if target == nil || target.ClassName != selectClass.ClassName {
+				// either deleted in the meantime or not the class the user asked for
+				// in this particular selection
+				continue
+			}
+
+			fields, err := r.parseSchema(target, selectClass.RefProperties, false)
+			if err != nil {
+				return nil, fmt.Errorf("resolve %s: %v", beacon, err)
+			}
+
+			out = append(out, search.LocalRef{
+				Class:  target.ClassName,
+				Fields: fields,
+			})
+		}
+	}
+
+	return out, nil
+}
+
+func parseUnresolvedRefs(in []interface{}, meta bool) (models.MultipleRef, error) {
+	bytes, err := json.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+
+	var refs models.MultipleRef
+	if err := json.Unmarshal(bytes, &refs); err != nil {
+		return nil, fmt.Errorf("parse refs: %v", err)
+	}
+
+	if !meta {
+		for _, ref := range refs {
+			ref.Meta = nil
+		}
+	}
+
+	return refs, nil
+}
+
+func parseMapProp(input map[string]interface{}) (interface{}, error) {
+	lat, latOK := toFloat64(input["lat"])
+	lon, lonOK := toFloat64(input["lon"])
+	_, phoneInputOK := input["input"]
+
+	if latOK && lonOK {
+		// this is a geoCoordinates prop
+		return &models.GeoCoordinates{Latitude: float32(lat), Longitude: float32(lon)}, nil
+	}
+
+	if phoneInputOK {
+		// this is a phone number
+		bytes, err := json.Marshal(input)
+		if err != nil {
+			return nil, err
+		}
+
+		var phone models.PhoneNumber
+		if err := json.Unmarshal(bytes, &phone); err != nil {
+			return nil, fmt.Errorf("parse phone number: %v", err)
+		}
+
+		return &phone, nil
+	}
+
+	return nil, fmt.Errorf("unknown map prop which is not a geo prop or phone: %v", input)
+}
+
+func uppercaseFirstLetter(in string) string {
+	first := string(in[0])
+	rest := string(in[1:])
+
+	return strings.ToUpper(first) + rest
+}
@@ -0,0 +1,98 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	bolt "go.etcd.io/bbolt"
+)
+
+// ScrollClass calls fn for every object of the class in the order of their
+// ids. All objects are read in a single transaction, so the iteration sees a
+// consistent view of the class. References are not resolved. The iteration
+// stops at the first error returned by fn.
+func (d *DB) ScrollClass(ctx context.Context, k kind.Kind, className string,
+	fn func(search.Result) error) error {
+	err := d.db.View(func(tx *bolt.Tx) error {
+		resolver := newResolver(d, tx)
+		return d.iterate(tx, k, className, nil, func(obj *storageObject) (bool, error) {
+			if err := ctx.Err(); err != nil {
+				return false, err
+			}
+
+			res, err := resolver.result(obj, nil, false)
+			if err != nil {
+				return false, fmt.Errorf("object %s: %v", obj.ID, err)
+			}
+
+			if err := fn(res); err != nil {
+				return false, err
+			}
+
+			return true, nil
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("scroll class: %v", err)
+	}
+
+	return nil
+}
+
+// ClassPage returns a page of the objects of the class which match the
+// (optional) filter. The objects are returned in the order of their ids, only
+// objects with an id greater than the cursor are returned. In contrast to
+// ScrollClass the objects include their meta, so they can be stored again
+// without losing e.g. the classification meta.
+func (d *DB) ClassPage(ctx context.Context, k kind.Kind, className string,
+	filter *filters.LocalFilter, pagination *filters.Pagination) ([]search.Result, error) {
+	var out []search.Result
+	err := d.db.View(func(tx *bolt.Tx) error {
+		resolver := newResolver(d, tx)
+		m := newMatcher(d, tx)
+
+		return d.iterateAfter(tx, k, className, filter, pagination.After, func(obj *storageObject) (bool, error) {
+			if err := ctx.Err(); err != nil {
+				return false, err
+			}
+
+			ok, err := m.matches(obj, filter)
+			if err != nil {
+				return false, err
+			}
+
+			if !ok {
+				return true, nil
+			}
+
+			res, err := resolver.result(obj, nil, true)
+			if err != nil {
+				return false, fmt.Errorf("object %s: %v", obj.ID, err)
+			}
+
+			out = append(out, res)
+			return len(out) < pagination.Limit, nil
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("class page: %v", err)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,561 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+)
+
+// searchParams is the internal, repo-wide representation of a search. If
+// kind is empty all kinds are considered, if className is empty all classes
+// (of the kind) are considered. A nil vector means no vector scoring. Without
+// vector scoring results are ordered by id, so that after (the cursor) only
+// applies to searches without a vector. A sort replaces the order by id. If
+// keywordRanking is set, results are ordered by their BM25 score instead.
+type searchParams struct {
+	kind           kind.Kind
+	className      string
+	vector         []float32
+	offset         int
+	limit          int
+	after          strfmt.UUID
+	sort           []filters.Sort
+	keywordRanking *traverser.KeywordRankingParams
+	filters        *filters.LocalFilter
+	properties     traverser.SelectProperties
+	meta           bool
+}
+
+// ClassSearch searches for classes with optional filters without vector scoring
+func (d *DB) ClassSearch(ctx context.Context, params traverser.GetParams) ([]search.Result, error) {
+	start := time.Now()
+	res, err := d.search(ctx, searchParams{
+		kind:       params.Kind,
+		className:  params.ClassName,
+		offset:     offsetFromPagination(params.Pagination),
+		limit:      limitFromPagination(params.Pagination),
+		after:      afterFromPagination(params.Pagination),
+		sort:       params.Sort,
+		filters:    params.Filters,
+		properties: params.Properties,
+	})
+	d.logger.WithFields(logrus.Fields{
+		"action": "db_class_search",
+		"took":   time.Since(start),
+	}).Debug("completed class search")
+
+	return res, err
+}
+
+// VectorClassSearch limits the vector search to a specific class (and kind)
+func (d *DB) VectorClassSearch(ctx context.Context, params traverser.GetParams) ([]search.Result, error) {
+	start := time.Now()
+	res, err := d.search(ctx, searchParams{
+		kind:       params.Kind,
+		className:  params.ClassName,
+		vector:     params.SearchVector,
+		offset:     offsetFromPagination(params.Pagination),
+		limit:      limitFromPagination(params.Pagination),
+		filters:    params.Filters,
+		properties: params.Properties,
+	})
+	d.logger.WithFields(logrus.Fields{
+		"action": "db_vector_class_search",
+		"took":   time.Since(start),
+	}).Debug("completed vector class search")
+
+	return res, err
+}
+
+// KeywordClassSearch ranks the objects of the class by the BM25 relevance of
+// the query
+func (d *DB) KeywordClassSearch(ctx context.Context, params traverser.GetParams) ([]search.Result, error) {
+	start := time.Now()
+	res, err := d.search(ctx, searchParams{
+		kind:           params.Kind,
+		className:      params.ClassName,
+		offset:         offsetFromPagination(params.Pagination),
+		limit:          limitFromPagination(params.Pagination),
+		keywordRanking: params.KeywordRanking,
+		filters:        params.Filters,
+		properties:     params.Properties,
+	})
+	d.logger.WithFields(logrus.Fields{
+		"action": "db_keyword_class_search",
+		"took":   time.Since(start),
+	}).Debug("completed keyword class search")
+
+	return res, err
+}
+
+// VectorSearch retrives the closest concepts by vector distance
+func (d *DB) VectorSearch(ctx context.Context, vector []float32,
+	limit int, filters *filters.LocalFilter) ([]search.Result, error) {
+	return d.search(ctx, searchParams{
+		vector:  vector,
+		limit:   limit,
+		filters: filters,
+	})
+}
+
+func limitFromPagination(p *filters.Pagination) int {
+	if p == nil {
+		return 100
+	}
+
+	return p.Limit
+}
+
+func offsetFromPagination(p *filters.Pagination) int {
+	if p == nil {
+		return 0
+	}
+
+	return p.Offset
+}
+
+func afterFromPagination(p *filters.Pagination) strfmt.UUID {
+	if p == nil {
+		return ""
+	}
+
+	return p.After
+}
+
+// scoredObject is a search match, dist is only set for vector searches and
+// score only for keyword searches
+type scoredObject struct {
+	obj   *storageObject
+	dist  float32
+	score float32
+}
+
+func (d *DB) search(ctx context.Context, params searchParams) ([]search.Result, error) {
+	d.logger.
+		WithField("action", "db_search").
+		WithField("kind", params.kind).
+		WithField("class", params.className).
+		WithField("filters", params.filters).
+		Debug("starting search in embedded db")
+
+	var indexes []classVectorIndex
+	if params.vector != nil {
+		var err error
+		indexes, err = d.vectorIndexesFor(params.kind, params.className)
+		if err != nil {
+			return nil, fmt.Errorf("search: %v", err)
+		}
+	}
+
+	var out []search.Result
+	err := d.db.View(func(tx *bolt.Tx) error {
+		var matches []scoredObject
+		var err error
+		if params.vector != nil {
+			matches, err = d.findNearest(ctx, tx, indexes, params)
+		} else if params.keywordRanking != nil {
+			matches, err = d.findKeywordMatches(ctx, tx, params)
+		} else {
+			matches, err = d.findMatches(ctx, tx, params)
+		}
+		if err != nil {
+			return err
+		}
+
+		resolver := newResolver(d, tx)
+		out = make([]search.Result, len(matches))
+		for i, match := range matches {
+			res, err := resolver.result(match.obj, params.properties, params.meta)
+			if err != nil {
+				return fmt.Errorf("result %d: %v", i, err)
+			}
+
+			if params.vector != nil {
+				res.Score = 1 - match.dist
+			}
+
+			if params.keywordRanking != nil {
+				res.Score = match.score
+			}
+
+			out[i] = res
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("search: %v", err)
+	}
+
+	return out, nil
+}
+
+// findMatches returns all objects matching the search params in the order
+// they are stored, which is the order of their ids. The first offset matches
+// and all objects up to and including the cursor are skipped, the results are
+// cut off at the limit.
+func (d *DB) findMatches(ctx context.Context, tx *bolt.Tx,
+	params searchParams) ([]scoredObject, error) {
+	if len(params.sort) > 0 {
+		return d.findSortedMatches(ctx, tx, params)
+	}
+
+	var matches []scoredObject
+	skipped := 0
+	m := newMatcher(d, tx)
+
+	err := d.iterate(tx, params.kind, params.className, params.filters,
+		func(obj *storageObject) (bool, error) {
+			if err := ctx.Err(); err != nil {
+				return false, err
+			}
+
+			if params.after != "" && obj.ID <= params.after {
+				return true, nil
+			}
+
+			ok, err := m.matches(obj, params.filters)
+			if err != nil {
+				return false, err
+			}
+
+			if !ok {
+				return true, nil
+			}
+
+			if skipped < params.offset {
+				skipped++
+				return true, nil
+			}
+
+			matches = append(matches, scoredObject{obj: obj})
+
+			// there is no need to look at more objects than we're going to return
+			return params.limit < 0 || len(matches) < params.limit, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return matches, nil
+}
+
+type classVectorIndex struct {
+	kind      kind.Kind
+	className string
+	index     *vectorIndex
+}
+
+// vectorIndexesFor returns the vector index of the class. If no class is set,
+// the indexes of all classes (of the kind) are returned.
+func (d *DB) vectorIndexesFor(k kind.Kind, className string) ([]classVectorIndex, error) {
+	type class struct {
+		kind kind.Kind
+		name string
+	}
+
+	var classes []class
+	if className != "" {
+		classes = append(classes, class{k, className})
+	} else if d.schemaGetter != nil {
+		s := d.schemaGetter.GetSchemaSkipAuth()
+		for _, ki := range []kind.Kind{kind.Thing, kind.Action} {
+			if k != "" && k != ki {
+				continue
+			}
+
+			semanticSchema := s.SemanticSchemaFor(ki)
+			if semanticSchema == nil {
+				continue
+			}
+
+			for _, c := range semanticSchema.Classes {
+				classes = append(classes, class{ki, c.Class})
+			}
+		}
+	}
+
+	out := make([]classVectorIndex, len(classes))
+	for i, c := range classes {
+		index, err := d.vectorIndex(c.kind, c.name)
+		if err != nil {
+			return nil, err
+		}
+
+		out[i] = classVectorIndex{kind: c.kind, className: c.name, index: index}
+	}
+
+	return out, nil
+}
+
+// findNearest uses the vector indexes to find the objects closest to the
+// search vector. Filters restrict the candidates before the vector search,
+// so that the limit is always respected if enough objects match.
+func (d *DB) findNearest(ctx context.Context, tx *bolt.Tx,
+	indexes []classVectorIndex, params searchParams) ([]scoredObject, error) {
+	var matches []scoredObject
+	for _, index := range indexes {
+		var allow map[strfmt.UUID]struct{}
+		if params.filters != nil {
+			var err error
+			allow, err = d.matchingIDs(ctx, tx, index.kind, index.className, params.filters,
+				func(*storageObject) bool { return true })
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		classMatches, err := d.nearestInIndex(tx, index.index, params.vector,
+			params.offset+params.limit, allow)
+		if err != nil {
+			return nil, fmt.Errorf("vector search in class %s: %v", index.className, err)
+		}
+
+		matches = append(matches, classMatches...)
+	}
+
+	sort.SliceStable(matches, func(a, b int) bool {
+		return matches[a].dist < matches[b].dist
+	})
+
+	if params.offset >= len(matches) {
+		return nil, nil
+	}
+	matches = matches[params.offset:]
+
+	if params.limit >= 0 && len(matches) > params.limit {
+		matches = matches[:params.limit]
+	}
+
+	return matches, nil
+}
+
+// matchingIDs returns the ids of all objects of the class which match the
+// filters and the additional condition
+func (d *DB) matchingIDs(ctx context.Context, tx *bolt.Tx, k kind.Kind, className string,
+	f *filters.LocalFilter, condition func(obj *storageObject) bool) (map[strfmt.UUID]struct{}, error) {
+	out := map[strfmt.UUID]struct{}{}
+	m := newMatcher(d, tx)
+
+	err := d.iterate(tx, k, className, f, func(obj *storageObject) (bool, error) {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+
+		if !condition(obj) {
+			return true, nil
+		}
+
+		ok, err := m.matches(obj, f)
+		if err != nil {
+			return false, err
+		}
+
+		if ok {
+			out[obj.ID] = struct{}{}
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// nearestInIndex loads the objects found in the vector index from the
+// current transaction, objects that were deleted in the meantime are
+// skipped
+func (d *DB) nearestInIndex(tx *bolt.Tx, index *vectorIndex, vector []float32,
+	limit int, allow map[strfmt.UUID]struct{}) ([]scoredObject, error) {
+	ids, dists, err := index.search(vector, limit, allow)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]scoredObject, 0, len(ids))
+	for i, id := range ids {
+		obj, err := d.objectByID(tx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if obj == nil {
+			continue
+		}
+
+		out = append(out, scoredObject{obj: obj, dist: dists[i]})
+	}
+
+	return out, nil
+}
+
+// iterate calls fn for each object that could potentially match the filters,
+// iteration stops if fn returns false or errors
+func (d *DB) iterate(tx *bolt.Tx, k kind.Kind, className string,
+	f *filters.LocalFilter, fn func(obj *storageObject) (bool, error)) error {
+	return d.iterateAfter(tx, k, className, f, "", fn)
+}
+
+// iterateAfter is like iterate, but starts right after the id, so that paging
+// through a class doesn't have to skip over all previous pages
+func (d *DB) iterateAfter(tx *bolt.Tx, k kind.Kind, className string,
+	f *filters.LocalFilter, after strfmt.UUID, fn func(obj *storageObject) (bool, error)) error {
+	if className == "" {
+		return d.forEachObjectAfter(tx.Bucket(ObjectsBucket), after, func(obj *storageObject) (bool, error) {
+			if k != "" && obj.Kind != k {
+				return true, nil
+			}
+
+			return fn(obj)
+		})
+	}
+
+	candidates := d.candidates(tx, k, className, f)
+	if candidates != nil {
+		ids := make([]string, 0, len(candidates))
+		for id := range candidates {
+			if after != "" && string(id) <= string(after) {
+				continue
+			}
+			ids = append(ids, string(id))
+		}
+		sort.Strings(ids)
+
+		for _, id := range ids {
+			obj, err := d.objectByID(tx, strfmt.UUID(id))
+			if err != nil {
+				return err
+			}
+
+			if obj == nil {
+				continue
+			}
+
+			cont, err := fn(obj)
+			if err != nil {
+				return err
+			}
+
+			if !cont {
+				return nil
+			}
+		}
+
+		return nil
+	}
+
+	classBucket := tx.Bucket(ClassesBucket).Bucket(classBucketName(k, className))
+	if classBucket == nil {
+		return nil
+	}
+
+	return d.forEachIDAfter(tx, classBucket, after, fn)
+}
+
+var errStopIteration = fmt.Errorf("stop iteration")
+
+// forEachObject parses every value of the bucket as a storage object
+func (d *DB) forEachObject(b *bolt.Bucket, fn func(obj *storageObject) (bool, error)) error {
+	return d.forEachObjectAfter(b, "", fn)
+}
+
+// forEachObjectAfter is like forEachObject, but only starts after the key
+func (d *DB) forEachObjectAfter(b *bolt.Bucket, after strfmt.UUID,
+	fn func(obj *storageObject) (bool, error)) error {
+	err := forEachAfter(b, after, func(k, v []byte) error {
+		return callStoppable(k, v, fn)
+	})
+	if err == errStopIteration {
+		return nil
+	}
+
+	return err
+}
+
+// forEachID treats every key of the bucket as an object id which is looked
+// up in the objects bucket
+func (d *DB) forEachID(tx *bolt.Tx, b *bolt.Bucket, fn func(obj *storageObject) (bool, error)) error {
+	return d.forEachIDAfter(tx, b, "", fn)
+}
+
+// forEachIDAfter is like forEachID, but only starts after the id
+func (d *DB) forEachIDAfter(tx *bolt.Tx, b *bolt.Bucket, after strfmt.UUID,
+	fn func(obj *storageObject) (bool, error)) error {
+	objects := tx.Bucket(ObjectsBucket)
+	err := forEachAfter(b, after, func(k, v []byte) error {
+		data := objects.Get(k)
+		if data == nil {
+			return nil
+		}
+
+		return callStoppable(k, data, fn)
+	})
+	if err == errStopIteration {
+		return nil
+	}
+
+	return err
+}
+
+// forEachAfter seeks to the first key greater than after, rather than
+// iterating the whole bucket up to it
+func forEachAfter(b *bolt.Bucket, after strfmt.UUID, fn func(k, v []byte) error) error {
+	c := b.Cursor()
+	k, v := c.First()
+	if after != "" {
+		k, v = c.Seek([]byte(after))
+		if k != nil && string(k) == string(after) {
+			k, v = c.Next()
+		}
+	}
+
+	for ; k != nil; k, v = c.Next() {
+		if err := fn(k, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func callStoppable(id, data []byte, fn func(obj *storageObject) (bool, error)) error {
+	obj, err := parseStorageObject(data)
+	if err != nil {
+		return fmt.Errorf("object '%s': %v", string(id), err)
+	}
+
+	cont, err := fn(obj)
+	if err != nil {
+		return err
+	}
+
+	if !cont {
+		return errStopIteration
+	}
+
+	return nil
+}
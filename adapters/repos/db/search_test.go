@@ -0,0 +1,624 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/crossref"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCRUD(t *testing.T) {
+	repo, cleanup := newTestDB(t)
+	defer cleanup()
+
+	t.Run("getting a thing by id", func(t *testing.T) {
+		res, err := repo.ThingByID(context.Background(), productA, nil, false)
+		require.Nil(t, err)
+		require.NotNil(t, res)
+
+		assert.Equal(t, "Product", res.ClassName)
+		assert.Equal(t, "anvil", res.Schema.(map[string]interface{})["name"])
+		assert.Equal(t, 10.0, res.Schema.(map[string]interface{})["price"])
+	})
+
+	t.Run("getting a thing as an action", func(t *testing.T) {
+		res, err := repo.ActionByID(context.Background(), productA, nil, false)
+		require.Nil(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("resolving a reference", func(t *testing.T) {
+		props := traverser.SelectProperties{
+			traverser.SelectProperty{
+				Name: "OfCompany",
+				Refs: []traverser.SelectClass{
+					traverser.SelectClass{
+						ClassName: "Company",
+						RefProperties: traverser.SelectProperties{
+							traverser.SelectProperty{Name: "name", IsPrimitive: true},
+						},
+					},
+				},
+			},
+		}
+
+		res, err := repo.ThingByID(context.Background(), productA, props, false)
+		require.Nil(t, err)
+		require.NotNil(t, res)
+
+		refs := res.Schema.(map[string]interface{})["OfCompany"].([]interface{})
+		require.Len(t, refs, 1)
+		company := refs[0].(search.LocalRef)
+		assert.Equal(t, "Company", company.Class)
+		assert.Equal(t, "Acme", company.Fields["name"])
+	})
+
+	t.Run("checking existence", func(t *testing.T) {
+		ok, err := repo.Exists(context.Background(), productB)
+		require.Nil(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("deleting a thing", func(t *testing.T) {
+		err := repo.DeleteThing(context.Background(), "Product", productB)
+		require.Nil(t, err)
+
+		ok, err := repo.Exists(context.Background(), productB)
+		require.Nil(t, err)
+		assert.False(t, ok)
+
+		res, err := repo.ClassSearch(context.Background(), traverser.GetParams{
+			Kind:      kind.Thing,
+			ClassName: "Product",
+			Filters:   filterEqual("name", schema.DataTypeString, "rocket"),
+		})
+		require.Nil(t, err)
+		assert.Len(t, res, 0)
+	})
+
+	t.Run("deleting a thing with the wrong class", func(t *testing.T) {
+		err := repo.DeleteThing(context.Background(), "Company", productA)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestSearch(t *testing.T) {
+	repo, cleanup := newTestDB(t)
+	defer cleanup()
+
+	type test struct {
+		name        string
+		filter      *filters.LocalFilter
+		expectedIDs []interface{}
+	}
+
+	tests := []test{
+		{
+			name:        "string equal",
+			filter:      filterEqual("name", schema.DataTypeString, "rocket"),
+			expectedIDs: []interface{}{productB},
+		},
+		{
+			name:        "text equal matches single words",
+			filter:      filterEqual("description", schema.DataTypeText, "heavy"),
+			expectedIDs: []interface{}{productA, productC},
+		},
+		{
+			name: "number greater than",
+			filter: &filters.LocalFilter{Root: &filters.Clause{
+				Operator: filters.OperatorGreaterThan,
+				On:       &filters.Path{Class: "Product", Property: "price"},
+				Value:    &filters.Value{Type: schema.DataTypeNumber, Value: 20.0},
+			}},
+			expectedIDs: []interface{}{productB, productC},
+		},
+		{
+			name: "and",
+			filter: &filters.LocalFilter{Root: &filters.Clause{
+				Operator: filters.OperatorAnd,
+				Operands: []filters.Clause{
+					*filterEqual("description", schema.DataTypeText, "heavy").Root,
+					*filterEqual("available", schema.DataTypeBoolean, true).Root,
+					*filterEqual("price", schema.DataTypeNumber, 30.0).Root,
+				},
+			}},
+			expectedIDs: []interface{}{productC},
+		},
+		{
+			name: "like",
+			filter: &filters.LocalFilter{Root: &filters.Clause{
+				Operator: filters.OperatorLike,
+				On:       &filters.Path{Class: "Product", Property: "name"},
+				Value:    &filters.Value{Type: schema.DataTypeString, Value: "r?ck*"},
+			}},
+			expectedIDs: []interface{}{productB},
+		},
+		{
+			name: "on a ref prop",
+			filter: &filters.LocalFilter{Root: &filters.Clause{
+				Operator: filters.OperatorEqual,
+				On: &filters.Path{
+					Class:    "Product",
+					Property: "ofCompany",
+					Child:    &filters.Path{Class: "Company", Property: "name"},
+				},
+				Value: &filters.Value{Type: schema.DataTypeString, Value: "Acme"},
+			}},
+			expectedIDs: []interface{}{productA, productB, productC},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := repo.ClassSearch(context.Background(), traverser.GetParams{
+				Kind:      kind.Thing,
+				ClassName: "Product",
+				Filters:   test.filter,
+			})
+			require.Nil(t, err)
+			assert.ElementsMatch(t, test.expectedIDs, extractIDs(res))
+		})
+	}
+
+	t.Run("vector search sorts by distance", func(t *testing.T) {
+		res, err := repo.VectorClassSearch(context.Background(), traverser.GetParams{
+			Kind:         kind.Thing,
+			ClassName:    "Product",
+			SearchVector: []float32{0, 1, 0},
+			Pagination:   &filters.Pagination{Limit: 2},
+		})
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+		assert.Equal(t, []interface{}{productC, productB}, extractIDs(res))
+		assert.InDelta(t, 1, res[0].Score, 0.0001)
+	})
+
+	t.Run("vector search across classes", func(t *testing.T) {
+		res, err := repo.VectorSearch(context.Background(), []float32{1, 0, 0}, 10, nil)
+		require.Nil(t, err)
+		assert.Len(t, res, 4)
+	})
+}
+
+func TestPagination(t *testing.T) {
+	repo, cleanup := newTestDB(t)
+	defer cleanup()
+
+	classSearch := func(p *filters.Pagination) []interface{} {
+		res, err := repo.ClassSearch(context.Background(), traverser.GetParams{
+			Kind:       kind.Thing,
+			ClassName:  "Product",
+			Pagination: p,
+		})
+		require.Nil(t, err)
+		return extractIDs(res)
+	}
+
+	t.Run("results are ordered by id", func(t *testing.T) {
+		assert.Equal(t, []interface{}{productA, productB, productC},
+			classSearch(&filters.Pagination{Limit: 10}))
+	})
+
+	t.Run("with an offset", func(t *testing.T) {
+		assert.Equal(t, []interface{}{productB},
+			classSearch(&filters.Pagination{Offset: 1, Limit: 1}))
+	})
+
+	t.Run("with an offset past the last result", func(t *testing.T) {
+		assert.Len(t, classSearch(&filters.Pagination{Offset: 3, Limit: 10}), 0)
+	})
+
+	t.Run("with a cursor", func(t *testing.T) {
+		assert.Equal(t, []interface{}{productB, productC},
+			classSearch(&filters.Pagination{After: productA, Limit: 10}))
+	})
+
+	t.Run("listing things with a cursor", func(t *testing.T) {
+		res, err := repo.ThingSearch(context.Background(),
+			&filters.Pagination{After: productB, Limit: 10}, nil, nil, false)
+		require.Nil(t, err)
+		assert.Equal(t, []interface{}{productC}, extractIDs(res))
+	})
+
+	t.Run("vector search with an offset", func(t *testing.T) {
+		res, err := repo.VectorClassSearch(context.Background(), traverser.GetParams{
+			Kind:         kind.Thing,
+			ClassName:    "Product",
+			SearchVector: []float32{0, 1, 0},
+			Pagination:   &filters.Pagination{Offset: 1, Limit: 1},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []interface{}{productB}, extractIDs(res))
+	})
+}
+
+func TestMergeAndReferences(t *testing.T) {
+	repo, cleanup := newTestDB(t)
+	defer cleanup()
+
+	err := repo.Merge(context.Background(), kinds.MergeDocument{
+		Kind:  kind.Thing,
+		Class: "Product",
+		ID:    productA,
+		PrimitiveSchema: map[string]interface{}{
+			"name": "hammer",
+		},
+		Vector: []float32{1, 0, 0},
+		References: kinds.BatchReferences{
+			kinds.BatchReference{
+				From: &crossref.RefSource{
+					Local:    true,
+					PeerName: "localhost",
+					Property: "ofCompany",
+					Class:    "Product",
+					TargetID: productA,
+					Kind:     kind.Thing,
+				},
+				To: &crossref.Ref{
+					Local:    true,
+					PeerName: "localhost",
+					TargetID: productB,
+					Kind:     kind.Thing,
+				},
+			},
+		},
+	})
+	require.Nil(t, err)
+
+	res, err := repo.ClassSearch(context.Background(), traverser.GetParams{
+		Kind:      kind.Thing,
+		ClassName: "Product",
+		Filters:   filterEqual("name", schema.DataTypeString, "hammer"),
+	})
+	require.Nil(t, err)
+	require.Len(t, res, 1)
+	assert.Equal(t, productA, res[0].ID)
+
+	refs := res[0].Schema.(map[string]interface{})["ofCompany"].(models.MultipleRef)
+	assert.Len(t, refs, 2)
+}
+
+func TestBatchMerge(t *testing.T) {
+	repo, cleanup := newTestDB(t)
+	defer cleanup()
+
+	res, err := repo.BatchMerge(context.Background(), kinds.BatchMergeDocuments{
+		{
+			OriginalIndex: 0,
+			Merge: kinds.MergeDocument{
+				Kind:            kind.Thing,
+				Class:           "Product",
+				ID:              productA,
+				PrimitiveSchema: map[string]interface{}{"name": "hammer"},
+				Vector:          []float32{1, 0, 0},
+			},
+		},
+		{
+			OriginalIndex: 1,
+			Err:           fmt.Errorf("invalid merge"),
+			Merge: kinds.MergeDocument{
+				Kind:            kind.Thing,
+				Class:           "Product",
+				ID:              productB,
+				PrimitiveSchema: map[string]interface{}{"name": "hammer"},
+			},
+		},
+		{
+			OriginalIndex: 2,
+			Merge: kinds.MergeDocument{
+				Kind:            kind.Action,
+				Class:           "Product",
+				ID:              productB,
+				PrimitiveSchema: map[string]interface{}{"name": "hammer"},
+			},
+		},
+	})
+	require.Nil(t, err)
+	require.Len(t, res, 3)
+	assert.Nil(t, res[0].Err)
+	assert.Equal(t, fmt.Errorf("invalid merge"), res[1].Err)
+	assert.Equal(t, fmt.Errorf("action '%s' not found", productB), res[2].Err)
+
+	found, err := repo.ClassSearch(context.Background(), traverser.GetParams{
+		Kind:      kind.Thing,
+		ClassName: "Product",
+		Filters:   filterEqual("name", schema.DataTypeString, "hammer"),
+	})
+	require.Nil(t, err)
+	assert.Equal(t, []interface{}{productA}, extractIDs(found))
+}
+
+func filterEqual(prop string, dt schema.DataType, value interface{}) *filters.LocalFilter {
+	return &filters.LocalFilter{Root: &filters.Clause{
+		Operator: filters.OperatorEqual,
+		On:       &filters.Path{Class: "Product", Property: schema.PropertyName(prop)},
+		Value:    &filters.Value{Type: dt, Value: value},
+	}}
+}
+
+func extractIDs(in []search.Result) []interface{} {
+	out := make([]interface{}, len(in))
+	for i, res := range in {
+		out[i] = res.ID
+	}
+
+	return out
+}
+
+func TestVectorIndexUpdates(t *testing.T) {
+	repo, cleanup := newTestDB(t)
+	defer cleanup()
+
+	nearest := func(t *testing.T, vector []float32) []interface{} {
+		res, err := repo.VectorClassSearch(context.Background(), traverser.GetParams{
+			Kind:         kind.Thing,
+			ClassName:    "Product",
+			SearchVector: vector,
+			Pagination:   &filters.Pagination{Limit: 1},
+		})
+		require.Nil(t, err)
+		return extractIDs(res)
+	}
+
+	t.Run("initially built from disk", func(t *testing.T) {
+		assert.Equal(t, []interface{}{productC}, nearest(t, []float32{0, 1, 0}))
+	})
+
+	t.Run("after updating a vector", func(t *testing.T) {
+		err := repo.PutThing(context.Background(), &models.Thing{
+			ID:     productA,
+			Class:  "Product",
+			Schema: map[string]interface{}{"name": "anvil"},
+		}, []float32{0, 0, 1})
+		require.Nil(t, err)
+
+		assert.Equal(t, []interface{}{productA}, nearest(t, []float32{0, 0, 1}))
+	})
+
+	t.Run("after deleting an object", func(t *testing.T) {
+		err := repo.DeleteThing(context.Background(), "Product", productC)
+		require.Nil(t, err)
+
+		assert.Equal(t, []interface{}{productB}, nearest(t, []float32{0, 1, 0}))
+	})
+
+	t.Run("with a filter", func(t *testing.T) {
+		res, err := repo.VectorClassSearch(context.Background(), traverser.GetParams{
+			Kind:         kind.Thing,
+			ClassName:    "Product",
+			SearchVector: []float32{0, 0, 1},
+			Filters:      filterEqual("name", schema.DataTypeString, "rocket"),
+			Pagination:   &filters.Pagination{Limit: 1},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []interface{}{productB}, extractIDs(res))
+	})
+
+	t.Run("importing a vector of the wrong length", func(t *testing.T) {
+		err := repo.PutThing(context.Background(), &models.Thing{
+			ID:     productC,
+			Class:  "Product",
+			Schema: map[string]interface{}{"name": "magnet"},
+		}, []float32{0, 1})
+		assert.NotNil(t, err)
+	})
+}
+
+func TestSort(t *testing.T) {
+	repo, cleanup := newTestDB(t)
+	defer cleanup()
+
+	classSearch := func(sort []filters.Sort, p *filters.Pagination,
+		f *filters.LocalFilter) []interface{} {
+		res, err := repo.ClassSearch(context.Background(), traverser.GetParams{
+			Kind:       kind.Thing,
+			ClassName:  "Product",
+			Pagination: p,
+			Filters:    f,
+			Sort:       sort,
+		})
+		require.Nil(t, err)
+		return extractIDs(res)
+	}
+
+	byPriceThenName := []filters.Sort{
+		{Path: []string{"price"}, Order: filters.SortOrderDesc},
+		{Path: []string{"name"}, Order: filters.SortOrderAsc},
+	}
+
+	t.Run("by multiple properties", func(t *testing.T) {
+		assert.Equal(t, []interface{}{productC, productB, productA},
+			classSearch(byPriceThenName, &filters.Pagination{Limit: 10}, nil))
+	})
+
+	t.Run("with an offset", func(t *testing.T) {
+		assert.Equal(t, []interface{}{productB},
+			classSearch(byPriceThenName, &filters.Pagination{Offset: 1, Limit: 1}, nil))
+	})
+
+	t.Run("with a filter", func(t *testing.T) {
+		sort := []filters.Sort{{Path: []string{"name"}, Order: filters.SortOrderDesc}}
+		assert.Equal(t, []interface{}{productB, productC},
+			classSearch(sort, &filters.Pagination{Limit: 10},
+				filterEqual("price", schema.DataTypeNumber, 30.0)))
+	})
+
+	t.Run("listing things across classes", func(t *testing.T) {
+		res, err := repo.ThingSearch(context.Background(), &filters.Pagination{Limit: 10},
+			[]filters.Sort{{Path: []string{"name"}, Order: filters.SortOrderAsc}}, nil, false)
+		require.Nil(t, err)
+		assert.Equal(t, []interface{}{companyID, productA, productC, productB}, extractIDs(res))
+	})
+}
+
+func TestKeywordSearch(t *testing.T) {
+	repo, cleanup := newTestDB(t)
+	defer cleanup()
+
+	keywordSearch := func(query string, props []string,
+		f *filters.LocalFilter) []search.Result {
+		res, err := repo.KeywordClassSearch(context.Background(), traverser.GetParams{
+			Kind:       kind.Thing,
+			ClassName:  "Product",
+			Pagination: &filters.Pagination{Limit: 10},
+			Filters:    f,
+			KeywordRanking: &traverser.KeywordRankingParams{
+				Query:      query,
+				Properties: props,
+			},
+		})
+		require.Nil(t, err)
+		return res
+	}
+
+	t.Run("ranks by relevance across text and string props", func(t *testing.T) {
+		res := keywordSearch("heavy magnet", []string{"name", "description"}, nil)
+		require.Len(t, res, 2)
+		assert.Equal(t, productC, res[0].ID)
+		assert.Equal(t, productA, res[1].ID)
+		assert.True(t, res[0].Score > res[1].Score)
+		assert.True(t, res[1].Score > 0)
+	})
+
+	t.Run("only searches the specified props", func(t *testing.T) {
+		res := keywordSearch("magnet", []string{"name"}, nil)
+		assert.Equal(t, []interface{}{productC}, extractIDs(res))
+
+		res = keywordSearch("heavy", []string{"name"}, nil)
+		assert.Len(t, res, 0)
+	})
+
+	t.Run("combined with a filter", func(t *testing.T) {
+		res := keywordSearch("heavy magnet", []string{"name", "description"},
+			filterEqual("price", schema.DataTypeNumber, 10.0))
+		assert.Equal(t, []interface{}{productA}, extractIDs(res))
+	})
+
+	t.Run("without any matching terms", func(t *testing.T) {
+		res := keywordSearch("spaceship", []string{"name", "description"}, nil)
+		assert.Len(t, res, 0)
+	})
+}
+
+func TestScrollClass(t *testing.T) {
+	repo, cleanup := newTestDB(t)
+	defer cleanup()
+
+	t.Run("all objects of the class are visited in id order", func(t *testing.T) {
+		var results []search.Result
+		err := repo.ScrollClass(context.Background(), kind.Thing, "Product",
+			func(res search.Result) error {
+				results = append(results, res)
+				return nil
+			})
+		require.Nil(t, err)
+
+		require.Len(t, results, 3)
+		assert.Equal(t, productA, results[0].ID)
+		assert.Equal(t, productB, results[1].ID)
+		assert.Equal(t, productC, results[2].ID)
+
+		assert.Equal(t, []float32{1, 0, 0}, results[0].Vector)
+		assert.Equal(t, "anvil", results[0].Schema.(map[string]interface{})["name"])
+		assert.Equal(t, models.MultipleRef{
+			&models.SingleRef{
+				Beacon: strfmt.URI("weaviate://localhost/things/" + companyID),
+			},
+		}, results[0].Schema.(map[string]interface{})["ofCompany"])
+	})
+
+	t.Run("an error stops the iteration", func(t *testing.T) {
+		calls := 0
+		err := repo.ScrollClass(context.Background(), kind.Thing, "Product",
+			func(res search.Result) error {
+				calls++
+				return fmt.Errorf("write failed")
+			})
+		assert.Equal(t, 1, calls)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "write failed")
+	})
+}
+
+func TestClassPage(t *testing.T) {
+	repo, cleanup := newTestDB(t)
+	defer cleanup()
+
+	t.Run("pages through the class with a cursor", func(t *testing.T) {
+		page, err := repo.ClassPage(context.Background(), kind.Thing, "Product",
+			nil, &filters.Pagination{Limit: 2})
+		require.Nil(t, err)
+		require.Len(t, page, 2)
+		assert.Equal(t, productA, page[0].ID)
+		assert.Equal(t, productB, page[1].ID)
+
+		require.NotNil(t, page[0].Meta)
+		assert.Equal(t, models.C11yVector{1, 0, 0}, page[0].Meta.Vector)
+
+		page, err = repo.ClassPage(context.Background(), kind.Thing, "Product",
+			nil, &filters.Pagination{Limit: 2, After: productB})
+		require.Nil(t, err)
+		require.Len(t, page, 1)
+		assert.Equal(t, productC, page[0].ID)
+	})
+
+	t.Run("only objects matching the filter are returned", func(t *testing.T) {
+		page, err := repo.ClassPage(context.Background(), kind.Thing, "Product",
+			filterEqual("price", schema.DataTypeNumber, 30.0), &filters.Pagination{Limit: 10})
+		require.Nil(t, err)
+		require.Len(t, page, 2)
+		assert.Equal(t, productB, page[0].ID)
+		assert.Equal(t, productC, page[1].ID)
+	})
+}
+
+func TestBatchDeleteObjects(t *testing.T) {
+	repo, cleanup := newTestDB(t)
+	defer cleanup()
+
+	// productB was deleted concurrently, i.e. after the objects to delete were
+	// listed, but before the batch delete
+	require.Nil(t, repo.DeleteThing(context.Background(), "Product", productB))
+
+	res, err := repo.BatchDeleteObjects(context.Background(), kind.Thing, "Product",
+		kinds.BatchDeleteObjects{
+			{OriginalIndex: 0, UUID: productA},
+			{OriginalIndex: 1, UUID: productB},
+			{OriginalIndex: 2, UUID: productC, Err: fmt.Errorf("restricted")},
+		})
+	require.Nil(t, err)
+	require.Len(t, res, 3)
+	assert.Nil(t, res[0].Err)
+	assert.Equal(t, fmt.Errorf("thing '%s' of class 'Product' not found", productB),
+		res[1].Err)
+	assert.Equal(t, fmt.Errorf("restricted"), res[2].Err)
+
+	found, err := repo.ClassPage(context.Background(), kind.Thing, "Product",
+		nil, &filters.Pagination{Limit: 10})
+	require.Nil(t, err)
+	assert.Equal(t, []interface{}{productC}, extractIDs(found))
+}
@@ -0,0 +1,212 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/semi-technologies/weaviate/entities/filters"
+	bolt "go.etcd.io/bbolt"
+)
+
+// findSortedMatches returns all objects matching the search params ordered
+// by the sort clauses. As the order is only known once all matches have been
+// seen, offset and limit are applied after sorting.
+func (d *DB) findSortedMatches(ctx context.Context, tx *bolt.Tx,
+	params searchParams) ([]scoredObject, error) {
+	var matches []scoredObject
+	m := newMatcher(d, tx)
+
+	err := d.iterate(tx, params.kind, params.className, params.filters,
+		func(obj *storageObject) (bool, error) {
+			if err := ctx.Err(); err != nil {
+				return false, err
+			}
+
+			ok, err := m.matches(obj, params.filters)
+			if err != nil {
+				return false, err
+			}
+
+			if ok {
+				matches = append(matches, scoredObject{obj: obj})
+			}
+
+			return true, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	// objects are iterated in id order, a stable sort keeps the id as the
+	// tie breaker
+	sort.SliceStable(matches, func(a, b int) bool {
+		return lessBySort(matches[a].obj, matches[b].obj, params.sort)
+	})
+
+	if params.offset >= len(matches) {
+		return nil, nil
+	}
+	matches = matches[params.offset:]
+
+	if params.limit >= 0 && len(matches) > params.limit {
+		matches = matches[:params.limit]
+	}
+
+	return matches, nil
+}
+
+// lessBySort compares the objects clause by clause, the next clause is only
+// considered if the values of the previous one are equal. Missing values
+// always come last.
+func lessBySort(a, b *storageObject, clauses []filters.Sort) bool {
+	for _, clause := range clauses {
+		valueA, okA := sortValue(a, clause)
+		valueB, okB := sortValue(b, clause)
+
+		switch {
+		case !okA && !okB:
+			continue
+		case !okA:
+			return false
+		case !okB:
+			return true
+		}
+
+		cmp := compareSortValues(valueA, valueB)
+		if cmp == 0 {
+			continue
+		}
+
+		if clause.Desc() {
+			return cmp > 0
+		}
+
+		return cmp < 0
+	}
+
+	return false
+}
+
+// sortValue of the object for the clause. Ints and numbers are float64 after
+// the roundtrip through json, dates are parsed so they compare correctly
+// across time zones.
+func sortValue(obj *storageObject, clause filters.Sort) (interface{}, bool) {
+	switch clause.Property() {
+	case filters.SortPropertyCreated:
+		return float64(obj.Created), true
+	case filters.SortPropertyUpdated:
+		return float64(obj.Updated), true
+	}
+
+	value, ok := obj.Properties[clause.Property()]
+	if !ok || value == nil {
+		return nil, false
+	}
+
+	if str, ok := value.(string); ok {
+		if date, err := time.Parse(time.RFC3339, str); err == nil {
+			return date, true
+		}
+	}
+
+	return value, true
+}
+
+// compareSortValues returns -1, 0 or 1. Values of different types can only
+// occur if the same property has a different type in different classes, they
+// are ordered by their type, so that values of the same type stay together.
+func compareSortValues(a, b interface{}) int {
+	switch typedA := a.(type) {
+	case float64:
+		if typedB, ok := b.(float64); ok {
+			return compareFloat64(typedA, typedB)
+		}
+	case string:
+		if typedB, ok := b.(string); ok {
+			return compareString(typedA, typedB)
+		}
+	case bool:
+		if typedB, ok := b.(bool); ok {
+			return compareBool(typedA, typedB)
+		}
+	case time.Time:
+		if typedB, ok := b.(time.Time); ok {
+			return compareTime(typedA, typedB)
+		}
+	}
+
+	return compareFloat64(float64(sortTypeRank(a)), float64(sortTypeRank(b)))
+}
+
+func sortTypeRank(v interface{}) int {
+	switch v.(type) {
+	case float64:
+		return 0
+	case time.Time:
+		return 1
+	case string:
+		return 2
+	case bool:
+		return 3
+	default:
+		return 4
+	}
+}
+
+func compareFloat64(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func compareString(a, b string) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func compareBool(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case !a:
+		return -1
+	default:
+		return 1
+	}
+}
+
+func compareTime(a, b time.Time) int {
+	switch {
+	case a.Before(b):
+		return -1
+	case a.After(b):
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,173 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+)
+
+// storageObject is the on-disk representation of a single thing or action.
+// Properties are stored in the same untyped form they would have after a
+// roundtrip through json, which means numbers are float64, dates are strings,
+// geo coordinates are {lat, lon} maps and references are slices of beacon
+// maps.
+type storageObject struct {
+	Kind          kind.Kind              `json:"kind"`
+	ID            strfmt.UUID            `json:"id"`
+	ClassName     string                 `json:"className"`
+	Properties    map[string]interface{} `json:"properties"`
+	Meta          *models.ObjectMeta     `json:"meta,omitempty"`
+	VectorWeights map[string]string      `json:"vectorWeights,omitempty"`
+	Vector        []float32              `json:"vector"`
+	Created       int64                  `json:"created"`
+	Updated       int64                  `json:"updated"`
+}
+
+func newStorageObject(k kind.Kind, id strfmt.UUID, className string,
+	props models.PropertySchema, meta *models.ObjectMeta,
+	vectorWeights interface{}, vector []float32,
+	created, updated int64) (*storageObject, error) {
+	normalized, err := normalizeProperties(props)
+	if err != nil {
+		return nil, err
+	}
+
+	var weights map[string]string
+	if vectorWeights != nil {
+		weights = vectorWeights.(map[string]string)
+	}
+
+	if meta != nil {
+		// the vector is stored separately, we don't need a second copy of it
+		metaCopy := *meta
+		metaCopy.Vector = nil
+		meta = &metaCopy
+	}
+
+	return &storageObject{
+		Kind:          k,
+		ID:            id,
+		ClassName:     className,
+		Properties:    normalized,
+		Meta:          meta,
+		VectorWeights: weights,
+		Vector:        vector,
+		Created:       created,
+		Updated:       updated,
+	}, nil
+}
+
+// normalizeProperties brings the properties into the form they will have
+// after being unmarshalled from disk, so that the inverted index can be built
+// from the exact same values that are later used for filtering
+func normalizeProperties(props models.PropertySchema) (map[string]interface{}, error) {
+	if props == nil {
+		return map[string]interface{}{}, nil
+	}
+
+	propsMap, ok := props.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected properties to be a map, got %T", props)
+	}
+
+	converted := make(map[string]interface{}, len(propsMap))
+	for key, value := range propsMap {
+		if gc, ok := value.(*models.GeoCoordinates); ok {
+			value = map[string]interface{}{
+				"lat": gc.Latitude,
+				"lon": gc.Longitude,
+			}
+		}
+
+		converted[key] = value
+	}
+
+	bytes, err := json.Marshal(converted)
+	if err != nil {
+		return nil, fmt.Errorf("normalize properties: %v", err)
+	}
+
+	var out map[string]interface{}
+	if err := json.Unmarshal(bytes, &out); err != nil {
+		return nil, fmt.Errorf("normalize properties: %v", err)
+	}
+
+	return out, nil
+}
+
+func (s *storageObject) MarshalBinary() ([]byte, error) {
+	return json.Marshal(s)
+}
+
+func (s *storageObject) UnmarshalBinary(data []byte) error {
+	return json.Unmarshal(data, s)
+}
+
+func parseStorageObject(data []byte) (*storageObject, error) {
+	var obj storageObject
+	if err := obj.UnmarshalBinary(data); err != nil {
+		return nil, fmt.Errorf("unmarshal storage object: %v", err)
+	}
+
+	if obj.Properties == nil {
+		obj.Properties = map[string]interface{}{}
+	}
+
+	return &obj, nil
+}
+
+// beacons of a reference property, invalid entries are silently skipped
+func (s *storageObject) beacons(prop string) []string {
+	refs, ok := s.Properties[prop].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	out := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		refMap, ok := ref.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		beacon, ok := refMap["beacon"].(string)
+		if !ok {
+			continue
+		}
+
+		out = append(out, beacon)
+	}
+
+	return out
+}
+
+// searchResult without any schema, it is up to the caller to set the schema
+// with the desired (resolved) properties
+func (s *storageObject) searchResult() search.Result {
+	return search.Result{
+		ID:            s.ID,
+		Kind:          s.Kind,
+		ClassName:     s.ClassName,
+		Vector:        s.Vector,
+		Created:       s.Created,
+		Updated:       s.Updated,
+		VectorWeights: s.VectorWeights,
+	}
+}
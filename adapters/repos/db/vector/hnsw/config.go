@@ -0,0 +1,60 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package hnsw
+
+const (
+	// DefaultMaxConnections is used if no value is specified
+	DefaultMaxConnections = 64
+
+	// DefaultEFConstruction is used if no value is specified
+	DefaultEFConstruction = 128
+
+	// DefaultEF is used if no value is specified
+	DefaultEF = 100
+)
+
+// DistanceFunc calculates the distance between two vectors, it must error
+// if the vectors cannot be compared, e.g. because of different lengths
+type DistanceFunc func(a, b []float32) (float32, error)
+
+// Config for a single index, zero values are replaced with the defaults
+type Config struct {
+	// MaxConnections per node and layer, the lowest layer allows for twice as
+	// many connections
+	MaxConnections int
+
+	// EFConstruction is the size of the dynamic candidate list on insertion
+	EFConstruction int
+
+	// EF is the size of the dynamic candidate list on search, it is never
+	// smaller than the number of requested results
+	EF int
+
+	// Distance between two vectors
+	Distance DistanceFunc
+}
+
+func (c *Config) setDefaults() {
+	if c.MaxConnections <= 0 {
+		c.MaxConnections = DefaultMaxConnections
+	}
+
+	if c.EFConstruction <= 0 {
+		c.EFConstruction = DefaultEFConstruction
+	}
+
+	if c.EF <= 0 {
+		c.EF = DefaultEF
+	}
+}
@@ -0,0 +1,440 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Package hnsw contains an in-memory approximate nearest neighbor index
+// based on Hierarchical Navigable Small World graphs, see
+// https://arxiv.org/abs/1603.09320
+package hnsw
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+)
+
+// flatSearchCutoff is the size of an allow list up to which a brute-force
+// search over the allowed nodes is cheaper than a (filtered) graph search
+const flatSearchCutoff = 1000
+
+// AllowList restricts a search to the contained ids. A nil AllowList allows
+// all ids.
+type AllowList map[uint64]struct{}
+
+// Contains is true if the list is nil or contains the id
+func (a AllowList) Contains(id uint64) bool {
+	if a == nil {
+		return true
+	}
+
+	_, ok := a[id]
+	return ok
+}
+
+type node struct {
+	id          uint64
+	vector      []float32
+	level       int
+	connections [][]uint64
+}
+
+// Index is safe to be used concurrently. Deleted nodes are tombstoned, they
+// still help with navigating the graph, but are never part of a result. Once
+// the majority of nodes is tombstoned, the graph is rebuilt.
+type Index struct {
+	sync.RWMutex
+	config          Config
+	levelNormalizer float64
+	rand            *rand.Rand
+
+	nodes        map[uint64]*node
+	tombstones   map[uint64]struct{}
+	entrypoint   *node
+	maxLevel     int
+	vectorLength int
+}
+
+// New creates an empty index
+func New(config Config) *Index {
+	config.setDefaults()
+	return &Index{
+		config:          config,
+		levelNormalizer: 1 / math.Log(float64(config.MaxConnections)),
+		rand:            rand.New(rand.NewSource(time.Now().UnixNano())),
+		nodes:           map[uint64]*node{},
+		tombstones:      map[uint64]struct{}{},
+	}
+}
+
+// Len is the number of nodes which are not tombstoned
+func (i *Index) Len() int {
+	i.RLock()
+	defer i.RUnlock()
+
+	return len(i.nodes) - len(i.tombstones)
+}
+
+// Add a new node to the index. The id must not be in use already, not even
+// by a deleted node.
+func (i *Index) Add(id uint64, vector []float32) error {
+	i.Lock()
+	defer i.Unlock()
+
+	if _, ok := i.nodes[id]; ok {
+		return fmt.Errorf("add node %d: id already in use", id)
+	}
+
+	if i.entrypoint != nil && len(vector) != i.vectorLength {
+		return fmt.Errorf("add node %d: vector has length %d, but existing nodes "+
+			"have length %d", id, len(vector), i.vectorLength)
+	}
+
+	return i.insert(&node{id: id, vector: vector, level: i.randomLevel()})
+}
+
+// VectorLength is the length all vectors in the index share, 0 if the index
+// is empty
+func (i *Index) VectorLength() int {
+	i.RLock()
+	defer i.RUnlock()
+
+	return i.vectorLength
+}
+
+func (i *Index) randomLevel() int {
+	return int(math.Floor(-math.Log(1-i.rand.Float64()) * i.levelNormalizer))
+}
+
+func (i *Index) insert(n *node) error {
+	n.connections = make([][]uint64, n.level+1)
+	i.nodes[n.id] = n
+
+	if i.entrypoint == nil {
+		i.entrypoint = n
+		i.maxLevel = n.level
+		i.vectorLength = len(n.vector)
+		return nil
+	}
+
+	entrypoints, err := i.descend(n.vector, n.level)
+	if err != nil {
+		return err
+	}
+
+	for level := min(n.level, i.maxLevel); level >= 0; level-- {
+		candidates, err := i.searchLayer(n.vector, entrypoints, i.config.EFConstruction,
+			level, nil, false)
+		if err != nil {
+			return err
+		}
+
+		neighbors := closest(candidates, i.maxConnections(level))
+		n.connections[level] = ids(neighbors)
+
+		for _, neighbor := range neighbors {
+			if err := i.connect(i.nodes[neighbor.id], n, level); err != nil {
+				return err
+			}
+		}
+
+		entrypoints = candidates
+	}
+
+	if n.level > i.maxLevel {
+		i.entrypoint = n
+		i.maxLevel = n.level
+	}
+
+	return nil
+}
+
+// connect adds an edge from -> to on the specified level. If this leads to
+// more connections than allowed, only the closest are kept.
+func (i *Index) connect(from, to *node, level int) error {
+	from.connections[level] = append(from.connections[level], to.id)
+
+	max := i.maxConnections(level)
+	if len(from.connections[level]) <= max {
+		return nil
+	}
+
+	candidates := make([]item, len(from.connections[level]))
+	for pos, id := range from.connections[level] {
+		dist, err := i.config.Distance(from.vector, i.nodes[id].vector)
+		if err != nil {
+			return err
+		}
+
+		candidates[pos] = item{id: id, dist: dist}
+	}
+
+	from.connections[level] = ids(closest(candidates, max))
+	return nil
+}
+
+func (i *Index) maxConnections(level int) int {
+	if level == 0 {
+		return 2 * i.config.MaxConnections
+	}
+
+	return i.config.MaxConnections
+}
+
+// descend greedily from the entrypoint through all layers above the target
+// level and returns the closest node found as the entrypoint for the target
+// level
+func (i *Index) descend(vector []float32, targetLevel int) ([]item, error) {
+	dist, err := i.config.Distance(vector, i.entrypoint.vector)
+	if err != nil {
+		return nil, err
+	}
+
+	entrypoints := []item{{id: i.entrypoint.id, dist: dist}}
+	for level := i.maxLevel; level > targetLevel; level-- {
+		entrypoints, err = i.searchLayer(vector, entrypoints, 1, level, nil, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return entrypoints, nil
+}
+
+// searchLayer returns up to ef nodes closest to the vector on the specified
+// level. All nodes are used for navigation, but only those contained in the
+// allow list and (if excludeTombstones is set) not deleted are returned.
+func (i *Index) searchLayer(vector []float32, entrypoints []item, ef int,
+	level int, allow AllowList, excludeTombstones bool) ([]item, error) {
+	isResult := func(id uint64) bool {
+		if !allow.Contains(id) {
+			return false
+		}
+
+		if excludeTombstones {
+			if _, deleted := i.tombstones[id]; deleted {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	visited := map[uint64]struct{}{}
+	candidates := newMinQueue()
+	results := newMaxQueue()
+
+	for _, ep := range entrypoints {
+		visited[ep.id] = struct{}{}
+		candidates.push(ep.id, ep.dist)
+		if isResult(ep.id) {
+			results.push(ep.id, ep.dist)
+		}
+	}
+
+	for candidates.Len() > 0 {
+		candidate := candidates.pop()
+		if results.Len() >= ef && candidate.dist > results.top().dist {
+			break
+		}
+
+		n := i.nodes[candidate.id]
+		if level >= len(n.connections) {
+			continue
+		}
+
+		for _, id := range n.connections[level] {
+			if _, ok := visited[id]; ok {
+				continue
+			}
+			visited[id] = struct{}{}
+
+			dist, err := i.config.Distance(vector, i.nodes[id].vector)
+			if err != nil {
+				return nil, err
+			}
+
+			if results.Len() < ef || dist < results.top().dist {
+				candidates.push(id, dist)
+
+				if isResult(id) {
+					results.push(id, dist)
+					if results.Len() > ef {
+						results.pop()
+					}
+				}
+			}
+		}
+	}
+
+	return results.items, nil
+}
+
+// SearchByVector returns the ids and distances of the k nodes closest to the
+// vector in ascending order. If an allow list is set, only its ids are
+// considered.
+func (i *Index) SearchByVector(vector []float32, k int,
+	allow AllowList) ([]uint64, []float32, error) {
+	i.RLock()
+	defer i.RUnlock()
+
+	if i.entrypoint == nil || k <= 0 {
+		return nil, nil, nil
+	}
+
+	if len(vector) != i.vectorLength {
+		return nil, nil, fmt.Errorf("search: vector has length %d, but indexed "+
+			"vectors have length %d", len(vector), i.vectorLength)
+	}
+
+	var results []item
+	var err error
+	if allow != nil && len(allow) <= flatSearchCutoff {
+		results, err = i.flatSearch(vector, allow)
+	} else {
+		results, err = i.graphSearch(vector, k, allow)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("search: %v", err)
+	}
+
+	results = closest(results, k)
+	dists := make([]float32, len(results))
+	for pos, res := range results {
+		dists[pos] = res.dist
+	}
+
+	return ids(results), dists, nil
+}
+
+func (i *Index) graphSearch(vector []float32, k int, allow AllowList) ([]item, error) {
+	entrypoints, err := i.descend(vector, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	ef := i.config.EF
+	if k > ef {
+		ef = k
+	}
+
+	return i.searchLayer(vector, entrypoints, ef, 0, allow, true)
+}
+
+func (i *Index) flatSearch(vector []float32, allow AllowList) ([]item, error) {
+	results := make([]item, 0, len(allow))
+	for id := range allow {
+		n, ok := i.nodes[id]
+		if !ok {
+			continue
+		}
+
+		if _, deleted := i.tombstones[id]; deleted {
+			continue
+		}
+
+		dist, err := i.config.Distance(vector, n.vector)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, item{id: id, dist: dist})
+	}
+
+	return results, nil
+}
+
+// Delete tombstones the node. Deleting an id which is not part of the index
+// has no effect.
+func (i *Index) Delete(id uint64) error {
+	i.Lock()
+	defer i.Unlock()
+
+	if _, ok := i.nodes[id]; !ok {
+		return nil
+	}
+
+	i.tombstones[id] = struct{}{}
+	if len(i.tombstones)*2 <= len(i.nodes) {
+		return nil
+	}
+
+	return i.rebuild()
+}
+
+// rebuild creates a fresh graph from all nodes which are not tombstoned
+func (i *Index) rebuild() error {
+	live := make([]*node, 0, len(i.nodes)-len(i.tombstones))
+	for id, n := range i.nodes {
+		if _, deleted := i.tombstones[id]; deleted {
+			continue
+		}
+
+		live = append(live, n)
+	}
+
+	sort.Slice(live, func(a, b int) bool {
+		return live[a].id < live[b].id
+	})
+
+	i.nodes = map[uint64]*node{}
+	i.tombstones = map[uint64]struct{}{}
+	i.entrypoint = nil
+	i.maxLevel = 0
+	i.vectorLength = 0
+
+	for _, n := range live {
+		if err := i.insert(&node{id: n.id, vector: n.vector, level: n.level}); err != nil {
+			return fmt.Errorf("rebuild: node %d: %v", n.id, err)
+		}
+	}
+
+	return nil
+}
+
+// closest returns up to n items with the lowest distance in ascending order
+func closest(in []item, n int) []item {
+	out := make([]item, len(in))
+	copy(out, in)
+	sort.Slice(out, func(a, b int) bool {
+		if out[a].dist != out[b].dist {
+			return out[a].dist < out[b].dist
+		}
+
+		return out[a].id < out[b].id
+	})
+
+	if len(out) > n {
+		out = out[:n]
+	}
+
+	return out
+}
+
+func ids(in []item) []uint64 {
+	out := make([]uint64, len(in))
+	for pos, item := range in {
+		out[pos] = item.id
+	}
+
+	return out
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
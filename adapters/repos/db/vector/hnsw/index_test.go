@@ -0,0 +1,186 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package hnsw
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/usecases/vectorizer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndex(t *testing.T) {
+	index := New(Config{
+		MaxConnections: 4,
+		EFConstruction: 16,
+		Distance:       vectorizer.NormalizedDistance,
+	})
+
+	vectors := [][]float32{
+		{1, 0, 0},
+		{0.9, 0.1, 0},
+		{0, 1, 0},
+		{0, 0.9, 0.1},
+		{0, 0, 1},
+	}
+
+	for id, vector := range vectors {
+		require.Nil(t, index.Add(uint64(id), vector))
+	}
+
+	t.Run("searching the closest nodes", func(t *testing.T) {
+		ids, dists, err := index.SearchByVector([]float32{1, 0, 0}, 2, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{0, 1}, ids)
+		assert.InDelta(t, 0, dists[0], 0.0001)
+		assert.True(t, dists[0] < dists[1])
+	})
+
+	t.Run("searching with an allow list", func(t *testing.T) {
+		ids, _, err := index.SearchByVector([]float32{1, 0, 0}, 2, AllowList{2: {}, 4: {}})
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []uint64{2, 4}, ids)
+	})
+
+	t.Run("adding an id which is already in use", func(t *testing.T) {
+		err := index.Add(0, []float32{1, 0, 0})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("adding a vector of a different length", func(t *testing.T) {
+		err := index.Add(7, []float32{1, 0})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("deleted nodes are never returned", func(t *testing.T) {
+		require.Nil(t, index.Delete(0))
+
+		ids, _, err := index.SearchByVector([]float32{1, 0, 0}, 1, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{1}, ids)
+		assert.Equal(t, 4, index.Len())
+	})
+
+	t.Run("deleting the majority of nodes", func(t *testing.T) {
+		require.Nil(t, index.Delete(1))
+		require.Nil(t, index.Delete(2))
+
+		ids, _, err := index.SearchByVector([]float32{1, 0, 0}, 5, nil)
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []uint64{3, 4}, ids)
+		assert.Equal(t, 2, index.Len())
+	})
+}
+
+func TestIndexRecall(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	dims := 16
+	size := 2000
+	k := 10
+
+	vectors := make([][]float32, size)
+	for i := range vectors {
+		vectors[i] = randomVector(r, dims)
+	}
+
+	index := New(Config{Distance: vectorizer.NormalizedDistance})
+	for id, vector := range vectors {
+		require.Nil(t, index.Add(uint64(id), vector))
+	}
+
+	// restricting the search to the even ids still leads to a graph search,
+	// as the allow list is larger than the cutoff for a flat search
+	evenIDs := AllowList{}
+	for id := 0; id < size; id += 2 {
+		evenIDs[uint64(id)] = struct{}{}
+	}
+
+	tests := []struct {
+		name  string
+		allow AllowList
+	}{
+		{name: "unfiltered", allow: nil},
+		{name: "filtered", allow: evenIDs},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var found, total int
+			for q := 0; q < 50; q++ {
+				query := randomVector(r, dims)
+				expected := bruteForce(t, vectors, query, k, test.allow)
+				actual, _, err := index.SearchByVector(query, k, test.allow)
+				require.Nil(t, err)
+
+				for _, id := range actual {
+					assert.True(t, test.allow.Contains(id))
+				}
+
+				found += overlap(expected, actual)
+				total += k
+			}
+
+			recall := float64(found) / float64(total)
+			assert.True(t, recall > 0.9, "recall should be above 0.9, got %f", recall)
+		})
+	}
+}
+
+func randomVector(r *rand.Rand, dims int) []float32 {
+	out := make([]float32, dims)
+	for i := range out {
+		out[i] = r.Float32() - 0.5
+	}
+
+	return out
+}
+
+func bruteForce(t *testing.T, vectors [][]float32, query []float32, k int,
+	allow AllowList) []uint64 {
+	var items []item
+	for id, vector := range vectors {
+		if !allow.Contains(uint64(id)) {
+			continue
+		}
+
+		dist, err := vectorizer.NormalizedDistance(query, vector)
+		require.Nil(t, err)
+		items = append(items, item{id: uint64(id), dist: dist})
+	}
+
+	sort.Slice(items, func(a, b int) bool {
+		return items[a].dist < items[b].dist
+	})
+
+	return ids(items[:k])
+}
+
+func overlap(a, b []uint64) int {
+	inA := map[uint64]struct{}{}
+	for _, id := range a {
+		inA[id] = struct{}{}
+	}
+
+	count := 0
+	for _, id := range b {
+		if _, ok := inA[id]; ok {
+			count++
+		}
+	}
+
+	return count
+}
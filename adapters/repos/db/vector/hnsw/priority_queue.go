@@ -0,0 +1,62 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package hnsw
+
+import "container/heap"
+
+type item struct {
+	id   uint64
+	dist float32
+}
+
+// queue is a priority queue of items, depending on the less func it is
+// either a min or a max heap. Use the constructors below.
+type queue struct {
+	items []item
+	less  func(a, b float32) bool
+}
+
+func newMinQueue() *queue {
+	return &queue{less: func(a, b float32) bool { return a < b }}
+}
+
+func newMaxQueue() *queue {
+	return &queue{less: func(a, b float32) bool { return a > b }}
+}
+
+func (q *queue) Len() int           { return len(q.items) }
+func (q *queue) Less(i, j int) bool { return q.less(q.items[i].dist, q.items[j].dist) }
+func (q *queue) Swap(i, j int)      { q.items[i], q.items[j] = q.items[j], q.items[i] }
+
+func (q *queue) Push(x interface{}) {
+	q.items = append(q.items, x.(item))
+}
+
+func (q *queue) Pop() interface{} {
+	last := q.items[len(q.items)-1]
+	q.items = q.items[:len(q.items)-1]
+	return last
+}
+
+func (q *queue) push(id uint64, dist float32) {
+	heap.Push(q, item{id: id, dist: dist})
+}
+
+func (q *queue) pop() item {
+	return heap.Pop(q).(item)
+}
+
+func (q *queue) top() item {
+	return q.items[0]
+}
@@ -0,0 +1,374 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/vectorizer"
+	bolt "go.etcd.io/bbolt"
+)
+
+// vectorIndex wraps an hnsw index of a single class. The hnsw index works
+// on numerical ids, so the mapping to and from uuids is kept alongside.
+//
+// Updates are applied after their transaction has been committed, so
+// updates of concurrent transactions could arrive out of order. The id of
+// the transaction which last touched an object is kept, so that outdated
+// updates can be ignored. Only transactions which committed around the same
+// time can arrive out of order, so versions are pruned once they fall
+// behind the latest version by more than versionRetention.
+type vectorIndex struct {
+	sync.Mutex
+	hnsw     *hnsw.Index
+	ids      map[strfmt.UUID]uint64
+	uuids    map[uint64]strfmt.UUID
+	versions map[strfmt.UUID]int
+	latest   int
+	prunedAt int
+	nextID   uint64
+}
+
+// versionRetention is the number of transactions for which the version of an
+// object is kept after it was last touched
+const versionRetention = 1000
+
+func newVectorIndex(config hnsw.Config) *vectorIndex {
+	return &vectorIndex{
+		hnsw:     hnsw.New(config),
+		ids:      map[strfmt.UUID]uint64{},
+		uuids:    map[uint64]strfmt.UUID{},
+		versions: map[strfmt.UUID]int{},
+	}
+}
+
+// put adds the vector or replaces the previous vector of the same object.
+// Objects without a vector are removed from the index.
+func (v *vectorIndex) put(id strfmt.UUID, vector []float32, version int) error {
+	v.Lock()
+	defer v.Unlock()
+
+	if !v.isNewer(id, version) {
+		return nil
+	}
+
+	if len(vector) == 0 {
+		if err := v.delete(id); err != nil {
+			return err
+		}
+
+		v.setVersion(id, version)
+		return nil
+	}
+
+	docID := v.nextID
+	v.nextID++
+
+	// the previous vector is only replaced once the new one was added, so
+	// that a failed add doesn't remove the object from the index
+	if err := v.hnsw.Add(docID, vector); err != nil {
+		return err
+	}
+
+	if err := v.delete(id); err != nil {
+		return err
+	}
+
+	v.ids[id] = docID
+	v.uuids[docID] = id
+	v.setVersion(id, version)
+	return nil
+}
+
+func (v *vectorIndex) remove(id strfmt.UUID, version int) error {
+	v.Lock()
+	defer v.Unlock()
+
+	if !v.isNewer(id, version) {
+		return nil
+	}
+
+	if err := v.delete(id); err != nil {
+		return err
+	}
+
+	v.setVersion(id, version)
+	return nil
+}
+
+// isNewer is true if the version is not older than the last one seen for the
+// object. It must be called with the lock held.
+func (v *vectorIndex) isNewer(id strfmt.UUID, version int) bool {
+	return version >= v.versions[id]
+}
+
+// setVersion records the version of an applied update and prunes versions
+// which can no longer be superseded by an outdated update. It must be called
+// with the lock held.
+func (v *vectorIndex) setVersion(id strfmt.UUID, version int) {
+	v.versions[id] = version
+	if version > v.latest {
+		v.latest = version
+	}
+
+	if v.latest-v.prunedAt < versionRetention {
+		return
+	}
+
+	for id, version := range v.versions {
+		if version < v.latest-versionRetention {
+			delete(v.versions, id)
+		}
+	}
+	v.prunedAt = v.latest
+}
+
+// delete must be called with the lock held
+func (v *vectorIndex) delete(id strfmt.UUID) error {
+	docID, ok := v.ids[id]
+	if !ok {
+		return nil
+	}
+
+	delete(v.ids, id)
+	delete(v.uuids, docID)
+	return v.hnsw.Delete(docID)
+}
+
+// checkVector errors if the vector cannot be part of the index, because its
+// length does not match the existing vectors
+func (v *vectorIndex) checkVector(vector []float32) error {
+	length := v.hnsw.VectorLength()
+	if len(vector) == 0 || length == 0 || length == len(vector) {
+		return nil
+	}
+
+	return fmt.Errorf("vector has length %d, but existing vectors of the class "+
+		"have length %d", len(vector), length)
+}
+
+// search returns up to k ids closest to the vector. If allow is non-nil the
+// results are restricted to the contained ids.
+func (v *vectorIndex) search(vector []float32, k int,
+	allow map[strfmt.UUID]struct{}) ([]strfmt.UUID, []float32, error) {
+	v.Lock()
+	var allowList hnsw.AllowList
+	if allow != nil {
+		allowList = make(hnsw.AllowList, len(allow))
+		for id := range allow {
+			if docID, ok := v.ids[id]; ok {
+				allowList[docID] = struct{}{}
+			}
+		}
+	}
+	v.Unlock()
+
+	if k < 0 {
+		k = v.hnsw.Len()
+	}
+
+	docIDs, dists, err := v.hnsw.SearchByVector(vector, k, allowList)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v.Lock()
+	defer v.Unlock()
+
+	ids := make([]strfmt.UUID, 0, len(docIDs))
+	outDists := make([]float32, 0, len(docIDs))
+	for i, docID := range docIDs {
+		id, ok := v.uuids[docID]
+		if !ok {
+			// replaced or deleted in the meantime
+			continue
+		}
+
+		ids = append(ids, id)
+		outDists = append(outDists, dists[i])
+	}
+
+	return ids, outDists, nil
+}
+
+// vectorIndexes holds one index per kind and class. An index is built from
+// disk on first use and kept up to date on every committed write from then
+// on.
+type vectorIndexes struct {
+	sync.Mutex
+	indexes map[string]*vectorIndex
+}
+
+func newVectorIndexes() *vectorIndexes {
+	return &vectorIndexes{indexes: map[string]*vectorIndex{}}
+}
+
+func (v *vectorIndexes) get(k kind.Kind, className string) *vectorIndex {
+	v.Lock()
+	defer v.Unlock()
+
+	return v.indexes[string(classBucketName(k, className))]
+}
+
+func (v *vectorIndexes) set(k kind.Kind, className string, index *vectorIndex) {
+	v.Lock()
+	defer v.Unlock()
+
+	if index == nil {
+		delete(v.indexes, string(classBucketName(k, className)))
+		return
+	}
+
+	v.indexes[string(classBucketName(k, className))] = index
+}
+
+// vectorIndex of the class, it is built if it doesn't exist yet. The index
+// must not be requested from within a transaction.
+func (d *DB) vectorIndex(k kind.Kind, className string) (*vectorIndex, error) {
+	if index := d.vectors.get(k, className); index != nil {
+		return index, nil
+	}
+
+	var index *vectorIndex
+	// an update tx is used for the sole purpose of excluding concurrent
+	// writes while the index is built, so that no write can be missed
+	err := d.db.Update(func(tx *bolt.Tx) error {
+		if index = d.vectors.get(k, className); index != nil {
+			// built by someone else in the meantime
+			return nil
+		}
+
+		index = newVectorIndex(d.vectorIndexConfig(className))
+		classBucket := tx.Bucket(ClassesBucket).Bucket(classBucketName(k, className))
+		if classBucket != nil {
+			err := d.forEachID(tx, classBucket, func(obj *storageObject) (bool, error) {
+				if err := index.put(obj.ID, obj.Vector, tx.ID()); err != nil {
+					d.logger.WithField("action", "db_build_vector_index").
+						WithField("class", className).
+						WithField("id", obj.ID).
+						WithError(err).
+						Warn("object cannot be part of the vector index")
+				}
+				return true, nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		d.vectors.set(k, className, index)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("build vector index for %s %s: %v", k.Name(), className, err)
+	}
+
+	return index, nil
+}
+
+func (d *DB) vectorIndexConfig(className string) hnsw.Config {
+	var classConfig *models.VectorIndexConfig
+	if d.schemaGetter != nil {
+		s := d.schemaGetter.GetSchemaSkipAuth()
+		if class := s.FindClassByName(schema.ClassName(className)); class != nil {
+			classConfig = class.VectorIndexConfig
+		}
+	}
+
+	config := hnsw.Config{Distance: vectorizer.NormalizedDistance}
+	if classConfig != nil {
+		config.MaxConnections = int(classConfig.MaxConnections)
+		config.EFConstruction = int(classConfig.EfConstruction)
+		config.EF = int(classConfig.Ef)
+	}
+
+	return config
+}
+
+// updateVectorIndexOnCommit makes sure the (already built) vector index
+// reflects the new state of the object once the transaction is committed.
+// If the previous version already had the same vector in the same class
+// nothing needs to be done.
+func (d *DB) updateVectorIndexOnCommit(tx *bolt.Tx, previous,
+	obj *storageObject) error {
+	if previous != nil && (previous.Kind != obj.Kind || previous.ClassName != obj.ClassName) {
+		d.deleteFromVectorIndexOnCommit(tx, previous)
+		previous = nil
+	}
+
+	if previous != nil && vectorsEqual(previous.Vector, obj.Vector) {
+		return nil
+	}
+
+	index := d.vectors.get(obj.Kind, obj.ClassName)
+	if index == nil {
+		// the index will be built from disk on first use
+		return nil
+	}
+
+	if err := index.checkVector(obj.Vector); err != nil {
+		return err
+	}
+
+	version := tx.ID()
+	tx.OnCommit(func() {
+		if err := index.put(obj.ID, obj.Vector, version); err != nil {
+			d.logger.WithField("action", "db_update_vector_index").
+				WithField("class", obj.ClassName).
+				WithField("id", obj.ID).
+				WithError(err).
+				Error("could not update vector index")
+		}
+	})
+
+	return nil
+}
+
+func (d *DB) deleteFromVectorIndexOnCommit(tx *bolt.Tx, obj *storageObject) {
+	index := d.vectors.get(obj.Kind, obj.ClassName)
+	if index == nil {
+		return
+	}
+
+	version := tx.ID()
+	tx.OnCommit(func() {
+		if err := index.remove(obj.ID, version); err != nil {
+			d.logger.WithField("action", "db_update_vector_index").
+				WithField("class", obj.ClassName).
+				WithField("id", obj.ID).
+				WithError(err).
+				Error("could not delete from vector index")
+		}
+	})
+}
+
+func vectorsEqual(a, b []float32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
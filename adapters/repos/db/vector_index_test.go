@@ -0,0 +1,62 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/usecases/vectorizer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVectorIndexVersions(t *testing.T) {
+	id := strfmt.UUID("8b0d0ba8-ef66-4a8d-a9b6-87d6f2a1c3a1")
+	newIndex := func() *vectorIndex {
+		return newVectorIndex(hnsw.Config{Distance: vectorizer.NormalizedDistance})
+	}
+
+	t.Run("an outdated update is ignored", func(t *testing.T) {
+		index := newIndex()
+		require.Nil(t, index.put(id, []float32{1, 0}, 2))
+		require.Nil(t, index.remove(id, 1))
+
+		ids, _, err := index.search([]float32{1, 0}, 1, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []strfmt.UUID{id}, ids)
+	})
+
+	t.Run("replacing a vector keeps a single mapping", func(t *testing.T) {
+		index := newIndex()
+		require.Nil(t, index.put(id, []float32{1, 0}, 1))
+		require.Nil(t, index.put(id, []float32{0, 1}, 2))
+
+		assert.Len(t, index.ids, 1)
+		assert.Len(t, index.uuids, 1)
+	})
+
+	t.Run("versions are pruned once they can't be superseded", func(t *testing.T) {
+		index := newIndex()
+		require.Nil(t, index.put(id, []float32{1, 0}, 1))
+		require.Nil(t, index.remove(id, 2))
+		require.Nil(t, index.put("8b0d0ba8-ef66-4a8d-a9b6-87d6f2a1c3a2",
+			[]float32{0, 1}, 3+versionRetention))
+
+		_, ok := index.versions[id]
+		assert.False(t, ok)
+		assert.Len(t, index.versions, 1)
+	})
+}
@@ -87,9 +87,13 @@ func (r *Repo) AddReference(ctx context.Context, k kind.Kind, source strfmt.UUID
 		return fmt.Errorf("index request: encode json: %v", err)
 	}
 
+	index := classIndexFromClassName(k, className)
+	done := r.reindexes.write()
+	defer done(indexedDoc{index: index, id: source.String()})
+
 	retries := 3
 	req := esapi.UpdateRequest{
-		Index:           classIndexFromClassName(k, className),
+		Index:           index,
 		DocumentID:      source.String(),
 		RetryOnConflict: &retries,
 		Body:            &buf,
@@ -62,6 +62,9 @@ func (r *Repo) BatchPutActions(ctx context.Context, batch kinds.BatchActions) (k
 		return batch, nil
 	}
 
+	done := r.reindexes.write()
+	defer done(batchActionDocs(batch)...)
+
 	req := esapi.BulkRequest{
 		Body: &buf,
 	}
@@ -91,7 +94,7 @@ func (r Repo) encodeBatchActions(enc *json.Encoder, batch kinds.BatchActions) er
 			vectorWeights = a.VectorWeights.(map[string]string)
 		}
 		bucket := r.objectBucket(kind.Action, a.ID.String(), a.Class, a.Schema,
-			nil, vectorWeights, single.Vector, a.CreationTimeUnix, a.LastUpdateTimeUnix)
+			a.Meta, vectorWeights, single.Vector, a.CreationTimeUnix, a.LastUpdateTimeUnix)
 
 		index := classIndexFromClassName(kind.Action, a.Class)
 		control := r.bulkIndexControlObject(index, a.ID.String())
@@ -152,6 +155,9 @@ func (r *Repo) BatchPutThings(ctx context.Context, batch kinds.BatchThings) (kin
 		return batch, nil
 	}
 
+	done := r.reindexes.write()
+	defer done(batchThingDocs(batch)...)
+
 	req := esapi.BulkRequest{
 		Body: &buf,
 	}
@@ -180,7 +186,7 @@ func (r Repo) encodeBatchThings(enc *json.Encoder, batch kinds.BatchThings) erro
 			vectorWeights = t.VectorWeights.(map[string]string)
 		}
 		bucket := r.objectBucket(kind.Thing, t.ID.String(), t.Class, t.Schema,
-			nil, vectorWeights, single.Vector, t.CreationTimeUnix, t.LastUpdateTimeUnix)
+			t.Meta, vectorWeights, single.Vector, t.CreationTimeUnix, t.LastUpdateTimeUnix)
 
 		index := classIndexFromClassName(kind.Thing, t.Class)
 		control := r.bulkIndexControlObject(index, t.ID.String())
@@ -229,6 +235,38 @@ func mergeBatchThingsWithErrors(batch kinds.BatchThings, res *esapi.Response) (k
 	return batch, nil
 }
 
+func batchActionDocs(batch kinds.BatchActions) []indexedDoc {
+	docs := make([]indexedDoc, 0, len(batch))
+	for _, single := range batch {
+		if single.Err != nil {
+			continue
+		}
+
+		docs = append(docs, indexedDoc{
+			index: classIndexFromClassName(kind.Action, single.Action.Class),
+			id:    single.Action.ID.String(),
+		})
+	}
+
+	return docs
+}
+
+func batchThingDocs(batch kinds.BatchThings) []indexedDoc {
+	docs := make([]indexedDoc, 0, len(batch))
+	for _, single := range batch {
+		if single.Err != nil {
+			continue
+		}
+
+		docs = append(docs, indexedDoc{
+			index: classIndexFromClassName(kind.Thing, single.Thing.Class),
+			id:    single.Thing.ID.String(),
+		})
+	}
+
+	return docs
+}
+
 func (r *Repo) bulkIndexControlObject(index, id string) bulkControlObject {
 	return bulkControlObject{
 		Index: &bulkID{
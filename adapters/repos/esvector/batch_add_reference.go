@@ -35,6 +35,9 @@ func (r *Repo) AddBatchReferences(ctx context.Context, list kinds.BatchReference
 		return list, nil
 	}
 
+	done := r.reindexes.write()
+	defer done(batchReferenceDocs(list)...)
+
 	req := esapi.BulkRequest{
 		Body: &buf,
 	}
@@ -75,6 +78,22 @@ func (r Repo) encodeBatchReferences(enc *json.Encoder, batch kinds.BatchReferenc
 	return nil
 }
 
+func batchReferenceDocs(batch kinds.BatchReferences) []indexedDoc {
+	docs := make([]indexedDoc, 0, len(batch))
+	for _, single := range batch {
+		if single.Err != nil {
+			continue
+		}
+
+		docs = append(docs, indexedDoc{
+			index: classIndexFromClassName(single.From.Kind, single.From.Class.String()),
+			id:    single.From.TargetID.String(),
+		})
+	}
+
+	return docs
+}
+
 func (r *Repo) bulkUpdateControlObject(index, id string) bulkControlObject {
 	return bulkControlObject{
 		Update: &bulkID{
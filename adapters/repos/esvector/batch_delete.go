@@ -0,0 +1,117 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package esvector
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/elastic/go-elasticsearch/v5/esapi"
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+)
+
+type bulkDeleteResponse struct {
+	Items []struct {
+		Delete *bulkDeleteItem `json:"delete"`
+	} `json:"items"`
+}
+
+// bulkDeleteItem is the outcome of a single delete, es doesn't consider a
+// delete of an object which doesn't exist an error, but sets the result to
+// "not_found"
+type bulkDeleteItem struct {
+	Error  interface{}
+	Result string `json:"result"`
+}
+
+func (i bulkDeleteItem) err(k kind.Kind, className string, id strfmt.UUID) error {
+	if i.Error != nil {
+		return fmt.Errorf("%v", i.Error)
+	}
+
+	if i.Result == "not_found" {
+		return fmt.Errorf("%s '%s' of class '%s' not found", k.Name(), id, className)
+	}
+
+	return nil
+}
+
+// BatchDeleteObjects deletes the objects of the class in a single bulk
+// request. Objects which don't exist (anymore), e.g. because they were deleted
+// concurrently, are marked as failed.
+func (r *Repo) BatchDeleteObjects(ctx context.Context, k kind.Kind, className string,
+	batch kinds.BatchDeleteObjects) (kinds.BatchDeleteObjects, error) {
+	index := classIndexFromClassName(k, className)
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	docs := make([]indexedDoc, 0, len(batch))
+	for _, single := range batch {
+		if single.Err != nil {
+			continue
+		}
+
+		err := enc.Encode(bulkDeleteControlObject(index, single.UUID.String()))
+		if err != nil {
+			return nil, fmt.Errorf("batch delete request: encode json: %v", err)
+		}
+		docs = append(docs, indexedDoc{index: index, id: single.UUID.String()})
+	}
+
+	if buf.Len() == 0 {
+		// es errors on an empty bulk request
+		return batch, nil
+	}
+
+	done := r.reindexes.write()
+	defer done(docs...)
+
+	req := esapi.BulkRequest{
+		Body: &buf,
+	}
+	res, err := req.Do(ctx, r.client)
+	if err != nil {
+		return nil, fmt.Errorf("batch delete request: %v", err)
+	}
+	defer res.Body.Close()
+
+	if err := errorResToErr(res, r.logger); err != nil {
+		return nil, fmt.Errorf("batch delete request: %v", err)
+	}
+
+	var parsed bulkDeleteResponse
+	if err := json.NewDecoder(res.Body).Decode(&parsed); err != nil {
+		return nil, fmt.Errorf("batch delete request: decode json: %v", err)
+	}
+
+	bulkIndex := 0
+	for i, single := range batch {
+		if single.Err != nil {
+			// was never sent off to es
+			continue
+		}
+
+		if item := parsed.Items[bulkIndex].Delete; item != nil {
+			batch[i].Err = item.err(k, className, single.UUID)
+		}
+
+		bulkIndex++
+	}
+
+	return batch, nil
+}
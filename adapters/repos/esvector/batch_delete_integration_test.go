@@ -0,0 +1,115 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package esvector
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/elastic/go-elasticsearch/v5"
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchDeleteObjects(t *testing.T) {
+	client, err := elasticsearch.NewClient(elasticsearch.Config{
+		Addresses: []string{"http://localhost:9201"},
+	})
+	require.Nil(t, err)
+
+	logger, _ := test.NewNullLogger()
+	schemaGetter := &fakeSchemaGetter{}
+	repo := NewRepo(client, logger, schemaGetter, 3, 100, 1, "0-1")
+	waitForEsToBeReady(t, repo)
+	migrator := NewMigrator(repo)
+
+	t.Run("creating the class", func(t *testing.T) {
+		class := &models.Class{
+			Class: "DeletableThing",
+			Properties: []*models.Property{
+				&models.Property{
+					Name:     "name",
+					DataType: []string{string(schema.DataTypeString)},
+				},
+			},
+		}
+
+		require.Nil(t,
+			migrator.AddClass(context.Background(), kind.Thing, class))
+	})
+
+	ids := []strfmt.UUID{
+		"0c9d3b4e-7a1f-4c55-8a0e-2f6d4b1e7c01",
+		"0c9d3b4e-7a1f-4c55-8a0e-2f6d4b1e7c02",
+		"0c9d3b4e-7a1f-4c55-8a0e-2f6d4b1e7c03",
+	}
+
+	t.Run("adding the things", func(t *testing.T) {
+		for i, id := range ids {
+			thing := &models.Thing{
+				ID:    id,
+				Class: "DeletableThing",
+				Schema: map[string]interface{}{
+					"name": fmt.Sprintf("thing %d", i),
+				},
+			}
+
+			require.Nil(t,
+				repo.PutThing(context.Background(), thing, []float32{1, 2, float32(i)}))
+		}
+
+		require.Nil(t, repo.forceRefresh(context.Background()))
+	})
+
+	t.Run("deleting the things", func(t *testing.T) {
+		// the second thing was deleted concurrently, i.e. after the objects to
+		// delete were listed, but before the batch delete
+		require.Nil(t,
+			repo.DeleteThing(context.Background(), "DeletableThing", ids[1]))
+
+		res, err := repo.BatchDeleteObjects(context.Background(), kind.Thing, "DeletableThing",
+			kinds.BatchDeleteObjects{
+				{OriginalIndex: 0, UUID: ids[0]},
+				{OriginalIndex: 1, UUID: ids[1]},
+				{OriginalIndex: 2, UUID: ids[2], Err: fmt.Errorf("restricted")},
+			})
+		require.Nil(t, err)
+		require.Len(t, res, 3)
+		assert.Nil(t, res[0].Err)
+		assert.Equal(t,
+			fmt.Errorf("thing '%s' of class 'DeletableThing' not found", ids[1]), res[1].Err)
+		assert.Equal(t, fmt.Errorf("restricted"), res[2].Err)
+	})
+
+	t.Run("only the thing which wasn't sent remains", func(t *testing.T) {
+		require.Nil(t, repo.forceRefresh(context.Background()))
+
+		page, err := repo.ClassPage(context.Background(), kind.Thing, "DeletableThing",
+			nil, &filters.Pagination{Limit: 10})
+		require.Nil(t, err)
+		require.Len(t, page, 1)
+		assert.Equal(t, ids[2], page[0].ID)
+	})
+}
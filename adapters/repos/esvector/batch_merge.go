@@ -0,0 +1,116 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package esvector
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/elastic/go-elasticsearch/v5/esapi"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+)
+
+// BatchMerge sends the merges without a previous error in a single bulk
+// request. A merge can consist of several bulk items (one for the primitive
+// props and one per reference), the errors of all of them are recorded on the
+// merge.
+func (r *Repo) BatchMerge(ctx context.Context,
+	batch kinds.BatchMergeDocuments) (kinds.BatchMergeDocuments, error) {
+	var buf bytes.Buffer
+	itemCounts := make([]int, len(batch))
+	docs := make([]indexedDoc, 0, len(batch))
+	for i, single := range batch {
+		if single.Err != nil {
+			// ignore merges that already have an error
+			continue
+		}
+
+		// encode each merge on its own, so that a failed merge can't leave
+		// partial items in the bulk request
+		var encoded bytes.Buffer
+		if err := r.encodeMerge(json.NewEncoder(&encoded), single.Merge); err != nil {
+			batch[i].Err = fmt.Errorf("encode: %v", err)
+			continue
+		}
+		buf.Write(encoded.Bytes())
+
+		itemCounts[i] = mergeItemCount(single.Merge)
+		docs = append(docs, indexedDoc{
+			index: classIndexFromClassName(single.Merge.Kind, single.Merge.Class),
+			id:    single.Merge.ID.String(),
+		})
+	}
+
+	if buf.Len() == 0 {
+		// es errors on an empty bulk request
+		return batch, nil
+	}
+
+	done := r.reindexes.write()
+	defer done(docs...)
+
+	req := esapi.BulkRequest{
+		Body: &buf,
+	}
+	res, err := req.Do(ctx, r.client)
+	if err != nil {
+		return nil, fmt.Errorf("batch merge request: %v", err)
+	}
+	defer res.Body.Close()
+
+	if err := errorResToErr(res, r.logger); err != nil {
+		return nil, fmt.Errorf("batch merge request: %v", err)
+	}
+
+	var parsed bulkIndexResponse
+	if err := json.NewDecoder(res.Body).Decode(&parsed); err != nil {
+		return nil, fmt.Errorf("batch merge request: decode json: %v", err)
+	}
+
+	if !parsed.Errors {
+		// no need to check for error positions if there are none
+		return batch, nil
+	}
+
+	bulkIndex := 0
+	for i := range batch {
+		var errors []string
+		for _, item := range parsed.Items[bulkIndex : bulkIndex+itemCounts[i]] {
+			if item.Update != nil && item.Update.Error != nil {
+				errors = append(errors, fmt.Sprintf("%v", item.Update.Error))
+			}
+		}
+
+		if len(errors) > 0 {
+			batch[i].Err = fmt.Errorf("%s", strings.Join(errors, ", "))
+		}
+
+		bulkIndex += itemCounts[i]
+	}
+
+	return batch, nil
+}
+
+// mergeItemCount is the number of bulk items encodeMerge produces
+func mergeItemCount(merge kinds.MergeDocument) int {
+	count := len(merge.References)
+	if hasPrimitiveMerge(merge) {
+		count++
+	}
+
+	return count
+}
@@ -29,9 +29,101 @@ import (
 	"github.com/semi-technologies/weaviate/usecases/vectorizer"
 )
 
+// GetUnclassified returns the objects of the class which have none of the
+// properties set and match the (optional) filter. Without pagination at most
+// 9999 objects are supported. With pagination the objects are sorted by id,
+// so that the next page can be retrieved with the last id as the cursor.
 func (r *Repo) GetUnclassified(ctx context.Context, kind kind.Kind,
-	class string, properties []string, filter *filters.LocalFilter) ([]search.Result, error) {
+	class string, properties []string, filter *filters.LocalFilter,
+	pagination *filters.Pagination) ([]search.Result, error) {
+	query, err := r.unclassifiedQuery(ctx, properties, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	body := map[string]interface{}{
+		"query": query,
+	}
+
+	if pagination == nil {
+		body["size"] = 9999
+		body["aggregations"] = map[string]interface{}{
+			"count": map[string]interface{}{
+				"value_count": map[string]interface{}{
+					"field": "_id",
+				},
+			},
+		}
+	} else {
+		body["size"] = pagination.Limit
+		body["sort"] = []interface{}{
+			map[string]interface{}{string(keyID): "asc"},
+		}
+		if pagination.After != "" {
+			body["search_after"] = []interface{}{pagination.After}
+		}
+	}
 
+	var buf bytes.Buffer
+	err = json.NewEncoder(&buf).Encode(body)
+	if err != nil {
+		return nil, fmt.Errorf("vector search: encode json: %v", err)
+	}
+	res, err := r.client.Search(
+		r.client.Search.WithContext(ctx),
+		r.client.Search.WithIndex(classIndexFromClassName(kind, class)),
+		r.client.Search.WithBody(&buf),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("vector search: %v", err)
+	}
+
+	return r.unclassifiedSearchResponse(ctx, res, nil, pagination == nil)
+}
+
+// CountUnclassified returns the number of objects GetUnclassified would
+// return across all pages
+func (r *Repo) CountUnclassified(ctx context.Context, kind kind.Kind,
+	class string, properties []string, filter *filters.LocalFilter) (int64, error) {
+	query, err := r.unclassifiedQuery(ctx, properties, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	var buf bytes.Buffer
+	err = json.NewEncoder(&buf).Encode(map[string]interface{}{
+		"query": query,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("count unclassified: encode json: %v", err)
+	}
+
+	res, err := r.client.Count(
+		r.client.Count.WithContext(ctx),
+		r.client.Count.WithIndex(classIndexFromClassName(kind, class)),
+		r.client.Count.WithBody(&buf),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("count unclassified: %v", err)
+	}
+
+	if err := errorResToErr(res, r.logger); err != nil {
+		return 0, fmt.Errorf("count unclassified: %v", err)
+	}
+
+	defer res.Body.Close()
+	var parsed struct {
+		Count int64 `json:"count"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&parsed); err != nil {
+		return 0, fmt.Errorf("count unclassified: decode json: %v", err)
+	}
+
+	return parsed.Count, nil
+}
+
+func (r *Repo) unclassifiedQuery(ctx context.Context, properties []string,
+	filter *filters.LocalFilter) (map[string]interface{}, error) {
 	mustNot := []map[string]interface{}{}
 	for _, prop := range properties {
 		mustNot = append(mustNot, map[string]interface{}{
@@ -41,49 +133,77 @@ func (r *Repo) GetUnclassified(ctx context.Context, kind kind.Kind,
 		})
 	}
 
-	var query map[string]interface{}
-
 	if filter == nil {
-		query = map[string]interface{}{
+		return map[string]interface{}{
 			"bool": map[string]interface{}{
 				"must_not": mustNot,
 			},
-		}
-	} else {
-		subquery, err := r.queryFromFilter(ctx, filter)
-		if err != nil {
-			return nil, fmt.Errorf("build filter: %v", err)
-		}
-		query = map[string]interface{}{
-			"bool": map[string]interface{}{
-				"must": []interface{}{
-					subquery,
-					map[string]interface{}{
-						"bool": map[string]interface{}{
-							"must_not": mustNot,
-						},
+		}, nil
+	}
+
+	subquery, err := r.queryFromFilter(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("build filter: %v", err)
+	}
+
+	return map[string]interface{}{
+		"bool": map[string]interface{}{
+			"must": []interface{}{
+				subquery,
+				map[string]interface{}{
+					"bool": map[string]interface{}{
+						"must_not": mustNot,
 					},
 				},
 			},
+		},
+	}, nil
+}
+
+// GetClassified returns a page of the objects of the class which have all of
+// the properties set and match the (optional) filter. The objects are sorted
+// by id, so that the next page can be retrieved with the last id as the
+// cursor.
+func (r *Repo) GetClassified(ctx context.Context, kind kind.Kind,
+	class string, properties []string, filter *filters.LocalFilter,
+	pagination *filters.Pagination) ([]search.Result, error) {
+	must := []interface{}{}
+	for _, prop := range properties {
+		must = append(must, map[string]interface{}{
+			"exists": map[string]interface{}{
+				"field": prop,
+			},
+		})
+	}
+
+	if filter != nil {
+		subquery, err := r.queryFromFilter(ctx, filter)
+		if err != nil {
+			return nil, fmt.Errorf("build filter: %v", err)
 		}
+
+		must = append(must, subquery)
 	}
 
 	body := map[string]interface{}{
-		"query": query,
-		"size":  9999,
-		"aggregations": map[string]interface{}{
-			"count": map[string]interface{}{
-				"value_count": map[string]interface{}{
-					"field": "_id",
-				},
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{
+				"must": must,
 			},
 		},
+		"size": pagination.Limit,
+		"sort": []interface{}{
+			map[string]interface{}{string(keyID): "asc"},
+		},
+	}
+	if pagination.After != "" {
+		body["search_after"] = []interface{}{pagination.After}
 	}
 
 	var buf bytes.Buffer
 	err := json.NewEncoder(&buf).Encode(body)
 	if err != nil {
-		return nil, fmt.Errorf("vector search: encode json: %v", err)
+		return nil, fmt.Errorf("get classified: encode json: %v", err)
 	}
 	res, err := r.client.Search(
 		r.client.Search.WithContext(ctx),
@@ -91,14 +211,14 @@ func (r *Repo) GetUnclassified(ctx context.Context, kind kind.Kind,
 		r.client.Search.WithBody(&buf),
 	)
 	if err != nil {
-		return nil, fmt.Errorf("vector search: %v", err)
+		return nil, fmt.Errorf("get classified: %v", err)
 	}
 
-	return r.unclassifiedSearchResponse(ctx, res, nil)
+	return r.unclassifiedSearchResponse(ctx, res, nil, false)
 }
 
 func (r *Repo) unclassifiedSearchResponse(ctx context.Context, res *esapi.Response,
-	properties traverser.SelectProperties) ([]search.Result,
+	properties traverser.SelectProperties, checkCount bool) ([]search.Result,
 	error) {
 	if err := errorResToErr(res, r.logger); err != nil {
 		return nil, fmt.Errorf("vector search: %v", err)
@@ -111,8 +231,10 @@ func (r *Repo) unclassifiedSearchResponse(ctx context.Context, res *esapi.Respon
 		return nil, fmt.Errorf("vector search: decode json: %v", err)
 	}
 
-	if err := checkClassificationCount(sr.Aggregations); err != nil {
-		return nil, err
+	if checkCount {
+		if err := checkClassificationCount(sr.Aggregations); err != nil {
+			return nil, err
+		}
 	}
 
 	requestCacher := newCacher(r)
@@ -248,15 +370,19 @@ func (r *Repo) aggregationsToClassificationNeighborRefs(input searchResponse,
 	sourceVector []float32) ([]classification.NeighborRef, error) {
 	hits := input.Hits.Hits
 
-	aggregations, err := extractRefNeighborsFromHits(hits, sourceVector)
+	aggregations, values, err := extractRefNeighborsFromHits(hits, sourceVector)
 	if err != nil {
 		return nil, err
 	}
 
-	return aggregateRefNeighbors(aggregations)
+	return aggregateRefNeighbors(aggregations, values)
 }
 
-func aggregateRefNeighbors(props map[string]map[string][]float32) ([]classification.NeighborRef, error) {
+// aggregateRefNeighbors votes for the most common beacon of each property, for
+// primitive properties the values are present, so the winning value can be
+// set instead of the beacon
+func aggregateRefNeighbors(props map[string]map[string][]float32,
+	values map[string]map[string]interface{}) ([]classification.NeighborRef, error) {
 	var out []classification.NeighborRef
 	for prop, beacons := range props {
 		var winningBeacon string
@@ -271,33 +397,47 @@ func aggregateRefNeighbors(props map[string]map[string][]float32) ([]classificat
 
 		winning, losing := extractWinningAndLoosingDistances(beacons, winningBeacon)
 
-		out = append(out, classification.NeighborRef{
-			Beacon:          strfmt.URI(winningBeacon),
+		total := 0
+		for _, distances := range beacons {
+			total += len(distances)
+		}
+
+		ref := classification.NeighborRef{
 			Count:           winningCount,
+			Total:           total,
 			Property:        prop,
 			WinningDistance: winning,
 			LosingDistance:  losing,
-		})
-
+		}
+		if value, ok := values[prop][winningBeacon]; ok {
+			ref.Value = value
+		} else {
+			ref.Beacon = strfmt.URI(winningBeacon)
+		}
+		out = append(out, ref)
 	}
 
 	return out, nil
 }
 
 func extractRefNeighborsFromHits(hits []hit,
-	sourceVector []float32) (map[string]map[string][]float32, error) {
-	// structure is [prop][beacon][[]distance]
+	sourceVector []float32) (map[string]map[string][]float32,
+	map[string]map[string]interface{}, error) {
+	// structure is [prop][beacon][[]distance], primitive values are
+	// aggregated by their string representation instead of the beacon
 	aggregations := map[string]map[string][]float32{}
+	// structure is [prop][string representation]value
+	values := map[string]map[string]interface{}{}
 
 	for _, hit := range hits {
 		v, err := extractVectorFromHit(hit)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		dist, err := vectorizer.NormalizedDistance(sourceVector, v)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		for key, value := range hit.Source {
@@ -305,15 +445,25 @@ func extractRefNeighborsFromHits(hits []hit,
 				continue
 			}
 
-			// assume is a ref
 			prop, ok := aggregations[key]
 			if !ok {
 				prop = map[string][]float32{}
 			}
 
+			if _, isRef := value.([]interface{}); !isRef {
+				representation := fmt.Sprint(value)
+				if values[key] == nil {
+					values[key] = map[string]interface{}{}
+				}
+				values[key][representation] = value
+				prop[representation] = append(prop[representation], dist)
+				aggregations[key] = prop
+				continue
+			}
+
 			beacon, err := extractBeaconFromProp(value)
 			if err != nil {
-				return nil, fmt.Errorf("prop %s: %v", key, err)
+				return nil, nil, fmt.Errorf("prop %s: %v", key, err)
 			}
 
 			prop[beacon] = append(prop[beacon], dist)
@@ -321,7 +471,7 @@ func extractRefNeighborsFromHits(hits []hit,
 		}
 	}
 
-	return aggregations, nil
+	return aggregations, values, nil
 }
 
 func extractVectorFromHit(hit hit) ([]float32, error) {
@@ -73,11 +73,48 @@ func TestClassifications(t *testing.T) {
 
 	t.Run("finding all unclassified (no filters)", func(t *testing.T) {
 		res, err := repo.GetUnclassified(context.Background(), kind.Thing,
-			"Article", []string{"exactCateogry", "mainCategory"}, nil)
+			"Article", []string{"exactCateogry", "mainCategory"}, nil, nil)
 		require.Nil(t, err)
 		require.Len(t, res, 6)
 	})
 
+	t.Run("paging through all unclassified", func(t *testing.T) {
+		pagination := &filters.Pagination{Limit: 4}
+		first, err := repo.GetUnclassified(context.Background(), kind.Thing,
+			"Article", []string{"exactCateogry", "mainCategory"}, nil, pagination)
+		require.Nil(t, err)
+		require.Len(t, first, 4)
+
+		pagination.After = first[3].ID
+		second, err := repo.GetUnclassified(context.Background(), kind.Thing,
+			"Article", []string{"exactCateogry", "mainCategory"}, nil, pagination)
+		require.Nil(t, err)
+		require.Len(t, second, 2)
+		assert.True(t, first[3].ID < second[0].ID, "pages are sorted by id")
+	})
+
+	t.Run("counting all unclassified", func(t *testing.T) {
+		count, err := repo.CountUnclassified(context.Background(), kind.Thing,
+			"Article", []string{"exactCateogry", "mainCategory"}, nil)
+		require.Nil(t, err)
+		assert.Equal(t, int64(6), count)
+	})
+
+	t.Run("paging through all classified", func(t *testing.T) {
+		pagination := &filters.Pagination{Limit: 2}
+		first, err := repo.GetClassified(context.Background(), kind.Thing,
+			"Article", []string{"exactCategory", "mainCategory"}, nil, pagination)
+		require.Nil(t, err)
+		require.Len(t, first, 2)
+
+		pagination.After = first[1].ID
+		second, err := repo.GetClassified(context.Background(), kind.Thing,
+			"Article", []string{"exactCategory", "mainCategory"}, nil, pagination)
+		require.Nil(t, err)
+		require.Len(t, second, 1)
+		assert.True(t, first[1].ID < second[0].ID, "pages are sorted by id")
+	})
+
 	t.Run("finding all unclassified (with filters)", func(t *testing.T) {
 		filter := &filters.LocalFilter{
 			Root: &filters.Clause{
@@ -93,7 +130,7 @@ func TestClassifications(t *testing.T) {
 		}
 
 		res, err := repo.GetUnclassified(context.Background(), kind.Thing,
-			"Article", []string{"exactCateogry", "mainCategory"}, filter)
+			"Article", []string{"exactCateogry", "mainCategory"}, filter, nil)
 		require.Nil(t, err)
 		require.Len(t, res, 1)
 		assert.Equal(t, strfmt.UUID("a2bbcbdc-76e1-477d-9e72-a6d2cfb50109"), res[0].ID)
@@ -73,6 +73,12 @@ func Test_Filters(t *testing.T) {
 	t.Run("chained primitive props",
 		testChainedPrmitiveProps(repo, migrator))
 
+	t.Run("sorting combined with filters and pagination",
+		testSortedClassSearch(repo))
+
+	t.Run("keyword ranking combined with filters",
+		testKeywordClassSearch(repo))
+
 	// NOTE: This test suite only tests filtering on primitive props, since
 	// filtering on ref props requires a ref-schema and cache to be present,
 	// those tests can be found in cache_multiple_reftypes_integration_test.go
@@ -321,6 +327,124 @@ func testChainedPrmitiveProps(repo *Repo,
 	}
 }
 
+func testSortedClassSearch(repo *Repo) func(t *testing.T) {
+	return func(t *testing.T) {
+		type test struct {
+			name        string
+			filter      *filters.LocalFilter
+			pagination  *filters.Pagination
+			sort        []filters.Sort
+			expectedIDs []strfmt.UUID
+		}
+
+		byHorsepower := []filters.Sort{
+			{Path: []string{"horsepower"}, Order: filters.SortOrderDesc},
+		}
+
+		tests := []test{
+			test{
+				name:        "by horsepower",
+				pagination:  &filters.Pagination{Limit: 100},
+				sort:        byHorsepower,
+				expectedIDs: []strfmt.UUID{carE63sID, carSprinterID, carPoloID},
+			},
+			test{
+				name:        "by horsepower with a filter and an offset",
+				filter:      buildFilter("horsepower", 110, gt, dtInt),
+				pagination:  &filters.Pagination{Limit: 1, Offset: 1},
+				sort:        byHorsepower,
+				expectedIDs: []strfmt.UUID{carSprinterID},
+			},
+			test{
+				name:       "by model name",
+				pagination: &filters.Pagination{Limit: 100},
+				sort: []filters.Sort{
+					{Path: []string{"modelName"}, Order: filters.SortOrderAsc},
+				},
+				expectedIDs: []strfmt.UUID{carE63sID, carPoloID, carSprinterID},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				params := traverser.GetParams{
+					Kind:       kind.Thing,
+					ClassName:  carClass.Class,
+					Pagination: test.pagination,
+					Filters:    test.filter,
+					Sort:       test.sort,
+				}
+				res, err := repo.ClassSearch(context.Background(), params)
+				require.Nil(t, err)
+
+				ids := make([]strfmt.UUID, len(res))
+				for pos, concept := range res {
+					ids[pos] = concept.ID
+				}
+				assert.Equal(t, test.expectedIDs, ids)
+			})
+		}
+	}
+}
+
+func testKeywordClassSearch(repo *Repo) func(t *testing.T) {
+	return func(t *testing.T) {
+		type test struct {
+			name        string
+			query       string
+			properties  []string
+			filter      *filters.LocalFilter
+			expectedIDs []strfmt.UUID
+		}
+
+		tests := []test{
+			test{
+				name:        "on a text prop, more occurrences rank higher",
+				query:       "small van",
+				properties:  []string{"description"},
+				expectedIDs: []strfmt.UUID{carPoloID, carSprinterID},
+			},
+			test{
+				name:        "on a string prop, the term needs to match exactly",
+				query:       "polo e63",
+				properties:  []string{"modelName"},
+				expectedIDs: []strfmt.UUID{carPoloID},
+			},
+			test{
+				name:        "with a filter",
+				query:       "small van",
+				properties:  []string{"description"},
+				filter:      buildFilter("horsepower", 110, gt, dtInt),
+				expectedIDs: []strfmt.UUID{carSprinterID},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				params := traverser.GetParams{
+					Kind:       kind.Thing,
+					ClassName:  carClass.Class,
+					Pagination: &filters.Pagination{Limit: 100},
+					Filters:    test.filter,
+					KeywordRanking: &traverser.KeywordRankingParams{
+						Query:      test.query,
+						Properties: test.properties,
+					},
+				}
+				res, err := repo.KeywordClassSearch(context.Background(), params)
+				require.Nil(t, err)
+
+				ids := make([]strfmt.UUID, len(res))
+				for pos, concept := range res {
+					ids[pos] = concept.ID
+					assert.True(t, concept.Score > 0)
+				}
+				assert.Equal(t, test.expectedIDs, ids)
+			})
+		}
+	}
+}
+
 func buildFilter(propName string, value interface{}, operator filters.Operator, schemaType schema.DataType) *filters.LocalFilter {
 	return &filters.LocalFilter{
 		Root: &filters.Clause{
@@ -18,6 +18,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 
 	"github.com/elastic/go-elasticsearch/v5/esapi"
 )
@@ -160,3 +161,87 @@ func (r *Repo) SetMappings(ctx context.Context, index string,
 
 	return nil
 }
+
+// aliasedIndex resolves the concrete index behind a class index name. Class
+// indices only become aliases once they have been reindexed, before that the
+// name is the name of the concrete index itself.
+func (r *Repo) aliasedIndex(ctx context.Context, name string) (string, error) {
+	req := esapi.IndicesGetAliasRequest{
+		Name: []string{name},
+	}
+
+	res, err := req.Do(ctx, r.client)
+	if err != nil {
+		return "", fmt.Errorf("get alias: %v", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return name, nil
+	}
+
+	if err := errorResToErr(res, r.logger); err != nil {
+		return "", fmt.Errorf("get alias: %v", err)
+	}
+
+	var indices map[string]interface{}
+	if err := json.NewDecoder(res.Body).Decode(&indices); err != nil {
+		return "", fmt.Errorf("get alias: decode json: %v", err)
+	}
+
+	if len(indices) != 1 {
+		return "", fmt.Errorf("get alias: expected alias %s to point to a single index, "+
+			"but got %d", name, len(indices))
+	}
+
+	for index := range indices {
+		name = index
+	}
+
+	return name, nil
+}
+
+// updateAliases applies all alias actions atomically
+func (r *Repo) updateAliases(ctx context.Context, actions []interface{}) error {
+	var buf bytes.Buffer
+	err := json.NewEncoder(&buf).Encode(map[string]interface{}{
+		"actions": actions,
+	})
+	if err != nil {
+		return fmt.Errorf("update aliases: encode json: %v", err)
+	}
+
+	req := esapi.IndicesUpdateAliasesRequest{
+		Body: &buf,
+	}
+
+	res, err := req.Do(ctx, r.client)
+	if err != nil {
+		return fmt.Errorf("update aliases: %v", err)
+	}
+	defer res.Body.Close()
+
+	if err := errorResToErr(res, r.logger); err != nil {
+		return fmt.Errorf("update aliases: %v", err)
+	}
+
+	return nil
+}
+
+func (r *Repo) refreshIndex(ctx context.Context, index string) error {
+	req := esapi.IndicesRefreshRequest{
+		Index: []string{index},
+	}
+
+	res, err := req.Do(ctx, r.client)
+	if err != nil {
+		return fmt.Errorf("refresh index: %v", err)
+	}
+	defer res.Body.Close()
+
+	if err := errorResToErr(res, r.logger); err != nil {
+		return fmt.Errorf("refresh index: %v", err)
+	}
+
+	return nil
+}
@@ -33,6 +33,12 @@ func (r *Repo) Merge(ctx context.Context, merge kinds.MergeDocument) error {
 		return fmt.Errorf("merge: encode: %v", err)
 	}
 
+	done := r.reindexes.write()
+	defer done(indexedDoc{
+		index: classIndexFromClassName(merge.Kind, merge.Class),
+		id:    merge.ID.String(),
+	})
+
 	req := esapi.BulkRequest{
 		Body: &buf,
 	}
@@ -75,7 +81,7 @@ func (r *Repo) errorsInBulkResponse(res *esapi.Response) error {
 }
 
 func (r *Repo) encodeMerge(enc *json.Encoder, merge kinds.MergeDocument) error {
-	if merge.PrimitiveSchema != nil && len(merge.PrimitiveSchema) > 0 {
+	if hasPrimitiveMerge(merge) {
 		if err := r.encodeMergePrimitive(enc, merge); err != nil {
 			return fmt.Errorf("encode primitive: %v", err)
 		}
@@ -90,12 +96,25 @@ func (r *Repo) encodeMerge(enc *json.Encoder, merge kinds.MergeDocument) error {
 	return nil
 }
 
+// hasPrimitiveMerge is true if the merge updates the document itself, i.e.
+// it has primitive props or a vector
+func hasPrimitiveMerge(merge kinds.MergeDocument) bool {
+	return len(merge.PrimitiveSchema) > 0 || len(merge.Vector) > 0
+}
+
 func (r *Repo) encodeMergePrimitive(enc *json.Encoder, merge kinds.MergeDocument) error {
 	index := classIndexFromClassName(merge.Kind, merge.Class)
 	control := r.bulkUpdateControlObject(index, merge.ID.String())
-	initial := map[string]interface{}{
-		keyVector.String():  vectorToBase64(merge.Vector),
-		keyUpdated.String(): merge.UpdateTime,
+	initial := map[string]interface{}{}
+
+	// a merge without a vector keeps the stored one
+	if len(merge.Vector) > 0 {
+		initial[keyVector.String()] = vectorToBase64(merge.Vector)
+	}
+
+	// a merge of only the vector doesn't change the object
+	if len(merge.PrimitiveSchema) > 0 {
+		initial[keyUpdated.String()] = merge.UpdateTime
 	}
 	props := r.addPropsToBucket(initial, merge.PrimitiveSchema)
 
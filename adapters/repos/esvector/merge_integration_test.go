@@ -292,4 +292,43 @@ func Test_MergingObjects(t *testing.T) {
 
 		assert.ElementsMatch(t, foundBeacons, expectedBeacons)
 	})
+
+	t.Run("merge in batch, with one object which doesn't exist", func(t *testing.T) {
+		res, err := repo.BatchMerge(context.Background(), kinds.BatchMergeDocuments{
+			{
+				OriginalIndex: 0,
+				Merge: kinds.MergeDocument{
+					Class:           "MergeTestTarget",
+					ID:              target1,
+					Kind:            kind.Thing,
+					PrimitiveSchema: map[string]interface{}{"name": "batch merged"},
+					Vector:          []float32{0.7},
+				},
+			},
+			{
+				OriginalIndex: 1,
+				Merge: kinds.MergeDocument{
+					Class:           "MergeTestTarget",
+					ID:              "3fa1e2b2-4d5c-4e6f-8a9b-0c1d2e3f4a5b",
+					Kind:            kind.Thing,
+					PrimitiveSchema: map[string]interface{}{"name": "batch merged"},
+					Vector:          []float32{0.7},
+				},
+			},
+		})
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+		assert.Nil(t, res[0].Err)
+		assert.NotNil(t, res[1].Err)
+	})
+
+	refreshAll(t, client)
+
+	t.Run("check that the batch merge was applied", func(t *testing.T) {
+		target, err := repo.ThingByID(context.Background(), target1, nil, false)
+		require.Nil(t, err)
+
+		assert.Equal(t, "batch merged", target.Schema.(map[string]interface{})["name"])
+		assert.Equal(t, []float32{0.7}, target.Vector)
+	})
 }
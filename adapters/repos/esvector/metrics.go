@@ -0,0 +1,96 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package esvector
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/semi-technologies/weaviate/usecases/monitoring"
+)
+
+// NewInstrumentedTransport observes the duration of every request to
+// elasticsearch. Set it as the transport of the elasticsearch client.
+func NewInstrumentedTransport(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &instrumentedTransport{next: next}
+}
+
+type instrumentedTransport struct {
+	next http.RoundTripper
+}
+
+func (t *instrumentedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	before := time.Now()
+	res, err := t.next.RoundTrip(req)
+
+	operation := operationFromRequest(req)
+	if level, ok := req.Context().Value(lookupLevelKey{}).(int); ok {
+		operation = fmt.Sprintf("reference_lookup_level_%d", level)
+	}
+
+	monitoring.GetMetrics().ESVectorDuration.
+		WithLabelValues(classFromRequest(req), operation).
+		Observe(time.Since(before).Seconds())
+
+	return res, err
+}
+
+type lookupLevelKey struct{}
+
+// withLookupLevel marks the requests of the request cacher with the level of
+// the nested reference lookup, the root level is 1
+func withLookupLevel(ctx context.Context, level int) context.Context {
+	return context.WithValue(ctx, lookupLevelKey{}, level)
+}
+
+// operationFromRequest is the elasticsearch endpoint, such as "search" or
+// "bulk", requests without an endpoint operate on the index itself
+func operationFromRequest(req *http.Request) string {
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	for _, segment := range segments {
+		if strings.HasPrefix(segment, "_") {
+			return strings.TrimPrefix(segment, "_")
+		}
+	}
+
+	if segments[0] == "" {
+		return "info"
+	}
+
+	return strings.ToLower(req.Method) + "_index"
+}
+
+// classFromRequest is the lowercased class name of the index in the path or
+// "*" if the request spans several or no classes
+func classFromRequest(req *http.Request) string {
+	index := strings.SplitN(strings.Trim(req.URL.Path, "/"), "/", 2)[0]
+	if strings.ContainsAny(index, ",*") {
+		return "*"
+	}
+
+	for _, prefix := range []string{indexPrefix + "thing_", indexPrefix + "action_"} {
+		if strings.HasPrefix(index, prefix) {
+			return strings.TrimPrefix(index, prefix)
+		}
+	}
+
+	return "*"
+}
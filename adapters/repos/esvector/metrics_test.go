@@ -0,0 +1,52 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package esvector
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetricLabelsFromRequest(t *testing.T) {
+	type test struct {
+		method            string
+		path              string
+		expectedClass     string
+		expectedOperation string
+	}
+
+	tests := []test{
+		{http.MethodPost, "/class_thing_city/_search", "city", "search"},
+		{http.MethodPost, "/class_action_flight/_update/123", "flight", "update"},
+		{http.MethodPost, "/class_thing_*/_search", "*", "search"},
+		{http.MethodPost, "/class_thing_city,class_action_flight/_search", "*", "search"},
+		{http.MethodPost, "/_bulk", "*", "bulk"},
+		{http.MethodPost, "/_mget", "*", "mget"},
+		{http.MethodPut, "/class_thing_city", "city", "put_index"},
+		{http.MethodDelete, "/class_thing_city", "city", "delete_index"},
+		{http.MethodGet, "/", "*", "info"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.method+" "+tc.path, func(t *testing.T) {
+			req, err := http.NewRequest(tc.method, "http://localhost:9200"+tc.path, nil)
+			assert.Nil(t, err)
+
+			assert.Equal(t, tc.expectedClass, classFromRequest(req))
+			assert.Equal(t, tc.expectedOperation, operationFromRequest(req))
+		})
+	}
+}
@@ -17,7 +17,10 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/go-openapi/strfmt"
+	uuid "github.com/satori/go.uuid"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/schema/kind"
@@ -50,10 +53,21 @@ func (m *Migrator) AddClass(ctx context.Context, kind kind.Kind, class *models.C
 	return nil
 }
 
-// DropClass deletes a class specific index
+// DropClass deletes a class specific index. A running reindex of the class
+// is cancelled, as there is nothing left to migrate.
 func (m *Migrator) DropClass(ctx context.Context, kind kind.Kind, className string) error {
 	index := classIndexFromClassName(kind, className)
-	err := m.repo.DeleteIndex(ctx, index)
+	if job := m.repo.reindexes.runningJob(index); job != nil {
+		job.cancel()
+		<-job.done
+	}
+
+	concrete, err := m.repo.aliasedIndex(ctx, index)
+	if err != nil {
+		return fmt.Errorf("drop class %s: %v", className, err)
+	}
+
+	err = m.repo.DeleteIndex(ctx, concrete)
 	if err != nil {
 		return fmt.Errorf("drop class %s: delete index: %v", className, err)
 	}
@@ -61,12 +75,31 @@ func (m *Migrator) DropClass(ctx context.Context, kind kind.Kind, className stri
 	return nil
 }
 
-// UpdateClass does nothing if the keywords should be changed and errors if the
-// className should be changed - the class name must be immutable when the
-// esvector index is active
+// UpdateClass does nothing if the keywords should be changed and starts a
+// reindex if the class should be renamed, since the class name is part of
+// both the index name and every object
 func (m *Migrator) UpdateClass(ctx context.Context, kind kind.Kind, className string, newClassName *string, newKeywords *models.Keywords) error {
-	if newClassName != nil {
-		return fmt.Errorf("esvector does not support renaming of classes")
+	if newClassName == nil {
+		return nil
+	}
+
+	class, err := m.classFromSchema(*newClassName)
+	if err != nil {
+		return fmt.Errorf("rename class %s: %v", className, err)
+	}
+
+	err = m.startReindex(ctx, reindexParams{
+		kind:      kind,
+		operation: models.SchemaReindexOperationRenameClass,
+		className: *newClassName,
+		from:      className,
+		to:        *newClassName,
+		oldIndex:  classIndexFromClassName(kind, className),
+		props:     class.Properties,
+		transform: renameClassTransform(*newClassName),
+	})
+	if err != nil {
+		return fmt.Errorf("rename class %s: %v", className, err)
 	}
 
 	return nil
@@ -83,22 +116,75 @@ func (m *Migrator) AddProperty(ctx context.Context, kind kind.Kind, className st
 			className, prop.Name, err)
 	}
 
+	// objects which use the new property might be copied into the target
+	// of a running reindex
+	if job := m.repo.reindexes.runningJob(index); job != nil {
+		err := m.setMappings(ctx, job.target, []*models.Property{prop})
+		if err != nil {
+			return fmt.Errorf("add property %s to class %s: map properties of reindex target: %v",
+				className, prop.Name, err)
+		}
+	}
+
 	return nil
 }
 
-// DropProperty has no effect since mapped property types cannot be deleted in
-// elasticsearch
+// DropProperty starts a reindex into an index without the property, since
+// mapped properties cannot be deleted in elasticsearch
 func (m *Migrator) DropProperty(ctx context.Context, kind kind.Kind, className string, propertyName string) error {
-	// ignore but don't error
+	class, err := m.classFromSchema(className)
+	if err != nil {
+		return fmt.Errorf("drop property %s of class %s: %v", propertyName, className, err)
+	}
+
+	var props []*models.Property
+	for _, prop := range class.Properties {
+		if prop.Name != propertyName {
+			props = append(props, prop)
+		}
+	}
+
+	err = m.startReindex(ctx, reindexParams{
+		kind:      kind,
+		operation: models.SchemaReindexOperationDropProperty,
+		className: className,
+		from:      propertyName,
+		oldIndex:  classIndexFromClassName(kind, className),
+		props:     props,
+		transform: dropPropertyTransform(propertyName),
+	})
+	if err != nil {
+		return fmt.Errorf("drop property %s of class %s: %v", propertyName, className, err)
+	}
+
 	return nil
 }
 
-// UpdateProperty will do nothing if keywords should be updated and error if a
-// name should be updated. Property names must be immutable when the esvector
-// index is enabled
+// UpdateProperty will do nothing if keywords should be updated and start a
+// reindex if the property should be renamed, since mapped properties cannot
+// be renamed in elasticsearch
 func (m *Migrator) UpdateProperty(ctx context.Context, kind kind.Kind, className string, propName string, newName *string, newKeywords *models.Keywords) error {
-	if newName != nil {
-		return fmt.Errorf("esvector does not support renaming of properties")
+	if newName == nil {
+		return nil
+	}
+
+	class, err := m.classFromSchema(className)
+	if err != nil {
+		return fmt.Errorf("rename property %s of class %s: %v", propName, className, err)
+	}
+
+	err = m.startReindex(ctx, reindexParams{
+		kind:      kind,
+		operation: models.SchemaReindexOperationRenameProperty,
+		className: className,
+		from:      propName,
+		to:        *newName,
+		oldIndex:  classIndexFromClassName(kind, className),
+		props:     class.Properties,
+		transform: renamePropertyTransform(propName, *newName),
+	})
+	if err != nil {
+		return fmt.Errorf("rename property %s of class %s: %v", propName, className, err)
 	}
 
 	return nil
@@ -110,6 +196,131 @@ func (m *Migrator) UpdatePropertyAddDataType(ctx context.Context, kind kind.Kind
 	return nil
 }
 
+// Reindexes lists all reindex jobs since startup
+func (m *Migrator) Reindexes() []*models.SchemaReindex {
+	return m.repo.reindexes.list()
+}
+
+// Reindex returns the status of a single reindex job, nil if it doesn't exist
+func (m *Migrator) Reindex(id strfmt.UUID) *models.SchemaReindex {
+	return m.repo.reindexes.get(id)
+}
+
+// classFromSchema reads the class from the schema, which is expected to
+// already contain the changes to migrate
+func (m *Migrator) classFromSchema(className string) (*models.Class, error) {
+	s := m.repo.schemaGetter.GetSchemaSkipAuth()
+	class := s.FindClassByName(schema.ClassName(className))
+	if class == nil {
+		return nil, fmt.Errorf("class '%s' not found in schema", className)
+	}
+
+	return class, nil
+}
+
+type reindexParams struct {
+	kind      kind.Kind
+	operation string
+	className string
+	from      string
+	to        string
+	oldIndex  string
+	props     []*models.Property
+	transform func(doc map[string]interface{})
+}
+
+// startReindex prepares the target index, then copies the objects in the
+// background. On a class rename the class is immediately served under its
+// new name from the source index, until the target takes over.
+func (m *Migrator) startReindex(ctx context.Context, p reindexParams) error {
+	alias := classIndexFromClassName(p.kind, p.className)
+	source, err := m.repo.aliasedIndex(ctx, p.oldIndex)
+	if err != nil {
+		return err
+	}
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		return err
+	}
+
+	indices := []string{p.oldIndex}
+	if alias != p.oldIndex {
+		indices = append(indices, alias)
+	}
+
+	target := versionedIndexName(alias, indexVersion(source)+1)
+	jobCtx, cancel := context.WithCancel(context.Background())
+	job := &reindexJob{
+		status: models.SchemaReindex{
+			ID:          strfmt.UUID(id.String()),
+			Kind:        p.kind.Name(),
+			ClassName:   p.className,
+			Operation:   p.operation,
+			From:        p.from,
+			To:          p.to,
+			SourceIndex: source,
+			TargetIndex: target,
+			Status:      models.SchemaReindexStatusRunning,
+			Meta: &models.SchemaReindexMeta{
+				Started: strfmt.DateTime(time.Now()),
+			},
+		},
+		indices:   indices,
+		alias:     alias,
+		source:    source,
+		target:    target,
+		transform: p.transform,
+		cancel:    cancel,
+		done:      make(chan struct{}),
+	}
+
+	if err := m.repo.reindexes.start(job); err != nil {
+		cancel()
+		return err
+	}
+
+	if err := m.prepareReindex(ctx, job, p.props); err != nil {
+		cancel()
+		m.repo.reindexes.stop(job, err, true)
+		return err
+	}
+
+	go m.runReindex(jobCtx, job)
+	return nil
+}
+
+func (m *Migrator) prepareReindex(ctx context.Context, job *reindexJob,
+	props []*models.Property) error {
+	err := m.repo.PutIndex(ctx, job.target)
+	if err != nil {
+		return fmt.Errorf("create index: %v", err)
+	}
+
+	err = m.setMappings(ctx, job.target, props)
+	if err == nil && len(job.indices) > 1 {
+		err = m.repo.updateAliases(ctx, []interface{}{
+			map[string]interface{}{
+				"add": map[string]interface{}{
+					"index": job.source,
+					"alias": job.alias,
+				},
+			},
+		})
+	}
+	if err != nil {
+		if err := m.repo.DeleteIndex(ctx, job.target); err != nil {
+			m.repo.logger.WithField("action", "esvector_reindex_cleanup").
+				WithField("target", job.target).
+				WithError(err).
+				Warn("could not delete target index of failed reindex")
+		}
+		return fmt.Errorf("prepare index: %v", err)
+	}
+
+	return nil
+}
+
 const indexPrefix = "class_"
 
 func classIndexFromClass(kind kind.Kind, class *models.Class) string {
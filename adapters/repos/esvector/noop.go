@@ -51,11 +51,13 @@ func (r *NoOpRepo) ActionByID(ctx context.Context, id strfmt.UUID, props travers
 	panic("no op repo: not implemented")
 }
 
-func (r *NoOpRepo) ThingSearch(ctx context.Context, limit int, filters *filters.LocalFilter) (search.Results, error) {
+func (r *NoOpRepo) ThingSearch(ctx context.Context, pagination *filters.Pagination,
+	sort []filters.Sort, filters *filters.LocalFilter, meta bool) (search.Results, error) {
 	panic("no op repo: not implemented")
 }
 
-func (r *NoOpRepo) ActionSearch(ctx context.Context, limit int, filters *filters.LocalFilter) (search.Results, error) {
+func (r *NoOpRepo) ActionSearch(ctx context.Context, pagination *filters.Pagination,
+	sort []filters.Sort, filters *filters.LocalFilter, meta bool) (search.Results, error) {
 	panic("no op repo: not implemented")
 }
 
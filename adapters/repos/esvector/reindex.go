@@ -0,0 +1,637 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package esvector
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v5/esapi"
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+)
+
+// Mapped fields can neither be renamed nor removed from an es index. So
+// instead of migrating the class index in place, a reindex job copies all
+// objects into a new versioned index, whose mapping is built from the updated
+// schema. Once all objects are copied, the new index atomically replaces the
+// old one behind an alias carrying the class index name. All requests address
+// class indices by that name, so they switch over at once.
+//
+// Reads and writes are served by the old index while the objects are copied.
+// Every write to a class that is being reindexed is recorded, so the written
+// objects can be copied again afterwards. Only while the last recorded writes
+// are copied and the alias is swapped, writes are held back until the swap is
+// complete.
+
+const (
+	// versionedIndexPrefix is prepended to the indices created by a reindex
+	// job. It must not start with the indexPrefix, otherwise the target index
+	// would be matched by the wildcards over all class indices while it is
+	// being filled.
+	versionedIndexPrefix = "v"
+
+	// reindexCatchUpPasses is the maximum number of times the recorded writes
+	// are copied, before writes are held back for the final pass
+	reindexCatchUpPasses = 5
+
+	// reindexCatchUpThreshold is the number of recorded writes which are small
+	// enough to be copied in the final pass right away
+	reindexCatchUpThreshold = 100
+)
+
+func versionedIndexName(alias string, version int) string {
+	return fmt.Sprintf("%s%d_%s", versionedIndexPrefix, version, alias)
+}
+
+// indexVersion of a concrete class index. Class indices which have never been
+// reindexed are version 1.
+func indexVersion(index string) int {
+	if !strings.HasPrefix(index, versionedIndexPrefix) {
+		return 1
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(index, versionedIndexPrefix), "_", 2)
+	version, err := strconv.Atoi(parts[0])
+	if err != nil || len(parts) < 2 {
+		return 1
+	}
+
+	return version
+}
+
+// indexedDoc identifies a written object by the (alias) name of its class
+// index
+type indexedDoc struct {
+	index string
+	id    string
+}
+
+// reindexJob copies all objects from the source to the target index,
+// transforming each object on the way
+type reindexJob struct {
+	status models.SchemaReindex
+
+	// indices are the names under which the source is written to during the
+	// reindex. On a class rename these are both the old and the new name.
+	indices []string
+
+	// alias is the name of the class index after the schema change, it points
+	// to the target once the job has completed
+	alias     string
+	source    string
+	target    string
+	transform func(doc map[string]interface{})
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// reindexes keeps track of all reindex jobs since startup and of the objects
+// written to the classes which are currently being reindexed
+type reindexes struct {
+	// gate is held for reading by every write and for writing while a job
+	// copies the last changes and swaps the indices
+	gate sync.RWMutex
+
+	sync.Mutex
+	jobs    []*reindexJob
+	running map[string]*reindexJob
+	dirty   map[*reindexJob]map[string]struct{}
+}
+
+func newReindexes() *reindexes {
+	return &reindexes{
+		running: map[string]*reindexJob{},
+		dirty:   map[*reindexJob]map[string]struct{}{},
+	}
+}
+
+// write must be called before every write to a class index. The returned
+// function must be called with the written objects once the write has
+// completed, regardless of whether it succeeded.
+func (r *reindexes) write() func(docs ...indexedDoc) {
+	r.gate.RLock()
+	return func(docs ...indexedDoc) {
+		r.record(docs)
+		r.gate.RUnlock()
+	}
+}
+
+func (r *reindexes) record(docs []indexedDoc) {
+	r.Lock()
+	defer r.Unlock()
+
+	for _, doc := range docs {
+		job, ok := r.running[doc.index]
+		if !ok {
+			continue
+		}
+
+		r.dirty[job][doc.id] = struct{}{}
+	}
+}
+
+// start records writes to the indices of the job from now on. Only a single
+// job can run per class index at a time.
+func (r *reindexes) start(job *reindexJob) error {
+	r.Lock()
+	defer r.Unlock()
+
+	for _, index := range job.indices {
+		if running, ok := r.running[index]; ok {
+			return fmt.Errorf("index %s is still being reindexed by reindex %s",
+				index, running.status.ID)
+		}
+	}
+
+	for _, index := range job.indices {
+		r.running[index] = job
+	}
+	r.dirty[job] = map[string]struct{}{}
+	r.jobs = append(r.jobs, job)
+	return nil
+}
+
+// takeDirty returns the ids of all objects written since the last call
+func (r *reindexes) takeDirty(job *reindexJob) []string {
+	r.Lock()
+	defer r.Unlock()
+
+	ids := make([]string, 0, len(r.dirty[job]))
+	for id := range r.dirty[job] {
+		ids = append(ids, id)
+	}
+	r.dirty[job] = map[string]struct{}{}
+
+	return ids
+}
+
+// stop no longer records writes for the job and sets its final status. A job
+// which failed before it could be started is forgotten entirely.
+func (r *reindexes) stop(job *reindexJob, err error, forget bool) {
+	r.Lock()
+	defer r.Unlock()
+
+	for _, index := range job.indices {
+		if r.running[index] == job {
+			delete(r.running, index)
+		}
+	}
+	delete(r.dirty, job)
+
+	if forget {
+		for i, other := range r.jobs {
+			if other == job {
+				r.jobs = append(r.jobs[:i], r.jobs[i+1:]...)
+				break
+			}
+		}
+		return
+	}
+
+	job.status.Meta.Completed = strfmt.DateTime(time.Now())
+	if err != nil {
+		job.status.Status = models.SchemaReindexStatusFailed
+		job.status.Error = err.Error()
+	} else {
+		job.status.Status = models.SchemaReindexStatusCompleted
+	}
+}
+
+func (r *reindexes) addCount(job *reindexJob, count int) {
+	r.Lock()
+	defer r.Unlock()
+
+	job.status.Meta.Count += int64(count)
+}
+
+// runningJob for the class index if there is one
+func (r *reindexes) runningJob(index string) *reindexJob {
+	r.Lock()
+	defer r.Unlock()
+
+	return r.running[index]
+}
+
+func (r *reindexes) list() []*models.SchemaReindex {
+	r.Lock()
+	defer r.Unlock()
+
+	out := make([]*models.SchemaReindex, len(r.jobs))
+	for i, job := range r.jobs {
+		out[i] = job.statusCopy()
+	}
+
+	return out
+}
+
+func (r *reindexes) get(id strfmt.UUID) *models.SchemaReindex {
+	r.Lock()
+	defer r.Unlock()
+
+	for _, job := range r.jobs {
+		if job.status.ID == id {
+			return job.statusCopy()
+		}
+	}
+
+	return nil
+}
+
+func (j *reindexJob) statusCopy() *models.SchemaReindex {
+	status := j.status
+	meta := *j.status.Meta
+	status.Meta = &meta
+	return &status
+}
+
+// run copies all objects, then the objects written in the meantime and
+// finally swaps the alias to the target index
+func (m *Migrator) runReindex(ctx context.Context, job *reindexJob) {
+	defer close(job.done)
+
+	err := m.reindexAll(ctx, job)
+	if err == nil {
+		err = m.reindexWritten(ctx, job)
+	}
+	if err == nil {
+		err = m.reindexSwap(ctx, job)
+	}
+
+	if err != nil {
+		m.repo.logger.WithField("action", "esvector_reindex_failed").
+			WithField("reindex", job.status.ID).
+			WithField("source", job.source).
+			WithField("target", job.target).
+			WithError(err).
+			Error("reindex failed, source index remains in use")
+
+		// the source is still in use, the target is of no use anymore
+		if err := m.repo.DeleteIndex(context.Background(), job.target); err != nil {
+			m.repo.logger.WithField("action", "esvector_reindex_cleanup").
+				WithField("target", job.target).
+				WithError(err).
+				Warn("could not delete target index of failed reindex")
+		}
+	}
+
+	m.repo.reindexes.stop(job, err, false)
+}
+
+func (m *Migrator) reindexAll(ctx context.Context, job *reindexJob) error {
+	err := m.repo.scrollIndex(ctx, job.source, func(hits []hit) error {
+		return m.copyHits(ctx, job, hits)
+	})
+	if err != nil {
+		return fmt.Errorf("copy objects: %v", err)
+	}
+
+	return nil
+}
+
+// reindexWritten copies the objects written during the previous pass until
+// only few writes remain for the final pass
+func (m *Migrator) reindexWritten(ctx context.Context, job *reindexJob) error {
+	for i := 0; i < reindexCatchUpPasses; i++ {
+		ids := m.repo.reindexes.takeDirty(job)
+		if err := m.copyIDs(ctx, job, ids); err != nil {
+			return fmt.Errorf("copy written objects: %v", err)
+		}
+
+		if len(ids) < reindexCatchUpThreshold {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// reindexSwap copies the last written objects and swaps the alias while
+// writes are held back, so that no write can be lost in between
+func (m *Migrator) reindexSwap(ctx context.Context, job *reindexJob) error {
+	m.repo.reindexes.gate.Lock()
+	defer m.repo.reindexes.gate.Unlock()
+
+	if err := m.copyIDs(ctx, job, m.repo.reindexes.takeDirty(job)); err != nil {
+		return fmt.Errorf("copy written objects: %v", err)
+	}
+
+	if err := m.repo.refreshIndex(ctx, job.target); err != nil {
+		return fmt.Errorf("swap alias: %v", err)
+	}
+
+	// removing the source index also removes all aliases pointing to it,
+	// this includes the old class name on a class rename
+	err := m.repo.updateAliases(ctx, []interface{}{
+		map[string]interface{}{
+			"add": map[string]interface{}{
+				"index": job.target,
+				"alias": job.alias,
+			},
+		},
+		map[string]interface{}{
+			"remove_index": map[string]interface{}{
+				"index": job.source,
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("swap alias: %v", err)
+	}
+
+	return nil
+}
+
+func (m *Migrator) copyHits(ctx context.Context, job *reindexJob, hits []hit) error {
+	if len(hits) == 0 {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, hit := range hits {
+		job.transform(hit.Source)
+		if err := enc.Encode(m.repo.bulkIndexControlObject(job.target, hit.ID)); err != nil {
+			return err
+		}
+
+		if err := enc.Encode(hit.Source); err != nil {
+			return err
+		}
+	}
+
+	if err := m.repo.bulk(ctx, &buf); err != nil {
+		return err
+	}
+
+	m.repo.reindexes.addCount(job, len(hits))
+	return nil
+}
+
+// copyIDs copies the current state of the objects from the source. Objects
+// which no longer exist in the source have been deleted since they were
+// copied, so they are deleted in the target, too.
+func (m *Migrator) copyIDs(ctx context.Context, job *reindexJob, ids []string) error {
+	for len(ids) > 0 {
+		n := scrollBatchSize
+		if n > len(ids) {
+			n = len(ids)
+		}
+
+		docs, err := m.repo.getByIDs(ctx, job.source, ids[:n])
+		if err != nil {
+			return err
+		}
+
+		var found []hit
+		var buf bytes.Buffer
+		enc := json.NewEncoder(&buf)
+		for _, doc := range docs {
+			if doc.Found {
+				found = append(found, hit{ID: doc.ID, Source: doc.Source})
+				continue
+			}
+
+			if err := enc.Encode(bulkDeleteControlObject(job.target, doc.ID)); err != nil {
+				return err
+			}
+		}
+
+		if buf.Len() > 0 {
+			if err := m.repo.bulk(ctx, &buf); err != nil {
+				return err
+			}
+		}
+
+		if err := m.copyHits(ctx, job, found); err != nil {
+			return err
+		}
+
+		ids = ids[n:]
+	}
+
+	return nil
+}
+
+type bulkDeleteControl struct {
+	Delete *bulkID `json:"delete"`
+}
+
+func bulkDeleteControlObject(index, id string) bulkDeleteControl {
+	return bulkDeleteControl{
+		Delete: &bulkID{
+			Index: index,
+			ID:    id,
+		},
+	}
+}
+
+// bulk sends a bulk request and errors if any of its operations failed.
+// Deletes of objects which don't exist are not considered failures.
+func (r *Repo) bulk(ctx context.Context, body *bytes.Buffer) error {
+	req := esapi.BulkRequest{
+		Body: body,
+	}
+
+	res, err := req.Do(ctx, r.client)
+	if err != nil {
+		return fmt.Errorf("bulk request: %v", err)
+	}
+	defer res.Body.Close()
+
+	if err := errorResToErr(res, r.logger); err != nil {
+		return fmt.Errorf("bulk request: %v", err)
+	}
+
+	var parsed struct {
+		Errors bool                                     `json:"errors"`
+		Items  []map[string]struct{ Error interface{} } `json:"items"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&parsed); err != nil {
+		return fmt.Errorf("bulk request: decode json: %v", err)
+	}
+
+	if !parsed.Errors {
+		return nil
+	}
+
+	var errors []string
+	for _, item := range parsed.Items {
+		for _, op := range item {
+			if op.Error != nil {
+				errors = append(errors, fmt.Sprintf("%v", op.Error))
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return fmt.Errorf("bulk request: %s", strings.Join(errors, ", "))
+	}
+
+	return nil
+}
+
+// scrollIndex calls fn with the raw hits of every batch of objects of the
+// index
+func (r *Repo) scrollIndex(ctx context.Context, index string,
+	fn func(hits []hit) error) error {
+	var buf bytes.Buffer
+	body := map[string]interface{}{
+		"query": map[string]interface{}{
+			"match_all": map[string]interface{}{},
+		},
+		"size": scrollBatchSize,
+		"sort": []interface{}{"_doc"},
+	}
+
+	err := json.NewEncoder(&buf).Encode(body)
+	if err != nil {
+		return fmt.Errorf("scroll index: encode json: %v", err)
+	}
+
+	res, err := r.client.Search(
+		r.client.Search.WithContext(ctx),
+		r.client.Search.WithIndex(index),
+		r.client.Search.WithScroll(scrollKeepAlive),
+		r.client.Search.WithBody(&buf),
+	)
+	if err != nil {
+		return fmt.Errorf("scroll index: %v", err)
+	}
+
+	var scrollID string
+	defer func() {
+		if scrollID != "" {
+			r.clearScroll(scrollID)
+		}
+	}()
+
+	for {
+		var sr searchResponse
+		sr, err = r.rawScrollResponse(res)
+		if err != nil {
+			return fmt.Errorf("scroll index: %v", err)
+		}
+		scrollID = sr.ScrollID
+
+		if len(sr.Hits.Hits) == 0 {
+			return nil
+		}
+
+		if err := fn(sr.Hits.Hits); err != nil {
+			return err
+		}
+
+		res, err = r.client.Scroll(
+			r.client.Scroll.WithContext(ctx),
+			r.client.Scroll.WithScrollID(scrollID),
+			r.client.Scroll.WithScroll(scrollKeepAlive),
+		)
+		if err != nil {
+			return fmt.Errorf("scroll index: %v", err)
+		}
+	}
+}
+
+func (r *Repo) rawScrollResponse(res *esapi.Response) (searchResponse, error) {
+	defer res.Body.Close()
+	if err := errorResToErr(res, r.logger); err != nil {
+		return searchResponse{}, err
+	}
+
+	var sr searchResponse
+	err := json.NewDecoder(res.Body).Decode(&sr)
+	if err != nil {
+		return searchResponse{}, fmt.Errorf("decode json: %v", err)
+	}
+
+	return sr, nil
+}
+
+type reindexDoc struct {
+	ID     string                 `json:"_id"`
+	Found  bool                   `json:"found"`
+	Source map[string]interface{} `json:"_source"`
+}
+
+// getByIDs reads the objects in realtime, i.e. without waiting for a refresh
+// of the index
+func (r *Repo) getByIDs(ctx context.Context, index string,
+	ids []string) ([]reindexDoc, error) {
+	var buf bytes.Buffer
+	err := json.NewEncoder(&buf).Encode(map[string]interface{}{
+		"ids": ids,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get by ids: encode json: %v", err)
+	}
+
+	req := esapi.MgetRequest{
+		Index: index,
+		Body:  &buf,
+	}
+
+	res, err := req.Do(ctx, r.client)
+	if err != nil {
+		return nil, fmt.Errorf("get by ids: %v", err)
+	}
+	defer res.Body.Close()
+
+	if err := errorResToErr(res, r.logger); err != nil {
+		return nil, fmt.Errorf("get by ids: %v", err)
+	}
+
+	var parsed struct {
+		Docs []reindexDoc `json:"docs"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&parsed); err != nil {
+		return nil, fmt.Errorf("get by ids: decode json: %v", err)
+	}
+
+	return parsed.Docs, nil
+}
+
+// renameClassTransform sets the new class name on every object
+func renameClassTransform(newName string) func(doc map[string]interface{}) {
+	return func(doc map[string]interface{}) {
+		doc[keyClassName.String()] = newName
+	}
+}
+
+// renamePropertyTransform moves the value of the property to its new name
+func renamePropertyTransform(oldName, newName string) func(doc map[string]interface{}) {
+	return func(doc map[string]interface{}) {
+		value, ok := doc[oldName]
+		if !ok {
+			return
+		}
+
+		delete(doc, oldName)
+		doc[newName] = value
+	}
+}
+
+// dropPropertyTransform removes the property from every object
+func dropPropertyTransform(name string) func(doc map[string]interface{}) {
+	return func(doc map[string]interface{}) {
+		delete(doc, name)
+	}
+}
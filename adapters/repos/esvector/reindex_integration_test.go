@@ -0,0 +1,210 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package esvector
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v5"
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReindex(t *testing.T) {
+	client, err := elasticsearch.NewClient(elasticsearch.Config{
+		Addresses: []string{"http://localhost:9201"},
+	})
+	require.Nil(t, err)
+
+	class := &models.Class{
+		Class: "ReindexedCity",
+		Properties: []*models.Property{
+			&models.Property{
+				Name:     "name",
+				DataType: []string{string(schema.DataTypeString)},
+			},
+			&models.Property{
+				Name:     "population",
+				DataType: []string{string(schema.DataTypeInt)},
+			},
+		},
+	}
+
+	logger, _ := test.NewNullLogger()
+	schemaGetter := &fakeSchemaGetter{schema: schema.Schema{
+		Things: &models.Schema{Classes: []*models.Class{class}},
+	}}
+	repo := NewRepo(client, logger, schemaGetter, 3, 100, 1, "0-1")
+	waitForEsToBeReady(t, repo)
+	migrator := NewMigrator(repo)
+
+	ids := []strfmt.UUID{
+		"9e2d3f8a-7c1b-4d5e-8f6a-2b3c4d5e6f01",
+		"9e2d3f8a-7c1b-4d5e-8f6a-2b3c4d5e6f02",
+		"9e2d3f8a-7c1b-4d5e-8f6a-2b3c4d5e6f03",
+	}
+	writtenDuringReindex := strfmt.UUID("9e2d3f8a-7c1b-4d5e-8f6a-2b3c4d5e6f04")
+
+	t.Run("importing the class", func(t *testing.T) {
+		require.Nil(t, migrator.AddClass(context.Background(), kind.Thing, class))
+
+		for i, id := range ids {
+			thing := &models.Thing{
+				ID:    id,
+				Class: "ReindexedCity",
+				Schema: map[string]interface{}{
+					"name":       fmt.Sprintf("city %d", i),
+					"population": float64(1000 * i),
+				},
+			}
+
+			require.Nil(t,
+				repo.PutThing(context.Background(), thing, []float32{1, 2, float32(i)}))
+		}
+
+		require.Nil(t, repo.forceRefresh(context.Background()))
+	})
+
+	t.Run("renaming a property", func(t *testing.T) {
+		class.Properties[1].Name = "inhabitants"
+		newName := "inhabitants"
+		err := migrator.UpdateProperty(context.Background(), kind.Thing,
+			"ReindexedCity", "population", &newName, nil)
+		require.Nil(t, err)
+
+		// writes continue while the objects are copied
+		thing := &models.Thing{
+			ID:    writtenDuringReindex,
+			Class: "ReindexedCity",
+			Schema: map[string]interface{}{
+				"name":        "city 3",
+				"inhabitants": float64(3000),
+			},
+		}
+		require.Nil(t,
+			repo.PutThing(context.Background(), thing, []float32{1, 2, 3}))
+
+		job := waitForReindex(t, migrator)
+		assert.Equal(t, models.SchemaReindexStatusCompleted, job.Status)
+		assert.Equal(t, models.SchemaReindexOperationRenameProperty, job.Operation)
+		assert.Equal(t, "class_thing_reindexedcity", job.SourceIndex)
+		assert.Equal(t, "v2_class_thing_reindexedcity", job.TargetIndex)
+		assert.True(t, job.Meta.Count >= 3)
+
+		require.Nil(t, repo.forceRefresh(context.Background()))
+		for i, id := range append(ids, writtenDuringReindex) {
+			res, err := repo.ThingByID(context.Background(), id, nil, false)
+			require.Nil(t, err)
+			require.NotNil(t, res)
+			props := res.Schema.(map[string]interface{})
+			assert.Equal(t, float64(1000*i), props["inhabitants"])
+			assert.Nil(t, props["population"])
+			assert.Equal(t, []float32{1, 2, float32(i)}, res.Vector)
+		}
+
+		concrete, err := repo.aliasedIndex(context.Background(), "class_thing_reindexedcity")
+		require.Nil(t, err)
+		assert.Equal(t, "v2_class_thing_reindexedcity", concrete)
+	})
+
+	t.Run("dropping a property", func(t *testing.T) {
+		err := migrator.DropProperty(context.Background(), kind.Thing,
+			"ReindexedCity", "name")
+		require.Nil(t, err)
+		class.Properties = class.Properties[1:]
+
+		job := waitForReindex(t, migrator)
+		assert.Equal(t, models.SchemaReindexStatusCompleted, job.Status)
+		assert.Equal(t, "v2_class_thing_reindexedcity", job.SourceIndex)
+		assert.Equal(t, "v3_class_thing_reindexedcity", job.TargetIndex)
+
+		require.Nil(t, repo.forceRefresh(context.Background()))
+		res, err := repo.ThingByID(context.Background(), ids[1], nil, false)
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		props := res.Schema.(map[string]interface{})
+		assert.Nil(t, props["name"])
+		assert.Equal(t, float64(1000), props["inhabitants"])
+
+		ok, err := repo.indexExists(context.Background(), "v2_class_thing_reindexedcity")
+		require.Nil(t, err)
+		assert.False(t, ok, "source index must be deleted")
+	})
+
+	t.Run("renaming the class", func(t *testing.T) {
+		class.Class = "ReindexedTown"
+		newName := "ReindexedTown"
+		err := migrator.UpdateClass(context.Background(), kind.Thing,
+			"ReindexedCity", &newName, nil)
+		require.Nil(t, err)
+
+		job := waitForReindex(t, migrator)
+		assert.Equal(t, models.SchemaReindexStatusCompleted, job.Status)
+		assert.Equal(t, "v4_class_thing_reindexedtown", job.TargetIndex)
+
+		require.Nil(t, repo.forceRefresh(context.Background()))
+		res, err := repo.ThingByID(context.Background(), ids[2], nil, false)
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, "ReindexedTown", res.ClassName)
+
+		ok, err := repo.indexExists(context.Background(), "class_thing_reindexedcity")
+		require.Nil(t, err)
+		assert.False(t, ok, "the old class name must no longer exist")
+	})
+
+	t.Run("listing the jobs", func(t *testing.T) {
+		jobs := migrator.Reindexes()
+		require.Len(t, jobs, 3)
+		assert.Equal(t, jobs[2], migrator.Reindex(jobs[2].ID))
+	})
+
+	t.Run("dropping the reindexed class", func(t *testing.T) {
+		err := migrator.DropClass(context.Background(), kind.Thing, "ReindexedTown")
+		require.Nil(t, err)
+
+		ok, err := repo.indexExists(context.Background(), "v4_class_thing_reindexedtown")
+		require.Nil(t, err)
+		assert.False(t, ok)
+	})
+}
+
+// waitForReindex waits for the latest reindex job to finish
+func waitForReindex(t *testing.T, migrator *Migrator) *models.SchemaReindex {
+	deadline := time.Now().Add(30 * time.Second)
+	for time.Now().Before(deadline) {
+		jobs := migrator.Reindexes()
+		require.True(t, len(jobs) > 0)
+		job := jobs[len(jobs)-1]
+		if job.Status != models.SchemaReindexStatusRunning {
+			return job
+		}
+
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	t.Fatalf("reindex did not finish in time")
+	return nil
+}
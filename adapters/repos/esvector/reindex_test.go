@@ -0,0 +1,147 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package esvector
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndexVersions(t *testing.T) {
+	assert.Equal(t, 1, indexVersion("class_thing_city"))
+	assert.Equal(t, "v2_class_thing_city", versionedIndexName("class_thing_city", 2))
+	assert.Equal(t, 2, indexVersion("v2_class_thing_city"))
+	assert.Equal(t, 13, indexVersion(versionedIndexName("class_action_visit", 13)))
+}
+
+func TestReindexTransforms(t *testing.T) {
+	doc := func() map[string]interface{} {
+		return map[string]interface{}{
+			keyClassName.String(): "City",
+			"name":                "Amsterdam",
+			"population":          float64(800000),
+		}
+	}
+
+	t.Run("renaming the class", func(t *testing.T) {
+		d := doc()
+		renameClassTransform("Town")(d)
+		assert.Equal(t, "Town", d[keyClassName.String()])
+	})
+
+	t.Run("renaming a property", func(t *testing.T) {
+		d := doc()
+		renamePropertyTransform("population", "inhabitants")(d)
+		assert.Equal(t, map[string]interface{}{
+			keyClassName.String(): "City",
+			"name":                "Amsterdam",
+			"inhabitants":         float64(800000),
+		}, d)
+	})
+
+	t.Run("renaming a property the object doesn't have", func(t *testing.T) {
+		d := doc()
+		renamePropertyTransform("area", "size")(d)
+		assert.Equal(t, doc(), d)
+	})
+
+	t.Run("dropping a property", func(t *testing.T) {
+		d := doc()
+		dropPropertyTransform("name")(d)
+		assert.Equal(t, map[string]interface{}{
+			keyClassName.String(): "City",
+			"population":          float64(800000),
+		}, d)
+	})
+}
+
+func TestReindexes(t *testing.T) {
+	newJob := func(id strfmt.UUID, indices ...string) *reindexJob {
+		return &reindexJob{
+			status: models.SchemaReindex{
+				ID:     id,
+				Status: models.SchemaReindexStatusRunning,
+				Meta: &models.SchemaReindexMeta{
+					Started: strfmt.DateTime(time.Now()),
+				},
+			},
+			indices: indices,
+		}
+	}
+
+	r := newReindexes()
+	rename := newJob("7f0c8a5e-2f4d-4c3b-9a8e-1d2c3b4a5f01",
+		"class_thing_city", "class_thing_town")
+
+	t.Run("starting a job", func(t *testing.T) {
+		require.Nil(t, r.start(rename))
+		assert.Equal(t, rename, r.runningJob("class_thing_town"))
+	})
+
+	t.Run("starting another job on the same index", func(t *testing.T) {
+		other := newJob("7f0c8a5e-2f4d-4c3b-9a8e-1d2c3b4a5f02", "class_thing_city")
+		err := r.start(other)
+		require.NotNil(t, err)
+		assert.Equal(t, "index class_thing_city is still being reindexed by "+
+			"reindex 7f0c8a5e-2f4d-4c3b-9a8e-1d2c3b4a5f01", err.Error())
+	})
+
+	t.Run("recording writes under both class names", func(t *testing.T) {
+		done := r.write()
+		done(indexedDoc{index: "class_thing_city", id: "a"},
+			indexedDoc{index: "class_thing_town", id: "b"},
+			indexedDoc{index: "class_thing_country", id: "c"})
+
+		ids := r.takeDirty(rename)
+		sort.Strings(ids)
+		assert.Equal(t, []string{"a", "b"}, ids)
+		assert.Len(t, r.takeDirty(rename), 0, "writes are only returned once")
+	})
+
+	t.Run("counting copied objects", func(t *testing.T) {
+		r.addCount(rename, 3)
+		r.addCount(rename, 2)
+		assert.Equal(t, int64(5), r.get(rename.status.ID).Meta.Count)
+	})
+
+	t.Run("completing the job", func(t *testing.T) {
+		r.stop(rename, nil, false)
+		assert.Nil(t, r.runningJob("class_thing_city"))
+
+		status := r.get(rename.status.ID)
+		require.NotNil(t, status)
+		assert.Equal(t, models.SchemaReindexStatusCompleted, status.Status)
+	})
+
+	t.Run("a job which couldn't be started is forgotten", func(t *testing.T) {
+		job := newJob("7f0c8a5e-2f4d-4c3b-9a8e-1d2c3b4a5f03", "class_thing_city")
+		require.Nil(t, r.start(job))
+		r.stop(job, nil, true)
+
+		assert.Nil(t, r.get(job.status.ID))
+		assert.Len(t, r.list(), 1)
+	})
+
+	t.Run("the status returned is a copy", func(t *testing.T) {
+		status := r.get(rename.status.ID)
+		status.Meta.Count = 17
+		assert.Equal(t, int64(5), r.get(rename.status.ID).Meta.Count)
+	})
+}
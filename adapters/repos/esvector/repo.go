@@ -73,6 +73,7 @@ type Repo struct {
 	schemaRefFinder           schemaRefFinder
 	numberOfShards            int
 	autoExpandReplicas        string
+	reindexes                 *reindexes
 }
 
 type schemaRefFinder interface {
@@ -106,6 +107,7 @@ func NewRepo(client *elasticsearch.Client, logger logrus.FieldLogger,
 		schemaRefFinder:           &noopSchemaRefFinder{},
 		numberOfShards:            numberOfShards,
 		autoExpandReplicas:        autoExpandReplicas,
+		reindexes:                 newReindexes(),
 	}
 }
 
@@ -214,8 +216,12 @@ func (r *Repo) putObject(ctx context.Context,
 		return fmt.Errorf("index request: encode json: %v", err)
 	}
 
+	index := classIndexFromClassName(k, className)
+	done := r.reindexes.write()
+	defer done(indexedDoc{index: index, id: id})
+
 	req := esapi.IndexRequest{
-		Index:      classIndexFromClassName(k, className),
+		Index:      index,
 		DocumentID: id,
 		Body:       &buf,
 	}
@@ -241,8 +247,12 @@ func (r *Repo) putObject(ctx context.Context,
 }
 
 func (r *Repo) DeleteThing(ctx context.Context, className string, id strfmt.UUID) error {
+	index := classIndexFromClassName(kind.Thing, className)
+	done := r.reindexes.write()
+	defer done(indexedDoc{index: index, id: id.String()})
+
 	req := esapi.DeleteRequest{
-		Index:      classIndexFromClassName(kind.Thing, className),
+		Index:      index,
 		DocumentID: id.String(),
 	}
 
@@ -259,8 +269,12 @@ func (r *Repo) DeleteThing(ctx context.Context, className string, id strfmt.UUID
 }
 
 func (r *Repo) DeleteAction(ctx context.Context, className string, id strfmt.UUID) error {
+	index := classIndexFromClassName(kind.Action, className)
+	done := r.reindexes.write()
+	defer done(indexedDoc{index: index, id: id.String()})
+
 	req := esapi.DeleteRequest{
-		Index:      classIndexFromClassName(kind.Action, className),
+		Index:      index,
 		DocumentID: id.String(),
 	}
 
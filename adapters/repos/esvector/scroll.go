@@ -0,0 +1,222 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package esvector
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v5/esapi"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+)
+
+const (
+	// scrollBatchSize is the number of objects retrieved per scroll request
+	scrollBatchSize = 500
+
+	// scrollKeepAlive is how long es keeps the scroll context between two
+	// requests, it only needs to cover the time to process a single batch
+	scrollKeepAlive = time.Minute
+)
+
+// ScrollClass calls fn for every object of the class. The objects are read
+// in batches through a scroll, so the iteration sees a consistent view of the
+// class regardless of its size. References are not resolved. The iteration
+// stops at the first error returned by fn.
+func (r *Repo) ScrollClass(ctx context.Context, k kind.Kind, className string,
+	fn func(search.Result) error) error {
+	var buf bytes.Buffer
+	body := map[string]interface{}{
+		"query": map[string]interface{}{
+			"match_all": map[string]interface{}{},
+		},
+		"size": scrollBatchSize,
+		"sort": []interface{}{"_doc"},
+	}
+
+	err := json.NewEncoder(&buf).Encode(body)
+	if err != nil {
+		return fmt.Errorf("scroll class: encode json: %v", err)
+	}
+
+	res, err := r.client.Search(
+		r.client.Search.WithContext(ctx),
+		r.client.Search.WithIndex(classIndexFromClassName(k, className)),
+		r.client.Search.WithScroll(scrollKeepAlive),
+		r.client.Search.WithBody(&buf),
+	)
+	if err != nil {
+		return fmt.Errorf("scroll class: %v", err)
+	}
+
+	var scrollID string
+	defer func() {
+		if scrollID != "" {
+			r.clearScroll(scrollID)
+		}
+	}()
+
+	for {
+		var results []search.Result
+		results, scrollID, err = r.scrollResponse(ctx, res)
+		if err != nil {
+			return fmt.Errorf("scroll class: %v", err)
+		}
+
+		if len(results) == 0 {
+			return nil
+		}
+
+		for _, result := range results {
+			if err := fn(result); err != nil {
+				return err
+			}
+		}
+
+		res, err = r.client.Scroll(
+			r.client.Scroll.WithContext(ctx),
+			r.client.Scroll.WithScrollID(scrollID),
+			r.client.Scroll.WithScroll(scrollKeepAlive),
+		)
+		if err != nil {
+			return fmt.Errorf("scroll class: %v", err)
+		}
+	}
+}
+
+func (r *Repo) scrollResponse(ctx context.Context,
+	res *esapi.Response) ([]search.Result, string, error) {
+	defer res.Body.Close()
+	if err := errorResToErr(res, r.logger); err != nil {
+		return nil, "", err
+	}
+
+	var sr searchResponse
+	err := json.NewDecoder(res.Body).Decode(&sr)
+	if err != nil {
+		return nil, "", fmt.Errorf("decode json: %v", err)
+	}
+
+	requestCacher := newCacher(r)
+	err = requestCacher.build(ctx, sr, nil, false)
+	if err != nil {
+		return nil, "", fmt.Errorf("build request cache: %v", err)
+	}
+
+	results, err := sr.toResults(r, nil, false, requestCacher)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return results, sr.ScrollID, nil
+}
+
+// clearScroll releases the scroll context early. This is best effort, es
+// would release the context after the keep alive expires anyway.
+func (r *Repo) clearScroll(scrollID string) {
+	res, err := r.client.ClearScroll(r.client.ClearScroll.WithScrollID(scrollID))
+	if err == nil {
+		defer res.Body.Close()
+		err = errorResToErr(res, r.logger)
+	}
+
+	if err != nil {
+		r.logger.WithField("action", "esvector_clear_scroll").
+			WithError(err).Warn("could not clear scroll")
+	}
+}
+
+// ClassPage returns a page of the objects of the class which match the
+// (optional) filter. The objects are sorted by id, so that the next page can
+// be retrieved with the last id as the cursor. In contrast to ScrollClass the
+// objects include their meta, so they can be stored again without losing
+// e.g. the classification meta.
+func (r *Repo) ClassPage(ctx context.Context, k kind.Kind, className string,
+	filter *filters.LocalFilter, pagination *filters.Pagination) ([]search.Result, error) {
+	query := map[string]interface{}{
+		"match_all": map[string]interface{}{},
+	}
+	if filter != nil {
+		subquery, err := r.queryFromFilter(ctx, filter)
+		if err != nil {
+			if _, ok := err.(SubQueryNoResultsErr); ok {
+				// a sub-query found nothing, so nothing can match the filter
+				return nil, nil
+			}
+			return nil, fmt.Errorf("class page: build filter: %v", err)
+		}
+
+		query = subquery
+	}
+
+	body := map[string]interface{}{
+		"query": query,
+		"size":  pagination.Limit,
+		"sort": []interface{}{
+			map[string]interface{}{string(keyID): "asc"},
+		},
+	}
+	if pagination.After != "" {
+		body["search_after"] = []interface{}{pagination.After}
+	}
+
+	var buf bytes.Buffer
+	err := json.NewEncoder(&buf).Encode(body)
+	if err != nil {
+		return nil, fmt.Errorf("class page: encode json: %v", err)
+	}
+
+	res, err := r.client.Search(
+		r.client.Search.WithContext(ctx),
+		r.client.Search.WithIndex(classIndexFromClassName(k, className)),
+		r.client.Search.WithBody(&buf),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("class page: %v", err)
+	}
+
+	results, err := r.classPageResponse(ctx, res)
+	if err != nil {
+		return nil, fmt.Errorf("class page: %v", err)
+	}
+
+	return results, nil
+}
+
+func (r *Repo) classPageResponse(ctx context.Context,
+	res *esapi.Response) ([]search.Result, error) {
+	defer res.Body.Close()
+	if err := errorResToErr(res, r.logger); err != nil {
+		return nil, err
+	}
+
+	var sr searchResponse
+	err := json.NewDecoder(res.Body).Decode(&sr)
+	if err != nil {
+		return nil, fmt.Errorf("decode json: %v", err)
+	}
+
+	requestCacher := newCacher(r)
+	err = requestCacher.build(ctx, sr, nil, true)
+	if err != nil {
+		return nil, fmt.Errorf("build request cache: %v", err)
+	}
+
+	return sr.toResults(r, nil, true, requestCacher)
+}
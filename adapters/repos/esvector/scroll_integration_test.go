@@ -0,0 +1,131 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package esvector
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/elastic/go-elasticsearch/v5"
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScrollClass(t *testing.T) {
+	client, err := elasticsearch.NewClient(elasticsearch.Config{
+		Addresses: []string{"http://localhost:9201"},
+	})
+	require.Nil(t, err)
+
+	logger, _ := test.NewNullLogger()
+	schemaGetter := &fakeSchemaGetter{}
+	repo := NewRepo(client, logger, schemaGetter, 3, 100, 1, "0-1")
+	waitForEsToBeReady(t, repo)
+	migrator := NewMigrator(repo)
+
+	t.Run("creating the class", func(t *testing.T) {
+		class := &models.Class{
+			Class: "ScrollableThing",
+			Properties: []*models.Property{
+				&models.Property{
+					Name:     "name",
+					DataType: []string{string(schema.DataTypeString)},
+				},
+			},
+		}
+
+		require.Nil(t,
+			migrator.AddClass(context.Background(), kind.Thing, class))
+	})
+
+	ids := []strfmt.UUID{
+		"5f2b6a2c-2a6c-4f0e-9c7e-1b1d1f0c9a01",
+		"5f2b6a2c-2a6c-4f0e-9c7e-1b1d1f0c9a02",
+		"5f2b6a2c-2a6c-4f0e-9c7e-1b1d1f0c9a03",
+	}
+
+	t.Run("adding the things", func(t *testing.T) {
+		for i, id := range ids {
+			thing := &models.Thing{
+				ID:    id,
+				Class: "ScrollableThing",
+				Schema: map[string]interface{}{
+					"name": fmt.Sprintf("thing %d", i),
+				},
+			}
+
+			require.Nil(t,
+				repo.PutThing(context.Background(), thing, []float32{1, 2, float32(i)}))
+		}
+
+		require.Nil(t, repo.forceRefresh(context.Background()))
+	})
+
+	t.Run("scrolling through the class", func(t *testing.T) {
+		found := map[strfmt.UUID]search.Result{}
+		err := repo.ScrollClass(context.Background(), kind.Thing, "ScrollableThing",
+			func(res search.Result) error {
+				found[res.ID] = res
+				return nil
+			})
+		require.Nil(t, err)
+
+		require.Len(t, found, 3)
+		for i, id := range ids {
+			assert.Equal(t, []float32{1, 2, float32(i)}, found[id].Vector)
+			assert.Equal(t, fmt.Sprintf("thing %d", i),
+				found[id].Schema.(map[string]interface{})["name"])
+		}
+	})
+
+	t.Run("an error stops the iteration", func(t *testing.T) {
+		calls := 0
+		err := repo.ScrollClass(context.Background(), kind.Thing, "ScrollableThing",
+			func(res search.Result) error {
+				calls++
+				return fmt.Errorf("write failed")
+			})
+		assert.Equal(t, 1, calls)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "write failed")
+	})
+
+	t.Run("paging through the class", func(t *testing.T) {
+		page, err := repo.ClassPage(context.Background(), kind.Thing, "ScrollableThing",
+			nil, &filters.Pagination{Limit: 2})
+		require.Nil(t, err)
+		require.Len(t, page, 2)
+		assert.Equal(t, ids[0], page[0].ID)
+		assert.Equal(t, ids[1], page[1].ID)
+		require.NotNil(t, page[0].Meta)
+		assert.Equal(t, models.C11yVector{1, 2, 0}, page[0].Meta.Vector)
+
+		page, err = repo.ClassPage(context.Background(), kind.Thing, "ScrollableThing",
+			nil, &filters.Pagination{Limit: 2, After: ids[1]})
+		require.Nil(t, err)
+		require.Len(t, page, 1)
+		assert.Equal(t, ids[2], page[0].ID)
+	})
+}
@@ -33,15 +33,17 @@ import (
 )
 
 // ThingSearch searches for all things with optional filters without vector scoring
-func (r *Repo) ThingSearch(ctx context.Context, limit int,
-	filters *filters.LocalFilter, meta bool) (search.Results, error) {
-	return r.search(ctx, allThingIndices, nil, limit, filters, traverser.GetParams{}, meta)
+func (r *Repo) ThingSearch(ctx context.Context, pagination *filters.Pagination,
+	sort []filters.Sort, filters *filters.LocalFilter, meta bool) (search.Results, error) {
+	return r.search(ctx, allThingIndices, nil, pagination, filters,
+		traverser.GetParams{Sort: sort}, meta)
 }
 
 // ActionSearch searches for all things with optional filters without vector scoring
-func (r *Repo) ActionSearch(ctx context.Context, limit int,
-	filters *filters.LocalFilter, meta bool) (search.Results, error) {
-	return r.search(ctx, allActionIndices, nil, limit, filters, traverser.GetParams{}, meta)
+func (r *Repo) ActionSearch(ctx context.Context, pagination *filters.Pagination,
+	sort []filters.Sort, filters *filters.LocalFilter, meta bool) (search.Results, error) {
+	return r.search(ctx, allActionIndices, nil, pagination, filters,
+		traverser.GetParams{Sort: sort}, meta)
 }
 
 // ThingByID extracts the one result matching the ID. Returns nil on no results
@@ -112,6 +114,7 @@ func (r *Repo) byIndexAndID(ctx context.Context, index string, id strfmt.UUID,
 
 func (r *Repo) searchByID(ctx context.Context, index string, id strfmt.UUID,
 	properties traverser.SelectProperties, meta bool) (*search.Result, error) {
+	pagination := &filters.Pagination{Limit: 2}
 	filters := &filters.LocalFilter{
 		Root: &filters.Clause{
 			On:       &filters.Path{Property: schema.PropertyName(keyID)},
@@ -119,7 +122,7 @@ func (r *Repo) searchByID(ctx context.Context, index string, id strfmt.UUID,
 			Operator: filters.OperatorEqual,
 		},
 	}
-	res, err := r.search(ctx, index, nil, 2, filters, traverser.GetParams{Properties: properties}, meta)
+	res, err := r.search(ctx, index, nil, pagination, filters, traverser.GetParams{Properties: properties}, meta)
 	if err != nil {
 		return nil, err
 	}
@@ -161,7 +164,7 @@ func (r *Repo) ClassSearch(ctx context.Context, params traverser.GetParams) ([]s
 	start := time.Now()
 	r.requestCounter = &counterImpl{}
 	index := classIndexFromClassName(params.Kind, params.ClassName)
-	res, err := r.search(ctx, index, nil, params.Pagination.Limit, params.Filters, params, false)
+	res, err := r.search(ctx, index, nil, params.Pagination, params.Filters, params, false)
 	count := r.requestCounter.(*counterImpl).Get()
 	r.logger.WithFields(logrus.Fields{
 		"action":        "esvector_class_search",
@@ -180,7 +183,7 @@ func (r *Repo) VectorClassSearch(ctx context.Context, params traverser.GetParams
 	start := time.Now()
 	r.requestCounter = &counterImpl{}
 	index := classIndexFromClassName(params.Kind, params.ClassName)
-	res, err := r.search(ctx, index, params.SearchVector, params.Pagination.Limit, params.Filters, params, false)
+	res, err := r.search(ctx, index, params.SearchVector, params.Pagination, params.Filters, params, false)
 	count := r.requestCounter.(*counterImpl).Get()
 	r.logger.WithFields(logrus.Fields{
 		"action":        "esvector_vector_class_search",
@@ -191,14 +194,34 @@ func (r *Repo) VectorClassSearch(ctx context.Context, params traverser.GetParams
 	return res, err
 }
 
+// KeywordClassSearch ranks the objects of the class by the BM25 relevance of
+// the query
+func (r *Repo) KeywordClassSearch(ctx context.Context, params traverser.GetParams) ([]search.Result, error) {
+	ctx, cancel := limitUnlimitedContext(ctx)
+	defer cancel()
+
+	start := time.Now()
+	r.requestCounter = &counterImpl{}
+	index := classIndexFromClassName(params.Kind, params.ClassName)
+	res, err := r.search(ctx, index, nil, params.Pagination, params.Filters, params, false)
+	count := r.requestCounter.(*counterImpl).Get()
+	r.logger.WithFields(logrus.Fields{
+		"action":        "esvector_keyword_class_search",
+		"request_count": count,
+		"took":          time.Since(start),
+	}).Debug("completed keyword class search")
+
+	return res, err
+}
+
 // VectorSearch retrives the closest concepts by vector distance
 func (r *Repo) VectorSearch(ctx context.Context, vector []float32,
 	limit int, filters *filters.LocalFilter) ([]search.Result, error) {
-	return r.search(ctx, "*", vector, limit, filters, traverser.GetParams{}, false)
+	return r.search(ctx, "*", vector, paginationWithLimit(limit), filters, traverser.GetParams{}, false)
 }
 
 func (r *Repo) search(ctx context.Context, index string,
-	vector []float32, limit int,
+	vector []float32, pagination *filters.Pagination,
 	filters *filters.LocalFilter, params traverser.GetParams, meta bool) ([]search.Result, error) {
 
 	r.logger.
@@ -223,7 +246,11 @@ func (r *Repo) search(ctx context.Context, index string,
 		return nil, err
 	}
 
-	body := r.buildSearchBody(query, vector, limit)
+	if params.KeywordRanking != nil {
+		query = keywordRankingQuery(query, params.KeywordRanking)
+	}
+
+	body := r.buildSearchBody(query, vector, pagination, params)
 
 	err = json.NewEncoder(&buf).Encode(body)
 	if err != nil {
@@ -242,7 +269,8 @@ func (r *Repo) search(ctx context.Context, index string,
 	return r.searchResponse(ctx, res, params.Properties, meta)
 }
 
-func (r *Repo) buildSearchBody(filterQuery map[string]interface{}, vector []float32, limit int) map[string]interface{} {
+func (r *Repo) buildSearchBody(filterQuery map[string]interface{}, vector []float32,
+	pagination *filters.Pagination, params traverser.GetParams) map[string]interface{} {
 	var query map[string]interface{}
 
 	if vector == nil {
@@ -271,14 +299,87 @@ func (r *Repo) buildSearchBody(filterQuery map[string]interface{}, vector []floa
 		}
 	}
 
-	return map[string]interface{}{
+	body := map[string]interface{}{
 		"query": query,
-		"size":  limit,
+		"size":  pagination.Limit,
+	}
+
+	if pagination.Offset > 0 {
+		body["from"] = pagination.Offset
 	}
+
+	if vector == nil && params.KeywordRanking == nil {
+		// without vector or keyword scoring there is no natural order, sort by
+		// id so that pages are stable and a cursor can be used to continue
+		// from. A user-specified sort takes precedence, the id is the tie
+		// breaker.
+		body["sort"] = append(sortClauses(params.Sort),
+			map[string]interface{}{string(keyID): "asc"})
+
+		if pagination.After != "" {
+			body["search_after"] = []interface{}{pagination.After}
+		}
+	}
+
+	return body
+}
+
+// keywordRankingQuery scores the objects matching the filters by the BM25
+// relevance of the query, which is the default similarity of es. The query is
+// split on whitespace only, so that each term is analyzed by the analyzer of
+// the field: text is tokenized, whereas a string (keyword) needs to match a
+// term exactly. Operators are disabled as the query is a plain search term.
+func keywordRankingQuery(filterQuery map[string]interface{},
+	params *traverser.KeywordRankingParams) map[string]interface{} {
+	return map[string]interface{}{
+		"bool": map[string]interface{}{
+			"must": map[string]interface{}{
+				"simple_query_string": map[string]interface{}{
+					"query":            params.Query,
+					"fields":           params.Properties,
+					"flags":            "WHITESPACE",
+					"default_operator": "or",
+				},
+			},
+			"filter": filterQuery,
+		},
+	}
+}
+
+// sortClauses translates the sort into es sort clauses. Missing values come
+// last regardless of the order. As a property does not need to be present in
+// all searched indices, unmapped fields are treated as missing values instead
+// of failing the search.
+func sortClauses(sort []filters.Sort) []interface{} {
+	out := make([]interface{}, len(sort))
+	for i, s := range sort {
+		field := s.Property()
+		switch field {
+		case filters.SortPropertyCreated:
+			field = keyCreated.String()
+		case filters.SortPropertyUpdated:
+			field = keyUpdated.String()
+		}
+
+		out[i] = map[string]interface{}{
+			field: map[string]interface{}{
+				"order":         s.Order,
+				"missing":       "_last",
+				"unmapped_type": Keyword,
+			},
+		}
+	}
+
+	return out
+}
+
+func paginationWithLimit(limit int) *filters.Pagination {
+	return &filters.Pagination{Limit: limit}
 }
 
 type searchResponse struct {
-	Hits struct {
+	ScrollID string `json:"_scroll_id"`
+	Hits     struct {
 		Hits []hit `json:"hits"`
 	} `json:"hits"`
 	Aggregations aggregations `json:"aggregations"`
@@ -50,6 +50,7 @@ type cacher struct {
 	repo   *Repo
 	store  map[storageIdentifier]search.Result
 	meta   *bool // meta is immutable for the lifetime of the request cacher, so we can safely store it
+	level  int   // level of the current nested lookup, only used for metrics
 }
 
 func (c *cacher) get(si storageIdentifier) (search.Result, bool) {
@@ -317,6 +318,8 @@ func (c *cacher) fetchJobs(ctx context.Context) error {
 	}
 
 	c.repo.requestCounter.Inc()
+	c.level++
+	ctx = withLookupLevel(ctx, c.level)
 	body := jobListToMgetBody(jobs)
 
 	var buf bytes.Buffer
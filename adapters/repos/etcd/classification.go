@@ -78,6 +78,27 @@ func (r *ClassificationRepo) Get(ctx context.Context, id strfmt.UUID) (*models.C
 	}
 }
 
+// List all classifications which have been stored
+func (r *ClassificationRepo) List(ctx context.Context) ([]models.Classification, error) {
+	res, err := r.client.Get(ctx, ClassificationStorageKey+"/", clientv3.WithPrefix())
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve prefix '%s' from etcd: %v",
+			ClassificationStorageKey, err)
+	}
+
+	out := make([]models.Classification, len(res.Kvs))
+	for i, kv := range res.Kvs {
+		classification, err := r.unmarshalClassification(kv.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		out[i] = *classification
+	}
+
+	return out, nil
+}
+
 func (r *ClassificationRepo) unmarshalClassification(bytes []byte) (*models.Classification, error) {
 	var class models.Classification
 	err := json.Unmarshal(bytes, &class)
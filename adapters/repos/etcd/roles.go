@@ -0,0 +1,88 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package etcd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/semi-technologies/weaviate/entities/models"
+)
+
+// RoleStorageKey is the etcd key prefix used to store the roles
+const RoleStorageKey = "/weaviate/roles"
+
+func roleKeyFromName(name string) string {
+	return fmt.Sprintf("%s/%s", RoleStorageKey, name)
+}
+
+// RoleRepo is an etcd-based Repo to persist the roles managed through the api
+type RoleRepo struct {
+	client *clientv3.Client
+}
+
+// NewRoleRepo based on etcd
+func NewRoleRepo(client *clientv3.Client) *RoleRepo {
+	return &RoleRepo{
+		client: client,
+	}
+}
+
+// Put role in remote repository
+func (r *RoleRepo) Put(ctx context.Context, role *models.Role) error {
+	roleBytes, err := json.Marshal(role)
+	if err != nil {
+		return fmt.Errorf("could not marshal role to json: %s", err)
+	}
+
+	_, err = r.client.Put(ctx, roleKeyFromName(role.Name), string(roleBytes))
+	if err != nil {
+		return fmt.Errorf("could not store role in etcd: %s", err)
+	}
+
+	return nil
+}
+
+// Delete role from remote repository
+func (r *RoleRepo) Delete(ctx context.Context, name string) error {
+	_, err := r.client.Delete(ctx, roleKeyFromName(name))
+	if err != nil {
+		return fmt.Errorf("could not delete role from etcd: %s", err)
+	}
+
+	return nil
+}
+
+// List all roles which have been stored
+func (r *RoleRepo) List(ctx context.Context) ([]*models.Role, error) {
+	res, err := r.client.Get(ctx, RoleStorageKey+"/", clientv3.WithPrefix())
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve prefix '%s' from etcd: %v",
+			RoleStorageKey, err)
+	}
+
+	out := make([]*models.Role, len(res.Kvs))
+	for i, kv := range res.Kvs {
+		var role models.Role
+		if err := json.Unmarshal(kv.Value, &role); err != nil {
+			return nil, fmt.Errorf("could not parse the role: %s", err)
+		}
+
+		out[i] = &role
+	}
+
+	return out, nil
+}
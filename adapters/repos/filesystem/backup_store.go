@@ -0,0 +1,100 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package filesystem
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// BackupStore keeps every backup in its own directory below the configured
+// path, each file of a backup is a file in this directory
+type BackupStore struct {
+	path string
+}
+
+// NewBackupStore below path, the directory is created on the first write
+func NewBackupStore(path string) *BackupStore {
+	return &BackupStore{path: path}
+}
+
+// Writer for the file of the backup. The content is written to a temporary
+// file which only replaces the actual file once the writer is closed, so
+// readers never see a partially written file.
+func (s *BackupStore) Writer(id, file string) (io.WriteCloser, error) {
+	dir, err := s.dir(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("create backup directory: %v", err)
+	}
+
+	tmp, err := ioutil.TempFile(dir, file+".tmp")
+	if err != nil {
+		return nil, fmt.Errorf("create file: %v", err)
+	}
+
+	return &atomicFile{File: tmp, target: filepath.Join(dir, file)}, nil
+}
+
+// Reader for the file of the backup, nil if it does not exist
+func (s *BackupStore) Reader(id, file string) (io.ReadCloser, error) {
+	dir, err := s.dir(id)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(filepath.Join(dir, file))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("open file: %v", err)
+	}
+
+	return f, nil
+}
+
+// dir of the backup, the id must not point outside of the store's path
+func (s *BackupStore) dir(id string) (string, error) {
+	if id == "" || id != filepath.Base(id) || id == "." || id == ".." {
+		return "", fmt.Errorf("invalid backup id '%s'", id)
+	}
+
+	return filepath.Join(s.path, id), nil
+}
+
+type atomicFile struct {
+	*os.File
+	target string
+}
+
+func (f *atomicFile) Close() error {
+	if err := f.File.Close(); err != nil {
+		os.Remove(f.File.Name())
+		return fmt.Errorf("close file: %v", err)
+	}
+
+	if err := os.Rename(f.File.Name(), f.target); err != nil {
+		os.Remove(f.File.Name())
+		return fmt.Errorf("rename file: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package filesystem
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackupStore(t *testing.T) {
+	path, err := ioutil.TempDir("", "backups")
+	require.Nil(t, err)
+	defer os.RemoveAll(path)
+
+	store := NewBackupStore(path)
+
+	t.Run("reading a file which does not exist", func(t *testing.T) {
+		r, err := store.Reader("my-backup", "backup.json")
+		require.Nil(t, err)
+		assert.Nil(t, r)
+	})
+
+	t.Run("a file is only visible once it is closed", func(t *testing.T) {
+		w, err := store.Writer("my-backup", "backup.json")
+		require.Nil(t, err)
+
+		_, err = w.Write([]byte(`{"id":"my-backup"}`))
+		require.Nil(t, err)
+
+		r, err := store.Reader("my-backup", "backup.json")
+		require.Nil(t, err)
+		assert.Nil(t, r)
+
+		require.Nil(t, w.Close())
+
+		r, err = store.Reader("my-backup", "backup.json")
+		require.Nil(t, err)
+		require.NotNil(t, r)
+		defer r.Close()
+
+		content, err := ioutil.ReadAll(r)
+		require.Nil(t, err)
+		assert.Equal(t, `{"id":"my-backup"}`, string(content))
+	})
+
+	t.Run("rewriting a file replaces it", func(t *testing.T) {
+		w, err := store.Writer("my-backup", "backup.json")
+		require.Nil(t, err)
+		_, err = w.Write([]byte(`{}`))
+		require.Nil(t, err)
+		require.Nil(t, w.Close())
+
+		content, err := ioutil.ReadFile(filepath.Join(path, "my-backup", "backup.json"))
+		require.Nil(t, err)
+		assert.Equal(t, `{}`, string(content))
+
+		files, err := ioutil.ReadDir(filepath.Join(path, "my-backup"))
+		require.Nil(t, err)
+		assert.Len(t, files, 1, "no temporary files should be left over")
+	})
+
+	t.Run("ids outside of the store are rejected", func(t *testing.T) {
+		_, err := store.Writer("../elsewhere", "backup.json")
+		assert.NotNil(t, err)
+
+		_, err = store.Reader("..", "backup.json")
+		assert.NotNil(t, err)
+	})
+}
@@ -113,6 +113,43 @@ func (a *Client) ActionsDelete(params *ActionsDeleteParams, authInfo runtime.Cli
 	panic(msg)
 }
 
+/*
+ActionsExport exports all actions of a class
+
+Streams all Actions of a class as newline-delimited JSON, one Action per line. Each line contains the schema, the timestamps, the vector weights and the stored vector of the Action, so that the export can be imported again as-is through the batch endpoint.
+*/
+func (a *Client) ActionsExport(params *ActionsExportParams, authInfo runtime.ClientAuthInfoWriter) (*ActionsExportOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewActionsExportParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "actions.export",
+		Method:             "GET",
+		PathPattern:        "/actions/export",
+		ProducesMediaTypes: []string{"application/x-ndjson"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ActionsExportReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ActionsExportOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for actions.export: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 ActionsGet gets a specific action based on its UUID and a thing UUID also available as websocket bus
 
@@ -61,6 +61,12 @@ func (o *ActionsDeleteReader) ReadResponse(response runtime.ClientResponse, cons
 			return nil, err
 		}
 		return nil, result
+	case 409:
+		result := NewActionsDeleteConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewActionsDeleteInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -169,6 +175,39 @@ func (o *ActionsDeleteNotFound) readResponse(response runtime.ClientResponse, co
 	return nil
 }
 
+// NewActionsDeleteConflict creates a ActionsDeleteConflict with default headers values
+func NewActionsDeleteConflict() *ActionsDeleteConflict {
+	return &ActionsDeleteConflict{}
+}
+
+/*ActionsDeleteConflict handles this case with default header values.
+
+The object is still referenced through a reference property with the onDelete policy 'restrict'.
+*/
+type ActionsDeleteConflict struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *ActionsDeleteConflict) Error() string {
+	return fmt.Sprintf("[DELETE /actions/{id}][%d] actionsDeleteConflict  %+v", 409, o.Payload)
+}
+
+func (o *ActionsDeleteConflict) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ActionsDeleteConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewActionsDeleteInternalServerError creates a ActionsDeleteInternalServerError with default headers values
 func NewActionsDeleteInternalServerError() *ActionsDeleteInternalServerError {
 	return &ActionsDeleteInternalServerError{}
@@ -0,0 +1,153 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package actions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewActionsExportParams creates a new ActionsExportParams object
+// with the default values initialized.
+func NewActionsExportParams() *ActionsExportParams {
+	var ()
+	return &ActionsExportParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewActionsExportParamsWithTimeout creates a new ActionsExportParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewActionsExportParamsWithTimeout(timeout time.Duration) *ActionsExportParams {
+	var ()
+	return &ActionsExportParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewActionsExportParamsWithContext creates a new ActionsExportParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewActionsExportParamsWithContext(ctx context.Context) *ActionsExportParams {
+	var ()
+	return &ActionsExportParams{
+
+		Context: ctx,
+	}
+}
+
+// NewActionsExportParamsWithHTTPClient creates a new ActionsExportParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewActionsExportParamsWithHTTPClient(client *http.Client) *ActionsExportParams {
+	var ()
+	return &ActionsExportParams{
+		HTTPClient: client,
+	}
+}
+
+/*ActionsExportParams contains all the parameters to send to the API endpoint
+for the actions export operation typically these are written to a http.Request
+*/
+type ActionsExportParams struct {
+
+	/*Class
+	  The name of the class to export.
+
+	*/
+	Class string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the actions export params
+func (o *ActionsExportParams) WithTimeout(timeout time.Duration) *ActionsExportParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the actions export params
+func (o *ActionsExportParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the actions export params
+func (o *ActionsExportParams) WithContext(ctx context.Context) *ActionsExportParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the actions export params
+func (o *ActionsExportParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the actions export params
+func (o *ActionsExportParams) WithHTTPClient(client *http.Client) *ActionsExportParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the actions export params
+func (o *ActionsExportParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClass adds the class to the actions export params
+func (o *ActionsExportParams) WithClass(class string) *ActionsExportParams {
+	o.SetClass(class)
+	return o
+}
+
+// SetClass adds the class to the actions export params
+func (o *ActionsExportParams) SetClass(class string) {
+	o.Class = class
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ActionsExportParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// query param class
+	qrClass := o.Class
+	qClass := qrClass
+	if qClass != "" {
+		if err := r.SetQueryParam("class", qClass); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,215 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package actions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// ActionsExportReader is a Reader for the ActionsExport structure.
+type ActionsExportReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ActionsExportReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewActionsExportOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewActionsExportUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewActionsExportForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewActionsExportNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewActionsExportInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewActionsExportOK creates a ActionsExportOK with default headers values
+func NewActionsExportOK() *ActionsExportOK {
+	return &ActionsExportOK{}
+}
+
+/*ActionsExportOK handles this case with default header values.
+
+Successful response, the body contains one Action per line.
+*/
+type ActionsExportOK struct {
+}
+
+func (o *ActionsExportOK) Error() string {
+	return fmt.Sprintf("[GET /actions/export][%d] actionsExportOK ", 200)
+}
+
+func (o *ActionsExportOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewActionsExportUnauthorized creates a ActionsExportUnauthorized with default headers values
+func NewActionsExportUnauthorized() *ActionsExportUnauthorized {
+	return &ActionsExportUnauthorized{}
+}
+
+/*ActionsExportUnauthorized handles this case with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type ActionsExportUnauthorized struct {
+}
+
+func (o *ActionsExportUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /actions/export][%d] actionsExportUnauthorized ", 401)
+}
+
+func (o *ActionsExportUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewActionsExportForbidden creates a ActionsExportForbidden with default headers values
+func NewActionsExportForbidden() *ActionsExportForbidden {
+	return &ActionsExportForbidden{}
+}
+
+/*ActionsExportForbidden handles this case with default header values.
+
+Forbidden
+*/
+type ActionsExportForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *ActionsExportForbidden) Error() string {
+	return fmt.Sprintf("[GET /actions/export][%d] actionsExportForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ActionsExportForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ActionsExportForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewActionsExportNotFound creates a ActionsExportNotFound with default headers values
+func NewActionsExportNotFound() *ActionsExportNotFound {
+	return &ActionsExportNotFound{}
+}
+
+/*ActionsExportNotFound handles this case with default header values.
+
+There is no Action class with this name.
+*/
+type ActionsExportNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *ActionsExportNotFound) Error() string {
+	return fmt.Sprintf("[GET /actions/export][%d] actionsExportNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ActionsExportNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ActionsExportNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewActionsExportInternalServerError creates a ActionsExportInternalServerError with default headers values
+func NewActionsExportInternalServerError() *ActionsExportInternalServerError {
+	return &ActionsExportInternalServerError{}
+}
+
+/*ActionsExportInternalServerError handles this case with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type ActionsExportInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *ActionsExportInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /actions/export][%d] actionsExportInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ActionsExportInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ActionsExportInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -61,6 +61,12 @@ func (o *ActionsListReader) ReadResponse(response runtime.ClientResponse, consum
 			return nil, err
 		}
 		return nil, result
+	case 422:
+		result := NewActionsListUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewActionsListInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -181,6 +187,39 @@ func (o *ActionsListNotFound) readResponse(response runtime.ClientResponse, cons
 	return nil
 }
 
+// NewActionsListUnprocessableEntity creates a ActionsListUnprocessableEntity with default headers values
+func NewActionsListUnprocessableEntity() *ActionsListUnprocessableEntity {
+	return &ActionsListUnprocessableEntity{}
+}
+
+/*ActionsListUnprocessableEntity handles this case with default header values.
+
+Invalid paging parameters.
+*/
+type ActionsListUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *ActionsListUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[GET /actions][%d] actionsListUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ActionsListUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ActionsListUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewActionsListInternalServerError creates a ActionsListInternalServerError with default headers values
 func NewActionsListInternalServerError() *ActionsListInternalServerError {
 	return &ActionsListInternalServerError{}
@@ -0,0 +1,149 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package backups
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewBackupsGetParams creates a new BackupsGetParams object
+// with the default values initialized.
+func NewBackupsGetParams() *BackupsGetParams {
+	var ()
+	return &BackupsGetParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewBackupsGetParamsWithTimeout creates a new BackupsGetParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewBackupsGetParamsWithTimeout(timeout time.Duration) *BackupsGetParams {
+	var ()
+	return &BackupsGetParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewBackupsGetParamsWithContext creates a new BackupsGetParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewBackupsGetParamsWithContext(ctx context.Context) *BackupsGetParams {
+	var ()
+	return &BackupsGetParams{
+
+		Context: ctx,
+	}
+}
+
+// NewBackupsGetParamsWithHTTPClient creates a new BackupsGetParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewBackupsGetParamsWithHTTPClient(client *http.Client) *BackupsGetParams {
+	var ()
+	return &BackupsGetParams{
+		HTTPClient: client,
+	}
+}
+
+/*BackupsGetParams contains all the parameters to send to the API endpoint
+for the backups get operation typically these are written to a http.Request
+*/
+type BackupsGetParams struct {
+
+	/*ID
+	  backup id
+
+	*/
+	ID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the backups get params
+func (o *BackupsGetParams) WithTimeout(timeout time.Duration) *BackupsGetParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the backups get params
+func (o *BackupsGetParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the backups get params
+func (o *BackupsGetParams) WithContext(ctx context.Context) *BackupsGetParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the backups get params
+func (o *BackupsGetParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the backups get params
+func (o *BackupsGetParams) WithHTTPClient(client *http.Client) *BackupsGetParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the backups get params
+func (o *BackupsGetParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the backups get params
+func (o *BackupsGetParams) WithID(id string) *BackupsGetParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the backups get params
+func (o *BackupsGetParams) SetID(id string) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *BackupsGetParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
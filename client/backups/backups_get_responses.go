@@ -0,0 +1,215 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package backups
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// BackupsGetReader is a Reader for the BackupsGet structure.
+type BackupsGetReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *BackupsGetReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewBackupsGetOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewBackupsGetUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewBackupsGetForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewBackupsGetNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewBackupsGetInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewBackupsGetOK creates a BackupsGetOK with default headers values
+func NewBackupsGetOK() *BackupsGetOK {
+	return &BackupsGetOK{}
+}
+
+/*BackupsGetOK handles this case with default header values.
+
+Found the backup, returned as body
+*/
+type BackupsGetOK struct {
+	Payload *models.Backup
+}
+
+func (o *BackupsGetOK) Error() string {
+	return fmt.Sprintf("[GET /backups/{id}][%d] backupsGetOK  %+v", 200, o.Payload)
+}
+
+func (o *BackupsGetOK) GetPayload() *models.Backup {
+	return o.Payload
+}
+
+func (o *BackupsGetOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Backup)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewBackupsGetUnauthorized creates a BackupsGetUnauthorized with default headers values
+func NewBackupsGetUnauthorized() *BackupsGetUnauthorized {
+	return &BackupsGetUnauthorized{}
+}
+
+/*BackupsGetUnauthorized handles this case with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type BackupsGetUnauthorized struct {
+}
+
+func (o *BackupsGetUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /backups/{id}][%d] backupsGetUnauthorized ", 401)
+}
+
+func (o *BackupsGetUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewBackupsGetForbidden creates a BackupsGetForbidden with default headers values
+func NewBackupsGetForbidden() *BackupsGetForbidden {
+	return &BackupsGetForbidden{}
+}
+
+/*BackupsGetForbidden handles this case with default header values.
+
+Forbidden
+*/
+type BackupsGetForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *BackupsGetForbidden) Error() string {
+	return fmt.Sprintf("[GET /backups/{id}][%d] backupsGetForbidden  %+v", 403, o.Payload)
+}
+
+func (o *BackupsGetForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BackupsGetForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewBackupsGetNotFound creates a BackupsGetNotFound with default headers values
+func NewBackupsGetNotFound() *BackupsGetNotFound {
+	return &BackupsGetNotFound{}
+}
+
+/*BackupsGetNotFound handles this case with default header values.
+
+Not Found - Backup does not exist
+*/
+type BackupsGetNotFound struct {
+}
+
+func (o *BackupsGetNotFound) Error() string {
+	return fmt.Sprintf("[GET /backups/{id}][%d] backupsGetNotFound ", 404)
+}
+
+func (o *BackupsGetNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewBackupsGetInternalServerError creates a BackupsGetInternalServerError with default headers values
+func NewBackupsGetInternalServerError() *BackupsGetInternalServerError {
+	return &BackupsGetInternalServerError{}
+}
+
+/*BackupsGetInternalServerError handles this case with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type BackupsGetInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *BackupsGetInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /backups/{id}][%d] backupsGetInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *BackupsGetInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BackupsGetInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package backups
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewBackupsRestoreGetParams creates a new BackupsRestoreGetParams object
+// with the default values initialized.
+func NewBackupsRestoreGetParams() *BackupsRestoreGetParams {
+	var ()
+	return &BackupsRestoreGetParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewBackupsRestoreGetParamsWithTimeout creates a new BackupsRestoreGetParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewBackupsRestoreGetParamsWithTimeout(timeout time.Duration) *BackupsRestoreGetParams {
+	var ()
+	return &BackupsRestoreGetParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewBackupsRestoreGetParamsWithContext creates a new BackupsRestoreGetParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewBackupsRestoreGetParamsWithContext(ctx context.Context) *BackupsRestoreGetParams {
+	var ()
+	return &BackupsRestoreGetParams{
+
+		Context: ctx,
+	}
+}
+
+// NewBackupsRestoreGetParamsWithHTTPClient creates a new BackupsRestoreGetParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewBackupsRestoreGetParamsWithHTTPClient(client *http.Client) *BackupsRestoreGetParams {
+	var ()
+	return &BackupsRestoreGetParams{
+		HTTPClient: client,
+	}
+}
+
+/*BackupsRestoreGetParams contains all the parameters to send to the API endpoint
+for the backups restore get operation typically these are written to a http.Request
+*/
+type BackupsRestoreGetParams struct {
+
+	/*ID
+	  backup id
+
+	*/
+	ID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the backups restore get params
+func (o *BackupsRestoreGetParams) WithTimeout(timeout time.Duration) *BackupsRestoreGetParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the backups restore get params
+func (o *BackupsRestoreGetParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the backups restore get params
+func (o *BackupsRestoreGetParams) WithContext(ctx context.Context) *BackupsRestoreGetParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the backups restore get params
+func (o *BackupsRestoreGetParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the backups restore get params
+func (o *BackupsRestoreGetParams) WithHTTPClient(client *http.Client) *BackupsRestoreGetParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the backups restore get params
+func (o *BackupsRestoreGetParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the backups restore get params
+func (o *BackupsRestoreGetParams) WithID(id string) *BackupsRestoreGetParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the backups restore get params
+func (o *BackupsRestoreGetParams) SetID(id string) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *BackupsRestoreGetParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
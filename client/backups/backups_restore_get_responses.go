@@ -0,0 +1,215 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package backups
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// BackupsRestoreGetReader is a Reader for the BackupsRestoreGet structure.
+type BackupsRestoreGetReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *BackupsRestoreGetReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewBackupsRestoreGetOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewBackupsRestoreGetUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewBackupsRestoreGetForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewBackupsRestoreGetNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewBackupsRestoreGetInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewBackupsRestoreGetOK creates a BackupsRestoreGetOK with default headers values
+func NewBackupsRestoreGetOK() *BackupsRestoreGetOK {
+	return &BackupsRestoreGetOK{}
+}
+
+/*BackupsRestoreGetOK handles this case with default header values.
+
+Found the restore, returned as body
+*/
+type BackupsRestoreGetOK struct {
+	Payload *models.BackupRestore
+}
+
+func (o *BackupsRestoreGetOK) Error() string {
+	return fmt.Sprintf("[GET /backups/{id}/restore][%d] backupsRestoreGetOK  %+v", 200, o.Payload)
+}
+
+func (o *BackupsRestoreGetOK) GetPayload() *models.BackupRestore {
+	return o.Payload
+}
+
+func (o *BackupsRestoreGetOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.BackupRestore)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewBackupsRestoreGetUnauthorized creates a BackupsRestoreGetUnauthorized with default headers values
+func NewBackupsRestoreGetUnauthorized() *BackupsRestoreGetUnauthorized {
+	return &BackupsRestoreGetUnauthorized{}
+}
+
+/*BackupsRestoreGetUnauthorized handles this case with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type BackupsRestoreGetUnauthorized struct {
+}
+
+func (o *BackupsRestoreGetUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /backups/{id}/restore][%d] backupsRestoreGetUnauthorized ", 401)
+}
+
+func (o *BackupsRestoreGetUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewBackupsRestoreGetForbidden creates a BackupsRestoreGetForbidden with default headers values
+func NewBackupsRestoreGetForbidden() *BackupsRestoreGetForbidden {
+	return &BackupsRestoreGetForbidden{}
+}
+
+/*BackupsRestoreGetForbidden handles this case with default header values.
+
+Forbidden
+*/
+type BackupsRestoreGetForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *BackupsRestoreGetForbidden) Error() string {
+	return fmt.Sprintf("[GET /backups/{id}/restore][%d] backupsRestoreGetForbidden  %+v", 403, o.Payload)
+}
+
+func (o *BackupsRestoreGetForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BackupsRestoreGetForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewBackupsRestoreGetNotFound creates a BackupsRestoreGetNotFound with default headers values
+func NewBackupsRestoreGetNotFound() *BackupsRestoreGetNotFound {
+	return &BackupsRestoreGetNotFound{}
+}
+
+/*BackupsRestoreGetNotFound handles this case with default header values.
+
+Not Found - Backup does not exist or was never restored
+*/
+type BackupsRestoreGetNotFound struct {
+}
+
+func (o *BackupsRestoreGetNotFound) Error() string {
+	return fmt.Sprintf("[GET /backups/{id}/restore][%d] backupsRestoreGetNotFound ", 404)
+}
+
+func (o *BackupsRestoreGetNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewBackupsRestoreGetInternalServerError creates a BackupsRestoreGetInternalServerError with default headers values
+func NewBackupsRestoreGetInternalServerError() *BackupsRestoreGetInternalServerError {
+	return &BackupsRestoreGetInternalServerError{}
+}
+
+/*BackupsRestoreGetInternalServerError handles this case with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type BackupsRestoreGetInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *BackupsRestoreGetInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /backups/{id}/restore][%d] backupsRestoreGetInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *BackupsRestoreGetInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BackupsRestoreGetInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,254 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package backups
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// BackupsRestoreReader is a Reader for the BackupsRestore structure.
+type BackupsRestoreReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *BackupsRestoreReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 201:
+		result := NewBackupsRestoreCreated()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewBackupsRestoreUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewBackupsRestoreForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewBackupsRestoreNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewBackupsRestoreUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewBackupsRestoreInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewBackupsRestoreCreated creates a BackupsRestoreCreated with default headers values
+func NewBackupsRestoreCreated() *BackupsRestoreCreated {
+	return &BackupsRestoreCreated{}
+}
+
+/*BackupsRestoreCreated handles this case with default header values.
+
+Successfully started restore.
+*/
+type BackupsRestoreCreated struct {
+	Payload *models.BackupRestore
+}
+
+func (o *BackupsRestoreCreated) Error() string {
+	return fmt.Sprintf("[POST /backups/{id}/restore][%d] backupsRestoreCreated  %+v", 201, o.Payload)
+}
+
+func (o *BackupsRestoreCreated) GetPayload() *models.BackupRestore {
+	return o.Payload
+}
+
+func (o *BackupsRestoreCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.BackupRestore)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewBackupsRestoreUnauthorized creates a BackupsRestoreUnauthorized with default headers values
+func NewBackupsRestoreUnauthorized() *BackupsRestoreUnauthorized {
+	return &BackupsRestoreUnauthorized{}
+}
+
+/*BackupsRestoreUnauthorized handles this case with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type BackupsRestoreUnauthorized struct {
+}
+
+func (o *BackupsRestoreUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /backups/{id}/restore][%d] backupsRestoreUnauthorized ", 401)
+}
+
+func (o *BackupsRestoreUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewBackupsRestoreForbidden creates a BackupsRestoreForbidden with default headers values
+func NewBackupsRestoreForbidden() *BackupsRestoreForbidden {
+	return &BackupsRestoreForbidden{}
+}
+
+/*BackupsRestoreForbidden handles this case with default header values.
+
+Forbidden
+*/
+type BackupsRestoreForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *BackupsRestoreForbidden) Error() string {
+	return fmt.Sprintf("[POST /backups/{id}/restore][%d] backupsRestoreForbidden  %+v", 403, o.Payload)
+}
+
+func (o *BackupsRestoreForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BackupsRestoreForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewBackupsRestoreNotFound creates a BackupsRestoreNotFound with default headers values
+func NewBackupsRestoreNotFound() *BackupsRestoreNotFound {
+	return &BackupsRestoreNotFound{}
+}
+
+/*BackupsRestoreNotFound handles this case with default header values.
+
+Not Found - Backup does not exist
+*/
+type BackupsRestoreNotFound struct {
+}
+
+func (o *BackupsRestoreNotFound) Error() string {
+	return fmt.Sprintf("[POST /backups/{id}/restore][%d] backupsRestoreNotFound ", 404)
+}
+
+func (o *BackupsRestoreNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewBackupsRestoreUnprocessableEntity creates a BackupsRestoreUnprocessableEntity with default headers values
+func NewBackupsRestoreUnprocessableEntity() *BackupsRestoreUnprocessableEntity {
+	return &BackupsRestoreUnprocessableEntity{}
+}
+
+/*BackupsRestoreUnprocessableEntity handles this case with default header values.
+
+Invalid restore, for example a class which is already present in the schema.
+*/
+type BackupsRestoreUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *BackupsRestoreUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /backups/{id}/restore][%d] backupsRestoreUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *BackupsRestoreUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BackupsRestoreUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewBackupsRestoreInternalServerError creates a BackupsRestoreInternalServerError with default headers values
+func NewBackupsRestoreInternalServerError() *BackupsRestoreInternalServerError {
+	return &BackupsRestoreInternalServerError{}
+}
+
+/*BackupsRestoreInternalServerError handles this case with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type BackupsRestoreInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *BackupsRestoreInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /backups/{id}/restore][%d] backupsRestoreInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *BackupsRestoreInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BackupsRestoreInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package batching
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// NewBatchingActionsDeleteParams creates a new BatchingActionsDeleteParams object
+// with the default values initialized.
+func NewBatchingActionsDeleteParams() *BatchingActionsDeleteParams {
+	var ()
+	return &BatchingActionsDeleteParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewBatchingActionsDeleteParamsWithTimeout creates a new BatchingActionsDeleteParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewBatchingActionsDeleteParamsWithTimeout(timeout time.Duration) *BatchingActionsDeleteParams {
+	var ()
+	return &BatchingActionsDeleteParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewBatchingActionsDeleteParamsWithContext creates a new BatchingActionsDeleteParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewBatchingActionsDeleteParamsWithContext(ctx context.Context) *BatchingActionsDeleteParams {
+	var ()
+	return &BatchingActionsDeleteParams{
+
+		Context: ctx,
+	}
+}
+
+// NewBatchingActionsDeleteParamsWithHTTPClient creates a new BatchingActionsDeleteParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewBatchingActionsDeleteParamsWithHTTPClient(client *http.Client) *BatchingActionsDeleteParams {
+	var ()
+	return &BatchingActionsDeleteParams{
+		HTTPClient: client,
+	}
+}
+
+/*BatchingActionsDeleteParams contains all the parameters to send to the API endpoint
+for the batching actions delete operation typically these are written to a http.Request
+*/
+type BatchingActionsDeleteParams struct {
+
+	/*Body*/
+	Body *models.BatchDelete
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the batching actions delete params
+func (o *BatchingActionsDeleteParams) WithTimeout(timeout time.Duration) *BatchingActionsDeleteParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the batching actions delete params
+func (o *BatchingActionsDeleteParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the batching actions delete params
+func (o *BatchingActionsDeleteParams) WithContext(ctx context.Context) *BatchingActionsDeleteParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the batching actions delete params
+func (o *BatchingActionsDeleteParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the batching actions delete params
+func (o *BatchingActionsDeleteParams) WithHTTPClient(client *http.Client) *BatchingActionsDeleteParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the batching actions delete params
+func (o *BatchingActionsDeleteParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the batching actions delete params
+func (o *BatchingActionsDeleteParams) WithBody(body *models.BatchDelete) *BatchingActionsDeleteParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the batching actions delete params
+func (o *BatchingActionsDeleteParams) SetBody(body *models.BatchDelete) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *BatchingActionsDeleteParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
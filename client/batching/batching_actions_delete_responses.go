@@ -0,0 +1,227 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package batching
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// BatchingActionsDeleteReader is a Reader for the BatchingActionsDelete structure.
+type BatchingActionsDeleteReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *BatchingActionsDeleteReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewBatchingActionsDeleteOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewBatchingActionsDeleteUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewBatchingActionsDeleteForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewBatchingActionsDeleteUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewBatchingActionsDeleteInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewBatchingActionsDeleteOK creates a BatchingActionsDeleteOK with default headers values
+func NewBatchingActionsDeleteOK() *BatchingActionsDeleteOK {
+	return &BatchingActionsDeleteOK{}
+}
+
+/*BatchingActionsDeleteOK handles this case with default header values.
+
+Request succeeded, see response body to get detailed information about the deleted actions.
+*/
+type BatchingActionsDeleteOK struct {
+	Payload *models.BatchDeleteResponse
+}
+
+func (o *BatchingActionsDeleteOK) Error() string {
+	return fmt.Sprintf("[DELETE /batching/actions][%d] batchingActionsDeleteOK  %+v", 200, o.Payload)
+}
+
+func (o *BatchingActionsDeleteOK) GetPayload() *models.BatchDeleteResponse {
+	return o.Payload
+}
+
+func (o *BatchingActionsDeleteOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.BatchDeleteResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewBatchingActionsDeleteUnauthorized creates a BatchingActionsDeleteUnauthorized with default headers values
+func NewBatchingActionsDeleteUnauthorized() *BatchingActionsDeleteUnauthorized {
+	return &BatchingActionsDeleteUnauthorized{}
+}
+
+/*BatchingActionsDeleteUnauthorized handles this case with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type BatchingActionsDeleteUnauthorized struct {
+}
+
+func (o *BatchingActionsDeleteUnauthorized) Error() string {
+	return fmt.Sprintf("[DELETE /batching/actions][%d] batchingActionsDeleteUnauthorized ", 401)
+}
+
+func (o *BatchingActionsDeleteUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewBatchingActionsDeleteForbidden creates a BatchingActionsDeleteForbidden with default headers values
+func NewBatchingActionsDeleteForbidden() *BatchingActionsDeleteForbidden {
+	return &BatchingActionsDeleteForbidden{}
+}
+
+/*BatchingActionsDeleteForbidden handles this case with default header values.
+
+Forbidden
+*/
+type BatchingActionsDeleteForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *BatchingActionsDeleteForbidden) Error() string {
+	return fmt.Sprintf("[DELETE /batching/actions][%d] batchingActionsDeleteForbidden  %+v", 403, o.Payload)
+}
+
+func (o *BatchingActionsDeleteForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BatchingActionsDeleteForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewBatchingActionsDeleteUnprocessableEntity creates a BatchingActionsDeleteUnprocessableEntity with default headers values
+func NewBatchingActionsDeleteUnprocessableEntity() *BatchingActionsDeleteUnprocessableEntity {
+	return &BatchingActionsDeleteUnprocessableEntity{}
+}
+
+/*BatchingActionsDeleteUnprocessableEntity handles this case with default header values.
+
+Request body is well-formed (i.e., syntactically correct), but semantically erroneous, e.g. the class doesn't exist or the filter is invalid.
+*/
+type BatchingActionsDeleteUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *BatchingActionsDeleteUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[DELETE /batching/actions][%d] batchingActionsDeleteUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *BatchingActionsDeleteUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BatchingActionsDeleteUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewBatchingActionsDeleteInternalServerError creates a BatchingActionsDeleteInternalServerError with default headers values
+func NewBatchingActionsDeleteInternalServerError() *BatchingActionsDeleteInternalServerError {
+	return &BatchingActionsDeleteInternalServerError{}
+}
+
+/*BatchingActionsDeleteInternalServerError handles this case with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type BatchingActionsDeleteInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *BatchingActionsDeleteInternalServerError) Error() string {
+	return fmt.Sprintf("[DELETE /batching/actions][%d] batchingActionsDeleteInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *BatchingActionsDeleteInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BatchingActionsDeleteInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,294 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package batching
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/swag"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// BatchingActionsMergeReader is a Reader for the BatchingActionsMerge structure.
+type BatchingActionsMergeReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *BatchingActionsMergeReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewBatchingActionsMergeOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewBatchingActionsMergeUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewBatchingActionsMergeForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewBatchingActionsMergeUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewBatchingActionsMergeInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewBatchingActionsMergeOK creates a BatchingActionsMergeOK with default headers values
+func NewBatchingActionsMergeOK() *BatchingActionsMergeOK {
+	return &BatchingActionsMergeOK{}
+}
+
+/*BatchingActionsMergeOK handles this case with default header values.
+
+Request succeeded, see response body to get detailed information about each merged Action.
+*/
+type BatchingActionsMergeOK struct {
+	Payload []*models.ActionsGetResponse
+}
+
+func (o *BatchingActionsMergeOK) Error() string {
+	return fmt.Sprintf("[PATCH /batching/actions][%d] batchingActionsMergeOK  %+v", 200, o.Payload)
+}
+
+func (o *BatchingActionsMergeOK) GetPayload() []*models.ActionsGetResponse {
+	return o.Payload
+}
+
+func (o *BatchingActionsMergeOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewBatchingActionsMergeUnauthorized creates a BatchingActionsMergeUnauthorized with default headers values
+func NewBatchingActionsMergeUnauthorized() *BatchingActionsMergeUnauthorized {
+	return &BatchingActionsMergeUnauthorized{}
+}
+
+/*BatchingActionsMergeUnauthorized handles this case with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type BatchingActionsMergeUnauthorized struct {
+}
+
+func (o *BatchingActionsMergeUnauthorized) Error() string {
+	return fmt.Sprintf("[PATCH /batching/actions][%d] batchingActionsMergeUnauthorized ", 401)
+}
+
+func (o *BatchingActionsMergeUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewBatchingActionsMergeForbidden creates a BatchingActionsMergeForbidden with default headers values
+func NewBatchingActionsMergeForbidden() *BatchingActionsMergeForbidden {
+	return &BatchingActionsMergeForbidden{}
+}
+
+/*BatchingActionsMergeForbidden handles this case with default header values.
+
+Forbidden
+*/
+type BatchingActionsMergeForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *BatchingActionsMergeForbidden) Error() string {
+	return fmt.Sprintf("[PATCH /batching/actions][%d] batchingActionsMergeForbidden  %+v", 403, o.Payload)
+}
+
+func (o *BatchingActionsMergeForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BatchingActionsMergeForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewBatchingActionsMergeUnprocessableEntity creates a BatchingActionsMergeUnprocessableEntity with default headers values
+func NewBatchingActionsMergeUnprocessableEntity() *BatchingActionsMergeUnprocessableEntity {
+	return &BatchingActionsMergeUnprocessableEntity{}
+}
+
+/*BatchingActionsMergeUnprocessableEntity handles this case with default header values.
+
+Request body is well-formed (i.e., syntactically correct), but semantically erroneous.
+*/
+type BatchingActionsMergeUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *BatchingActionsMergeUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[PATCH /batching/actions][%d] batchingActionsMergeUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *BatchingActionsMergeUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BatchingActionsMergeUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewBatchingActionsMergeInternalServerError creates a BatchingActionsMergeInternalServerError with default headers values
+func NewBatchingActionsMergeInternalServerError() *BatchingActionsMergeInternalServerError {
+	return &BatchingActionsMergeInternalServerError{}
+}
+
+/*BatchingActionsMergeInternalServerError handles this case with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type BatchingActionsMergeInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *BatchingActionsMergeInternalServerError) Error() string {
+	return fmt.Sprintf("[PATCH /batching/actions][%d] batchingActionsMergeInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *BatchingActionsMergeInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BatchingActionsMergeInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*BatchingActionsMergeBody batching actions merge body
+swagger:model BatchingActionsMergeBody
+*/
+type BatchingActionsMergeBody struct {
+
+	// actions
+	Actions []*models.Action `json:"actions"`
+}
+
+// Validate validates this batching actions merge body
+func (o *BatchingActionsMergeBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateActions(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *BatchingActionsMergeBody) validateActions(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Actions) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(o.Actions); i++ {
+		if swag.IsZero(o.Actions[i]) { // not required
+			continue
+		}
+
+		if o.Actions[i] != nil {
+			if err := o.Actions[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("body" + "." + "actions" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *BatchingActionsMergeBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *BatchingActionsMergeBody) UnmarshalBinary(b []byte) error {
+	var res BatchingActionsMergeBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -76,6 +76,80 @@ func (a *Client) BatchingActionsCreate(params *BatchingActionsCreateParams, auth
 	panic(msg)
 }
 
+/*
+BatchingActionsDelete deletes actions matching a filter as a batch
+
+Delete all Actions of a class which match the where filter. Use dryRun to find out how many actions would be deleted.
+*/
+func (a *Client) BatchingActionsDelete(params *BatchingActionsDeleteParams, authInfo runtime.ClientAuthInfoWriter) (*BatchingActionsDeleteOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBatchingActionsDeleteParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "batching.actions.delete",
+		Method:             "DELETE",
+		PathPattern:        "/batching/actions",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &BatchingActionsDeleteReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*BatchingActionsDeleteOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for batching.actions.delete: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+BatchingActionsMerge updates actions by merging partial actions as a batch
+
+Merge partial Actions into existing Actions in bulk. Every Action must contain its id and class, the class is immutable. Provided schema values are validated and Actions are only revectorized if a value which is part of their vector changes.
+*/
+func (a *Client) BatchingActionsMerge(params *BatchingActionsMergeParams, authInfo runtime.ClientAuthInfoWriter) (*BatchingActionsMergeOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBatchingActionsMergeParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "batching.actions.merge",
+		Method:             "PATCH",
+		PathPattern:        "/batching/actions",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &BatchingActionsMergeReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*BatchingActionsMergeOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for batching.actions.merge: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 BatchingReferencesCreate creates new cross references between arbitrary classes in bulk
 
@@ -150,6 +224,80 @@ func (a *Client) BatchingThingsCreate(params *BatchingThingsCreateParams, authIn
 	panic(msg)
 }
 
+/*
+BatchingThingsDelete deletes things matching a filter as a batch
+
+Delete all Things of a class which match the where filter. Use dryRun to find out how many things would be deleted.
+*/
+func (a *Client) BatchingThingsDelete(params *BatchingThingsDeleteParams, authInfo runtime.ClientAuthInfoWriter) (*BatchingThingsDeleteOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBatchingThingsDeleteParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "batching.things.delete",
+		Method:             "DELETE",
+		PathPattern:        "/batching/things",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &BatchingThingsDeleteReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*BatchingThingsDeleteOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for batching.things.delete: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+BatchingThingsMerge updates things by merging partial things as a batch
+
+Merge partial Things into existing Things in bulk. Every Thing must contain its id and class, the class is immutable. Provided schema values are validated and Things are only revectorized if a value which is part of their vector changes.
+*/
+func (a *Client) BatchingThingsMerge(params *BatchingThingsMergeParams, authInfo runtime.ClientAuthInfoWriter) (*BatchingThingsMergeOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBatchingThingsMergeParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "batching.things.merge",
+		Method:             "PATCH",
+		PathPattern:        "/batching/things",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &BatchingThingsMergeReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*BatchingThingsMergeOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for batching.things.merge: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
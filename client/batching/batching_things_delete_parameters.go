@@ -0,0 +1,149 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package batching
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// NewBatchingThingsDeleteParams creates a new BatchingThingsDeleteParams object
+// with the default values initialized.
+func NewBatchingThingsDeleteParams() *BatchingThingsDeleteParams {
+	var ()
+	return &BatchingThingsDeleteParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewBatchingThingsDeleteParamsWithTimeout creates a new BatchingThingsDeleteParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewBatchingThingsDeleteParamsWithTimeout(timeout time.Duration) *BatchingThingsDeleteParams {
+	var ()
+	return &BatchingThingsDeleteParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewBatchingThingsDeleteParamsWithContext creates a new BatchingThingsDeleteParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewBatchingThingsDeleteParamsWithContext(ctx context.Context) *BatchingThingsDeleteParams {
+	var ()
+	return &BatchingThingsDeleteParams{
+
+		Context: ctx,
+	}
+}
+
+// NewBatchingThingsDeleteParamsWithHTTPClient creates a new BatchingThingsDeleteParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewBatchingThingsDeleteParamsWithHTTPClient(client *http.Client) *BatchingThingsDeleteParams {
+	var ()
+	return &BatchingThingsDeleteParams{
+		HTTPClient: client,
+	}
+}
+
+/*BatchingThingsDeleteParams contains all the parameters to send to the API endpoint
+for the batching things delete operation typically these are written to a http.Request
+*/
+type BatchingThingsDeleteParams struct {
+
+	/*Body*/
+	Body *models.BatchDelete
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the batching things delete params
+func (o *BatchingThingsDeleteParams) WithTimeout(timeout time.Duration) *BatchingThingsDeleteParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the batching things delete params
+func (o *BatchingThingsDeleteParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the batching things delete params
+func (o *BatchingThingsDeleteParams) WithContext(ctx context.Context) *BatchingThingsDeleteParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the batching things delete params
+func (o *BatchingThingsDeleteParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the batching things delete params
+func (o *BatchingThingsDeleteParams) WithHTTPClient(client *http.Client) *BatchingThingsDeleteParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the batching things delete params
+func (o *BatchingThingsDeleteParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the batching things delete params
+func (o *BatchingThingsDeleteParams) WithBody(body *models.BatchDelete) *BatchingThingsDeleteParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the batching things delete params
+func (o *BatchingThingsDeleteParams) SetBody(body *models.BatchDelete) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *BatchingThingsDeleteParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
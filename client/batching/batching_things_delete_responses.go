@@ -0,0 +1,227 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package batching
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// BatchingThingsDeleteReader is a Reader for the BatchingThingsDelete structure.
+type BatchingThingsDeleteReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *BatchingThingsDeleteReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewBatchingThingsDeleteOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewBatchingThingsDeleteUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewBatchingThingsDeleteForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewBatchingThingsDeleteUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewBatchingThingsDeleteInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewBatchingThingsDeleteOK creates a BatchingThingsDeleteOK with default headers values
+func NewBatchingThingsDeleteOK() *BatchingThingsDeleteOK {
+	return &BatchingThingsDeleteOK{}
+}
+
+/*BatchingThingsDeleteOK handles this case with default header values.
+
+Request succeeded, see response body to get detailed information about the deleted things.
+*/
+type BatchingThingsDeleteOK struct {
+	Payload *models.BatchDeleteResponse
+}
+
+func (o *BatchingThingsDeleteOK) Error() string {
+	return fmt.Sprintf("[DELETE /batching/things][%d] batchingThingsDeleteOK  %+v", 200, o.Payload)
+}
+
+func (o *BatchingThingsDeleteOK) GetPayload() *models.BatchDeleteResponse {
+	return o.Payload
+}
+
+func (o *BatchingThingsDeleteOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.BatchDeleteResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewBatchingThingsDeleteUnauthorized creates a BatchingThingsDeleteUnauthorized with default headers values
+func NewBatchingThingsDeleteUnauthorized() *BatchingThingsDeleteUnauthorized {
+	return &BatchingThingsDeleteUnauthorized{}
+}
+
+/*BatchingThingsDeleteUnauthorized handles this case with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type BatchingThingsDeleteUnauthorized struct {
+}
+
+func (o *BatchingThingsDeleteUnauthorized) Error() string {
+	return fmt.Sprintf("[DELETE /batching/things][%d] batchingThingsDeleteUnauthorized ", 401)
+}
+
+func (o *BatchingThingsDeleteUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewBatchingThingsDeleteForbidden creates a BatchingThingsDeleteForbidden with default headers values
+func NewBatchingThingsDeleteForbidden() *BatchingThingsDeleteForbidden {
+	return &BatchingThingsDeleteForbidden{}
+}
+
+/*BatchingThingsDeleteForbidden handles this case with default header values.
+
+Forbidden
+*/
+type BatchingThingsDeleteForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *BatchingThingsDeleteForbidden) Error() string {
+	return fmt.Sprintf("[DELETE /batching/things][%d] batchingThingsDeleteForbidden  %+v", 403, o.Payload)
+}
+
+func (o *BatchingThingsDeleteForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BatchingThingsDeleteForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewBatchingThingsDeleteUnprocessableEntity creates a BatchingThingsDeleteUnprocessableEntity with default headers values
+func NewBatchingThingsDeleteUnprocessableEntity() *BatchingThingsDeleteUnprocessableEntity {
+	return &BatchingThingsDeleteUnprocessableEntity{}
+}
+
+/*BatchingThingsDeleteUnprocessableEntity handles this case with default header values.
+
+Request body is well-formed (i.e., syntactically correct), but semantically erroneous, e.g. the class doesn't exist or the filter is invalid.
+*/
+type BatchingThingsDeleteUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *BatchingThingsDeleteUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[DELETE /batching/things][%d] batchingThingsDeleteUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *BatchingThingsDeleteUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BatchingThingsDeleteUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewBatchingThingsDeleteInternalServerError creates a BatchingThingsDeleteInternalServerError with default headers values
+func NewBatchingThingsDeleteInternalServerError() *BatchingThingsDeleteInternalServerError {
+	return &BatchingThingsDeleteInternalServerError{}
+}
+
+/*BatchingThingsDeleteInternalServerError handles this case with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type BatchingThingsDeleteInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *BatchingThingsDeleteInternalServerError) Error() string {
+	return fmt.Sprintf("[DELETE /batching/things][%d] batchingThingsDeleteInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *BatchingThingsDeleteInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BatchingThingsDeleteInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package batching
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewBatchingThingsMergeParams creates a new BatchingThingsMergeParams object
+// with the default values initialized.
+func NewBatchingThingsMergeParams() *BatchingThingsMergeParams {
+	var ()
+	return &BatchingThingsMergeParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewBatchingThingsMergeParamsWithTimeout creates a new BatchingThingsMergeParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewBatchingThingsMergeParamsWithTimeout(timeout time.Duration) *BatchingThingsMergeParams {
+	var ()
+	return &BatchingThingsMergeParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewBatchingThingsMergeParamsWithContext creates a new BatchingThingsMergeParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewBatchingThingsMergeParamsWithContext(ctx context.Context) *BatchingThingsMergeParams {
+	var ()
+	return &BatchingThingsMergeParams{
+
+		Context: ctx,
+	}
+}
+
+// NewBatchingThingsMergeParamsWithHTTPClient creates a new BatchingThingsMergeParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewBatchingThingsMergeParamsWithHTTPClient(client *http.Client) *BatchingThingsMergeParams {
+	var ()
+	return &BatchingThingsMergeParams{
+		HTTPClient: client,
+	}
+}
+
+/*BatchingThingsMergeParams contains all the parameters to send to the API endpoint
+for the batching things merge operation typically these are written to a http.Request
+*/
+type BatchingThingsMergeParams struct {
+
+	/*Body*/
+	Body BatchingThingsMergeBody
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the batching things merge params
+func (o *BatchingThingsMergeParams) WithTimeout(timeout time.Duration) *BatchingThingsMergeParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the batching things merge params
+func (o *BatchingThingsMergeParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the batching things merge params
+func (o *BatchingThingsMergeParams) WithContext(ctx context.Context) *BatchingThingsMergeParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the batching things merge params
+func (o *BatchingThingsMergeParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the batching things merge params
+func (o *BatchingThingsMergeParams) WithHTTPClient(client *http.Client) *BatchingThingsMergeParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the batching things merge params
+func (o *BatchingThingsMergeParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the batching things merge params
+func (o *BatchingThingsMergeParams) WithBody(body BatchingThingsMergeBody) *BatchingThingsMergeParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the batching things merge params
+func (o *BatchingThingsMergeParams) SetBody(body BatchingThingsMergeBody) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *BatchingThingsMergeParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if err := r.SetBodyParam(o.Body); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
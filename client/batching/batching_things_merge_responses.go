@@ -0,0 +1,294 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package batching
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/swag"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// BatchingThingsMergeReader is a Reader for the BatchingThingsMerge structure.
+type BatchingThingsMergeReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *BatchingThingsMergeReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewBatchingThingsMergeOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewBatchingThingsMergeUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewBatchingThingsMergeForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewBatchingThingsMergeUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewBatchingThingsMergeInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewBatchingThingsMergeOK creates a BatchingThingsMergeOK with default headers values
+func NewBatchingThingsMergeOK() *BatchingThingsMergeOK {
+	return &BatchingThingsMergeOK{}
+}
+
+/*BatchingThingsMergeOK handles this case with default header values.
+
+Request succeeded, see response body to get detailed information about each merged Thing.
+*/
+type BatchingThingsMergeOK struct {
+	Payload []*models.ThingsGetResponse
+}
+
+func (o *BatchingThingsMergeOK) Error() string {
+	return fmt.Sprintf("[PATCH /batching/things][%d] batchingThingsMergeOK  %+v", 200, o.Payload)
+}
+
+func (o *BatchingThingsMergeOK) GetPayload() []*models.ThingsGetResponse {
+	return o.Payload
+}
+
+func (o *BatchingThingsMergeOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewBatchingThingsMergeUnauthorized creates a BatchingThingsMergeUnauthorized with default headers values
+func NewBatchingThingsMergeUnauthorized() *BatchingThingsMergeUnauthorized {
+	return &BatchingThingsMergeUnauthorized{}
+}
+
+/*BatchingThingsMergeUnauthorized handles this case with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type BatchingThingsMergeUnauthorized struct {
+}
+
+func (o *BatchingThingsMergeUnauthorized) Error() string {
+	return fmt.Sprintf("[PATCH /batching/things][%d] batchingThingsMergeUnauthorized ", 401)
+}
+
+func (o *BatchingThingsMergeUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewBatchingThingsMergeForbidden creates a BatchingThingsMergeForbidden with default headers values
+func NewBatchingThingsMergeForbidden() *BatchingThingsMergeForbidden {
+	return &BatchingThingsMergeForbidden{}
+}
+
+/*BatchingThingsMergeForbidden handles this case with default header values.
+
+Forbidden
+*/
+type BatchingThingsMergeForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *BatchingThingsMergeForbidden) Error() string {
+	return fmt.Sprintf("[PATCH /batching/things][%d] batchingThingsMergeForbidden  %+v", 403, o.Payload)
+}
+
+func (o *BatchingThingsMergeForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BatchingThingsMergeForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewBatchingThingsMergeUnprocessableEntity creates a BatchingThingsMergeUnprocessableEntity with default headers values
+func NewBatchingThingsMergeUnprocessableEntity() *BatchingThingsMergeUnprocessableEntity {
+	return &BatchingThingsMergeUnprocessableEntity{}
+}
+
+/*BatchingThingsMergeUnprocessableEntity handles this case with default header values.
+
+Request body is well-formed (i.e., syntactically correct), but semantically erroneous.
+*/
+type BatchingThingsMergeUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *BatchingThingsMergeUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[PATCH /batching/things][%d] batchingThingsMergeUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *BatchingThingsMergeUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BatchingThingsMergeUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewBatchingThingsMergeInternalServerError creates a BatchingThingsMergeInternalServerError with default headers values
+func NewBatchingThingsMergeInternalServerError() *BatchingThingsMergeInternalServerError {
+	return &BatchingThingsMergeInternalServerError{}
+}
+
+/*BatchingThingsMergeInternalServerError handles this case with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type BatchingThingsMergeInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *BatchingThingsMergeInternalServerError) Error() string {
+	return fmt.Sprintf("[PATCH /batching/things][%d] batchingThingsMergeInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *BatchingThingsMergeInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BatchingThingsMergeInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*BatchingThingsMergeBody batching things merge body
+swagger:model BatchingThingsMergeBody
+*/
+type BatchingThingsMergeBody struct {
+
+	// things
+	Things []*models.Thing `json:"things"`
+}
+
+// Validate validates this batching things merge body
+func (o *BatchingThingsMergeBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateThings(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *BatchingThingsMergeBody) validateThings(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Things) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(o.Things); i++ {
+		if swag.IsZero(o.Things[i]) { // not required
+			continue
+		}
+
+		if o.Things[i] != nil {
+			if err := o.Things[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("body" + "." + "things" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *BatchingThingsMergeBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *BatchingThingsMergeBody) UnmarshalBinary(b []byte) error {
+	var res BatchingThingsMergeBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -0,0 +1,149 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package classifications
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewClassificationsCancelParams creates a new ClassificationsCancelParams object
+// with the default values initialized.
+func NewClassificationsCancelParams() *ClassificationsCancelParams {
+	var ()
+	return &ClassificationsCancelParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewClassificationsCancelParamsWithTimeout creates a new ClassificationsCancelParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewClassificationsCancelParamsWithTimeout(timeout time.Duration) *ClassificationsCancelParams {
+	var ()
+	return &ClassificationsCancelParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewClassificationsCancelParamsWithContext creates a new ClassificationsCancelParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewClassificationsCancelParamsWithContext(ctx context.Context) *ClassificationsCancelParams {
+	var ()
+	return &ClassificationsCancelParams{
+
+		Context: ctx,
+	}
+}
+
+// NewClassificationsCancelParamsWithHTTPClient creates a new ClassificationsCancelParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewClassificationsCancelParamsWithHTTPClient(client *http.Client) *ClassificationsCancelParams {
+	var ()
+	return &ClassificationsCancelParams{
+		HTTPClient: client,
+	}
+}
+
+/*ClassificationsCancelParams contains all the parameters to send to the API endpoint
+for the classifications cancel operation typically these are written to a http.Request
+*/
+type ClassificationsCancelParams struct {
+
+	/*ID
+	  classification id
+
+	*/
+	ID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the classifications cancel params
+func (o *ClassificationsCancelParams) WithTimeout(timeout time.Duration) *ClassificationsCancelParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the classifications cancel params
+func (o *ClassificationsCancelParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the classifications cancel params
+func (o *ClassificationsCancelParams) WithContext(ctx context.Context) *ClassificationsCancelParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the classifications cancel params
+func (o *ClassificationsCancelParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the classifications cancel params
+func (o *ClassificationsCancelParams) WithHTTPClient(client *http.Client) *ClassificationsCancelParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the classifications cancel params
+func (o *ClassificationsCancelParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the classifications cancel params
+func (o *ClassificationsCancelParams) WithID(id string) *ClassificationsCancelParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the classifications cancel params
+func (o *ClassificationsCancelParams) SetID(id string) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ClassificationsCancelParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,254 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package classifications
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// ClassificationsCancelReader is a Reader for the ClassificationsCancel structure.
+type ClassificationsCancelReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ClassificationsCancelReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 202:
+		result := NewClassificationsCancelAccepted()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewClassificationsCancelUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewClassificationsCancelForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewClassificationsCancelNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewClassificationsCancelConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewClassificationsCancelInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewClassificationsCancelAccepted creates a ClassificationsCancelAccepted with default headers values
+func NewClassificationsCancelAccepted() *ClassificationsCancelAccepted {
+	return &ClassificationsCancelAccepted{}
+}
+
+/*ClassificationsCancelAccepted handles this case with default header values.
+
+Cancellation requested, the classification is returned as body
+*/
+type ClassificationsCancelAccepted struct {
+	Payload *models.Classification
+}
+
+func (o *ClassificationsCancelAccepted) Error() string {
+	return fmt.Sprintf("[DELETE /classifications/{id}][%d] classificationsCancelAccepted  %+v", 202, o.Payload)
+}
+
+func (o *ClassificationsCancelAccepted) GetPayload() *models.Classification {
+	return o.Payload
+}
+
+func (o *ClassificationsCancelAccepted) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Classification)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewClassificationsCancelUnauthorized creates a ClassificationsCancelUnauthorized with default headers values
+func NewClassificationsCancelUnauthorized() *ClassificationsCancelUnauthorized {
+	return &ClassificationsCancelUnauthorized{}
+}
+
+/*ClassificationsCancelUnauthorized handles this case with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type ClassificationsCancelUnauthorized struct {
+}
+
+func (o *ClassificationsCancelUnauthorized) Error() string {
+	return fmt.Sprintf("[DELETE /classifications/{id}][%d] classificationsCancelUnauthorized ", 401)
+}
+
+func (o *ClassificationsCancelUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewClassificationsCancelForbidden creates a ClassificationsCancelForbidden with default headers values
+func NewClassificationsCancelForbidden() *ClassificationsCancelForbidden {
+	return &ClassificationsCancelForbidden{}
+}
+
+/*ClassificationsCancelForbidden handles this case with default header values.
+
+Forbidden
+*/
+type ClassificationsCancelForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *ClassificationsCancelForbidden) Error() string {
+	return fmt.Sprintf("[DELETE /classifications/{id}][%d] classificationsCancelForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ClassificationsCancelForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ClassificationsCancelForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewClassificationsCancelNotFound creates a ClassificationsCancelNotFound with default headers values
+func NewClassificationsCancelNotFound() *ClassificationsCancelNotFound {
+	return &ClassificationsCancelNotFound{}
+}
+
+/*ClassificationsCancelNotFound handles this case with default header values.
+
+Not Found - Classification does not exist
+*/
+type ClassificationsCancelNotFound struct {
+}
+
+func (o *ClassificationsCancelNotFound) Error() string {
+	return fmt.Sprintf("[DELETE /classifications/{id}][%d] classificationsCancelNotFound ", 404)
+}
+
+func (o *ClassificationsCancelNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewClassificationsCancelConflict creates a ClassificationsCancelConflict with default headers values
+func NewClassificationsCancelConflict() *ClassificationsCancelConflict {
+	return &ClassificationsCancelConflict{}
+}
+
+/*ClassificationsCancelConflict handles this case with default header values.
+
+The classification is not running
+*/
+type ClassificationsCancelConflict struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *ClassificationsCancelConflict) Error() string {
+	return fmt.Sprintf("[DELETE /classifications/{id}][%d] classificationsCancelConflict  %+v", 409, o.Payload)
+}
+
+func (o *ClassificationsCancelConflict) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ClassificationsCancelConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewClassificationsCancelInternalServerError creates a ClassificationsCancelInternalServerError with default headers values
+func NewClassificationsCancelInternalServerError() *ClassificationsCancelInternalServerError {
+	return &ClassificationsCancelInternalServerError{}
+}
+
+/*ClassificationsCancelInternalServerError handles this case with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type ClassificationsCancelInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *ClassificationsCancelInternalServerError) Error() string {
+	return fmt.Sprintf("[DELETE /classifications/{id}][%d] classificationsCancelInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ClassificationsCancelInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ClassificationsCancelInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
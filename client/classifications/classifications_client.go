@@ -39,6 +39,43 @@ type Client struct {
 	formats   strfmt.Registry
 }
 
+/*
+ClassificationsCancel cancels a running classification
+
+Cancel a running classification. The classification stops after the object it is currently classifying, objects which have already been classified keep their references.
+*/
+func (a *Client) ClassificationsCancel(params *ClassificationsCancelParams, authInfo runtime.ClientAuthInfoWriter) (*ClassificationsCancelAccepted, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewClassificationsCancelParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "classifications.cancel",
+		Method:             "DELETE",
+		PathPattern:        "/classifications/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ClassificationsCancelReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ClassificationsCancelAccepted)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for classifications.cancel: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 ClassificationsGet views previously created classification
 
@@ -76,6 +113,43 @@ func (a *Client) ClassificationsGet(params *ClassificationsGetParams, authInfo r
 	panic(msg)
 }
 
+/*
+ClassificationsList lists classifications
+
+List classifications, most recently started first. Only classifications of classes the user is allowed to view are listed.
+*/
+func (a *Client) ClassificationsList(params *ClassificationsListParams, authInfo runtime.ClientAuthInfoWriter) (*ClassificationsListOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewClassificationsListParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "classifications.list",
+		Method:             "GET",
+		PathPattern:        "/classifications/",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ClassificationsListReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ClassificationsListOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for classifications.list: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 ClassificationsPost starts a classification
 
@@ -0,0 +1,192 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package classifications
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewClassificationsListParams creates a new ClassificationsListParams object
+// with the default values initialized.
+func NewClassificationsListParams() *ClassificationsListParams {
+	var ()
+	return &ClassificationsListParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewClassificationsListParamsWithTimeout creates a new ClassificationsListParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewClassificationsListParamsWithTimeout(timeout time.Duration) *ClassificationsListParams {
+	var ()
+	return &ClassificationsListParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewClassificationsListParamsWithContext creates a new ClassificationsListParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewClassificationsListParamsWithContext(ctx context.Context) *ClassificationsListParams {
+	var ()
+	return &ClassificationsListParams{
+
+		Context: ctx,
+	}
+}
+
+// NewClassificationsListParamsWithHTTPClient creates a new ClassificationsListParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewClassificationsListParamsWithHTTPClient(client *http.Client) *ClassificationsListParams {
+	var ()
+	return &ClassificationsListParams{
+		HTTPClient: client,
+	}
+}
+
+/*ClassificationsListParams contains all the parameters to send to the API endpoint
+for the classifications list operation typically these are written to a http.Request
+*/
+type ClassificationsListParams struct {
+
+	/*Class
+	  only list classifications of this class
+
+	*/
+	Class *string
+	/*Status
+	  only list classifications with this status
+
+	*/
+	Status *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the classifications list params
+func (o *ClassificationsListParams) WithTimeout(timeout time.Duration) *ClassificationsListParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the classifications list params
+func (o *ClassificationsListParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the classifications list params
+func (o *ClassificationsListParams) WithContext(ctx context.Context) *ClassificationsListParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the classifications list params
+func (o *ClassificationsListParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the classifications list params
+func (o *ClassificationsListParams) WithHTTPClient(client *http.Client) *ClassificationsListParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the classifications list params
+func (o *ClassificationsListParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClass adds the class to the classifications list params
+func (o *ClassificationsListParams) WithClass(class *string) *ClassificationsListParams {
+	o.SetClass(class)
+	return o
+}
+
+// SetClass adds the class to the classifications list params
+func (o *ClassificationsListParams) SetClass(class *string) {
+	o.Class = class
+}
+
+// WithStatus adds the status to the classifications list params
+func (o *ClassificationsListParams) WithStatus(status *string) *ClassificationsListParams {
+	o.SetStatus(status)
+	return o
+}
+
+// SetStatus adds the status to the classifications list params
+func (o *ClassificationsListParams) SetStatus(status *string) {
+	o.Status = status
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ClassificationsListParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Class != nil {
+
+		// query param class
+		var qrClass string
+		if o.Class != nil {
+			qrClass = *o.Class
+		}
+		qClass := qrClass
+		if qClass != "" {
+			if err := r.SetQueryParam("class", qClass); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Status != nil {
+
+		// query param status
+		var qrStatus string
+		if o.Status != nil {
+			qrStatus = *o.Status
+		}
+		qStatus := qrStatus
+		if qStatus != "" {
+			if err := r.SetQueryParam("status", qStatus); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
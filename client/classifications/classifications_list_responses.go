@@ -0,0 +1,188 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package classifications
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// ClassificationsListReader is a Reader for the ClassificationsList structure.
+type ClassificationsListReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ClassificationsListReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewClassificationsListOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewClassificationsListUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewClassificationsListForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewClassificationsListInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewClassificationsListOK creates a ClassificationsListOK with default headers values
+func NewClassificationsListOK() *ClassificationsListOK {
+	return &ClassificationsListOK{}
+}
+
+/*ClassificationsListOK handles this case with default header values.
+
+Successful response.
+*/
+type ClassificationsListOK struct {
+	Payload *models.ClassificationsListResponse
+}
+
+func (o *ClassificationsListOK) Error() string {
+	return fmt.Sprintf("[GET /classifications/][%d] classificationsListOK  %+v", 200, o.Payload)
+}
+
+func (o *ClassificationsListOK) GetPayload() *models.ClassificationsListResponse {
+	return o.Payload
+}
+
+func (o *ClassificationsListOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ClassificationsListResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewClassificationsListUnauthorized creates a ClassificationsListUnauthorized with default headers values
+func NewClassificationsListUnauthorized() *ClassificationsListUnauthorized {
+	return &ClassificationsListUnauthorized{}
+}
+
+/*ClassificationsListUnauthorized handles this case with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type ClassificationsListUnauthorized struct {
+}
+
+func (o *ClassificationsListUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /classifications/][%d] classificationsListUnauthorized ", 401)
+}
+
+func (o *ClassificationsListUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewClassificationsListForbidden creates a ClassificationsListForbidden with default headers values
+func NewClassificationsListForbidden() *ClassificationsListForbidden {
+	return &ClassificationsListForbidden{}
+}
+
+/*ClassificationsListForbidden handles this case with default header values.
+
+Forbidden
+*/
+type ClassificationsListForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *ClassificationsListForbidden) Error() string {
+	return fmt.Sprintf("[GET /classifications/][%d] classificationsListForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ClassificationsListForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ClassificationsListForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewClassificationsListInternalServerError creates a ClassificationsListInternalServerError with default headers values
+func NewClassificationsListInternalServerError() *ClassificationsListInternalServerError {
+	return &ClassificationsListInternalServerError{}
+}
+
+/*ClassificationsListInternalServerError handles this case with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type ClassificationsListInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *ClassificationsListInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /classifications/][%d] classificationsListInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ClassificationsListInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ClassificationsListInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package maintenance
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// NewMaintenanceDanglingReferencesParams creates a new MaintenanceDanglingReferencesParams object
+// with the default values initialized.
+func NewMaintenanceDanglingReferencesParams() *MaintenanceDanglingReferencesParams {
+	var ()
+	return &MaintenanceDanglingReferencesParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewMaintenanceDanglingReferencesParamsWithTimeout creates a new MaintenanceDanglingReferencesParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewMaintenanceDanglingReferencesParamsWithTimeout(timeout time.Duration) *MaintenanceDanglingReferencesParams {
+	var ()
+	return &MaintenanceDanglingReferencesParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewMaintenanceDanglingReferencesParamsWithContext creates a new MaintenanceDanglingReferencesParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewMaintenanceDanglingReferencesParamsWithContext(ctx context.Context) *MaintenanceDanglingReferencesParams {
+	var ()
+	return &MaintenanceDanglingReferencesParams{
+
+		Context: ctx,
+	}
+}
+
+// NewMaintenanceDanglingReferencesParamsWithHTTPClient creates a new MaintenanceDanglingReferencesParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewMaintenanceDanglingReferencesParamsWithHTTPClient(client *http.Client) *MaintenanceDanglingReferencesParams {
+	var ()
+	return &MaintenanceDanglingReferencesParams{
+		HTTPClient: client,
+	}
+}
+
+/*MaintenanceDanglingReferencesParams contains all the parameters to send to the API endpoint
+for the maintenance dangling references operation typically these are written to a http.Request
+*/
+type MaintenanceDanglingReferencesParams struct {
+
+	/*Body*/
+	Body *models.DanglingReferencesCheck
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the maintenance dangling references params
+func (o *MaintenanceDanglingReferencesParams) WithTimeout(timeout time.Duration) *MaintenanceDanglingReferencesParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the maintenance dangling references params
+func (o *MaintenanceDanglingReferencesParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the maintenance dangling references params
+func (o *MaintenanceDanglingReferencesParams) WithContext(ctx context.Context) *MaintenanceDanglingReferencesParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the maintenance dangling references params
+func (o *MaintenanceDanglingReferencesParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the maintenance dangling references params
+func (o *MaintenanceDanglingReferencesParams) WithHTTPClient(client *http.Client) *MaintenanceDanglingReferencesParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the maintenance dangling references params
+func (o *MaintenanceDanglingReferencesParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the maintenance dangling references params
+func (o *MaintenanceDanglingReferencesParams) WithBody(body *models.DanglingReferencesCheck) *MaintenanceDanglingReferencesParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the maintenance dangling references params
+func (o *MaintenanceDanglingReferencesParams) SetBody(body *models.DanglingReferencesCheck) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *MaintenanceDanglingReferencesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,227 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package maintenance
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// MaintenanceDanglingReferencesReader is a Reader for the MaintenanceDanglingReferences structure.
+type MaintenanceDanglingReferencesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *MaintenanceDanglingReferencesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewMaintenanceDanglingReferencesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewMaintenanceDanglingReferencesUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewMaintenanceDanglingReferencesForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewMaintenanceDanglingReferencesUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewMaintenanceDanglingReferencesInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewMaintenanceDanglingReferencesOK creates a MaintenanceDanglingReferencesOK with default headers values
+func NewMaintenanceDanglingReferencesOK() *MaintenanceDanglingReferencesOK {
+	return &MaintenanceDanglingReferencesOK{}
+}
+
+/*MaintenanceDanglingReferencesOK handles this case with default header values.
+
+The scan finished, see the response body for the dangling references.
+*/
+type MaintenanceDanglingReferencesOK struct {
+	Payload *models.DanglingReferencesReport
+}
+
+func (o *MaintenanceDanglingReferencesOK) Error() string {
+	return fmt.Sprintf("[POST /maintenance/dangling-references][%d] maintenanceDanglingReferencesOK  %+v", 200, o.Payload)
+}
+
+func (o *MaintenanceDanglingReferencesOK) GetPayload() *models.DanglingReferencesReport {
+	return o.Payload
+}
+
+func (o *MaintenanceDanglingReferencesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.DanglingReferencesReport)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewMaintenanceDanglingReferencesUnauthorized creates a MaintenanceDanglingReferencesUnauthorized with default headers values
+func NewMaintenanceDanglingReferencesUnauthorized() *MaintenanceDanglingReferencesUnauthorized {
+	return &MaintenanceDanglingReferencesUnauthorized{}
+}
+
+/*MaintenanceDanglingReferencesUnauthorized handles this case with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type MaintenanceDanglingReferencesUnauthorized struct {
+}
+
+func (o *MaintenanceDanglingReferencesUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /maintenance/dangling-references][%d] maintenanceDanglingReferencesUnauthorized ", 401)
+}
+
+func (o *MaintenanceDanglingReferencesUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewMaintenanceDanglingReferencesForbidden creates a MaintenanceDanglingReferencesForbidden with default headers values
+func NewMaintenanceDanglingReferencesForbidden() *MaintenanceDanglingReferencesForbidden {
+	return &MaintenanceDanglingReferencesForbidden{}
+}
+
+/*MaintenanceDanglingReferencesForbidden handles this case with default header values.
+
+Forbidden
+*/
+type MaintenanceDanglingReferencesForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *MaintenanceDanglingReferencesForbidden) Error() string {
+	return fmt.Sprintf("[POST /maintenance/dangling-references][%d] maintenanceDanglingReferencesForbidden  %+v", 403, o.Payload)
+}
+
+func (o *MaintenanceDanglingReferencesForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *MaintenanceDanglingReferencesForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewMaintenanceDanglingReferencesUnprocessableEntity creates a MaintenanceDanglingReferencesUnprocessableEntity with default headers values
+func NewMaintenanceDanglingReferencesUnprocessableEntity() *MaintenanceDanglingReferencesUnprocessableEntity {
+	return &MaintenanceDanglingReferencesUnprocessableEntity{}
+}
+
+/*MaintenanceDanglingReferencesUnprocessableEntity handles this case with default header values.
+
+Request body is well-formed (i.e., syntactically correct), but semantically erroneous, e.g. the class doesn't exist.
+*/
+type MaintenanceDanglingReferencesUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *MaintenanceDanglingReferencesUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /maintenance/dangling-references][%d] maintenanceDanglingReferencesUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *MaintenanceDanglingReferencesUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *MaintenanceDanglingReferencesUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewMaintenanceDanglingReferencesInternalServerError creates a MaintenanceDanglingReferencesInternalServerError with default headers values
+func NewMaintenanceDanglingReferencesInternalServerError() *MaintenanceDanglingReferencesInternalServerError {
+	return &MaintenanceDanglingReferencesInternalServerError{}
+}
+
+/*MaintenanceDanglingReferencesInternalServerError handles this case with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type MaintenanceDanglingReferencesInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *MaintenanceDanglingReferencesInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /maintenance/dangling-references][%d] maintenanceDanglingReferencesInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *MaintenanceDanglingReferencesInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *MaintenanceDanglingReferencesInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
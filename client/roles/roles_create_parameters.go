@@ -0,0 +1,149 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package roles
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// NewRolesCreateParams creates a new RolesCreateParams object
+// with the default values initialized.
+func NewRolesCreateParams() *RolesCreateParams {
+	var ()
+	return &RolesCreateParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewRolesCreateParamsWithTimeout creates a new RolesCreateParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewRolesCreateParamsWithTimeout(timeout time.Duration) *RolesCreateParams {
+	var ()
+	return &RolesCreateParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewRolesCreateParamsWithContext creates a new RolesCreateParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewRolesCreateParamsWithContext(ctx context.Context) *RolesCreateParams {
+	var ()
+	return &RolesCreateParams{
+
+		Context: ctx,
+	}
+}
+
+// NewRolesCreateParamsWithHTTPClient creates a new RolesCreateParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewRolesCreateParamsWithHTTPClient(client *http.Client) *RolesCreateParams {
+	var ()
+	return &RolesCreateParams{
+		HTTPClient: client,
+	}
+}
+
+/*RolesCreateParams contains all the parameters to send to the API endpoint
+for the roles create operation typically these are written to a http.Request
+*/
+type RolesCreateParams struct {
+
+	/*Body*/
+	Body *models.Role
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the roles create params
+func (o *RolesCreateParams) WithTimeout(timeout time.Duration) *RolesCreateParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the roles create params
+func (o *RolesCreateParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the roles create params
+func (o *RolesCreateParams) WithContext(ctx context.Context) *RolesCreateParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the roles create params
+func (o *RolesCreateParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the roles create params
+func (o *RolesCreateParams) WithHTTPClient(client *http.Client) *RolesCreateParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the roles create params
+func (o *RolesCreateParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the roles create params
+func (o *RolesCreateParams) WithBody(body *models.Role) *RolesCreateParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the roles create params
+func (o *RolesCreateParams) SetBody(body *models.Role) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *RolesCreateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,227 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package roles
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// RolesCreateReader is a Reader for the RolesCreate structure.
+type RolesCreateReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *RolesCreateReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 201:
+		result := NewRolesCreateCreated()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewRolesCreateUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewRolesCreateForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewRolesCreateUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewRolesCreateInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewRolesCreateCreated creates a RolesCreateCreated with default headers values
+func NewRolesCreateCreated() *RolesCreateCreated {
+	return &RolesCreateCreated{}
+}
+
+/*RolesCreateCreated handles this case with default header values.
+
+Role created.
+*/
+type RolesCreateCreated struct {
+	Payload *models.Role
+}
+
+func (o *RolesCreateCreated) Error() string {
+	return fmt.Sprintf("[POST /roles][%d] rolesCreateCreated  %+v", 201, o.Payload)
+}
+
+func (o *RolesCreateCreated) GetPayload() *models.Role {
+	return o.Payload
+}
+
+func (o *RolesCreateCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Role)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRolesCreateUnauthorized creates a RolesCreateUnauthorized with default headers values
+func NewRolesCreateUnauthorized() *RolesCreateUnauthorized {
+	return &RolesCreateUnauthorized{}
+}
+
+/*RolesCreateUnauthorized handles this case with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type RolesCreateUnauthorized struct {
+}
+
+func (o *RolesCreateUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /roles][%d] rolesCreateUnauthorized ", 401)
+}
+
+func (o *RolesCreateUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewRolesCreateForbidden creates a RolesCreateForbidden with default headers values
+func NewRolesCreateForbidden() *RolesCreateForbidden {
+	return &RolesCreateForbidden{}
+}
+
+/*RolesCreateForbidden handles this case with default header values.
+
+Forbidden
+*/
+type RolesCreateForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *RolesCreateForbidden) Error() string {
+	return fmt.Sprintf("[POST /roles][%d] rolesCreateForbidden  %+v", 403, o.Payload)
+}
+
+func (o *RolesCreateForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *RolesCreateForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRolesCreateUnprocessableEntity creates a RolesCreateUnprocessableEntity with default headers values
+func NewRolesCreateUnprocessableEntity() *RolesCreateUnprocessableEntity {
+	return &RolesCreateUnprocessableEntity{}
+}
+
+/*RolesCreateUnprocessableEntity handles this case with default header values.
+
+Invalid role or rbac authorization is not enabled.
+*/
+type RolesCreateUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *RolesCreateUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /roles][%d] rolesCreateUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *RolesCreateUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *RolesCreateUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRolesCreateInternalServerError creates a RolesCreateInternalServerError with default headers values
+func NewRolesCreateInternalServerError() *RolesCreateInternalServerError {
+	return &RolesCreateInternalServerError{}
+}
+
+/*RolesCreateInternalServerError handles this case with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type RolesCreateInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *RolesCreateInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /roles][%d] rolesCreateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *RolesCreateInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *RolesCreateInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
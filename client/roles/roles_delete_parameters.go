@@ -0,0 +1,149 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package roles
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewRolesDeleteParams creates a new RolesDeleteParams object
+// with the default values initialized.
+func NewRolesDeleteParams() *RolesDeleteParams {
+	var ()
+	return &RolesDeleteParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewRolesDeleteParamsWithTimeout creates a new RolesDeleteParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewRolesDeleteParamsWithTimeout(timeout time.Duration) *RolesDeleteParams {
+	var ()
+	return &RolesDeleteParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewRolesDeleteParamsWithContext creates a new RolesDeleteParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewRolesDeleteParamsWithContext(ctx context.Context) *RolesDeleteParams {
+	var ()
+	return &RolesDeleteParams{
+
+		Context: ctx,
+	}
+}
+
+// NewRolesDeleteParamsWithHTTPClient creates a new RolesDeleteParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewRolesDeleteParamsWithHTTPClient(client *http.Client) *RolesDeleteParams {
+	var ()
+	return &RolesDeleteParams{
+		HTTPClient: client,
+	}
+}
+
+/*RolesDeleteParams contains all the parameters to send to the API endpoint
+for the roles delete operation typically these are written to a http.Request
+*/
+type RolesDeleteParams struct {
+
+	/*Name
+	  name of the role
+
+	*/
+	Name string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the roles delete params
+func (o *RolesDeleteParams) WithTimeout(timeout time.Duration) *RolesDeleteParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the roles delete params
+func (o *RolesDeleteParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the roles delete params
+func (o *RolesDeleteParams) WithContext(ctx context.Context) *RolesDeleteParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the roles delete params
+func (o *RolesDeleteParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the roles delete params
+func (o *RolesDeleteParams) WithHTTPClient(client *http.Client) *RolesDeleteParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the roles delete params
+func (o *RolesDeleteParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithName adds the name to the roles delete params
+func (o *RolesDeleteParams) WithName(name string) *RolesDeleteParams {
+	o.SetName(name)
+	return o
+}
+
+// SetName adds the name to the roles delete params
+func (o *RolesDeleteParams) SetName(name string) {
+	o.Name = name
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *RolesDeleteParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param name
+	if err := r.SetPathParam("name", o.Name); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,242 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package roles
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// RolesDeleteReader is a Reader for the RolesDelete structure.
+type RolesDeleteReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *RolesDeleteReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewRolesDeleteNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewRolesDeleteUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewRolesDeleteForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewRolesDeleteNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewRolesDeleteUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewRolesDeleteInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewRolesDeleteNoContent creates a RolesDeleteNoContent with default headers values
+func NewRolesDeleteNoContent() *RolesDeleteNoContent {
+	return &RolesDeleteNoContent{}
+}
+
+/*RolesDeleteNoContent handles this case with default header values.
+
+Role deleted.
+*/
+type RolesDeleteNoContent struct {
+}
+
+func (o *RolesDeleteNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /roles/{name}][%d] rolesDeleteNoContent ", 204)
+}
+
+func (o *RolesDeleteNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewRolesDeleteUnauthorized creates a RolesDeleteUnauthorized with default headers values
+func NewRolesDeleteUnauthorized() *RolesDeleteUnauthorized {
+	return &RolesDeleteUnauthorized{}
+}
+
+/*RolesDeleteUnauthorized handles this case with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type RolesDeleteUnauthorized struct {
+}
+
+func (o *RolesDeleteUnauthorized) Error() string {
+	return fmt.Sprintf("[DELETE /roles/{name}][%d] rolesDeleteUnauthorized ", 401)
+}
+
+func (o *RolesDeleteUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewRolesDeleteForbidden creates a RolesDeleteForbidden with default headers values
+func NewRolesDeleteForbidden() *RolesDeleteForbidden {
+	return &RolesDeleteForbidden{}
+}
+
+/*RolesDeleteForbidden handles this case with default header values.
+
+Forbidden
+*/
+type RolesDeleteForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *RolesDeleteForbidden) Error() string {
+	return fmt.Sprintf("[DELETE /roles/{name}][%d] rolesDeleteForbidden  %+v", 403, o.Payload)
+}
+
+func (o *RolesDeleteForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *RolesDeleteForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRolesDeleteNotFound creates a RolesDeleteNotFound with default headers values
+func NewRolesDeleteNotFound() *RolesDeleteNotFound {
+	return &RolesDeleteNotFound{}
+}
+
+/*RolesDeleteNotFound handles this case with default header values.
+
+Not Found - Role does not exist
+*/
+type RolesDeleteNotFound struct {
+}
+
+func (o *RolesDeleteNotFound) Error() string {
+	return fmt.Sprintf("[DELETE /roles/{name}][%d] rolesDeleteNotFound ", 404)
+}
+
+func (o *RolesDeleteNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewRolesDeleteUnprocessableEntity creates a RolesDeleteUnprocessableEntity with default headers values
+func NewRolesDeleteUnprocessableEntity() *RolesDeleteUnprocessableEntity {
+	return &RolesDeleteUnprocessableEntity{}
+}
+
+/*RolesDeleteUnprocessableEntity handles this case with default header values.
+
+Invalid role or rbac authorization is not enabled.
+*/
+type RolesDeleteUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *RolesDeleteUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[DELETE /roles/{name}][%d] rolesDeleteUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *RolesDeleteUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *RolesDeleteUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRolesDeleteInternalServerError creates a RolesDeleteInternalServerError with default headers values
+func NewRolesDeleteInternalServerError() *RolesDeleteInternalServerError {
+	return &RolesDeleteInternalServerError{}
+}
+
+/*RolesDeleteInternalServerError handles this case with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type RolesDeleteInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *RolesDeleteInternalServerError) Error() string {
+	return fmt.Sprintf("[DELETE /roles/{name}][%d] rolesDeleteInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *RolesDeleteInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *RolesDeleteInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package roles
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewRolesGetParams creates a new RolesGetParams object
+// with the default values initialized.
+func NewRolesGetParams() *RolesGetParams {
+	var ()
+	return &RolesGetParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewRolesGetParamsWithTimeout creates a new RolesGetParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewRolesGetParamsWithTimeout(timeout time.Duration) *RolesGetParams {
+	var ()
+	return &RolesGetParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewRolesGetParamsWithContext creates a new RolesGetParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewRolesGetParamsWithContext(ctx context.Context) *RolesGetParams {
+	var ()
+	return &RolesGetParams{
+
+		Context: ctx,
+	}
+}
+
+// NewRolesGetParamsWithHTTPClient creates a new RolesGetParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewRolesGetParamsWithHTTPClient(client *http.Client) *RolesGetParams {
+	var ()
+	return &RolesGetParams{
+		HTTPClient: client,
+	}
+}
+
+/*RolesGetParams contains all the parameters to send to the API endpoint
+for the roles get operation typically these are written to a http.Request
+*/
+type RolesGetParams struct {
+
+	/*Name
+	  name of the role
+
+	*/
+	Name string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the roles get params
+func (o *RolesGetParams) WithTimeout(timeout time.Duration) *RolesGetParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the roles get params
+func (o *RolesGetParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the roles get params
+func (o *RolesGetParams) WithContext(ctx context.Context) *RolesGetParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the roles get params
+func (o *RolesGetParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the roles get params
+func (o *RolesGetParams) WithHTTPClient(client *http.Client) *RolesGetParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the roles get params
+func (o *RolesGetParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithName adds the name to the roles get params
+func (o *RolesGetParams) WithName(name string) *RolesGetParams {
+	o.SetName(name)
+	return o
+}
+
+// SetName adds the name to the roles get params
+func (o *RolesGetParams) SetName(name string) {
+	o.Name = name
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *RolesGetParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param name
+	if err := r.SetPathParam("name", o.Name); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,254 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package roles
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// RolesGetReader is a Reader for the RolesGet structure.
+type RolesGetReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *RolesGetReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewRolesGetOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewRolesGetUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewRolesGetForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewRolesGetNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewRolesGetUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewRolesGetInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewRolesGetOK creates a RolesGetOK with default headers values
+func NewRolesGetOK() *RolesGetOK {
+	return &RolesGetOK{}
+}
+
+/*RolesGetOK handles this case with default header values.
+
+Found the role, returned as body.
+*/
+type RolesGetOK struct {
+	Payload *models.Role
+}
+
+func (o *RolesGetOK) Error() string {
+	return fmt.Sprintf("[GET /roles/{name}][%d] rolesGetOK  %+v", 200, o.Payload)
+}
+
+func (o *RolesGetOK) GetPayload() *models.Role {
+	return o.Payload
+}
+
+func (o *RolesGetOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Role)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRolesGetUnauthorized creates a RolesGetUnauthorized with default headers values
+func NewRolesGetUnauthorized() *RolesGetUnauthorized {
+	return &RolesGetUnauthorized{}
+}
+
+/*RolesGetUnauthorized handles this case with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type RolesGetUnauthorized struct {
+}
+
+func (o *RolesGetUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /roles/{name}][%d] rolesGetUnauthorized ", 401)
+}
+
+func (o *RolesGetUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewRolesGetForbidden creates a RolesGetForbidden with default headers values
+func NewRolesGetForbidden() *RolesGetForbidden {
+	return &RolesGetForbidden{}
+}
+
+/*RolesGetForbidden handles this case with default header values.
+
+Forbidden
+*/
+type RolesGetForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *RolesGetForbidden) Error() string {
+	return fmt.Sprintf("[GET /roles/{name}][%d] rolesGetForbidden  %+v", 403, o.Payload)
+}
+
+func (o *RolesGetForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *RolesGetForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRolesGetNotFound creates a RolesGetNotFound with default headers values
+func NewRolesGetNotFound() *RolesGetNotFound {
+	return &RolesGetNotFound{}
+}
+
+/*RolesGetNotFound handles this case with default header values.
+
+Not Found - Role does not exist
+*/
+type RolesGetNotFound struct {
+}
+
+func (o *RolesGetNotFound) Error() string {
+	return fmt.Sprintf("[GET /roles/{name}][%d] rolesGetNotFound ", 404)
+}
+
+func (o *RolesGetNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewRolesGetUnprocessableEntity creates a RolesGetUnprocessableEntity with default headers values
+func NewRolesGetUnprocessableEntity() *RolesGetUnprocessableEntity {
+	return &RolesGetUnprocessableEntity{}
+}
+
+/*RolesGetUnprocessableEntity handles this case with default header values.
+
+Invalid role or rbac authorization is not enabled.
+*/
+type RolesGetUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *RolesGetUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[GET /roles/{name}][%d] rolesGetUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *RolesGetUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *RolesGetUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRolesGetInternalServerError creates a RolesGetInternalServerError with default headers values
+func NewRolesGetInternalServerError() *RolesGetInternalServerError {
+	return &RolesGetInternalServerError{}
+}
+
+/*RolesGetInternalServerError handles this case with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type RolesGetInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *RolesGetInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /roles/{name}][%d] rolesGetInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *RolesGetInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *RolesGetInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package roles
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewRolesListParams creates a new RolesListParams object
+// with the default values initialized.
+func NewRolesListParams() *RolesListParams {
+
+	return &RolesListParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewRolesListParamsWithTimeout creates a new RolesListParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewRolesListParamsWithTimeout(timeout time.Duration) *RolesListParams {
+
+	return &RolesListParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewRolesListParamsWithContext creates a new RolesListParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewRolesListParamsWithContext(ctx context.Context) *RolesListParams {
+
+	return &RolesListParams{
+
+		Context: ctx,
+	}
+}
+
+// NewRolesListParamsWithHTTPClient creates a new RolesListParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewRolesListParamsWithHTTPClient(client *http.Client) *RolesListParams {
+
+	return &RolesListParams{
+		HTTPClient: client,
+	}
+}
+
+/*RolesListParams contains all the parameters to send to the API endpoint
+for the roles list operation typically these are written to a http.Request
+*/
+type RolesListParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the roles list params
+func (o *RolesListParams) WithTimeout(timeout time.Duration) *RolesListParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the roles list params
+func (o *RolesListParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the roles list params
+func (o *RolesListParams) WithContext(ctx context.Context) *RolesListParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the roles list params
+func (o *RolesListParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the roles list params
+func (o *RolesListParams) WithHTTPClient(client *http.Client) *RolesListParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the roles list params
+func (o *RolesListParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *RolesListParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,225 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package roles
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// RolesListReader is a Reader for the RolesList structure.
+type RolesListReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *RolesListReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewRolesListOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewRolesListUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewRolesListForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewRolesListUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewRolesListInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewRolesListOK creates a RolesListOK with default headers values
+func NewRolesListOK() *RolesListOK {
+	return &RolesListOK{}
+}
+
+/*RolesListOK handles this case with default header values.
+
+All roles.
+*/
+type RolesListOK struct {
+	Payload []*models.Role
+}
+
+func (o *RolesListOK) Error() string {
+	return fmt.Sprintf("[GET /roles][%d] rolesListOK  %+v", 200, o.Payload)
+}
+
+func (o *RolesListOK) GetPayload() []*models.Role {
+	return o.Payload
+}
+
+func (o *RolesListOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRolesListUnauthorized creates a RolesListUnauthorized with default headers values
+func NewRolesListUnauthorized() *RolesListUnauthorized {
+	return &RolesListUnauthorized{}
+}
+
+/*RolesListUnauthorized handles this case with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type RolesListUnauthorized struct {
+}
+
+func (o *RolesListUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /roles][%d] rolesListUnauthorized ", 401)
+}
+
+func (o *RolesListUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewRolesListForbidden creates a RolesListForbidden with default headers values
+func NewRolesListForbidden() *RolesListForbidden {
+	return &RolesListForbidden{}
+}
+
+/*RolesListForbidden handles this case with default header values.
+
+Forbidden
+*/
+type RolesListForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *RolesListForbidden) Error() string {
+	return fmt.Sprintf("[GET /roles][%d] rolesListForbidden  %+v", 403, o.Payload)
+}
+
+func (o *RolesListForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *RolesListForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRolesListUnprocessableEntity creates a RolesListUnprocessableEntity with default headers values
+func NewRolesListUnprocessableEntity() *RolesListUnprocessableEntity {
+	return &RolesListUnprocessableEntity{}
+}
+
+/*RolesListUnprocessableEntity handles this case with default header values.
+
+Invalid role or rbac authorization is not enabled.
+*/
+type RolesListUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *RolesListUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[GET /roles][%d] rolesListUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *RolesListUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *RolesListUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRolesListInternalServerError creates a RolesListInternalServerError with default headers values
+func NewRolesListInternalServerError() *RolesListInternalServerError {
+	return &RolesListInternalServerError{}
+}
+
+/*RolesListInternalServerError handles this case with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type RolesListInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *RolesListInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /roles][%d] rolesListInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *RolesListInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *RolesListInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package roles
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// NewRolesUpdateParams creates a new RolesUpdateParams object
+// with the default values initialized.
+func NewRolesUpdateParams() *RolesUpdateParams {
+	var ()
+	return &RolesUpdateParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewRolesUpdateParamsWithTimeout creates a new RolesUpdateParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewRolesUpdateParamsWithTimeout(timeout time.Duration) *RolesUpdateParams {
+	var ()
+	return &RolesUpdateParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewRolesUpdateParamsWithContext creates a new RolesUpdateParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewRolesUpdateParamsWithContext(ctx context.Context) *RolesUpdateParams {
+	var ()
+	return &RolesUpdateParams{
+
+		Context: ctx,
+	}
+}
+
+// NewRolesUpdateParamsWithHTTPClient creates a new RolesUpdateParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewRolesUpdateParamsWithHTTPClient(client *http.Client) *RolesUpdateParams {
+	var ()
+	return &RolesUpdateParams{
+		HTTPClient: client,
+	}
+}
+
+/*RolesUpdateParams contains all the parameters to send to the API endpoint
+for the roles update operation typically these are written to a http.Request
+*/
+type RolesUpdateParams struct {
+
+	/*Body*/
+	Body *models.Role
+	/*Name
+	  name of the role
+
+	*/
+	Name string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the roles update params
+func (o *RolesUpdateParams) WithTimeout(timeout time.Duration) *RolesUpdateParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the roles update params
+func (o *RolesUpdateParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the roles update params
+func (o *RolesUpdateParams) WithContext(ctx context.Context) *RolesUpdateParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the roles update params
+func (o *RolesUpdateParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the roles update params
+func (o *RolesUpdateParams) WithHTTPClient(client *http.Client) *RolesUpdateParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the roles update params
+func (o *RolesUpdateParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the roles update params
+func (o *RolesUpdateParams) WithBody(body *models.Role) *RolesUpdateParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the roles update params
+func (o *RolesUpdateParams) SetBody(body *models.Role) {
+	o.Body = body
+}
+
+// WithName adds the name to the roles update params
+func (o *RolesUpdateParams) WithName(name string) *RolesUpdateParams {
+	o.SetName(name)
+	return o
+}
+
+// SetName adds the name to the roles update params
+func (o *RolesUpdateParams) SetName(name string) {
+	o.Name = name
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *RolesUpdateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	// path param name
+	if err := r.SetPathParam("name", o.Name); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,254 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package roles
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// RolesUpdateReader is a Reader for the RolesUpdate structure.
+type RolesUpdateReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *RolesUpdateReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewRolesUpdateOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewRolesUpdateUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewRolesUpdateForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewRolesUpdateNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewRolesUpdateUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewRolesUpdateInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewRolesUpdateOK creates a RolesUpdateOK with default headers values
+func NewRolesUpdateOK() *RolesUpdateOK {
+	return &RolesUpdateOK{}
+}
+
+/*RolesUpdateOK handles this case with default header values.
+
+Role updated.
+*/
+type RolesUpdateOK struct {
+	Payload *models.Role
+}
+
+func (o *RolesUpdateOK) Error() string {
+	return fmt.Sprintf("[PUT /roles/{name}][%d] rolesUpdateOK  %+v", 200, o.Payload)
+}
+
+func (o *RolesUpdateOK) GetPayload() *models.Role {
+	return o.Payload
+}
+
+func (o *RolesUpdateOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Role)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRolesUpdateUnauthorized creates a RolesUpdateUnauthorized with default headers values
+func NewRolesUpdateUnauthorized() *RolesUpdateUnauthorized {
+	return &RolesUpdateUnauthorized{}
+}
+
+/*RolesUpdateUnauthorized handles this case with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type RolesUpdateUnauthorized struct {
+}
+
+func (o *RolesUpdateUnauthorized) Error() string {
+	return fmt.Sprintf("[PUT /roles/{name}][%d] rolesUpdateUnauthorized ", 401)
+}
+
+func (o *RolesUpdateUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewRolesUpdateForbidden creates a RolesUpdateForbidden with default headers values
+func NewRolesUpdateForbidden() *RolesUpdateForbidden {
+	return &RolesUpdateForbidden{}
+}
+
+/*RolesUpdateForbidden handles this case with default header values.
+
+Forbidden
+*/
+type RolesUpdateForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *RolesUpdateForbidden) Error() string {
+	return fmt.Sprintf("[PUT /roles/{name}][%d] rolesUpdateForbidden  %+v", 403, o.Payload)
+}
+
+func (o *RolesUpdateForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *RolesUpdateForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRolesUpdateNotFound creates a RolesUpdateNotFound with default headers values
+func NewRolesUpdateNotFound() *RolesUpdateNotFound {
+	return &RolesUpdateNotFound{}
+}
+
+/*RolesUpdateNotFound handles this case with default header values.
+
+Not Found - Role does not exist
+*/
+type RolesUpdateNotFound struct {
+}
+
+func (o *RolesUpdateNotFound) Error() string {
+	return fmt.Sprintf("[PUT /roles/{name}][%d] rolesUpdateNotFound ", 404)
+}
+
+func (o *RolesUpdateNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewRolesUpdateUnprocessableEntity creates a RolesUpdateUnprocessableEntity with default headers values
+func NewRolesUpdateUnprocessableEntity() *RolesUpdateUnprocessableEntity {
+	return &RolesUpdateUnprocessableEntity{}
+}
+
+/*RolesUpdateUnprocessableEntity handles this case with default header values.
+
+Invalid role or rbac authorization is not enabled.
+*/
+type RolesUpdateUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *RolesUpdateUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[PUT /roles/{name}][%d] rolesUpdateUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *RolesUpdateUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *RolesUpdateUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRolesUpdateInternalServerError creates a RolesUpdateInternalServerError with default headers values
+func NewRolesUpdateInternalServerError() *RolesUpdateInternalServerError {
+	return &RolesUpdateInternalServerError{}
+}
+
+/*RolesUpdateInternalServerError handles this case with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type RolesUpdateInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *RolesUpdateInternalServerError) Error() string {
+	return fmt.Sprintf("[PUT /roles/{name}][%d] rolesUpdateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *RolesUpdateInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *RolesUpdateInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
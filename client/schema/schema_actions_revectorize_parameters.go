@@ -0,0 +1,170 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// NewSchemaActionsRevectorizeParams creates a new SchemaActionsRevectorizeParams object
+// with the default values initialized.
+func NewSchemaActionsRevectorizeParams() *SchemaActionsRevectorizeParams {
+	var ()
+	return &SchemaActionsRevectorizeParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewSchemaActionsRevectorizeParamsWithTimeout creates a new SchemaActionsRevectorizeParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewSchemaActionsRevectorizeParamsWithTimeout(timeout time.Duration) *SchemaActionsRevectorizeParams {
+	var ()
+	return &SchemaActionsRevectorizeParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewSchemaActionsRevectorizeParamsWithContext creates a new SchemaActionsRevectorizeParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewSchemaActionsRevectorizeParamsWithContext(ctx context.Context) *SchemaActionsRevectorizeParams {
+	var ()
+	return &SchemaActionsRevectorizeParams{
+
+		Context: ctx,
+	}
+}
+
+// NewSchemaActionsRevectorizeParamsWithHTTPClient creates a new SchemaActionsRevectorizeParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewSchemaActionsRevectorizeParamsWithHTTPClient(client *http.Client) *SchemaActionsRevectorizeParams {
+	var ()
+	return &SchemaActionsRevectorizeParams{
+		HTTPClient: client,
+	}
+}
+
+/*SchemaActionsRevectorizeParams contains all the parameters to send to the API endpoint
+for the schema actions revectorize operation typically these are written to a http.Request
+*/
+type SchemaActionsRevectorizeParams struct {
+
+	/*Body
+	  optional where filter to limit the revectorized actions
+
+	*/
+	Body *models.Revectorization
+	/*ClassName*/
+	ClassName string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the schema actions revectorize params
+func (o *SchemaActionsRevectorizeParams) WithTimeout(timeout time.Duration) *SchemaActionsRevectorizeParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the schema actions revectorize params
+func (o *SchemaActionsRevectorizeParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the schema actions revectorize params
+func (o *SchemaActionsRevectorizeParams) WithContext(ctx context.Context) *SchemaActionsRevectorizeParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the schema actions revectorize params
+func (o *SchemaActionsRevectorizeParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the schema actions revectorize params
+func (o *SchemaActionsRevectorizeParams) WithHTTPClient(client *http.Client) *SchemaActionsRevectorizeParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the schema actions revectorize params
+func (o *SchemaActionsRevectorizeParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the schema actions revectorize params
+func (o *SchemaActionsRevectorizeParams) WithBody(body *models.Revectorization) *SchemaActionsRevectorizeParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the schema actions revectorize params
+func (o *SchemaActionsRevectorizeParams) SetBody(body *models.Revectorization) {
+	o.Body = body
+}
+
+// WithClassName adds the className to the schema actions revectorize params
+func (o *SchemaActionsRevectorizeParams) WithClassName(className string) *SchemaActionsRevectorizeParams {
+	o.SetClassName(className)
+	return o
+}
+
+// SetClassName adds the className to the schema actions revectorize params
+func (o *SchemaActionsRevectorizeParams) SetClassName(className string) {
+	o.ClassName = className
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *SchemaActionsRevectorizeParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	// path param className
+	if err := r.SetPathParam("className", o.ClassName); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,266 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// SchemaActionsRevectorizeReader is a Reader for the SchemaActionsRevectorize structure.
+type SchemaActionsRevectorizeReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SchemaActionsRevectorizeReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 202:
+		result := NewSchemaActionsRevectorizeAccepted()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewSchemaActionsRevectorizeUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewSchemaActionsRevectorizeForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewSchemaActionsRevectorizeConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewSchemaActionsRevectorizeUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewSchemaActionsRevectorizeInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewSchemaActionsRevectorizeAccepted creates a SchemaActionsRevectorizeAccepted with default headers values
+func NewSchemaActionsRevectorizeAccepted() *SchemaActionsRevectorizeAccepted {
+	return &SchemaActionsRevectorizeAccepted{}
+}
+
+/*SchemaActionsRevectorizeAccepted handles this case with default header values.
+
+Successfully started the revectorization.
+*/
+type SchemaActionsRevectorizeAccepted struct {
+	Payload *models.Revectorization
+}
+
+func (o *SchemaActionsRevectorizeAccepted) Error() string {
+	return fmt.Sprintf("[POST /schema/actions/{className}/revectorize][%d] schemaActionsRevectorizeAccepted  %+v", 202, o.Payload)
+}
+
+func (o *SchemaActionsRevectorizeAccepted) GetPayload() *models.Revectorization {
+	return o.Payload
+}
+
+func (o *SchemaActionsRevectorizeAccepted) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Revectorization)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaActionsRevectorizeUnauthorized creates a SchemaActionsRevectorizeUnauthorized with default headers values
+func NewSchemaActionsRevectorizeUnauthorized() *SchemaActionsRevectorizeUnauthorized {
+	return &SchemaActionsRevectorizeUnauthorized{}
+}
+
+/*SchemaActionsRevectorizeUnauthorized handles this case with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type SchemaActionsRevectorizeUnauthorized struct {
+}
+
+func (o *SchemaActionsRevectorizeUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /schema/actions/{className}/revectorize][%d] schemaActionsRevectorizeUnauthorized ", 401)
+}
+
+func (o *SchemaActionsRevectorizeUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaActionsRevectorizeForbidden creates a SchemaActionsRevectorizeForbidden with default headers values
+func NewSchemaActionsRevectorizeForbidden() *SchemaActionsRevectorizeForbidden {
+	return &SchemaActionsRevectorizeForbidden{}
+}
+
+/*SchemaActionsRevectorizeForbidden handles this case with default header values.
+
+Forbidden
+*/
+type SchemaActionsRevectorizeForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *SchemaActionsRevectorizeForbidden) Error() string {
+	return fmt.Sprintf("[POST /schema/actions/{className}/revectorize][%d] schemaActionsRevectorizeForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaActionsRevectorizeForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaActionsRevectorizeForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaActionsRevectorizeConflict creates a SchemaActionsRevectorizeConflict with default headers values
+func NewSchemaActionsRevectorizeConflict() *SchemaActionsRevectorizeConflict {
+	return &SchemaActionsRevectorizeConflict{}
+}
+
+/*SchemaActionsRevectorizeConflict handles this case with default header values.
+
+A revectorization of the class is already running
+*/
+type SchemaActionsRevectorizeConflict struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *SchemaActionsRevectorizeConflict) Error() string {
+	return fmt.Sprintf("[POST /schema/actions/{className}/revectorize][%d] schemaActionsRevectorizeConflict  %+v", 409, o.Payload)
+}
+
+func (o *SchemaActionsRevectorizeConflict) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaActionsRevectorizeConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaActionsRevectorizeUnprocessableEntity creates a SchemaActionsRevectorizeUnprocessableEntity with default headers values
+func NewSchemaActionsRevectorizeUnprocessableEntity() *SchemaActionsRevectorizeUnprocessableEntity {
+	return &SchemaActionsRevectorizeUnprocessableEntity{}
+}
+
+/*SchemaActionsRevectorizeUnprocessableEntity handles this case with default header values.
+
+Invalid revectorization, e.g. the class does not exist or has no vectorizer
+*/
+type SchemaActionsRevectorizeUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *SchemaActionsRevectorizeUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /schema/actions/{className}/revectorize][%d] schemaActionsRevectorizeUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *SchemaActionsRevectorizeUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaActionsRevectorizeUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaActionsRevectorizeInternalServerError creates a SchemaActionsRevectorizeInternalServerError with default headers values
+func NewSchemaActionsRevectorizeInternalServerError() *SchemaActionsRevectorizeInternalServerError {
+	return &SchemaActionsRevectorizeInternalServerError{}
+}
+
+/*SchemaActionsRevectorizeInternalServerError handles this case with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type SchemaActionsRevectorizeInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *SchemaActionsRevectorizeInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /schema/actions/{className}/revectorize][%d] schemaActionsRevectorizeInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaActionsRevectorizeInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaActionsRevectorizeInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -214,6 +214,43 @@ func (a *Client) SchemaActionsPropertiesUpdate(params *SchemaActionsPropertiesUp
 	panic(msg)
 }
 
+/*
+SchemaActionsRevectorize recomputes the vectors of the actions of a class
+
+Start a revectorization which recomputes the vectors of all actions of the class, or of those matching the where filter, with the current vectorizer settings and stores them in batches. The revectorization runs in the background, use GET /schema/revectorizations/{id} to retrieve its progress.
+*/
+func (a *Client) SchemaActionsRevectorize(params *SchemaActionsRevectorizeParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaActionsRevectorizeAccepted, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaActionsRevectorizeParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "schema.actions.revectorize",
+		Method:             "POST",
+		PathPattern:        "/schema/actions/{className}/revectorize",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaActionsRevectorizeReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaActionsRevectorizeAccepted)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.actions.revectorize: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 SchemaActionsUpdate renames or replace the keywords of the action
 */
@@ -284,6 +321,191 @@ func (a *Client) SchemaDump(params *SchemaDumpParams, authInfo runtime.ClientAut
 	panic(msg)
 }
 
+/*
+SchemaReindexesGet views a reindex job
+
+Get the status and progress of a reindex job
+*/
+func (a *Client) SchemaReindexesGet(params *SchemaReindexesGetParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaReindexesGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaReindexesGetParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "schema.reindexes.get",
+		Method:             "GET",
+		PathPattern:        "/schema/reindexes/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaReindexesGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaReindexesGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.reindexes.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaReindexesList lists reindex jobs
+
+List the reindex jobs which were started by renaming a class or property or by dropping a property. Jobs are kept in memory, so only the jobs started since the last restart are listed.
+*/
+func (a *Client) SchemaReindexesList(params *SchemaReindexesListParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaReindexesListOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaReindexesListParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "schema.reindexes.list",
+		Method:             "GET",
+		PathPattern:        "/schema/reindexes",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaReindexesListReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaReindexesListOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.reindexes.list: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaRevectorizationsCancel cancels a running revectorization
+
+Cancel a running revectorization. Objects which have already been revectorized keep their new vectors, all other objects keep their previous vectors.
+*/
+func (a *Client) SchemaRevectorizationsCancel(params *SchemaRevectorizationsCancelParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaRevectorizationsCancelAccepted, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaRevectorizationsCancelParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "schema.revectorizations.cancel",
+		Method:             "DELETE",
+		PathPattern:        "/schema/revectorizations/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaRevectorizationsCancelReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaRevectorizationsCancelAccepted)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.revectorizations.cancel: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaRevectorizationsGet gets a revectorization
+
+Get the status and progress of a revectorization.
+*/
+func (a *Client) SchemaRevectorizationsGet(params *SchemaRevectorizationsGetParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaRevectorizationsGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaRevectorizationsGetParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "schema.revectorizations.get",
+		Method:             "GET",
+		PathPattern:        "/schema/revectorizations/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaRevectorizationsGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaRevectorizationsGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.revectorizations.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaRevectorizationsList lists revectorizations
+
+List the revectorizations, most recently started first. Revectorizations are kept in memory, so only the revectorizations started since the last restart are listed, of which at most the 100 most recent finished ones are kept. Only revectorizations of classes the user is allowed to view are listed.
+*/
+func (a *Client) SchemaRevectorizationsList(params *SchemaRevectorizationsListParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaRevectorizationsListOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaRevectorizationsListParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "schema.revectorizations.list",
+		Method:             "GET",
+		PathPattern:        "/schema/revectorizations",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaRevectorizationsListReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaRevectorizationsListOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.revectorizations.list: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 SchemaThingsCreate creates a new thing class in the ontology
 */
@@ -459,6 +681,43 @@ func (a *Client) SchemaThingsPropertiesUpdate(params *SchemaThingsPropertiesUpda
 	panic(msg)
 }
 
+/*
+SchemaThingsRevectorize recomputes the vectors of the things of a class
+
+Start a revectorization which recomputes the vectors of all things of the class, or of those matching the where filter, with the current vectorizer settings and stores them in batches. The revectorization runs in the background, use GET /schema/revectorizations/{id} to retrieve its progress.
+*/
+func (a *Client) SchemaThingsRevectorize(params *SchemaThingsRevectorizeParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaThingsRevectorizeAccepted, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaThingsRevectorizeParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "schema.things.revectorize",
+		Method:             "POST",
+		PathPattern:        "/schema/things/{className}/revectorize",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaThingsRevectorizeReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaThingsRevectorizeAccepted)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.things.revectorize: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 SchemaThingsUpdate renames or replace the keywords of the thing
 */
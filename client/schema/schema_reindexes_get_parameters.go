@@ -0,0 +1,149 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewSchemaReindexesGetParams creates a new SchemaReindexesGetParams object
+// with the default values initialized.
+func NewSchemaReindexesGetParams() *SchemaReindexesGetParams {
+	var ()
+	return &SchemaReindexesGetParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewSchemaReindexesGetParamsWithTimeout creates a new SchemaReindexesGetParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewSchemaReindexesGetParamsWithTimeout(timeout time.Duration) *SchemaReindexesGetParams {
+	var ()
+	return &SchemaReindexesGetParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewSchemaReindexesGetParamsWithContext creates a new SchemaReindexesGetParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewSchemaReindexesGetParamsWithContext(ctx context.Context) *SchemaReindexesGetParams {
+	var ()
+	return &SchemaReindexesGetParams{
+
+		Context: ctx,
+	}
+}
+
+// NewSchemaReindexesGetParamsWithHTTPClient creates a new SchemaReindexesGetParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewSchemaReindexesGetParamsWithHTTPClient(client *http.Client) *SchemaReindexesGetParams {
+	var ()
+	return &SchemaReindexesGetParams{
+		HTTPClient: client,
+	}
+}
+
+/*SchemaReindexesGetParams contains all the parameters to send to the API endpoint
+for the schema reindexes get operation typically these are written to a http.Request
+*/
+type SchemaReindexesGetParams struct {
+
+	/*ID
+	  reindex job id
+
+	*/
+	ID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the schema reindexes get params
+func (o *SchemaReindexesGetParams) WithTimeout(timeout time.Duration) *SchemaReindexesGetParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the schema reindexes get params
+func (o *SchemaReindexesGetParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the schema reindexes get params
+func (o *SchemaReindexesGetParams) WithContext(ctx context.Context) *SchemaReindexesGetParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the schema reindexes get params
+func (o *SchemaReindexesGetParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the schema reindexes get params
+func (o *SchemaReindexesGetParams) WithHTTPClient(client *http.Client) *SchemaReindexesGetParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the schema reindexes get params
+func (o *SchemaReindexesGetParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the schema reindexes get params
+func (o *SchemaReindexesGetParams) WithID(id strfmt.UUID) *SchemaReindexesGetParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the schema reindexes get params
+func (o *SchemaReindexesGetParams) SetID(id strfmt.UUID) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *SchemaReindexesGetParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
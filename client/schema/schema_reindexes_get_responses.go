@@ -0,0 +1,215 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// SchemaReindexesGetReader is a Reader for the SchemaReindexesGet structure.
+type SchemaReindexesGetReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SchemaReindexesGetReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSchemaReindexesGetOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewSchemaReindexesGetUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewSchemaReindexesGetForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewSchemaReindexesGetNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewSchemaReindexesGetInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewSchemaReindexesGetOK creates a SchemaReindexesGetOK with default headers values
+func NewSchemaReindexesGetOK() *SchemaReindexesGetOK {
+	return &SchemaReindexesGetOK{}
+}
+
+/*SchemaReindexesGetOK handles this case with default header values.
+
+Found the reindex job, returned as body
+*/
+type SchemaReindexesGetOK struct {
+	Payload *models.SchemaReindex
+}
+
+func (o *SchemaReindexesGetOK) Error() string {
+	return fmt.Sprintf("[GET /schema/reindexes/{id}][%d] schemaReindexesGetOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaReindexesGetOK) GetPayload() *models.SchemaReindex {
+	return o.Payload
+}
+
+func (o *SchemaReindexesGetOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.SchemaReindex)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaReindexesGetUnauthorized creates a SchemaReindexesGetUnauthorized with default headers values
+func NewSchemaReindexesGetUnauthorized() *SchemaReindexesGetUnauthorized {
+	return &SchemaReindexesGetUnauthorized{}
+}
+
+/*SchemaReindexesGetUnauthorized handles this case with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type SchemaReindexesGetUnauthorized struct {
+}
+
+func (o *SchemaReindexesGetUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /schema/reindexes/{id}][%d] schemaReindexesGetUnauthorized ", 401)
+}
+
+func (o *SchemaReindexesGetUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaReindexesGetForbidden creates a SchemaReindexesGetForbidden with default headers values
+func NewSchemaReindexesGetForbidden() *SchemaReindexesGetForbidden {
+	return &SchemaReindexesGetForbidden{}
+}
+
+/*SchemaReindexesGetForbidden handles this case with default header values.
+
+Forbidden
+*/
+type SchemaReindexesGetForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *SchemaReindexesGetForbidden) Error() string {
+	return fmt.Sprintf("[GET /schema/reindexes/{id}][%d] schemaReindexesGetForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaReindexesGetForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaReindexesGetForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaReindexesGetNotFound creates a SchemaReindexesGetNotFound with default headers values
+func NewSchemaReindexesGetNotFound() *SchemaReindexesGetNotFound {
+	return &SchemaReindexesGetNotFound{}
+}
+
+/*SchemaReindexesGetNotFound handles this case with default header values.
+
+Not Found - Reindex job does not exist
+*/
+type SchemaReindexesGetNotFound struct {
+}
+
+func (o *SchemaReindexesGetNotFound) Error() string {
+	return fmt.Sprintf("[GET /schema/reindexes/{id}][%d] schemaReindexesGetNotFound ", 404)
+}
+
+func (o *SchemaReindexesGetNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaReindexesGetInternalServerError creates a SchemaReindexesGetInternalServerError with default headers values
+func NewSchemaReindexesGetInternalServerError() *SchemaReindexesGetInternalServerError {
+	return &SchemaReindexesGetInternalServerError{}
+}
+
+/*SchemaReindexesGetInternalServerError handles this case with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type SchemaReindexesGetInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *SchemaReindexesGetInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /schema/reindexes/{id}][%d] schemaReindexesGetInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaReindexesGetInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaReindexesGetInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewSchemaReindexesListParams creates a new SchemaReindexesListParams object
+// with the default values initialized.
+func NewSchemaReindexesListParams() *SchemaReindexesListParams {
+
+	return &SchemaReindexesListParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewSchemaReindexesListParamsWithTimeout creates a new SchemaReindexesListParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewSchemaReindexesListParamsWithTimeout(timeout time.Duration) *SchemaReindexesListParams {
+
+	return &SchemaReindexesListParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewSchemaReindexesListParamsWithContext creates a new SchemaReindexesListParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewSchemaReindexesListParamsWithContext(ctx context.Context) *SchemaReindexesListParams {
+
+	return &SchemaReindexesListParams{
+
+		Context: ctx,
+	}
+}
+
+// NewSchemaReindexesListParamsWithHTTPClient creates a new SchemaReindexesListParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewSchemaReindexesListParamsWithHTTPClient(client *http.Client) *SchemaReindexesListParams {
+
+	return &SchemaReindexesListParams{
+		HTTPClient: client,
+	}
+}
+
+/*SchemaReindexesListParams contains all the parameters to send to the API endpoint
+for the schema reindexes list operation typically these are written to a http.Request
+*/
+type SchemaReindexesListParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the schema reindexes list params
+func (o *SchemaReindexesListParams) WithTimeout(timeout time.Duration) *SchemaReindexesListParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the schema reindexes list params
+func (o *SchemaReindexesListParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the schema reindexes list params
+func (o *SchemaReindexesListParams) WithContext(ctx context.Context) *SchemaReindexesListParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the schema reindexes list params
+func (o *SchemaReindexesListParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the schema reindexes list params
+func (o *SchemaReindexesListParams) WithHTTPClient(client *http.Client) *SchemaReindexesListParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the schema reindexes list params
+func (o *SchemaReindexesListParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *SchemaReindexesListParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
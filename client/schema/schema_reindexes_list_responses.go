@@ -0,0 +1,186 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// SchemaReindexesListReader is a Reader for the SchemaReindexesList structure.
+type SchemaReindexesListReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SchemaReindexesListReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSchemaReindexesListOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewSchemaReindexesListUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewSchemaReindexesListForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewSchemaReindexesListInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewSchemaReindexesListOK creates a SchemaReindexesListOK with default headers values
+func NewSchemaReindexesListOK() *SchemaReindexesListOK {
+	return &SchemaReindexesListOK{}
+}
+
+/*SchemaReindexesListOK handles this case with default header values.
+
+Successfully listed the reindex jobs.
+*/
+type SchemaReindexesListOK struct {
+	Payload []*models.SchemaReindex
+}
+
+func (o *SchemaReindexesListOK) Error() string {
+	return fmt.Sprintf("[GET /schema/reindexes][%d] schemaReindexesListOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaReindexesListOK) GetPayload() []*models.SchemaReindex {
+	return o.Payload
+}
+
+func (o *SchemaReindexesListOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaReindexesListUnauthorized creates a SchemaReindexesListUnauthorized with default headers values
+func NewSchemaReindexesListUnauthorized() *SchemaReindexesListUnauthorized {
+	return &SchemaReindexesListUnauthorized{}
+}
+
+/*SchemaReindexesListUnauthorized handles this case with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type SchemaReindexesListUnauthorized struct {
+}
+
+func (o *SchemaReindexesListUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /schema/reindexes][%d] schemaReindexesListUnauthorized ", 401)
+}
+
+func (o *SchemaReindexesListUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaReindexesListForbidden creates a SchemaReindexesListForbidden with default headers values
+func NewSchemaReindexesListForbidden() *SchemaReindexesListForbidden {
+	return &SchemaReindexesListForbidden{}
+}
+
+/*SchemaReindexesListForbidden handles this case with default header values.
+
+Forbidden
+*/
+type SchemaReindexesListForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *SchemaReindexesListForbidden) Error() string {
+	return fmt.Sprintf("[GET /schema/reindexes][%d] schemaReindexesListForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaReindexesListForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaReindexesListForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaReindexesListInternalServerError creates a SchemaReindexesListInternalServerError with default headers values
+func NewSchemaReindexesListInternalServerError() *SchemaReindexesListInternalServerError {
+	return &SchemaReindexesListInternalServerError{}
+}
+
+/*SchemaReindexesListInternalServerError handles this case with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type SchemaReindexesListInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *SchemaReindexesListInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /schema/reindexes][%d] schemaReindexesListInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaReindexesListInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaReindexesListInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
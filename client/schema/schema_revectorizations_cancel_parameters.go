@@ -0,0 +1,149 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewSchemaRevectorizationsCancelParams creates a new SchemaRevectorizationsCancelParams object
+// with the default values initialized.
+func NewSchemaRevectorizationsCancelParams() *SchemaRevectorizationsCancelParams {
+	var ()
+	return &SchemaRevectorizationsCancelParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewSchemaRevectorizationsCancelParamsWithTimeout creates a new SchemaRevectorizationsCancelParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewSchemaRevectorizationsCancelParamsWithTimeout(timeout time.Duration) *SchemaRevectorizationsCancelParams {
+	var ()
+	return &SchemaRevectorizationsCancelParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewSchemaRevectorizationsCancelParamsWithContext creates a new SchemaRevectorizationsCancelParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewSchemaRevectorizationsCancelParamsWithContext(ctx context.Context) *SchemaRevectorizationsCancelParams {
+	var ()
+	return &SchemaRevectorizationsCancelParams{
+
+		Context: ctx,
+	}
+}
+
+// NewSchemaRevectorizationsCancelParamsWithHTTPClient creates a new SchemaRevectorizationsCancelParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewSchemaRevectorizationsCancelParamsWithHTTPClient(client *http.Client) *SchemaRevectorizationsCancelParams {
+	var ()
+	return &SchemaRevectorizationsCancelParams{
+		HTTPClient: client,
+	}
+}
+
+/*SchemaRevectorizationsCancelParams contains all the parameters to send to the API endpoint
+for the schema revectorizations cancel operation typically these are written to a http.Request
+*/
+type SchemaRevectorizationsCancelParams struct {
+
+	/*ID
+	  revectorization id
+
+	*/
+	ID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the schema revectorizations cancel params
+func (o *SchemaRevectorizationsCancelParams) WithTimeout(timeout time.Duration) *SchemaRevectorizationsCancelParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the schema revectorizations cancel params
+func (o *SchemaRevectorizationsCancelParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the schema revectorizations cancel params
+func (o *SchemaRevectorizationsCancelParams) WithContext(ctx context.Context) *SchemaRevectorizationsCancelParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the schema revectorizations cancel params
+func (o *SchemaRevectorizationsCancelParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the schema revectorizations cancel params
+func (o *SchemaRevectorizationsCancelParams) WithHTTPClient(client *http.Client) *SchemaRevectorizationsCancelParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the schema revectorizations cancel params
+func (o *SchemaRevectorizationsCancelParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the schema revectorizations cancel params
+func (o *SchemaRevectorizationsCancelParams) WithID(id strfmt.UUID) *SchemaRevectorizationsCancelParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the schema revectorizations cancel params
+func (o *SchemaRevectorizationsCancelParams) SetID(id strfmt.UUID) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *SchemaRevectorizationsCancelParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,254 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// SchemaRevectorizationsCancelReader is a Reader for the SchemaRevectorizationsCancel structure.
+type SchemaRevectorizationsCancelReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SchemaRevectorizationsCancelReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 202:
+		result := NewSchemaRevectorizationsCancelAccepted()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewSchemaRevectorizationsCancelUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewSchemaRevectorizationsCancelForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewSchemaRevectorizationsCancelNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewSchemaRevectorizationsCancelConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewSchemaRevectorizationsCancelInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewSchemaRevectorizationsCancelAccepted creates a SchemaRevectorizationsCancelAccepted with default headers values
+func NewSchemaRevectorizationsCancelAccepted() *SchemaRevectorizationsCancelAccepted {
+	return &SchemaRevectorizationsCancelAccepted{}
+}
+
+/*SchemaRevectorizationsCancelAccepted handles this case with default header values.
+
+Cancellation requested, the revectorization is returned as body
+*/
+type SchemaRevectorizationsCancelAccepted struct {
+	Payload *models.Revectorization
+}
+
+func (o *SchemaRevectorizationsCancelAccepted) Error() string {
+	return fmt.Sprintf("[DELETE /schema/revectorizations/{id}][%d] schemaRevectorizationsCancelAccepted  %+v", 202, o.Payload)
+}
+
+func (o *SchemaRevectorizationsCancelAccepted) GetPayload() *models.Revectorization {
+	return o.Payload
+}
+
+func (o *SchemaRevectorizationsCancelAccepted) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Revectorization)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaRevectorizationsCancelUnauthorized creates a SchemaRevectorizationsCancelUnauthorized with default headers values
+func NewSchemaRevectorizationsCancelUnauthorized() *SchemaRevectorizationsCancelUnauthorized {
+	return &SchemaRevectorizationsCancelUnauthorized{}
+}
+
+/*SchemaRevectorizationsCancelUnauthorized handles this case with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type SchemaRevectorizationsCancelUnauthorized struct {
+}
+
+func (o *SchemaRevectorizationsCancelUnauthorized) Error() string {
+	return fmt.Sprintf("[DELETE /schema/revectorizations/{id}][%d] schemaRevectorizationsCancelUnauthorized ", 401)
+}
+
+func (o *SchemaRevectorizationsCancelUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaRevectorizationsCancelForbidden creates a SchemaRevectorizationsCancelForbidden with default headers values
+func NewSchemaRevectorizationsCancelForbidden() *SchemaRevectorizationsCancelForbidden {
+	return &SchemaRevectorizationsCancelForbidden{}
+}
+
+/*SchemaRevectorizationsCancelForbidden handles this case with default header values.
+
+Forbidden
+*/
+type SchemaRevectorizationsCancelForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *SchemaRevectorizationsCancelForbidden) Error() string {
+	return fmt.Sprintf("[DELETE /schema/revectorizations/{id}][%d] schemaRevectorizationsCancelForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaRevectorizationsCancelForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaRevectorizationsCancelForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaRevectorizationsCancelNotFound creates a SchemaRevectorizationsCancelNotFound with default headers values
+func NewSchemaRevectorizationsCancelNotFound() *SchemaRevectorizationsCancelNotFound {
+	return &SchemaRevectorizationsCancelNotFound{}
+}
+
+/*SchemaRevectorizationsCancelNotFound handles this case with default header values.
+
+Not Found - Revectorization does not exist
+*/
+type SchemaRevectorizationsCancelNotFound struct {
+}
+
+func (o *SchemaRevectorizationsCancelNotFound) Error() string {
+	return fmt.Sprintf("[DELETE /schema/revectorizations/{id}][%d] schemaRevectorizationsCancelNotFound ", 404)
+}
+
+func (o *SchemaRevectorizationsCancelNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaRevectorizationsCancelConflict creates a SchemaRevectorizationsCancelConflict with default headers values
+func NewSchemaRevectorizationsCancelConflict() *SchemaRevectorizationsCancelConflict {
+	return &SchemaRevectorizationsCancelConflict{}
+}
+
+/*SchemaRevectorizationsCancelConflict handles this case with default header values.
+
+The revectorization is not running
+*/
+type SchemaRevectorizationsCancelConflict struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *SchemaRevectorizationsCancelConflict) Error() string {
+	return fmt.Sprintf("[DELETE /schema/revectorizations/{id}][%d] schemaRevectorizationsCancelConflict  %+v", 409, o.Payload)
+}
+
+func (o *SchemaRevectorizationsCancelConflict) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaRevectorizationsCancelConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaRevectorizationsCancelInternalServerError creates a SchemaRevectorizationsCancelInternalServerError with default headers values
+func NewSchemaRevectorizationsCancelInternalServerError() *SchemaRevectorizationsCancelInternalServerError {
+	return &SchemaRevectorizationsCancelInternalServerError{}
+}
+
+/*SchemaRevectorizationsCancelInternalServerError handles this case with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type SchemaRevectorizationsCancelInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *SchemaRevectorizationsCancelInternalServerError) Error() string {
+	return fmt.Sprintf("[DELETE /schema/revectorizations/{id}][%d] schemaRevectorizationsCancelInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaRevectorizationsCancelInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaRevectorizationsCancelInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
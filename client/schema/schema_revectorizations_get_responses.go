@@ -0,0 +1,215 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// SchemaRevectorizationsGetReader is a Reader for the SchemaRevectorizationsGet structure.
+type SchemaRevectorizationsGetReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SchemaRevectorizationsGetReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSchemaRevectorizationsGetOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewSchemaRevectorizationsGetUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewSchemaRevectorizationsGetForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewSchemaRevectorizationsGetNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewSchemaRevectorizationsGetInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewSchemaRevectorizationsGetOK creates a SchemaRevectorizationsGetOK with default headers values
+func NewSchemaRevectorizationsGetOK() *SchemaRevectorizationsGetOK {
+	return &SchemaRevectorizationsGetOK{}
+}
+
+/*SchemaRevectorizationsGetOK handles this case with default header values.
+
+Found the revectorization, returned as body
+*/
+type SchemaRevectorizationsGetOK struct {
+	Payload *models.Revectorization
+}
+
+func (o *SchemaRevectorizationsGetOK) Error() string {
+	return fmt.Sprintf("[GET /schema/revectorizations/{id}][%d] schemaRevectorizationsGetOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaRevectorizationsGetOK) GetPayload() *models.Revectorization {
+	return o.Payload
+}
+
+func (o *SchemaRevectorizationsGetOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Revectorization)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaRevectorizationsGetUnauthorized creates a SchemaRevectorizationsGetUnauthorized with default headers values
+func NewSchemaRevectorizationsGetUnauthorized() *SchemaRevectorizationsGetUnauthorized {
+	return &SchemaRevectorizationsGetUnauthorized{}
+}
+
+/*SchemaRevectorizationsGetUnauthorized handles this case with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type SchemaRevectorizationsGetUnauthorized struct {
+}
+
+func (o *SchemaRevectorizationsGetUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /schema/revectorizations/{id}][%d] schemaRevectorizationsGetUnauthorized ", 401)
+}
+
+func (o *SchemaRevectorizationsGetUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaRevectorizationsGetForbidden creates a SchemaRevectorizationsGetForbidden with default headers values
+func NewSchemaRevectorizationsGetForbidden() *SchemaRevectorizationsGetForbidden {
+	return &SchemaRevectorizationsGetForbidden{}
+}
+
+/*SchemaRevectorizationsGetForbidden handles this case with default header values.
+
+Forbidden
+*/
+type SchemaRevectorizationsGetForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *SchemaRevectorizationsGetForbidden) Error() string {
+	return fmt.Sprintf("[GET /schema/revectorizations/{id}][%d] schemaRevectorizationsGetForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaRevectorizationsGetForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaRevectorizationsGetForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaRevectorizationsGetNotFound creates a SchemaRevectorizationsGetNotFound with default headers values
+func NewSchemaRevectorizationsGetNotFound() *SchemaRevectorizationsGetNotFound {
+	return &SchemaRevectorizationsGetNotFound{}
+}
+
+/*SchemaRevectorizationsGetNotFound handles this case with default header values.
+
+Not Found - Revectorization does not exist
+*/
+type SchemaRevectorizationsGetNotFound struct {
+}
+
+func (o *SchemaRevectorizationsGetNotFound) Error() string {
+	return fmt.Sprintf("[GET /schema/revectorizations/{id}][%d] schemaRevectorizationsGetNotFound ", 404)
+}
+
+func (o *SchemaRevectorizationsGetNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaRevectorizationsGetInternalServerError creates a SchemaRevectorizationsGetInternalServerError with default headers values
+func NewSchemaRevectorizationsGetInternalServerError() *SchemaRevectorizationsGetInternalServerError {
+	return &SchemaRevectorizationsGetInternalServerError{}
+}
+
+/*SchemaRevectorizationsGetInternalServerError handles this case with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type SchemaRevectorizationsGetInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *SchemaRevectorizationsGetInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /schema/revectorizations/{id}][%d] schemaRevectorizationsGetInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaRevectorizationsGetInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaRevectorizationsGetInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewSchemaRevectorizationsListParams creates a new SchemaRevectorizationsListParams object
+// with the default values initialized.
+func NewSchemaRevectorizationsListParams() *SchemaRevectorizationsListParams {
+
+	return &SchemaRevectorizationsListParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewSchemaRevectorizationsListParamsWithTimeout creates a new SchemaRevectorizationsListParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewSchemaRevectorizationsListParamsWithTimeout(timeout time.Duration) *SchemaRevectorizationsListParams {
+
+	return &SchemaRevectorizationsListParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewSchemaRevectorizationsListParamsWithContext creates a new SchemaRevectorizationsListParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewSchemaRevectorizationsListParamsWithContext(ctx context.Context) *SchemaRevectorizationsListParams {
+
+	return &SchemaRevectorizationsListParams{
+
+		Context: ctx,
+	}
+}
+
+// NewSchemaRevectorizationsListParamsWithHTTPClient creates a new SchemaRevectorizationsListParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewSchemaRevectorizationsListParamsWithHTTPClient(client *http.Client) *SchemaRevectorizationsListParams {
+
+	return &SchemaRevectorizationsListParams{
+		HTTPClient: client,
+	}
+}
+
+/*SchemaRevectorizationsListParams contains all the parameters to send to the API endpoint
+for the schema revectorizations list operation typically these are written to a http.Request
+*/
+type SchemaRevectorizationsListParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the schema revectorizations list params
+func (o *SchemaRevectorizationsListParams) WithTimeout(timeout time.Duration) *SchemaRevectorizationsListParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the schema revectorizations list params
+func (o *SchemaRevectorizationsListParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the schema revectorizations list params
+func (o *SchemaRevectorizationsListParams) WithContext(ctx context.Context) *SchemaRevectorizationsListParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the schema revectorizations list params
+func (o *SchemaRevectorizationsListParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the schema revectorizations list params
+func (o *SchemaRevectorizationsListParams) WithHTTPClient(client *http.Client) *SchemaRevectorizationsListParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the schema revectorizations list params
+func (o *SchemaRevectorizationsListParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *SchemaRevectorizationsListParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// SchemaRevectorizationsListReader is a Reader for the SchemaRevectorizationsList structure.
+type SchemaRevectorizationsListReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SchemaRevectorizationsListReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSchemaRevectorizationsListOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewSchemaRevectorizationsListUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewSchemaRevectorizationsListForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewSchemaRevectorizationsListInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewSchemaRevectorizationsListOK creates a SchemaRevectorizationsListOK with default headers values
+func NewSchemaRevectorizationsListOK() *SchemaRevectorizationsListOK {
+	return &SchemaRevectorizationsListOK{}
+}
+
+/*SchemaRevectorizationsListOK handles this case with default header values.
+
+Successfully listed the revectorizations.
+*/
+type SchemaRevectorizationsListOK struct {
+	Payload []*models.Revectorization
+}
+
+func (o *SchemaRevectorizationsListOK) Error() string {
+	return fmt.Sprintf("[GET /schema/revectorizations][%d] schemaRevectorizationsListOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaRevectorizationsListOK) GetPayload() []*models.Revectorization {
+	return o.Payload
+}
+
+func (o *SchemaRevectorizationsListOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaRevectorizationsListUnauthorized creates a SchemaRevectorizationsListUnauthorized with default headers values
+func NewSchemaRevectorizationsListUnauthorized() *SchemaRevectorizationsListUnauthorized {
+	return &SchemaRevectorizationsListUnauthorized{}
+}
+
+/*SchemaRevectorizationsListUnauthorized handles this case with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type SchemaRevectorizationsListUnauthorized struct {
+}
+
+func (o *SchemaRevectorizationsListUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /schema/revectorizations][%d] schemaRevectorizationsListUnauthorized ", 401)
+}
+
+func (o *SchemaRevectorizationsListUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaRevectorizationsListForbidden creates a SchemaRevectorizationsListForbidden with default headers values
+func NewSchemaRevectorizationsListForbidden() *SchemaRevectorizationsListForbidden {
+	return &SchemaRevectorizationsListForbidden{}
+}
+
+/*SchemaRevectorizationsListForbidden handles this case with default header values.
+
+Forbidden
+*/
+type SchemaRevectorizationsListForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *SchemaRevectorizationsListForbidden) Error() string {
+	return fmt.Sprintf("[GET /schema/revectorizations][%d] schemaRevectorizationsListForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaRevectorizationsListForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaRevectorizationsListForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaRevectorizationsListInternalServerError creates a SchemaRevectorizationsListInternalServerError with default headers values
+func NewSchemaRevectorizationsListInternalServerError() *SchemaRevectorizationsListInternalServerError {
+	return &SchemaRevectorizationsListInternalServerError{}
+}
+
+/*SchemaRevectorizationsListInternalServerError handles this case with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type SchemaRevectorizationsListInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *SchemaRevectorizationsListInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /schema/revectorizations][%d] schemaRevectorizationsListInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaRevectorizationsListInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaRevectorizationsListInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,266 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// SchemaThingsRevectorizeReader is a Reader for the SchemaThingsRevectorize structure.
+type SchemaThingsRevectorizeReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SchemaThingsRevectorizeReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 202:
+		result := NewSchemaThingsRevectorizeAccepted()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewSchemaThingsRevectorizeUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewSchemaThingsRevectorizeForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewSchemaThingsRevectorizeConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewSchemaThingsRevectorizeUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewSchemaThingsRevectorizeInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewSchemaThingsRevectorizeAccepted creates a SchemaThingsRevectorizeAccepted with default headers values
+func NewSchemaThingsRevectorizeAccepted() *SchemaThingsRevectorizeAccepted {
+	return &SchemaThingsRevectorizeAccepted{}
+}
+
+/*SchemaThingsRevectorizeAccepted handles this case with default header values.
+
+Successfully started the revectorization.
+*/
+type SchemaThingsRevectorizeAccepted struct {
+	Payload *models.Revectorization
+}
+
+func (o *SchemaThingsRevectorizeAccepted) Error() string {
+	return fmt.Sprintf("[POST /schema/things/{className}/revectorize][%d] schemaThingsRevectorizeAccepted  %+v", 202, o.Payload)
+}
+
+func (o *SchemaThingsRevectorizeAccepted) GetPayload() *models.Revectorization {
+	return o.Payload
+}
+
+func (o *SchemaThingsRevectorizeAccepted) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Revectorization)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaThingsRevectorizeUnauthorized creates a SchemaThingsRevectorizeUnauthorized with default headers values
+func NewSchemaThingsRevectorizeUnauthorized() *SchemaThingsRevectorizeUnauthorized {
+	return &SchemaThingsRevectorizeUnauthorized{}
+}
+
+/*SchemaThingsRevectorizeUnauthorized handles this case with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type SchemaThingsRevectorizeUnauthorized struct {
+}
+
+func (o *SchemaThingsRevectorizeUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /schema/things/{className}/revectorize][%d] schemaThingsRevectorizeUnauthorized ", 401)
+}
+
+func (o *SchemaThingsRevectorizeUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaThingsRevectorizeForbidden creates a SchemaThingsRevectorizeForbidden with default headers values
+func NewSchemaThingsRevectorizeForbidden() *SchemaThingsRevectorizeForbidden {
+	return &SchemaThingsRevectorizeForbidden{}
+}
+
+/*SchemaThingsRevectorizeForbidden handles this case with default header values.
+
+Forbidden
+*/
+type SchemaThingsRevectorizeForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *SchemaThingsRevectorizeForbidden) Error() string {
+	return fmt.Sprintf("[POST /schema/things/{className}/revectorize][%d] schemaThingsRevectorizeForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaThingsRevectorizeForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaThingsRevectorizeForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaThingsRevectorizeConflict creates a SchemaThingsRevectorizeConflict with default headers values
+func NewSchemaThingsRevectorizeConflict() *SchemaThingsRevectorizeConflict {
+	return &SchemaThingsRevectorizeConflict{}
+}
+
+/*SchemaThingsRevectorizeConflict handles this case with default header values.
+
+A revectorization of the class is already running
+*/
+type SchemaThingsRevectorizeConflict struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *SchemaThingsRevectorizeConflict) Error() string {
+	return fmt.Sprintf("[POST /schema/things/{className}/revectorize][%d] schemaThingsRevectorizeConflict  %+v", 409, o.Payload)
+}
+
+func (o *SchemaThingsRevectorizeConflict) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaThingsRevectorizeConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaThingsRevectorizeUnprocessableEntity creates a SchemaThingsRevectorizeUnprocessableEntity with default headers values
+func NewSchemaThingsRevectorizeUnprocessableEntity() *SchemaThingsRevectorizeUnprocessableEntity {
+	return &SchemaThingsRevectorizeUnprocessableEntity{}
+}
+
+/*SchemaThingsRevectorizeUnprocessableEntity handles this case with default header values.
+
+Invalid revectorization, e.g. the class does not exist or has no vectorizer
+*/
+type SchemaThingsRevectorizeUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *SchemaThingsRevectorizeUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /schema/things/{className}/revectorize][%d] schemaThingsRevectorizeUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *SchemaThingsRevectorizeUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaThingsRevectorizeUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaThingsRevectorizeInternalServerError creates a SchemaThingsRevectorizeInternalServerError with default headers values
+func NewSchemaThingsRevectorizeInternalServerError() *SchemaThingsRevectorizeInternalServerError {
+	return &SchemaThingsRevectorizeInternalServerError{}
+}
+
+/*SchemaThingsRevectorizeInternalServerError handles this case with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type SchemaThingsRevectorizeInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *SchemaThingsRevectorizeInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /schema/things/{className}/revectorize][%d] schemaThingsRevectorizeInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaThingsRevectorizeInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaThingsRevectorizeInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
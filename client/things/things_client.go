@@ -113,6 +113,43 @@ func (a *Client) ThingsDelete(params *ThingsDeleteParams, authInfo runtime.Clien
 	panic(msg)
 }
 
+/*
+ThingsExport exports all things of a class
+
+Streams all Things of a class as newline-delimited JSON, one Thing per line. Each line contains the schema, the timestamps, the vector weights and the stored vector of the Thing, so that the export can be imported again as-is through the batch endpoint.
+*/
+func (a *Client) ThingsExport(params *ThingsExportParams, authInfo runtime.ClientAuthInfoWriter) (*ThingsExportOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewThingsExportParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "things.export",
+		Method:             "GET",
+		PathPattern:        "/things/export",
+		ProducesMediaTypes: []string{"application/x-ndjson"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ThingsExportReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ThingsExportOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for things.export: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 ThingsGet gets a thing based on its UUID
 
@@ -61,6 +61,12 @@ func (o *ThingsDeleteReader) ReadResponse(response runtime.ClientResponse, consu
 			return nil, err
 		}
 		return nil, result
+	case 409:
+		result := NewThingsDeleteConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewThingsDeleteInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -169,6 +175,39 @@ func (o *ThingsDeleteNotFound) readResponse(response runtime.ClientResponse, con
 	return nil
 }
 
+// NewThingsDeleteConflict creates a ThingsDeleteConflict with default headers values
+func NewThingsDeleteConflict() *ThingsDeleteConflict {
+	return &ThingsDeleteConflict{}
+}
+
+/*ThingsDeleteConflict handles this case with default header values.
+
+The object is still referenced through a reference property with the onDelete policy 'restrict'.
+*/
+type ThingsDeleteConflict struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *ThingsDeleteConflict) Error() string {
+	return fmt.Sprintf("[DELETE /things/{id}][%d] thingsDeleteConflict  %+v", 409, o.Payload)
+}
+
+func (o *ThingsDeleteConflict) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ThingsDeleteConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewThingsDeleteInternalServerError creates a ThingsDeleteInternalServerError with default headers values
 func NewThingsDeleteInternalServerError() *ThingsDeleteInternalServerError {
 	return &ThingsDeleteInternalServerError{}
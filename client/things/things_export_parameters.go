@@ -0,0 +1,153 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package things
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewThingsExportParams creates a new ThingsExportParams object
+// with the default values initialized.
+func NewThingsExportParams() *ThingsExportParams {
+	var ()
+	return &ThingsExportParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewThingsExportParamsWithTimeout creates a new ThingsExportParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewThingsExportParamsWithTimeout(timeout time.Duration) *ThingsExportParams {
+	var ()
+	return &ThingsExportParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewThingsExportParamsWithContext creates a new ThingsExportParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewThingsExportParamsWithContext(ctx context.Context) *ThingsExportParams {
+	var ()
+	return &ThingsExportParams{
+
+		Context: ctx,
+	}
+}
+
+// NewThingsExportParamsWithHTTPClient creates a new ThingsExportParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewThingsExportParamsWithHTTPClient(client *http.Client) *ThingsExportParams {
+	var ()
+	return &ThingsExportParams{
+		HTTPClient: client,
+	}
+}
+
+/*ThingsExportParams contains all the parameters to send to the API endpoint
+for the things export operation typically these are written to a http.Request
+*/
+type ThingsExportParams struct {
+
+	/*Class
+	  The name of the class to export.
+
+	*/
+	Class string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the things export params
+func (o *ThingsExportParams) WithTimeout(timeout time.Duration) *ThingsExportParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the things export params
+func (o *ThingsExportParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the things export params
+func (o *ThingsExportParams) WithContext(ctx context.Context) *ThingsExportParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the things export params
+func (o *ThingsExportParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the things export params
+func (o *ThingsExportParams) WithHTTPClient(client *http.Client) *ThingsExportParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the things export params
+func (o *ThingsExportParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClass adds the class to the things export params
+func (o *ThingsExportParams) WithClass(class string) *ThingsExportParams {
+	o.SetClass(class)
+	return o
+}
+
+// SetClass adds the class to the things export params
+func (o *ThingsExportParams) SetClass(class string) {
+	o.Class = class
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ThingsExportParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// query param class
+	qrClass := o.Class
+	qClass := qrClass
+	if qClass != "" {
+		if err := r.SetQueryParam("class", qClass); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,215 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package things
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/semi-technologies/weaviate/entities/models"
+)
+
+// ThingsExportReader is a Reader for the ThingsExport structure.
+type ThingsExportReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ThingsExportReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewThingsExportOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewThingsExportUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewThingsExportForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewThingsExportNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewThingsExportInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewThingsExportOK creates a ThingsExportOK with default headers values
+func NewThingsExportOK() *ThingsExportOK {
+	return &ThingsExportOK{}
+}
+
+/*ThingsExportOK handles this case with default header values.
+
+Successful response, the body contains one Thing per line.
+*/
+type ThingsExportOK struct {
+}
+
+func (o *ThingsExportOK) Error() string {
+	return fmt.Sprintf("[GET /things/export][%d] thingsExportOK ", 200)
+}
+
+func (o *ThingsExportOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewThingsExportUnauthorized creates a ThingsExportUnauthorized with default headers values
+func NewThingsExportUnauthorized() *ThingsExportUnauthorized {
+	return &ThingsExportUnauthorized{}
+}
+
+/*ThingsExportUnauthorized handles this case with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type ThingsExportUnauthorized struct {
+}
+
+func (o *ThingsExportUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /things/export][%d] thingsExportUnauthorized ", 401)
+}
+
+func (o *ThingsExportUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewThingsExportForbidden creates a ThingsExportForbidden with default headers values
+func NewThingsExportForbidden() *ThingsExportForbidden {
+	return &ThingsExportForbidden{}
+}
+
+/*ThingsExportForbidden handles this case with default header values.
+
+Forbidden
+*/
+type ThingsExportForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *ThingsExportForbidden) Error() string {
+	return fmt.Sprintf("[GET /things/export][%d] thingsExportForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ThingsExportForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ThingsExportForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewThingsExportNotFound creates a ThingsExportNotFound with default headers values
+func NewThingsExportNotFound() *ThingsExportNotFound {
+	return &ThingsExportNotFound{}
+}
+
+/*ThingsExportNotFound handles this case with default header values.
+
+There is no Thing class with this name.
+*/
+type ThingsExportNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *ThingsExportNotFound) Error() string {
+	return fmt.Sprintf("[GET /things/export][%d] thingsExportNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ThingsExportNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ThingsExportNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewThingsExportInternalServerError creates a ThingsExportInternalServerError with default headers values
+func NewThingsExportInternalServerError() *ThingsExportInternalServerError {
+	return &ThingsExportInternalServerError{}
+}
+
+/*ThingsExportInternalServerError handles this case with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type ThingsExportInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *ThingsExportInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /things/export][%d] thingsExportInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ThingsExportInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ThingsExportInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -75,6 +75,11 @@ for the things list operation typically these are written to a http.Request
 */
 type ThingsListParams struct {
 
+	/*After
+	  Only return items after this cursor, such as the continuationToken of a previous page. Items are ordered by their id. Cannot be combined with 'offset'.
+
+	*/
+	After *string
 	/*Limit
 	  The maximum number of items to be returned per page. Default value is set in Weaviate config.
 
@@ -85,6 +90,16 @@ type ThingsListParams struct {
 
 	*/
 	Meta *bool
+	/*Offset
+	  The number of items to skip. Cannot be combined with 'after'.
+
+	*/
+	Offset *int64
+	/*Sort
+	  Comma-separated list of properties to sort the items by, each optionally followed by ':asc' or ':desc', such as 'price:desc,_created'. Primitive properties other than text, geoCoordinates and phoneNumber can be used, as well as '_created' and '_updated'. The order defaults to ascending. Items without a value come last. Cannot be combined with 'after'.
+
+	*/
+	Sort *string
 
 	timeout    time.Duration
 	Context    context.Context
@@ -124,6 +139,17 @@ func (o *ThingsListParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithAfter adds the after to the things list params
+func (o *ThingsListParams) WithAfter(after *string) *ThingsListParams {
+	o.SetAfter(after)
+	return o
+}
+
+// SetAfter adds the after to the things list params
+func (o *ThingsListParams) SetAfter(after *string) {
+	o.After = after
+}
+
 // WithLimit adds the limit to the things list params
 func (o *ThingsListParams) WithLimit(limit *int64) *ThingsListParams {
 	o.SetLimit(limit)
@@ -146,6 +172,28 @@ func (o *ThingsListParams) SetMeta(meta *bool) {
 	o.Meta = meta
 }
 
+// WithOffset adds the offset to the things list params
+func (o *ThingsListParams) WithOffset(offset *int64) *ThingsListParams {
+	o.SetOffset(offset)
+	return o
+}
+
+// SetOffset adds the offset to the things list params
+func (o *ThingsListParams) SetOffset(offset *int64) {
+	o.Offset = offset
+}
+
+// WithSort adds the sort to the things list params
+func (o *ThingsListParams) WithSort(sort *string) *ThingsListParams {
+	o.SetSort(sort)
+	return o
+}
+
+// SetSort adds the sort to the things list params
+func (o *ThingsListParams) SetSort(sort *string) {
+	o.Sort = sort
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *ThingsListParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -154,6 +202,22 @@ func (o *ThingsListParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Re
 	}
 	var res []error
 
+	if o.After != nil {
+
+		// query param after
+		var qrAfter string
+		if o.After != nil {
+			qrAfter = *o.After
+		}
+		qAfter := qrAfter
+		if qAfter != "" {
+			if err := r.SetQueryParam("after", qAfter); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if o.Limit != nil {
 
 		// query param limit
@@ -186,6 +250,38 @@ func (o *ThingsListParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Re
 
 	}
 
+	if o.Offset != nil {
+
+		// query param offset
+		var qrOffset int64
+		if o.Offset != nil {
+			qrOffset = *o.Offset
+		}
+		qOffset := swag.FormatInt64(qrOffset)
+		if qOffset != "" {
+			if err := r.SetQueryParam("offset", qOffset); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Sort != nil {
+
+		// query param sort
+		var qrSort string
+		if o.Sort != nil {
+			qrSort = *o.Sort
+		}
+		qSort := qrSort
+		if qSort != "" {
+			if err := r.SetQueryParam("sort", qSort); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -61,6 +61,12 @@ func (o *ThingsListReader) ReadResponse(response runtime.ClientResponse, consume
 			return nil, err
 		}
 		return nil, result
+	case 422:
+		result := NewThingsListUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewThingsListInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -181,6 +187,39 @@ func (o *ThingsListNotFound) readResponse(response runtime.ClientResponse, consu
 	return nil
 }
 
+// NewThingsListUnprocessableEntity creates a ThingsListUnprocessableEntity with default headers values
+func NewThingsListUnprocessableEntity() *ThingsListUnprocessableEntity {
+	return &ThingsListUnprocessableEntity{}
+}
+
+/*ThingsListUnprocessableEntity handles this case with default header values.
+
+Invalid paging parameters.
+*/
+type ThingsListUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *ThingsListUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[GET /things][%d] thingsListUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ThingsListUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ThingsListUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewThingsListInternalServerError creates a ThingsListInternalServerError with default headers values
 func NewThingsListInternalServerError() *ThingsListInternalServerError {
 	return &ThingsListInternalServerError{}
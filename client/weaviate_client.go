@@ -25,13 +25,16 @@ import (
 	strfmt "github.com/go-openapi/strfmt"
 
 	"github.com/semi-technologies/weaviate/client/actions"
+	"github.com/semi-technologies/weaviate/client/backups"
 	"github.com/semi-technologies/weaviate/client/batching"
 	"github.com/semi-technologies/weaviate/client/classifications"
 	"github.com/semi-technologies/weaviate/client/contextionary_api"
 	"github.com/semi-technologies/weaviate/client/graphql"
+	"github.com/semi-technologies/weaviate/client/maintenance"
 	"github.com/semi-technologies/weaviate/client/meta"
 	"github.com/semi-technologies/weaviate/client/operations"
 	"github.com/semi-technologies/weaviate/client/p2_p"
+	"github.com/semi-technologies/weaviate/client/roles"
 	"github.com/semi-technologies/weaviate/client/schema"
 	"github.com/semi-technologies/weaviate/client/things"
 	"github.com/semi-technologies/weaviate/client/well_known"
@@ -82,6 +85,8 @@ func New(transport runtime.ClientTransport, formats strfmt.Registry) *Weaviate {
 
 	cli.Actions = actions.New(transport, formats)
 
+	cli.Backups = backups.New(transport, formats)
+
 	cli.Batching = batching.New(transport, formats)
 
 	cli.Classifications = classifications.New(transport, formats)
@@ -90,12 +95,16 @@ func New(transport runtime.ClientTransport, formats strfmt.Registry) *Weaviate {
 
 	cli.Graphql = graphql.New(transport, formats)
 
+	cli.Maintenance = maintenance.New(transport, formats)
+
 	cli.Meta = meta.New(transport, formats)
 
 	cli.Operations = operations.New(transport, formats)
 
 	cli.P2p = p2_p.New(transport, formats)
 
+	cli.Roles = roles.New(transport, formats)
+
 	cli.Schema = schema.New(transport, formats)
 
 	cli.Things = things.New(transport, formats)
@@ -148,6 +157,8 @@ func (cfg *TransportConfig) WithSchemes(schemes []string) *TransportConfig {
 type Weaviate struct {
 	Actions *actions.Client
 
+	Backups *backups.Client
+
 	Batching *batching.Client
 
 	Classifications *classifications.Client
@@ -156,12 +167,16 @@ type Weaviate struct {
 
 	Graphql *graphql.Client
 
+	Maintenance *maintenance.Client
+
 	Meta *meta.Client
 
 	Operations *operations.Client
 
 	P2p *p2_p.Client
 
+	Roles *roles.Client
+
 	Schema *schema.Client
 
 	Things *things.Client
@@ -177,6 +192,8 @@ func (c *Weaviate) SetTransport(transport runtime.ClientTransport) {
 
 	c.Actions.SetTransport(transport)
 
+	c.Backups.SetTransport(transport)
+
 	c.Batching.SetTransport(transport)
 
 	c.Classifications.SetTransport(transport)
@@ -185,12 +202,16 @@ func (c *Weaviate) SetTransport(transport runtime.ClientTransport) {
 
 	c.Graphql.SetTransport(transport)
 
+	c.Maintenance.SetTransport(transport)
+
 	c.Meta.SetTransport(transport)
 
 	c.Operations.SetTransport(transport)
 
 	c.P2p.SetTransport(transport)
 
+	c.Roles.SetTransport(transport)
+
 	c.Schema.SetTransport(transport)
 
 	c.Things.SetTransport(transport)
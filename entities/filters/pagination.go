@@ -13,21 +13,71 @@
 
 package filters
 
-// Pagination for now only contains a limit paramter, but might be extended in
-// the future
+import (
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+)
+
+// Pagination restricts the results to a page. A page is either set through
+// an offset or through a cursor (After), in which case only objects with an
+// id greater than the cursor are returned. Cursors allow for an exhaustive
+// iteration which isn't limited by the maximum offset of a backend. As results
+// of vector searches are ordered by distance, cursors can only be used
+// without vector scoring.
 type Pagination struct {
-	Limit int
+	Offset int
+	Limit  int
+	After  strfmt.UUID
+}
+
+// Validate that offset and cursor aren't combined and aren't negative
+func (p *Pagination) Validate() error {
+	if p == nil {
+		return nil
+	}
+
+	if p.Offset < 0 {
+		return fmt.Errorf("offset cannot be negative, got %d", p.Offset)
+	}
+
+	if p.Limit < 0 {
+		return fmt.Errorf("limit cannot be negative, got %d", p.Limit)
+	}
+
+	if p.Offset > 0 && p.After != "" {
+		return fmt.Errorf("offset and after cannot be combined")
+	}
+
+	return nil
 }
 
-// ExtractPaginationFromArgs gets the limit key out of a map. Not specific to
-// GQL, but can be used from GQL
+// ExtractPaginationFromArgs gets the limit, offset and after keys out of a
+// map. Not specific to GQL, but can be used from GQL
 func ExtractPaginationFromArgs(args map[string]interface{}) (*Pagination, error) {
-	limit, ok := args["limit"]
-	if !ok {
+	limit, limitOk := args["limit"]
+	offset, offsetOk := args["offset"]
+	after, afterOk := args["after"]
+	if !limitOk && !offsetOk && !afterOk {
 		return nil, nil
 	}
 
-	return &Pagination{
-		Limit: limit.(int),
-	}, nil
+	p := &Pagination{}
+	if limitOk {
+		p.Limit = limit.(int)
+	}
+
+	if offsetOk {
+		p.Offset = offset.(int)
+	}
+
+	if afterOk {
+		p.After = strfmt.UUID(after.(string))
+	}
+
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	return p, nil
 }
@@ -0,0 +1,62 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package filters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ExtractPaginationFromArgs(t *testing.T) {
+	t.Run("without any pagination args", func(t *testing.T) {
+		p, err := ExtractPaginationFromArgs(map[string]interface{}{})
+		require.Nil(t, err)
+		assert.Nil(t, p)
+	})
+
+	t.Run("with limit and offset", func(t *testing.T) {
+		p, err := ExtractPaginationFromArgs(map[string]interface{}{
+			"limit":  10,
+			"offset": 20,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, &Pagination{Limit: 10, Offset: 20}, p)
+	})
+
+	t.Run("with limit and a cursor", func(t *testing.T) {
+		p, err := ExtractPaginationFromArgs(map[string]interface{}{
+			"limit": 10,
+			"after": "e5dc4a4c-ef0f-3aed-89a3-a73435c6bbcf",
+		})
+		require.Nil(t, err)
+		assert.Equal(t, &Pagination{Limit: 10, After: "e5dc4a4c-ef0f-3aed-89a3-a73435c6bbcf"}, p)
+	})
+
+	t.Run("with offset and a cursor", func(t *testing.T) {
+		_, err := ExtractPaginationFromArgs(map[string]interface{}{
+			"offset": 10,
+			"after":  "e5dc4a4c-ef0f-3aed-89a3-a73435c6bbcf",
+		})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("with a negative offset", func(t *testing.T) {
+		_, err := ExtractPaginationFromArgs(map[string]interface{}{
+			"offset": -1,
+		})
+		assert.NotNil(t, err)
+	})
+}
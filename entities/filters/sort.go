@@ -0,0 +1,195 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package filters
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+)
+
+const (
+	// SortOrderAsc orders from the smallest to the largest value
+	SortOrderAsc = "asc"
+	// SortOrderDesc orders from the largest to the smallest value
+	SortOrderDesc = "desc"
+)
+
+const (
+	// SortPropertyCreated orders by the creation time of an object
+	SortPropertyCreated = "_created"
+	// SortPropertyUpdated orders by the last update time of an object
+	SortPropertyUpdated = "_updated"
+)
+
+// Sort orders the results by the property at the path. Only properties of
+// the searched class itself can be used, so the path always has exactly one
+// segment. Objects without a value for the property come last regardless of
+// the order.
+type Sort struct {
+	Path  []string
+	Order string
+}
+
+// Property the results are ordered by
+func (s Sort) Property() string {
+	if len(s.Path) == 0 {
+		return ""
+	}
+
+	return s.Path[0]
+}
+
+// IsMeta is true if the sort is on the creation or update time rather than on
+// a user-specified property
+func (s Sort) IsMeta() bool {
+	prop := s.Property()
+	return prop == SortPropertyCreated || prop == SortPropertyUpdated
+}
+
+// Desc is true if the order is descending
+func (s Sort) Desc() bool {
+	return s.Order == SortOrderDesc
+}
+
+func (s Sort) validate() error {
+	if len(s.Path) != 1 {
+		return fmt.Errorf("path must have exactly one segment, got %v", s.Path)
+	}
+
+	if s.Order != SortOrderAsc && s.Order != SortOrderDesc {
+		return fmt.Errorf("order must be one of %q or %q, got %q", SortOrderAsc,
+			SortOrderDesc, s.Order)
+	}
+
+	return nil
+}
+
+// ValidateSort checks the sort clauses against the classes that are
+// searched. A property must be of a sortable data type in at least one of
+// the classes, objects of the remaining classes are treated as having no
+// value.
+func ValidateSort(sort []Sort, classes []*models.Class) error {
+	for i, s := range sort {
+		if err := s.validate(); err != nil {
+			return fmt.Errorf("sort %d: %v", i, err)
+		}
+
+		if s.IsMeta() {
+			continue
+		}
+
+		if !sortableInAnyClass(s.Property(), classes) {
+			return fmt.Errorf("sort %d: no property %q of one of the sortable types %v",
+				i, s.Property(), schema.SortableDataTypes)
+		}
+	}
+
+	return nil
+}
+
+func sortableInAnyClass(propName string, classes []*models.Class) bool {
+	for _, class := range classes {
+		if class == nil {
+			continue
+		}
+
+		for _, prop := range class.Properties {
+			if prop.Name != propName || len(prop.DataType) != 1 {
+				continue
+			}
+
+			if schema.DataType(prop.DataType[0]).IsSortable() {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// ExtractSortFromArgs gets the sort clauses out of the "sort" key of a map,
+// the order defaults to ascending. Not specific to GQL, but can be used from
+// GQL
+func ExtractSortFromArgs(args map[string]interface{}) ([]Sort, error) {
+	sortArgs, ok := args["sort"]
+	if !ok {
+		return nil, nil
+	}
+
+	list, ok := sortArgs.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("sort: expected a list, got %T", sortArgs)
+	}
+
+	out := make([]Sort, len(list))
+	for i, elem := range list {
+		elemMap, ok := elem.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("sort %d: expected a map, got %T", i, elem)
+		}
+
+		s := Sort{Order: SortOrderAsc}
+		if path, ok := elemMap["path"].([]interface{}); ok {
+			for _, segment := range path {
+				s.Path = append(s.Path, segment.(string))
+			}
+		}
+
+		if order, ok := elemMap["order"].(string); ok {
+			s.Order = order
+		}
+
+		if err := s.validate(); err != nil {
+			return nil, fmt.Errorf("sort %d: %v", i, err)
+		}
+
+		out[i] = s
+	}
+
+	return out, nil
+}
+
+// ParseSort parses a comma-separated list of properties, each optionally
+// followed by a colon and the order, such as "price:desc,_created". The
+// order defaults to ascending.
+func ParseSort(in string) ([]Sort, error) {
+	if in == "" {
+		return nil, nil
+	}
+
+	parts := strings.Split(in, ",")
+	out := make([]Sort, len(parts))
+	for i, part := range parts {
+		s := Sort{Order: SortOrderAsc}
+		segments := strings.SplitN(strings.TrimSpace(part), ":", 2)
+		if segments[0] != "" {
+			s.Path = []string{segments[0]}
+		}
+
+		if len(segments) == 2 {
+			s.Order = segments[1]
+		}
+
+		if err := s.validate(); err != nil {
+			return nil, fmt.Errorf("sort %d: %v", i, err)
+		}
+
+		out[i] = s
+	}
+
+	return out, nil
+}
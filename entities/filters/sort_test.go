@@ -0,0 +1,137 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package filters
+
+import (
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseSort(t *testing.T) {
+	t.Run("with an empty string", func(t *testing.T) {
+		sort, err := ParseSort("")
+		require.Nil(t, err)
+		assert.Nil(t, sort)
+	})
+
+	t.Run("with multiple properties", func(t *testing.T) {
+		sort, err := ParseSort("price:desc,_created")
+		require.Nil(t, err)
+		assert.Equal(t, []Sort{
+			{Path: []string{"price"}, Order: SortOrderDesc},
+			{Path: []string{"_created"}, Order: SortOrderAsc},
+		}, sort)
+	})
+
+	t.Run("with an invalid order", func(t *testing.T) {
+		_, err := ParseSort("price:sideways")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("with a missing property", func(t *testing.T) {
+		_, err := ParseSort("price,:desc")
+		assert.NotNil(t, err)
+	})
+}
+
+func Test_ExtractSortFromArgs(t *testing.T) {
+	t.Run("without a sort arg", func(t *testing.T) {
+		sort, err := ExtractSortFromArgs(map[string]interface{}{})
+		require.Nil(t, err)
+		assert.Nil(t, sort)
+	})
+
+	t.Run("with the order defaulting to asc", func(t *testing.T) {
+		sort, err := ExtractSortFromArgs(map[string]interface{}{
+			"sort": []interface{}{
+				map[string]interface{}{
+					"path":  []interface{}{"price"},
+					"order": "desc",
+				},
+				map[string]interface{}{
+					"path": []interface{}{"name"},
+				},
+			},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []Sort{
+			{Path: []string{"price"}, Order: SortOrderDesc},
+			{Path: []string{"name"}, Order: SortOrderAsc},
+		}, sort)
+	})
+
+	t.Run("with a nested path", func(t *testing.T) {
+		_, err := ExtractSortFromArgs(map[string]interface{}{
+			"sort": []interface{}{
+				map[string]interface{}{
+					"path": []interface{}{"ofCompany", "Company", "name"},
+				},
+			},
+		})
+		assert.NotNil(t, err)
+	})
+}
+
+func Test_ValidateSort(t *testing.T) {
+	classes := []*models.Class{
+		{
+			Class: "Product",
+			Properties: []*models.Property{
+				{Name: "price", DataType: []string{"number"}},
+				{Name: "description", DataType: []string{"text"}},
+				{Name: "ofCompany", DataType: []string{"Company"}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		sort        []Sort
+		expectedErr bool
+	}{
+		{
+			name: "on a sortable property",
+			sort: []Sort{{Path: []string{"price"}, Order: SortOrderAsc}},
+		},
+		{
+			name: "on the creation time",
+			sort: []Sort{{Path: []string{"_created"}, Order: SortOrderDesc}},
+		},
+		{
+			name:        "on a text property",
+			sort:        []Sort{{Path: []string{"description"}, Order: SortOrderAsc}},
+			expectedErr: true,
+		},
+		{
+			name:        "on a reference property",
+			sort:        []Sort{{Path: []string{"ofCompany"}, Order: SortOrderAsc}},
+			expectedErr: true,
+		},
+		{
+			name:        "on a non-existing property",
+			sort:        []Sort{{Path: []string{"color"}, Order: SortOrderAsc}},
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateSort(test.sort, classes)
+			assert.Equal(t, test.expectedErr, err != nil)
+		})
+	}
+}
@@ -49,6 +49,9 @@ type Action struct {
 	// schema
 	Schema PropertySchema `json:"schema,omitempty"`
 
+	// vector
+	Vector Vector `json:"vector,omitempty"`
+
 	// vector weights
 	VectorWeights VectorWeights `json:"vectorWeights,omitempty"`
 }
@@ -65,6 +68,10 @@ func (m *Action) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateVector(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -102,6 +109,22 @@ func (m *Action) validateMeta(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Action) validateVector(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Vector) { // not required
+		return nil
+	}
+
+	if err := m.Vector.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("vector")
+		}
+		return err
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *Action) MarshalBinary() ([]byte, error) {
 	if m == nil {
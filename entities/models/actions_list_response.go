@@ -34,7 +34,10 @@ type ActionsListResponse struct {
 	// The actual list of Actions.
 	Actions []*Action `json:"actions"`
 
-	// The total number of Actions for the query. The number of items in a response may be smaller due to paging.
+	// Set if more Actions might be available. Pass it as the 'after' parameter to retrieve the next page.
+	ContinuationToken string `json:"continuationToken,omitempty"`
+
+	// The number of Actions in this page of the list, which only contains Actions of classes the principal may list.
 	TotalResults int64 `json:"totalResults,omitempty"`
 }
 
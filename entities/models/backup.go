@@ -0,0 +1,182 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Backup A backup of the schema, all objects with their vectors and the classification records, written to the backup directory of weaviate.
+// swagger:model Backup
+type Backup struct {
+
+	// The classes which are part of this backup.
+	// Read Only: true
+	Classes []string `json:"classes"`
+
+	// error message if status == failed
+	// Read Only: true
+	Error string `json:"error,omitempty"`
+
+	// Back up all classes but these. Cannot be combined with include.
+	Exclude []string `json:"exclude"`
+
+	// ID to uniquely identify this backup, it is used as the name of the backup's directory. Generated if not set.
+	// Pattern: ^[a-zA-Z0-9_-]+$
+	ID string `json:"id,omitempty"`
+
+	// Only back up these classes. All classes are backed up if neither include nor exclude are set.
+	Include []string `json:"include"`
+
+	// progress of the backup
+	// Read Only: true
+	Meta *BackupMeta `json:"meta,omitempty"`
+
+	// status of this backup
+	// Read Only: true
+	// Enum: [running completed failed]
+	Status string `json:"status,omitempty"`
+
+	// Version of the archive format of this backup.
+	// Read Only: true
+	Version int64 `json:"version,omitempty"`
+}
+
+// Validate validates this backup
+func (m *Backup) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMeta(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Backup) validateID(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ID) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("id", "body", string(m.ID), `^[a-zA-Z0-9_-]+$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Backup) validateMeta(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Meta) { // not required
+		return nil
+	}
+
+	if m.Meta != nil {
+		if err := m.Meta.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("meta")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+var backupTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["running","completed","failed"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		backupTypeStatusPropEnum = append(backupTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// BackupStatusRunning captures enum value "running"
+	BackupStatusRunning string = "running"
+
+	// BackupStatusCompleted captures enum value "completed"
+	BackupStatusCompleted string = "completed"
+
+	// BackupStatusFailed captures enum value "failed"
+	BackupStatusFailed string = "failed"
+)
+
+// prop value enum
+func (m *Backup) validateStatusEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, backupTypeStatusPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Backup) validateStatus(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Backup) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Backup) UnmarshalBinary(b []byte) error {
+	var res Backup
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
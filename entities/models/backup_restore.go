@@ -0,0 +1,161 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// BackupRestore Restore of a backup into this instance. The restored classes must not be present in the schema yet.
+// swagger:model BackupRestore
+type BackupRestore struct {
+
+	// ID of the backup which is restored
+	// Read Only: true
+	BackupID string `json:"backupId,omitempty"`
+
+	// The classes which are restored.
+	// Read Only: true
+	Classes []string `json:"classes"`
+
+	// error message if status == failed
+	// Read Only: true
+	Error string `json:"error,omitempty"`
+
+	// Restore all classes of the backup but these. Cannot be combined with include.
+	Exclude []string `json:"exclude"`
+
+	// Only restore these classes of the backup. All classes of the backup are restored if neither include nor exclude are set.
+	Include []string `json:"include"`
+
+	// progress of the restore
+	// Read Only: true
+	Meta *BackupMeta `json:"meta,omitempty"`
+
+	// status of this restore
+	// Read Only: true
+	// Enum: [running completed failed]
+	Status string `json:"status,omitempty"`
+}
+
+// Validate validates this backup restore
+func (m *BackupRestore) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateMeta(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BackupRestore) validateMeta(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Meta) { // not required
+		return nil
+	}
+
+	if m.Meta != nil {
+		if err := m.Meta.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("meta")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+var backupRestoreTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["running","completed","failed"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		backupRestoreTypeStatusPropEnum = append(backupRestoreTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// BackupRestoreStatusRunning captures enum value "running"
+	BackupRestoreStatusRunning string = "running"
+
+	// BackupRestoreStatusCompleted captures enum value "completed"
+	BackupRestoreStatusCompleted string = "completed"
+
+	// BackupRestoreStatusFailed captures enum value "failed"
+	BackupRestoreStatusFailed string = "failed"
+)
+
+// prop value enum
+func (m *BackupRestore) validateStatusEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, backupRestoreTypeStatusPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *BackupRestore) validateStatus(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BackupRestore) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BackupRestore) UnmarshalBinary(b []byte) error {
+	var res BackupRestore
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,162 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// BatchDelete Delete all objects of a class matching a where filter in a single request
+// swagger:model BatchDelete
+type BatchDelete struct {
+
+	// class of the objects to be deleted
+	// Required: true
+	Class *string `json:"class"`
+
+	// Deprecated, has no effect. The references to the deleted objects are always handled according to the onDelete policy of their property, like for single deletes.
+	DeleteReferences *bool `json:"deleteReferences,omitempty"`
+
+	// only count and, in verbose mode, list the matching objects without deleting them
+	DryRun *bool `json:"dryRun,omitempty"`
+
+	// minimal only lists the objects which could not be deleted, verbose lists all matching objects
+	// Enum: [minimal verbose]
+	Output *string `json:"output,omitempty"`
+
+	// filter the objects to be deleted, it is required so that a class can't be emptied by accident
+	// Required: true
+	Where *WhereFilter `json:"where"`
+}
+
+// Validate validates this batch delete
+func (m *BatchDelete) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateClass(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateOutput(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateWhere(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BatchDelete) validateClass(formats strfmt.Registry) error {
+
+	if err := validate.Required("class", "body", m.Class); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var batchDeleteTypeOutputPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["minimal","verbose"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		batchDeleteTypeOutputPropEnum = append(batchDeleteTypeOutputPropEnum, v)
+	}
+}
+
+const (
+
+	// BatchDeleteOutputMinimal captures enum value "minimal"
+	BatchDeleteOutputMinimal string = "minimal"
+
+	// BatchDeleteOutputVerbose captures enum value "verbose"
+	BatchDeleteOutputVerbose string = "verbose"
+)
+
+// prop value enum
+func (m *BatchDelete) validateOutputEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, batchDeleteTypeOutputPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *BatchDelete) validateOutput(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Output) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateOutputEnum("output", "body", *m.Output); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *BatchDelete) validateWhere(formats strfmt.Registry) error {
+
+	if err := validate.Required("where", "body", m.Where); err != nil {
+		return err
+	}
+
+	if m.Where != nil {
+		if err := m.Where.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("where")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BatchDelete) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BatchDelete) UnmarshalBinary(b []byte) error {
+	var res BatchDelete
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,114 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// BatchDeleteResponse Outcome of a batch delete
+// swagger:model BatchDeleteResponse
+type BatchDeleteResponse struct {
+
+	// class of the deleted objects
+	Class string `json:"class,omitempty"`
+
+	// whether the objects were only counted, but not deleted
+	DryRun bool `json:"dryRun,omitempty"`
+
+	// number of objects which could not be deleted
+	Failed int64 `json:"failed,omitempty"`
+
+	// number of objects matching the filter
+	Matches int64 `json:"matches,omitempty"`
+
+	// the objects which could not be deleted or, in verbose mode, all matching objects, but at most 1000 objects
+	Objects []*BatchDeleteResult `json:"objects"`
+
+	// number of referencing objects which could not be updated, they still reference deleted objects
+	ReferencesFailed int64 `json:"referencesFailed,omitempty"`
+
+	// number of references to the deleted objects which were removed according to the onDelete policy of their property
+	ReferencesRemoved int64 `json:"referencesRemoved,omitempty"`
+
+	// number of objects which were deleted
+	Successful int64 `json:"successful,omitempty"`
+}
+
+// Validate validates this batch delete response
+func (m *BatchDeleteResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateObjects(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BatchDeleteResponse) validateObjects(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Objects) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Objects); i++ {
+		if swag.IsZero(m.Objects[i]) { // not required
+			continue
+		}
+
+		if m.Objects[i] != nil {
+			if err := m.Objects[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("objects" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BatchDeleteResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BatchDeleteResponse) UnmarshalBinary(b []byte) error {
+	var res BatchDeleteResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
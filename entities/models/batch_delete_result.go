@@ -0,0 +1,162 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// BatchDeleteResult Outcome of a single object of a batch delete
+// swagger:model BatchDeleteResult
+type BatchDeleteResult struct {
+
+	// errors
+	Errors *ErrorResponse `json:"errors,omitempty"`
+
+	// ID of the object
+	// Format: uuid
+	ID strfmt.UUID `json:"id,omitempty"`
+
+	// status
+	// Enum: [SUCCESS DRYRUN FAILED]
+	Status *string `json:"status,omitempty"`
+}
+
+// Validate validates this batch delete result
+func (m *BatchDeleteResult) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateErrors(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BatchDeleteResult) validateErrors(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Errors) { // not required
+		return nil
+	}
+
+	if m.Errors != nil {
+		if err := m.Errors.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("errors")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *BatchDeleteResult) validateID(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var batchDeleteResultTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["SUCCESS","DRYRUN","FAILED"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		batchDeleteResultTypeStatusPropEnum = append(batchDeleteResultTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// BatchDeleteResultStatusSUCCESS captures enum value "SUCCESS"
+	BatchDeleteResultStatusSUCCESS string = "SUCCESS"
+
+	// BatchDeleteResultStatusDRYRUN captures enum value "DRYRUN"
+	BatchDeleteResultStatusDRYRUN string = "DRYRUN"
+
+	// BatchDeleteResultStatusFAILED captures enum value "FAILED"
+	BatchDeleteResultStatusFAILED string = "FAILED"
+)
+
+// prop value enum
+func (m *BatchDeleteResult) validateStatusEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, batchDeleteResultTypeStatusPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *BatchDeleteResult) validateStatus(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", *m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BatchDeleteResult) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BatchDeleteResult) UnmarshalBinary(b []byte) error {
+	var res BatchDeleteResult
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -19,12 +19,14 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"encoding/json"
 	"strconv"
 
 	strfmt "github.com/go-openapi/strfmt"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // Class class
@@ -43,8 +45,15 @@ type Class struct {
 	// The properties of the class.
 	Properties []*Property `json:"properties"`
 
+	// vector index config
+	VectorIndexConfig *VectorIndexConfig `json:"vectorIndexConfig,omitempty"`
+
 	// Set this to true if the object vector should include the class name in calculating the overall vector position
 	VectorizeClassName *bool `json:"vectorizeClassName,omitempty"`
+
+	// Specify how the vectors for this class should be determined. The default 'contextionary' computes them from the class and property names and values. With 'text2vec-http' the same corpus is posted to the HTTP vectorizer endpoint configured in weaviate, which answers with the vector. With 'none' no vectors are computed, the contextionary is not required for this class and vectors can only be provided at import.
+	// Enum: [contextionary text2vec-http none]
+	Vectorizer string `json:"vectorizer,omitempty"`
 }
 
 // Validate validates this class
@@ -59,6 +68,14 @@ func (m *Class) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateVectorIndexConfig(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateVectorizer(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -106,6 +123,70 @@ func (m *Class) validateProperties(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Class) validateVectorIndexConfig(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.VectorIndexConfig) { // not required
+		return nil
+	}
+
+	if m.VectorIndexConfig != nil {
+		if err := m.VectorIndexConfig.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("vectorIndexConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+var classTypeVectorizerPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["contextionary","text2vec-http","none"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		classTypeVectorizerPropEnum = append(classTypeVectorizerPropEnum, v)
+	}
+}
+
+const (
+
+	// ClassVectorizerContextionary captures enum value "contextionary"
+	ClassVectorizerContextionary string = "contextionary"
+
+	// ClassVectorizerText2vecHTTP captures enum value "text2vec-http"
+	ClassVectorizerText2vecHTTP string = "text2vec-http"
+
+	// ClassVectorizerNone captures enum value "none"
+	ClassVectorizerNone string = "none"
+)
+
+// prop value enum
+func (m *Class) validateVectorizerEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, classTypeVectorizerPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Class) validateVectorizer(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Vectorizer) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateVectorizerEnum("vectorizer", "body", m.Vectorizer); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *Class) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -20,6 +20,7 @@ package models
 
 import (
 	"encoding/json"
+	"strconv"
 
 	strfmt "github.com/go-openapi/strfmt"
 
@@ -41,29 +42,64 @@ type Classification struct {
 	// which ref-property to set as part of the classification
 	ClassifyProperties []string `json:"classifyProperties"`
 
+	// If true, the references are computed, but not stored. The computed references are listed in predictions instead.
+	DryRun *bool `json:"dryRun,omitempty"`
+
 	// error message if status == failed
 	Error string `json:"error,omitempty"`
 
+	// Only for type 'knn'. If true, no objects are classified. Instead the classification is evaluated with a k-fold cross-validation over the training set, the results are listed in evaluation.
+	Evaluate *bool `json:"evaluate,omitempty"`
+
+	// The results of an evaluation
+	// Read Only: true
+	Evaluation *ClassificationEvaluation `json:"evaluation,omitempty"`
+
+	// Only if evaluate is set. The number of folds the training set is split into, each fold is classified based on all other folds.
+	EvaluationFolds *int64 `json:"evaluationFolds,omitempty"`
+
 	// ID to uniquely identify this classification run
 	// Format: uuid
 	ID strfmt.UUID `json:"id,omitempty"`
 
+	// Only for type 'contextual'. Words with an information gain below this percentile of the object's words are ignored. The information gain measures how well a word tells the possible targets apart.
+	InformationGainCutoffPercentile *int64 `json:"informationGainCutoffPercentile,omitempty"`
+
+	// Only for type 'contextual'. The word with the highest information gain is weighted this many times as much as a word without any information gain.
+	InformationGainMaximumBoost *int64 `json:"informationGainMaximumBoost,omitempty"`
+
 	// k-value when using k-Neareast-Neighbor
 	K *int32 `json:"k,omitempty"`
 
 	// additional meta information about the classification
 	Meta *ClassificationMeta `json:"meta,omitempty"`
 
+	// Only for type 'contextual'. A property is left unclassified if the certainty of the closest target is below this value. Certainty ranges from 0 to 1.
+	MinimumCertainty float64 `json:"minimumCertainty,omitempty"`
+
+	// Only for type 'contextual'. The cut-offs never leave fewer words than this, if the text contains as many known words.
+	MinimumUsableWords *int64 `json:"minimumUsableWords,omitempty"`
+
+	// The references computed by a dry run. At most the configured maximum number of predictions (1000 by default) is listed, the counts in meta include all objects.
+	// Read Only: true
+	Predictions []*ClassificationPrediction `json:"predictions"`
+
 	// limit the objects to be classified
 	SourceWhere *WhereFilter `json:"sourceWhere,omitempty"`
 
-	// status of this classification
-	// Enum: [running completed failed]
+	// status of this classification. A classification is interrupted if weaviate was restarted while it was running.
+	// Enum: [running completed failed cancelled interrupted]
 	Status string `json:"status,omitempty"`
 
 	// Limit the possible sources when using an algorithm which doesn't really on trainig data, e.g. 'contextual'. When using an algorithm with a training set, such as 'knn', limit the training set instead
 	TargetWhere *WhereFilter `json:"targetWhere,omitempty"`
 
+	// Only for type 'contextual'. Words of an object with a TF-IDF score below this percentile of the object's words are ignored.
+	TfidfCutoffPercentile *int64 `json:"tfidfCutoffPercentile,omitempty"`
+
+	// maximum duration of the classification in seconds, it fails once the timeout is exceeded
+	Timeout *int64 `json:"timeout,omitempty"`
+
 	// Limit the training objects to be considered during the classification. Can only be used on types with explicit training sets, such as 'knn'
 	TrainingSetWhere *WhereFilter `json:"trainingSetWhere,omitempty"`
 
@@ -76,6 +112,10 @@ type Classification struct {
 func (m *Classification) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateEvaluation(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateID(formats); err != nil {
 		res = append(res, err)
 	}
@@ -84,6 +124,10 @@ func (m *Classification) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validatePredictions(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateSourceWhere(formats); err != nil {
 		res = append(res, err)
 	}
@@ -110,6 +154,24 @@ func (m *Classification) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Classification) validateEvaluation(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Evaluation) { // not required
+		return nil
+	}
+
+	if m.Evaluation != nil {
+		if err := m.Evaluation.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("evaluation")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Classification) validateID(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.ID) { // not required
@@ -141,6 +203,31 @@ func (m *Classification) validateMeta(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Classification) validatePredictions(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Predictions) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Predictions); i++ {
+		if swag.IsZero(m.Predictions[i]) { // not required
+			continue
+		}
+
+		if m.Predictions[i] != nil {
+			if err := m.Predictions[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("predictions" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *Classification) validateSourceWhere(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.SourceWhere) { // not required
@@ -163,7 +250,7 @@ var classificationTypeStatusPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["running","completed","failed"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["running","completed","failed","cancelled","interrupted"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -181,6 +268,12 @@ const (
 
 	// ClassificationStatusFailed captures enum value "failed"
 	ClassificationStatusFailed string = "failed"
+
+	// ClassificationStatusCancelled captures enum value "cancelled"
+	ClassificationStatusCancelled string = "cancelled"
+
+	// ClassificationStatusInterrupted captures enum value "interrupted"
+	ClassificationStatusInterrupted string = "interrupted"
 )
 
 // prop value enum
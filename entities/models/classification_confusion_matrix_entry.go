@@ -0,0 +1,108 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ClassificationConfusionMatrixEntry The number of objects of a property with the actual target which were predicted as the predicted target
+// swagger:model ClassificationConfusionMatrixEntry
+type ClassificationConfusionMatrixEntry struct {
+
+	// The beacon of the actual target
+	// Format: uri
+	Actual strfmt.URI `json:"actual,omitempty"`
+
+	// The number of objects
+	Count int64 `json:"count,omitempty"`
+
+	// The beacon of the predicted target
+	// Format: uri
+	Predicted strfmt.URI `json:"predicted,omitempty"`
+
+	// The classified property
+	Property string `json:"property,omitempty"`
+}
+
+// Validate validates this classification confusion matrix entry
+func (m *ClassificationConfusionMatrixEntry) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateActual(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePredicted(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ClassificationConfusionMatrixEntry) validateActual(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Actual) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("actual", "body", "uri", m.Actual.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ClassificationConfusionMatrixEntry) validatePredicted(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Predicted) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("predicted", "body", "uri", m.Predicted.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ClassificationConfusionMatrixEntry) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ClassificationConfusionMatrixEntry) UnmarshalBinary(b []byte) error {
+	var res ClassificationConfusionMatrixEntry
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
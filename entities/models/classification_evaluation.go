@@ -0,0 +1,134 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// ClassificationEvaluation The result of a k-fold cross-validation of a classification over its training set
+// swagger:model ClassificationEvaluation
+type ClassificationEvaluation struct {
+
+	// The share of all predicted references which match the actual reference
+	Accuracy float64 `json:"accuracy,omitempty"`
+
+	// How often each actual target was predicted as each target, entries which never occurred are omitted
+	ConfusionMatrix []*ClassificationConfusionMatrixEntry `json:"confusionMatrix"`
+
+	// The number of objects of the training set
+	Count int64 `json:"count,omitempty"`
+
+	// The number of folds the training set was split into
+	Folds int64 `json:"folds,omitempty"`
+
+	// Precision and recall per property and target
+	Labels []*ClassificationEvaluationLabel `json:"labels"`
+}
+
+// Validate validates this classification evaluation
+func (m *ClassificationEvaluation) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateConfusionMatrix(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLabels(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ClassificationEvaluation) validateConfusionMatrix(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ConfusionMatrix) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.ConfusionMatrix); i++ {
+		if swag.IsZero(m.ConfusionMatrix[i]) { // not required
+			continue
+		}
+
+		if m.ConfusionMatrix[i] != nil {
+			if err := m.ConfusionMatrix[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("confusionMatrix" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ClassificationEvaluation) validateLabels(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Labels) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Labels); i++ {
+		if swag.IsZero(m.Labels[i]) { // not required
+			continue
+		}
+
+		if m.Labels[i] != nil {
+			if err := m.Labels[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("labels" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ClassificationEvaluation) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ClassificationEvaluation) UnmarshalBinary(b []byte) error {
+	var res ClassificationEvaluation
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,93 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ClassificationEvaluationLabel The precision and recall of a single target of a classified property
+// swagger:model ClassificationEvaluationLabel
+type ClassificationEvaluationLabel struct {
+
+	// The beacon of the target
+	// Format: uri
+	Beacon strfmt.URI `json:"beacon,omitempty"`
+
+	// The share of the predictions of this target which were correct
+	Precision float64 `json:"precision,omitempty"`
+
+	// The classified property
+	Property string `json:"property,omitempty"`
+
+	// The share of the objects with this target which were predicted correctly
+	Recall float64 `json:"recall,omitempty"`
+
+	// The number of objects which actually reference this target
+	Support int64 `json:"support,omitempty"`
+}
+
+// Validate validates this classification evaluation label
+func (m *ClassificationEvaluationLabel) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateBeacon(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ClassificationEvaluationLabel) validateBeacon(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Beacon) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("beacon", "body", "uri", m.Beacon.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ClassificationEvaluationLabel) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ClassificationEvaluationLabel) UnmarshalBinary(b []byte) error {
+	var res ClassificationEvaluationLabel
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -43,9 +43,22 @@ type ClassificationMeta struct {
 	// number of objects successfully classified
 	CountSucceeded int64 `json:"countSucceeded,omitempty"`
 
+	// number of objects which were left unclassified, as no target reached the minimum certainty
+	CountUnclassified int64 `json:"countUnclassified,omitempty"`
+
+	// estimated time when this classification will finish, based on the progress so far
+	// Format: date-time
+	EstimatedCompletion strfmt.DateTime `json:"estimatedCompletion,omitempty"`
+
+	// number of objects which have been processed so far
+	Processed int64 `json:"processed,omitempty"`
+
 	// time when this classification was started
 	// Format: date-time
 	Started strfmt.DateTime `json:"started,omitempty"`
+
+	// number of objects to be classified, known once the classification has started processing
+	Total int64 `json:"total,omitempty"`
 }
 
 // Validate validates this classification meta
@@ -56,6 +69,10 @@ func (m *ClassificationMeta) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateEstimatedCompletion(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateStarted(formats); err != nil {
 		res = append(res, err)
 	}
@@ -79,6 +96,19 @@ func (m *ClassificationMeta) validateCompleted(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ClassificationMeta) validateEstimatedCompletion(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.EstimatedCompletion) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("estimatedCompletion", "body", "date-time", m.EstimatedCompletion.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *ClassificationMeta) validateStarted(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Started) { // not required
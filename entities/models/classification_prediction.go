@@ -0,0 +1,111 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ClassificationPrediction A reference which would have been set by a classification
+// swagger:model ClassificationPrediction
+type ClassificationPrediction struct {
+
+	// The beacon of the reference, not set for primitive properties
+	// Format: uri
+	Beacon strfmt.URI `json:"beacon,omitempty"`
+
+	// ID of the object which would have been classified
+	// Format: uuid
+	ID strfmt.UUID `json:"id,omitempty"`
+
+	// The classified property
+	Property string `json:"property,omitempty"`
+
+	// The value of a classified primitive property
+	Value interface{} `json:"value,omitempty"`
+
+	// The distance of the winning reference, see the reference meta of the respective classification type
+	WinningDistance float64 `json:"winningDistance,omitempty"`
+}
+
+// Validate validates this classification prediction
+func (m *ClassificationPrediction) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateBeacon(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ClassificationPrediction) validateBeacon(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Beacon) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("beacon", "body", "uri", m.Beacon.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ClassificationPrediction) validateID(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ClassificationPrediction) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ClassificationPrediction) UnmarshalBinary(b []byte) error {
+	var res ClassificationPrediction
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
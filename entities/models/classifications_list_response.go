@@ -0,0 +1,96 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// ClassificationsListResponse List of classifications.
+// swagger:model ClassificationsListResponse
+type ClassificationsListResponse struct {
+
+	// The actual list of classifications.
+	Classifications []*Classification `json:"classifications"`
+
+	// The total number of classifications in the list.
+	TotalResults int64 `json:"totalResults,omitempty"`
+}
+
+// Validate validates this classifications list response
+func (m *ClassificationsListResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateClassifications(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ClassificationsListResponse) validateClassifications(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Classifications) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Classifications); i++ {
+		if swag.IsZero(m.Classifications[i]) { // not required
+			continue
+		}
+
+		if m.Classifications[i] != nil {
+			if err := m.Classifications[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("classifications" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ClassificationsListResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ClassificationsListResponse) UnmarshalBinary(b []byte) error {
+	var res ClassificationsListResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,161 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// DanglingReference A reference to an object which doesn't exist
+// swagger:model DanglingReference
+type DanglingReference struct {
+
+	// the dangling reference
+	// Format: uri
+	Beacon strfmt.URI `json:"beacon,omitempty"`
+
+	// class of the referencing object
+	Class string `json:"class,omitempty"`
+
+	// id of the referencing object
+	// Format: uuid
+	ID strfmt.UUID `json:"id,omitempty"`
+
+	// kind of the referencing object
+	// Enum: [thing action]
+	Kind string `json:"kind,omitempty"`
+
+	// reference property which contains the reference
+	Property string `json:"property,omitempty"`
+}
+
+// Validate validates this dangling reference
+func (m *DanglingReference) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateBeacon(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateKind(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *DanglingReference) validateBeacon(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Beacon) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("beacon", "body", "uri", m.Beacon.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *DanglingReference) validateID(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var danglingReferenceTypeKindPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["thing","action"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		danglingReferenceTypeKindPropEnum = append(danglingReferenceTypeKindPropEnum, v)
+	}
+}
+
+const (
+
+	// DanglingReferenceKindThing captures enum value "thing"
+	DanglingReferenceKindThing string = "thing"
+
+	// DanglingReferenceKindAction captures enum value "action"
+	DanglingReferenceKindAction string = "action"
+)
+
+// prop value enum
+func (m *DanglingReference) validateKindEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, danglingReferenceTypeKindPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *DanglingReference) validateKind(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Kind) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateKindEnum("kind", "body", m.Kind); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *DanglingReference) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *DanglingReference) UnmarshalBinary(b []byte) error {
+	var res DanglingReference
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
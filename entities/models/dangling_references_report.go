@@ -0,0 +1,105 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// DanglingReferencesReport The dangling references which were found and, if repaired, how many objects were updated.
+// swagger:model DanglingReferencesReport
+type DanglingReferencesReport struct {
+
+	// dangling references
+	DanglingReferences []*DanglingReference `json:"danglingReferences"`
+
+	// number of objects which could not be stored without their dangling references
+	ObjectsFailed int64 `json:"objectsFailed,omitempty"`
+
+	// number of objects which were stored without their dangling references
+	ObjectsRepaired int64 `json:"objectsRepaired,omitempty"`
+
+	// number of objects which were scanned
+	ObjectsScanned int64 `json:"objectsScanned,omitempty"`
+
+	// whether the dangling references were removed
+	Repair bool `json:"repair,omitempty"`
+}
+
+// Validate validates this dangling references report
+func (m *DanglingReferencesReport) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDanglingReferences(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *DanglingReferencesReport) validateDanglingReferences(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.DanglingReferences) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.DanglingReferences); i++ {
+		if swag.IsZero(m.DanglingReferences[i]) { // not required
+			continue
+		}
+
+		if m.DanglingReferences[i] != nil {
+			if err := m.DanglingReferences[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("danglingReferences" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *DanglingReferencesReport) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *DanglingReferencesReport) UnmarshalBinary(b []byte) error {
+	var res DanglingReferencesReport
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -19,6 +19,8 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"strconv"
+
 	strfmt "github.com/go-openapi/strfmt"
 
 	"github.com/go-openapi/errors"
@@ -44,6 +46,9 @@ type ObjectMetaClassification struct {
 	// Format: uuid
 	ID strfmt.UUID `json:"id,omitempty"`
 
+	// How confident the classification of each classified field is, only set for type "knn". References carry their distances in their own meta as well, primitive fields can only be described here.
+	Properties []*ObjectMetaClassificationProperty `json:"properties"`
+
 	// The properties in scope of the classification. Note that this doesn't mean that these fields were necessarily classified, this only means that those fields were in scope of the classificiation. See "classifiedFields" for details.
 	Scope []string `json:"scope"`
 }
@@ -60,6 +65,10 @@ func (m *ObjectMetaClassification) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateProperties(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -92,6 +101,31 @@ func (m *ObjectMetaClassification) validateID(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ObjectMetaClassification) validateProperties(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Properties) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Properties); i++ {
+		if swag.IsZero(m.Properties[i]) { // not required
+			continue
+		}
+
+		if m.Properties[i] != nil {
+			if err := m.Properties[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("properties" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *ObjectMetaClassification) MarshalBinary() ([]byte, error) {
 	if m == nil {
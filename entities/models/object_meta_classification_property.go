@@ -0,0 +1,65 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/swag"
+)
+
+// ObjectMetaClassificationProperty The confidence of the classification of a single field
+// swagger:model ObjectMetaClassificationProperty
+type ObjectMetaClassificationProperty struct {
+
+	// The share of the neighbors which voted for the winning value or reference
+	Confidence float64 `json:"confidence,omitempty"`
+
+	// Mean distance of all neighbors from the losing group. Optional. If k equals the size of the winning group, there is no losing group.
+	LosingDistance *float64 `json:"losingDistance,omitempty"`
+
+	// The name of the classified field
+	Name string `json:"name,omitempty"`
+
+	// Mean distance of all neighbors from the winning group
+	WinningDistance float64 `json:"winningDistance,omitempty"`
+}
+
+// Validate validates this object meta classification property
+func (m *ObjectMetaClassificationProperty) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ObjectMetaClassificationProperty) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ObjectMetaClassificationProperty) UnmarshalBinary(b []byte) error {
+	var res ObjectMetaClassificationProperty
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -51,6 +51,10 @@ type Property struct {
 	// Name of the property as URI relative to the schema URL.
 	Name string `json:"name,omitempty"`
 
+	// Only for reference properties. What happens to the references in this property when a referenced object is deleted: 'restrict' prevents the deletion of the referenced object, 'cascade' deletes the referencing object as well and 'setNull' removes the reference. Not set is the same as setNull.
+	// Enum: [restrict cascade setNull]
+	OnDelete string `json:"onDelete,omitempty"`
+
 	// Set this to true if the object vector should include this property's name in calculating the overall vector position. If set to false (default), only the property value will be used.
 	VectorizePropertyName bool `json:"vectorizePropertyName,omitempty"`
 }
@@ -67,6 +71,10 @@ func (m *Property) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateOnDelete(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -132,6 +140,52 @@ func (m *Property) validateKeywords(formats strfmt.Registry) error {
 	return nil
 }
 
+var propertyTypeOnDeletePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["restrict","cascade","setNull"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		propertyTypeOnDeletePropEnum = append(propertyTypeOnDeletePropEnum, v)
+	}
+}
+
+const (
+
+	// PropertyOnDeleteRestrict captures enum value "restrict"
+	PropertyOnDeleteRestrict string = "restrict"
+
+	// PropertyOnDeleteCascade captures enum value "cascade"
+	PropertyOnDeleteCascade string = "cascade"
+
+	// PropertyOnDeleteSetNull captures enum value "setNull"
+	PropertyOnDeleteSetNull string = "setNull"
+)
+
+// prop value enum
+func (m *Property) validateOnDeleteEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, propertyTypeOnDeletePropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Property) validateOnDelete(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.OnDelete) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateOnDeleteEnum("onDelete", "body", m.OnDelete); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *Property) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -19,8 +19,11 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"strconv"
+
 	strfmt "github.com/go-openapi/strfmt"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/swag"
 )
 
@@ -28,6 +31,9 @@ import (
 // swagger:model ReferenceMetaClassification
 type ReferenceMetaClassification struct {
 
+	// The words of the basedOnProperties which the classification was based on, only set for type 'contextual'.
+	BasedOnWords []*ReferenceMetaClassificationWord `json:"basedOnWords"`
+
 	// Mean distance of all neighbors from the losing group. Optional. If k equals the size of the winning group, there is no losing group.
 	LosingDistance *float64 `json:"losingDistance,omitempty"`
 
@@ -37,6 +43,40 @@ type ReferenceMetaClassification struct {
 
 // Validate validates this reference meta classification
 func (m *ReferenceMetaClassification) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateBasedOnWords(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ReferenceMetaClassification) validateBasedOnWords(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.BasedOnWords) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.BasedOnWords); i++ {
+		if swag.IsZero(m.BasedOnWords[i]) { // not required
+			continue
+		}
+
+		if m.BasedOnWords[i] != nil {
+			if err := m.BasedOnWords[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("basedOnWords" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
@@ -0,0 +1,253 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Revectorization A background job which recomputes the vectors of the objects of a class with the current vectorizer settings of the class, e.g. after vectorizeClassName, vectorizePropertyName or the indexing of a property was changed or the contextionary was extended.
+// swagger:model Revectorization
+type Revectorization struct {
+
+	// name of the revectorized class
+	// Read Only: true
+	Class string `json:"class,omitempty"`
+
+	// error message if status == failed
+	// Read Only: true
+	Error string `json:"error,omitempty"`
+
+	// ID to uniquely identify this revectorization
+	// Read Only: true
+	// Format: uuid
+	ID strfmt.UUID `json:"id,omitempty"`
+
+	// kind of the revectorized class
+	// Read Only: true
+	// Enum: [thing action]
+	Kind string `json:"kind,omitempty"`
+
+	// progress of the revectorization
+	// Read Only: true
+	Meta *RevectorizationMeta `json:"meta,omitempty"`
+
+	// status of this revectorization
+	// Read Only: true
+	// Enum: [running completed failed cancelled]
+	Status string `json:"status,omitempty"`
+
+	// limit the objects to be revectorized using a filter, all objects of the class are revectorized if not set
+	Where *WhereFilter `json:"where,omitempty"`
+}
+
+// Validate validates this revectorization
+func (m *Revectorization) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateKind(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMeta(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateWhere(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Revectorization) validateID(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var revectorizationTypeKindPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["thing","action"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		revectorizationTypeKindPropEnum = append(revectorizationTypeKindPropEnum, v)
+	}
+}
+
+const (
+
+	// RevectorizationKindThing captures enum value "thing"
+	RevectorizationKindThing string = "thing"
+
+	// RevectorizationKindAction captures enum value "action"
+	RevectorizationKindAction string = "action"
+)
+
+// prop value enum
+func (m *Revectorization) validateKindEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, revectorizationTypeKindPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Revectorization) validateKind(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Kind) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateKindEnum("kind", "body", m.Kind); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Revectorization) validateMeta(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Meta) { // not required
+		return nil
+	}
+
+	if m.Meta != nil {
+		if err := m.Meta.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("meta")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+var revectorizationTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["running","completed","failed","cancelled"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		revectorizationTypeStatusPropEnum = append(revectorizationTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// RevectorizationStatusRunning captures enum value "running"
+	RevectorizationStatusRunning string = "running"
+
+	// RevectorizationStatusCompleted captures enum value "completed"
+	RevectorizationStatusCompleted string = "completed"
+
+	// RevectorizationStatusFailed captures enum value "failed"
+	RevectorizationStatusFailed string = "failed"
+
+	// RevectorizationStatusCancelled captures enum value "cancelled"
+	RevectorizationStatusCancelled string = "cancelled"
+)
+
+// prop value enum
+func (m *Revectorization) validateStatusEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, revectorizationTypeStatusPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Revectorization) validateStatus(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Revectorization) validateWhere(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Where) { // not required
+		return nil
+	}
+
+	if m.Where != nil {
+		if err := m.Where.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("where")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Revectorization) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Revectorization) UnmarshalBinary(b []byte) error {
+	var res Revectorization
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,296 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// SchemaReindex A background job which copies all objects of a class into a new index of the vector index, so that a class or property can be renamed or a property can be dropped while the class stays available for reads and writes.
+// swagger:model SchemaReindex
+type SchemaReindex struct {
+
+	// name of the reindexed class after the schema change
+	// Read Only: true
+	ClassName string `json:"className,omitempty"`
+
+	// error message if status == failed
+	// Read Only: true
+	Error string `json:"error,omitempty"`
+
+	// previous name of the renamed class or property or the name of the dropped property
+	// Read Only: true
+	From string `json:"from,omitempty"`
+
+	// ID to uniquely identify this reindex job
+	// Read Only: true
+	// Format: uuid
+	ID strfmt.UUID `json:"id,omitempty"`
+
+	// kind of the reindexed class
+	// Read Only: true
+	// Enum: [thing action]
+	Kind string `json:"kind,omitempty"`
+
+	// progress of the reindex job
+	// Read Only: true
+	Meta *SchemaReindexMeta `json:"meta,omitempty"`
+
+	// the schema change which required the reindex
+	// Read Only: true
+	// Enum: [renameClass renameProperty dropProperty]
+	Operation string `json:"operation,omitempty"`
+
+	// index the objects are copied from
+	// Read Only: true
+	SourceIndex string `json:"sourceIndex,omitempty"`
+
+	// status of this reindex job
+	// Read Only: true
+	// Enum: [running completed failed]
+	Status string `json:"status,omitempty"`
+
+	// index the objects are copied to, it replaces the source index once all objects are copied
+	// Read Only: true
+	TargetIndex string `json:"targetIndex,omitempty"`
+
+	// new name of the renamed class or property, empty when a property is dropped
+	// Read Only: true
+	To string `json:"to,omitempty"`
+}
+
+// Validate validates this schema reindex
+func (m *SchemaReindex) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateKind(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMeta(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateOperation(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *SchemaReindex) validateID(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var schemaReindexTypeKindPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["thing","action"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		schemaReindexTypeKindPropEnum = append(schemaReindexTypeKindPropEnum, v)
+	}
+}
+
+const (
+
+	// SchemaReindexKindThing captures enum value "thing"
+	SchemaReindexKindThing string = "thing"
+
+	// SchemaReindexKindAction captures enum value "action"
+	SchemaReindexKindAction string = "action"
+)
+
+// prop value enum
+func (m *SchemaReindex) validateKindEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, schemaReindexTypeKindPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *SchemaReindex) validateKind(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Kind) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateKindEnum("kind", "body", m.Kind); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *SchemaReindex) validateMeta(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Meta) { // not required
+		return nil
+	}
+
+	if m.Meta != nil {
+		if err := m.Meta.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("meta")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+var schemaReindexTypeOperationPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["renameClass","renameProperty","dropProperty"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		schemaReindexTypeOperationPropEnum = append(schemaReindexTypeOperationPropEnum, v)
+	}
+}
+
+const (
+
+	// SchemaReindexOperationRenameClass captures enum value "renameClass"
+	SchemaReindexOperationRenameClass string = "renameClass"
+
+	// SchemaReindexOperationRenameProperty captures enum value "renameProperty"
+	SchemaReindexOperationRenameProperty string = "renameProperty"
+
+	// SchemaReindexOperationDropProperty captures enum value "dropProperty"
+	SchemaReindexOperationDropProperty string = "dropProperty"
+)
+
+// prop value enum
+func (m *SchemaReindex) validateOperationEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, schemaReindexTypeOperationPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *SchemaReindex) validateOperation(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Operation) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateOperationEnum("operation", "body", m.Operation); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var schemaReindexTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["running","completed","failed"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		schemaReindexTypeStatusPropEnum = append(schemaReindexTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// SchemaReindexStatusRunning captures enum value "running"
+	SchemaReindexStatusRunning string = "running"
+
+	// SchemaReindexStatusCompleted captures enum value "completed"
+	SchemaReindexStatusCompleted string = "completed"
+
+	// SchemaReindexStatusFailed captures enum value "failed"
+	SchemaReindexStatusFailed string = "failed"
+)
+
+// prop value enum
+func (m *SchemaReindex) validateStatusEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, schemaReindexTypeStatusPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *SchemaReindex) validateStatus(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *SchemaReindex) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *SchemaReindex) UnmarshalBinary(b []byte) error {
+	var res SchemaReindex
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
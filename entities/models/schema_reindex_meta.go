@@ -0,0 +1,105 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// SchemaReindexMeta Progress of a reindex job
+// swagger:model SchemaReindexMeta
+type SchemaReindexMeta struct {
+
+	// time when the reindex job finished
+	// Format: date-time
+	Completed strfmt.DateTime `json:"completed,omitempty"`
+
+	// number of objects copied so far, objects which were written to during the reindex are copied again and counted again
+	Count int64 `json:"count,omitempty"`
+
+	// time when the reindex job was started
+	// Format: date-time
+	Started strfmt.DateTime `json:"started,omitempty"`
+}
+
+// Validate validates this schema reindex meta
+func (m *SchemaReindexMeta) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCompleted(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStarted(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *SchemaReindexMeta) validateCompleted(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Completed) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("completed", "body", "date-time", m.Completed.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *SchemaReindexMeta) validateStarted(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Started) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("started", "body", "date-time", m.Started.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *SchemaReindexMeta) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *SchemaReindexMeta) UnmarshalBinary(b []byte) error {
+	var res SchemaReindexMeta
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -31,10 +31,13 @@ import (
 // swagger:model ThingsListResponse
 type ThingsListResponse struct {
 
+	// Set if more Things might be available. Pass it as the 'after' parameter to retrieve the next page.
+	ContinuationToken string `json:"continuationToken,omitempty"`
+
 	// The actual list of Things.
 	Things []*Thing `json:"things"`
 
-	// The total number of Things for the query. The number of items in a response may be smaller due to paging.
+	// The number of Things in this page of the list, which only contains Things of classes the principal may list.
 	TotalResults int64 `json:"totalResults,omitempty"`
 }
 
@@ -0,0 +1,62 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/swag"
+)
+
+// VectorIndexConfig Tunes the approximate nearest neighbor (HNSW) index of a class. Unset values fall back to the defaults. The config cannot be changed once the class has been created.
+// swagger:model VectorIndexConfig
+type VectorIndexConfig struct {
+
+	// Size of the dynamic candidate list when searching. Higher values lead to a better recall at the cost of slower searches.
+	Ef int64 `json:"ef,omitempty"`
+
+	// Size of the dynamic candidate list when inserting new objects. Higher values lead to a better index quality at the cost of slower imports.
+	EfConstruction int64 `json:"efConstruction,omitempty"`
+
+	// Maximum number of connections per object and layer in the index graph. The lowest layer allows for twice as many connections.
+	MaxConnections int64 `json:"maxConnections,omitempty"`
+}
+
+// Validate validates this vector index config
+func (m *VectorIndexConfig) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *VectorIndexConfig) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *VectorIndexConfig) UnmarshalBinary(b []byte) error {
+	var res VectorIndexConfig
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
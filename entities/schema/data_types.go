@@ -45,6 +45,21 @@ const (
 
 var PrimitiveDataTypes []DataType = []DataType{DataTypeString, DataTypeText, DataTypeInt, DataTypeNumber, DataTypeBoolean, DataTypeDate, DataTypeGeoCoordinates, DataTypePhoneNumber}
 
+// SortableDataTypes are the primitive data types results can be ordered by.
+// Text is tokenized, geo coordinates and phone numbers have no natural order.
+var SortableDataTypes []DataType = []DataType{DataTypeString, DataTypeInt, DataTypeNumber, DataTypeBoolean, DataTypeDate}
+
+// IsSortable returns true if results can be ordered by values of this type
+func (dt DataType) IsSortable() bool {
+	for _, sortable := range SortableDataTypes {
+		if dt == sortable {
+			return true
+		}
+	}
+
+	return false
+}
+
 type PropertyKind int
 
 const PropertyKindPrimitive PropertyKind = 1
@@ -29,6 +29,7 @@ require (
 	github.com/mitchellh/mapstructure v1.2.2 // indirect
 	github.com/modern-go/reflect2 v1.0.1 // indirect
 	github.com/nyaruka/phonenumbers v1.0.54
+	github.com/prometheus/client_golang v0.9.2
 	github.com/rs/cors v1.5.0
 	github.com/satori/go.uuid v0.0.0-20180103174451-36e9d2ebbde5
 	github.com/semi-technologies/contextionary v0.0.0-20200131144445-074b13178761
@@ -36,6 +37,7 @@ require (
 	github.com/square/go-jose v2.3.0+incompatible
 	github.com/stretchr/testify v1.4.0
 	github.com/ugorji/go/codec v0.0.0-20190309163734-c4a1c341dc93
+	go.etcd.io/bbolt v1.3.5
 	go.mongodb.org/mongo-driver v1.3.1 // indirect
 	golang.org/x/net v0.0.0-20200226121028-0de0cce0169b
 	golang.org/x/tools v0.0.0-20200403190813-44a64ad78b9b // indirect
@@ -0,0 +1,124 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Package audit records one structured event per mutating operation, such as
+// who created, updated or deleted which object or class, and whether the
+// operation succeeded.
+package audit
+
+import (
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// OutcomeSuccess marks an operation that was carried out
+	OutcomeSuccess = "success"
+	// OutcomeFailure marks an operation that was denied or failed
+	OutcomeFailure = "failure"
+)
+
+// Event of a single mutating operation. Batch operations produce one event
+// per item.
+type Event struct {
+	Time     time.Time   `json:"time"`
+	Username string      `json:"username"`
+	Groups   []string    `json:"groups,omitempty"`
+	Verb     string      `json:"verb"`
+	Resource string      `json:"resource"`
+	Class    string      `json:"class,omitempty"`
+	ID       strfmt.UUID `json:"id,omitempty"`
+	Outcome  string      `json:"outcome"`
+	Error    string      `json:"error,omitempty"`
+}
+
+// NewEvent for the principal, an operation which returned a non-nil err is
+// recorded as a failure
+func NewEvent(principal *models.Principal, verb, resource string, err error) Event {
+	e := Event{
+		Username: "anonymous",
+		Verb:     verb,
+		Resource: resource,
+		Outcome:  OutcomeSuccess,
+	}
+
+	if principal != nil {
+		e.Username = principal.Username
+		e.Groups = principal.Groups
+	}
+
+	if err != nil {
+		e.Outcome = OutcomeFailure
+		e.Error = err.Error()
+	}
+
+	return e
+}
+
+// WithObject sets the class and id of the affected object, the id is empty
+// for operations on classes
+func (e Event) WithObject(className string, id strfmt.UUID) Event {
+	e.Class = className
+	e.ID = id
+	return e
+}
+
+// Sink persists audit events, such as a rotating file
+type Sink interface {
+	Write(events []Event) error
+}
+
+// Logger records events to its sink. A nil *Logger is valid and discards all
+// events, so the use cases don't have to check whether auditing is enabled.
+type Logger struct {
+	sync.Mutex
+	sink       Sink
+	logger     logrus.FieldLogger
+	timeSource func() time.Time
+}
+
+// New audit logger writing to the specified sink. Errors of the sink are
+// logged, but never fail the audited operation.
+func New(sink Sink, logger logrus.FieldLogger) *Logger {
+	return &Logger{
+		sink:       sink,
+		logger:     logger,
+		timeSource: time.Now,
+	}
+}
+
+// Record the events, events without a time are stamped with the current time
+func (l *Logger) Record(events ...Event) {
+	if l == nil || len(events) == 0 {
+		return
+	}
+
+	now := l.timeSource().UTC()
+	for i := range events {
+		if events[i].Time.IsZero() {
+			events[i].Time = now
+		}
+	}
+
+	l.Lock()
+	defer l.Unlock()
+
+	if err := l.sink.Write(events); err != nil {
+		l.logger.WithField("action", "audit_write").WithError(err).
+			Error("could not write audit events")
+	}
+}
@@ -0,0 +1,83 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package audit
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEvent(t *testing.T) {
+	t.Run("successful operation of a principal", func(t *testing.T) {
+		principal := &models.Principal{Username: "alice", Groups: []string{"editors"}}
+		event := NewEvent(principal, "delete", "things/Article/foo", nil).
+			WithObject("Article", "foo")
+
+		assert.Equal(t, Event{
+			Username: "alice",
+			Groups:   []string{"editors"},
+			Verb:     "delete",
+			Resource: "things/Article/foo",
+			Class:    "Article",
+			ID:       "foo",
+			Outcome:  OutcomeSuccess,
+		}, event)
+	})
+
+	t.Run("failed operation without a principal", func(t *testing.T) {
+		event := NewEvent(nil, "create", "things/Article", errors.New("forbidden"))
+
+		assert.Equal(t, Event{
+			Username: "anonymous",
+			Verb:     "create",
+			Resource: "things/Article",
+			Outcome:  OutcomeFailure,
+			Error:    "forbidden",
+		}, event)
+	})
+}
+
+func TestLogger(t *testing.T) {
+	buf := bytes.NewBuffer(nil)
+	logger, _ := test.NewNullLogger()
+	l := New(NewWriterSink(buf), logger)
+	l.timeSource = func() time.Time { return time.Unix(1000, 0) }
+
+	l.Record(
+		NewEvent(nil, "create", "batch/things/Article", nil).WithObject("Article", "foo"),
+		NewEvent(nil, "create", "batch/things/Article", errors.New("invalid")),
+	)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+	assert.Equal(t, `{"time":"1970-01-01T00:16:40Z","username":"anonymous",`+
+		`"verb":"create","resource":"batch/things/Article","class":"Article",`+
+		`"id":"foo","outcome":"success"}`, lines[0])
+	assert.Equal(t, `{"time":"1970-01-01T00:16:40Z","username":"anonymous",`+
+		`"verb":"create","resource":"batch/things/Article","outcome":"failure",`+
+		`"error":"invalid"}`, lines[1])
+}
+
+func TestLogger_Nil(t *testing.T) {
+	var l *Logger
+	assert.NotPanics(t, func() { l.Record(NewEvent(nil, "create", "things", nil)) })
+}
@@ -0,0 +1,146 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+)
+
+// WriterSink writes every event as a single line of JSON to the writer
+type WriterSink struct {
+	w io.Writer
+}
+
+// NewWriterSink writing to w, such as os.Stdout
+func NewWriterSink(w io.Writer) *WriterSink {
+	return &WriterSink{w: w}
+}
+
+// Write the events as newline-delimited JSON
+func (s *WriterSink) Write(events []Event) error {
+	return writeEvents(s.w, events)
+}
+
+// FileSink appends events as newline-delimited JSON to a local file. Once the
+// file exceeds maxSize bytes it is rotated to path.1, path.1 to path.2 and so
+// on, keeping at most maxBackups rotated files.
+type FileSink struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+
+	file *os.File
+	size int64
+}
+
+// NewFileSink opens (or creates) the file at path
+func NewFileSink(path string, maxSize int64, maxBackups int) (*FileSink, error) {
+	s := &FileSink{
+		path:       path,
+		maxSize:    maxSize,
+		maxBackups: maxBackups,
+	}
+
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *FileSink) open() error {
+	f, err := os.OpenFile(s.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return fmt.Errorf("open audit file: %v", err)
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return fmt.Errorf("stat audit file: %v", err)
+	}
+
+	s.file = f
+	s.size = info.Size()
+	return nil
+}
+
+// Write the events and rotate the file if it grew too large. Callers must
+// not call Write concurrently, the Logger takes care of this.
+func (s *FileSink) Write(events []Event) error {
+	if err := writeEvents(countingWriter{s}, events); err != nil {
+		return err
+	}
+
+	if s.maxSize > 0 && s.size >= s.maxSize {
+		return s.rotate()
+	}
+
+	return nil
+}
+
+func (s *FileSink) rotate() error {
+	if err := s.file.Close(); err != nil {
+		return fmt.Errorf("rotate audit file: close: %v", err)
+	}
+
+	// drop the oldest backup, then shift all others by one
+	os.Remove(s.backupPath(s.maxBackups))
+	for i := s.maxBackups - 1; i >= 1; i-- {
+		os.Rename(s.backupPath(i), s.backupPath(i+1))
+	}
+
+	if s.maxBackups > 0 {
+		if err := os.Rename(s.path, s.backupPath(1)); err != nil {
+			return fmt.Errorf("rotate audit file: %v", err)
+		}
+	} else if err := os.Remove(s.path); err != nil {
+		return fmt.Errorf("rotate audit file: %v", err)
+	}
+
+	return s.open()
+}
+
+func (s *FileSink) backupPath(i int) string {
+	return fmt.Sprintf("%s.%d", s.path, i)
+}
+
+// Close the underlying file
+func (s *FileSink) Close() error {
+	return s.file.Close()
+}
+
+type countingWriter struct {
+	s *FileSink
+}
+
+func (w countingWriter) Write(p []byte) (int, error) {
+	n, err := w.s.file.Write(p)
+	w.s.size += int64(n)
+	return n, err
+}
+
+func writeEvents(w io.Writer, events []Event) error {
+	enc := json.NewEncoder(w)
+	for _, event := range events {
+		if err := enc.Encode(event); err != nil {
+			return fmt.Errorf("write audit event: %v", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package audit
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileSink_Rotation(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "audit.log")
+
+	// every event is larger than the max size, so every write rotates
+	sink, err := NewFileSink(path, 10, 2)
+	require.Nil(t, err)
+	defer sink.Close()
+
+	for _, verb := range []string{"first", "second", "third", "fourth"} {
+		require.Nil(t, sink.Write([]Event{{Verb: verb}}))
+	}
+
+	contents := func(path string) string {
+		b, err := ioutil.ReadFile(path)
+		require.Nil(t, err)
+		return string(b)
+	}
+
+	assert.Equal(t, "", contents(path), "current file is empty after rotating")
+	assert.True(t, strings.Contains(contents(path+".1"), `"verb":"fourth"`))
+	assert.True(t, strings.Contains(contents(path+".2"), `"verb":"third"`))
+	_, err = os.Stat(path + ".3")
+	assert.True(t, os.IsNotExist(err), "only max backups are kept")
+}
+
+func TestFileSink_Append(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "audit.log")
+
+	sink, err := NewFileSink(path, 1024*1024, 2)
+	require.Nil(t, err)
+	require.Nil(t, sink.Write([]Event{{Verb: "first"}}))
+	require.Nil(t, sink.Close())
+
+	// reopening, such as after a restart, appends to the existing file
+	sink, err = NewFileSink(path, 1024*1024, 2)
+	require.Nil(t, err)
+	require.Nil(t, sink.Write([]Event{{Verb: "second"}}))
+	require.Nil(t, sink.Close())
+
+	b, err := ioutil.ReadFile(path)
+	require.Nil(t, err)
+	lines := strings.Split(strings.TrimSpace(string(b)), "\n")
+	require.Len(t, lines, 2)
+	assert.True(t, strings.Contains(lines[0], `"verb":"first"`))
+	assert.True(t, strings.Contains(lines[1], `"verb":"second"`))
+}
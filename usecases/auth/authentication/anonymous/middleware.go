@@ -18,6 +18,7 @@ import (
 	"strings"
 
 	"github.com/go-openapi/runtime"
+	"github.com/semi-technologies/weaviate/usecases/auth/authentication/apikey"
 	"github.com/semi-technologies/weaviate/usecases/config"
 )
 
@@ -43,17 +44,17 @@ func (c *Client) Middleware(next http.Handler) http.Handler {
 	}
 
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if hasBearerAuth(r) {
-			// if an OIDC-Header is present we can be sure that the OIDC
-			// Authenticator has already validated the token, so we don't have to do
-			// anything and cann call the next handler.
+		if hasBearerAuth(r) || hasAPIKeyHeader(r) {
+			// if an OIDC-Header or an api key is present we can be sure that the
+			// respective Authenticator has already validated it, so we don't have to
+			// do anything and cann call the next handler.
 			next.ServeHTTP(w, r)
 			return
 		}
 
 		w.WriteHeader(401)
 		w.Write([]byte(
-			`{"code":401,"message":"anonymous access not enabled, please provide an auth scheme such as OIDC"}`,
+			`{"code":401,"message":"anonymous access not enabled, please provide an auth scheme such as OIDC or an api key"}`,
 		))
 	})
 }
@@ -81,3 +82,7 @@ func hasBearerAuth(r *http.Request) bool {
 
 	return token != ""
 }
+
+func hasAPIKeyHeader(r *http.Request) bool {
+	return r.Header.Get(apikey.HeaderName) != ""
+}
@@ -98,4 +98,30 @@ func Test_AnonymousMiddleware_Disabled(t *testing.T) {
 
 		assert.Equal(t, response.StatusCode, 900)
 	})
+
+	t.Run("when api keys are enabled, and an api key header provided", func(t *testing.T) {
+		r := httptest.NewRequest("GET", "/foo", nil)
+		r.Header.Add("X-Api-Key", "foo")
+		w := httptest.NewRecorder()
+
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(900)
+		})
+
+		cfg := config.Config{
+			Authentication: config.Authentication{
+				AnonymousAccess: config.AnonymousAccess{
+					Enabled: false,
+				},
+				APIKey: config.APIKey{
+					Enabled: true,
+				},
+			},
+		}
+
+		New(cfg).Middleware(next).ServeHTTP(w, r)
+		response := w.Result()
+
+		assert.Equal(t, response.StatusCode, 900)
+	})
 }
@@ -0,0 +1,241 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package apikey
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	errors "github.com/go-openapi/errors"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+)
+
+// HeaderName is the header an api key can be sent in as an alternative to
+// sending it as a bearer token
+const HeaderName = "X-Api-Key"
+
+// Client validates static api keys and provides a middleware to be used with
+// the goswagger API. Keys from the keys file can be reloaded at runtime.
+type Client struct {
+	config config.APIKey
+	logger logrus.FieldLogger
+
+	sync.RWMutex
+	principals  map[[sha256.Size]byte]*models.Principal
+	keysModTime time.Time
+}
+
+// New api key client. If api keys are enabled, the keys are validated and
+// loaded at startup, so that an invalid configuration fails early.
+func New(cfg config.Config, logger logrus.FieldLogger) (*Client, error) {
+	client := &Client{
+		config: cfg.Authentication.APIKey,
+		logger: logger,
+	}
+
+	if !client.config.Enabled {
+		// if api keys are not enabled, there is nothing to load. The "disabled"
+		// client is however still valuable to deny any requests coming in with
+		// an api key set.
+		return client, nil
+	}
+
+	if err := client.Reload(); err != nil {
+		return nil, fmt.Errorf("apikey init: %v", err)
+	}
+
+	return client, nil
+}
+
+// Enabled indicates whether api keys are configured at all
+func (c *Client) Enabled() bool {
+	return c.config.Enabled
+}
+
+// ValidateAndExtract can be used as a middleware for go-swagger
+func (c *Client) ValidateAndExtract(token string, scopes []string) (*models.Principal, error) {
+	if !c.config.Enabled {
+		return nil, errors.New(401, "apikey auth is not configured, please try another auth scheme or set up weaviate with api keys configured")
+	}
+
+	principal, ok := c.Lookup(token)
+	if !ok {
+		return nil, errors.New(401, "invalid api key")
+	}
+
+	return principal, nil
+}
+
+// Lookup the principal of the key, ok is false if the key is unknown or api
+// keys are not enabled
+func (c *Client) Lookup(token string) (*models.Principal, bool) {
+	if !c.config.Enabled || token == "" {
+		return nil, false
+	}
+
+	c.RLock()
+	defer c.RUnlock()
+
+	principal, ok := c.principals[sha256.Sum256([]byte(token))]
+	if !ok {
+		return nil, false
+	}
+
+	// return a copy, so that callers can't alter the configured principal
+	return &models.Principal{
+		Username: principal.Username,
+		Groups:   append([]string(nil), principal.Groups...),
+	}, true
+}
+
+// Reload the inline keys and the keys file. The previous keys are kept if the
+// new ones are invalid.
+func (c *Client) Reload() error {
+	entries := append([]config.APIKeyEntry(nil), c.config.Keys...)
+	var modTime time.Time
+
+	if c.config.KeysFile != "" {
+		fromFile, mt, err := readKeysFile(c.config.KeysFile)
+		if err != nil {
+			return err
+		}
+
+		entries = append(entries, fromFile...)
+		modTime = mt
+	}
+
+	principals, err := buildPrincipals(entries)
+	if err != nil {
+		return err
+	}
+
+	c.Lock()
+	c.principals = principals
+	c.keysModTime = modTime
+	c.Unlock()
+
+	return nil
+}
+
+// WatchKeysFile reloads the keys whenever the keys file was modified. It
+// checks for modifications in the specified interval and blocks until the
+// context is cancelled.
+func (c *Client) WatchKeysFile(ctx context.Context, interval time.Duration) {
+	if !c.config.Enabled || c.config.KeysFile == "" {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.reloadIfModified()
+		}
+	}
+}
+
+func (c *Client) reloadIfModified() {
+	info, err := os.Stat(c.config.KeysFile)
+	if err != nil {
+		c.logger.WithField("action", "apikey_reload").WithError(err).
+			Error("could not stat api keys file, keeping previous keys")
+		return
+	}
+
+	c.RLock()
+	unchanged := info.ModTime().Equal(c.keysModTime)
+	c.RUnlock()
+	if unchanged {
+		return
+	}
+
+	if err := c.Reload(); err != nil {
+		c.logger.WithField("action", "apikey_reload").WithError(err).
+			Error("could not reload api keys, keeping previous keys")
+		return
+	}
+
+	c.logger.WithField("action", "apikey_reload").
+		Info("reloaded api keys")
+}
+
+func readKeysFile(path string) ([]config.APIKeyEntry, time.Time, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("keys file: %v", err)
+	}
+
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("keys file: %v", err)
+	}
+
+	var entries []config.APIKeyEntry
+	if err := yaml.Unmarshal(contents, &entries); err != nil {
+		return nil, time.Time{}, fmt.Errorf("keys file: %v", err)
+	}
+
+	return entries, info.ModTime(), nil
+}
+
+func buildPrincipals(entries []config.APIKeyEntry) (map[[sha256.Size]byte]*models.Principal, error) {
+	var msgs []string
+	principals := map[[sha256.Size]byte]*models.Principal{}
+
+	for i, entry := range entries {
+		if entry.Key == "" {
+			msgs = append(msgs, fmt.Sprintf("key %d: missing required field 'key'", i))
+			continue
+		}
+
+		if entry.Username == "" {
+			msgs = append(msgs, fmt.Sprintf("key %d: missing required field 'username'", i))
+			continue
+		}
+
+		hash := sha256.Sum256([]byte(entry.Key))
+		if _, ok := principals[hash]; ok {
+			msgs = append(msgs, fmt.Sprintf("key %d: key is not unique", i))
+			continue
+		}
+
+		principals[hash] = &models.Principal{
+			Username: entry.Username,
+			Groups:   entry.Groups,
+		}
+	}
+
+	if len(msgs) > 0 {
+		return nil, fmt.Errorf(strings.Join(msgs, ", "))
+	}
+
+	if len(principals) == 0 {
+		return nil, fmt.Errorf("no api keys configured, set 'keys' or 'keys_file'")
+	}
+
+	return principals, nil
+}
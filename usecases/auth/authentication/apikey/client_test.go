@@ -0,0 +1,167 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package apikey
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Disabled(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	client, err := New(config.Config{}, logger)
+	require.Nil(t, err)
+
+	_, err = client.ValidateAndExtract("some-key", nil)
+	assert.Equal(t, "apikey auth is not configured, please try another auth scheme "+
+		"or set up weaviate with api keys configured", err.Error())
+
+	_, ok := client.Lookup("some-key")
+	assert.False(t, ok)
+}
+
+func TestClient_InvalidConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		keys        []config.APIKeyEntry
+		expectedErr string
+	}{
+		{
+			name:        "no keys",
+			expectedErr: "apikey init: no api keys configured, set 'keys' or 'keys_file'",
+		},
+		{
+			name: "missing fields",
+			keys: []config.APIKeyEntry{
+				{Username: "alice"},
+				{Key: "secret"},
+			},
+			expectedErr: "apikey init: key 0: missing required field 'key', " +
+				"key 1: missing required field 'username'",
+		},
+		{
+			name: "duplicate key",
+			keys: []config.APIKeyEntry{
+				{Key: "secret", Username: "alice"},
+				{Key: "secret", Username: "bob"},
+			},
+			expectedErr: "apikey init: key 1: key is not unique",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			logger, _ := test.NewNullLogger()
+			_, err := New(configWithKeys(tc.keys, ""), logger)
+			require.NotNil(t, err)
+			assert.Equal(t, tc.expectedErr, err.Error())
+		})
+	}
+}
+
+func TestClient_ValidateAndExtract(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	client, err := New(configWithKeys([]config.APIKeyEntry{
+		{Key: "old-secret", Username: "alice", Groups: []string{"admins"}},
+		{Key: "new-secret", Username: "alice", Groups: []string{"admins"}},
+		{Key: "other-secret", Username: "bob"},
+	}, ""), logger)
+	require.Nil(t, err)
+
+	t.Run("with a valid key", func(t *testing.T) {
+		principal, err := client.ValidateAndExtract("new-secret", nil)
+		require.Nil(t, err)
+		assert.Equal(t, &models.Principal{Username: "alice", Groups: []string{"admins"}}, principal)
+	})
+
+	t.Run("with another key of the same user", func(t *testing.T) {
+		principal, err := client.ValidateAndExtract("old-secret", nil)
+		require.Nil(t, err)
+		assert.Equal(t, "alice", principal.Username)
+	})
+
+	t.Run("with an unknown key", func(t *testing.T) {
+		_, err := client.ValidateAndExtract("unknown", nil)
+		assert.Equal(t, "invalid api key", err.Error())
+	})
+}
+
+func TestClient_KeysFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "apikey")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "keys.yaml")
+
+	writeKeys := func(t *testing.T, contents string, modTime time.Time) {
+		require.Nil(t, ioutil.WriteFile(path, []byte(contents), 0600))
+		require.Nil(t, os.Chtimes(path, modTime, modTime))
+	}
+
+	writeKeys(t, "- key: first-secret\n  username: alice\n", time.Unix(1000, 0))
+
+	logger, _ := test.NewNullLogger()
+	client, err := New(configWithKeys([]config.APIKeyEntry{
+		{Key: "inline-secret", Username: "bob"},
+	}, path), logger)
+	require.Nil(t, err)
+
+	t.Run("inline and file keys are loaded", func(t *testing.T) {
+		_, ok := client.Lookup("inline-secret")
+		assert.True(t, ok)
+		_, ok = client.Lookup("first-secret")
+		assert.True(t, ok)
+	})
+
+	t.Run("rotating the key in the file", func(t *testing.T) {
+		writeKeys(t, "- key: second-secret\n  username: alice\n", time.Unix(2000, 0))
+		client.reloadIfModified()
+
+		_, ok := client.Lookup("first-secret")
+		assert.False(t, ok, "the rotated key must no longer be valid")
+		principal, ok := client.Lookup("second-secret")
+		require.True(t, ok)
+		assert.Equal(t, "alice", principal.Username)
+		_, ok = client.Lookup("inline-secret")
+		assert.True(t, ok, "inline keys must be kept")
+	})
+
+	t.Run("an invalid file keeps the previous keys", func(t *testing.T) {
+		writeKeys(t, "- key: second-secret\n", time.Unix(3000, 0))
+		client.reloadIfModified()
+
+		_, ok := client.Lookup("second-secret")
+		assert.True(t, ok)
+	})
+}
+
+func configWithKeys(keys []config.APIKeyEntry, keysFile string) config.Config {
+	return config.Config{
+		Authentication: config.Authentication{
+			APIKey: config.APIKey{
+				Enabled:  true,
+				Keys:     keys,
+				KeysFile: keysFile,
+			},
+		},
+	}
+}
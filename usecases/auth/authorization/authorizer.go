@@ -16,6 +16,7 @@ package authorization
 import (
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/usecases/auth/authorization/adminlist"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization/rbac"
 	"github.com/semi-technologies/weaviate/usecases/config"
 )
 
@@ -33,6 +34,10 @@ func New(cfg config.Config) Authorizer {
 		return adminlist.New(cfg.Authorization.AdminList)
 	}
 
+	if cfg.Authorization.RBAC.Enabled {
+		return rbac.New(cfg.Authorization.RBAC)
+	}
+
 	return &DummyAuthorizer{}
 }
 
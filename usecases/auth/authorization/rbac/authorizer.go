@@ -0,0 +1,91 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package rbac
+
+import (
+	"sync"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization/errors"
+)
+
+// AnonymousPrincipalUsername is the username roles need to be bound to in
+// order to apply to unauthenticated requests
+const AnonymousPrincipalUsername = "anonymous"
+
+// Authorizer grants access if any of the roles bound to the user or one of
+// their groups allows the verb on the resource
+type Authorizer struct {
+	sync.RWMutex
+	static  []role
+	managed []role
+}
+
+// New Authorizer with the static roles of the config
+func New(cfg Config) *Authorizer {
+	a := &Authorizer{}
+	for _, r := range cfg.Roles {
+		a.static = append(a.static, newRole(r.model()))
+	}
+
+	return a
+}
+
+// Authorize the principal to perform the verb on the resource
+func (a *Authorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	if principal == nil {
+		principal = newAnonymousPrincipal()
+	}
+
+	a.RLock()
+	defer a.RUnlock()
+
+	for _, roles := range [][]role{a.static, a.managed} {
+		for _, r := range roles {
+			if r.boundTo(principal) && r.allows(verb, resource) {
+				return nil
+			}
+		}
+	}
+
+	return errors.NewForbidden(principal, verb, resource)
+}
+
+// StaticRoles are the roles from the config, they can't be changed
+func (a *Authorizer) StaticRoles() []*models.Role {
+	out := make([]*models.Role, len(a.static))
+	for i, r := range a.static {
+		out[i] = r.model
+	}
+
+	return out
+}
+
+// SetManagedRoles replaces all roles which are managed through the api
+func (a *Authorizer) SetManagedRoles(roles []*models.Role) {
+	managed := make([]role, len(roles))
+	for i, r := range roles {
+		managed[i] = newRole(r)
+	}
+
+	a.Lock()
+	defer a.Unlock()
+	a.managed = managed
+}
+
+func newAnonymousPrincipal() *models.Principal {
+	return &models.Principal{
+		Username: AnonymousPrincipalUsername,
+	}
+}
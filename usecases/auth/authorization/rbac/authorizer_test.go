@@ -0,0 +1,147 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package rbac
+
+import (
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Authorizer(t *testing.T) {
+	cfg := Config{
+		Enabled: true,
+		Roles: []Role{
+			{
+				Name: "importer",
+				Permissions: []Permission{
+					{
+						Verbs:     []string{"create", "update"},
+						Resources: []string{"things/*", "batch/*"},
+					},
+				},
+				Users: []string{"alice"},
+			},
+			{
+				Name: "analyst",
+				Permissions: []Permission{
+					{
+						Verbs:     []string{"get", "list"},
+						Resources: []string{"things/*", "traversal/*"},
+					},
+				},
+				Groups: []string{"data-team"},
+			},
+			{
+				Name: "schema-editor",
+				Permissions: []Permission{
+					{
+						Verbs:     []string{"*"},
+						Resources: []string{"schema/things"},
+					},
+				},
+				Users: []string{AnonymousPrincipalUsername},
+			},
+		},
+	}
+
+	type test struct {
+		name      string
+		principal *models.Principal
+		verb      string
+		resource  string
+		allowed   bool
+	}
+
+	alice := &models.Principal{Username: "alice"}
+	bob := &models.Principal{Username: "bob", Groups: []string{"data-team"}}
+	carol := &models.Principal{Username: "carol", Groups: []string{"marketing"}}
+
+	tests := []test{
+		{"bound user, matching verb and resource", alice, "create", "things", true},
+		{"bound user, nested resource", alice, "update", "things/some-id", true},
+		{"bound user, literal wildcard resource", alice, "update", "batch/*", true},
+		{"bound user, wrong verb", alice, "delete", "things/some-id", false},
+		{"bound user, wrong resource", alice, "create", "actions", false},
+		{"bound group", bob, "get", "traversal/*", true},
+		{"bound group, wrong verb", bob, "create", "things", false},
+		{"unbound group", carol, "get", "things", false},
+		{"anonymous with any verb", nil, "delete", "schema/things", true},
+		{"anonymous on a resource below an exact pattern", nil, "update", "schema/things/Foo", false},
+	}
+
+	authorizer := New(cfg)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := authorizer.Authorize(test.principal, test.verb, test.resource)
+			if test.allowed {
+				assert.Nil(t, err)
+			} else {
+				assert.IsType(t, errors.Forbidden{}, err)
+			}
+		})
+	}
+
+	t.Run("managed roles are considered", func(t *testing.T) {
+		authorizer := New(cfg)
+		err := authorizer.Authorize(carol, "get", "classifications/*")
+		assert.IsType(t, errors.Forbidden{}, err)
+
+		authorizer.SetManagedRoles([]*models.Role{
+			{
+				Name: "classifier",
+				Permissions: []*models.RolePermission{
+					{Verbs: []string{"get"}, Resources: []string{"classifications/*"}},
+				},
+				Groups: []string{"marketing"},
+			},
+		})
+		err = authorizer.Authorize(carol, "get", "classifications/*")
+		assert.Nil(t, err)
+
+		authorizer.SetManagedRoles(nil)
+		err = authorizer.Authorize(carol, "get", "classifications/*")
+		assert.IsType(t, errors.Forbidden{}, err)
+	})
+}
+
+func Test_Matches(t *testing.T) {
+	type test struct {
+		pattern  string
+		resource string
+		expected bool
+	}
+
+	tests := []test{
+		{"*", "things/some-id", true},
+		{"things", "things", true},
+		{"things", "things/some-id", false},
+		{"things/*", "things", true},
+		{"things/*", "things/some-id", true},
+		{"things/*", "things/some-id/references", true},
+		{"things/*", "thingsfoo", false},
+		{"things/*", "actions", false},
+		{"backups/*/restore", "backups/my-backup/restore", true},
+		{"backups/*/restore", "backups/my-backup", false},
+		{"schema/*/Foo", "schema/things/Foo", true},
+		{"schema/*/*", "schema/things", true},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, matches(test.pattern, test.resource),
+			"pattern '%s' on resource '%s'", test.pattern, test.resource)
+	}
+}
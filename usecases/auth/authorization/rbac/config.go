@@ -0,0 +1,80 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package rbac
+
+import (
+	"fmt"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+)
+
+// Config grants permissions through roles. The roles defined here are static,
+// additional roles can be managed at runtime through the api.
+type Config struct {
+	Enabled bool   `json:"enabled" yaml:"enabled"`
+	Roles   []Role `json:"roles" yaml:"roles"`
+}
+
+// Role as defined in the config file, see models.Role for the meaning of the
+// fields
+type Role struct {
+	Name        string       `json:"name" yaml:"name"`
+	Permissions []Permission `json:"permissions" yaml:"permissions"`
+	Users       []string     `json:"users" yaml:"users"`
+	Groups      []string     `json:"groups" yaml:"groups"`
+}
+
+// Permission grants all verbs on all resources
+type Permission struct {
+	Verbs     []string `json:"verbs" yaml:"verbs"`
+	Resources []string `json:"resources" yaml:"resources"`
+}
+
+// Validate rbac config for viability, can be called from the central config
+// package
+func (c Config) Validate() error {
+	names := map[string]struct{}{}
+	for _, role := range c.Roles {
+		if err := validateRole(role.model()); err != nil {
+			return fmt.Errorf("rbac: %v", err)
+		}
+
+		if _, ok := names[role.Name]; ok {
+			return fmt.Errorf("rbac: role '%s' is defined more than once", role.Name)
+		}
+		names[role.Name] = struct{}{}
+	}
+
+	return nil
+}
+
+// model of the role, roles from the config are always static
+func (r Role) model() *models.Role {
+	static := true
+	permissions := make([]*models.RolePermission, len(r.Permissions))
+	for i, p := range r.Permissions {
+		permissions[i] = &models.RolePermission{
+			Verbs:     p.Verbs,
+			Resources: p.Resources,
+		}
+	}
+
+	return &models.Role{
+		Name:        r.Name,
+		Permissions: permissions,
+		Users:       r.Users,
+		Groups:      r.Groups,
+		Static:      &static,
+	}
+}
@@ -0,0 +1,96 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package rbac
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Validation(t *testing.T) {
+	validPermissions := []Permission{
+		{Verbs: []string{"get"}, Resources: []string{"things/*"}},
+	}
+
+	t.Run("with valid roles", func(t *testing.T) {
+		cfg := Config{
+			Enabled: true,
+			Roles: []Role{
+				{Name: "analyst", Permissions: validPermissions, Users: []string{"alice"}},
+				{Name: "schema-editor", Permissions: validPermissions, Groups: []string{"admins"}},
+			},
+		}
+
+		assert.Nil(t, cfg.Validate())
+	})
+
+	t.Run("with an invalid name", func(t *testing.T) {
+		cfg := Config{
+			Enabled: true,
+			Roles:   []Role{{Name: "my role", Permissions: validPermissions}},
+		}
+
+		assert.Equal(t, fmt.Errorf("rbac: invalid role name 'my role', the name may only "+
+			"contain letters, numbers, dashes and underscores"), cfg.Validate())
+	})
+
+	t.Run("without permissions", func(t *testing.T) {
+		cfg := Config{
+			Enabled: true,
+			Roles:   []Role{{Name: "analyst"}},
+		}
+
+		assert.Equal(t, fmt.Errorf("rbac: role 'analyst': at least one permission is required"),
+			cfg.Validate())
+	})
+
+	t.Run("with a permission without verbs", func(t *testing.T) {
+		cfg := Config{
+			Enabled: true,
+			Roles: []Role{{Name: "analyst", Permissions: []Permission{
+				{Resources: []string{"things"}},
+			}}},
+		}
+
+		assert.Equal(t, fmt.Errorf("rbac: role 'analyst': permission 0: at least one verb "+
+			"and one resource are required"), cfg.Validate())
+	})
+
+	t.Run("with an invalid resource pattern", func(t *testing.T) {
+		cfg := Config{
+			Enabled: true,
+			Roles: []Role{{Name: "analyst", Permissions: []Permission{
+				{Verbs: []string{"get"}, Resources: []string{"things/["}},
+			}}},
+		}
+
+		assert.Equal(t, fmt.Errorf("rbac: role 'analyst': permission 0: invalid resource "+
+			"pattern 'things/['"), cfg.Validate())
+	})
+
+	t.Run("with a role defined twice", func(t *testing.T) {
+		cfg := Config{
+			Enabled: true,
+			Roles: []Role{
+				{Name: "analyst", Permissions: validPermissions},
+				{Name: "analyst", Permissions: validPermissions},
+			},
+		}
+
+		assert.Equal(t, fmt.Errorf("rbac: role 'analyst' is defined more than once"),
+			cfg.Validate())
+	})
+}
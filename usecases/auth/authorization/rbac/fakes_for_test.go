@@ -0,0 +1,58 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package rbac
+
+import (
+	"context"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+)
+
+type fakeRoleRepo struct {
+	roles map[string]*models.Role
+}
+
+func newFakeRoleRepo(roles ...*models.Role) *fakeRoleRepo {
+	r := &fakeRoleRepo{roles: map[string]*models.Role{}}
+	for _, role := range roles {
+		r.roles[role.Name] = role
+	}
+
+	return r
+}
+
+func (f *fakeRoleRepo) List(ctx context.Context) ([]*models.Role, error) {
+	var out []*models.Role
+	for _, role := range f.roles {
+		out = append(out, role)
+	}
+
+	return out, nil
+}
+
+func (f *fakeRoleRepo) Put(ctx context.Context, role *models.Role) error {
+	f.roles[role.Name] = role
+	return nil
+}
+
+func (f *fakeRoleRepo) Delete(ctx context.Context, name string) error {
+	delete(f.roles, name)
+	return nil
+}
+
+type fakeAuthorizer struct{}
+
+func (f *fakeAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	return nil
+}
@@ -0,0 +1,267 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package rbac
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+)
+
+// RoleRepo persists the roles managed through the api
+type RoleRepo interface {
+	List(ctx context.Context) ([]*models.Role, error)
+	Put(ctx context.Context, role *models.Role) error
+	Delete(ctx context.Context, name string) error
+}
+
+type authorizer interface {
+	Authorize(principal *models.Principal, verb, resource string) error
+}
+
+// Manager manages the roles which are not defined in the config. Changes are
+// persisted in the repo and take effect immediately.
+type Manager struct {
+	sync.Mutex
+	repo       RoleRepo
+	rbac       *Authorizer
+	authorizer authorizer
+	managed    map[string]*models.Role
+}
+
+// NewManager for the rbac authorizer. If rbac is nil, because a different
+// authorization method is configured, all calls fail with an
+// ErrInvalidUserInput.
+func NewManager(repo RoleRepo, rbac *Authorizer, authorizer authorizer) *Manager {
+	return &Manager{
+		repo:       repo,
+		rbac:       rbac,
+		authorizer: authorizer,
+		managed:    map[string]*models.Role{},
+	}
+}
+
+// Init loads the previously stored roles into the authorizer
+func (m *Manager) Init(ctx context.Context) error {
+	if m.rbac == nil {
+		return nil
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	roles, err := m.repo.List(ctx)
+	if err != nil {
+		return fmt.Errorf("load roles: %v", err)
+	}
+
+	for _, role := range roles {
+		m.managed[role.Name] = role
+	}
+	m.apply()
+
+	return nil
+}
+
+// List the static as well as the managed roles
+func (m *Manager) List(ctx context.Context, principal *models.Principal) ([]*models.Role, error) {
+	err := m.authorizer.Authorize(principal, "list", "roles")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.validateEnabled(); err != nil {
+		return nil, err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	return append(m.rbac.StaticRoles(), m.managedRoles()...), nil
+}
+
+// Get a single role by name, returns nil if the role doesn't exist
+func (m *Manager) Get(ctx context.Context, principal *models.Principal,
+	name string) (*models.Role, error) {
+	err := m.authorizer.Authorize(principal, "get", fmt.Sprintf("roles/%s", name))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.validateEnabled(); err != nil {
+		return nil, err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	if role := m.staticRole(name); role != nil {
+		return role, nil
+	}
+
+	return m.managed[name], nil
+}
+
+// Add a role, the name must not be in use yet
+func (m *Manager) Add(ctx context.Context, principal *models.Principal,
+	role *models.Role) (*models.Role, error) {
+	err := m.authorizer.Authorize(principal, "create", "roles")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.validateEnabled(); err != nil {
+		return nil, err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	if err := validateRole(role); err != nil {
+		return nil, NewErrInvalidUserInput("invalid role: %v", err)
+	}
+
+	if m.staticRole(role.Name) != nil || m.managed[role.Name] != nil {
+		return nil, NewErrInvalidUserInput("role '%s' already exists", role.Name)
+	}
+
+	return m.put(ctx, role)
+}
+
+// Update replaces the permissions and bindings of a managed role
+func (m *Manager) Update(ctx context.Context, principal *models.Principal,
+	name string, role *models.Role) (*models.Role, error) {
+	err := m.authorizer.Authorize(principal, "update", fmt.Sprintf("roles/%s", name))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.validateEnabled(); err != nil {
+		return nil, err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	if err := m.validateManaged(name); err != nil {
+		return nil, err
+	}
+
+	if role.Name != name {
+		return nil, NewErrInvalidUserInput("role name '%s' does not match the name "+
+			"in the path '%s', roles can't be renamed", role.Name, name)
+	}
+
+	if err := validateRole(role); err != nil {
+		return nil, NewErrInvalidUserInput("invalid role: %v", err)
+	}
+
+	return m.put(ctx, role)
+}
+
+// Delete a managed role
+func (m *Manager) Delete(ctx context.Context, principal *models.Principal, name string) error {
+	err := m.authorizer.Authorize(principal, "delete", fmt.Sprintf("roles/%s", name))
+	if err != nil {
+		return err
+	}
+
+	if err := m.validateEnabled(); err != nil {
+		return err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	if err := m.validateManaged(name); err != nil {
+		return err
+	}
+
+	if err := m.repo.Delete(ctx, name); err != nil {
+		return fmt.Errorf("delete role: %v", err)
+	}
+
+	delete(m.managed, name)
+	m.apply()
+
+	return nil
+}
+
+func (m *Manager) put(ctx context.Context, role *models.Role) (*models.Role, error) {
+	static := false
+	role.Static = &static
+
+	if err := m.repo.Put(ctx, role); err != nil {
+		return nil, fmt.Errorf("store role: %v", err)
+	}
+
+	m.managed[role.Name] = role
+	m.apply()
+
+	return role, nil
+}
+
+func (m *Manager) validateEnabled() error {
+	if m.rbac == nil {
+		return NewErrInvalidUserInput("roles can only be managed if rbac authorization " +
+			"is enabled, set 'authorization.rbac.enabled'")
+	}
+
+	return nil
+}
+
+func (m *Manager) validateManaged(name string) error {
+	if m.staticRole(name) != nil {
+		return NewErrInvalidUserInput("role '%s' is defined in the config and "+
+			"can't be changed through the api", name)
+	}
+
+	if m.managed[name] == nil {
+		return NewErrNotFound("no role with name '%s'", name)
+	}
+
+	return nil
+}
+
+func (m *Manager) staticRole(name string) *models.Role {
+	for _, role := range m.rbac.StaticRoles() {
+		if role.Name == name {
+			return role
+		}
+	}
+
+	return nil
+}
+
+// managedRoles sorted by name
+func (m *Manager) managedRoles() []*models.Role {
+	out := make([]*models.Role, 0, len(m.managed))
+	for _, role := range m.managed {
+		out = append(out, role)
+	}
+
+	sort.Slice(out, func(a, b int) bool {
+		return out[a].Name < out[b].Name
+	})
+
+	return out
+}
+
+// apply the current set of managed roles to the authorizer
+func (m *Manager) apply() {
+	m.rbac.SetManagedRoles(m.managedRoles())
+}
@@ -0,0 +1,140 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package rbac
+
+import (
+	"context"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Manager(t *testing.T) {
+	cfg := Config{
+		Enabled: true,
+		Roles: []Role{
+			{
+				Name: "admin",
+				Permissions: []Permission{
+					{Verbs: []string{"*"}, Resources: []string{"*"}},
+				},
+				Users: []string{"alice"},
+			},
+		},
+	}
+
+	importer := func() *models.Role {
+		return &models.Role{
+			Name: "importer",
+			Permissions: []*models.RolePermission{
+				{Verbs: []string{"create"}, Resources: []string{"things/*"}},
+			},
+			Users: []string{"bob"},
+		}
+	}
+
+	bob := &models.Principal{Username: "bob"}
+	ctx := context.Background()
+
+	t.Run("stored roles are loaded on init", func(t *testing.T) {
+		authorizer := New(cfg)
+		m := NewManager(newFakeRoleRepo(importer()), authorizer, &fakeAuthorizer{})
+		require.Nil(t, m.Init(ctx))
+
+		assert.Nil(t, authorizer.Authorize(bob, "create", "things"))
+
+		roles, err := m.List(ctx, nil)
+		require.Nil(t, err)
+		require.Len(t, roles, 2)
+		assert.Equal(t, "admin", roles[0].Name)
+		assert.True(t, *roles[0].Static)
+		assert.Equal(t, "importer", roles[1].Name)
+	})
+
+	t.Run("adding, updating and deleting a role", func(t *testing.T) {
+		authorizer := New(cfg)
+		repo := newFakeRoleRepo()
+		m := NewManager(repo, authorizer, &fakeAuthorizer{})
+		require.Nil(t, m.Init(ctx))
+
+		res, err := m.Add(ctx, nil, importer())
+		require.Nil(t, err)
+		assert.False(t, *res.Static)
+		assert.NotNil(t, repo.roles["importer"])
+		assert.Nil(t, authorizer.Authorize(bob, "create", "things"))
+
+		_, err = m.Add(ctx, nil, importer())
+		assert.Equal(t, NewErrInvalidUserInput("role 'importer' already exists"), err)
+
+		updated := importer()
+		updated.Users = []string{"carol"}
+		_, err = m.Update(ctx, nil, "importer", updated)
+		require.Nil(t, err)
+		assert.IsType(t, errors.Forbidden{}, authorizer.Authorize(bob, "create", "things"))
+
+		role, err := m.Get(ctx, nil, "importer")
+		require.Nil(t, err)
+		assert.Equal(t, []string{"carol"}, role.Users)
+
+		require.Nil(t, m.Delete(ctx, nil, "importer"))
+		assert.Nil(t, repo.roles["importer"])
+
+		role, err = m.Get(ctx, nil, "importer")
+		require.Nil(t, err)
+		assert.Nil(t, role)
+	})
+
+	t.Run("invalid changes", func(t *testing.T) {
+		m := NewManager(newFakeRoleRepo(), New(cfg), &fakeAuthorizer{})
+		require.Nil(t, m.Init(ctx))
+
+		_, err := m.Add(ctx, nil, &models.Role{Name: "no-permissions"})
+		assert.Equal(t, NewErrInvalidUserInput("invalid role: role 'no-permissions': "+
+			"at least one permission is required"), err)
+
+		role := importer()
+		role.Name = "admin"
+		_, err = m.Add(ctx, nil, role)
+		assert.Equal(t, NewErrInvalidUserInput("role 'admin' already exists"), err)
+
+		_, err = m.Update(ctx, nil, "admin", role)
+		assert.Equal(t, NewErrInvalidUserInput("role 'admin' is defined in the config "+
+			"and can't be changed through the api"), err)
+
+		err = m.Delete(ctx, nil, "admin")
+		assert.Equal(t, NewErrInvalidUserInput("role 'admin' is defined in the config "+
+			"and can't be changed through the api"), err)
+
+		_, err = m.Update(ctx, nil, "importer", importer())
+		assert.Equal(t, NewErrNotFound("no role with name 'importer'"), err)
+
+		_, err = m.Add(ctx, nil, importer())
+		require.Nil(t, err)
+		_, err = m.Update(ctx, nil, "importer", role)
+		assert.Equal(t, NewErrInvalidUserInput("role name 'admin' does not match the "+
+			"name in the path 'importer', roles can't be renamed"), err)
+	})
+
+	t.Run("without rbac enabled", func(t *testing.T) {
+		m := NewManager(newFakeRoleRepo(), nil, &fakeAuthorizer{})
+		require.Nil(t, m.Init(ctx))
+
+		_, err := m.List(ctx, nil)
+		assert.Equal(t, NewErrInvalidUserInput("roles can only be managed if rbac "+
+			"authorization is enabled, set 'authorization.rbac.enabled'"), err)
+	})
+}
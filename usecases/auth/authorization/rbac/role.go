@@ -0,0 +1,159 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package rbac
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+)
+
+var validRoleName = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+func validateRole(role *models.Role) error {
+	if !validRoleName.MatchString(role.Name) {
+		return fmt.Errorf("invalid role name '%s', the name may only contain letters, "+
+			"numbers, dashes and underscores", role.Name)
+	}
+
+	if len(role.Permissions) == 0 {
+		return fmt.Errorf("role '%s': at least one permission is required", role.Name)
+	}
+
+	for i, p := range role.Permissions {
+		if p == nil || len(p.Verbs) == 0 || len(p.Resources) == 0 {
+			return fmt.Errorf("role '%s': permission %d: at least one verb and one "+
+				"resource are required", role.Name, i)
+		}
+
+		for _, resource := range p.Resources {
+			if _, err := path.Match(resource, ""); err != nil {
+				return fmt.Errorf("role '%s': permission %d: invalid resource pattern '%s'",
+					role.Name, i, resource)
+			}
+		}
+	}
+
+	return nil
+}
+
+// role is the representation of a models.Role optimized for lookups
+type role struct {
+	model       *models.Role
+	permissions []permission
+	users       map[string]struct{}
+	groups      map[string]struct{}
+}
+
+type permission struct {
+	verbs     map[string]struct{}
+	resources []string
+}
+
+func newRole(model *models.Role) role {
+	r := role{
+		model:  model,
+		users:  set(model.Users),
+		groups: set(model.Groups),
+	}
+
+	for _, p := range model.Permissions {
+		r.permissions = append(r.permissions, permission{
+			verbs:     set(p.Verbs),
+			resources: p.Resources,
+		})
+	}
+
+	return r
+}
+
+func (r role) boundTo(principal *models.Principal) bool {
+	if _, ok := r.users[principal.Username]; ok {
+		return true
+	}
+
+	for _, group := range principal.Groups {
+		if _, ok := r.groups[group]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r role) allows(verb, resource string) bool {
+	for _, p := range r.permissions {
+		if !p.allowsVerb(verb) {
+			continue
+		}
+
+		for _, pattern := range p.resources {
+			if matches(pattern, resource) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (p permission) allowsVerb(verb string) bool {
+	if _, ok := p.verbs["*"]; ok {
+		return true
+	}
+
+	_, ok := p.verbs[verb]
+	return ok
+}
+
+// matches reports whether the resource matches the pattern. Patterns use the
+// syntax of path.Match, so a "*" matches a single segment of the resource. A
+// trailing "/*" additionally matches the resource without it and any number
+// of segments below, so that "things/*" covers "things" and "things/<id>"
+// alike. A "*" on its own matches every resource.
+func matches(pattern, resource string) bool {
+	if pattern == "*" {
+		return true
+	}
+
+	if ok, _ := path.Match(pattern, resource); ok {
+		return true
+	}
+
+	if !strings.HasSuffix(pattern, "/*") {
+		return false
+	}
+
+	prefix := strings.TrimSuffix(pattern, "/*")
+	n := strings.Count(prefix, "/") + 1
+	segments := strings.SplitN(resource, "/", n+1)
+	if len(segments) < n {
+		return false
+	}
+
+	ok, _ := path.Match(prefix, strings.Join(segments[:n], "/"))
+	return ok
+}
+
+func set(list []string) map[string]struct{} {
+	out := make(map[string]struct{}, len(list))
+	for _, item := range list {
+		out[item] = struct{}{}
+	}
+
+	return out
+}
@@ -0,0 +1,70 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package authorization
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+)
+
+// AllClasses is used in place of a class name if the class is not known, so
+// that only principals with access to all classes are authorized
+const AllClasses = "*"
+
+// ClassResource is the resource of all objects of a class, e.g.
+// things/Article
+func ClassResource(k kind.Kind, className string) string {
+	return fmt.Sprintf("%ss/%s", k.Name(), className)
+}
+
+// ObjectResource is the resource of a single object, e.g.
+// things/Article/<id>
+func ObjectResource(k kind.Kind, className string, id strfmt.UUID) string {
+	return fmt.Sprintf("%ss/%s/%s", k.Name(), className, id)
+}
+
+// BatchResource is the resource of the objects of a class imported in
+// batches, e.g. batch/things/Article
+func BatchResource(k kind.Kind, className string) string {
+	return fmt.Sprintf("batch/%ss/%s", k.Name(), className)
+}
+
+// TraversalResource is the resource of a class in traversals (Get, Aggregate
+// and Explore), e.g. traversal/things/Article
+func TraversalResource(k kind.Kind, className string) string {
+	return fmt.Sprintf("traversal/%ss/%s", k.Name(), className)
+}
+
+// ClassificationResource is the resource of classifications of a class, e.g.
+// classifications/Article. Class names are unique across kinds, a
+// classification is identified by its class alone.
+func ClassificationResource(className string) string {
+	return fmt.Sprintf("classifications/%s", className)
+}
+
+// RevectorizationResource is the resource of revectorizations of a class,
+// e.g. revectorizations/Article. Like classifications, a revectorization is
+// identified by its class alone.
+func RevectorizationResource(className string) string {
+	return fmt.Sprintf("revectorizations/%s", className)
+}
+
+// DanglingReferencesResource is the resource of checking the references of a
+// class for references to objects which no longer exist, e.g.
+// maintenance/dangling-references/Article
+func DanglingReferencesResource(className string) string {
+	return fmt.Sprintf("maintenance/dangling-references/%s", className)
+}
@@ -0,0 +1,144 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package backup
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+)
+
+// archiveVersion is the version of the archive format written by this
+// version of weaviate. It needs to be increased on every change which older
+// versions can't restore.
+const archiveVersion = 1
+
+// the files of a backup, the status files are rewritten on every progress
+// update, the other ones are written exactly once
+const (
+	fileBackup          = "backup.json"
+	fileRestore         = "restore.json"
+	fileSchema          = "schema.json"
+	fileClassifications = "classifications.json"
+	fileObjects         = "objects.ndjson"
+)
+
+// objectRecord is a single line of the objects file
+type objectRecord struct {
+	Kind          kind.Kind              `json:"kind"`
+	Class         string                 `json:"class"`
+	ID            strfmt.UUID            `json:"id"`
+	Schema        map[string]interface{} `json:"schema,omitempty"`
+	Meta          *models.ObjectMeta     `json:"meta,omitempty"`
+	VectorWeights map[string]string      `json:"vectorWeights,omitempty"`
+	Vector        []float32              `json:"vector,omitempty"`
+	Created       int64                  `json:"created"`
+	Updated       int64                  `json:"updated"`
+}
+
+func (r objectRecord) thing() *models.Thing {
+	return &models.Thing{
+		Class:              r.Class,
+		ID:                 r.ID,
+		Schema:             r.Schema,
+		Meta:               r.Meta,
+		VectorWeights:      r.vectorWeights(),
+		CreationTimeUnix:   r.Created,
+		LastUpdateTimeUnix: r.Updated,
+	}
+}
+
+func (r objectRecord) action() *models.Action {
+	return &models.Action{
+		Class:              r.Class,
+		ID:                 r.ID,
+		Schema:             r.Schema,
+		Meta:               r.Meta,
+		VectorWeights:      r.vectorWeights(),
+		CreationTimeUnix:   r.Created,
+		LastUpdateTimeUnix: r.Updated,
+	}
+}
+
+// vectorWeights must not be a typed nil, as the repos only check the
+// interface for nil
+func (r objectRecord) vectorWeights() models.VectorWeights {
+	if r.VectorWeights == nil {
+		return nil
+	}
+
+	return r.VectorWeights
+}
+
+// parseSchema turns the untyped property values read from the archive back
+// into the types the repos expect for the data type of each property
+func parseSchema(class *models.Class, in map[string]interface{}) (map[string]interface{}, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	out := map[string]interface{}{}
+	for name, value := range in {
+		dt, err := schema.GetPropertyDataType(class, name)
+		if err != nil {
+			return nil, err
+		}
+
+		parsed, err := parseValue(*dt, value)
+		if err != nil {
+			return nil, fmt.Errorf("property '%s': %v", name, err)
+		}
+
+		out[name] = parsed
+	}
+
+	return out, nil
+}
+
+func parseValue(dt schema.DataType, value interface{}) (interface{}, error) {
+	switch dt {
+	case schema.DataTypeCRef:
+		var refs models.MultipleRef
+		return refs, remarshal(value, &refs)
+	case schema.DataTypeGeoCoordinates:
+		var geo models.GeoCoordinates
+		return &geo, remarshal(value, &geo)
+	case schema.DataTypePhoneNumber:
+		var phone models.PhoneNumber
+		return &phone, remarshal(value, &phone)
+	case schema.DataTypeDate:
+		asString, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("date must be a string, but got %T", value)
+		}
+		return time.Parse(time.RFC3339, asString)
+	default:
+		// strings, texts, numbers, ints and booleans already have their types
+		return value, nil
+	}
+}
+
+func remarshal(in interface{}, out interface{}) error {
+	bytes, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(bytes, out)
+}
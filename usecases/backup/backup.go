@@ -0,0 +1,330 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	uuid "github.com/satori/go.uuid"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+)
+
+// batchSize is the number of objects read from the repo at once, the
+// progress is persisted after every batch
+const batchSize = 500
+
+// Backup starts a backup of the classes selected by include or exclude. The
+// schema can't be changed until the backup is completed, so that the
+// archived schema matches the archived objects.
+func (m *Manager) Backup(ctx context.Context, principal *models.Principal,
+	params models.Backup) (*models.Backup, error) {
+	err := m.authorizer.Authorize(principal, "create", "backups/*")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.validateConfigured(); err != nil {
+		return nil, err
+	}
+
+	if err := m.assignID(&params); err != nil {
+		return nil, err
+	}
+
+	unlock, err := m.locks.LockConnector()
+	if err != nil {
+		return nil, fmt.Errorf("could not acquire lock: %v", err)
+	}
+
+	backupSchema, err := m.prepareBackup(&params)
+	if err != nil {
+		m.unlock(unlock)
+		return nil, err
+	}
+
+	go m.runBackup(params, backupSchema, unlock)
+
+	return &params, nil
+}
+
+func (m *Manager) assignID(params *models.Backup) error {
+	if params.ID == "" {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return fmt.Errorf("backup: assign id: %v", err)
+		}
+
+		params.ID = id.String()
+		return nil
+	}
+
+	if !validID.MatchString(params.ID) {
+		return NewErrInvalidUserInput("invalid backup id '%s', it can only "+
+			"contain letters, digits, '-' and '_'", params.ID)
+	}
+
+	return nil
+}
+
+func (m *Manager) prepareBackup(params *models.Backup) (schema.Schema, error) {
+	existing, err := m.readBackup(params.ID)
+	if err != nil {
+		return schema.Schema{}, err
+	}
+
+	if existing != nil {
+		return schema.Schema{}, NewErrInvalidUserInput("backup '%s' already exists",
+			params.ID)
+	}
+
+	backupSchema, err := filterSchema(m.schemaManager.GetSchemaSkipAuth(),
+		params.Include, params.Exclude)
+	if err != nil {
+		return schema.Schema{}, err
+	}
+
+	if err := validateReferences(backupSchema, schema.Schema{}); err != nil {
+		return schema.Schema{}, err
+	}
+
+	params.Classes = classNames(backupSchema)
+	params.Version = archiveVersion
+	params.Status = models.BackupStatusRunning
+	params.Meta = &models.BackupMeta{
+		Started: strfmt.DateTime(time.Now()),
+	}
+
+	if err := m.writeJSON(params.ID, fileBackup, params); err != nil {
+		return schema.Schema{}, fmt.Errorf("backup: write status: %v", err)
+	}
+
+	return backupSchema, nil
+}
+
+// Get the status of the backup, nil if it does not exist
+func (m *Manager) Get(ctx context.Context, principal *models.Principal,
+	id string) (*models.Backup, error) {
+	err := m.authorizer.Authorize(principal, "get", fmt.Sprintf("backups/%s", id))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.validateConfigured(); err != nil {
+		return nil, err
+	}
+
+	if !validID.MatchString(id) {
+		return nil, nil
+	}
+
+	return m.readBackup(id)
+}
+
+func (m *Manager) readBackup(id string) (*models.Backup, error) {
+	var backup models.Backup
+	ok, err := m.readJSON(id, fileBackup, &backup)
+	if err != nil {
+		return nil, fmt.Errorf("read backup '%s': %v", id, err)
+	}
+
+	if !ok {
+		return nil, nil
+	}
+
+	return &backup, nil
+}
+
+func (m *Manager) runBackup(backup models.Backup, backupSchema schema.Schema,
+	unlock func() error) {
+	defer m.unlock(unlock)
+	ctx := context.Background()
+
+	if err := m.writeJSON(backup.ID, fileSchema, backupSchema); err != nil {
+		m.failBackup(backup, fmt.Errorf("write schema: %v", err))
+		return
+	}
+
+	if err := m.backupClassifications(ctx, backup); err != nil {
+		m.failBackup(backup, fmt.Errorf("write classifications: %v", err))
+		return
+	}
+
+	if err := m.backupObjects(ctx, &backup); err != nil {
+		m.failBackup(backup, fmt.Errorf("write objects: %v", err))
+		return
+	}
+
+	backup.Status = models.BackupStatusCompleted
+	backup.Meta.Completed = strfmt.DateTime(time.Now())
+	if err := m.writeJSON(backup.ID, fileBackup, backup); err != nil {
+		m.failBackup(backup, fmt.Errorf("write status: %v", err))
+	}
+}
+
+func (m *Manager) backupClassifications(ctx context.Context, backup models.Backup) error {
+	all, err := m.classifications.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	selected := []models.Classification{}
+	for _, classification := range all {
+		if contains(backup.Classes, classification.Class) {
+			selected = append(selected, classification)
+		}
+	}
+
+	return m.writeJSON(backup.ID, fileClassifications, selected)
+}
+
+func (m *Manager) backupObjects(ctx context.Context, backup *models.Backup) error {
+	w, err := m.store.Writer(backup.ID, fileObjects)
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(w)
+	for _, k := range []kind.Kind{kind.Thing, kind.Action} {
+		if err := m.backupObjectsOfKind(ctx, k, backup, enc); err != nil {
+			w.Close()
+			return fmt.Errorf("%ss: %v", k.Name(), err)
+		}
+	}
+
+	return w.Close()
+}
+
+// backupObjectsOfKind pages through all objects of the kind in the order of
+// their ids, objects of classes which are not part of the backup are skipped
+func (m *Manager) backupObjectsOfKind(ctx context.Context, k kind.Kind,
+	backup *models.Backup, enc *json.Encoder) error {
+	search := m.vectorRepo.ThingSearch
+	if k == kind.Action {
+		search = m.vectorRepo.ActionSearch
+	}
+
+	var after strfmt.UUID
+	for {
+		res, err := search(ctx, &filters.Pagination{Limit: batchSize, After: after},
+			nil, nil, true)
+		if err != nil {
+			return err
+		}
+
+		for _, obj := range res {
+			if !contains(backup.Classes, obj.ClassName) {
+				continue
+			}
+
+			if err := enc.Encode(recordFromResult(k, obj)); err != nil {
+				return err
+			}
+			backup.Meta.Count++
+		}
+
+		if len(res) < batchSize {
+			return nil
+		}
+
+		after = res[len(res)-1].ID
+		if err := m.writeJSON(backup.ID, fileBackup, backup); err != nil {
+			return fmt.Errorf("write status: %v", err)
+		}
+	}
+}
+
+func recordFromResult(k kind.Kind, res search.Result) objectRecord {
+	var props map[string]interface{}
+	if res.Schema != nil {
+		props = res.Schema.(map[string]interface{})
+		delete(props, "uuid")
+	}
+
+	meta := res.Meta
+	if meta != nil {
+		// the vector is already part of the record
+		withoutVector := *meta
+		withoutVector.Vector = nil
+		meta = &withoutVector
+		if meta.Classification == nil {
+			meta = nil
+		}
+	}
+
+	return objectRecord{
+		Kind:          k,
+		Class:         res.ClassName,
+		ID:            res.ID,
+		Schema:        props,
+		Meta:          meta,
+		VectorWeights: res.VectorWeights,
+		Vector:        res.Vector,
+		Created:       res.Created,
+		Updated:       res.Updated,
+	}
+}
+
+func (m *Manager) failBackup(backup models.Backup, err error) {
+	backup.Status = models.BackupStatusFailed
+	backup.Error = err.Error()
+	backup.Meta.Completed = strfmt.DateTime(time.Now())
+	if err := m.writeJSON(backup.ID, fileBackup, backup); err != nil {
+		m.logger.WithField("action", "backup").WithField("backup", backup.ID).
+			WithError(err).Error("could not persist failed backup")
+	}
+}
+
+func (m *Manager) unlock(unlock func() error) {
+	if err := unlock(); err != nil {
+		m.logger.WithField("action", "backup").WithError(err).
+			Error("could not release lock")
+	}
+}
+
+func (m *Manager) writeJSON(id, file string, value interface{}) error {
+	w, err := m.store.Writer(id, file)
+	if err != nil {
+		return err
+	}
+
+	if err := json.NewEncoder(w).Encode(value); err != nil {
+		w.Close()
+		return err
+	}
+
+	return w.Close()
+}
+
+// readJSON is false if the file does not exist
+func (m *Manager) readJSON(id, file string, value interface{}) (bool, error) {
+	r, err := m.store.Reader(id, file)
+	if err != nil {
+		return false, err
+	}
+
+	if r == nil {
+		return false, nil
+	}
+	defer r.Close()
+
+	return true, json.NewDecoder(r).Decode(value)
+}
@@ -0,0 +1,129 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package backup
+
+import (
+	"strings"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+)
+
+// filterSchema to the classes selected by include or exclude, if neither is
+// set all classes are selected. Classes in either list must be part of the
+// schema.
+func filterSchema(s schema.Schema, include, exclude []string) (schema.Schema, error) {
+	if len(include) > 0 && len(exclude) > 0 {
+		return schema.Schema{}, NewErrInvalidUserInput(
+			"include and exclude cannot be set at the same time")
+	}
+
+	for _, list := range [][]string{include, exclude} {
+		for _, className := range list {
+			if s.FindClassByName(schema.ClassName(className)) == nil {
+				return schema.Schema{}, NewErrInvalidUserInput(
+					"class '%s' does not exist", className)
+			}
+		}
+	}
+
+	selected := func(className string) bool {
+		if len(include) > 0 {
+			return contains(include, className)
+		}
+
+		return !contains(exclude, className)
+	}
+
+	return schema.Schema{
+		Things:  filterClasses(s.Things, selected),
+		Actions: filterClasses(s.Actions, selected),
+	}, nil
+}
+
+func filterClasses(in *models.Schema, selected func(string) bool) *models.Schema {
+	out := &models.Schema{Classes: []*models.Class{}}
+	if in == nil {
+		return out
+	}
+
+	*out = *in
+	out.Classes = []*models.Class{}
+	for _, class := range in.Classes {
+		if selected(class.Class) {
+			out.Classes = append(out.Classes, class)
+		}
+	}
+
+	return out
+}
+
+// validateReferences makes sure every reference property of the selected
+// classes points to a class which is either selected as well or already
+// present in the existing schema, otherwise the selected classes could not be
+// added to an empty schema. References to other peers are not checked.
+func validateReferences(selected, existing schema.Schema) error {
+	for _, class := range classNames(selected) {
+		for _, prop := range selected.FindClassByName(schema.ClassName(class)).Properties {
+			for _, target := range prop.DataType {
+				if !isClassName(target) || strings.Contains(target, "/") {
+					continue
+				}
+
+				if selected.FindClassByName(schema.ClassName(target)) != nil ||
+					existing.FindClassByName(schema.ClassName(target)) != nil {
+					continue
+				}
+
+				return NewErrInvalidUserInput("class '%s' references class '%s' "+
+					"with property '%s', include class '%s' as well", class, target,
+					prop.Name, target)
+			}
+		}
+	}
+
+	return nil
+}
+
+// classNames of all things, then all actions
+func classNames(s schema.Schema) []string {
+	var out []string
+	for _, semanticSchema := range []*models.Schema{s.Things, s.Actions} {
+		if semanticSchema == nil {
+			continue
+		}
+
+		for _, class := range semanticSchema.Classes {
+			out = append(out, class.Class)
+		}
+	}
+
+	return out
+}
+
+// isClassName is true if the data type of a property is a reference, class
+// names are always capitalized, primitive data types never are
+func isClassName(dataType string) bool {
+	return len(dataType) > 0 && strings.ToUpper(dataType[:1]) == dataType[:1]
+}
+
+func contains(list []string, needle string) bool {
+	for _, item := range list {
+		if item == needle {
+			return true
+		}
+	}
+
+	return false
+}
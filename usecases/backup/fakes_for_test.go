@@ -0,0 +1,244 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"sync"
+
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+)
+
+type fakeStore struct {
+	sync.Mutex
+	files map[string][]byte
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{files: map[string][]byte{}}
+}
+
+func (s *fakeStore) Writer(id, file string) (io.WriteCloser, error) {
+	return &fakeFile{store: s, key: id + "/" + file}, nil
+}
+
+func (s *fakeStore) Reader(id, file string) (io.ReadCloser, error) {
+	s.Lock()
+	defer s.Unlock()
+	content, ok := s.files[id+"/"+file]
+	if !ok {
+		return nil, nil
+	}
+
+	return ioutil.NopCloser(bytes.NewReader(content)), nil
+}
+
+type fakeFile struct {
+	bytes.Buffer
+	store *fakeStore
+	key   string
+}
+
+func (f *fakeFile) Close() error {
+	f.store.Lock()
+	defer f.store.Unlock()
+	f.store.files[f.key] = f.Bytes()
+	return nil
+}
+
+type fakeSchemaManager struct {
+	sync.Mutex
+	schema schema.Schema
+}
+
+func (f *fakeSchemaManager) GetSchemaSkipAuth() schema.Schema {
+	f.Lock()
+	defer f.Unlock()
+	return f.schema
+}
+
+func (f *fakeSchemaManager) AddThing(ctx context.Context, principal *models.Principal,
+	class *models.Class) error {
+	return f.addClass(f.schema.Things, class)
+}
+
+func (f *fakeSchemaManager) AddAction(ctx context.Context, principal *models.Principal,
+	class *models.Class) error {
+	return f.addClass(f.schema.Actions, class)
+}
+
+func (f *fakeSchemaManager) addClass(semanticSchema *models.Schema, class *models.Class) error {
+	f.Lock()
+	defer f.Unlock()
+	for _, prop := range class.Properties {
+		if err := f.validateDataType(prop); err != nil {
+			return err
+		}
+	}
+
+	semanticSchema.Classes = append(semanticSchema.Classes, class)
+	return nil
+}
+
+func (f *fakeSchemaManager) AddThingProperty(ctx context.Context, principal *models.Principal,
+	className string, prop *models.Property) error {
+	return f.addProperty(kind.Thing, className, prop)
+}
+
+func (f *fakeSchemaManager) AddActionProperty(ctx context.Context, principal *models.Principal,
+	className string, prop *models.Property) error {
+	return f.addProperty(kind.Action, className, prop)
+}
+
+func (f *fakeSchemaManager) addProperty(k kind.Kind, className string, prop *models.Property) error {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.validateDataType(prop); err != nil {
+		return err
+	}
+
+	class := f.schema.GetClass(k, schema.ClassName(className))
+	if class == nil {
+		return fmt.Errorf("class '%s' not found", className)
+	}
+
+	class.Properties = append(class.Properties, prop)
+	return nil
+}
+
+// validateDataType behaves like the schema manager which requires the
+// targets of references to exist
+func (f *fakeSchemaManager) validateDataType(prop *models.Property) error {
+	for _, dt := range prop.DataType {
+		if isClassName(dt) && f.schema.FindClassByName(schema.ClassName(dt)) == nil {
+			return fmt.Errorf("property '%s': class '%s' not found", prop.Name, dt)
+		}
+	}
+
+	return nil
+}
+
+type fakeVectorRepo struct {
+	sync.Mutex
+	things  []search.Result
+	actions []search.Result
+
+	putThings  []*models.Thing
+	putActions []*models.Action
+	putVectors map[string][]float32
+}
+
+func (f *fakeVectorRepo) ThingSearch(ctx context.Context, pagination *filters.Pagination,
+	sort []filters.Sort, filters *filters.LocalFilter, meta bool) (search.Results, error) {
+	return page(f.things, pagination), nil
+}
+
+func (f *fakeVectorRepo) ActionSearch(ctx context.Context, pagination *filters.Pagination,
+	sort []filters.Sort, filters *filters.LocalFilter, meta bool) (search.Results, error) {
+	return page(f.actions, pagination), nil
+}
+
+// page like the repos, ordered by id and starting after the cursor
+func page(in []search.Result, pagination *filters.Pagination) search.Results {
+	sorted := make([]search.Result, len(in))
+	copy(sorted, in)
+	sort.Slice(sorted, func(a, b int) bool { return sorted[a].ID < sorted[b].ID })
+
+	out := search.Results{}
+	for _, res := range sorted {
+		if res.ID <= pagination.After {
+			continue
+		}
+
+		if len(out) == pagination.Limit {
+			break
+		}
+
+		out = append(out, res)
+	}
+
+	return out
+}
+
+func (f *fakeVectorRepo) PutThing(ctx context.Context, thing *models.Thing, vector []float32) error {
+	f.Lock()
+	defer f.Unlock()
+	f.putThings = append(f.putThings, thing)
+	f.putVectors[thing.ID.String()] = vector
+	return nil
+}
+
+func (f *fakeVectorRepo) PutAction(ctx context.Context, action *models.Action, vector []float32) error {
+	f.Lock()
+	defer f.Unlock()
+	f.putActions = append(f.putActions, action)
+	f.putVectors[action.ID.String()] = vector
+	return nil
+}
+
+type fakeClassificationRepo struct {
+	sync.Mutex
+	classifications []models.Classification
+}
+
+func (f *fakeClassificationRepo) List(ctx context.Context) ([]models.Classification, error) {
+	f.Lock()
+	defer f.Unlock()
+	return f.classifications, nil
+}
+
+func (f *fakeClassificationRepo) Put(ctx context.Context, classification models.Classification) error {
+	f.Lock()
+	defer f.Unlock()
+	f.classifications = append(f.classifications, classification)
+	return nil
+}
+
+type fakeLocks struct {
+	sync.Mutex
+	locked bool
+}
+
+func (f *fakeLocks) LockConnector() (func() error, error) {
+	f.Lock()
+	defer f.Unlock()
+	f.locked = true
+	return func() error {
+		f.Lock()
+		defer f.Unlock()
+		f.locked = false
+		return nil
+	}, nil
+}
+
+func (f *fakeLocks) isLocked() bool {
+	f.Lock()
+	defer f.Unlock()
+	return f.locked
+}
+
+type fakeAuthorizer struct{}
+
+func (f *fakeAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	return nil
+}
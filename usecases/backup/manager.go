@@ -0,0 +1,109 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package backup
+
+import (
+	"context"
+	"io"
+	"regexp"
+
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/sirupsen/logrus"
+)
+
+// Manager writes backups of the schema, all objects with their vectors and
+// the classification records to a Store and restores them into an instance
+// which does not contain the backed up classes yet. Both run in the
+// background, their progress is persisted next to the backup.
+type Manager struct {
+	store           Store
+	schemaManager   schemaManager
+	vectorRepo      vectorRepo
+	classifications classificationRepo
+	locks           locks
+	authorizer      authorizer
+	logger          logrus.FieldLogger
+}
+
+// Store persists the files of backups, each backup has its own set of files
+// identified by the backup id
+type Store interface {
+	// Writer for the file of the backup. The file must only be visible to
+	// readers once the writer is closed.
+	Writer(id, file string) (io.WriteCloser, error)
+
+	// Reader for the file of the backup, nil if the file does not exist
+	Reader(id, file string) (io.ReadCloser, error)
+}
+
+type schemaManager interface {
+	GetSchemaSkipAuth() schema.Schema
+	AddThing(ctx context.Context, principal *models.Principal, class *models.Class) error
+	AddAction(ctx context.Context, principal *models.Principal, class *models.Class) error
+	AddThingProperty(ctx context.Context, principal *models.Principal,
+		class string, property *models.Property) error
+	AddActionProperty(ctx context.Context, principal *models.Principal,
+		class string, property *models.Property) error
+}
+
+type vectorRepo interface {
+	ThingSearch(ctx context.Context, pagination *filters.Pagination,
+		sort []filters.Sort, filters *filters.LocalFilter, meta bool) (search.Results, error)
+	ActionSearch(ctx context.Context, pagination *filters.Pagination,
+		sort []filters.Sort, filters *filters.LocalFilter, meta bool) (search.Results, error)
+	PutThing(ctx context.Context, thing *models.Thing, vector []float32) error
+	PutAction(ctx context.Context, action *models.Action, vector []float32) error
+}
+
+type classificationRepo interface {
+	List(ctx context.Context) ([]models.Classification, error)
+	Put(ctx context.Context, classification models.Classification) error
+}
+
+type locks interface {
+	LockConnector() (func() error, error)
+}
+
+type authorizer interface {
+	Authorize(principal *models.Principal, verb, resource string) error
+}
+
+// New backup manager. Without a store backups are not configured and every
+// request is rejected.
+func New(store Store, sm schemaManager, vr vectorRepo, cr classificationRepo,
+	locks locks, authorizer authorizer, logger logrus.FieldLogger) *Manager {
+	return &Manager{
+		store:           store,
+		schemaManager:   sm,
+		vectorRepo:      vr,
+		classifications: cr,
+		locks:           locks,
+		authorizer:      authorizer,
+		logger:          logger,
+	}
+}
+
+var validID = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+func (m *Manager) validateConfigured() error {
+	if m.store == nil {
+		return NewErrInvalidUserInput("backups are not configured, " +
+			"set 'backup.path' in the config to enable them")
+	}
+
+	return nil
+}
@@ -0,0 +1,373 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package backup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackupAndRestore(t *testing.T) {
+	store := newFakeStore()
+	source, sourceLocks := newTestManager(store, testSchema())
+	source.vectorRepo.(*fakeVectorRepo).things = testThings()
+	source.vectorRepo.(*fakeVectorRepo).actions = testActions()
+	source.classifications.(*fakeClassificationRepo).classifications = []models.Classification{
+		{ID: "a4b1b4d2-2d9a-4a3b-9e4f-8e6a4c5bb1a1", Class: "City"},
+		{ID: "c3d2a1b0-6f5e-4d3c-8b2a-1f0e9d8c7b6a", Class: "Visit"},
+	}
+
+	t.Run("backing up all things except visits", func(t *testing.T) {
+		res, err := source.Backup(context.Background(), nil, models.Backup{
+			ID:      "my-backup",
+			Exclude: []string{"Visit"},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, models.BackupStatusRunning, res.Status)
+		assert.Equal(t, []string{"City", "Country"}, res.Classes)
+
+		backup := waitForBackup(t, source, "my-backup")
+		assert.Equal(t, models.BackupStatusCompleted, backup.Status, backup.Error)
+		assert.Equal(t, int64(archiveVersion), backup.Version)
+		assert.Equal(t, int64(3), backup.Meta.Count)
+		assert.False(t, sourceLocks.isLocked(), "lock must be released")
+	})
+
+	target, _ := newTestManager(store, emptySchema())
+	targetRepo := target.vectorRepo.(*fakeVectorRepo)
+
+	t.Run("restoring the backup into an empty instance", func(t *testing.T) {
+		res, err := target.Restore(context.Background(), nil, "my-backup",
+			models.BackupRestore{})
+		require.Nil(t, err)
+		assert.Equal(t, models.BackupRestoreStatusRunning, res.Status)
+
+		restore := waitForRestore(t, target, "my-backup")
+		assert.Equal(t, models.BackupRestoreStatusCompleted, restore.Status, restore.Error)
+		assert.Equal(t, int64(3), restore.Meta.Count)
+	})
+
+	t.Run("the schema is restored including references", func(t *testing.T) {
+		s := target.schemaManager.GetSchemaSkipAuth()
+		assert.Equal(t, []string{"City", "Country"}, classNames(s))
+
+		city := s.GetClass(kind.Thing, "City")
+		require.NotNil(t, city)
+		assert.Equal(t, models.ClassVectorizerNone, city.Vectorizer)
+		prop, err := schema.GetPropertyByName(city, "inCountry")
+		require.Nil(t, err)
+		assert.Equal(t, []string{"Country"}, prop.DataType)
+	})
+
+	t.Run("the objects are restored with their vectors and types", func(t *testing.T) {
+		require.Len(t, targetRepo.putThings, 3)
+		assert.Len(t, targetRepo.putActions, 0)
+
+		amsterdam := targetRepo.putThings[0]
+		assert.Equal(t, strfmt.UUID("1f2b7a38-0000-0000-0000-000000000001"), amsterdam.ID)
+		assert.Equal(t, "City", amsterdam.Class)
+		assert.Equal(t, int64(1000), amsterdam.CreationTimeUnix)
+		assert.Equal(t, map[string]string{"amsterdam": "1"}, amsterdam.VectorWeights)
+		assert.Equal(t, []float32{1, 2, 3}, targetRepo.putVectors[amsterdam.ID.String()])
+
+		props := amsterdam.Schema.(map[string]interface{})
+		assert.Equal(t, "Amsterdam", props["name"])
+		assert.Equal(t, 872680.0, props["population"])
+		assert.Equal(t, &models.GeoCoordinates{Latitude: 52.37, Longitude: 4.89},
+			props["location"])
+		assert.Equal(t, time.Date(1275, 10, 27, 0, 0, 0, 0, time.UTC), props["founded"])
+		assert.Equal(t, models.MultipleRef{
+			&models.SingleRef{Beacon: "weaviate://localhost/things/1f2b7a38-0000-0000-0000-000000000003"},
+		}, props["inCountry"])
+
+		rotterdam := targetRepo.putThings[1]
+		assert.Nil(t, rotterdam.VectorWeights)
+		require.NotNil(t, rotterdam.Meta)
+		assert.Nil(t, rotterdam.Meta.Vector, "vector is only stored once")
+		assert.Equal(t, strfmt.UUID("a4b1b4d2-2d9a-4a3b-9e4f-8e6a4c5bb1a1"),
+			rotterdam.Meta.Classification.ID)
+	})
+
+	t.Run("only classifications of restored classes are restored", func(t *testing.T) {
+		classifications := target.classifications.(*fakeClassificationRepo).classifications
+		require.Len(t, classifications, 1)
+		assert.Equal(t, "City", classifications[0].Class)
+	})
+
+	t.Run("restoring again into the same instance", func(t *testing.T) {
+		_, err := target.Restore(context.Background(), nil, "my-backup",
+			models.BackupRestore{})
+		assert.Equal(t, NewErrInvalidUserInput("class 'City' is already present "+
+			"in the schema, delete it or exclude it from the restore"), err)
+	})
+}
+
+func TestBackupValidation(t *testing.T) {
+	type test struct {
+		name          string
+		params        models.Backup
+		expectedError error
+	}
+
+	tests := []test{
+		{
+			name:   "include and exclude",
+			params: models.Backup{Include: []string{"City"}, Exclude: []string{"Visit"}},
+			expectedError: NewErrInvalidUserInput(
+				"include and exclude cannot be set at the same time"),
+		},
+		{
+			name:          "an unknown class",
+			params:        models.Backup{Include: []string{"Planet"}},
+			expectedError: NewErrInvalidUserInput("class 'Planet' does not exist"),
+		},
+		{
+			name:   "a class whose reference target is excluded",
+			params: models.Backup{Exclude: []string{"Country"}},
+			expectedError: NewErrInvalidUserInput("class 'City' references class " +
+				"'Country' with property 'inCountry', include class 'Country' as well"),
+		},
+		{
+			name:   "an invalid id",
+			params: models.Backup{ID: "../other"},
+			expectedError: NewErrInvalidUserInput("invalid backup id '../other', it " +
+				"can only contain letters, digits, '-' and '_'"),
+		},
+		{
+			name:          "an id which is already in use",
+			params:        models.Backup{ID: "existing"},
+			expectedError: NewErrInvalidUserInput("backup 'existing' already exists"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			store := newFakeStore()
+			store.files["existing/"+fileBackup] = []byte(`{"id":"existing"}`)
+			m, locks := newTestManager(store, testSchema())
+
+			_, err := m.Backup(context.Background(), nil, test.params)
+			assert.Equal(t, test.expectedError, err)
+			assert.False(t, locks.isLocked(), "lock must be released")
+		})
+	}
+
+	t.Run("without a configured store", func(t *testing.T) {
+		m, _ := newTestManager(nil, testSchema())
+		m.store = nil
+
+		_, err := m.Backup(context.Background(), nil, models.Backup{})
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+	})
+}
+
+func TestRestoreValidation(t *testing.T) {
+	t.Run("a backup which does not exist", func(t *testing.T) {
+		m, _ := newTestManager(newFakeStore(), emptySchema())
+
+		_, err := m.Restore(context.Background(), nil, "missing", models.BackupRestore{})
+		assert.Equal(t, NewErrNotFound("backup 'missing' does not exist"), err)
+	})
+
+	t.Run("a backup which did not complete", func(t *testing.T) {
+		store := newFakeStore()
+		store.files["failed/"+fileBackup] = []byte(`{"id":"failed","status":"failed","version":1}`)
+		m, _ := newTestManager(store, emptySchema())
+
+		_, err := m.Restore(context.Background(), nil, "failed", models.BackupRestore{})
+		assert.Equal(t, NewErrInvalidUserInput("backup 'failed' is failed, only "+
+			"completed backups can be restored"), err)
+	})
+
+	t.Run("a backup of a newer archive version", func(t *testing.T) {
+		store := newFakeStore()
+		store.files["future/"+fileBackup] = []byte(`{"id":"future","status":"completed","version":2}`)
+		m, _ := newTestManager(store, emptySchema())
+
+		_, err := m.Restore(context.Background(), nil, "future", models.BackupRestore{})
+		assert.Equal(t, NewErrInvalidUserInput("backup 'future' has archive version "+
+			"2, but this version of weaviate can only restore archives up to version 1"), err)
+	})
+
+	t.Run("restoring a class without the target of its references", func(t *testing.T) {
+		store := newFakeStore()
+		source, _ := newTestManager(store, testSchema())
+		_, err := source.Backup(context.Background(), nil, models.Backup{ID: "all"})
+		require.Nil(t, err)
+		waitForBackup(t, source, "all")
+
+		m, _ := newTestManager(store, emptySchema())
+		_, err = m.Restore(context.Background(), nil, "all",
+			models.BackupRestore{Include: []string{"City"}})
+		assert.Equal(t, NewErrInvalidUserInput("class 'City' references class "+
+			"'Country' with property 'inCountry', include class 'Country' as well"), err)
+	})
+}
+
+func newTestManager(store Store, s schema.Schema) (*Manager, *fakeLocks) {
+	logger, _ := test.NewNullLogger()
+	locks := &fakeLocks{}
+	return New(store, &fakeSchemaManager{schema: s},
+		&fakeVectorRepo{putVectors: map[string][]float32{}},
+		&fakeClassificationRepo{}, locks, &fakeAuthorizer{}, logger), locks
+}
+
+func waitForBackup(t *testing.T, m *Manager, id string) *models.Backup {
+	for i := 0; i < 100; i++ {
+		backup, err := m.Get(context.Background(), nil, id)
+		require.Nil(t, err)
+		if backup != nil && backup.Status != models.BackupStatusRunning {
+			return backup
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	t.Fatalf("backup '%s' did not finish", id)
+	return nil
+}
+
+func waitForRestore(t *testing.T, m *Manager, id string) *models.BackupRestore {
+	for i := 0; i < 100; i++ {
+		restore, err := m.GetRestore(context.Background(), nil, id)
+		require.Nil(t, err)
+		if restore != nil && restore.Status != models.BackupRestoreStatusRunning {
+			return restore
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	t.Fatalf("restore of backup '%s' did not finish", id)
+	return nil
+}
+
+func emptySchema() schema.Schema {
+	return schema.Schema{
+		Things:  &models.Schema{Classes: []*models.Class{}},
+		Actions: &models.Schema{Classes: []*models.Class{}},
+	}
+}
+
+func testSchema() schema.Schema {
+	return schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class:      "City",
+					Vectorizer: models.ClassVectorizerNone,
+					Properties: []*models.Property{
+						{Name: "name", DataType: []string{"string"}},
+						{Name: "population", DataType: []string{"int"}},
+						{Name: "location", DataType: []string{"geoCoordinates"}},
+						{Name: "founded", DataType: []string{"date"}},
+						{Name: "inCountry", DataType: []string{"Country"}},
+					},
+				},
+				{
+					Class: "Country",
+					Properties: []*models.Property{
+						{Name: "name", DataType: []string{"string"}},
+						{Name: "capital", DataType: []string{"City"}},
+					},
+				},
+			},
+		},
+		Actions: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class: "Visit",
+					Properties: []*models.Property{
+						{Name: "visitor", DataType: []string{"string"}},
+					},
+				},
+			},
+		},
+	}
+}
+
+// testThings as the repos return them, with the reference and geo
+// properties typed, but dates as strings
+func testThings() []search.Result {
+	return []search.Result{
+		{
+			ID:        "1f2b7a38-0000-0000-0000-000000000002",
+			Kind:      kind.Thing,
+			ClassName: "City",
+			Vector:    []float32{4, 5, 6},
+			Schema: map[string]interface{}{
+				"uuid": "1f2b7a38-0000-0000-0000-000000000002",
+				"name": "Rotterdam",
+			},
+			Meta: &models.ObjectMeta{
+				Vector: []float32{4, 5, 6},
+				Classification: &models.ObjectMetaClassification{
+					ID: "a4b1b4d2-2d9a-4a3b-9e4f-8e6a4c5bb1a1",
+				},
+			},
+		},
+		{
+			ID:        "1f2b7a38-0000-0000-0000-000000000001",
+			Kind:      kind.Thing,
+			ClassName: "City",
+			Vector:    []float32{1, 2, 3},
+			Created:   1000,
+			Updated:   2000,
+			Schema: map[string]interface{}{
+				"uuid":       "1f2b7a38-0000-0000-0000-000000000001",
+				"name":       "Amsterdam",
+				"population": 872680.0,
+				"location":   &models.GeoCoordinates{Latitude: 52.37, Longitude: 4.89},
+				"founded":    "1275-10-27T00:00:00Z",
+				"inCountry": models.MultipleRef{
+					&models.SingleRef{Beacon: "weaviate://localhost/things/1f2b7a38-0000-0000-0000-000000000003"},
+				},
+			},
+			VectorWeights: map[string]string{"amsterdam": "1"},
+			Meta:          &models.ObjectMeta{Vector: []float32{1, 2, 3}},
+		},
+		{
+			ID:        "1f2b7a38-0000-0000-0000-000000000003",
+			Kind:      kind.Thing,
+			ClassName: "Country",
+			Vector:    []float32{7, 8, 9},
+			Schema: map[string]interface{}{
+				"uuid": "1f2b7a38-0000-0000-0000-000000000003",
+				"name": "Netherlands",
+			},
+		},
+	}
+}
+
+func testActions() []search.Result {
+	return []search.Result{
+		{
+			ID:        "1f2b7a38-0000-0000-0000-000000000004",
+			Kind:      kind.Action,
+			ClassName: "Visit",
+			Vector:    []float32{1, 1, 1},
+			Schema: map[string]interface{}{
+				"visitor": "John",
+			},
+		},
+	}
+}
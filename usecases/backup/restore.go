@@ -0,0 +1,339 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+)
+
+// Restore starts the restore of the classes of a completed backup which are
+// selected by include or exclude. None of them may be present in the schema
+// yet.
+func (m *Manager) Restore(ctx context.Context, principal *models.Principal,
+	id string, params models.BackupRestore) (*models.BackupRestore, error) {
+	err := m.authorizer.Authorize(principal, "create", fmt.Sprintf("backups/%s/restore", id))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.validateConfigured(); err != nil {
+		return nil, err
+	}
+
+	restoreSchema, err := m.prepareRestore(id, &params)
+	if err != nil {
+		return nil, err
+	}
+
+	go m.runRestore(principal, params, restoreSchema)
+
+	return &params, nil
+}
+
+func (m *Manager) prepareRestore(id string,
+	params *models.BackupRestore) (schema.Schema, error) {
+	if !validID.MatchString(id) {
+		return schema.Schema{}, NewErrNotFound("backup '%s' does not exist", id)
+	}
+
+	backup, err := m.readBackup(id)
+	if err != nil {
+		return schema.Schema{}, err
+	}
+
+	if backup == nil {
+		return schema.Schema{}, NewErrNotFound("backup '%s' does not exist", id)
+	}
+
+	if err := validateRestorable(backup); err != nil {
+		return schema.Schema{}, err
+	}
+
+	previous, err := m.readRestore(id)
+	if err != nil {
+		return schema.Schema{}, err
+	}
+
+	if previous != nil && previous.Status == models.BackupRestoreStatusRunning {
+		return schema.Schema{}, NewErrInvalidUserInput(
+			"backup '%s' is already being restored", id)
+	}
+
+	var archived schema.Schema
+	if _, err := m.readJSON(id, fileSchema, &archived); err != nil {
+		return schema.Schema{}, fmt.Errorf("read schema of backup '%s': %v", id, err)
+	}
+
+	restoreSchema, err := filterSchema(archived, params.Include, params.Exclude)
+	if err != nil {
+		return schema.Schema{}, err
+	}
+
+	existing := m.schemaManager.GetSchemaSkipAuth()
+	for _, className := range classNames(restoreSchema) {
+		if existing.FindClassByName(schema.ClassName(className)) != nil {
+			return schema.Schema{}, NewErrInvalidUserInput("class '%s' is already "+
+				"present in the schema, delete it or exclude it from the restore",
+				className)
+		}
+	}
+
+	if err := validateReferences(restoreSchema, existing); err != nil {
+		return schema.Schema{}, err
+	}
+
+	params.BackupID = id
+	params.Classes = classNames(restoreSchema)
+	params.Status = models.BackupRestoreStatusRunning
+	params.Meta = &models.BackupMeta{
+		Started: strfmt.DateTime(time.Now()),
+	}
+
+	if err := m.writeJSON(id, fileRestore, params); err != nil {
+		return schema.Schema{}, fmt.Errorf("restore: write status: %v", err)
+	}
+
+	return restoreSchema, nil
+}
+
+func validateRestorable(backup *models.Backup) error {
+	if backup.Status != models.BackupStatusCompleted {
+		return NewErrInvalidUserInput("backup '%s' is %s, only completed backups "+
+			"can be restored", backup.ID, backup.Status)
+	}
+
+	if backup.Version > archiveVersion {
+		return NewErrInvalidUserInput("backup '%s' has archive version %d, but "+
+			"this version of weaviate can only restore archives up to version %d",
+			backup.ID, backup.Version, archiveVersion)
+	}
+
+	return nil
+}
+
+// GetRestore returns the status of the restore of the backup, nil if the
+// backup was never restored
+func (m *Manager) GetRestore(ctx context.Context, principal *models.Principal,
+	id string) (*models.BackupRestore, error) {
+	err := m.authorizer.Authorize(principal, "get", fmt.Sprintf("backups/%s/restore", id))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.validateConfigured(); err != nil {
+		return nil, err
+	}
+
+	if !validID.MatchString(id) {
+		return nil, nil
+	}
+
+	return m.readRestore(id)
+}
+
+func (m *Manager) readRestore(id string) (*models.BackupRestore, error) {
+	var restore models.BackupRestore
+	ok, err := m.readJSON(id, fileRestore, &restore)
+	if err != nil {
+		return nil, fmt.Errorf("read restore of backup '%s': %v", id, err)
+	}
+
+	if !ok {
+		return nil, nil
+	}
+
+	return &restore, nil
+}
+
+func (m *Manager) runRestore(principal *models.Principal,
+	restore models.BackupRestore, restoreSchema schema.Schema) {
+	ctx := context.Background()
+
+	if err := m.restoreSchema(ctx, principal, restoreSchema); err != nil {
+		m.failRestore(restore, fmt.Errorf("restore schema: %v", err))
+		return
+	}
+
+	if err := m.restoreClassifications(ctx, restore); err != nil {
+		m.failRestore(restore, fmt.Errorf("restore classifications: %v", err))
+		return
+	}
+
+	if err := m.restoreObjects(ctx, &restore, restoreSchema); err != nil {
+		m.failRestore(restore, fmt.Errorf("restore objects: %v", err))
+		return
+	}
+
+	restore.Status = models.BackupRestoreStatusCompleted
+	restore.Meta.Completed = strfmt.DateTime(time.Now())
+	if err := m.writeJSON(restore.BackupID, fileRestore, restore); err != nil {
+		m.failRestore(restore, fmt.Errorf("write status: %v", err))
+	}
+}
+
+// restoreSchema adds all classes without their reference properties first,
+// as a reference property can only be added once its target classes exist.
+// The reference properties are added in a second step.
+func (m *Manager) restoreSchema(ctx context.Context, principal *models.Principal,
+	restoreSchema schema.Schema) error {
+	addClass := map[kind.Kind]func(context.Context, *models.Principal, *models.Class) error{
+		kind.Thing:  m.schemaManager.AddThing,
+		kind.Action: m.schemaManager.AddAction,
+	}
+	addProperty := map[kind.Kind]func(context.Context, *models.Principal, string, *models.Property) error{
+		kind.Thing:  m.schemaManager.AddThingProperty,
+		kind.Action: m.schemaManager.AddActionProperty,
+	}
+
+	for _, k := range []kind.Kind{kind.Thing, kind.Action} {
+		for _, class := range restoreSchema.SemanticSchemaFor(k).Classes {
+			withoutRefs := *class
+			withoutRefs.Properties = nil
+			for _, prop := range class.Properties {
+				if !isReference(prop) {
+					withoutRefs.Properties = append(withoutRefs.Properties, prop)
+				}
+			}
+
+			if err := addClass[k](ctx, principal, &withoutRefs); err != nil {
+				return fmt.Errorf("class '%s': %v", class.Class, err)
+			}
+		}
+	}
+
+	for _, k := range []kind.Kind{kind.Thing, kind.Action} {
+		for _, class := range restoreSchema.SemanticSchemaFor(k).Classes {
+			for _, prop := range class.Properties {
+				if !isReference(prop) {
+					continue
+				}
+
+				if err := addProperty[k](ctx, principal, class.Class, prop); err != nil {
+					return fmt.Errorf("class '%s': property '%s': %v", class.Class,
+						prop.Name, err)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func isReference(prop *models.Property) bool {
+	return len(prop.DataType) > 0 && isClassName(prop.DataType[0])
+}
+
+func (m *Manager) restoreClassifications(ctx context.Context,
+	restore models.BackupRestore) error {
+	var classifications []models.Classification
+	if _, err := m.readJSON(restore.BackupID, fileClassifications,
+		&classifications); err != nil {
+		return err
+	}
+
+	for _, classification := range classifications {
+		if !contains(restore.Classes, classification.Class) {
+			continue
+		}
+
+		if err := m.classifications.Put(ctx, classification); err != nil {
+			return fmt.Errorf("classification '%s': %v", classification.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func (m *Manager) restoreObjects(ctx context.Context, restore *models.BackupRestore,
+	restoreSchema schema.Schema) error {
+	r, err := m.store.Reader(restore.BackupID, fileObjects)
+	if err != nil {
+		return err
+	}
+
+	if r == nil {
+		return fmt.Errorf("backup has no objects file")
+	}
+	defer r.Close()
+
+	dec := json.NewDecoder(r)
+	for {
+		var record objectRecord
+		err := dec.Decode(&record)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if !contains(restore.Classes, record.Class) {
+			continue
+		}
+
+		if err := m.restoreObject(ctx, record, restoreSchema); err != nil {
+			return fmt.Errorf("%s '%s': %v", record.Kind.Name(), record.ID, err)
+		}
+
+		restore.Meta.Count++
+		if restore.Meta.Count%batchSize == 0 {
+			if err := m.writeJSON(restore.BackupID, fileRestore, restore); err != nil {
+				return fmt.Errorf("write status: %v", err)
+			}
+		}
+	}
+}
+
+func (m *Manager) restoreObject(ctx context.Context, record objectRecord,
+	restoreSchema schema.Schema) error {
+	class := restoreSchema.GetClass(record.Kind, schema.ClassName(record.Class))
+	if class == nil {
+		return fmt.Errorf("class '%s' is not part of the schema of the backup",
+			record.Class)
+	}
+
+	props, err := parseSchema(class, record.Schema)
+	if err != nil {
+		return err
+	}
+	record.Schema = props
+
+	switch record.Kind {
+	case kind.Thing:
+		return m.vectorRepo.PutThing(ctx, record.thing(), record.Vector)
+	case kind.Action:
+		return m.vectorRepo.PutAction(ctx, record.action(), record.Vector)
+	default:
+		return fmt.Errorf("impossible kind: %v", record.Kind)
+	}
+}
+
+func (m *Manager) failRestore(restore models.BackupRestore, err error) {
+	restore.Status = models.BackupRestoreStatusFailed
+	restore.Error = err.Error()
+	restore.Meta.Completed = strfmt.DateTime(time.Now())
+	if err := m.writeJSON(restore.BackupID, fileRestore, restore); err != nil {
+		m.logger.WithField("action", "backup_restore").WithField("backup", restore.BackupID).
+			WithError(err).Error("could not persist failed restore")
+	}
+}
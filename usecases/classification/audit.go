@@ -0,0 +1,44 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package classification
+
+import (
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/audit"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization"
+)
+
+type auditor interface {
+	Record(events ...audit.Event)
+}
+
+// SetAuditor enables recording an audit event for every scheduled or
+// cancelled classification
+func (c *Classifier) SetAuditor(a auditor) {
+	c.auditor = a
+}
+
+// audit the scheduling or cancellation of a classification, the id is the id
+// of the classification, as the classified objects are only known once it
+// completes
+func (c *Classifier) audit(principal *models.Principal, verb string,
+	params models.Classification, err error) {
+	if c.auditor == nil {
+		return
+	}
+
+	resource := authorization.ClassificationResource(params.Class)
+	c.auditor.Record(audit.NewEvent(principal, verb, resource, err).
+		WithObject(params.Class, params.ID))
+}
@@ -47,9 +47,9 @@ package classification
 // 		},
 // 		testCase{
 // 			methodName:       "Schedule",
-// 			additionalArgs:   []interface{}{models.Classification{}},
+// 			additionalArgs:   []interface{}{models.Classification{Class: "Foo"}},
 // 			expectedVerb:     "create",
-// 			expectedResource: "classifications/*",
+// 			expectedResource: "classifications/Foo",
 // 		},
 // 	}
 
@@ -16,6 +16,7 @@ package classification
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/go-openapi/strfmt"
@@ -26,6 +27,9 @@ import (
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/schema/kind"
 	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
 	schemaUC "github.com/semi-technologies/weaviate/usecases/schema"
 	"github.com/semi-technologies/weaviate/usecases/traverser"
 	libvectorizer "github.com/semi-technologies/weaviate/usecases/vectorizer"
@@ -39,45 +43,96 @@ type Classifier struct {
 	vectorRepo   vectorRepo
 	authorizer   authorizer
 	distancer    distancer
+	auditor      auditor
+
+	// contextionary provides the word vectors of contextual classifications
+	contextionary contextionary
+
+	// jobs holds the cancel funcs of the classifications running in this
+	// process
+	jobs     map[strfmt.UUID]context.CancelFunc
+	jobsLock sync.Mutex
+
+	// progressInterval is the minimum time between two progress updates which
+	// are persisted in the repo
+	progressInterval time.Duration
+
+	// workers classify the items of a page of pageSize unclassified items
+	// concurrently
+	workers  int
+	pageSize int
+
+	// maxPredictions limits the references listed by a dry run, which are
+	// stored as part of the classification
+	maxPredictions int
 }
 
 type authorizer interface {
 	Authorize(principal *models.Principal, verb, resource string) error
 }
 
-func New(sg schemaUC.SchemaGetter, cr Repo, vr vectorRepo, authorizer authorizer) *Classifier {
+type contextionary interface {
+	IsWordPresent(ctx context.Context, word string) (bool, error)
+	VectorForWord(ctx context.Context, word string) ([]float32, error)
+}
+
+func New(sg schemaUC.SchemaGetter, cr Repo, vr vectorRepo, authorizer authorizer,
+	c11y contextionary) *Classifier {
 	return &Classifier{
-		schemaGetter: sg,
-		repo:         cr,
-		vectorRepo:   vr,
-		authorizer:   authorizer,
-		distancer:    libvectorizer.NormalizedDistance,
+		schemaGetter:  sg,
+		repo:          cr,
+		vectorRepo:    vr,
+		authorizer:    authorizer,
+		contextionary: c11y,
+		distancer:     libvectorizer.NormalizedDistance,
+		jobs:          map[strfmt.UUID]context.CancelFunc{},
+
+		progressInterval: time.Second,
+		workers:          4,
+		pageSize:         100,
+		maxPredictions:   1000,
 	}
 }
 
+// SetRunConfig overrides the default number of workers, the page size and
+// the maximum number of predictions of classification runs
+func (c *Classifier) SetRunConfig(cfg config.Classification) {
+	c.workers = cfg.Workers
+	c.pageSize = cfg.PageSize
+	c.maxPredictions = cfg.MaxPredictions
+}
+
 // Repo to manage classification state, should be consistent, not used to store
 // acutal data object vectors, see VectorRepo
 type Repo interface {
 	Put(ctx context.Context, classification models.Classification) error
 	Get(ctx context.Context, id strfmt.UUID) (*models.Classification, error)
+	List(ctx context.Context) ([]models.Classification, error)
 }
 
 type VectorRepo interface {
 	GetUnclassified(ctx context.Context, kind kind.Kind, class string,
-		properties []string, filter *libfilters.LocalFilter) ([]search.Result, error)
+		properties []string, filter *libfilters.LocalFilter,
+		pagination *libfilters.Pagination) ([]search.Result, error)
+	CountUnclassified(ctx context.Context, kind kind.Kind, class string,
+		properties []string, filter *libfilters.LocalFilter) (int64, error)
 	AggregateNeighbors(ctx context.Context, vector []float32,
 		kind kind.Kind, class string, properties []string, k int,
 		filter *libfilters.LocalFilter) ([]NeighborRef, error)
 	VectorClassSearch(ctx context.Context, params traverser.GetParams) ([]search.Result, error)
+	GetClassified(ctx context.Context, kind kind.Kind, class string,
+		properties []string, filter *libfilters.LocalFilter,
+		pagination *libfilters.Pagination) ([]search.Result, error)
 }
 
 type vectorRepo interface {
 	VectorRepo
-	PutThing(ctx context.Context, thing *models.Thing, vector []float32) error
-	PutAction(ctx context.Context, action *models.Action, vector []float32) error
+	BatchPutThings(ctx context.Context, batch kinds.BatchThings) (kinds.BatchThings, error)
+	BatchPutActions(ctx context.Context, batch kinds.BatchActions) (kinds.BatchActions, error)
 }
 
-// NeighborRef is the result of an aggregation of the ref properties of k neighbors
+// NeighborRef is the result of an aggregation of the ref or primitive
+// properties of k neighbors
 type NeighborRef struct {
 	// Property indicates which property was aggregated
 	Property string
@@ -85,9 +140,17 @@ type NeighborRef struct {
 	// The beacon of the most common (kNN) reference
 	Beacon strfmt.URI
 
-	// Count (n<=k) of number of the winning Beacon
+	// Value is the most common value of a primitive property, Beacon is empty
+	// in this case. The value is untyped as it was stored, e.g. numbers might
+	// be float64.
+	Value interface{}
+
+	// Count (n<=k) of number of the winning Beacon or Value
 	Count int
 
+	// Total (n<=k) number of neighbors which had the property set
+	Total int
+
 	WinningDistance float32
 	LosingDistance  *float32
 }
@@ -98,8 +161,13 @@ type filters struct {
 	trainingSet *libfilters.LocalFilter
 }
 
-func (c *Classifier) Schedule(ctx context.Context, principal *models.Principal, params models.Classification) (*models.Classification, error) {
-	err := c.authorizer.Authorize(principal, "create", "classifications/*")
+func (c *Classifier) Schedule(ctx context.Context, principal *models.Principal, params models.Classification) (_ *models.Classification, err error) {
+	defer func() {
+		c.audit(principal, "create", params, err)
+	}()
+
+	err = c.authorizer.Authorize(principal, "create",
+		authorization.ClassificationResource(params.Class))
 	if err != nil {
 		return nil, err
 	}
@@ -131,7 +199,10 @@ func (c *Classifier) Schedule(ctx context.Context, principal *models.Principal,
 		return nil, err
 	}
 
-	go c.run(params, kind, filters)
+	runCtx, cancel := context.WithTimeout(context.Background(),
+		time.Duration(*params.Timeout)*time.Second)
+	c.startJob(params.ID, cancel)
+	go c.run(runCtx, params, kind, filters)
 
 	return &params, nil
 }
@@ -177,12 +248,25 @@ func (c *Classifier) assignNewID(params *models.Classification) error {
 }
 
 func (c *Classifier) Get(ctx context.Context, principal *models.Principal, id strfmt.UUID) (*models.Classification, error) {
-	err := c.authorizer.Authorize(principal, "get", "classifications/*")
+	classification, err := c.repo.Get(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
-	return c.repo.Get(ctx, id)
+	// an unknown classification is authorized against all classes, so that its
+	// absence does not reveal anything the principal could not see anyway
+	className := authorization.AllClasses
+	if classification != nil {
+		className = classification.Class
+	}
+
+	err = c.authorizer.Authorize(principal, "get",
+		authorization.ClassificationResource(className))
+	if err != nil {
+		return nil, err
+	}
+
+	return classification, nil
 }
 
 func (c *Classifier) setDefaultValuesForOptionalFields(params *models.Classification) {
@@ -191,6 +275,11 @@ func (c *Classifier) setDefaultValuesForOptionalFields(params *models.Classifica
 		params.Type = &defaultType
 	}
 
+	if params.Timeout == nil {
+		timeout := int64(defaultTimeout)
+		params.Timeout = &timeout
+	}
+
 	if *params.Type == "knn" {
 		c.setDefaultsForKNN(params)
 	}
@@ -206,8 +295,31 @@ func (c *Classifier) setDefaultsForKNN(params *models.Classification) {
 		defaultK := int32(3)
 		params.K = &defaultK
 	}
+
+	if params.Evaluate != nil && *params.Evaluate && params.EvaluationFolds == nil {
+		defaultFolds := int64(5)
+		params.EvaluationFolds = &defaultFolds
+	}
 }
 
 func (c *Classifier) setDefaultsForContextual(params *models.Classification) {
-	// none at the moment
+	if params.TfidfCutoffPercentile == nil {
+		defaultPercentile := int64(80)
+		params.TfidfCutoffPercentile = &defaultPercentile
+	}
+
+	if params.InformationGainCutoffPercentile == nil {
+		defaultPercentile := int64(50)
+		params.InformationGainCutoffPercentile = &defaultPercentile
+	}
+
+	if params.InformationGainMaximumBoost == nil {
+		defaultBoost := int64(3)
+		params.InformationGainMaximumBoost = &defaultBoost
+	}
+
+	if params.MinimumUsableWords == nil {
+		defaultMinimum := int64(3)
+		params.MinimumUsableWords = &defaultMinimum
+	}
 }
@@ -19,6 +19,7 @@ import (
 	"time"
 
 	"github.com/go-openapi/strfmt"
+	libfilters "github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema/kind"
 	"github.com/semi-technologies/weaviate/entities/search"
@@ -28,31 +29,41 @@ import (
 // which is generic, whereas the individual classify_item fns can be found in
 // the respective files such as classifier_run_knn.go
 
-type classifyItemFn func(item search.Result, kind kind.Kind, params models.Classification, filters filters) error
+// maxRecordedErrors is the number of per-item errors which are listed in the
+// error of a completed run, all others are only counted in CountFailed
+const maxRecordedErrors = 10
 
-func (c *Classifier) run(params models.Classification, kind kind.Kind,
-	filters filters) {
-	ctx, cancel := contextWithTimeout(30 * time.Second)
-	defer cancel()
+// classifyItemFn returns the item with the classified properties set, it
+// doesn't store the item
+type classifyItemFn func(item search.Result, kind kind.Kind, params models.Classification, filters filters) (search.Result, error)
+
+func (c *Classifier) run(ctx context.Context, params models.Classification,
+	kind kind.Kind, filters filters) {
+	defer c.finishJob(params.ID)
+
+	// the caller still holds the original meta, progress updates must not
+	// modify it concurrently
+	meta := *params.Meta
+	params.Meta = &meta
+
+	if params.Evaluate != nil && *params.Evaluate {
+		c.evaluate(ctx, params, kind, filters)
+		return
+	}
 
-	unclassifiedItems, err := c.vectorRepo.GetUnclassified(ctx,
+	total, err := c.vectorRepo.CountUnclassified(ctx,
 		kind, params.Class, params.ClassifyProperties, filters.source)
 	if err != nil {
 		c.failRunWithError(params, err)
 		return
 	}
 
-	if unclassifiedItems == nil || len(unclassifiedItems) == 0 {
+	if total == 0 {
 		c.failRunWithError(params,
 			fmt.Errorf("no classes to be classified - did you run a previous classification already?"))
 		return
 	}
 
-	var (
-		successCount int64
-		errorCount   int64
-	)
-
 	var classifyItem classifyItemFn
 
 	// safe to deref as we have passed validation at this point and or setting of
@@ -61,43 +72,135 @@ func (c *Classifier) run(params models.Classification, kind kind.Kind,
 	case "knn":
 		classifyItem = c.classifyItemUsingKNN
 	case "contextual":
-		classifyItem = c.classifyItemContextual
+		classifyItem, err = c.prepareContextual(ctx, kind, params, filters)
+		if err != nil {
+			if ctx.Err() == context.Canceled {
+				c.cancelRun(params)
+				return
+			}
+
+			c.failRunWithError(params, err)
+			return
+		}
 	default:
 		c.failRunWithError(params,
 			fmt.Errorf("unsupported type '%s', have no classify item fn for this", *params.Type))
 		return
 	}
 
+	var (
+		successCount      int64
+		errorCount        int64
+		unclassifiedCount int64
+		stopped           bool
+	)
+
+	params.Meta.Total = total
+	progress := newProgressReporter(c.repo, c.progressInterval)
 	errors := &errorCompounder{}
-	for _, item := range unclassifiedItems {
-		err := classifyItem(item, kind, params, filters)
+
+	// items which could not be classified are still unclassified, the cursor
+	// makes sure they are not loaded again
+	pagination := &libfilters.Pagination{Limit: c.pageSize}
+	for {
+		if ctx.Err() != nil {
+			stopped = true
+			break
+		}
+
+		page, err := c.vectorRepo.GetUnclassified(ctx, kind, params.Class,
+			params.ClassifyProperties, filters.source, pagination)
 		if err != nil {
-			errors.add(err)
-			errorCount++
-		} else {
-			successCount++
+			if ctx.Err() != nil {
+				stopped = true
+				break
+			}
+
+			c.failRunWithError(params, err)
+			return
 		}
 
-		time.Sleep(10 * time.Millisecond)
+		if len(page) == 0 {
+			break
+		}
+		pagination.After = page[len(page)-1].ID
+
+		for _, res := range c.classifyPage(ctx, page, kind, params, filters, classifyItem) {
+			switch {
+			case res.skipped:
+				stopped = true
+			case res.err != nil:
+				if errorCount < maxRecordedErrors {
+					errors.add(res.err)
+				}
+				errorCount++
+			case res.unclassified:
+				unclassifiedCount++
+			default:
+				successCount++
+				params.Predictions = c.appendPredictions(params.Predictions, res.predictions)
+			}
+		}
 
+		progress.report(&params, successCount, errorCount, unclassifiedCount)
 	}
 
 	params.Meta.Completed = strfmt.DateTime(time.Now())
 	params.Meta.CountSucceeded = successCount
 	params.Meta.CountFailed = errorCount
-	params.Meta.Count = successCount + errorCount
+	params.Meta.CountUnclassified = unclassifiedCount
+	params.Meta.Count = successCount + errorCount + unclassifiedCount
+	params.Meta.EstimatedCompletion = params.Meta.Completed
+
+	if stopped {
+		switch ctx.Err() {
+		case context.Canceled:
+			c.cancelRun(params)
+			return
+		case context.DeadlineExceeded:
+			c.failRunWithError(params, fmt.Errorf("timeout of %ds exceeded after "+
+				"%d of %d objects", *params.Timeout, params.Meta.Count, params.Meta.Total))
+			return
+		}
+	}
 
+	// items which failed don't stop the run, the remaining items are still
+	// classified, so the run completes with the failures recorded. Only if
+	// not a single item could be processed the run itself failed.
 	err = errors.toError()
-	if err != nil {
+	if err != nil && errorCount == params.Meta.Count {
 		c.failRunWithError(params, err)
 		return
 	}
 
+	if err != nil {
+		params.Error = fmt.Sprintf("%d of %d objects could not be classified: %v",
+			errorCount, params.Meta.Count, err)
+		if errorCount > maxRecordedErrors {
+			params.Error += fmt.Sprintf(" (and %d more)", errorCount-maxRecordedErrors)
+		}
+	}
+
 	c.succeedRun(params)
 }
 
+// appendPredictions appends the predictions of a dry run until
+// maxPredictions are reached, the counts of the run still include all items
+func (c *Classifier) appendPredictions(list,
+	predictions []*models.ClassificationPrediction) []*models.ClassificationPrediction {
+	if free := c.maxPredictions - len(list); free < len(predictions) {
+		if free <= 0 {
+			return list
+		}
+		predictions = predictions[:free]
+	}
+
+	return append(list, predictions...)
+}
+
 func (c *Classifier) succeedRun(params models.Classification) {
 	params.Status = models.ClassificationStatusCompleted
+	observeRun(params)
 	ctx, cancel := contextWithTimeout(2 * time.Second)
 	defer cancel()
 	err := c.repo.Put(ctx, params)
@@ -110,6 +213,7 @@ func (c *Classifier) succeedRun(params models.Classification) {
 func (c *Classifier) failRunWithError(params models.Classification, err error) {
 	params.Status = models.ClassificationStatusFailed
 	params.Error = fmt.Sprintf("classification failed: %v", err)
+	observeRun(params)
 	err = c.repo.Put(context.Background(), params)
 	if err != nil {
 		// TODO: log
@@ -117,16 +221,13 @@ func (c *Classifier) failRunWithError(params models.Classification, err error) {
 	}
 }
 
-func (c *Classifier) store(item search.Result) error {
-	ctx, cancel := contextWithTimeout(2 * time.Second)
-	defer cancel()
-	switch item.Kind {
-	case kind.Thing:
-		return c.vectorRepo.PutThing(ctx, item.Thing(), item.Vector)
-	case kind.Action:
-		return c.vectorRepo.PutAction(ctx, item.Action(), item.Vector)
-	default:
-		return fmt.Errorf("impossible kind")
+func (c *Classifier) cancelRun(params models.Classification) {
+	params.Status = models.ClassificationStatusCancelled
+	observeRun(params)
+	err := c.repo.Put(context.Background(), params)
+	if err != nil {
+		// TODO: log
+
 	}
 }
 
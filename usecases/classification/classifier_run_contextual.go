@@ -14,7 +14,12 @@
 package classification
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-openapi/strfmt"
@@ -27,85 +32,256 @@ import (
 	"github.com/semi-technologies/weaviate/usecases/traverser"
 )
 
-type contextualItemClassifier struct {
-	item       search.Result
-	kind       kind.Kind
-	params     models.Classification
+// contextualCandidates is the number of the closest targets, which the words
+// of an item are weighed against
+const contextualCandidates = 10
+
+// errNotClassified is returned for an item of which none of the properties
+// could be classified with the minimum certainty
+var errNotClassified = errors.New("no property reached the minimum certainty")
+
+// contextualRun holds everything which is shared by the items of a single
+// contextual classification
+type contextualRun struct {
 	classifier *Classifier
 	schema     schema.Schema
-	filters    filters
+	corpus     *tfidfCorpus
+	vectors    *wordVectors
 }
 
-func (c *Classifier) classifyItemContextual(item search.Result, kind kind.Kind, params models.Classification, filters filters) error {
-	schema := c.schemaGetter.GetSchemaSkipAuth()
+// prepareContextual builds the document frequencies of the words of the
+// basedOnProperties across all items to be classified, they are required to
+// score the words of the individual items
+func (c *Classifier) prepareContextual(ctx context.Context, kind kind.Kind,
+	params models.Classification, filters filters) (classifyItemFn, error) {
+	run := &contextualRun{
+		classifier: c,
+		schema:     c.schemaGetter.GetSchemaSkipAuth(),
+		corpus:     newTfidfCorpus(),
+		vectors:    newWordVectors(c.contextionary),
+	}
+
+	pagination := &libfilters.Pagination{Limit: c.pageSize}
+	for {
+		page, err := c.vectorRepo.GetUnclassified(ctx, kind, params.Class,
+			params.ClassifyProperties, filters.source, pagination)
+		if err != nil {
+			return nil, fmt.Errorf("contextual: build corpus: %v", err)
+		}
+
+		if len(page) == 0 {
+			break
+		}
+		pagination.After = page[len(page)-1].ID
+
+		for _, item := range page {
+			run.corpus.add(textWords(item, params.BasedOnProperties))
+		}
+	}
+
+	return run.classifyItem, nil
+}
+
+func (r *contextualRun) classifyItem(item search.Result, kind kind.Kind,
+	params models.Classification, filters filters) (search.Result, error) {
+	words, err := r.usableWords(item, params)
+	if err != nil {
+		return item, fmt.Errorf("contextual: %v", err)
+	}
+
 	run := &contextualItemClassifier{
 		item:       item,
 		kind:       kind,
 		params:     params,
-		classifier: c,
-		schema:     schema,
+		classifier: r.classifier,
+		schema:     r.schema,
 		filters:    filters,
+		words:      words,
+	}
+
+	classified, err := run.do()
+	if err != nil {
+		if err == errNotClassified {
+			return item, err
+		}
+		return item, fmt.Errorf("contextual: %v", err)
+	}
+
+	return classified, nil
+}
+
+// usableWords are the words of the basedOnProperties which are known to the
+// contextionary and pass the tf-idf cutoff
+func (r *contextualRun) usableWords(item search.Result,
+	params models.Classification) ([]scoredWord, error) {
+	words := textWords(item, params.BasedOnProperties)
+	if len(words) == 0 {
+		return nil, fmt.Errorf("no text in basedOnProperties %v", params.BasedOnProperties)
+	}
+
+	var known []scoredWord
+	for word, score := range r.corpus.scores(words) {
+		vector, err := r.vectors.get(word)
+		if err != nil {
+			return nil, fmt.Errorf("vector for word '%s': %v", word, err)
+		}
+
+		if vector == nil {
+			continue
+		}
+
+		known = append(known, scoredWord{word: word, vector: vector,
+			tfidf: score, weight: score})
+	}
+
+	if len(known) == 0 {
+		return nil, fmt.Errorf("none of the words of basedOnProperties %v is "+
+			"present in the contextionary", params.BasedOnProperties)
+	}
+
+	// the scores come from a map, sort by word first so that ties are cut
+	// deterministically
+	sort.Slice(known, func(a, b int) bool { return known[a].word < known[b].word })
+	return cutoff(known, byTfidf, *params.TfidfCutoffPercentile,
+		int(*params.MinimumUsableWords)), nil
+}
+
+func textWords(item search.Result, properties []string) []string {
+	props, ok := item.Schema.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	var texts []string
+	for _, prop := range properties {
+		if text, ok := props[prop].(string); ok {
+			texts = append(texts, text)
+		}
+	}
+
+	return tokenize(strings.Join(texts, " "))
+}
+
+func byTfidf(w scoredWord) float64           { return w.tfidf }
+func byInformationGain(w scoredWord) float64 { return w.informationGain }
+
+// wordVectors caches the vectors of the words for the duration of a run, the
+// vector of a word which isn't present in the contextionary is nil
+type wordVectors struct {
+	sync.Mutex
+	c11y    contextionary
+	vectors map[string][]float32
+}
+
+func newWordVectors(c11y contextionary) *wordVectors {
+	return &wordVectors{c11y: c11y, vectors: map[string][]float32{}}
+}
+
+func (w *wordVectors) get(word string) ([]float32, error) {
+	w.Lock()
+	vector, ok := w.vectors[word]
+	w.Unlock()
+	if ok {
+		return vector, nil
 	}
 
-	err := run.do()
+	ctx, cancel := contextWithTimeout(2 * time.Second)
+	defer cancel()
+
+	present, err := w.c11y.IsWordPresent(ctx, word)
 	if err != nil {
-		return fmt.Errorf("contextual: %v", err)
+		return nil, err
+	}
+
+	if present {
+		vector, err = w.c11y.VectorForWord(ctx, word)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	return nil
+	w.Lock()
+	w.vectors[word] = vector
+	w.Unlock()
+	return vector, nil
 }
 
-func (c *contextualItemClassifier) do() error {
+type contextualItemClassifier struct {
+	item       search.Result
+	kind       kind.Kind
+	params     models.Classification
+	classifier *Classifier
+	schema     schema.Schema
+	filters    filters
+	words      []scoredWord
+}
+
+func (c *contextualItemClassifier) do() (search.Result, error) {
 	var classified []string
 	for _, propName := range c.params.ClassifyProperties {
-		current, err := c.property(propName)
+		ok, err := c.property(propName)
 		if err != nil {
-			return fmt.Errorf("prop '%s': %v", propName, err)
+			return c.item, fmt.Errorf("prop '%s': %v", propName, err)
+		}
+
+		if !ok {
+			continue
 		}
 
 		// append list of actually classified (can differ from scope!) properties,
 		// so we can build the object meta information
-		classified = append(classified, current)
+		classified = append(classified, propName)
 	}
 
-	c.classifier.extendItemWithObjectMeta(&c.item, c.params, classified)
-	err := c.classifier.store(c.item)
-	if err != nil {
-		return fmt.Errorf("store %s/%s: %v", c.item.ClassName, c.item.ID, err)
+	if len(classified) == 0 {
+		return c.item, errNotClassified
 	}
 
-	return nil
+	c.classifier.extendItemWithObjectMeta(&c.item, c.params, classified)
+	return c.item, nil
 }
 
-func (c *contextualItemClassifier) property(propName string) (string, error) {
+// property is classified if the closest target is at least as certain as the
+// minimum certainty
+func (c *contextualItemClassifier) property(propName string) (bool, error) {
 	targetClass, targetKind, err := c.classAndKindOfTarget(propName)
 	if err != nil {
-		return "", fmt.Errorf("inspect target: %v", err)
+		return false, fmt.Errorf("inspect target: %v", err)
+	}
+
+	candidates, err := c.findCandidates(targetClass, targetKind, weightedMean(c.words))
+	if err != nil {
+		return false, fmt.Errorf("find target: %v", err)
 	}
 
-	res, err := c.findTarget(targetClass, targetKind)
+	words, err := c.weighByInformationGain(candidates)
 	if err != nil {
-		return "", fmt.Errorf("find target: %v", err)
+		return false, fmt.Errorf("information gain: %v", err)
 	}
 
-	distance, err := c.distance(res.Vector)
+	target, distance, err := c.closest(candidates, weightedMean(words))
 	if err != nil {
-		return "", fmt.Errorf("calculate distance: %v", err)
+		return false, fmt.Errorf("calculate distance: %v", err)
 	}
 
-	targetBeacon := crossref.New("localhost", res.ID, res.Kind).String()
+	if 1-distance < c.params.MinimumCertainty {
+		return false, nil
+	}
+
+	targetBeacon := crossref.New("localhost", target.ID, target.Kind).String()
 	c.item.Schema.(map[string]interface{})[propName] = models.MultipleRef{
 		&models.SingleRef{
 			Beacon: strfmt.URI(targetBeacon),
 			Meta: &models.ReferenceMeta{
 				Classification: &models.ReferenceMetaClassification{
 					WinningDistance: distance,
+					BasedOnWords:    basedOnWords(words),
 				},
 			},
 		},
 	}
 
-	return propName, nil
+	return true, nil
 }
 
 func (c *contextualItemClassifier) classAndKindOfTarget(propName string) (schema.ClassName, kind.Kind, error) {
@@ -129,16 +305,17 @@ func (c *contextualItemClassifier) classAndKindOfTarget(propName string) (schema
 	return targetClass, targetKind, nil
 }
 
-func (c *contextualItemClassifier) findTarget(targetClass schema.ClassName, targetKind kind.Kind) (*search.Result, error) {
+func (c *contextualItemClassifier) findCandidates(targetClass schema.ClassName,
+	targetKind kind.Kind, vector []float32) ([]search.Result, error) {
 	ctx, cancel := contextWithTimeout(2 * time.Second)
 	defer cancel()
 
 	res, err := c.classifier.vectorRepo.VectorClassSearch(ctx, traverser.GetParams{
-		SearchVector: c.item.Vector,
+		SearchVector: vector,
 		ClassName:    targetClass.String(),
 		Kind:         targetKind,
 		Pagination: &libfilters.Pagination{
-			Limit: 1,
+			Limit: contextualCandidates,
 		},
 		Filters: c.filters.target,
 		Properties: traverser.SelectProperties{
@@ -151,14 +328,88 @@ func (c *contextualItemClassifier) findTarget(targetClass schema.ClassName, targ
 		return nil, fmt.Errorf("search closest target: %v", err)
 	}
 
-	if res == nil || len(res) == 0 {
+	if len(res) == 0 {
 		return nil, fmt.Errorf("no potential targets found of class '%s' (%s)", targetClass, targetKind)
 	}
 
-	return &res[0], nil
+	return res, nil
+}
+
+// weighByInformationGain boosts the words which are close to only some of the
+// candidates, as they help most to tell the candidates apart. A word which is
+// equally similar to all candidates gains no information.
+func (c *contextualItemClassifier) weighByInformationGain(
+	candidates []search.Result) ([]scoredWord, error) {
+	words := make([]scoredWord, len(c.words))
+	for i, word := range c.words {
+		var max, sum float64
+		for j, candidate := range candidates {
+			dist, err := c.classifier.distancer(word.vector, candidate.Vector)
+			if err != nil {
+				return nil, err
+			}
+
+			sim := 1 - float64(dist)
+			sum += sim
+			if j == 0 || sim > max {
+				max = sim
+			}
+		}
+
+		word.informationGain = max - sum/float64(len(candidates))
+		words[i] = word
+	}
+
+	words = cutoff(words, byInformationGain, *c.params.InformationGainCutoffPercentile,
+		int(*c.params.MinimumUsableWords))
+
+	maxGain := words[0].informationGain
+	boost := float64(*c.params.InformationGainMaximumBoost)
+	for i := range words {
+		words[i].weight = words[i].tfidf
+		if maxGain > 0 {
+			words[i].weight *= 1 + (boost-1)*words[i].informationGain/maxGain
+		}
+	}
+
+	return words, nil
 }
 
-func (c *contextualItemClassifier) distance(target []float32) (float64, error) {
-	dist, err := c.classifier.distancer(c.item.Vector, target)
-	return float64(dist), err
+func (c *contextualItemClassifier) closest(candidates []search.Result,
+	vector []float32) (*search.Result, float64, error) {
+	var (
+		winner   *search.Result
+		distance float32
+	)
+
+	for i := range candidates {
+		dist, err := c.classifier.distancer(vector, candidates[i].Vector)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if winner == nil || dist < distance {
+			winner = &candidates[i]
+			distance = dist
+		}
+	}
+
+	return winner, float64(distance), nil
+}
+
+func basedOnWords(words []scoredWord) []*models.ReferenceMetaClassificationWord {
+	sorted := append([]scoredWord{}, words...)
+	sort.SliceStable(sorted, func(a, b int) bool {
+		return sorted[a].weight > sorted[b].weight
+	})
+
+	out := make([]*models.ReferenceMetaClassificationWord, len(sorted))
+	for i, word := range sorted {
+		out[i] = &models.ReferenceMetaClassificationWord{
+			Word:   word.word,
+			Weight: word.weight,
+		}
+	}
+
+	return out
 }
@@ -18,11 +18,12 @@ import (
 	"time"
 
 	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/schema/kind"
 	"github.com/semi-technologies/weaviate/entities/search"
 )
 
-func (c *Classifier) classifyItemUsingKNN(item search.Result, kind kind.Kind, params models.Classification, filters filters) error {
+func (c *Classifier) classifyItemUsingKNN(item search.Result, kind kind.Kind, params models.Classification, filters filters) (search.Result, error) {
 	ctx, cancel := contextWithTimeout(2 * time.Second)
 	defer cancel()
 
@@ -32,10 +33,11 @@ func (c *Classifier) classifyItemUsingKNN(item search.Result, kind kind.Kind, pa
 		params.ClassifyProperties, int(*params.K), filters.trainingSet)
 
 	if err != nil {
-		return fmt.Errorf("classify %s/%s: %v", item.ClassName, item.ID, err)
+		return item, fmt.Errorf("classify %s/%s: %v", item.ClassName, item.ID, err)
 	}
 
 	var classified []string
+	var confidences []*models.ObjectMetaClassificationProperty
 
 	for _, agg := range res {
 		var losingDistance *float64
@@ -43,6 +45,28 @@ func (c *Classifier) classifyItemUsingKNN(item search.Result, kind kind.Kind, pa
 			d := float64(*agg.LosingDistance)
 			losingDistance = &d
 		}
+
+		confidence := &models.ObjectMetaClassificationProperty{
+			Name:            agg.Property,
+			WinningDistance: float64(agg.WinningDistance),
+			LosingDistance:  losingDistance,
+		}
+		if agg.Total > 0 {
+			confidence.Confidence = float64(agg.Count) / float64(agg.Total)
+		}
+		confidences = append(confidences, confidence)
+
+		if agg.Beacon == "" {
+			value, err := c.primitiveValue(kind, item.ClassName, agg.Property, agg.Value)
+			if err != nil {
+				return item, fmt.Errorf("classify %s/%s: %v", item.ClassName, item.ID, err)
+			}
+
+			item.Schema.(map[string]interface{})[agg.Property] = value
+			classified = append(classified, agg.Property)
+			continue
+		}
+
 		item.Schema.(map[string]interface{})[agg.Property] = models.MultipleRef{
 			&models.SingleRef{
 				Beacon: agg.Beacon,
@@ -61,10 +85,45 @@ func (c *Classifier) classifyItemUsingKNN(item search.Result, kind kind.Kind, pa
 	}
 
 	c.extendItemWithObjectMeta(&item, params, classified)
-	err = c.store(item)
+	item.Meta.Classification.Properties = confidences
+	return item, nil
+}
+
+// primitiveValue converts the value as it was stored on the neighbors to the
+// type of the property, e.g. numbers are stored as float64 in some repos
+func (c *Classifier) primitiveValue(kind kind.Kind, className, propName string,
+	value interface{}) (interface{}, error) {
+	s := c.schemaGetter.GetSchemaSkipAuth()
+	prop, err := s.GetProperty(kind, schema.ClassName(className), schema.PropertyName(propName))
 	if err != nil {
-		return fmt.Errorf("store %s/%s: %v", item.ClassName, item.ID, err)
+		return nil, fmt.Errorf("prop '%s': %v", propName, err)
+	}
+
+	dataType, err := s.FindPropertyDataType(prop.DataType)
+	if err != nil {
+		return nil, fmt.Errorf("prop '%s': %v", propName, err)
+	}
+
+	switch dataType.AsPrimitive() {
+	case schema.DataTypeInt:
+		switch typed := value.(type) {
+		case float64:
+			return int64(typed), nil
+		case int64:
+			return typed, nil
+		case int:
+			return int64(typed), nil
+		}
+	case schema.DataTypeBoolean:
+		if typed, ok := value.(bool); ok {
+			return typed, nil
+		}
+	case schema.DataTypeString, schema.DataTypeText:
+		if typed, ok := value.(string); ok {
+			return typed, nil
+		}
 	}
 
-	return nil
+	return nil, fmt.Errorf("prop '%s': neighbors have value %v of type %T, "+
+		"which doesn't match data type '%s'", propName, value, value, dataType.AsPrimitive())
 }
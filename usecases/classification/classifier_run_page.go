@@ -0,0 +1,218 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package classification
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+)
+
+// itemResult is the outcome of a single item of a page, skipped items were
+// neither classified nor stored as the run was stopped. Unclassified items
+// were processed, but none of their properties could be classified with
+// enough certainty, so they aren't stored either.
+type itemResult struct {
+	err          error
+	skipped      bool
+	unclassified bool
+
+	// predictions are only set on a dry run, which doesn't store the items
+	predictions []*models.ClassificationPrediction
+}
+
+// classifyPage classifies the items of the page concurrently and stores all
+// successfully classified items in bulk. A failure of an item doesn't affect
+// the other items. The results match the items by index.
+func (c *Classifier) classifyPage(ctx context.Context, items []search.Result,
+	kind kind.Kind, params models.Classification, filters filters,
+	classifyItem classifyItemFn) []itemResult {
+	results := make([]itemResult, len(items))
+	classified := make([]search.Result, len(items))
+
+	indices := make(chan int)
+	wg := &sync.WaitGroup{}
+	for i := 0; i < c.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				if ctx.Err() != nil {
+					// cancelled or timed out, finish the items which are already
+					// being classified, but don't start new ones
+					results[i].skipped = true
+					continue
+				}
+
+				classified[i], results[i].err = classifyItem(items[i], kind, params, filters)
+				if results[i].err == errNotClassified {
+					results[i].err = nil
+					results[i].unclassified = true
+				}
+			}
+		}()
+	}
+
+	for i := range items {
+		indices <- i
+	}
+	close(indices)
+	wg.Wait()
+
+	if params.DryRun != nil && *params.DryRun {
+		for i := range results {
+			if results[i].err == nil && !results[i].skipped && !results[i].unclassified {
+				results[i].predictions = predictions(classified[i], params.ClassifyProperties)
+			}
+		}
+		return results
+	}
+
+	c.storePage(classified, results)
+	return results
+}
+
+// predictions lists the references which were set on the classified item
+func predictions(item search.Result,
+	properties []string) []*models.ClassificationPrediction {
+	props, ok := item.Schema.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	var out []*models.ClassificationPrediction
+	for _, prop := range properties {
+		value, ok := props[prop]
+		if !ok || value == nil {
+			// not every property is necessarily classified
+			continue
+		}
+
+		refs, ok := value.(models.MultipleRef)
+		if !ok {
+			out = append(out, &models.ClassificationPrediction{
+				ID:       item.ID,
+				Property: prop,
+				Value:    value,
+			})
+			continue
+		}
+
+		if len(refs) == 0 {
+			continue
+		}
+
+		prediction := &models.ClassificationPrediction{
+			ID:       item.ID,
+			Property: prop,
+			Beacon:   refs[0].Beacon,
+		}
+		if refs[0].Meta != nil && refs[0].Meta.Classification != nil {
+			prediction.WinningDistance = refs[0].Meta.Classification.WinningDistance
+		}
+		out = append(out, prediction)
+	}
+
+	return out
+}
+
+// storePage writes the classified items of a page through the batch path of
+// the vector repo, errors of individual items are set on their result
+func (c *Classifier) storePage(classified []search.Result, results []itemResult) {
+	// the items are stored even if the run has just been cancelled, so that no
+	// classification work is lost
+	ctx, cancel := contextWithTimeout(30 * time.Second)
+	defer cancel()
+
+	var things kinds.BatchThings
+	var actions kinds.BatchActions
+	for i, item := range classified {
+		if results[i].err != nil || results[i].skipped || results[i].unclassified {
+			continue
+		}
+
+		switch item.Kind {
+		case kind.Thing:
+			things = append(things, kinds.BatchThing{
+				OriginalIndex: i,
+				Thing:         item.Thing(),
+				UUID:          item.ID,
+				Vector:        item.Vector,
+			})
+		case kind.Action:
+			actions = append(actions, kinds.BatchAction{
+				OriginalIndex: i,
+				Action:        item.Action(),
+				UUID:          item.ID,
+				Vector:        item.Vector,
+			})
+		default:
+			results[i].err = fmt.Errorf("store %s/%s: impossible kind", item.ClassName, item.ID)
+		}
+	}
+
+	if len(things) > 0 {
+		stored, err := c.vectorRepo.BatchPutThings(ctx, things)
+		if err != nil {
+			setStoreErrors(results, classified, thingIndices(things), err)
+		}
+		for _, item := range stored {
+			if item.Err != nil {
+				setStoreErrors(results, classified, []int{item.OriginalIndex}, item.Err)
+			}
+		}
+	}
+
+	if len(actions) > 0 {
+		stored, err := c.vectorRepo.BatchPutActions(ctx, actions)
+		if err != nil {
+			setStoreErrors(results, classified, actionIndices(actions), err)
+		}
+		for _, item := range stored {
+			if item.Err != nil {
+				setStoreErrors(results, classified, []int{item.OriginalIndex}, item.Err)
+			}
+		}
+	}
+}
+
+func setStoreErrors(results []itemResult, classified []search.Result,
+	indices []int, err error) {
+	for _, i := range indices {
+		results[i].err = fmt.Errorf("store %s/%s: %v",
+			classified[i].ClassName, classified[i].ID, err)
+	}
+}
+
+func thingIndices(batch kinds.BatchThings) []int {
+	out := make([]int, len(batch))
+	for i, item := range batch {
+		out[i] = item.OriginalIndex
+	}
+	return out
+}
+
+func actionIndices(batch kinds.BatchActions) []int {
+	out := make([]int, len(batch))
+	for i, item := range batch {
+		out[i] = item.OriginalIndex
+	}
+	return out
+}
@@ -0,0 +1,80 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package classification
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Classifier_PagedRun(t *testing.T) {
+	params := func() models.Classification {
+		k := int32(1)
+		return models.Classification{
+			Class:              "Article",
+			BasedOnProperties:  []string{"description"},
+			ClassifyProperties: []string{"exactCategory", "mainCategory"},
+			K:                  &k,
+		}
+	}
+
+	t.Run("with several pages and workers", func(t *testing.T) {
+		vectorRepo := newFakeVectorRepoKNN(testDataToBeClassified(), testDataAlreadyClassified())
+		classifier := New(&fakeSchemaGetter{testSchema()}, newFakeClassificationRepo(),
+			vectorRepo, &fakeAuthorizer{}, nil)
+		classifier.SetRunConfig(config.Classification{Workers: 3, PageSize: 4})
+
+		class, err := classifier.Schedule(context.Background(), nil, params())
+		require.Nil(t, err)
+		waitForStatusToNoLongerBeRunning(t, classifier, class.ID)
+
+		res, err := classifier.Get(context.Background(), nil, class.ID)
+		require.Nil(t, err)
+		assert.Equal(t, models.ClassificationStatusCompleted, res.Status)
+		assert.Equal(t, int64(6), res.Meta.CountSucceeded)
+		assert.Len(t, vectorRepo.db, 6)
+	})
+
+	t.Run("when storing single items fails", func(t *testing.T) {
+		failing := strfmt.UUID("75ba35af-6a08-40ae-b442-3bec69b355f9")
+		vectorRepo := newFakeVectorRepoKNN(testDataToBeClassified(), testDataAlreadyClassified())
+		vectorRepo.errorOnStore = map[strfmt.UUID]error{
+			failing: errors.New("disk full"),
+		}
+		classifier := New(&fakeSchemaGetter{testSchema()}, newFakeClassificationRepo(),
+			vectorRepo, &fakeAuthorizer{}, nil)
+		classifier.SetRunConfig(config.Classification{Workers: 2, PageSize: 2})
+
+		class, err := classifier.Schedule(context.Background(), nil, params())
+		require.Nil(t, err)
+		waitForStatusToNoLongerBeRunning(t, classifier, class.ID)
+
+		res, err := classifier.Get(context.Background(), nil, class.ID)
+		require.Nil(t, err)
+		assert.Equal(t, models.ClassificationStatusCompleted, res.Status,
+			"failures of single items don't fail the run")
+		assert.Equal(t, "1 of 6 objects could not be classified: store Article/"+
+			"75ba35af-6a08-40ae-b442-3bec69b355f9: disk full", res.Error)
+		assert.Equal(t, int64(5), res.Meta.CountSucceeded, "other items are not affected")
+		assert.Equal(t, int64(1), res.Meta.CountFailed)
+		assert.Len(t, vectorRepo.db, 5)
+	})
+}
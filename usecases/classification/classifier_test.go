@@ -30,7 +30,7 @@ import (
 func Test_Classifier_KNN(t *testing.T) {
 	t.Run("with invalid data", func(t *testing.T) {
 		sg := &fakeSchemaGetter{testSchema()}
-		_, err := New(sg, nil, nil, &fakeAuthorizer{}).Schedule(context.Background(), nil, models.Classification{})
+		_, err := New(sg, nil, nil, &fakeAuthorizer{}, nil).Schedule(context.Background(), nil, models.Classification{})
 		assert.NotNil(t, err, "should error with invalid user input")
 	})
 
@@ -42,7 +42,7 @@ func Test_Classifier_KNN(t *testing.T) {
 		repo := newFakeClassificationRepo()
 		authorizer := &fakeAuthorizer{}
 		vectorRepo := newFakeVectorRepoKNN(testDataToBeClassified(), testDataAlreadyClassified())
-		classifier := New(sg, repo, vectorRepo, authorizer)
+		classifier := New(sg, repo, vectorRepo, authorizer, nil)
 
 		k := int32(1)
 		params := models.Classification{
@@ -78,6 +78,9 @@ func Test_Classifier_KNN(t *testing.T) {
 			require.Nil(t, err)
 			require.NotNil(t, class)
 			assert.Equal(t, models.ClassificationStatusCompleted, class.Status)
+			assert.Equal(t, int64(6), class.Meta.Total)
+			assert.Equal(t, int64(6), class.Meta.Processed)
+			assert.Equal(t, int64(30), *class.Timeout, "default timeout was set")
 		})
 
 		t.Run("the classifier updated the things/actions with the classified references", func(t *testing.T) {
@@ -115,7 +118,7 @@ func Test_Classifier_KNN(t *testing.T) {
 		authorizer := &fakeAuthorizer{}
 		vectorRepo := newFakeVectorRepoKNN(testDataToBeClassified(), testDataAlreadyClassified())
 		vectorRepo.errorOnAggregate = errors.New("something went wrong")
-		classifier := New(sg, repo, vectorRepo, authorizer)
+		classifier := New(sg, repo, vectorRepo, authorizer, nil)
 
 		k := int32(1)
 		params := models.Classification{
@@ -143,12 +146,12 @@ func Test_Classifier_KNN(t *testing.T) {
 			require.NotNil(t, class)
 			assert.Equal(t, models.ClassificationStatusFailed, class.Status)
 			expectedErr := "classification failed: " +
-				"classify Article/75ba35af-6a08-40ae-b442-3bec69b355f9: something went wrong, " +
-				"classify Article/f850439a-d3cd-4f17-8fbf-5a64405645cd: something went wrong, " +
-				"classify Article/a2bbcbdc-76e1-477d-9e72-a6d2cfb50109: something went wrong, " +
 				"classify Article/069410c3-4b9e-4f68-8034-32a066cb7997: something went wrong, " +
 				"classify Article/06a1e824-889c-4649-97f9-1ed3fa401d8e: something went wrong, " +
-				"classify Article/6402e649-b1e0-40ea-b192-a64eab0d5e56: something went wrong"
+				"classify Article/6402e649-b1e0-40ea-b192-a64eab0d5e56: something went wrong, " +
+				"classify Article/75ba35af-6a08-40ae-b442-3bec69b355f9: something went wrong, " +
+				"classify Article/a2bbcbdc-76e1-477d-9e72-a6d2cfb50109: something went wrong, " +
+				"classify Article/f850439a-d3cd-4f17-8fbf-5a64405645cd: something went wrong"
 			assert.Equal(t, expectedErr, class.Error)
 		})
 	})
@@ -158,7 +161,7 @@ func Test_Classifier_KNN(t *testing.T) {
 		repo := newFakeClassificationRepo()
 		authorizer := &fakeAuthorizer{}
 		vectorRepo := newFakeVectorRepoKNN(nil, testDataAlreadyClassified())
-		classifier := New(sg, repo, vectorRepo, authorizer)
+		classifier := New(sg, repo, vectorRepo, authorizer, nil)
 
 		k := int32(1)
 		params := models.Classification{
@@ -192,6 +195,58 @@ func Test_Classifier_KNN(t *testing.T) {
 	})
 }
 
+func Test_Classifier_KNN_PrimitiveProperties(t *testing.T) {
+	trainingSet := testDataAlreadyClassified()
+	labels := []struct {
+		category  string
+		breaking  bool
+		wordCount float64
+	}{
+		{"politics", true, 300},
+		{"society", false, 500},
+		{"food", false, 800},
+	}
+	for i, label := range labels {
+		// the repos store numbers as float64
+		props := trainingSet[i].Schema.(map[string]interface{})
+		props["category"] = label.category
+		props["breaking"] = label.breaking
+		props["wordCount"] = label.wordCount
+	}
+
+	vectorRepo := newFakeVectorRepoKNN(testDataToBeClassified(), trainingSet)
+	classifier := New(&fakeSchemaGetter{testSchema()}, newFakeClassificationRepo(),
+		vectorRepo, &fakeAuthorizer{}, nil)
+
+	class, err := classifier.Schedule(context.Background(), nil, models.Classification{
+		Class:              "Article",
+		BasedOnProperties:  []string{"description"},
+		ClassifyProperties: []string{"category", "breaking", "wordCount"},
+		K:                  ptInt(1),
+	})
+	require.Nil(t, err)
+	waitForStatusToNoLongerBeRunning(t, classifier, class.ID)
+
+	res, err := classifier.Get(context.Background(), nil, class.ID)
+	require.Nil(t, err)
+	assert.Equal(t, models.ClassificationStatusCompleted, res.Status)
+
+	thing, ok := vectorRepo.get("75ba35af-6a08-40ae-b442-3bec69b355f9")
+	require.True(t, ok)
+	props := thing.Schema.(map[string]interface{})
+	assert.Equal(t, "politics", props["category"])
+	assert.Equal(t, true, props["breaking"])
+	assert.Equal(t, int64(300), props["wordCount"])
+
+	require.NotNil(t, thing.Meta)
+	meta := thing.Meta.Classification
+	assert.ElementsMatch(t, []string{"category", "breaking", "wordCount"}, meta.ClassifiedFields)
+	require.Len(t, meta.Properties, 3)
+	for _, prop := range meta.Properties {
+		assert.Equal(t, 1.0, prop.Confidence)
+	}
+}
+
 func Test_Classifier_Contextual(t *testing.T) {
 	var id strfmt.UUID
 	// so we can reuse it for follow up requests, such as checking the status
@@ -201,7 +256,7 @@ func Test_Classifier_Contextual(t *testing.T) {
 		repo := newFakeClassificationRepo()
 		authorizer := &fakeAuthorizer{}
 		vectorRepo := newFakeVectorRepoContextual(testDataToBeClassified(), testDataPossibleTargets())
-		classifier := New(sg, repo, vectorRepo, authorizer)
+		classifier := New(sg, repo, vectorRepo, authorizer, newFakeContextionary())
 
 		contextual := "contextual"
 		params := models.Classification{
@@ -265,6 +320,52 @@ func Test_Classifier_Contextual(t *testing.T) {
 				checkRef(t, vectorRepo, idArticleSocietyTwo, "mainCategory", idMainCategoryPoliticsAndSociety)
 			})
 		})
+
+		t.Run("the words the classification was based on are recorded", func(t *testing.T) {
+			thing, ok := vectorRepo.get("75ba35af-6a08-40ae-b442-3bec69b355f9")
+			require.True(t, ok)
+
+			refs := thing.Schema.(map[string]interface{})["exactCategory"].(models.MultipleRef)
+			meta := refs[0].Meta.Classification
+			var words []string
+			for _, word := range meta.BasedOnWords {
+				words = append(words, word.Word)
+				assert.True(t, word.Weight > 0, "word has a weight")
+			}
+			assert.ElementsMatch(t, []string{"barack", "obama", "president"}, words)
+			assert.InDelta(t, 0, meta.WinningDistance, 0.0001)
+		})
+	})
+
+	t.Run("with a minimum certainty", func(t *testing.T) {
+		repo := newFakeClassificationRepo()
+		vectorRepo := newFakeVectorRepoContextual(testDataToBeClassified(), testDataPossibleTargets())
+		classifier := New(&fakeSchemaGetter{testSchema()}, repo, vectorRepo,
+			&fakeAuthorizer{}, newFakeContextionary())
+
+		contextual := "contextual"
+		params := models.Classification{
+			Class:              "Article",
+			BasedOnProperties:  []string{"description"},
+			ClassifyProperties: []string{"mainCategory"},
+			Type:               &contextual,
+			MinimumCertainty:   0.9,
+		}
+
+		class, err := classifier.Schedule(context.Background(), nil, params)
+		require.Nil(t, err)
+		waitForStatusToNoLongerBeRunning(t, classifier, class.ID)
+
+		res, err := classifier.Get(context.Background(), nil, class.ID)
+		require.Nil(t, err)
+		assert.Equal(t, models.ClassificationStatusCompleted, res.Status)
+
+		// politics and society are only about 0.85 certain to be 'Politics and Society'
+		assert.Equal(t, int64(2), res.Meta.CountSucceeded)
+		assert.Equal(t, int64(4), res.Meta.CountUnclassified)
+		assert.Equal(t, int64(6), res.Meta.Count)
+		require.Len(t, vectorRepo.db, 2, "unclassified items are not stored")
+		checkRef(t, vectorRepo, "06a1e824-889c-4649-97f9-1ed3fa401d8e", "mainCategory", idMainCategoryFoodAndDrink)
 	})
 
 	// t.Run("when errors occur during classification", func(t *testing.T) {
@@ -273,7 +374,7 @@ func Test_Classifier_Contextual(t *testing.T) {
 	// 	authorizer := &fakeAuthorizer{}
 	// 	vectorRepo := newFakeVectorRepoKNN(testDataToBeClassified(), testDataAlreadyClassified())
 	// 	vectorRepo.errorOnAggregate = errors.New("something went wrong")
-	// 	classifier := New(sg, repo, vectorRepo, authorizer)
+	// 	classifier := New(sg, repo, vectorRepo, authorizer, nil)
 
 	// 	k := int32(1)
 	// 	params := models.Classification{
@@ -316,7 +417,7 @@ func Test_Classifier_Contextual(t *testing.T) {
 	// 	repo := newFakeClassificationRepo()
 	// 	authorizer := &fakeAuthorizer{}
 	// 	vectorRepo := newFakeVectorRepoKNN(nil, testDataAlreadyClassified())
-	// 	classifier := New(sg, repo, vectorRepo, authorizer)
+	// 	classifier := New(sg, repo, vectorRepo, authorizer, nil)
 
 	// 	k := int32(1)
 	// 	params := models.Classification{
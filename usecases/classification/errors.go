@@ -0,0 +1,45 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package classification
+
+import "fmt"
+
+// ErrNotFound indicates the classification doesn't exist
+type ErrNotFound struct {
+	msg string
+}
+
+func (e ErrNotFound) Error() string {
+	return e.msg
+}
+
+// NewErrNotFound with Errorf signature
+func NewErrNotFound(format string, args ...interface{}) ErrNotFound {
+	return ErrNotFound{msg: fmt.Sprintf(format, args...)}
+}
+
+// ErrNotRunning indicates that the operation requires a running
+// classification
+type ErrNotRunning struct {
+	msg string
+}
+
+func (e ErrNotRunning) Error() string {
+	return e.msg
+}
+
+// NewErrNotRunning with Errorf signature
+func NewErrNotRunning(format string, args ...interface{}) ErrNotRunning {
+	return ErrNotRunning{msg: fmt.Sprintf(format, args...)}
+}
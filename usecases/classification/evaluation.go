@@ -0,0 +1,309 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package classification
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	libfilters "github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+)
+
+// evaluate performs a k-fold cross-validation of a knn classification over
+// its training set. Every object of the training set is classified based on
+// the objects of all other folds and the prediction is compared to the
+// object's actual references. No object is modified.
+func (c *Classifier) evaluate(ctx context.Context, params models.Classification,
+	kind kind.Kind, filters filters) {
+	items, err := c.trainingSet(ctx, kind, params, filters)
+	if err != nil {
+		if ctx.Err() == context.Canceled {
+			c.cancelRun(params)
+			return
+		}
+
+		c.failRunWithError(params, err)
+		return
+	}
+
+	folds := int(*params.EvaluationFolds)
+	if len(items) < folds {
+		c.failRunWithError(params, fmt.Errorf("training set has %d objects, "+
+			"but at least one object per fold is required for %d folds", len(items), folds))
+		return
+	}
+
+	params.Meta.Total = int64(len(items))
+	progress := newProgressReporter(c.repo, c.progressInterval)
+	results := newEvaluation()
+	var evaluated int64
+
+	for i := range items {
+		if ctx.Err() != nil {
+			break
+		}
+
+		predicted, err := c.predictFromOtherFolds(items, i, folds, int(*params.K),
+			params.ClassifyProperties)
+		if err != nil {
+			c.failRunWithError(params, err)
+			return
+		}
+
+		for _, prop := range params.ClassifyProperties {
+			// the training set only contains objects with all properties set
+			actual, _ := singleBeacon(items[i], prop)
+			results.add(prop, actual, predicted[prop])
+		}
+
+		evaluated++
+		progress.report(&params, evaluated, 0, 0)
+	}
+
+	params.Meta.Completed = strfmt.DateTime(time.Now())
+	params.Meta.CountSucceeded = evaluated
+	params.Meta.Count = evaluated
+	params.Meta.EstimatedCompletion = params.Meta.Completed
+
+	switch ctx.Err() {
+	case context.Canceled:
+		c.cancelRun(params)
+		return
+	case context.DeadlineExceeded:
+		c.failRunWithError(params, fmt.Errorf("timeout of %ds exceeded after "+
+			"%d of %d objects", *params.Timeout, params.Meta.Count, params.Meta.Total))
+		return
+	}
+
+	params.Evaluation = results.result(folds, len(items))
+	c.succeedRun(params)
+}
+
+// trainingSet loads all objects with all classifyProperties set which match
+// the trainingSetWhere filter, they are sorted by id
+func (c *Classifier) trainingSet(ctx context.Context, kind kind.Kind,
+	params models.Classification, filters filters) ([]search.Result, error) {
+	var out []search.Result
+	pagination := &libfilters.Pagination{Limit: c.pageSize}
+	for {
+		page, err := c.vectorRepo.GetClassified(ctx, kind, params.Class,
+			params.ClassifyProperties, filters.trainingSet, pagination)
+		if err != nil {
+			return nil, fmt.Errorf("load training set: %v", err)
+		}
+
+		if len(page) == 0 {
+			return out, nil
+		}
+
+		for _, item := range page {
+			for _, prop := range params.ClassifyProperties {
+				if _, err := singleBeacon(item, prop); err != nil {
+					return nil, fmt.Errorf("load training set: %s/%s: %v",
+						item.ClassName, item.ID, err)
+				}
+			}
+		}
+
+		out = append(out, page...)
+		pagination.After = page[len(page)-1].ID
+	}
+}
+
+// predictFromOtherFolds classifies the item at the position based on its k
+// nearest neighbors which aren't part of the item's fold. The objects are
+// assigned to the folds round-robin in the order of their ids.
+func (c *Classifier) predictFromOtherFolds(items []search.Result, pos, folds,
+	k int, properties []string) (map[string]string, error) {
+	type neighbor struct {
+		item *search.Result
+		dist float32
+	}
+
+	var neighbors []neighbor
+	for i := range items {
+		if i%folds == pos%folds {
+			continue
+		}
+
+		dist, err := c.distancer(items[pos].Vector, items[i].Vector)
+		if err != nil {
+			return nil, fmt.Errorf("distance %s to %s: %v", items[pos].ID, items[i].ID, err)
+		}
+
+		neighbors = append(neighbors, neighbor{&items[i], dist})
+	}
+
+	sort.SliceStable(neighbors, func(a, b int) bool {
+		return neighbors[a].dist < neighbors[b].dist
+	})
+	if len(neighbors) > k {
+		neighbors = neighbors[:k]
+	}
+
+	out := map[string]string{}
+	for _, prop := range properties {
+		votes := map[string]int{}
+		for _, n := range neighbors {
+			beacon, _ := singleBeacon(*n.item, prop)
+			votes[beacon]++
+		}
+
+		out[prop] = mostVoted(votes)
+	}
+
+	return out, nil
+}
+
+// mostVoted breaks ties alphabetically to keep evaluations reproducible
+func mostVoted(votes map[string]int) string {
+	candidates := make([]string, 0, len(votes))
+	for beacon := range votes {
+		candidates = append(candidates, beacon)
+	}
+	sort.Strings(candidates)
+
+	var winner string
+	for _, beacon := range candidates {
+		if winner == "" || votes[beacon] > votes[winner] {
+			winner = beacon
+		}
+	}
+
+	return winner
+}
+
+func singleBeacon(item search.Result, prop string) (string, error) {
+	props, ok := item.Schema.(map[string]interface{})
+	if !ok {
+		return "", fmt.Errorf("expected schema to be map, got %T", item.Schema)
+	}
+
+	refs, ok := props[prop].(models.MultipleRef)
+	if !ok || len(refs) != 1 {
+		return "", fmt.Errorf("property '%s': expected exactly one reference", prop)
+	}
+
+	return refs[0].Beacon.String(), nil
+}
+
+// evaluation counts how often each actual beacon was predicted as which
+// beacon, all metrics are derived from these counts
+type evaluation struct {
+	// structure is [prop][actual][predicted]count
+	confusion map[string]map[string]map[string]int64
+	correct   int64
+	total     int64
+}
+
+func newEvaluation() *evaluation {
+	return &evaluation{confusion: map[string]map[string]map[string]int64{}}
+}
+
+func (e *evaluation) add(prop, actual, predicted string) {
+	if e.confusion[prop] == nil {
+		e.confusion[prop] = map[string]map[string]int64{}
+	}
+	if e.confusion[prop][actual] == nil {
+		e.confusion[prop][actual] = map[string]int64{}
+	}
+
+	e.confusion[prop][actual][predicted]++
+	e.total++
+	if actual == predicted {
+		e.correct++
+	}
+}
+
+func (e *evaluation) result(folds, count int) *models.ClassificationEvaluation {
+	out := &models.ClassificationEvaluation{
+		Folds:           int64(folds),
+		Count:           int64(count),
+		Labels:          []*models.ClassificationEvaluationLabel{},
+		ConfusionMatrix: []*models.ClassificationConfusionMatrixEntry{},
+	}
+
+	if e.total > 0 {
+		out.Accuracy = float64(e.correct) / float64(e.total)
+	}
+
+	props := make([]string, 0, len(e.confusion))
+	for prop := range e.confusion {
+		props = append(props, prop)
+	}
+	sort.Strings(props)
+
+	for _, prop := range props {
+		matrix := e.confusion[prop]
+		actualCounts := map[string]int64{}
+		predictedCounts := map[string]int64{}
+		for actual, row := range matrix {
+			for predicted, n := range row {
+				actualCounts[actual] += n
+				predictedCounts[predicted] += n
+			}
+		}
+
+		for _, label := range unionOfKeys(actualCounts, predictedCounts) {
+			truePositives := matrix[label][label]
+			result := &models.ClassificationEvaluationLabel{
+				Property: prop,
+				Beacon:   strfmt.URI(label),
+				Support:  actualCounts[label],
+			}
+			if predictedCounts[label] > 0 {
+				result.Precision = float64(truePositives) / float64(predictedCounts[label])
+			}
+			if actualCounts[label] > 0 {
+				result.Recall = float64(truePositives) / float64(actualCounts[label])
+			}
+			out.Labels = append(out.Labels, result)
+		}
+
+		for _, actual := range unionOfKeys(actualCounts) {
+			for _, predicted := range unionOfKeys(matrix[actual]) {
+				out.ConfusionMatrix = append(out.ConfusionMatrix,
+					&models.ClassificationConfusionMatrixEntry{
+						Property:  prop,
+						Actual:    strfmt.URI(actual),
+						Predicted: strfmt.URI(predicted),
+						Count:     matrix[actual][predicted],
+					})
+			}
+		}
+	}
+
+	return out
+}
+
+func unionOfKeys(maps ...map[string]int64) []string {
+	seen := map[string]bool{}
+	var out []string
+	for _, m := range maps {
+		for key := range m {
+			if !seen[key] {
+				seen[key] = true
+				out = append(out, key)
+			}
+		}
+	}
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,177 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package classification
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Classifier_Evaluate(t *testing.T) {
+	trainingSet := search.Results{
+		trainingItem("01", []float32{1, 0, 0}, idCategoryPolitics),
+		trainingItem("02", []float32{0.9, 0.1, 0}, idCategoryPolitics),
+		trainingItem("03", []float32{0.95, 0, 0.05}, idCategoryPolitics),
+		trainingItem("04", []float32{0, 0, 1}, idCategoryFoodAndDrink),
+		trainingItem("05", []float32{0.1, 0, 0.9}, idCategoryFoodAndDrink),
+		trainingItem("06", []float32{0, 0.05, 0.95}, idCategoryFoodAndDrink),
+	}
+	vectorRepo := newFakeVectorRepoKNN(testDataToBeClassified(), trainingSet)
+	classifier := New(&fakeSchemaGetter{testSchema()}, newFakeClassificationRepo(),
+		vectorRepo, &fakeAuthorizer{}, nil)
+
+	params := models.Classification{
+		Class:              "Article",
+		BasedOnProperties:  []string{"description"},
+		ClassifyProperties: []string{"exactCategory"},
+		K:                  ptInt(1),
+		Evaluate:           ptBool(true),
+		EvaluationFolds:    ptInt64(3),
+	}
+
+	class, err := classifier.Schedule(context.Background(), nil, params)
+	require.Nil(t, err)
+	waitForStatusToNoLongerBeRunning(t, classifier, class.ID)
+
+	res, err := classifier.Get(context.Background(), nil, class.ID)
+	require.Nil(t, err)
+	assert.Equal(t, models.ClassificationStatusCompleted, res.Status)
+	assert.Len(t, vectorRepo.db, 0, "nothing is stored")
+
+	require.NotNil(t, res.Evaluation)
+	assert.Equal(t, int64(3), res.Evaluation.Folds)
+	assert.Equal(t, int64(6), res.Evaluation.Count)
+	assert.Equal(t, 1.0, res.Evaluation.Accuracy)
+	require.Len(t, res.Evaluation.Labels, 2)
+	for _, label := range res.Evaluation.Labels {
+		assert.Equal(t, 1.0, label.Precision)
+		assert.Equal(t, 1.0, label.Recall)
+		assert.Equal(t, int64(3), label.Support)
+	}
+}
+
+func Test_Classifier_Evaluate_TooFewObjects(t *testing.T) {
+	vectorRepo := newFakeVectorRepoKNN(testDataToBeClassified(), testDataAlreadyClassified())
+	classifier := New(&fakeSchemaGetter{testSchema()}, newFakeClassificationRepo(),
+		vectorRepo, &fakeAuthorizer{}, nil)
+
+	class, err := classifier.Schedule(context.Background(), nil, models.Classification{
+		Class:              "Article",
+		BasedOnProperties:  []string{"description"},
+		ClassifyProperties: []string{"exactCategory"},
+		Evaluate:           ptBool(true),
+	})
+	require.Nil(t, err)
+	waitForStatusToNoLongerBeRunning(t, classifier, class.ID)
+
+	res, err := classifier.Get(context.Background(), nil, class.ID)
+	require.Nil(t, err)
+	assert.Equal(t, models.ClassificationStatusFailed, res.Status)
+	assert.Equal(t, "classification failed: training set has 3 objects, but at "+
+		"least one object per fold is required for 5 folds", res.Error)
+}
+
+func Test_Classifier_DryRun(t *testing.T) {
+	vectorRepo := newFakeVectorRepoKNN(testDataToBeClassified(), testDataAlreadyClassified())
+	classifier := New(&fakeSchemaGetter{testSchema()}, newFakeClassificationRepo(),
+		vectorRepo, &fakeAuthorizer{}, nil)
+
+	class, err := classifier.Schedule(context.Background(), nil, models.Classification{
+		Class:              "Article",
+		BasedOnProperties:  []string{"description"},
+		ClassifyProperties: []string{"exactCategory", "mainCategory"},
+		K:                  ptInt(1),
+		DryRun:             ptBool(true),
+	})
+	require.Nil(t, err)
+	waitForStatusToNoLongerBeRunning(t, classifier, class.ID)
+
+	res, err := classifier.Get(context.Background(), nil, class.ID)
+	require.Nil(t, err)
+	assert.Equal(t, models.ClassificationStatusCompleted, res.Status)
+	assert.Equal(t, int64(6), res.Meta.CountSucceeded)
+	assert.Len(t, vectorRepo.db, 0, "nothing is stored")
+	require.Len(t, res.Predictions, 12)
+
+	for _, prediction := range res.Predictions {
+		if prediction.ID == "75ba35af-6a08-40ae-b442-3bec69b355f9" &&
+			prediction.Property == "exactCategory" {
+			assert.Equal(t, beaconRef(idCategoryPolitics).Beacon, prediction.Beacon)
+		}
+	}
+}
+
+func Test_Classifier_DryRunMaxPredictions(t *testing.T) {
+	vectorRepo := newFakeVectorRepoKNN(testDataToBeClassified(), testDataAlreadyClassified())
+	classifier := New(&fakeSchemaGetter{testSchema()}, newFakeClassificationRepo(),
+		vectorRepo, &fakeAuthorizer{}, nil)
+	classifier.SetRunConfig(config.Classification{Workers: 2, PageSize: 2, MaxPredictions: 5})
+
+	class, err := classifier.Schedule(context.Background(), nil, models.Classification{
+		Class:              "Article",
+		BasedOnProperties:  []string{"description"},
+		ClassifyProperties: []string{"exactCategory", "mainCategory"},
+		K:                  ptInt(1),
+		DryRun:             ptBool(true),
+	})
+	require.Nil(t, err)
+	waitForStatusToNoLongerBeRunning(t, classifier, class.ID)
+
+	res, err := classifier.Get(context.Background(), nil, class.ID)
+	require.Nil(t, err)
+	assert.Equal(t, models.ClassificationStatusCompleted, res.Status)
+	assert.Equal(t, int64(6), res.Meta.CountSucceeded, "all items are counted")
+	assert.Len(t, res.Predictions, 5)
+}
+
+func Test_EvaluationMetrics(t *testing.T) {
+	e := newEvaluation()
+	e.add("prop", "a", "a")
+	e.add("prop", "a", "a")
+	e.add("prop", "a", "b")
+	e.add("prop", "b", "b")
+
+	res := e.result(2, 4)
+	assert.Equal(t, 0.75, res.Accuracy)
+	assert.Equal(t, []*models.ClassificationEvaluationLabel{
+		{Property: "prop", Beacon: "a", Precision: 1, Recall: 2.0 / 3, Support: 3},
+		{Property: "prop", Beacon: "b", Precision: 0.5, Recall: 1, Support: 1},
+	}, res.Labels)
+	assert.Equal(t, []*models.ClassificationConfusionMatrixEntry{
+		{Property: "prop", Actual: "a", Predicted: "a", Count: 2},
+		{Property: "prop", Actual: "a", Predicted: "b", Count: 1},
+		{Property: "prop", Actual: "b", Predicted: "b", Count: 1},
+	}, res.ConfusionMatrix)
+}
+
+func trainingItem(id string, vector []float32, category string) search.Result {
+	return search.Result{
+		ID:        strfmt.UUID(fmt.Sprintf("00000000-0000-0000-0000-0000000000%s", id)),
+		Kind:      kind.Thing,
+		ClassName: "Article",
+		Vector:    vector,
+		Schema: map[string]interface{}{
+			"exactCategory": models.MultipleRef{beaconRef(category)},
+		},
+	}
+}
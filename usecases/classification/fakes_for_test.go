@@ -27,6 +27,7 @@ import (
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/schema/kind"
 	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
 	"github.com/semi-technologies/weaviate/usecases/traverser"
 )
 
@@ -53,6 +54,13 @@ func (f *fakeClassificationRepo) Put(ctx context.Context, class models.Classific
 	f.Lock()
 	defer f.Unlock()
 
+	if class.Meta != nil {
+		// the real repos serialize the classification, so later changes to the
+		// meta must not be visible to readers
+		meta := *class.Meta
+		class.Meta = &meta
+	}
+
 	f.db[class.ID] = class
 	return nil
 }
@@ -69,6 +77,18 @@ func (f *fakeClassificationRepo) Get(ctx context.Context, id strfmt.UUID) (*mode
 	return &class, nil
 }
 
+func (f *fakeClassificationRepo) List(ctx context.Context) ([]models.Classification, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	out := make([]models.Classification, 0, len(f.db))
+	for _, class := range f.db {
+		out = append(out, class)
+	}
+
+	return out, nil
+}
+
 func newFakeVectorRepoKNN(unclassified, classified search.Results) *fakeVectorRepoKNN {
 	return &fakeVectorRepoKNN{
 		unclassified: unclassified,
@@ -85,16 +105,33 @@ type fakeVectorRepoKNN struct {
 	classified       []search.Result
 	db               map[strfmt.UUID]*models.Thing
 	errorOnAggregate error
+	errorOnStore     map[strfmt.UUID]error
+}
+
+func (f *fakeVectorRepoKNN) GetClassified(ctx context.Context,
+	k kind.Kind, class string, properties []string,
+	filter *libfilters.LocalFilter, pagination *libfilters.Pagination) ([]search.Result, error) {
+	if k != kind.Thing {
+		return nil, fmt.Errorf("unsupported kind in test fake: %v", k)
+	}
+
+	return paginate(f.classified, pagination), nil
 }
 
 func (f *fakeVectorRepoKNN) GetUnclassified(ctx context.Context,
 	k kind.Kind, class string, properties []string,
-	filter *libfilters.LocalFilter) ([]search.Result, error) {
+	filter *libfilters.LocalFilter, pagination *libfilters.Pagination) ([]search.Result, error) {
 	if k != kind.Thing {
 		return nil, fmt.Errorf("unsupported kind in test fake: %v", k)
 	}
 
-	return f.unclassified, nil
+	return paginate(f.unclassified, pagination), nil
+}
+
+func (f *fakeVectorRepoKNN) CountUnclassified(ctx context.Context,
+	k kind.Kind, class string, properties []string,
+	filter *libfilters.LocalFilter) (int64, error) {
+	return int64(len(f.unclassified)), nil
 }
 
 func (f *fakeVectorRepoKNN) AggregateNeighbors(ctx context.Context, vector []float32,
@@ -112,7 +149,8 @@ func (f *fakeVectorRepoKNN) AggregateNeighbors(ctx context.Context, vector []flo
 		return nil, fmt.Errorf("fake vector repo only supports k=1")
 	}
 
-	results := f.classified
+	// items are classified concurrently, so sort a copy
+	results := append([]search.Result{}, f.classified...)
 	sort.SliceStable(results, func(i, j int) bool {
 		simI, err := cosineSim(results[i].Vector, vector)
 		if err != nil {
@@ -134,7 +172,17 @@ func (f *fakeVectorRepoKNN) AggregateNeighbors(ctx context.Context, vector []flo
 			return nil, fmt.Errorf("missing prop %s", propName)
 		}
 
-		refs := prop.(models.MultipleRef)
+		refs, ok := prop.(models.MultipleRef)
+		if !ok {
+			out = append(out, NeighborRef{
+				Value:    prop,
+				Count:    1,
+				Total:    1,
+				Property: propName,
+			})
+			continue
+		}
+
 		if len(refs) != 1 {
 			return nil, fmt.Errorf("wrong length %d", len(refs))
 		}
@@ -142,6 +190,7 @@ func (f *fakeVectorRepoKNN) AggregateNeighbors(ctx context.Context, vector []flo
 		out = append(out, NeighborRef{
 			Beacon:   refs[0].Beacon,
 			Count:    1,
+			Total:    1,
 			Property: propName,
 		})
 	}
@@ -154,15 +203,22 @@ func (f *fakeVectorRepoKNN) VectorClassSearch(ctx context.Context,
 	return nil, fmt.Errorf("vector class search not implemented in fake")
 }
 
-func (f *fakeVectorRepoKNN) PutThing(ctx context.Context, thing *models.Thing, vector []float32) error {
+func (f *fakeVectorRepoKNN) BatchPutThings(ctx context.Context, batch kinds.BatchThings) (kinds.BatchThings, error) {
 	f.Lock()
 	defer f.Unlock()
-	f.db[thing.ID] = thing
-	return nil
+	for i, item := range batch {
+		if err, ok := f.errorOnStore[item.UUID]; ok {
+			batch[i].Err = err
+			continue
+		}
+
+		f.db[item.Thing.ID] = item.Thing
+	}
+	return batch, nil
 }
 
-func (f *fakeVectorRepoKNN) PutAction(ctx context.Context, thing *models.Action, vector []float32) error {
-	return fmt.Errorf("put action not implemented in fake")
+func (f *fakeVectorRepoKNN) BatchPutActions(ctx context.Context, batch kinds.BatchActions) (kinds.BatchActions, error) {
+	return nil, fmt.Errorf("batch put actions not implemented in fake")
 }
 
 func (f *fakeVectorRepoKNN) get(id strfmt.UUID) (*models.Thing, bool) {
@@ -225,12 +281,24 @@ func (f *fakeVectorRepoContextual) get(id strfmt.UUID) (*models.Thing, bool) {
 
 func (f *fakeVectorRepoContextual) GetUnclassified(ctx context.Context,
 	k kind.Kind, class string, properties []string,
-	filter *libfilters.LocalFilter) ([]search.Result, error) {
+	filter *libfilters.LocalFilter, pagination *libfilters.Pagination) ([]search.Result, error) {
 	if k != kind.Thing {
 		return nil, fmt.Errorf("unsupported kind in test fake: %v", k)
 	}
 
-	return f.unclassified, nil
+	return paginate(f.unclassified, pagination), nil
+}
+
+func (f *fakeVectorRepoContextual) CountUnclassified(ctx context.Context,
+	k kind.Kind, class string, properties []string,
+	filter *libfilters.LocalFilter) (int64, error) {
+	return int64(len(f.unclassified)), nil
+}
+
+func (f *fakeVectorRepoContextual) GetClassified(ctx context.Context,
+	k kind.Kind, class string, properties []string,
+	filter *libfilters.LocalFilter, pagination *libfilters.Pagination) ([]search.Result, error) {
+	panic("not implemented")
 }
 
 func (f *fakeVectorRepoContextual) AggregateNeighbors(ctx context.Context, vector []float32,
@@ -239,15 +307,17 @@ func (f *fakeVectorRepoContextual) AggregateNeighbors(ctx context.Context, vecto
 	panic("not implemented")
 }
 
-func (f *fakeVectorRepoContextual) PutThing(ctx context.Context, thing *models.Thing, vector []float32) error {
+func (f *fakeVectorRepoContextual) BatchPutThings(ctx context.Context, batch kinds.BatchThings) (kinds.BatchThings, error) {
 	f.Lock()
 	defer f.Unlock()
-	f.db[thing.ID] = thing
-	return nil
+	for _, item := range batch {
+		f.db[item.Thing.ID] = item.Thing
+	}
+	return batch, nil
 }
 
-func (f *fakeVectorRepoContextual) PutAction(ctx context.Context, thing *models.Action, vector []float32) error {
-	return fmt.Errorf("put action not implemented in fake")
+func (f *fakeVectorRepoContextual) BatchPutActions(ctx context.Context, batch kinds.BatchActions) (kinds.BatchActions, error) {
+	return nil, fmt.Errorf("batch put actions not implemented in fake")
 }
 
 func (f *fakeVectorRepoContextual) VectorClassSearch(ctx context.Context,
@@ -278,11 +348,82 @@ func (f *fakeVectorRepoContextual) VectorClassSearch(ctx context.Context,
 		return nil, f.errorOnAggregate
 	}
 
-	var out = []search.Result{
-		results[0],
+	if params.Pagination != nil && params.Pagination.Limit < len(results) {
+		results = results[:params.Pagination.Limit]
 	}
 
-	return out, f.errorOnAggregate
+	return results, f.errorOnAggregate
+}
+
+// newFakeContextionary knows the words of the test data which relate to
+// politics, society or food, all other words aren't present
+func newFakeContextionary() *fakeContextionary {
+	politics := []float32{1, 0, 0}
+	society := []float32{0, 1, 0}
+	food := []float32{0, 0, 1}
+
+	return &fakeContextionary{words: map[string][]float32{
+		"barack":    politics,
+		"obama":     politics,
+		"obamas":    politics,
+		"president": politics,
+		"johnny":    society,
+		"depp":      society,
+		"actor":     society,
+		"brad":      society,
+		"pitt":      society,
+		"movie":     society,
+		"ice":       food,
+		"cream":     food,
+		"sugar":     food,
+		"fries":     food,
+		"belgium":   food,
+		"france":    food,
+	}}
+}
+
+type fakeContextionary struct {
+	words map[string][]float32
+}
+
+func (f *fakeContextionary) IsWordPresent(ctx context.Context, word string) (bool, error) {
+	_, ok := f.words[word]
+	return ok, nil
+}
+
+func (f *fakeContextionary) VectorForWord(ctx context.Context, word string) ([]float32, error) {
+	vector, ok := f.words[word]
+	if !ok {
+		return nil, fmt.Errorf("word '%s' not present", word)
+	}
+	return vector, nil
+}
+
+// paginate sorts the items by id like the real repos do
+func paginate(in []search.Result, pagination *libfilters.Pagination) []search.Result {
+	items := append([]search.Result{}, in...)
+	sort.Slice(items, func(a, b int) bool {
+		return items[a].ID < items[b].ID
+	})
+
+	if pagination == nil {
+		return items
+	}
+
+	var out []search.Result
+	for _, item := range items {
+		if item.ID <= pagination.After {
+			continue
+		}
+
+		if len(out) == pagination.Limit {
+			break
+		}
+
+		out = append(out, item)
+	}
+
+	return out
 }
 
 func matchClassName(in []search.Result, className string) []search.Result {
@@ -0,0 +1,188 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package classification
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization"
+)
+
+// defaultTimeout in seconds for classifications which don't set a timeout
+const defaultTimeout = 30
+
+// ListFilter limits the classifications returned by List, empty fields
+// match all classifications
+type ListFilter struct {
+	Class  string
+	Status string
+}
+
+func (f ListFilter) matches(classification models.Classification) bool {
+	if f.Class != "" && f.Class != classification.Class {
+		return false
+	}
+
+	if f.Status != "" && f.Status != classification.Status {
+		return false
+	}
+
+	return true
+}
+
+// List the classifications matching the filter, most recently started first.
+// Classifications of classes the principal is not allowed to view are
+// omitted.
+func (c *Classifier) List(ctx context.Context, principal *models.Principal,
+	filter ListFilter) ([]models.Classification, error) {
+	all, err := c.repo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list classifications: %v", err)
+	}
+
+	allowed := map[string]bool{}
+	out := make([]models.Classification, 0, len(all))
+	for _, classification := range all {
+		if !filter.matches(classification) {
+			continue
+		}
+
+		ok, cached := allowed[classification.Class]
+		if !cached {
+			ok = c.authorizer.Authorize(principal, "get",
+				authorization.ClassificationResource(classification.Class)) == nil
+			allowed[classification.Class] = ok
+		}
+
+		if ok {
+			out = append(out, classification)
+		}
+	}
+
+	sort.SliceStable(out, func(a, b int) bool {
+		return startedAt(out[a]).After(startedAt(out[b]))
+	})
+
+	return out, nil
+}
+
+func startedAt(classification models.Classification) time.Time {
+	if classification.Meta == nil {
+		return time.Time{}
+	}
+
+	return time.Time(classification.Meta.Started)
+}
+
+// Cancel a running classification. Cancellation is cooperative: the
+// classification stops after the item it is currently classifying and is then
+// marked as cancelled.
+func (c *Classifier) Cancel(ctx context.Context, principal *models.Principal,
+	id strfmt.UUID) (_ *models.Classification, err error) {
+	classification, err := c.repo.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("cancel classification: %v", err)
+	}
+
+	if classification == nil {
+		err = c.authorizer.Authorize(principal, "delete",
+			authorization.ClassificationResource(authorization.AllClasses))
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, NewErrNotFound("classification '%s' does not exist", id)
+	}
+
+	defer func() {
+		c.audit(principal, "delete", *classification, err)
+	}()
+
+	err = c.authorizer.Authorize(principal, "delete",
+		authorization.ClassificationResource(classification.Class))
+	if err != nil {
+		return nil, err
+	}
+
+	if classification.Status != models.ClassificationStatusRunning ||
+		!c.cancelJob(id) {
+		return nil, NewErrNotRunning("classification '%s' is not running, "+
+			"its status is '%s'", id, classification.Status)
+	}
+
+	return classification, nil
+}
+
+// MarkInterrupted marks all classifications which are still running
+// according to the repo as interrupted. It is meant to be called on startup,
+// as no classification can survive a restart.
+func (c *Classifier) MarkInterrupted(ctx context.Context) error {
+	all, err := c.repo.List(ctx)
+	if err != nil {
+		return fmt.Errorf("list classifications: %v", err)
+	}
+
+	for _, classification := range all {
+		if classification.Status != models.ClassificationStatusRunning {
+			continue
+		}
+
+		classification.Status = models.ClassificationStatusInterrupted
+		classification.Error = "classification was interrupted by a restart"
+		if err := c.repo.Put(ctx, classification); err != nil {
+			return fmt.Errorf("mark classification '%s' as interrupted: %v",
+				classification.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func (c *Classifier) startJob(id strfmt.UUID, cancel context.CancelFunc) {
+	c.jobsLock.Lock()
+	defer c.jobsLock.Unlock()
+
+	c.jobs[id] = cancel
+}
+
+// cancelJob returns false if the job is not running in this process
+func (c *Classifier) cancelJob(id strfmt.UUID) bool {
+	c.jobsLock.Lock()
+	defer c.jobsLock.Unlock()
+
+	cancel, ok := c.jobs[id]
+	if !ok {
+		return false
+	}
+
+	cancel()
+	return true
+}
+
+// finishJob releases the resources of the job's context, it must be called
+// once the run is over
+func (c *Classifier) finishJob(id strfmt.UUID) {
+	c.jobsLock.Lock()
+	defer c.jobsLock.Unlock()
+
+	if cancel, ok := c.jobs[id]; ok {
+		cancel()
+		delete(c.jobs, id)
+	}
+}
@@ -0,0 +1,178 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package classification
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Classifier_List(t *testing.T) {
+	repo := newFakeClassificationRepo()
+	for _, class := range []models.Classification{
+		classificationRecord("1", "Article", models.ClassificationStatusCompleted, 1),
+		classificationRecord("2", "Article", models.ClassificationStatusRunning, 3),
+		classificationRecord("3", "Article", models.ClassificationStatusFailed, 2),
+		classificationRecord("4", "Secret", models.ClassificationStatusRunning, 4),
+	} {
+		require.Nil(t, repo.Put(context.Background(), class))
+	}
+
+	authorizer := &denyClassificationsOf{class: "Secret"}
+	classifier := New(&fakeSchemaGetter{testSchema()}, repo, nil, authorizer, nil)
+
+	t.Run("without a filter", func(t *testing.T) {
+		res, err := classifier.List(context.Background(), nil, ListFilter{})
+		require.Nil(t, err)
+		assert.Equal(t, []strfmt.UUID{"1", "3", "2"}, classificationIDs(res),
+			"forbidden classes are omitted, most recent first")
+	})
+
+	t.Run("filtered by status", func(t *testing.T) {
+		res, err := classifier.List(context.Background(), nil,
+			ListFilter{Status: models.ClassificationStatusRunning})
+		require.Nil(t, err)
+		assert.Equal(t, []strfmt.UUID{"2"}, classificationIDs(res))
+	})
+
+	t.Run("filtered by class", func(t *testing.T) {
+		res, err := classifier.List(context.Background(), nil,
+			ListFilter{Class: "Secret"})
+		require.Nil(t, err)
+		assert.Len(t, res, 0)
+	})
+}
+
+func Test_Classifier_Cancel(t *testing.T) {
+	sg := &fakeSchemaGetter{testSchema()}
+	repo := newFakeClassificationRepo()
+	vectorRepo := newFakeVectorRepoKNN(testDataToBeClassified(), testDataAlreadyClassified())
+	classifier := New(sg, repo, vectorRepo, &fakeAuthorizer{}, nil)
+	// one item at a time, so that the run is still going when it is cancelled
+	classifier.SetRunConfig(config.Classification{Workers: 1, PageSize: 1})
+
+	k := int32(1)
+	class, err := classifier.Schedule(context.Background(), nil, models.Classification{
+		Class:              "Article",
+		BasedOnProperties:  []string{"description"},
+		ClassifyProperties: []string{"exactCategory", "mainCategory"},
+		K:                  &k,
+	})
+	require.Nil(t, err)
+
+	t.Run("cancelling the running classification", func(t *testing.T) {
+		res, err := classifier.Cancel(context.Background(), nil, class.ID)
+		require.Nil(t, err)
+		assert.Equal(t, class.ID, res.ID)
+	})
+
+	waitForStatusToNoLongerBeRunning(t, classifier, class.ID)
+
+	t.Run("status is now cancelled", func(t *testing.T) {
+		res, err := classifier.Get(context.Background(), nil, class.ID)
+		require.Nil(t, err)
+		assert.Equal(t, models.ClassificationStatusCancelled, res.Status)
+		assert.True(t, res.Meta.Processed < res.Meta.Total,
+			"stopped before all items were processed")
+	})
+
+	t.Run("cancelling it again", func(t *testing.T) {
+		_, err := classifier.Cancel(context.Background(), nil, class.ID)
+		assert.IsType(t, ErrNotRunning{}, err)
+	})
+
+	t.Run("cancelling an unknown classification", func(t *testing.T) {
+		_, err := classifier.Cancel(context.Background(), nil, "unknown")
+		assert.IsType(t, ErrNotFound{}, err)
+	})
+}
+
+func Test_Classifier_MarkInterrupted(t *testing.T) {
+	repo := newFakeClassificationRepo()
+	require.Nil(t, repo.Put(context.Background(),
+		classificationRecord("1", "Article", models.ClassificationStatusRunning, 1)))
+	require.Nil(t, repo.Put(context.Background(),
+		classificationRecord("2", "Article", models.ClassificationStatusCompleted, 2)))
+	classifier := New(&fakeSchemaGetter{testSchema()}, repo, nil, &fakeAuthorizer{}, nil)
+
+	require.Nil(t, classifier.MarkInterrupted(context.Background()))
+
+	running, err := repo.Get(context.Background(), "1")
+	require.Nil(t, err)
+	assert.Equal(t, models.ClassificationStatusInterrupted, running.Status)
+
+	completed, err := repo.Get(context.Background(), "2")
+	require.Nil(t, err)
+	assert.Equal(t, models.ClassificationStatusCompleted, completed.Status)
+}
+
+func Test_ProgressReporter(t *testing.T) {
+	repo := newFakeClassificationRepo()
+	reporter := newProgressReporter(repo, 0)
+	reporter.started = time.Now().Add(-10 * time.Second)
+	params := classificationRecord("1", "Article", models.ClassificationStatusRunning, 1)
+	params.Meta.Total = 20
+
+	reporter.report(&params, 7, 2, 1)
+
+	stored, err := repo.Get(context.Background(), "1")
+	require.Nil(t, err)
+	assert.Equal(t, int64(10), stored.Meta.Processed)
+	assert.Equal(t, int64(7), stored.Meta.CountSucceeded)
+	assert.Equal(t, int64(2), stored.Meta.CountFailed)
+	assert.Equal(t, int64(1), stored.Meta.CountUnclassified)
+	assert.WithinDuration(t, time.Now().Add(10*time.Second),
+		time.Time(stored.Meta.EstimatedCompletion), time.Second)
+}
+
+type denyClassificationsOf struct {
+	class string
+}
+
+func (d *denyClassificationsOf) Authorize(principal *models.Principal, verb, resource string) error {
+	if resource == "classifications/"+d.class {
+		return errors.New("forbidden")
+	}
+
+	return nil
+}
+
+func classificationRecord(id strfmt.UUID, class, status string,
+	startedMinutesAgo int) models.Classification {
+	return models.Classification{
+		ID:     id,
+		Class:  class,
+		Status: status,
+		Meta: &models.ClassificationMeta{
+			Started: strfmt.DateTime(time.Now().Add(
+				-time.Duration(startedMinutesAgo) * time.Minute)),
+		},
+	}
+}
+
+func classificationIDs(in []models.Classification) []strfmt.UUID {
+	out := make([]strfmt.UUID, len(in))
+	for i, class := range in {
+		out[i] = class.ID
+	}
+	return out
+}
@@ -0,0 +1,46 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package classification
+
+import (
+	"time"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/monitoring"
+)
+
+// observeRun records the duration and the item counts of a classification run
+// which has just reached its final status
+func observeRun(params models.Classification) {
+	if params.Meta == nil {
+		return
+	}
+
+	var classificationType string
+	if params.Type != nil {
+		classificationType = *params.Type
+	}
+
+	metrics := monitoring.GetMetrics()
+	started := time.Time(params.Meta.Started)
+	metrics.ClassificationDuration.
+		WithLabelValues(params.Class, classificationType, params.Status).
+		Observe(time.Since(started).Seconds())
+	metrics.ClassificationObjects.
+		WithLabelValues(params.Class, classificationType, "succeeded").
+		Add(float64(params.Meta.CountSucceeded))
+	metrics.ClassificationObjects.
+		WithLabelValues(params.Class, classificationType, "failed").
+		Add(float64(params.Meta.CountFailed))
+}
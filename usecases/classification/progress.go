@@ -0,0 +1,76 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package classification
+
+import (
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+)
+
+// progressReporter updates the progress of a running classification and
+// persists it at most once per interval
+type progressReporter struct {
+	repo     Repo
+	interval time.Duration
+	started  time.Time
+	lastPut  time.Time
+}
+
+func newProgressReporter(repo Repo, interval time.Duration) *progressReporter {
+	now := time.Now()
+	return &progressReporter{
+		repo:     repo,
+		interval: interval,
+		started:  now,
+		lastPut:  now,
+	}
+}
+
+func (p *progressReporter) report(params *models.Classification, succeeded,
+	failed, unclassified int64) {
+	processed := succeeded + failed + unclassified
+	params.Meta.Processed = processed
+	params.Meta.CountSucceeded = succeeded
+	params.Meta.CountFailed = failed
+	params.Meta.CountUnclassified = unclassified
+	params.Meta.EstimatedCompletion = strfmt.DateTime(
+		p.estimateCompletion(processed, params.Meta.Total))
+
+	if time.Since(p.lastPut) < p.interval {
+		return
+	}
+
+	ctx, cancel := contextWithTimeout(2 * time.Second)
+	defer cancel()
+	if err := p.repo.Put(ctx, *params); err != nil {
+		// progress is only informational, the final state is persisted anyway
+		return
+	}
+
+	p.lastPut = time.Now()
+}
+
+// estimateCompletion assumes that the remaining items take as long as the
+// average of the processed ones
+func (p *progressReporter) estimateCompletion(processed, total int64) time.Time {
+	now := time.Now()
+	if processed == 0 {
+		return now
+	}
+
+	perItem := now.Sub(p.started) / time.Duration(processed)
+	return now.Add(perItem * time.Duration(total-processed))
+}
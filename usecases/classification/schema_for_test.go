@@ -34,6 +34,10 @@ func testSchema() schema.Schema {
 				&models.Class{
 					Class: "MainCategory",
 				},
+				&models.Class{
+					Class:      "Tag",
+					Vectorizer: models.ClassVectorizerText2vecHTTP,
+				},
 				&models.Class{
 					Class: "Article",
 					Properties: []*models.Property{
@@ -62,6 +66,26 @@ func testSchema() schema.Schema {
 							Name:     "anyCategory",
 							DataType: []string{"MainCategory", "ExactCategory"},
 						},
+						&models.Property{
+							Name:     "tag",
+							DataType: []string{"Tag"},
+						},
+						&models.Property{
+							Name:     "category",
+							DataType: []string{string(schema.DataTypeString)},
+						},
+						&models.Property{
+							Name:     "breaking",
+							DataType: []string{string(schema.DataTypeBoolean)},
+						},
+						&models.Property{
+							Name:     "wordCount",
+							DataType: []string{string(schema.DataTypeInt)},
+						},
+						&models.Property{
+							Name:     "score",
+							DataType: []string{string(schema.DataTypeNumber)},
+						},
 					},
 				},
 			},
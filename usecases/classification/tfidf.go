@@ -0,0 +1,151 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package classification
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// tokenize splits the text into lowercase words, every character which is
+// neither a letter nor a digit separates words
+func tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// tfidfCorpus counts in how many documents each word occurs, so that the
+// words of a single document can be scored by their TF-IDF
+type tfidfCorpus struct {
+	documents   int
+	frequencies map[string]int
+}
+
+func newTfidfCorpus() *tfidfCorpus {
+	return &tfidfCorpus{frequencies: map[string]int{}}
+}
+
+func (c *tfidfCorpus) add(words []string) {
+	c.documents++
+	seen := map[string]bool{}
+	for _, word := range words {
+		if seen[word] {
+			continue
+		}
+
+		seen[word] = true
+		c.frequencies[word]++
+	}
+}
+
+// scores of every distinct word of the document. The idf is smoothed, so that
+// words which weren't part of the corpus and words which occur in every
+// document still have a positive score.
+func (c *tfidfCorpus) scores(words []string) map[string]float64 {
+	counts := map[string]int{}
+	for _, word := range words {
+		counts[word]++
+	}
+
+	out := make(map[string]float64, len(counts))
+	for word, count := range counts {
+		tf := float64(count) / float64(len(words))
+		idf := math.Log(float64(1+c.documents)/float64(1+c.frequencies[word])) + 1
+		out[word] = tf * idf
+	}
+
+	return out
+}
+
+// scoredWord is a word of a document with its vector and its scores so far
+type scoredWord struct {
+	word            string
+	vector          []float32
+	tfidf           float64
+	informationGain float64
+	weight          float64
+}
+
+// cutoff keeps the words whose score is at least the percentile of all
+// scores, but never fewer than minimum words if there are as many. The words
+// are returned by descending score.
+func cutoff(words []scoredWord, score func(w scoredWord) float64,
+	percentile int64, minimum int) []scoredWord {
+	if len(words) == 0 {
+		return words
+	}
+
+	sorted := append([]scoredWord{}, words...)
+	sort.SliceStable(sorted, func(a, b int) bool {
+		return score(sorted[a]) > score(sorted[b])
+	})
+
+	threshold := percentileOf(sorted, score, percentile)
+	keep := 0
+	for keep < len(sorted) && score(sorted[keep]) >= threshold {
+		keep++
+	}
+
+	if keep < minimum {
+		keep = minimum
+	}
+
+	if keep > len(sorted) {
+		keep = len(sorted)
+	}
+
+	return sorted[:keep]
+}
+
+// percentileOf uses the nearest-rank method on words which are sorted by
+// descending score
+func percentileOf(sorted []scoredWord, score func(w scoredWord) float64,
+	percentile int64) float64 {
+	rank := int(math.Ceil(float64(percentile) / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+
+	// the ranks are ascending, the words are sorted descending
+	return score(sorted[len(sorted)-rank])
+}
+
+// weightedMean of the word vectors, all vectors need to have the same length
+func weightedMean(words []scoredWord) []float32 {
+	if len(words) == 0 {
+		return nil
+	}
+
+	out := make([]float32, len(words[0].vector))
+	var total float64
+	for _, word := range words {
+		total += word.weight
+		for i, value := range word.vector {
+			out[i] += value * float32(word.weight)
+		}
+	}
+
+	if total == 0 {
+		return out
+	}
+
+	for i := range out {
+		out[i] = out[i] / float32(total)
+	}
+
+	return out
+}
@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package classification
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Tokenize(t *testing.T) {
+	assert.Equal(t, []string{"barack", "obama", "is", "a", "former", "us", "president"},
+		tokenize("Barack Obama is a former US-President"))
+	assert.Equal(t, []string{"ice", "cream", "2019"}, tokenize("  Ice-Cream (2019)!"))
+	assert.Len(t, tokenize(""), 0)
+}
+
+func Test_TfidfCorpus(t *testing.T) {
+	corpus := newTfidfCorpus()
+	corpus.add([]string{"the", "president"})
+	corpus.add([]string{"the", "actor"})
+	corpus.add([]string{"the", "sugar", "sugar"})
+
+	scores := corpus.scores([]string{"the", "sugar", "sugar"})
+	assert.True(t, scores["sugar"] > scores["the"],
+		"a frequent word which is rare in the corpus scores higher")
+	assert.InDelta(t, 1.0/3, scores["the"], 0.0001,
+		"a word in every document has an idf of 1")
+
+	scores = corpus.scores([]string{"unseen"})
+	assert.True(t, scores["unseen"] > 0, "unseen words still score")
+}
+
+func Test_Cutoff(t *testing.T) {
+	words := []scoredWord{
+		{word: "a", tfidf: 0.1},
+		{word: "b", tfidf: 0.4},
+		{word: "c", tfidf: 0.3},
+		{word: "d", tfidf: 0.2},
+		{word: "e", tfidf: 0.5},
+	}
+
+	t.Run("keeping the top percentile", func(t *testing.T) {
+		out := cutoff(words, byTfidf, 60, 1)
+		assert.Equal(t, []string{"e", "b", "c"}, wordsOf(out))
+	})
+
+	t.Run("keeping the minimum number of words", func(t *testing.T) {
+		out := cutoff(words, byTfidf, 100, 2)
+		assert.Equal(t, []string{"e", "b"}, wordsOf(out))
+	})
+
+	t.Run("with fewer words than the minimum", func(t *testing.T) {
+		out := cutoff(words[:2], byTfidf, 100, 3)
+		assert.Equal(t, []string{"b", "a"}, wordsOf(out))
+	})
+}
+
+func Test_WeightedMean(t *testing.T) {
+	out := weightedMean([]scoredWord{
+		{vector: []float32{1, 0}, weight: 3},
+		{vector: []float32{0, 1}, weight: 1},
+	})
+	assert.InDeltaSlice(t, []float32{0.75, 0.25}, out, 0.0001)
+}
+
+func wordsOf(in []scoredWord) []string {
+	out := make([]string, len(in))
+	for i, word := range in {
+		out[i] = word.word
+	}
+	return out
+}
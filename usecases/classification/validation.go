@@ -61,17 +61,28 @@ func (v *Validator) validate() {
 		return
 	}
 
-	v.contextualTypeFeasibility()
+	v.contextualTypeFeasibility(class)
 	v.knnTypeFeasibility()
+	v.contextualSettings()
+	v.evaluation()
+	v.timeout()
 	v.basedOnProperties(class)
 	v.classifyProperties(class)
 }
 
-func (v *Validator) contextualTypeFeasibility() {
+func (v *Validator) contextualTypeFeasibility(class *models.Class) {
 	if !v.typeContextual() {
 		return
 	}
 
+	// the text of the basedOnProperties is vectorized word by word with the
+	// contextionary, so the targets need to be in the same space
+	if vectorizer := schemaUC.Vectorizer(class); vectorizer != models.ClassVectorizerContextionary {
+		v.errors.addf("class '%s' uses vectorizer '%s', classification of type "+
+			"'contextual' requires vectorizer '%s'", class.Class, vectorizer,
+			models.ClassVectorizerContextionary)
+	}
+
 	if v.subject.K != nil {
 		v.errors.addf("field 'k' can only be set for type 'knn', but got type 'contextual'")
 	}
@@ -91,6 +102,78 @@ func (v *Validator) knnTypeFeasibility() {
 	}
 }
 
+func (v *Validator) contextualSettings() {
+	settings := map[string]*int64{
+		"tfidfCutoffPercentile":           v.subject.TfidfCutoffPercentile,
+		"informationGainCutoffPercentile": v.subject.InformationGainCutoffPercentile,
+		"informationGainMaximumBoost":     v.subject.InformationGainMaximumBoost,
+		"minimumUsableWords":              v.subject.MinimumUsableWords,
+	}
+
+	if !v.typeContextual() {
+		for _, name := range []string{"tfidfCutoffPercentile",
+			"informationGainCutoffPercentile", "informationGainMaximumBoost",
+			"minimumUsableWords"} {
+			if settings[name] != nil {
+				v.errors.addf("field '%s' can only be set for type 'contextual'", name)
+			}
+		}
+
+		if v.subject.MinimumCertainty != 0 {
+			v.errors.addf("field 'minimumCertainty' can only be set for type 'contextual'")
+		}
+		return
+	}
+
+	for _, name := range []string{"tfidfCutoffPercentile", "informationGainCutoffPercentile"} {
+		if p := settings[name]; p != nil && (*p < 0 || *p > 100) {
+			v.errors.addf("field '%s' must be a percentile between 0 and 100, got %d", name, *p)
+		}
+	}
+
+	if b := v.subject.InformationGainMaximumBoost; b != nil && *b < 1 {
+		v.errors.addf("field 'informationGainMaximumBoost' must be at least 1, got %d", *b)
+	}
+
+	if w := v.subject.MinimumUsableWords; w != nil && *w < 1 {
+		v.errors.addf("field 'minimumUsableWords' must be at least 1, got %d", *w)
+	}
+
+	if c := v.subject.MinimumCertainty; c < 0 || c > 1 {
+		v.errors.addf("field 'minimumCertainty' must be between 0 and 1, got %v", c)
+	}
+}
+
+func (v *Validator) evaluation() {
+	evaluate := v.subject.Evaluate != nil && *v.subject.Evaluate
+	if !evaluate {
+		if v.subject.EvaluationFolds != nil {
+			v.errors.addf("field 'evaluationFolds' can only be set if 'evaluate' is true")
+		}
+		return
+	}
+
+	if !v.typeKNN() {
+		v.errors.addf("field 'evaluate' can only be set for type 'knn'")
+	}
+
+	if v.subject.DryRun != nil && *v.subject.DryRun {
+		v.errors.addf("fields 'evaluate' and 'dryRun' cannot be combined, " +
+			"an evaluation never stores any references")
+	}
+
+	if f := v.subject.EvaluationFolds; f != nil && *f < 2 {
+		v.errors.addf("field 'evaluationFolds' must be at least 2, got %d", *f)
+	}
+}
+
+func (v *Validator) timeout() {
+	if v.subject.Timeout != nil && *v.subject.Timeout <= 0 {
+		v.errors.addf("field 'timeout' must be a positive number of seconds, got %d",
+			*v.subject.Timeout)
+	}
+}
+
 func (v *Validator) basedOnProperties(class *models.Class) {
 	if v.subject.BasedOnProperties == nil || len(v.subject.BasedOnProperties) == 0 {
 		v.errors.addf("basedOnProperties must have at least one property")
@@ -157,7 +240,7 @@ func (v *Validator) classifyProperty(class *models.Class, propName string) {
 	}
 
 	if dt.IsPrimitive() {
-		v.errors.addf("classifyProperties: property '%s' must be of reference type (cref)", propName)
+		v.classifyPrimitiveProperty(propName, dt.AsPrimitive())
 		return
 	}
 
@@ -173,6 +256,49 @@ func (v *Validator) classifyProperty(class *models.Class, propName string) {
 				" has more than one target class, classification of type 'contextual' requires exactly one target class", propName)
 			return
 		}
+
+		v.sameVectorizer(class, propName, dt.Classes()[0])
+	}
+}
+
+// classifyPrimitiveProperty makes sure the value of the property can be
+// determined by a majority vote of the neighbors
+func (v *Validator) classifyPrimitiveProperty(propName string, dt schema.DataType) {
+	if !v.typeKNN() {
+		v.errors.addf("classifyProperties: property '%s' must be of reference type (cref), "+
+			"only classifications of type 'knn' support primitive properties", propName)
+		return
+	}
+
+	if v.subject.Evaluate != nil && *v.subject.Evaluate {
+		v.errors.addf("classifyProperties: property '%s' must be of reference type (cref), "+
+			"evaluations only support reference properties", propName)
+		return
+	}
+
+	switch dt {
+	case schema.DataTypeString, schema.DataTypeText, schema.DataTypeBoolean, schema.DataTypeInt:
+	default:
+		v.errors.addf("classifyProperties: property '%s' is of type '%s', only references "+
+			"and properties of type string, text, boolean or int can be classified", propName, dt)
+	}
+}
+
+// sameVectorizer makes sure the vectors of the target class can be compared
+// with the vectors of the classified class, which is only the case if both
+// are built by the same vectorizer
+func (v *Validator) sameVectorizer(class *models.Class, propName string,
+	targetName schema.ClassName) {
+	target := v.schema.FindClassByName(targetName)
+	if target == nil {
+		return
+	}
+
+	if schemaUC.Vectorizer(class) != schemaUC.Vectorizer(target) {
+		v.errors.addf("classifyProperties: property '%s': target class '%s' uses "+
+			"vectorizer '%s', but class '%s' uses vectorizer '%s', classification of "+
+			"type 'contextual' requires both to use the same vectorizer", propName,
+			target.Class, schemaUC.Vectorizer(target), class.Class, schemaUC.Vectorizer(class))
 	}
 }
 
@@ -57,6 +57,16 @@ func Test_ValidateUserInput(t *testing.T) {
 			},
 			expectedError: fmt.Errorf("invalid classification: basedOnProperties must have at least one property"),
 		},
+		testcase{
+			name: "timeout is not positive",
+			input: models.Classification{
+				Class:              "Article",
+				BasedOnProperties:  []string{"description"},
+				ClassifyProperties: []string{"exactCategory"},
+				Timeout:            ptInt64(0),
+			},
+			expectedError: fmt.Errorf("invalid classification: field 'timeout' must be a positive number of seconds, got 0"),
+		},
 
 		testcase{
 			name: "more than one basedOnProperty",
@@ -120,13 +130,23 @@ func Test_ValidateUserInput(t *testing.T) {
 		},
 
 		testcase{
-			name: "classifyProperties is not of reference type",
+			name: "classifyProperties is of an unsupported primitive type",
+			input: models.Classification{
+				Class:              "Article",
+				BasedOnProperties:  []string{"description"},
+				ClassifyProperties: []string{"score"},
+			},
+			expectedError: fmt.Errorf("invalid classification: classifyProperties: property 'score' is of type 'number', only references and properties of type string, text, boolean or int can be classified"),
+		},
+		testcase{
+			name: "classifyProperties is primitive, but evaluate is set",
 			input: models.Classification{
 				Class:              "Article",
 				BasedOnProperties:  []string{"description"},
-				ClassifyProperties: []string{"name"},
+				ClassifyProperties: []string{"category"},
+				Evaluate:           ptBool(true),
 			},
-			expectedError: fmt.Errorf("invalid classification: classifyProperties: property 'name' must be of reference type (cref)"),
+			expectedError: fmt.Errorf("invalid classification: classifyProperties: property 'category' must be of reference type (cref), evaluations only support reference properties"),
 		},
 
 		testcase{
@@ -157,6 +177,38 @@ func Test_ValidateUserInput(t *testing.T) {
 			},
 			expectedError: fmt.Errorf("invalid classification: type is 'knn', but 'targetWhere' filter is set, for 'knn' you cannot limit target data directly, instead limit training data through setting 'trainingSetWhere'"),
 		},
+		testcase{
+			name: "evaluate and dryRun are combined",
+			input: models.Classification{
+				Class:              "Article",
+				BasedOnProperties:  []string{"description"},
+				ClassifyProperties: []string{"exactCategory"},
+				Evaluate:           ptBool(true),
+				DryRun:             ptBool(true),
+			},
+			expectedError: fmt.Errorf("invalid classification: fields 'evaluate' and 'dryRun' cannot be combined, an evaluation never stores any references"),
+		},
+		testcase{
+			name: "evaluate with too few folds",
+			input: models.Classification{
+				Class:              "Article",
+				BasedOnProperties:  []string{"description"},
+				ClassifyProperties: []string{"exactCategory"},
+				Evaluate:           ptBool(true),
+				EvaluationFolds:    ptInt64(1),
+			},
+			expectedError: fmt.Errorf("invalid classification: field 'evaluationFolds' must be at least 2, got 1"),
+		},
+		testcase{
+			name: "evaluationFolds without evaluate",
+			input: models.Classification{
+				Class:              "Article",
+				BasedOnProperties:  []string{"description"},
+				ClassifyProperties: []string{"exactCategory"},
+				EvaluationFolds:    ptInt64(3),
+			},
+			expectedError: fmt.Errorf("invalid classification: field 'evaluationFolds' can only be set if 'evaluate' is true"),
+		},
 
 		// specific for contextual
 		testcase{
@@ -170,6 +222,17 @@ func Test_ValidateUserInput(t *testing.T) {
 			expectedError: fmt.Errorf("invalid classification: classifyProperties: property 'anyCategory' has more than one target class, classification of type 'contextual' requires exactly one target class"),
 		},
 
+		testcase{
+			name: "target class uses a different vectorizer",
+			input: models.Classification{
+				Class:              "Article",
+				BasedOnProperties:  []string{"description"},
+				ClassifyProperties: []string{"tag"},
+				Type:               ptString("contextual"),
+			},
+			expectedError: fmt.Errorf("invalid classification: classifyProperties: property 'tag': target class 'Tag' uses vectorizer 'text2vec-http', but class 'Article' uses vectorizer 'contextionary', classification of type 'contextual' requires both to use the same vectorizer"),
+		},
+
 		testcase{
 			name: "type is contextual, but k is set",
 			input: models.Classification{
@@ -181,6 +244,59 @@ func Test_ValidateUserInput(t *testing.T) {
 			},
 			expectedError: fmt.Errorf("invalid classification: field 'k' can only be set for type 'knn', but got type 'contextual'"),
 		},
+		testcase{
+			name: "type is knn, but a contextual setting is set",
+			input: models.Classification{
+				Class:                 "Article",
+				BasedOnProperties:     []string{"description"},
+				ClassifyProperties:    []string{"exactCategory"},
+				TfidfCutoffPercentile: ptInt64(80),
+			},
+			expectedError: fmt.Errorf("invalid classification: field 'tfidfCutoffPercentile' can only be set for type 'contextual'"),
+		},
+		testcase{
+			name: "type is contextual, but the percentile is out of range",
+			input: models.Classification{
+				Class:                           "Article",
+				BasedOnProperties:               []string{"description"},
+				ClassifyProperties:              []string{"exactCategory"},
+				Type:                            ptString("contextual"),
+				InformationGainCutoffPercentile: ptInt64(120),
+			},
+			expectedError: fmt.Errorf("invalid classification: field 'informationGainCutoffPercentile' must be a percentile between 0 and 100, got 120"),
+		},
+		testcase{
+			name: "type is contextual, but the minimum certainty is out of range",
+			input: models.Classification{
+				Class:              "Article",
+				BasedOnProperties:  []string{"description"},
+				ClassifyProperties: []string{"exactCategory"},
+				Type:               ptString("contextual"),
+				MinimumCertainty:   1.5,
+			},
+			expectedError: fmt.Errorf("invalid classification: field 'minimumCertainty' must be between 0 and 1, got 1.5"),
+		},
+		testcase{
+			name: "type is contextual, but classifyProperties is primitive",
+			input: models.Classification{
+				Class:              "Article",
+				BasedOnProperties:  []string{"description"},
+				ClassifyProperties: []string{"category"},
+				Type:               ptString("contextual"),
+			},
+			expectedError: fmt.Errorf("invalid classification: classifyProperties: property 'category' must be of reference type (cref), only classifications of type 'knn' support primitive properties"),
+		},
+		testcase{
+			name: "type is contextual, but evaluate is set",
+			input: models.Classification{
+				Class:              "Article",
+				BasedOnProperties:  []string{"description"},
+				ClassifyProperties: []string{"exactCategory"},
+				Type:               ptString("contextual"),
+				Evaluate:           ptBool(true),
+			},
+			expectedError: fmt.Errorf("invalid classification: field 'evaluate' can only be set for type 'knn'"),
+		},
 		testcase{
 			name: "trainingSetWhere is set",
 			input: models.Classification{
@@ -208,6 +324,14 @@ func ptInt(in int) *int32 {
 	return &a
 }
 
+func ptInt64(in int64) *int64 {
+	return &in
+}
+
 func ptString(in string) *string {
 	return &in
 }
+
+func ptBool(in bool) *bool {
+	return &in
+}
@@ -0,0 +1,78 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package config
+
+import "fmt"
+
+// Audit configures the audit log of mutating operations. It is independent
+// of telemetry.
+type Audit struct {
+	Enabled bool      `json:"enabled" yaml:"enabled"`
+	Sink    string    `json:"sink" yaml:"sink"`
+	File    AuditFile `json:"file" yaml:"file"`
+}
+
+// AuditFile configures the "file" audit sink. The file is rotated once it
+// exceeds MaxSizeMB, keeping at most MaxBackups rotated files.
+type AuditFile struct {
+	Path       string `json:"path" yaml:"path"`
+	MaxSizeMB  int    `json:"max_size_mb" yaml:"max_size_mb"`
+	MaxBackups int    `json:"max_backups" yaml:"max_backups"`
+}
+
+const (
+	// AuditSinkFile writes audit events to a rotating local file
+	AuditSinkFile = "file"
+	// AuditSinkStdout writes audit events to stdout
+	AuditSinkStdout = "stdout"
+)
+
+// SetDefaults for optional fields
+func (a *Audit) SetDefaults() {
+	if a.Sink == "" {
+		a.Sink = AuditSinkFile
+	}
+
+	if a.File.MaxSizeMB == 0 {
+		a.File.MaxSizeMB = 100
+	}
+
+	if a.File.MaxBackups == 0 {
+		a.File.MaxBackups = 5
+	}
+}
+
+// Validate the audit configuration, this requires defaults to be set.
+func (a Audit) Validate() error {
+	if !a.Enabled {
+		return nil
+	}
+
+	switch a.Sink {
+	case AuditSinkFile:
+		if a.File.Path == "" {
+			return fmt.Errorf("audit: sink 'file' requires 'file.path' to be set")
+		}
+
+		if a.File.MaxSizeMB < 0 || a.File.MaxBackups < 0 {
+			return fmt.Errorf("audit: 'file.max_size_mb' and 'file.max_backups' cannot be negative")
+		}
+	case AuditSinkStdout:
+	default:
+		return fmt.Errorf("audit: unsupported sink '%s', must be one of '%s', '%s'",
+			a.Sink, AuditSinkFile, AuditSinkStdout)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package config
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfig_Audit(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		assert.Nil(t, Audit{}.Validate())
+	})
+
+	t.Run("defaults to the file sink", func(t *testing.T) {
+		audit := Audit{Enabled: true, File: AuditFile{Path: "/tmp/audit.log"}}
+		audit.SetDefaults()
+
+		assert.Equal(t, AuditSinkFile, audit.Sink)
+		assert.Equal(t, 100, audit.File.MaxSizeMB)
+		assert.Equal(t, 5, audit.File.MaxBackups)
+		assert.Nil(t, audit.Validate())
+	})
+
+	t.Run("file sink without a path", func(t *testing.T) {
+		audit := Audit{Enabled: true}
+		audit.SetDefaults()
+
+		expected := fmt.Errorf("audit: sink 'file' requires 'file.path' to be set")
+		assert.Equal(t, expected, audit.Validate())
+	})
+
+	t.Run("unsupported sink", func(t *testing.T) {
+		audit := Audit{Enabled: true, Sink: "kafka"}
+		audit.SetDefaults()
+
+		expected := fmt.Errorf("audit: unsupported sink 'kafka', must be one of 'file', 'stdout'")
+		assert.Equal(t, expected, audit.Validate())
+	})
+}
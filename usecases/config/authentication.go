@@ -19,6 +19,7 @@ import "fmt"
 type Authentication struct {
 	OIDC            OIDC            `json:"oidc" yaml:"oidc"`
 	AnonymousAccess AnonymousAccess `json:"anonymous_access" yaml:"anonymous_access"`
+	APIKey          APIKey          `json:"apikey" yaml:"apikey"`
 }
 
 // Validate the Authentication configuration. This only validates at a general
@@ -33,7 +34,7 @@ func (a Authentication) Validate() error {
 }
 
 func (a Authentication) anyAuthMethodSelected() bool {
-	return a.AnonymousAccess.Enabled || a.OIDC.Enabled
+	return a.AnonymousAccess.Enabled || a.OIDC.Enabled || a.APIKey.Enabled
 }
 
 // AnonymousAccess considers users without any auth information as
@@ -53,3 +54,21 @@ type OIDC struct {
 	UsernameClaim     string `yaml:"username_claim" json:"username_claim"`
 	GroupsClaim       string `yaml:"groups_claim" json:"groups_claim"`
 }
+
+// APIKey configures static api keys, each of which authenticates as the
+// specified user and groups. Keys can either be set inline or in a separate
+// keys file which is reloaded when it changes, so keys can be rotated without
+// a restart.
+type APIKey struct {
+	Enabled  bool          `json:"enabled" yaml:"enabled"`
+	Keys     []APIKeyEntry `json:"keys" yaml:"keys"`
+	KeysFile string        `json:"keys_file" yaml:"keys_file"`
+}
+
+// APIKeyEntry maps a single key to a principal. Multiple keys can map to the
+// same user which allows for rotating keys.
+type APIKeyEntry struct {
+	Key      string   `json:"key" yaml:"key"`
+	Username string   `json:"username" yaml:"username"`
+	Groups   []string `json:"groups" yaml:"groups"`
+}
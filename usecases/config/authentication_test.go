@@ -54,6 +54,18 @@ func TestConfig_Authentication(t *testing.T) {
 		assert.Nil(t, err, "should not error")
 	})
 
+	t.Run("only apikey selected", func(t *testing.T) {
+		auth := Authentication{
+			APIKey: APIKey{
+				Enabled: true,
+			},
+		}
+
+		err := auth.Validate()
+
+		assert.Nil(t, err, "should not error")
+	})
+
 	t.Run("oidc and anonymous enabled together", func(t *testing.T) {
 		// this might seem counter-intuitive at first, but this makes a lot of
 		// sense when you consider the authorization strageies: for example we
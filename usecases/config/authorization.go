@@ -17,22 +17,34 @@ import (
 	"fmt"
 
 	"github.com/semi-technologies/weaviate/usecases/auth/authorization/adminlist"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization/rbac"
 )
 
 // Authorization configuration
 type Authorization struct {
 	AdminList adminlist.Config `json:"admin_list" yaml:"admin_list"`
+	RBAC      rbac.Config      `json:"rbac" yaml:"rbac"`
 }
 
 // Validate the Authorization configuration. This only validates at a general
 // level. Validation specific to the individual auth methods should happen
 // inside their respective packages
 func (a Authorization) Validate() error {
+	if a.AdminList.Enabled && a.RBAC.Enabled {
+		return fmt.Errorf("authorization: admin list and rbac can't be enabled at the same time")
+	}
+
 	if a.AdminList.Enabled {
 		if err := a.AdminList.Validate(); err != nil {
 			return fmt.Errorf("authorization: %s", err)
 		}
 	}
 
+	if a.RBAC.Enabled {
+		if err := a.RBAC.Validate(); err != nil {
+			return fmt.Errorf("authorization: %s", err)
+		}
+	}
+
 	return nil
 }
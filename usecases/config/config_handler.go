@@ -49,10 +49,15 @@ type Config struct {
 	Debug                bool            `json:"debug" yaml:"debug"`
 	QueryDefaults        QueryDefaults   `json:"query_defaults" yaml:"query_defaults"`
 	Contextionary        Contextionary   `json:"contextionary" yaml:"contextionary"`
+	Text2VecHTTP         Text2VecHTTP    `json:"text2vec_http" yaml:"text2vec_http"`
+	Backup               Backup          `json:"backup" yaml:"backup"`
 	ConfigurationStorage ConfigStore     `json:"configuration_storage" yaml:"configuration_storage"`
 	Authentication       Authentication  `json:"authentication" yaml:"authentication"`
 	Authorization        Authorization   `json:"authorization" yaml:"authorization"`
 	Telemetry            Telemetry       `json:"telemetry" yaml:"telemetry"`
+	Audit                Audit           `json:"audit" yaml:"audit"`
+	Monitoring           Monitoring      `json:"monitoring" yaml:"monitoring"`
+	Classification       Classification  `json:"classification" yaml:"classification"`
 	VectorIndex          VectorIndex     `json:"vector_index" yaml:"vector_index"`
 	EsvectorOnly         bool            `json:"esvectorOnly" yaml:"esvectorOnly"`
 	Origin               string          `json:"origin" yaml:"origin"`
@@ -67,6 +72,48 @@ type Contextionary struct {
 	URL string `json:"url" yaml:"url"`
 }
 
+// Text2VecHTTP configures the endpoint of the vectorizer "text2vec-http".
+// Classes can only select this vectorizer if a URL is set.
+type Text2VecHTTP struct {
+	URL string `json:"url" yaml:"url"`
+}
+
+// Classification configures how classification runs process the objects.
+// Unclassified objects are loaded in pages of PageSize objects, which are
+// classified by Workers concurrent workers. A dry run lists at most
+// MaxPredictions of the computed references.
+type Classification struct {
+	Workers        int `json:"workers" yaml:"workers"`
+	PageSize       int `json:"page_size" yaml:"page_size"`
+	MaxPredictions int `json:"max_predictions" yaml:"max_predictions"`
+}
+
+// SetDefaults for optional fields
+func (c *Classification) SetDefaults() {
+	if c.Workers <= 0 {
+		c.Workers = 4
+	}
+
+	if c.PageSize <= 0 {
+		c.PageSize = 100
+	}
+
+	if c.MaxPredictions <= 0 {
+		c.MaxPredictions = 1000
+	}
+}
+
+// Monitoring exposes prometheus metrics at /metrics if enabled
+type Monitoring struct {
+	Enabled bool `json:"enabled" yaml:"enabled"`
+}
+
+// Backup configures where backups are written to and restored from. Backups
+// are disabled if the path is empty.
+type Backup struct {
+	Path string `json:"path" yaml:"path"`
+}
+
 type VectorIndex struct {
 	Enabled                bool    `json:"enabled" yaml:"enabled"`
 	URL                    string  `json:"url" yaml:"url"`
@@ -138,12 +185,36 @@ type ConfigStore struct {
 	URL  string `json:"url" yaml:"url"`
 }
 
+// Names of the supported databases, any unrecognized name falls back to the
+// esvector database for backward compatibility
+const (
+	DatabaseEsvector = "esvector"
+	DatabaseEmbedded = "embedded"
+)
+
+// DefaultEmbeddedDataPath is used if the embedded db is selected, but no path
+// is configured
+const DefaultEmbeddedDataPath = "./data"
+
 // Database is the outline of the database
 type Database struct {
 	Name           string      `json:"name" yaml:"name"`
+	DataPath       string      `json:"data_path" yaml:"data_path"`
 	DatabaseConfig interface{} `json:"database_config" yaml:"database_config"`
 }
 
+// Embedded is true if the self-contained, disk-backed database should be
+// used instead of an external one
+func (d Database) Embedded() bool {
+	return d.Name == DatabaseEmbedded
+}
+
+func (d *Database) SetDefaults() {
+	if d.Embedded() && d.DataPath == "" {
+		d.DataPath = DefaultEmbeddedDataPath
+	}
+}
+
 // Instance is the outline for an external instance whereto crossreferences can be resolved
 type Instance struct {
 	URL      string `json:"url" yaml:"url"`
@@ -208,6 +279,13 @@ func (f *WeaviateConfig) LoadConfig(flags *swag.CommandLineOptionsGroup, logger
 	}
 
 	(&f.Config.VectorIndex).SetDefaults()
+	(&f.Config.Database).SetDefaults()
+	(&f.Config.Audit).SetDefaults()
+	(&f.Config.Classification).SetDefaults()
+
+	if err := f.Config.Audit.Validate(); err != nil {
+		return fmt.Errorf("invalid config: %v", err)
+	}
 
 	return nil
 }
@@ -22,6 +22,7 @@ import (
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization"
 	"github.com/semi-technologies/weaviate/usecases/kinds/validation"
 )
 
@@ -39,15 +40,20 @@ type addRepo interface {
 type schemaManager interface {
 	UpdatePropertyAddDataType(context.Context, *models.Principal, kind.Kind, string, string, string) error
 	GetSchema(principal *models.Principal) (schema.Schema, error)
+	GetSchemaSkipAuth() schema.Schema
 }
 
 // AddAction Class Instance to the connected DB. If the class contains a network
 // ref, it has a side-effect on the schema: The schema will be updated to
 // include this particular network ref class.
 func (m *Manager) AddAction(ctx context.Context, principal *models.Principal,
-	class *models.Action) (*models.Action, error) {
+	class *models.Action) (_ *models.Action, err error) {
+	defer func() {
+		m.auditCreate(principal, kind.Action, class.Class, class.ID, err)
+	}()
 
-	err := m.authorizer.Authorize(principal, "create", "actions")
+	err = m.authorizer.Authorize(principal, "create",
+		authorization.ClassResource(kind.Action, class.Class))
 	if err != nil {
 		return nil, err
 	}
@@ -125,17 +131,22 @@ func (m *Manager) vectorizeAndPutAction(ctx context.Context, class *models.Actio
 }
 
 func (m *Manager) validateAction(ctx context.Context, principal *models.Principal, class *models.Action) error {
-	// Validate schema given in body with the weaviate schema
-	if _, err := uuid.FromString(class.ID.String()); err != nil {
+	s, err := m.schemaManager.GetSchema(principal)
+	if err != nil {
 		return err
 	}
 
-	s, err := m.schemaManager.GetSchema(principal)
-	if err != nil {
+	return validateActionAgainst(ctx, validation.New(s, m.exists, m.network, m.config), class)
+}
+
+// validateActionAgainst validates the id and the schema given in the body with
+// the weaviate schema the validator holds
+func validateActionAgainst(ctx context.Context, v *validation.Validator, class *models.Action) error {
+	if _, err := uuid.FromString(class.ID.String()); err != nil {
 		return err
 	}
 
-	return validation.New(s, m.exists, m.network, m.config).Action(ctx, class)
+	return v.Action(ctx, class)
 }
 
 func (m *Manager) exists(ctx context.Context, k kind.Kind, id strfmt.UUID) (bool, error) {
@@ -146,9 +157,13 @@ func (m *Manager) exists(ctx context.Context, k kind.Kind, id strfmt.UUID) (bool
 // ref, it has a side-effect on the schema: The schema will be updated to
 // include this particular network ref class.
 func (m *Manager) AddThing(ctx context.Context, principal *models.Principal,
-	class *models.Thing) (*models.Thing, error) {
+	class *models.Thing) (_ *models.Thing, err error) {
+	defer func() {
+		m.auditCreate(principal, kind.Thing, class.Class, class.ID, err)
+	}()
 
-	err := m.authorizer.Authorize(principal, "create", "things")
+	err = m.authorizer.Authorize(principal, "create",
+		authorization.ClassResource(kind.Thing, class.Class))
 	if err != nil {
 		return nil, err
 	}
@@ -208,17 +223,22 @@ func (m *Manager) vectorizeAndPutThing(ctx context.Context, class *models.Thing)
 
 func (m *Manager) validateThing(ctx context.Context, principal *models.Principal,
 	class *models.Thing) error {
-	// Validate schema given in body with the weaviate schema
-	if _, err := uuid.FromString(class.ID.String()); err != nil {
+	s, err := m.schemaManager.GetSchema(principal)
+	if err != nil {
 		return err
 	}
 
-	s, err := m.schemaManager.GetSchema(principal)
-	if err != nil {
+	return validateThingAgainst(ctx, validation.New(s, m.exists, m.network, m.config), class)
+}
+
+// validateThingAgainst validates the id and the schema given in the body with
+// the weaviate schema the validator holds
+func validateThingAgainst(ctx context.Context, v *validation.Validator, class *models.Thing) error {
+	if _, err := uuid.FromString(class.ID.String()); err != nil {
 		return err
 	}
 
-	return validation.New(s, m.exists, m.network, m.config).Thing(ctx, class)
+	return v.Thing(ctx, class)
 }
 
 func (m *Manager) addNetworkDataTypesForThing(ctx context.Context, principal *models.Principal, class *models.Thing) error {
@@ -0,0 +1,176 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/audit"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization"
+)
+
+type auditor interface {
+	Record(events ...audit.Event)
+}
+
+// SetAuditor enables recording an audit event for every mutating call
+func (m *Manager) SetAuditor(a auditor) {
+	m.auditor = a
+}
+
+// SetAuditor enables recording an audit event for every item of a batch
+func (b *BatchManager) SetAuditor(a auditor) {
+	b.auditor = a
+}
+
+// auditCreate records the creation of an object, it is authorized on the
+// class, as the object didn't exist before
+func (m *Manager) auditCreate(principal *models.Principal, k kind.Kind,
+	className string, id strfmt.UUID, err error) {
+	if m.auditor == nil {
+		return
+	}
+
+	resource := authorization.ClassResource(k, className)
+	m.auditor.Record(audit.NewEvent(principal, "create", resource, err).
+		WithObject(className, id))
+}
+
+// auditObject records a mutation of an existing object, the class is empty
+// if the object doesn't exist
+func (m *Manager) auditObject(principal *models.Principal, verb string, k kind.Kind,
+	className string, id strfmt.UUID, err error) {
+	recordObject(m.auditor, principal, verb, k, className, id, err)
+}
+
+// auditObject records a mutation of a single object which is affected by a
+// batch, e.g. an object deleted through a cascading reference
+func (b *BatchManager) auditObject(principal *models.Principal, verb string, k kind.Kind,
+	className string, id strfmt.UUID, err error) {
+	recordObject(b.auditor, principal, verb, k, className, id, err)
+}
+
+func recordObject(a auditor, principal *models.Principal, verb string, k kind.Kind,
+	className string, id strfmt.UUID, err error) {
+	if a == nil {
+		return
+	}
+
+	class := className
+	if class == "" {
+		class = authorization.AllClasses
+	}
+
+	resource := authorization.ObjectResource(k, class, id)
+	a.Record(audit.NewEvent(principal, verb, resource, err).
+		WithObject(className, id))
+}
+
+// auditThings records an event per item of a batch create or merge. If the
+// batch failed as a whole, a single event for the entire batch is recorded
+// instead.
+func (b *BatchManager) auditThings(principal *models.Principal, verb string,
+	res BatchThings, err error) {
+	if b.auditor == nil {
+		return
+	}
+
+	if err != nil {
+		b.auditor.Record(audit.NewEvent(principal, verb, "batch/things", err))
+		return
+	}
+
+	events := make([]audit.Event, len(res))
+	for i, item := range res {
+		events[i] = audit.NewEvent(principal, verb,
+			authorization.BatchResource(kind.Thing, item.Thing.Class), item.Err).
+			WithObject(item.Thing.Class, item.UUID)
+	}
+	b.auditor.Record(events...)
+}
+
+// auditActions records an event per item of a batch create or merge. If the
+// batch failed as a whole, a single event for the entire batch is recorded
+// instead.
+func (b *BatchManager) auditActions(principal *models.Principal, verb string,
+	res BatchActions, err error) {
+	if b.auditor == nil {
+		return
+	}
+
+	if err != nil {
+		b.auditor.Record(audit.NewEvent(principal, verb, "batch/actions", err))
+		return
+	}
+
+	events := make([]audit.Event, len(res))
+	for i, item := range res {
+		events[i] = audit.NewEvent(principal, verb,
+			authorization.BatchResource(kind.Action, item.Action.Class), item.Err).
+			WithObject(item.Action.Class, item.UUID)
+	}
+	b.auditor.Record(events...)
+}
+
+// auditReferences records an event per reference, the affected object is the
+// source of the reference
+func (b *BatchManager) auditReferences(principal *models.Principal, res BatchReferences, err error) {
+	if b.auditor == nil {
+		return
+	}
+
+	if err != nil {
+		b.auditor.Record(audit.NewEvent(principal, "update", "batch/*", err))
+		return
+	}
+
+	events := make([]audit.Event, len(res))
+	for i, item := range res {
+		if item.From == nil {
+			// the source could not be parsed, so there is no object to point to
+			events[i] = audit.NewEvent(principal, "update", "batch/*", item.Err)
+			continue
+		}
+
+		className := item.From.Class.String()
+		events[i] = audit.NewEvent(principal, "update",
+			authorization.BatchResource(item.From.Kind, className), item.Err).
+			WithObject(className, item.From.TargetID)
+	}
+	b.auditor.Record(events...)
+}
+
+// auditDeletes records an event per object of a page of a batch delete. If
+// the batch delete failed as a whole, a single event for the class is
+// recorded instead.
+func (b *BatchManager) auditDeletes(principal *models.Principal, k kind.Kind,
+	className string, objects BatchDeleteObjects, err error) {
+	if b.auditor == nil {
+		return
+	}
+
+	resource := authorization.BatchResource(k, className)
+	if err != nil {
+		b.auditor.Record(audit.NewEvent(principal, "delete", resource, err))
+		return
+	}
+
+	events := make([]audit.Event, len(objects))
+	for i, item := range objects {
+		events[i] = audit.NewEvent(principal, "delete", resource, item.Err).
+			WithObject(className, item.UUID)
+	}
+	b.auditor.Record(events...)
+}
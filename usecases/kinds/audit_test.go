@@ -0,0 +1,125 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/audit"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Audit_DeleteThing(t *testing.T) {
+	id := strfmt.UUID("5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")
+	principal := &models.Principal{Username: "alice"}
+	logger, _ := test.NewNullLogger()
+
+	t.Run("successful deletion", func(t *testing.T) {
+		vectorRepo := &fakeVectorRepo{}
+		vectorRepo.On("ThingByID", id, mock.Anything, mock.Anything).
+			Return(&search.Result{ClassName: "Article"}, nil)
+		vectorRepo.On("DeleteThing", "Article", id).Return(nil)
+		auditor := &fakeAuditor{}
+		manager := NewManager(&fakeLocks{}, &fakeSchemaManager{}, &fakeNetwork{},
+			&config.WeaviateConfig{}, logger, &fakeAuthorizer{}, &fakeVectorizer{}, vectorRepo)
+		manager.SetAuditor(auditor)
+
+		err := manager.DeleteThing(context.Background(), principal, id)
+		require.Nil(t, err)
+
+		assert.Equal(t, []audit.Event{{
+			Username: "alice",
+			Verb:     "delete",
+			Resource: "things/Article/" + id.String(),
+			Class:    "Article",
+			ID:       id,
+			Outcome:  audit.OutcomeSuccess,
+		}}, auditor.events)
+	})
+
+	t.Run("denied deletion of an unknown thing", func(t *testing.T) {
+		vectorRepo := &fakeVectorRepo{}
+		vectorRepo.On("ThingByID", id, mock.Anything, mock.Anything).
+			Return((*search.Result)(nil), nil)
+		auditor := &fakeAuditor{}
+		manager := NewManager(&fakeLocks{}, &fakeSchemaManager{}, &fakeNetwork{},
+			&config.WeaviateConfig{}, logger, &authDenier{}, &fakeVectorizer{}, vectorRepo)
+		manager.SetAuditor(auditor)
+
+		err := manager.DeleteThing(context.Background(), principal, id)
+		require.NotNil(t, err)
+
+		assert.Equal(t, []audit.Event{{
+			Username: "alice",
+			Verb:     "delete",
+			Resource: "things/*/" + id.String(),
+			ID:       id,
+			Outcome:  audit.OutcomeFailure,
+			Error:    "just a test fake",
+		}}, auditor.events)
+	})
+}
+
+func Test_Audit_BatchAddThings(t *testing.T) {
+	principal := &models.Principal{Username: "alice"}
+	logger, _ := test.NewNullLogger()
+	vectorRepo := &fakeVectorRepo{}
+	vectorRepo.On("BatchPutThings", mock.Anything).Return(nil)
+	vectorizer := &fakeVectorizer{}
+	vectorizer.On("Thing", mock.Anything).Return([]float32{0, 1, 2}, nil)
+	schemaManager := &fakeSchemaManager{
+		GetSchemaResponse: schema.Schema{
+			Things: &models.Schema{Classes: []*models.Class{{Class: "Article"}}},
+		},
+	}
+	auditor := &fakeAuditor{}
+	manager := NewBatchManager(vectorRepo, vectorizer, &fakeLocks{}, schemaManager,
+		nil, &config.WeaviateConfig{}, logger, &fakeAuthorizer{})
+	manager.SetAuditor(auditor)
+
+	validID := strfmt.UUID("cf918366-3d3b-4b90-9bc6-bc5ea8762ff6")
+	_, err := manager.AddThings(context.Background(), principal, []*models.Thing{
+		{ID: validID, Class: "Article"},
+		{ID: "invalid", Class: "Article"},
+	}, []*string{})
+	require.Nil(t, err)
+
+	require.Len(t, auditor.events, 2, "one event per item")
+	assert.Equal(t, audit.Event{
+		Username: "alice",
+		Verb:     "create",
+		Resource: "batch/things/Article",
+		Class:    "Article",
+		ID:       validID,
+		Outcome:  audit.OutcomeSuccess,
+	}, auditor.events[0])
+	assert.Equal(t, audit.OutcomeFailure, auditor.events[1].Outcome)
+}
+
+type fakeAuditor struct {
+	events []audit.Event
+}
+
+func (f *fakeAuditor) Record(events ...audit.Event) {
+	f.events = append(f.events, events...)
+}
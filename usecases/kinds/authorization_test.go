@@ -21,10 +21,15 @@ import (
 	"testing"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
 	"github.com/semi-technologies/weaviate/usecases/config"
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -44,127 +49,147 @@ func Test_Kinds_Authorization(t *testing.T) {
 		// single kind
 		testCase{
 			methodName:       "AddThing",
-			additionalArgs:   []interface{}{(*models.Thing)(nil)},
+			additionalArgs:   []interface{}{&models.Thing{Class: "Foo"}},
 			expectedVerb:     "create",
-			expectedResource: "things",
+			expectedResource: "things/Foo",
 		},
 		testCase{
 			methodName:       "AddAction",
-			additionalArgs:   []interface{}{(*models.Action)(nil)},
+			additionalArgs:   []interface{}{&models.Action{Class: "Foo"}},
 			expectedVerb:     "create",
-			expectedResource: "actions",
+			expectedResource: "actions/Foo",
 		},
 		testCase{
 			methodName:       "ValidateThing",
-			additionalArgs:   []interface{}{(*models.Thing)(nil)},
+			additionalArgs:   []interface{}{&models.Thing{Class: "Foo"}},
 			expectedVerb:     "validate",
-			expectedResource: "things",
+			expectedResource: "things/Foo",
 		},
 		testCase{
 			methodName:       "ValidateAction",
-			additionalArgs:   []interface{}{(*models.Action)(nil)},
+			additionalArgs:   []interface{}{&models.Action{Class: "Foo"}},
 			expectedVerb:     "validate",
-			expectedResource: "actions",
+			expectedResource: "actions/Foo",
 		},
 		testCase{
 			methodName:       "GetThing",
 			additionalArgs:   []interface{}{strfmt.UUID("foo"), false},
 			expectedVerb:     "get",
-			expectedResource: "things/foo",
+			expectedResource: "things/*/foo",
 		},
 		testCase{
 			methodName:       "GetAction",
 			additionalArgs:   []interface{}{strfmt.UUID("foo"), false},
 			expectedVerb:     "get",
-			expectedResource: "actions/foo",
+			expectedResource: "actions/*/foo",
 		},
 		testCase{
 			methodName:       "DeleteThing",
 			additionalArgs:   []interface{}{strfmt.UUID("foo")},
 			expectedVerb:     "delete",
-			expectedResource: "things/foo",
+			expectedResource: "things/*/foo",
 		},
 		testCase{
 			methodName:       "DeleteAction",
 			additionalArgs:   []interface{}{strfmt.UUID("foo")},
 			expectedVerb:     "delete",
-			expectedResource: "actions/foo",
+			expectedResource: "actions/*/foo",
 		},
 		testCase{
 			methodName:       "UpdateThing",
-			additionalArgs:   []interface{}{strfmt.UUID("foo"), (*models.Thing)(nil)},
+			additionalArgs:   []interface{}{strfmt.UUID("foo"), &models.Thing{Class: "Foo"}},
 			expectedVerb:     "update",
-			expectedResource: "things/foo",
+			expectedResource: "things/*/foo",
 		},
 		testCase{
 			methodName:       "MergeThing",
-			additionalArgs:   []interface{}{strfmt.UUID("foo"), (*models.Thing)(nil)},
+			additionalArgs:   []interface{}{strfmt.UUID("foo"), &models.Thing{Class: "Foo"}},
 			expectedVerb:     "update",
-			expectedResource: "things/foo",
+			expectedResource: "things/*/foo",
 		},
 		testCase{
 			methodName:       "UpdateAction",
-			additionalArgs:   []interface{}{strfmt.UUID("foo"), (*models.Action)(nil)},
+			additionalArgs:   []interface{}{strfmt.UUID("foo"), &models.Action{Class: "Foo"}},
 			expectedVerb:     "update",
-			expectedResource: "actions/foo",
+			expectedResource: "actions/*/foo",
 		},
 		testCase{
 			methodName:       "MergeAction",
-			additionalArgs:   []interface{}{strfmt.UUID("foo"), (*models.Action)(nil)},
+			additionalArgs:   []interface{}{strfmt.UUID("foo"), &models.Action{Class: "Foo"}},
 			expectedVerb:     "update",
-			expectedResource: "actions/foo",
+			expectedResource: "actions/*/foo",
 		},
 
 		// list kinds
 		testCase{
 			methodName:       "GetThings",
-			additionalArgs:   []interface{}{(*int64)(nil), false},
+			additionalArgs:   []interface{}{(*int64)(nil), (*int64)(nil), strfmt.UUID(""), "", false},
 			expectedVerb:     "list",
 			expectedResource: "things",
 		},
 		testCase{
 			methodName:       "GetActions",
-			additionalArgs:   []interface{}{(*int64)(nil), false},
+			additionalArgs:   []interface{}{(*int64)(nil), (*int64)(nil), strfmt.UUID(""), "", false},
 			expectedVerb:     "list",
 			expectedResource: "actions",
 		},
+		testCase{
+			methodName:       "ExportThings",
+			additionalArgs:   []interface{}{"Foo", (func(*models.Thing) error)(nil)},
+			expectedVerb:     "list",
+			expectedResource: "things/Foo",
+		},
+		testCase{
+			methodName:       "ExportActions",
+			additionalArgs:   []interface{}{"Foo", (func(*models.Action) error)(nil)},
+			expectedVerb:     "list",
+			expectedResource: "actions/Foo",
+		},
 
 		// reference on kinds
 		testCase{
 			methodName:       "AddThingReference",
 			additionalArgs:   []interface{}{strfmt.UUID("foo"), "some prop", (*models.SingleRef)(nil)},
 			expectedVerb:     "update",
-			expectedResource: "things/foo",
+			expectedResource: "things/*/foo",
 		},
 		testCase{
 			methodName:       "AddActionReference",
 			additionalArgs:   []interface{}{strfmt.UUID("foo"), "some prop", (*models.SingleRef)(nil)},
 			expectedVerb:     "update",
-			expectedResource: "actions/foo",
+			expectedResource: "actions/*/foo",
 		},
 		testCase{
 			methodName:       "DeleteThingReference",
 			additionalArgs:   []interface{}{strfmt.UUID("foo"), "some prop", (*models.SingleRef)(nil)},
 			expectedVerb:     "update",
-			expectedResource: "things/foo",
+			expectedResource: "things/*/foo",
 		},
 		testCase{
 			methodName:       "DeleteActionReference",
 			additionalArgs:   []interface{}{strfmt.UUID("foo"), "some prop", (*models.SingleRef)(nil)},
 			expectedVerb:     "update",
-			expectedResource: "actions/foo",
+			expectedResource: "actions/*/foo",
 		},
 		testCase{
 			methodName:       "UpdateThingReferences",
 			additionalArgs:   []interface{}{strfmt.UUID("foo"), "some prop", (models.MultipleRef)(nil)},
 			expectedVerb:     "update",
-			expectedResource: "things/foo",
+			expectedResource: "things/*/foo",
 		},
 		testCase{
 			methodName:       "UpdateActionReferences",
 			additionalArgs:   []interface{}{strfmt.UUID("foo"), "some prop", (models.MultipleRef)(nil)},
 			expectedVerb:     "update",
-			expectedResource: "actions/foo",
+			expectedResource: "actions/*/foo",
+		},
+
+		// maintenance
+		testCase{
+			methodName:       "CheckDanglingReferences",
+			additionalArgs:   []interface{}{&models.DanglingReferencesCheck{Class: "Foo"}},
+			expectedVerb:     "list",
+			expectedResource: "maintenance/dangling-references/Foo",
 		},
 	}
 
@@ -175,6 +200,11 @@ func Test_Kinds_Authorization(t *testing.T) {
 		}
 
 		for _, method := range allExportedMethods(&Manager{}) {
+			switch method {
+			case "SetAuditor":
+				// only called at startup, not on behalf of a principal
+				continue
+			}
 			assert.Contains(t, testedMethods, method)
 		}
 	})
@@ -190,6 +220,11 @@ func Test_Kinds_Authorization(t *testing.T) {
 			authorizer := &authDenier{}
 			vectorizer := &fakeVectorizer{}
 			vectorRepo := &fakeVectorRepo{}
+			// objects which don't exist are authorized against all classes
+			vectorRepo.On("ThingByID", mock.Anything, mock.Anything, mock.Anything).
+				Return((*search.Result)(nil), nil)
+			vectorRepo.On("ActionByID", mock.Anything, mock.Anything, mock.Anything).
+				Return((*search.Result)(nil), nil)
 			manager := NewManager(locks, schemaManager, network,
 				cfg, logger, authorizer, vectorizer, vectorRepo)
 
@@ -203,6 +238,100 @@ func Test_Kinds_Authorization(t *testing.T) {
 				authorizer.calls[0], "correct paramteres must have been used on authorizer")
 		}
 	})
+
+	t.Run("the class of an existing object is part of the resource", func(t *testing.T) {
+		principal := &models.Principal{}
+		logger, _ := test.NewNullLogger()
+		authorizer := &authDenier{}
+		vectorRepo := &fakeVectorRepo{}
+		vectorRepo.On("ThingByID", strfmt.UUID("foo"), mock.Anything, mock.Anything).
+			Return(&search.Result{Kind: kind.Thing, ClassName: "Article", ID: "foo"}, nil)
+		manager := NewManager(&fakeLocks{}, &fakeSchemaManager{}, &fakeNetwork{},
+			&config.WeaviateConfig{}, logger, authorizer, &fakeVectorizer{}, vectorRepo)
+
+		err := manager.DeleteThing(context.Background(), principal, "foo")
+		assert.Equal(t, errors.New("just a test fake"), err)
+		assert.Equal(t, []authorizeCall{{principal, "delete", "things/Article/foo"}},
+			authorizer.calls)
+	})
+
+	t.Run("lists drop objects of classes the principal may not list", func(t *testing.T) {
+		principal := &models.Principal{}
+		logger, _ := test.NewNullLogger()
+		authorizer := &allowOnly{authDenier: &authDenier{},
+			resources: []string{"things", "things/Article"}}
+		vectorRepo := &fakeVectorRepo{}
+		vectorRepo.On("ThingSearch", mock.Anything, mock.Anything).Return(search.Results{
+			{Kind: kind.Thing, ClassName: "Article", ID: "1"},
+			{Kind: kind.Thing, ClassName: "Secret", ID: "2"},
+			{Kind: kind.Thing, ClassName: "Article", ID: "3"},
+		}, nil)
+		manager := NewManager(&fakeLocks{}, &fakeSchemaManager{}, &fakeNetwork{},
+			&config.WeaviateConfig{}, logger, authorizer, &fakeVectorizer{}, vectorRepo)
+
+		res, err := manager.GetThings(context.Background(), principal, nil, nil, "", "", false)
+		require.Nil(t, err)
+		require.Len(t, res.Things, 2)
+		assert.Equal(t, strfmt.UUID("1"), res.Things[0].ID)
+		assert.Equal(t, strfmt.UUID("3"), res.Things[1].ID)
+		assert.Equal(t, []authorizeCall{
+			{principal, "list", "things"},
+			{principal, "list", "things/Article"},
+			{principal, "list", "things/Secret"},
+		}, authorizer.calls, "every class must be authorized exactly once")
+	})
+
+	t.Run("lists are filled up with objects of classes the principal may list", func(t *testing.T) {
+		principal := &models.Principal{}
+		logger, _ := test.NewNullLogger()
+		authorizer := &allowOnly{authDenier: &authDenier{},
+			resources: []string{"things", "things/Article"}}
+		schemaManager := &fakeSchemaManager{GetSchemaResponse: schema.Schema{
+			Things: &models.Schema{Classes: []*models.Class{
+				{Class: "Article"},
+				{Class: "Secret"},
+			}},
+		}}
+		cfg := &config.WeaviateConfig{}
+		cfg.Config.QueryDefaults.Limit = 2
+		vectorRepo := &fakeVectorRepo{}
+		vectorRepo.On("ThingSearch", &filters.Pagination{Limit: 2}, mock.Anything).
+			Return(search.Results{
+				{Kind: kind.Thing, ClassName: "Article", ID: "1"},
+				{Kind: kind.Thing, ClassName: "Secret", ID: "2"},
+			}, nil)
+		vectorRepo.On("ThingSearch", &filters.Pagination{Limit: 2, Offset: 2}, mock.Anything).
+			Return(search.Results{
+				{Kind: kind.Thing, ClassName: "Secret", ID: "3"},
+				{Kind: kind.Thing, ClassName: "Article", ID: "4"},
+			}, nil)
+		vectorRepo.On("ThingSearch", &filters.Pagination{Limit: 2, Offset: 4}, mock.Anything).
+			Return(search.Results{
+				{Kind: kind.Thing, ClassName: "Article", ID: "5"},
+			}, nil)
+		manager := NewManager(&fakeLocks{}, schemaManager, &fakeNetwork{},
+			cfg, logger, authorizer, &fakeVectorizer{}, vectorRepo)
+
+		t.Run("the first page is full", func(t *testing.T) {
+			res, err := manager.GetThings(context.Background(), principal, nil, nil, "", "", false)
+			require.Nil(t, err)
+			require.Len(t, res.Things, 2)
+			assert.Equal(t, strfmt.UUID("1"), res.Things[0].ID)
+			assert.Equal(t, strfmt.UUID("4"), res.Things[1].ID)
+			assert.Equal(t, int64(2), res.TotalResults)
+			assert.Equal(t, "4", res.ContinuationToken)
+		})
+
+		t.Run("the offset only counts listable objects", func(t *testing.T) {
+			offset := int64(2)
+			res, err := manager.GetThings(context.Background(), principal, &offset, nil, "", "", false)
+			require.Nil(t, err)
+			require.Len(t, res.Things, 1)
+			assert.Equal(t, strfmt.UUID("5"), res.Things[0].ID)
+			assert.Equal(t, int64(1), res.TotalResults)
+			assert.Equal(t, "", res.ContinuationToken)
+		})
+	})
 }
 
 func Test_BatchKinds_Authorization(t *testing.T) {
@@ -235,6 +364,34 @@ func Test_BatchKinds_Authorization(t *testing.T) {
 			expectedVerb:     "update",
 			expectedResource: "batch/*",
 		},
+
+		testCase{
+			methodName:       "DeleteActions",
+			additionalArgs:   []interface{}{&models.BatchDelete{Class: ptString("Foo")}},
+			expectedVerb:     "delete",
+			expectedResource: "batch/actions/Foo",
+		},
+
+		testCase{
+			methodName:       "MergeActions",
+			additionalArgs:   []interface{}{[]*models.Action{}},
+			expectedVerb:     "update",
+			expectedResource: "batch/actions",
+		},
+
+		testCase{
+			methodName:       "MergeThings",
+			additionalArgs:   []interface{}{[]*models.Thing{}},
+			expectedVerb:     "update",
+			expectedResource: "batch/things",
+		},
+
+		testCase{
+			methodName:       "DeleteThings",
+			additionalArgs:   []interface{}{&models.BatchDelete{Class: ptString("Foo")}},
+			expectedVerb:     "delete",
+			expectedResource: "batch/things/Foo",
+		},
 	}
 
 	t.Run("verify that a test for every public method exists", func(t *testing.T) {
@@ -244,6 +401,11 @@ func Test_BatchKinds_Authorization(t *testing.T) {
 		}
 
 		for _, method := range allExportedMethods(&BatchManager{}) {
+			switch method {
+			case "SetAuditor":
+				// only called at startup, not on behalf of a principal
+				continue
+			}
 			assert.Contains(t, testedMethods, method)
 		}
 	})
@@ -288,6 +450,22 @@ func (a *authDenier) Authorize(principal *models.Principal, verb, resource strin
 	return errors.New("just a test fake")
 }
 
+// allowOnly allows the listed resources and denies everything else
+type allowOnly struct {
+	*authDenier
+	resources []string
+}
+
+func (a *allowOnly) Authorize(principal *models.Principal, verb, resource string) error {
+	err := a.authDenier.Authorize(principal, verb, resource)
+	for _, allowed := range a.resources {
+		if resource == allowed {
+			return nil
+		}
+	}
+	return err
+}
+
 // inspired by https://stackoverflow.com/a/33008200
 func callFuncByName(manager interface{}, funcName string, params ...interface{}) (out []reflect.Value, err error) {
 	managerValue := reflect.ValueOf(manager)
@@ -315,3 +493,7 @@ func allExportedMethods(subject interface{}) []string {
 
 	return methods
 }
+
+func ptString(in string) *string {
+	return &in
+}
@@ -0,0 +1,126 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization"
+)
+
+// authorizeThing authorizes the verb on a single thing. The resource is
+// qualified with the class of the thing, so the thing is looked up first. The
+// class is returned, it is empty if the thing doesn't exist.
+func (m *Manager) authorizeThing(ctx context.Context, principal *models.Principal,
+	verb string, id strfmt.UUID) (string, error) {
+	res, err := m.vectorRepo.ThingByID(ctx, id, nil, false)
+	if err != nil {
+		return "", NewErrInternal("repo: thing by id: %v", err)
+	}
+
+	return resultClass(res), m.authorizer.Authorize(principal, verb, objectResource(kind.Thing, id, res))
+}
+
+// authorizeAction authorizes the verb on a single action. The resource is
+// qualified with the class of the action, so the action is looked up first. The
+// class is returned, it is empty if the action doesn't exist.
+func (m *Manager) authorizeAction(ctx context.Context, principal *models.Principal,
+	verb string, id strfmt.UUID) (string, error) {
+	res, err := m.vectorRepo.ActionByID(ctx, id, nil, false)
+	if err != nil {
+		return "", NewErrInternal("repo: action by id: %v", err)
+	}
+
+	return resultClass(res), m.authorizer.Authorize(principal, verb, objectResource(kind.Action, id, res))
+}
+
+// objectResource of the object found for the id. If there is none, the class
+// is unknown, so that only principals with access to all classes learn that
+// the object doesn't exist.
+func objectResource(k kind.Kind, id strfmt.UUID, res *search.Result) string {
+	class := authorization.AllClasses
+	if res != nil {
+		class = res.ClassName
+	}
+
+	return authorization.ObjectResource(k, class, id)
+}
+
+func resultClass(res *search.Result) string {
+	if res == nil {
+		return ""
+	}
+
+	return res.ClassName
+}
+
+// listableClasses decides which classes the principal may list. The decision
+// is cached per class, so the authorizer is called at most once per class.
+type listableClasses struct {
+	authorizer authorizer
+	principal  *models.Principal
+	allowed    map[string]bool
+}
+
+func (m *Manager) listableClasses(principal *models.Principal) *listableClasses {
+	return &listableClasses{
+		authorizer: m.authorizer,
+		principal:  principal,
+		allowed:    map[string]bool{},
+	}
+}
+
+func (l *listableClasses) allows(k kind.Kind, className string) bool {
+	resource := authorization.ClassResource(k, className)
+	ok, cached := l.allowed[resource]
+	if !cached {
+		ok = l.authorizer.Authorize(l.principal, "list", resource) == nil
+		l.allowed[resource] = ok
+	}
+
+	return ok
+}
+
+// all is true if the principal may list every class of the kind
+func (l *listableClasses) all(s schema.Schema, k kind.Kind) bool {
+	semanticSchema := s.SemanticSchemaFor(k)
+	if semanticSchema == nil {
+		return true
+	}
+
+	for _, class := range semanticSchema.Classes {
+		if !l.allows(k, class.Class) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// filter drops all results of classes the principal may not list
+func (l *listableClasses) filter(results search.Results) search.Results {
+	out := make(search.Results, 0, len(results))
+	for _, res := range results {
+		if l.allows(res.Kind, res.ClassName) {
+			out = append(out, res)
+		}
+	}
+
+	return out
+}
@@ -25,15 +25,20 @@ import (
 	uuid "github.com/satori/go.uuid"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization"
 	"github.com/semi-technologies/weaviate/usecases/kinds/validation"
 	"github.com/semi-technologies/weaviate/usecases/traverser"
 )
 
 // AddActions Class Instances in batch to the connected DB
 func (b *BatchManager) AddActions(ctx context.Context, principal *models.Principal,
-	classes []*models.Action, fields []*string) (BatchActions, error) {
+	classes []*models.Action, fields []*string) (res BatchActions, err error) {
+	defer func() {
+		b.auditActions(principal, "create", res, err)
+	}()
+	observeBatchSize("actions", actionClassNames(classes))
 
-	err := b.authorizer.Authorize(principal, "create", "batch/actions")
+	err = b.authorizer.Authorize(principal, "create", "batch/actions")
 	if err != nil {
 		return nil, err
 	}
@@ -102,6 +107,8 @@ func (b *BatchManager) validateAction(ctx context.Context, principal *models.Pri
 	)
 
 	ec := &errorCompounder{}
+	ec.add(b.authorizer.Authorize(principal, "create",
+		authorization.BatchResource(kind.Action, concept.Class)))
 
 	if concept.ID == "" {
 		// Generate UUID for the new object
@@ -172,8 +179,13 @@ func actionsChanToSlice(c chan BatchAction) BatchActions {
 
 // AddThings Class Instances in batch to the connected DB
 func (b *BatchManager) AddThings(ctx context.Context, principal *models.Principal,
-	classes []*models.Thing, fields []*string) (BatchThings, error) {
-	err := b.authorizer.Authorize(principal, "create", "batch/things")
+	classes []*models.Thing, fields []*string) (res BatchThings, err error) {
+	defer func() {
+		b.auditThings(principal, "create", res, err)
+	}()
+	observeBatchSize("things", thingClassNames(classes))
+
+	err = b.authorizer.Authorize(principal, "create", "batch/things")
 	if err != nil {
 		return nil, err
 	}
@@ -242,6 +254,8 @@ func (b *BatchManager) validateThing(ctx context.Context, principal *models.Prin
 	)
 
 	ec := &errorCompounder{}
+	ec.add(b.authorizer.Authorize(principal, "create",
+		authorization.BatchResource(kind.Thing, concept.Class)))
 
 	if concept.ID == "" {
 		// Generate UUID for the new object
@@ -15,6 +15,8 @@ package kinds
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/go-openapi/strfmt"
@@ -145,6 +147,7 @@ func Test_BatchManager_AddActions(t *testing.T) {
 func Test_BatchManager_AddThings(t *testing.T) {
 	var (
 		vectorRepo *fakeVectorRepo
+		authorizer *denyResources
 		manager    *BatchManager
 	)
 
@@ -154,6 +157,9 @@ func Test_BatchManager_AddThings(t *testing.T) {
 				{
 					Class: "Foo",
 				},
+				{
+					Class: "Bar",
+				},
 			},
 		},
 	}
@@ -166,7 +172,7 @@ func Test_BatchManager_AddThings(t *testing.T) {
 			GetSchemaResponse: schema,
 		}
 		logger, _ := test.NewNullLogger()
-		authorizer := &fakeAuthorizer{}
+		authorizer = &denyResources{}
 		vectorizer := &fakeVectorizer{}
 		vectorizer.On("Thing", mock.Anything).Return([]float32{0, 1, 2}, nil)
 		manager = NewBatchManager(vectorRepo, vectorizer,
@@ -255,4 +261,42 @@ func Test_BatchManager_AddThings(t *testing.T) {
 		assert.Equal(t, vectorRepoCalledWithThings[0].Err.Error(), "uuid: incorrect UUID length: invalid")
 		assert.Equal(t, id2, vectorRepoCalledWithThings[1].UUID, "the user-specified uuid was used")
 	})
+
+	t.Run("with a class the principal may not create", func(t *testing.T) {
+		reset()
+		authorizer.denied = []string{"batch/things/Bar"}
+		vectorRepo.On("BatchPutThings", mock.Anything).Return(nil).Once()
+		things := []*models.Thing{
+			&models.Thing{
+				Class: "Foo",
+			},
+			&models.Thing{
+				Class: "Bar",
+			},
+		}
+
+		_, err := manager.AddThings(ctx, nil, things, []*string{})
+		vectorRepoCalledWithThings := vectorRepo.Calls[0].Arguments[0].(BatchThings)
+
+		assert.Nil(t, err)
+		require.Len(t, vectorRepoCalledWithThings, 2)
+		assert.Nil(t, vectorRepoCalledWithThings[0].Err)
+		assert.Equal(t, errors.New("denied: batch/things/Bar"),
+			vectorRepoCalledWithThings[1].Err)
+	})
+}
+
+// denyResources denies exactly the specified resources
+type denyResources struct {
+	denied []string
+}
+
+func (a *denyResources) Authorize(principal *models.Principal, verb, resource string) error {
+	for _, denied := range a.denied {
+		if denied == resource {
+			return fmt.Errorf("denied: %s", resource)
+		}
+	}
+
+	return nil
 }
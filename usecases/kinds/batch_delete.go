@@ -0,0 +1,255 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/filterext"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization"
+)
+
+// batchDeletePageSize is the number of matching objects which are deleted in
+// a single bulk request
+const batchDeletePageSize = 100
+
+// batchDeleteMaxObjects is the maximum number of objects listed in the result
+// of a batch delete, the counts always include all matching objects
+const batchDeleteMaxObjects = 1000
+
+// BatchDeleteResult is the outcome of a batch delete. Successful and Failed
+// count all matching objects. Objects lists the objects which could not be
+// deleted, those have an error set, and in verbose mode all others, but at
+// most batchDeleteMaxObjects.
+type BatchDeleteResult struct {
+	ClassName  string
+	DryRun     bool
+	Matches    int64
+	Successful int64
+	Failed     int64
+	Objects    BatchDeleteObjects
+
+	// ReferencesRemoved is the number of references to the deleted objects
+	// which were removed according to their onDelete policy,
+	// ReferencesFailed the number of referencing objects which could not be
+	// updated
+	ReferencesRemoved int64
+	ReferencesFailed  int64
+}
+
+// DeleteThings deletes all things of the class matching the where filter
+func (b *BatchManager) DeleteThings(ctx context.Context, principal *models.Principal,
+	params *models.BatchDelete) (*BatchDeleteResult, error) {
+	return b.deleteObjects(ctx, principal, kind.Thing, params)
+}
+
+// DeleteActions deletes all actions of the class matching the where filter
+func (b *BatchManager) DeleteActions(ctx context.Context, principal *models.Principal,
+	params *models.BatchDelete) (*BatchDeleteResult, error) {
+	return b.deleteObjects(ctx, principal, kind.Action, params)
+}
+
+func (b *BatchManager) deleteObjects(ctx context.Context, principal *models.Principal,
+	k kind.Kind, params *models.BatchDelete) (res *BatchDeleteResult, err error) {
+	if params == nil || params.Class == nil || *params.Class == "" {
+		return nil, NewErrInvalidUserInput("invalid param 'class': cannot be empty")
+	}
+	className := *params.Class
+	dryRun := params.DryRun != nil && *params.DryRun
+	verbose := params.Output != nil && *params.Output == models.BatchDeleteOutputVerbose
+
+	defer func() {
+		// the deleted objects are audited page by page, this only records
+		// that the batch delete as a whole failed
+		if !dryRun && err != nil {
+			b.auditDeletes(principal, k, className, nil, err)
+		}
+	}()
+
+	verb := "delete"
+	if dryRun {
+		verb = "list"
+	}
+	err = b.authorizer.Authorize(principal, verb, authorization.BatchResource(k, className))
+	if err != nil {
+		return nil, err
+	}
+
+	if params.Where == nil {
+		return nil, NewErrInvalidUserInput("invalid param 'where': cannot be empty, " +
+			"a filter is required to delete objects in batch")
+	}
+
+	filter, err := filterext.Parse(params.Where)
+	if err != nil {
+		return nil, NewErrInvalidUserInput("invalid param 'where': %v", err)
+	}
+
+	unlock, err := b.locks.LockConnector()
+	if err != nil {
+		return nil, NewErrInternal("could not aquire lock: %v", err)
+	}
+	defer unlock()
+
+	s, err := b.schemaManager.GetSchema(principal)
+	if err != nil {
+		return nil, NewErrInternal("could not get schema: %v", err)
+	}
+
+	if s.GetClass(k, schema.ClassName(className)) == nil {
+		return nil, NewErrInvalidUserInput("no %s class with name '%s'", k.Name(), className)
+	}
+
+	res = &BatchDeleteResult{ClassName: className, DryRun: dryRun}
+	err = b.deleteMatching(ctx, principal, k, className, filter, verbose, res)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// deleteMatching pages through the objects matching the filter and deletes
+// them page by page, unless it is a dry run
+func (b *BatchManager) deleteMatching(ctx context.Context, principal *models.Principal,
+	k kind.Kind, className string, filter *filters.LocalFilter, verbose bool,
+	res *BatchDeleteResult) error {
+	pagination := &filters.Pagination{Limit: batchDeletePageSize}
+	for {
+		page, err := b.vectorRepo.ClassPage(ctx, k, className, filter, pagination)
+		if err != nil {
+			return NewErrInternal("find matching %ss: %v", k.Name(), err)
+		}
+
+		if len(page) == 0 {
+			return nil
+		}
+		pagination.After = page[len(page)-1].ID
+
+		batch := make(BatchDeleteObjects, len(page))
+		for i, item := range page {
+			batch[i] = BatchDeleteObject{
+				OriginalIndex: int(res.Matches) + i,
+				UUID:          item.ID,
+			}
+		}
+		res.Matches += int64(len(page))
+
+		if !res.DryRun {
+			if err := b.deletePage(ctx, principal, k, className, batch, res); err != nil {
+				return err
+			}
+		}
+
+		res.add(batch, verbose)
+	}
+}
+
+// deletePage deletes the objects of a page and enforces the onDelete policies
+// of the references pointing to them like a single delete does. An object
+// which is still referenced through a restrict policy or whose cascaded
+// objects can't be deleted fails without affecting the other objects. The
+// errors are set on the batch.
+func (b *BatchManager) deletePage(ctx context.Context, principal *models.Principal,
+	k kind.Kind, className string, batch BatchDeleteObjects, res *BatchDeleteResult) error {
+	integrity := b.referenceIntegrity()
+	s := b.schemaManager.GetSchemaSkipAuth()
+	page := newDeletion()
+	deleted := map[strfmt.UUID]struct{}{}
+	for i, object := range batch {
+		if _, ok := deleted[object.UUID]; ok {
+			// already deleted through a cascading reference of another object
+			continue
+		}
+
+		d := newDeletion()
+		if err := integrity.plan(ctx, principal, s, d, k, className, object.UUID); err != nil {
+			batch[i].Err = err
+			continue
+		}
+
+		// the cascaded objects are deleted first, so that the matching object
+		// only disappears if all others are gone
+		for j := len(d.objects) - 1; j > 0; j-- {
+			obj := d.objects[j]
+			if _, ok := deleted[obj.id]; ok {
+				continue
+			}
+
+			err := deleteObject(ctx, b.vectorRepo, obj)
+			b.auditObject(principal, "delete", obj.kind, obj.className, obj.id, err)
+			if err != nil {
+				batch[i].Err = err
+				break
+			}
+			deleted[obj.id] = struct{}{}
+		}
+
+		page.merge(d)
+	}
+
+	// objects which were already deleted through a cascade aren't deleted again
+	pending := make(BatchDeleteObjects, 0, len(batch))
+	positions := make([]int, 0, len(batch))
+	for i, object := range batch {
+		if _, ok := deleted[object.UUID]; !ok {
+			pending = append(pending, object)
+			positions = append(positions, i)
+		}
+	}
+
+	pending, err := b.vectorRepo.BatchDeleteObjects(ctx, k, className, pending)
+	if err != nil {
+		return NewErrInternal("batch delete %ss: %v", k.Name(), err)
+	}
+
+	for i, object := range pending {
+		batch[positions[i]].Err = object.Err
+		if object.Err == nil {
+			deleted[object.UUID] = struct{}{}
+		}
+	}
+	b.auditDeletes(principal, k, className, batch, nil)
+
+	// the references are removed once the objects are deleted, referencing
+	// objects which can't be updated are counted, but don't fail the batch
+	removal, _ := integrity.unsetReferences(ctx, principal, page, deleted, b.auditObject)
+	res.ReferencesRemoved += removal.removed
+	res.ReferencesFailed += removal.failed
+
+	return nil
+}
+
+// add counts the objects of a page and lists them if they failed or the
+// output is verbose, as long as the result isn't full
+func (res *BatchDeleteResult) add(batch BatchDeleteObjects, verbose bool) {
+	for _, object := range batch {
+		switch {
+		case object.Err != nil:
+			res.Failed++
+		case res.DryRun:
+		default:
+			res.Successful++
+		}
+
+		if (object.Err != nil || verbose) && len(res.Objects) < batchDeleteMaxObjects {
+			res.Objects = append(res.Objects, object)
+		}
+	}
+}
@@ -0,0 +1,263 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_BatchManager_DeleteThings(t *testing.T) {
+	var (
+		vectorRepo *fakeVectorRepo
+		manager    *BatchManager
+	)
+
+	schemaWith := func(onDelete string) schema.Schema {
+		return schema.Schema{
+			Things: &models.Schema{
+				Classes: []*models.Class{
+					{
+						Class: "Foo",
+						Properties: []*models.Property{
+							{Name: "name", DataType: []string{"string"}},
+						},
+					},
+					{
+						Class: "Bar",
+						Properties: []*models.Property{
+							{Name: "ofFoo", DataType: []string{"Foo"}, OnDelete: onDelete},
+						},
+					},
+				},
+			},
+			Actions: &models.Schema{},
+		}
+	}
+
+	resetWith := func(onDelete string) {
+		vectorRepo = &fakeVectorRepo{}
+		schemaManager := &fakeSchemaManager{
+			GetSchemaResponse: schemaWith(onDelete),
+		}
+		logger, _ := test.NewNullLogger()
+		manager = NewBatchManager(vectorRepo, &fakeVectorizer{}, &fakeLocks{},
+			schemaManager, nil, &config.WeaviateConfig{}, logger, &fakeAuthorizer{})
+	}
+	reset := func() { resetWith("") }
+
+	ctx := context.Background()
+	className := "Foo"
+	name := "foo"
+	where := &models.WhereFilter{
+		Operator:    "Equal",
+		Path:        []string{"name"},
+		ValueString: &name,
+	}
+	matching := []search.Result{
+		{ID: "8b0d0ba8-ef66-4a8d-a9b6-87d6f2a1c3a1", ClassName: "Foo", Kind: kind.Thing},
+		{ID: "8b0d0ba8-ef66-4a8d-a9b6-87d6f2a1c3a2", ClassName: "Foo", Kind: kind.Thing},
+	}
+	last := matching[1].ID
+
+	t.Run("without a filter", func(t *testing.T) {
+		reset()
+		_, err := manager.DeleteThings(ctx, nil, &models.BatchDelete{Class: &className})
+		assert.Equal(t, NewErrInvalidUserInput("invalid param 'where': cannot be "+
+			"empty, a filter is required to delete objects in batch"), err)
+	})
+
+	t.Run("with a class which doesn't exist", func(t *testing.T) {
+		reset()
+		unknown := "Unknown"
+		_, err := manager.DeleteThings(ctx, nil, &models.BatchDelete{
+			Class: &unknown,
+			Where: where,
+		})
+		assert.Equal(t, NewErrInvalidUserInput("no thing class with name 'Unknown'"), err)
+	})
+
+	t.Run("as a dry run", func(t *testing.T) {
+		reset()
+		dryRun := true
+		verbose := models.BatchDeleteOutputVerbose
+		vectorRepo.On("ClassPage", kind.Thing, "Foo", strfmt.UUID("")).
+			Return(matching, nil).Once()
+		vectorRepo.On("ClassPage", kind.Thing, "Foo", last).
+			Return([]search.Result{}, nil).Once()
+
+		res, err := manager.DeleteThings(ctx, nil, &models.BatchDelete{
+			Class:  &className,
+			Where:  where,
+			DryRun: &dryRun,
+			Output: &verbose,
+		})
+		require.Nil(t, err)
+		assert.True(t, res.DryRun)
+		assert.Equal(t, int64(2), res.Matches)
+		assert.Len(t, res.Objects, 2)
+		vectorRepo.AssertNotCalled(t, "BatchDeleteObjects", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("deleting the matching objects", func(t *testing.T) {
+		reset()
+		vectorRepo.On("ClassPage", kind.Thing, "Foo", strfmt.UUID("")).
+			Return(matching, nil).Once()
+		vectorRepo.On("ClassPage", kind.Thing, "Foo", last).
+			Return([]search.Result{}, nil).Once()
+		// nothing references the matching objects
+		vectorRepo.On("ClassPage", kind.Thing, "Bar", strfmt.UUID("")).
+			Return([]search.Result{}, nil).Twice()
+		vectorRepo.On("BatchDeleteObjects", kind.Thing, "Foo", BatchDeleteObjects{
+			{OriginalIndex: 0, UUID: matching[0].ID},
+			{OriginalIndex: 1, UUID: matching[1].ID},
+		}).Return(nil).Once()
+
+		res, err := manager.DeleteThings(ctx, nil, &models.BatchDelete{
+			Class: &className,
+			Where: where,
+		})
+		require.Nil(t, err)
+		assert.False(t, res.DryRun)
+		assert.Equal(t, int64(2), res.Matches)
+		assert.Equal(t, int64(2), res.Successful)
+		assert.Len(t, res.Objects, 0, "only failed objects are listed")
+		vectorRepo.AssertExpectations(t)
+	})
+
+	referencing := func() search.Result {
+		return search.Result{
+			ID:        "4f4c3c57-5fd2-4d2f-9c42-0c6d6d9f1a01",
+			ClassName: "Bar",
+			Kind:      kind.Thing,
+			Vector:    []float32{1, 2},
+			Schema: map[string]interface{}{
+				"ofFoo": models.MultipleRef{
+					{Beacon: strfmt.URI("weaviate://localhost/things/" + matching[0].ID)},
+					{Beacon: "weaviate://localhost/things/4f4c3c57-5fd2-4d2f-9c42-0c6d6d9f1aff"},
+				},
+			},
+		}
+	}
+
+	// onlyFirstReferenced lets the first matching object be referenced by
+	// the referencing object, the second isn't referenced
+	onlyFirstReferenced := func() {
+		vectorRepo.On("ClassPage", kind.Thing, "Foo", strfmt.UUID("")).
+			Return(matching, nil).Once()
+		vectorRepo.On("ClassPage", kind.Thing, "Foo", last).
+			Return([]search.Result{}, nil).Once()
+		vectorRepo.On("ClassPage", kind.Thing, "Bar", strfmt.UUID("")).
+			Return([]search.Result{referencing()}, nil).Once()
+		vectorRepo.On("ClassPage", kind.Thing, "Bar", referencing().ID).
+			Return([]search.Result{}, nil).Once()
+		vectorRepo.On("ClassPage", kind.Thing, "Bar", strfmt.UUID("")).
+			Return([]search.Result{}, nil).Once()
+	}
+
+	t.Run("deleting the matching objects removes the references to them", func(t *testing.T) {
+		reset()
+		onlyFirstReferenced()
+		vectorRepo.On("BatchDeleteObjects", kind.Thing, "Foo", mock.Anything).
+			Return(nil).Once()
+		vectorRepo.On("BatchMerge", mock.MatchedBy(func(batch BatchMergeDocuments) bool {
+			if len(batch) != 1 {
+				return false
+			}
+
+			// only the changed references are merged, the rest of the object,
+			// including its vector, is left as it is stored
+			merge := batch[0].Merge
+			refs, _ := merge.PrimitiveSchema["ofFoo"].(models.MultipleRef)
+			return len(merge.PrimitiveSchema) == 1 && merge.Vector == nil &&
+				merge.ID == referencing().ID && len(refs) == 1 &&
+				refs[0].Beacon == "weaviate://localhost/things/4f4c3c57-5fd2-4d2f-9c42-0c6d6d9f1aff"
+		})).Return(nil).Once()
+
+		res, err := manager.DeleteThings(ctx, nil, &models.BatchDelete{
+			Class: &className,
+			Where: where,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, int64(2), res.Successful)
+		assert.Equal(t, int64(1), res.ReferencesRemoved)
+		assert.Equal(t, int64(0), res.ReferencesFailed)
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("with policy restrict a referenced object fails", func(t *testing.T) {
+		resetWith(models.PropertyOnDeleteRestrict)
+		onlyFirstReferenced()
+		vectorRepo.On("BatchDeleteObjects", kind.Thing, "Foo", mock.MatchedBy(
+			func(batch BatchDeleteObjects) bool {
+				return len(batch) == 2 && batch[0].Err != nil && batch[1].Err == nil
+			})).Return(nil).Once()
+
+		res, err := manager.DeleteThings(ctx, nil, &models.BatchDelete{
+			Class: &className,
+			Where: where,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, int64(1), res.Successful)
+		assert.Equal(t, int64(1), res.Failed)
+		require.Len(t, res.Objects, 1)
+		assert.IsType(t, ErrConflict{}, res.Objects[0].Err)
+		vectorRepo.AssertExpectations(t)
+		vectorRepo.AssertNotCalled(t, "BatchMerge", mock.Anything)
+	})
+
+	t.Run("with policy cascade the referencing objects are deleted", func(t *testing.T) {
+		resetWith(models.PropertyOnDeleteCascade)
+		onlyFirstReferenced()
+		vectorRepo.On("DeleteThing", "Bar", referencing().ID).Return(nil).Once()
+		vectorRepo.On("BatchDeleteObjects", kind.Thing, "Foo", mock.Anything).
+			Return(nil).Once()
+
+		res, err := manager.DeleteThings(ctx, nil, &models.BatchDelete{
+			Class: &className,
+			Where: where,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, int64(2), res.Successful)
+		vectorRepo.AssertExpectations(t)
+		vectorRepo.AssertNotCalled(t, "BatchMerge", mock.Anything)
+	})
+}
+
+func Test_BatchDeleteResult_Add(t *testing.T) {
+	res := &BatchDeleteResult{}
+	batch := make(BatchDeleteObjects, batchDeleteMaxObjects+1)
+	for i := range batch {
+		batch[i] = BatchDeleteObject{OriginalIndex: i, UUID: strfmt.UUID(fmt.Sprintf("%d", i))}
+	}
+	batch[0].Err = fmt.Errorf("not found")
+
+	res.add(batch, true)
+
+	assert.Equal(t, int64(batchDeleteMaxObjects), res.Successful)
+	assert.Equal(t, int64(1), res.Failed)
+	assert.Len(t, res.Objects, batchDeleteMaxObjects, "the listed objects are capped")
+}
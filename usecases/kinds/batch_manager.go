@@ -17,7 +17,11 @@ import (
 	"context"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
 	"github.com/semi-technologies/weaviate/usecases/config"
+	schemaUC "github.com/semi-technologies/weaviate/usecases/schema"
 	"github.com/sirupsen/logrus"
 )
 
@@ -33,6 +37,8 @@ type BatchManager struct {
 	authorizer    authorizer
 	vectorRepo    BatchVectorRepo
 	vectorizer    Vectorizer
+	auditor       auditor
+	refFinder     refFinder
 }
 
 type BatchVectorRepo interface {
@@ -44,6 +50,11 @@ type batchRepoNew interface {
 	BatchPutThings(ctx context.Context, things BatchThings) (BatchThings, error)
 	BatchPutActions(ctx context.Context, actions BatchActions) (BatchActions, error)
 	AddBatchReferences(ctx context.Context, references BatchReferences) (BatchReferences, error)
+	BatchMerge(ctx context.Context, merges BatchMergeDocuments) (BatchMergeDocuments, error)
+	BatchDeleteObjects(ctx context.Context, kind kind.Kind, className string,
+		objects BatchDeleteObjects) (BatchDeleteObjects, error)
+	ClassPage(ctx context.Context, kind kind.Kind, className string,
+		filter *filters.LocalFilter, pagination *filters.Pagination) ([]search.Result, error)
 }
 
 type batchAndGetRepo interface {
@@ -63,5 +74,16 @@ func NewBatchManager(vectorRepo BatchVectorRepo, vectorizer Vectorizer, locks lo
 		vectorRepo:    vectorRepo,
 		vectorizer:    vectorizer,
 		authorizer:    authorizer,
+		refFinder:     schemaUC.NewRefFinder(schemaManager, 1),
+	}
+}
+
+// referenceIntegrity of the batch manager, the references are checked
+// against the vector repo
+func (b *BatchManager) referenceIntegrity() *referenceIntegrity {
+	return &referenceIntegrity{
+		refFinder:  b.refFinder,
+		repo:       b.vectorRepo,
+		authorizer: b.authorizer,
 	}
 }
@@ -0,0 +1,352 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization"
+	"github.com/semi-technologies/weaviate/usecases/kinds/validation"
+	schemaUC "github.com/semi-technologies/weaviate/usecases/schema"
+)
+
+// MergeThings merges partial things into existing things in batch. Every
+// thing is validated like a single merge. A thing is only revectorized if a
+// value which is part of its vector changes.
+func (b *BatchManager) MergeThings(ctx context.Context, principal *models.Principal,
+	things []*models.Thing) (res BatchThings, err error) {
+	defer func() {
+		b.auditThings(principal, "update", res, err)
+	}()
+
+	err = b.authorizer.Authorize(principal, "update", "batch/things")
+	if err != nil {
+		return nil, err
+	}
+
+	unlock, err := b.locks.LockConnector()
+	if err != nil {
+		return nil, NewErrInternal("could not aquire lock: %v", err)
+	}
+	defer unlock()
+
+	return b.mergeThings(ctx, principal, things)
+}
+
+func (b *BatchManager) mergeThings(ctx context.Context, principal *models.Principal,
+	things []*models.Thing) (BatchThings, error) {
+	if len(things) == 0 {
+		return nil, NewErrInvalidUserInput("invalid param 'things': cannot be empty, " +
+			"need at least one thing for batching")
+	}
+
+	s, err := b.schemaManager.GetSchema(principal)
+	if err != nil {
+		return nil, err
+	}
+
+	merges := make(BatchMergeDocuments, len(things))
+	for i, thing := range things {
+		merges[i] = b.mergeThing(ctx, principal, s, i, thing)
+	}
+
+	merges, err = b.vectorRepo.BatchMerge(ctx, merges)
+	if err != nil {
+		return nil, NewErrInternal("batch merge things: %v", err)
+	}
+
+	res := make(BatchThings, len(things))
+	for i, merge := range merges {
+		res[i] = BatchThing{
+			OriginalIndex: i,
+			Err:           merge.Err,
+			Thing:         things[i],
+			UUID:          things[i].ID,
+			Vector:        merge.Merge.Vector,
+		}
+	}
+
+	return res, nil
+}
+
+func (b *BatchManager) mergeThing(ctx context.Context, principal *models.Principal,
+	s schema.Schema, originalIndex int, updated *models.Thing) BatchMergeDocument {
+	res := BatchMergeDocument{OriginalIndex: originalIndex}
+
+	err := b.authorizer.Authorize(principal, "update",
+		authorization.BatchResource(kind.Thing, updated.Class))
+	if err != nil {
+		res.Err = err
+		return res
+	}
+
+	previous, err := b.retrievePreviousAndValidateMergeThing(ctx, s, updated)
+	if err != nil {
+		res.Err = fmt.Errorf("invalid merge: %v", err)
+		return res
+	}
+
+	props, _ := updated.Schema.(map[string]interface{})
+	primitive, refs := splitPrimitiveAndRefs(props, updated.Class, updated.ID, kind.Thing)
+
+	vector := previous.Vector
+	if needsRevectorization(s, updated.Class, previous, primitive, updated.Vector) {
+		merged, err := mergeSchemas(previous.Schema, primitive)
+		if err != nil {
+			res.Err = fmt.Errorf("vectorize merged: %v", err)
+			return res
+		}
+
+		newVector, err := b.vectorizer.Thing(ctx, &models.Thing{
+			Class: updated.Class, Schema: merged, Vector: updated.Vector,
+		})
+		if err != nil {
+			res.Err = fmt.Errorf("vectorize merged: %v", err)
+			return res
+		}
+
+		if newVector != nil {
+			// class without vectorizer and no new vector set by the user keeps
+			// its previous vector
+			vector = newVector
+		}
+	}
+
+	res.Merge = MergeDocument{
+		Kind:            kind.Thing,
+		Class:           updated.Class,
+		ID:              updated.ID,
+		PrimitiveSchema: primitive,
+		References:      refs,
+		Vector:          vector,
+		UpdateTime:      unixNow(),
+	}
+	return res
+}
+
+func (b *BatchManager) retrievePreviousAndValidateMergeThing(ctx context.Context,
+	s schema.Schema, updated *models.Thing) (*search.Result, error) {
+	if updated.ID == "" {
+		return nil, fmt.Errorf("id is a required field")
+	}
+
+	previous, err := previousForMerge(ctx, b.vectorRepo, kind.Thing, updated.ID, updated.Class)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateThingAgainst(ctx, validation.New(s, b.exists, b.network, b.config), updated)
+	if err != nil {
+		return nil, err
+	}
+
+	return previous, nil
+}
+
+// MergeActions merges partial actions into existing actions in batch. Every
+// action is validated like a single merge. An action is only revectorized if
+// a value which is part of its vector changes.
+func (b *BatchManager) MergeActions(ctx context.Context, principal *models.Principal,
+	actions []*models.Action) (res BatchActions, err error) {
+	defer func() {
+		b.auditActions(principal, "update", res, err)
+	}()
+
+	err = b.authorizer.Authorize(principal, "update", "batch/actions")
+	if err != nil {
+		return nil, err
+	}
+
+	unlock, err := b.locks.LockConnector()
+	if err != nil {
+		return nil, NewErrInternal("could not aquire lock: %v", err)
+	}
+	defer unlock()
+
+	return b.mergeActions(ctx, principal, actions)
+}
+
+func (b *BatchManager) mergeActions(ctx context.Context, principal *models.Principal,
+	actions []*models.Action) (BatchActions, error) {
+	if len(actions) == 0 {
+		return nil, NewErrInvalidUserInput("invalid param 'actions': cannot be empty, " +
+			"need at least one action for batching")
+	}
+
+	s, err := b.schemaManager.GetSchema(principal)
+	if err != nil {
+		return nil, err
+	}
+
+	merges := make(BatchMergeDocuments, len(actions))
+	for i, action := range actions {
+		merges[i] = b.mergeAction(ctx, principal, s, i, action)
+	}
+
+	merges, err = b.vectorRepo.BatchMerge(ctx, merges)
+	if err != nil {
+		return nil, NewErrInternal("batch merge actions: %v", err)
+	}
+
+	res := make(BatchActions, len(actions))
+	for i, merge := range merges {
+		res[i] = BatchAction{
+			OriginalIndex: i,
+			Err:           merge.Err,
+			Action:        actions[i],
+			UUID:          actions[i].ID,
+			Vector:        merge.Merge.Vector,
+		}
+	}
+
+	return res, nil
+}
+
+func (b *BatchManager) mergeAction(ctx context.Context, principal *models.Principal,
+	s schema.Schema, originalIndex int, updated *models.Action) BatchMergeDocument {
+	res := BatchMergeDocument{OriginalIndex: originalIndex}
+
+	err := b.authorizer.Authorize(principal, "update",
+		authorization.BatchResource(kind.Action, updated.Class))
+	if err != nil {
+		res.Err = err
+		return res
+	}
+
+	previous, err := b.retrievePreviousAndValidateMergeAction(ctx, s, updated)
+	if err != nil {
+		res.Err = fmt.Errorf("invalid merge: %v", err)
+		return res
+	}
+
+	props, _ := updated.Schema.(map[string]interface{})
+	primitive, refs := splitPrimitiveAndRefs(props, updated.Class, updated.ID, kind.Action)
+
+	vector := previous.Vector
+	if needsRevectorization(s, updated.Class, previous, primitive, updated.Vector) {
+		merged, err := mergeSchemas(previous.Schema, primitive)
+		if err != nil {
+			res.Err = fmt.Errorf("vectorize merged: %v", err)
+			return res
+		}
+
+		newVector, err := b.vectorizer.Action(ctx, &models.Action{
+			Class: updated.Class, Schema: merged, Vector: updated.Vector,
+		})
+		if err != nil {
+			res.Err = fmt.Errorf("vectorize merged: %v", err)
+			return res
+		}
+
+		if newVector != nil {
+			// class without vectorizer and no new vector set by the user keeps
+			// its previous vector
+			vector = newVector
+		}
+	}
+
+	res.Merge = MergeDocument{
+		Kind:            kind.Action,
+		Class:           updated.Class,
+		ID:              updated.ID,
+		PrimitiveSchema: primitive,
+		References:      refs,
+		Vector:          vector,
+		UpdateTime:      unixNow(),
+	}
+	return res
+}
+
+func (b *BatchManager) retrievePreviousAndValidateMergeAction(ctx context.Context,
+	s schema.Schema, updated *models.Action) (*search.Result, error) {
+	if updated.ID == "" {
+		return nil, fmt.Errorf("id is a required field")
+	}
+
+	previous, err := previousForMerge(ctx, b.vectorRepo, kind.Action, updated.ID, updated.Class)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateActionAgainst(ctx, validation.New(s, b.exists, b.network, b.config), updated)
+	if err != nil {
+		return nil, err
+	}
+
+	return previous, nil
+}
+
+// needsRevectorization is true if the user set a new vector, the previous
+// version has no vector yet or the merge changes the value of an indexed
+// property. The values of all types are compared, as a vectorizer may read
+// more than the string values, changes to properties which aren't indexed
+// keep the vector.
+func needsRevectorization(s schema.Schema, className string, previous *search.Result,
+	primitive map[string]interface{}, vector []float32) bool {
+	if len(vector) > 0 {
+		return true
+	}
+
+	class := s.FindClassByName(schema.ClassName(className))
+	if class == nil || schemaUC.Vectorizer(class) == models.ClassVectorizerNone {
+		return false
+	}
+
+	if len(previous.Vector) == 0 {
+		return true
+	}
+
+	previousProps, _ := previous.Schema.(map[string]interface{})
+	for _, prop := range class.Properties {
+		value, ok := primitive[prop.Name]
+		if !ok {
+			continue
+		}
+
+		if prop.Index != nil && !*prop.Index {
+			continue
+		}
+
+		if valueChanged(previousProps[prop.Name], value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// valueChanged compares a stored value with a validated one in their JSON
+// form, which is how the repos store them. The validated values are typed,
+// e.g. int64 or time.Time, while the stored ones come back as float64 or
+// string.
+func valueChanged(previous, value interface{}) bool {
+	previousJSON, err := json.Marshal(previous)
+	if err != nil {
+		return true
+	}
+
+	valueJSON, err := json.Marshal(value)
+	if err != nil {
+		return true
+	}
+
+	return !bytes.Equal(previousJSON, valueJSON)
+}
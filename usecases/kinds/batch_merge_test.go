@@ -0,0 +1,259 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_BatchManager_MergeThings(t *testing.T) {
+	var (
+		vectorRepo *fakeVectorRepo
+		vectorizer *fakeVectorizer
+		manager    *BatchManager
+	)
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		vectorizer = &fakeVectorizer{}
+
+		// the area isn't indexed, so it isn't part of the vector
+		s := zooAnimalSchemaForTest()
+		notIndexed := false
+		for _, prop := range s.FindClassByName("Zoo").Properties {
+			if prop.Name == "area" {
+				prop.Index = &notIndexed
+			}
+		}
+		schemaManager := &fakeSchemaManager{
+			GetSchemaResponse: s,
+		}
+		logger, _ := test.NewNullLogger()
+		manager = NewBatchManager(vectorRepo, vectorizer, &fakeLocks{}, schemaManager,
+			&fakeNetwork{}, &config.WeaviateConfig{}, logger, &fakeAuthorizer{})
+	}
+
+	ctx := context.Background()
+	id := strfmt.UUID("dd59815b-142b-4c54-9b12-482434bd54ca")
+	previous := func() *search.Result {
+		return &search.Result{
+			ID:        id,
+			ClassName: "Zoo",
+			Kind:      kind.Thing,
+			Vector:    []float32{1, 2, 3},
+			Schema: map[string]interface{}{
+				"name": "Zoo of Berlin",
+				"area": 3.2,
+			},
+		}
+	}
+
+	// mergesWith matches the merge documents apart from their update time,
+	// which is set at merge time
+	mergesWith := func(expected ...MergeDocument) interface{} {
+		return mock.MatchedBy(func(batch BatchMergeDocuments) bool {
+			if len(batch) != len(expected) {
+				return false
+			}
+
+			for i, item := range batch {
+				item.Merge.UpdateTime = 0
+				if item.Err == nil && !assert.ObjectsAreEqual(expected[i], item.Merge) {
+					return false
+				}
+			}
+
+			return true
+		})
+	}
+
+	t.Run("without any things", func(t *testing.T) {
+		reset()
+		_, err := manager.MergeThings(ctx, nil, []*models.Thing{})
+		assert.Equal(t, NewErrInvalidUserInput("invalid param 'things': cannot be "+
+			"empty, need at least one thing for batching"), err)
+	})
+
+	t.Run("changing a property which isn't indexed", func(t *testing.T) {
+		reset()
+		vectorRepo.On("ThingByID", id, traverser.SelectProperties(nil), false).
+			Return(previous(), nil)
+		vectorRepo.On("BatchMerge", mergesWith(MergeDocument{
+			Kind:            kind.Thing,
+			Class:           "Zoo",
+			ID:              id,
+			PrimitiveSchema: map[string]interface{}{"area": 4.5},
+			Vector:          []float32{1, 2, 3},
+		})).Return(nil).Once()
+
+		res, err := manager.MergeThings(ctx, nil, []*models.Thing{{
+			ID:     id,
+			Class:  "Zoo",
+			Schema: map[string]interface{}{"area": 4.5},
+		}})
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Nil(t, res[0].Err)
+		assert.Equal(t, []float32{1, 2, 3}, res[0].Vector)
+		vectorRepo.AssertExpectations(t)
+		vectorizer.AssertNotCalled(t, "Thing", mock.Anything)
+	})
+
+	t.Run("setting a vectorized property to its previous value", func(t *testing.T) {
+		reset()
+		vectorRepo.On("ThingByID", id, traverser.SelectProperties(nil), false).
+			Return(previous(), nil)
+		vectorRepo.On("BatchMerge", mock.Anything).Return(nil).Once()
+
+		_, err := manager.MergeThings(ctx, nil, []*models.Thing{{
+			ID:     id,
+			Class:  "Zoo",
+			Schema: map[string]interface{}{"name": "Zoo of Berlin"},
+		}})
+		require.Nil(t, err)
+		vectorizer.AssertNotCalled(t, "Thing", mock.Anything)
+	})
+
+	t.Run("changing a vectorized property", func(t *testing.T) {
+		reset()
+		vectorRepo.On("ThingByID", id, traverser.SelectProperties(nil), false).
+			Return(previous(), nil)
+		vectorizer.On("Thing", &models.Thing{
+			Class: "Zoo",
+			Schema: map[string]interface{}{
+				"name": "Zoo of Hamburg",
+				"area": 3.2,
+			},
+		}).Return([]float32{4, 5, 6}, nil).Once()
+		vectorRepo.On("BatchMerge", mergesWith(MergeDocument{
+			Kind:            kind.Thing,
+			Class:           "Zoo",
+			ID:              id,
+			PrimitiveSchema: map[string]interface{}{"name": "Zoo of Hamburg"},
+			Vector:          []float32{4, 5, 6},
+		})).Return(nil).Once()
+
+		res, err := manager.MergeThings(ctx, nil, []*models.Thing{{
+			ID:     id,
+			Class:  "Zoo",
+			Schema: map[string]interface{}{"name": "Zoo of Hamburg"},
+		}})
+		require.Nil(t, err)
+		assert.Equal(t, []float32{4, 5, 6}, res[0].Vector)
+		vectorRepo.AssertExpectations(t)
+		vectorizer.AssertExpectations(t)
+	})
+
+	t.Run("changing an indexed property which isn't a string", func(t *testing.T) {
+		reset()
+		vectorRepo.On("ThingByID", id, traverser.SelectProperties(nil), false).
+			Return(previous(), nil)
+		vectorizer.On("Thing", mock.Anything).Return([]float32{4, 5, 6}, nil).Once()
+		vectorRepo.On("BatchMerge", mock.Anything).Return(nil).Once()
+
+		res, err := manager.MergeThings(ctx, nil, []*models.Thing{{
+			ID:     id,
+			Class:  "Zoo",
+			Schema: map[string]interface{}{"employees": json.Number("60")},
+		}})
+		require.Nil(t, err)
+		require.Nil(t, res[0].Err)
+		assert.Equal(t, []float32{4, 5, 6}, res[0].Vector, "a vectorizer may read any value")
+		vectorizer.AssertExpectations(t)
+	})
+
+	t.Run("setting indexed properties which aren't strings to their stored values", func(t *testing.T) {
+		reset()
+		stored := previous()
+		stored.Schema = map[string]interface{}{
+			"name":      "Zoo of Berlin",
+			"employees": 60.0,
+			"located":   &models.GeoCoordinates{Latitude: 52.5, Longitude: 13.4},
+			"foundedIn": "1844-08-01T00:00:00Z",
+		}
+		vectorRepo.On("ThingByID", id, traverser.SelectProperties(nil), false).
+			Return(stored, nil)
+		vectorRepo.On("BatchMerge", mock.Anything).Return(nil).Once()
+
+		res, err := manager.MergeThings(ctx, nil, []*models.Thing{{
+			ID:    id,
+			Class: "Zoo",
+			Schema: map[string]interface{}{
+				"employees": json.Number("60"),
+				"located": map[string]interface{}{
+					"latitude":  json.Number("52.5"),
+					"longitude": json.Number("13.4"),
+				},
+				"foundedIn": "1844-08-01T00:00:00Z",
+			},
+		}})
+		require.Nil(t, err)
+		require.Nil(t, res[0].Err)
+		assert.Equal(t, []float32{1, 2, 3}, res[0].Vector)
+		vectorizer.AssertNotCalled(t, "Thing", mock.Anything)
+	})
+
+	t.Run("with invalid items", func(t *testing.T) {
+		reset()
+		otherID := strfmt.UUID("dd59815b-142b-4c54-9b12-482434bd54cb")
+		vectorRepo.On("ThingByID", id, traverser.SelectProperties(nil), false).
+			Return(previous(), nil)
+		vectorRepo.On("ThingByID", otherID, traverser.SelectProperties(nil), false).
+			Return((*search.Result)(nil), nil)
+		vectorRepo.On("BatchMerge", mock.Anything).Return(nil).Once()
+
+		res, err := manager.MergeThings(ctx, nil, []*models.Thing{
+			{
+				Class:  "Zoo",
+				Schema: map[string]interface{}{"area": 4.5},
+			},
+			{
+				ID:     otherID,
+				Class:  "Zoo",
+				Schema: map[string]interface{}{"area": 4.5},
+			},
+			{
+				ID:     id,
+				Class:  "Animal",
+				Schema: map[string]interface{}{"area": 4.5},
+			},
+			{
+				ID:     id,
+				Class:  "Zoo",
+				Schema: map[string]interface{}{"area": 4.5},
+			},
+		})
+		require.Nil(t, err)
+		require.Len(t, res, 4)
+		assert.EqualError(t, res[0].Err, "invalid merge: id is a required field")
+		assert.EqualError(t, res[1].Err, "invalid merge: thing object with id "+
+			"'dd59815b-142b-4c54-9b12-482434bd54cb' does not exist")
+		assert.EqualError(t, res[2].Err, "invalid merge: class is immutable, "+
+			"but got 'Animal' for previous class 'Zoo'")
+		assert.Nil(t, res[3].Err)
+	})
+}
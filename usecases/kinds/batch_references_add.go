@@ -21,13 +21,18 @@ import (
 
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema/crossref"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization"
 )
 
 // AddReferences Class Instances in batch to the connected DB
 func (b *BatchManager) AddReferences(ctx context.Context, principal *models.Principal,
-	refs []*models.BatchReference) (BatchReferences, error) {
+	refs []*models.BatchReference) (res BatchReferences, err error) {
+	defer func() {
+		b.auditReferences(principal, res, err)
+		observeBatchSize("references", referenceClassNames(res))
+	}()
 
-	err := b.authorizer.Authorize(principal, "update", "batch/*")
+	err = b.authorizer.Authorize(principal, "update", "batch/*")
 	if err != nil {
 		return nil, err
 	}
@@ -38,16 +43,17 @@ func (b *BatchManager) AddReferences(ctx context.Context, principal *models.Prin
 	}
 	defer unlock()
 
-	return b.addReferences(ctx, refs)
+	return b.addReferences(ctx, principal, refs)
 }
 
-func (b *BatchManager) addReferences(ctx context.Context, refs []*models.BatchReference) (BatchReferences, error) {
+func (b *BatchManager) addReferences(ctx context.Context, principal *models.Principal,
+	refs []*models.BatchReference) (BatchReferences, error) {
 
 	if err := b.validateReferenceForm(refs); err != nil {
 		return nil, NewErrInvalidUserInput("invalid params: %v", err)
 	}
 
-	batchReferences := b.validateReferencesConcurrently(refs)
+	batchReferences := b.validateReferencesConcurrently(principal, refs)
 	if res, err := b.vectorRepo.AddBatchReferences(ctx, batchReferences); err != nil {
 		return nil, NewErrInternal("could not add batch request to connector: %v", err)
 	} else {
@@ -63,14 +69,15 @@ func (b *BatchManager) validateReferenceForm(refs []*models.BatchReference) erro
 	return nil
 }
 
-func (b *BatchManager) validateReferencesConcurrently(refs []*models.BatchReference) BatchReferences {
+func (b *BatchManager) validateReferencesConcurrently(principal *models.Principal,
+	refs []*models.BatchReference) BatchReferences {
 	c := make(chan BatchReference, len(refs))
 	wg := new(sync.WaitGroup)
 
 	// Generate a goroutine for each separate request
 	for i, ref := range refs {
 		wg.Add(1)
-		go b.validateReference(wg, ref, i, &c)
+		go b.validateReference(principal, wg, ref, i, &c)
 	}
 
 	wg.Wait()
@@ -78,7 +85,7 @@ func (b *BatchManager) validateReferencesConcurrently(refs []*models.BatchRefere
 	return referencesChanToSlice(c)
 }
 
-func (b *BatchManager) validateReference(wg *sync.WaitGroup, ref *models.BatchReference,
+func (b *BatchManager) validateReference(principal *models.Principal, wg *sync.WaitGroup, ref *models.BatchReference,
 	i int, resultsC *chan BatchReference) {
 	defer wg.Done()
 	var errors []error
@@ -88,6 +95,12 @@ func (b *BatchManager) validateReference(wg *sync.WaitGroup, ref *models.BatchRe
 	} else if !source.Local {
 		errors = append(errors, fmt.Errorf("source class must always point to the local peer, but got %s",
 			source.PeerName))
+	} else {
+		err := b.authorizer.Authorize(principal, "update",
+			authorization.BatchResource(source.Kind, source.Class.String()))
+		if err != nil {
+			errors = append(errors, err)
+		}
 	}
 
 	target, err := crossref.Parse(string(ref.To))
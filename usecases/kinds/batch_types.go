@@ -63,6 +63,32 @@ type BatchThing struct {
 // type using the .Response() method
 type BatchThings []BatchThing
 
+// BatchDeleteObject is a helper type that groups the info about one object
+// of a batch delete, i.e. its uuid and error state. Consumers (i.e. database
+// connectors) should skip objects which already have an error.
+type BatchDeleteObject struct {
+	OriginalIndex int
+	Err           error
+	UUID          strfmt.UUID
+}
+
+// BatchDeleteObjects groups many BatchDeleteObject items together, the order
+// matches the order in which the objects matched the filter
+type BatchDeleteObjects []BatchDeleteObject
+
+// BatchMergeDocument is a helper type that groups the info about one object
+// of a batch merge, i.e. the merge document and error state. Consumers (i.e.
+// database connectors) should skip merges which already have an error.
+type BatchMergeDocument struct {
+	OriginalIndex int
+	Err           error
+	Merge         MergeDocument
+}
+
+// BatchMergeDocuments groups many BatchMergeDocument items together, the
+// order matches the order from the original request
+type BatchMergeDocuments []BatchMergeDocument
+
 // BatchReference is a helper type that groups all the info about one references in a
 // batch that belongs together, i.e. from, to, original index and error state
 //
@@ -15,10 +15,10 @@ package kinds
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
 )
 
 type deleteAndGetRepo interface {
@@ -33,8 +33,12 @@ type deleteRepo interface {
 }
 
 // DeleteAction Class Instance from the conncected DB
-func (m *Manager) DeleteAction(ctx context.Context, principal *models.Principal, id strfmt.UUID) error {
-	err := m.authorizer.Authorize(principal, "delete", fmt.Sprintf("actions/%s", id.String()))
+func (m *Manager) DeleteAction(ctx context.Context, principal *models.Principal, id strfmt.UUID) (err error) {
+	var className string
+	defer func() {
+		m.auditObject(principal, "delete", kind.Action, className, id, err)
+	}()
+	className, err = m.authorizeAction(ctx, principal, "delete", id)
 	if err != nil {
 		return err
 	}
@@ -45,27 +49,27 @@ func (m *Manager) DeleteAction(ctx context.Context, principal *models.Principal,
 	}
 	defer unlock()
 
-	return m.deleteActionFromRepo(ctx, id)
+	return m.deleteActionFromRepo(ctx, principal, id)
 }
 
-func (m *Manager) deleteActionFromRepo(ctx context.Context, id strfmt.UUID) error {
+func (m *Manager) deleteActionFromRepo(ctx context.Context, principal *models.Principal,
+	id strfmt.UUID) error {
 	actionRes, err := m.getActionFromRepo(ctx, id, false)
 	if err != nil {
 		return err
 	}
 
 	action := actionRes.Action()
-	err = m.vectorRepo.DeleteAction(ctx, action.Class, id)
-	if err != nil {
-		return NewErrInternal("could not delete action from vector repo: %v", err)
-	}
-
-	return nil
+	return m.deleteWithReferences(ctx, principal, kind.Action, action.Class, id)
 }
 
 // DeleteThing Class Instance from the conncected DB
-func (m *Manager) DeleteThing(ctx context.Context, principal *models.Principal, id strfmt.UUID) error {
-	err := m.authorizer.Authorize(principal, "delete", fmt.Sprintf("things/%s", id.String()))
+func (m *Manager) DeleteThing(ctx context.Context, principal *models.Principal, id strfmt.UUID) (err error) {
+	var className string
+	defer func() {
+		m.auditObject(principal, "delete", kind.Thing, className, id, err)
+	}()
+	className, err = m.authorizeThing(ctx, principal, "delete", id)
 	if err != nil {
 		return err
 	}
@@ -76,21 +80,16 @@ func (m *Manager) DeleteThing(ctx context.Context, principal *models.Principal,
 	}
 	defer unlock()
 
-	return m.deleteThingFromRepo(ctx, id)
+	return m.deleteThingFromRepo(ctx, principal, id)
 }
 
-func (m *Manager) deleteThingFromRepo(ctx context.Context, id strfmt.UUID) error {
-
+func (m *Manager) deleteThingFromRepo(ctx context.Context, principal *models.Principal,
+	id strfmt.UUID) error {
 	thingRes, err := m.getThingFromRepo(ctx, id, false)
 	if err != nil {
 		return err
 	}
 
 	thing := thingRes.Thing()
-	err = m.vectorRepo.DeleteThing(ctx, thing.Class, id)
-	if err != nil {
-		return NewErrInternal("could not delete thing from vector repo: %v", err)
-	}
-
-	return nil
+	return m.deleteWithReferences(ctx, principal, kind.Thing, thing.Class, id)
 }
@@ -35,7 +35,7 @@ func Test_Delete_Action(t *testing.T) {
 		vectorRepo = &fakeVectorRepo{}
 		vectorRepo.On("ActionByID", mock.Anything, mock.Anything, mock.Anything).Return(&search.Result{
 			ClassName: "MyAction",
-		}, nil).Once()
+		}, nil).Twice() // once for authorization, once for the deletion
 		schemaManager := &fakeSchemaManager{}
 		locks := &fakeLocks{}
 		network := &fakeNetwork{}
@@ -70,7 +70,7 @@ func Test_Delete_Thing(t *testing.T) {
 		vectorRepo = &fakeVectorRepo{}
 		vectorRepo.On("ThingByID", mock.Anything, mock.Anything, mock.Anything).Return(&search.Result{
 			ClassName: "MyThing",
-		}, nil).Once()
+		}, nil).Twice() // once for authorization, once for the deletion
 		schemaManager := &fakeSchemaManager{}
 		locks := &fakeLocks{}
 		network := &fakeNetwork{}
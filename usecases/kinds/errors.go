@@ -56,3 +56,18 @@ func (e ErrNotFound) Error() string {
 func NewErrNotFound(format string, args ...interface{}) ErrNotFound {
 	return ErrNotFound{msg: fmt.Sprintf(format, args...)}
 }
+
+// ErrConflict indicates the action can't be performed in the current state,
+// e.g. because the object is still referenced
+type ErrConflict struct {
+	msg string
+}
+
+func (e ErrConflict) Error() string {
+	return e.msg
+}
+
+// NewErrConflict with Errorf signature
+func NewErrConflict(format string, args ...interface{}) ErrConflict {
+	return ErrConflict{msg: fmt.Sprintf(format, args...)}
+}
@@ -0,0 +1,87 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization"
+)
+
+// ExportThings calls fn for every thing of the class including its vector,
+// so that the things can be streamed to the user. The things are in the
+// format accepted by the batch import. The connector is locked for the whole
+// export, errors of fn abort the export.
+func (m *Manager) ExportThings(ctx context.Context, principal *models.Principal,
+	className string, fn func(*models.Thing) error) error {
+	err := m.authorizer.Authorize(principal, "list",
+		authorization.ClassResource(kind.Thing, className))
+	if err != nil {
+		return err
+	}
+
+	return m.export(ctx, principal, kind.Thing, className, func(res search.Result) error {
+		thing := res.Thing()
+		thing.Vector = res.Vector
+		return fn(thing)
+	})
+}
+
+// ExportActions calls fn for every action of the class including its vector,
+// so that the actions can be streamed to the user. The actions are in the
+// format accepted by the batch import. The connector is locked for the whole
+// export, errors of fn abort the export.
+func (m *Manager) ExportActions(ctx context.Context, principal *models.Principal,
+	className string, fn func(*models.Action) error) error {
+	err := m.authorizer.Authorize(principal, "list",
+		authorization.ClassResource(kind.Action, className))
+	if err != nil {
+		return err
+	}
+
+	return m.export(ctx, principal, kind.Action, className, func(res search.Result) error {
+		action := res.Action()
+		action.Vector = res.Vector
+		return fn(action)
+	})
+}
+
+func (m *Manager) export(ctx context.Context, principal *models.Principal,
+	k kind.Kind, className string, fn func(search.Result) error) error {
+	unlock, err := m.locks.LockConnector()
+	if err != nil {
+		return NewErrInternal("could not aquire lock: %v", err)
+	}
+	defer unlock()
+
+	s, err := m.schemaManager.GetSchema(principal)
+	if err != nil {
+		return NewErrInternal("could not get schema: %v", err)
+	}
+
+	if s.GetClass(k, schema.ClassName(className)) == nil {
+		return NewErrNotFound("no %s class with name '%s'", k.Name(), className)
+	}
+
+	err = m.vectorRepo.ScrollClass(ctx, k, className, fn)
+	if err != nil {
+		return NewErrInternal("export %ss: %v", k.Name(), err)
+	}
+
+	return nil
+}
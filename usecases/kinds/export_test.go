@@ -0,0 +1,134 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ExportThings(t *testing.T) {
+	var (
+		vectorRepo *fakeVectorRepo
+		manager    *Manager
+	)
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		schemaManager := &fakeSchemaManager{
+			GetSchemaResponse: schema.Schema{
+				Things: &models.Schema{
+					Classes: []*models.Class{{Class: "Product"}},
+				},
+			},
+		}
+		logger, _ := test.NewNullLogger()
+		manager = NewManager(&fakeLocks{}, schemaManager, &fakeNetwork{}, &config.WeaviateConfig{},
+			logger, &fakeAuthorizer{}, &fakeVectorizer{}, vectorRepo)
+	}
+
+	results := search.Results{
+		{
+			Kind:          kind.Thing,
+			ClassName:     "Product",
+			ID:            "1e6b7d0a-4fd2-4d0b-9a0a-e9c6e4e1e69d",
+			Schema:        map[string]interface{}{"name": "anvil"},
+			Vector:        []float32{1, 2, 3},
+			Created:       1000,
+			Updated:       2000,
+			VectorWeights: map[string]string{"anvil": "0.5"},
+		},
+		{
+			Kind:      kind.Thing,
+			ClassName: "Product",
+			ID:        "e9c12c22-766f-4bde-b140-d4cf8fd6e041",
+			Schema:    map[string]interface{}{"name": "rocket"},
+			Vector:    []float32{4, 5, 6},
+		},
+	}
+
+	t.Run("exporting all things of a class", func(t *testing.T) {
+		reset()
+		vectorRepo.On("ScrollClass", kind.Thing, "Product").Return(results, nil)
+
+		var things []*models.Thing
+		err := manager.ExportThings(context.Background(), nil, "Product",
+			func(thing *models.Thing) error {
+				things = append(things, thing)
+				return nil
+			})
+		require.Nil(t, err)
+
+		expected := []*models.Thing{
+			{
+				ID:                 "1e6b7d0a-4fd2-4d0b-9a0a-e9c6e4e1e69d",
+				Class:              "Product",
+				Schema:             map[string]interface{}{"name": "anvil"},
+				Vector:             models.Vector{1, 2, 3},
+				CreationTimeUnix:   1000,
+				LastUpdateTimeUnix: 2000,
+				VectorWeights:      map[string]string{"anvil": "0.5"},
+			},
+			{
+				ID:     "e9c12c22-766f-4bde-b140-d4cf8fd6e041",
+				Class:  "Product",
+				Schema: map[string]interface{}{"name": "rocket"},
+				Vector: models.Vector{4, 5, 6},
+				// set from the (empty) weights of the search result
+				VectorWeights: map[string]string(nil),
+			},
+		}
+		assert.Equal(t, expected, things)
+	})
+
+	t.Run("an error of the callback aborts the export", func(t *testing.T) {
+		reset()
+		vectorRepo.On("ScrollClass", kind.Thing, "Product").Return(results, nil)
+
+		calls := 0
+		err := manager.ExportThings(context.Background(), nil, "Product",
+			func(thing *models.Thing) error {
+				calls++
+				return errors.New("client went away")
+			})
+		assert.Equal(t, 1, calls)
+		assert.Equal(t, NewErrInternal("export things: client went away"), err)
+	})
+
+	t.Run("exporting a class which doesn't exist", func(t *testing.T) {
+		reset()
+
+		err := manager.ExportThings(context.Background(), nil, "Unknown",
+			func(thing *models.Thing) error { return nil })
+		assert.Equal(t, NewErrNotFound("no thing class with name 'Unknown'"), err)
+	})
+
+	t.Run("exporting a thing class as actions", func(t *testing.T) {
+		reset()
+
+		err := manager.ExportActions(context.Background(), nil, "Product",
+			func(action *models.Action) error { return nil })
+		assert.Equal(t, NewErrNotFound("no action class with name 'Product'"), err)
+	})
+}
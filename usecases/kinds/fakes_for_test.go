@@ -57,6 +57,10 @@ func (f *fakeSchemaManager) GetSchema(principal *models.Principal) (schema.Schem
 	return f.GetSchemaResponse, nil
 }
 
+func (f *fakeSchemaManager) GetSchemaSkipAuth() schema.Schema {
+	return f.GetSchemaResponse
+}
+
 type fakeLocks struct{}
 
 func (f *fakeLocks) LockConnector() (func() error, error) {
@@ -81,7 +85,7 @@ func (f *fakeVectorizer) Action(ctx context.Context, action *models.Action) ([]f
 	return args.Get(0).([]float32), args.Error(1)
 }
 
-func (f *fakeVectorizer) Corpi(ctx context.Context, corpi []string) ([]float32, error) {
+func (f *fakeVectorizer) Corpi(ctx context.Context, className string, corpi []string) ([]float32, error) {
 	panic("not implemented")
 }
 
@@ -146,14 +150,28 @@ func (f *fakeVectorRepo) ActionByID(ctx context.Context,
 	return args.Get(0).(*search.Result), args.Error(1)
 }
 
-func (f *fakeVectorRepo) ThingSearch(ctx context.Context, limit int,
-	filters *filters.LocalFilter, meta bool) (search.Results, error) {
-	return nil, nil
+func (f *fakeVectorRepo) ThingSearch(ctx context.Context, pagination *filters.Pagination,
+	sort []filters.Sort, filters *filters.LocalFilter, meta bool) (search.Results, error) {
+	args := f.Called(pagination, sort)
+	return args.Get(0).(search.Results), args.Error(1)
+}
+
+func (f *fakeVectorRepo) ActionSearch(ctx context.Context, pagination *filters.Pagination,
+	sort []filters.Sort, filters *filters.LocalFilter, meta bool) (search.Results, error) {
+	args := f.Called(pagination, sort)
+	return args.Get(0).(search.Results), args.Error(1)
 }
 
-func (f *fakeVectorRepo) ActionSearch(ctx context.Context, limit int,
-	filters *filters.LocalFilter, meta bool) (search.Results, error) {
-	return nil, nil
+func (f *fakeVectorRepo) ScrollClass(ctx context.Context, k kind.Kind, className string,
+	fn func(search.Result) error) error {
+	args := f.Called(k, className)
+	for _, res := range args.Get(0).(search.Results) {
+		if err := fn(res); err != nil {
+			return err
+		}
+	}
+
+	return args.Error(1)
 }
 
 func (f *fakeVectorRepo) PutThing(ctx context.Context,
@@ -172,6 +190,12 @@ func (f *fakeVectorRepo) BatchPutThings(ctx context.Context, batch BatchThings)
 	return batch, args.Error(0)
 }
 
+func (f *fakeVectorRepo) BatchMerge(ctx context.Context,
+	batch BatchMergeDocuments) (BatchMergeDocuments, error) {
+	args := f.Called(batch)
+	return batch, args.Error(0)
+}
+
 func (f *fakeVectorRepo) BatchPutActions(ctx context.Context, batch BatchActions) (BatchActions, error) {
 	args := f.Called(batch)
 	return batch, args.Error(0)
@@ -204,3 +228,15 @@ func (f *fakeVectorRepo) AddReference(ctx context.Context, kind kind.Kind, sourc
 	args := f.Called(kind, source, prop, ref)
 	return args.Error(0)
 }
+
+func (f *fakeVectorRepo) BatchDeleteObjects(ctx context.Context, k kind.Kind,
+	className string, batch BatchDeleteObjects) (BatchDeleteObjects, error) {
+	args := f.Called(k, className, batch)
+	return batch, args.Error(0)
+}
+
+func (f *fakeVectorRepo) ClassPage(ctx context.Context, k kind.Kind, className string,
+	filter *filters.LocalFilter, pagination *filters.Pagination) ([]search.Result, error) {
+	args := f.Called(k, className, pagination.After)
+	return args.Get(0).([]search.Result), args.Error(1)
+}
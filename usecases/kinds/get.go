@@ -19,7 +19,9 @@ import (
 	"math"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
 	"github.com/semi-technologies/weaviate/entities/search"
 	"github.com/semi-technologies/weaviate/usecases/traverser"
 )
@@ -35,28 +37,37 @@ type getRepo interface {
 // GetThing Class from the connected DB
 func (m *Manager) GetThing(ctx context.Context, principal *models.Principal,
 	id strfmt.UUID, meta bool) (*models.Thing, error) {
-	err := m.authorizer.Authorize(principal, "get", fmt.Sprintf("things/%s", id.String()))
-	if err != nil {
-		return nil, err
-	}
-
 	unlock, err := m.locks.LockConnector()
 	if err != nil {
 		return nil, NewErrInternal("could not aquire lock: %v", err)
 	}
 	defer unlock()
 
-	res, err := m.getThingFromRepo(ctx, id, meta)
+	// the thing is authorized after it was retrieved, as the resource contains
+	// its class
+	res, err := m.vectorRepo.ThingByID(ctx, id, traverser.SelectProperties{}, meta)
+	if err != nil {
+		return nil, NewErrInternal("repo: thing by id: %v", err)
+	}
+
+	err = m.authorizer.Authorize(principal, "get", objectResource(kind.Thing, id, res))
 	if err != nil {
 		return nil, err
 	}
 
+	if res == nil {
+		return nil, NewErrNotFound("no thing with id '%s'", id)
+	}
+
 	return res.Thing(), nil
 }
 
-// GetThings Class from the connected DB
+// GetThings Class from the connected DB. The page is set either through an offset or
+// through a cursor (after), the response contains the cursor for the next page
+// if the current page is full.
 func (m *Manager) GetThings(ctx context.Context, principal *models.Principal,
-	limit *int64, meta bool) ([]*models.Thing, error) {
+	offset, limit *int64, after strfmt.UUID, sort string,
+	meta bool) (*models.ThingsListResponse, error) {
 	err := m.authorizer.Authorize(principal, "list", "things")
 	if err != nil {
 		return nil, err
@@ -68,34 +79,48 @@ func (m *Manager) GetThings(ctx context.Context, principal *models.Principal,
 	}
 	defer unlock()
 
-	return m.getThingsFromRepo(ctx, limit, meta)
+	sortClauses, err := m.listSort(principal, kind.Thing, sort, after)
+	if err != nil {
+		return nil, NewErrInvalidUserInput("invalid sort: %v", err)
+	}
+
+	return m.getThingsFromRepo(ctx, principal, offset, limit, after, sortClauses, meta)
 }
 
 // GetAction Class from connected DB
 func (m *Manager) GetAction(ctx context.Context, principal *models.Principal,
 	id strfmt.UUID, meta bool) (*models.Action, error) {
-	err := m.authorizer.Authorize(principal, "get", fmt.Sprintf("actions/%s", id.String()))
-	if err != nil {
-		return nil, err
-	}
-
 	unlock, err := m.locks.LockConnector()
 	if err != nil {
 		return nil, NewErrInternal("could not aquire lock: %v", err)
 	}
 	defer unlock()
 
-	action, err := m.getActionFromRepo(ctx, id, meta)
+	// the action is authorized after it was retrieved, as the resource contains
+	// its class
+	res, err := m.vectorRepo.ActionByID(ctx, id, traverser.SelectProperties{}, meta)
+	if err != nil {
+		return nil, NewErrInternal("repo: action by id: %v", err)
+	}
+
+	err = m.authorizer.Authorize(principal, "get", objectResource(kind.Action, id, res))
 	if err != nil {
 		return nil, err
 	}
 
-	return action.Action(), nil
+	if res == nil {
+		return nil, NewErrNotFound("no action with id '%s'", id)
+	}
+
+	return res.Action(), nil
 }
 
-// GetActions Class from connected DB
+// GetActions Class from connected DB. The page is set either through an offset or
+// through a cursor (after), the response contains the cursor for the next page
+// if the current page is full.
 func (m *Manager) GetActions(ctx context.Context, principal *models.Principal,
-	limit *int64, meta bool) ([]*models.Action, error) {
+	offset, limit *int64, after strfmt.UUID, sort string,
+	meta bool) (*models.ActionsListResponse, error) {
 	err := m.authorizer.Authorize(principal, "list", "actions")
 	if err != nil {
 		return nil, err
@@ -107,7 +132,12 @@ func (m *Manager) GetActions(ctx context.Context, principal *models.Principal,
 	}
 	defer unlock()
 
-	return m.getActionsFromRepo(ctx, limit, meta)
+	sortClauses, err := m.listSort(principal, kind.Action, sort, after)
+	if err != nil {
+		return nil, NewErrInvalidUserInput("invalid sort: %v", err)
+	}
+
+	return m.getActionsFromRepo(ctx, principal, offset, limit, after, sortClauses, meta)
 }
 
 func (m *Manager) getThingFromRepo(ctx context.Context, id strfmt.UUID, meta bool) (*search.Result, error) {
@@ -123,15 +153,29 @@ func (m *Manager) getThingFromRepo(ctx context.Context, id strfmt.UUID, meta boo
 	return res, nil
 }
 
-func (m *Manager) getThingsFromRepo(ctx context.Context, limit *int64, meta bool) ([]*models.Thing, error) {
-	smartLimit := m.localLimitOrGlobalLimit(limit)
+func (m *Manager) getThingsFromRepo(ctx context.Context, principal *models.Principal,
+	offset, limit *int64, after strfmt.UUID, sort []filters.Sort,
+	meta bool) (*models.ThingsListResponse, error) {
+	pagination, err := m.listPagination(offset, limit, after)
+	if err != nil {
+		return nil, NewErrInvalidUserInput("invalid paging: %v", err)
+	}
 
-	res, err := m.vectorRepo.ThingSearch(ctx, smartLimit, nil, meta)
+	res, err := m.listPage(principal, kind.Thing, pagination,
+		func(pagination *filters.Pagination) (search.Results, error) {
+			return m.vectorRepo.ThingSearch(ctx, pagination, sort, nil, meta)
+		})
 	if err != nil {
 		return nil, NewErrInternal("list things: %v", err)
 	}
 
-	return res.Things(), nil
+	// TotalResults is the number of things in this page, counting all matches
+	// would require to read the entire list
+	return &models.ThingsListResponse{
+		Things:            res.Things(),
+		TotalResults:      int64(len(res)),
+		ContinuationToken: continuationToken(res, pagination.Limit, sort),
+	}, nil
 }
 
 func (m *Manager) getActionFromRepo(ctx context.Context, id strfmt.UUID, meta bool) (*search.Result, error) {
@@ -147,15 +191,79 @@ func (m *Manager) getActionFromRepo(ctx context.Context, id strfmt.UUID, meta bo
 	return res, nil
 }
 
-func (m *Manager) getActionsFromRepo(ctx context.Context, limit *int64, meta bool) ([]*models.Action, error) {
-	smartLimit := m.localLimitOrGlobalLimit(limit)
+func (m *Manager) getActionsFromRepo(ctx context.Context, principal *models.Principal,
+	offset, limit *int64, after strfmt.UUID, sort []filters.Sort,
+	meta bool) (*models.ActionsListResponse, error) {
+	pagination, err := m.listPagination(offset, limit, after)
+	if err != nil {
+		return nil, NewErrInvalidUserInput("invalid paging: %v", err)
+	}
 
-	res, err := m.vectorRepo.ActionSearch(ctx, smartLimit, nil, meta)
+	res, err := m.listPage(principal, kind.Action, pagination,
+		func(pagination *filters.Pagination) (search.Results, error) {
+			return m.vectorRepo.ActionSearch(ctx, pagination, sort, nil, meta)
+		})
 	if err != nil {
 		return nil, NewErrInternal("list actions: %v", err)
 	}
 
-	return res.Actions(), nil
+	// TotalResults is the number of actions in this page, counting all matches
+	// would require to read the entire list
+	return &models.ActionsListResponse{
+		Actions:           res.Actions(),
+		TotalResults:      int64(len(res)),
+		ContinuationToken: continuationToken(res, pagination.Limit, sort),
+	}, nil
+}
+
+// listPage retrieves a page of the list which spans all classes of the kind.
+// It only contains objects of classes the principal may list. If the principal
+// may not list every class, the list is read from its start until the page is
+// full, so that the offset only counts listable objects and consecutive pages
+// neither overlap nor come back short.
+func (m *Manager) listPage(principal *models.Principal, k kind.Kind,
+	pagination *filters.Pagination,
+	query func(*filters.Pagination) (search.Results, error)) (search.Results, error) {
+	listable := m.listableClasses(principal)
+	if listable.all(m.schemaManager.GetSchemaSkipAuth(), k) {
+		res, err := query(pagination)
+		if err != nil {
+			return nil, err
+		}
+
+		return listable.filter(res), nil
+	}
+
+	skip := pagination.Offset
+	next := &filters.Pagination{Limit: pagination.Limit, After: pagination.After}
+	page := search.Results{}
+	for len(page) < pagination.Limit {
+		res, err := query(next)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range listable.filter(res) {
+			switch {
+			case skip > 0:
+				skip--
+			case len(page) < pagination.Limit:
+				page = append(page, item)
+			}
+		}
+
+		if len(res) < next.Limit {
+			break
+		}
+
+		if next.After != "" {
+			next = &filters.Pagination{Limit: next.Limit, After: res[len(res)-1].ID}
+		} else {
+			next = &filters.Pagination{Limit: next.Limit, Offset: next.Offset + len(res)}
+		}
+	}
+
+	return page, nil
 }
 
 func (m *Manager) localLimitOrGlobalLimit(paramMaxResults *int64) int {
@@ -168,3 +276,68 @@ func (m *Manager) localLimitOrGlobalLimit(paramMaxResults *int64) int {
 	// Max results form URL, otherwise max = config.Limit.
 	return int(math.Min(float64(maxResults), float64(m.config.Config.QueryDefaults.Limit)))
 }
+
+func (m *Manager) listPagination(offset, limit *int64,
+	after strfmt.UUID) (*filters.Pagination, error) {
+	pagination := &filters.Pagination{
+		Limit: m.localLimitOrGlobalLimit(limit),
+		After: after,
+	}
+
+	if offset != nil {
+		pagination.Offset = int(*offset)
+	}
+
+	if err := pagination.Validate(); err != nil {
+		return nil, err
+	}
+
+	return pagination, nil
+}
+
+// listSort parses and validates the sort against all classes of the kind, a
+// property only needs to be present in one of them
+func (m *Manager) listSort(principal *models.Principal, k kind.Kind, sort string,
+	after strfmt.UUID) ([]filters.Sort, error) {
+	clauses, err := filters.ParseSort(sort)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(clauses) == 0 {
+		return nil, nil
+	}
+
+	if after != "" {
+		// the cursor is the id of the last result, which only marks a position if
+		// results are ordered by id
+		return nil, fmt.Errorf("sort cannot be combined with a cursor (after), use offset instead")
+	}
+
+	s, err := m.schemaManager.GetSchema(principal)
+	if err != nil {
+		return nil, err
+	}
+
+	var classes []*models.Class
+	if semanticSchema := s.SemanticSchemaFor(k); semanticSchema != nil {
+		classes = semanticSchema.Classes
+	}
+
+	if err := filters.ValidateSort(clauses, classes); err != nil {
+		return nil, err
+	}
+
+	return clauses, nil
+}
+
+// continuationToken is the cursor to retrieve the next page. It is only set
+// if the page is full, as otherwise there can't be any more results. Sorted
+// results can't be continued through a cursor, so there is no token either.
+func continuationToken(res search.Results, limit int, sort []filters.Sort) string {
+	if len(res) == 0 || len(res) < limit || len(sort) > 0 {
+		return ""
+	}
+
+	return res[len(res)-1].ID.String()
+}
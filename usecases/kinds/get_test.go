@@ -0,0 +1,156 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetThings_Pagination(t *testing.T) {
+	var (
+		vectorRepo *fakeVectorRepo
+		manager    *Manager
+	)
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		cfg := &config.WeaviateConfig{}
+		cfg.Config.QueryDefaults.Limit = 20
+		logger, _ := test.NewNullLogger()
+		manager = NewManager(&fakeLocks{}, &fakeSchemaManager{}, &fakeNetwork{}, cfg,
+			logger, &fakeAuthorizer{}, &fakeVectorizer{}, vectorRepo)
+	}
+
+	results := search.Results{
+		{Kind: kind.Thing, ID: "1e6b7d0a-4fd2-4d0b-9a0a-e9c6e4e1e69d"},
+		{Kind: kind.Thing, ID: "e9c12c22-766f-4bde-b140-d4cf8fd6e041"},
+	}
+
+	t.Run("with a full page", func(t *testing.T) {
+		reset()
+		vectorRepo.On("ThingSearch", &filters.Pagination{Limit: 2, Offset: 4}, []filters.Sort(nil)).
+			Return(results, nil)
+
+		res, err := manager.GetThings(context.Background(), nil, ptInt64(4), ptInt64(2), "", "", false)
+		require.Nil(t, err)
+		assert.Len(t, res.Things, 2)
+		assert.Equal(t, int64(2), res.TotalResults)
+		assert.Equal(t, "e9c12c22-766f-4bde-b140-d4cf8fd6e041", res.ContinuationToken,
+			"the token points to the last item of the page")
+	})
+
+	t.Run("with a cursor and a page which isn't full", func(t *testing.T) {
+		reset()
+		vectorRepo.On("ThingSearch", &filters.Pagination{Limit: 20, After: "1e6b7d0a-4fd2-4d0b-9a0a-e9c6e4e1e69d"},
+			[]filters.Sort(nil)).
+			Return(results[1:], nil)
+
+		res, err := manager.GetThings(context.Background(), nil, nil, nil,
+			strfmt.UUID("1e6b7d0a-4fd2-4d0b-9a0a-e9c6e4e1e69d"), "", false)
+		require.Nil(t, err)
+		assert.Len(t, res.Things, 1)
+		assert.Equal(t, "", res.ContinuationToken, "there can't be any more results")
+	})
+
+	t.Run("with both an offset and a cursor", func(t *testing.T) {
+		reset()
+
+		_, err := manager.GetThings(context.Background(), nil, ptInt64(4), nil,
+			strfmt.UUID("1e6b7d0a-4fd2-4d0b-9a0a-e9c6e4e1e69d"), "", false)
+		assert.Equal(t, NewErrInvalidUserInput("invalid paging: offset and after cannot be combined"), err)
+	})
+}
+
+func Test_GetThings_Sort(t *testing.T) {
+	var (
+		vectorRepo *fakeVectorRepo
+		manager    *Manager
+	)
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		cfg := &config.WeaviateConfig{}
+		cfg.Config.QueryDefaults.Limit = 20
+		logger, _ := test.NewNullLogger()
+		schemaManager := &fakeSchemaManager{
+			GetSchemaResponse: schema.Schema{
+				Things: &models.Schema{
+					Classes: []*models.Class{
+						{
+							Class: "Product",
+							Properties: []*models.Property{
+								{Name: "price", DataType: []string{"number"}},
+								{Name: "description", DataType: []string{"text"}},
+							},
+						},
+					},
+				},
+			},
+		}
+		manager = NewManager(&fakeLocks{}, schemaManager, &fakeNetwork{}, cfg,
+			logger, &fakeAuthorizer{}, &fakeVectorizer{}, vectorRepo)
+	}
+
+	results := search.Results{
+		{Kind: kind.Thing, ID: "1e6b7d0a-4fd2-4d0b-9a0a-e9c6e4e1e69d"},
+		{Kind: kind.Thing, ID: "e9c12c22-766f-4bde-b140-d4cf8fd6e041"},
+	}
+
+	t.Run("by a property and the creation time", func(t *testing.T) {
+		reset()
+		vectorRepo.On("ThingSearch", &filters.Pagination{Limit: 2}, []filters.Sort{
+			{Path: []string{"price"}, Order: filters.SortOrderDesc},
+			{Path: []string{"_created"}, Order: filters.SortOrderAsc},
+		}).Return(results, nil)
+
+		res, err := manager.GetThings(context.Background(), nil, nil, ptInt64(2), "",
+			"price:desc,_created", false)
+		require.Nil(t, err)
+		assert.Len(t, res.Things, 2)
+		assert.Equal(t, "", res.ContinuationToken,
+			"sorted results can't be continued through a cursor")
+	})
+
+	t.Run("by a property which can't be sorted", func(t *testing.T) {
+		reset()
+
+		_, err := manager.GetThings(context.Background(), nil, nil, nil, "",
+			"description", false)
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+	})
+
+	t.Run("combined with a cursor", func(t *testing.T) {
+		reset()
+
+		_, err := manager.GetThings(context.Background(), nil, nil, nil,
+			strfmt.UUID("1e6b7d0a-4fd2-4d0b-9a0a-e9c6e4e1e69d"), "price", false)
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+	})
+}
+
+func ptInt64(in int64) *int64 {
+	return &in
+}
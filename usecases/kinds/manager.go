@@ -27,10 +27,12 @@ import (
 	uuid "github.com/satori/go.uuid"
 	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/schema/kind"
 	"github.com/semi-technologies/weaviate/entities/search"
 	"github.com/semi-technologies/weaviate/usecases/config"
 	"github.com/semi-technologies/weaviate/usecases/network/common/peers"
+	schemaUC "github.com/semi-technologies/weaviate/usecases/schema"
 	"github.com/semi-technologies/weaviate/usecases/traverser"
 	"github.com/sirupsen/logrus"
 )
@@ -47,6 +49,8 @@ type Manager struct {
 	vectorizer    Vectorizer
 	vectorRepo    VectorRepo
 	timeSource    timeSource
+	auditor       auditor
+	refFinder     refFinder
 }
 
 type timeSource interface {
@@ -67,6 +71,11 @@ type authorizer interface {
 	Authorize(principal *models.Principal, verb, resource string) error
 }
 
+// refFinder lists the paths of all reference properties pointing to a class
+type refFinder interface {
+	Find(className schema.ClassName) []filters.Path
+}
+
 type network interface {
 	ListPeers() (peers.Peers, error)
 }
@@ -81,13 +90,21 @@ type VectorRepo interface {
 	ThingByID(ctx context.Context, id strfmt.UUID, props traverser.SelectProperties, meta bool) (*search.Result, error)
 	ActionByID(ctx context.Context, id strfmt.UUID, props traverser.SelectProperties, meta bool) (*search.Result, error)
 
-	ThingSearch(ctx context.Context, limit int, filters *filters.LocalFilter, meta bool) (search.Results, error)
-	ActionSearch(ctx context.Context, limit int, filters *filters.LocalFilter, meta bool) (search.Results, error)
+	ThingSearch(ctx context.Context, pagination *filters.Pagination, sort []filters.Sort,
+		filters *filters.LocalFilter, meta bool) (search.Results, error)
+	ActionSearch(ctx context.Context, pagination *filters.Pagination, sort []filters.Sort,
+		filters *filters.LocalFilter, meta bool) (search.Results, error)
 
 	Exists(ctx context.Context, id strfmt.UUID) (bool, error)
 
+	ScrollClass(ctx context.Context, kind kind.Kind, className string, fn func(search.Result) error) error
+
 	AddReference(ctx context.Context, kind kind.Kind, source strfmt.UUID, propName string, ref *models.SingleRef) error
 	Merge(ctx context.Context, merge MergeDocument) error
+
+	ClassPage(ctx context.Context, kind kind.Kind, className string,
+		filter *filters.LocalFilter, pagination *filters.Pagination) ([]search.Result, error)
+	BatchMerge(ctx context.Context, merges BatchMergeDocuments) (BatchMergeDocuments, error)
 }
 
 // NewManager creates a new manager
@@ -104,6 +121,7 @@ func NewManager(locks locks, schemaManager schemaManager,
 		authorizer:    authorizer,
 		vectorRepo:    vectorRepo,
 		timeSource:    defaultTimeSource{},
+		refFinder:     schemaUC.NewRefFinder(schemaManager, 1),
 	}
 }
 
@@ -36,9 +36,13 @@ type MergeDocument struct {
 }
 
 func (m *Manager) MergeAction(ctx context.Context, principal *models.Principal,
-	id strfmt.UUID, updated *models.Action) error {
+	id strfmt.UUID, updated *models.Action) (err error) {
+	var className string
+	defer func() {
+		m.auditObject(principal, "update", kind.Action, className, id, err)
+	}()
 
-	err := m.authorizer.Authorize(principal, "update", fmt.Sprintf("actions/%s", id.String()))
+	className, err = m.authorizeAction(ctx, principal, "update", id)
 	if err != nil {
 		return err
 	}
@@ -47,14 +51,20 @@ func (m *Manager) MergeAction(ctx context.Context, principal *models.Principal,
 	if err != nil {
 		return NewErrInvalidUserInput("invalid merge: %v", err)
 	}
-	primitive, refs := m.splitPrimitiveAndRefs(updated.Schema.(map[string]interface{}),
+	primitive, refs := splitPrimitiveAndRefs(updated.Schema.(map[string]interface{}),
 		updated.Class, id, kind.Action)
 
-	vector, err := m.mergeActionSchemasAndVectorize(ctx, previous.ClassName, previous.Schema, primitive)
+	vector, err := m.mergeActionSchemasAndVectorize(ctx, previous.ClassName, previous.Schema,
+		primitive, updated.Vector)
 	if err != nil {
 		return NewErrInternal("vectorize merged: %v", err)
 	}
 
+	if vector == nil {
+		// class without vectorizer and no new vector set by the user
+		vector = previous.Vector
+	}
+
 	err = m.vectorRepo.Merge(ctx, MergeDocument{
 		Kind:            kind.Action,
 		Class:           updated.Class,
@@ -74,24 +84,11 @@ func (m *Manager) MergeAction(ctx context.Context, principal *models.Principal,
 func (m *Manager) retrievePreviousAndValidateMergeAction(ctx context.Context, principal *models.Principal,
 	id strfmt.UUID, updated *models.Action) (*search.Result, error) {
 
-	if updated.Class == "" {
-		return nil, fmt.Errorf("class is a required (and immutable) field")
-	}
-
-	action, err := m.vectorRepo.ActionByID(ctx, id, nil, false)
+	action, err := previousForMerge(ctx, m.vectorRepo, kind.Action, id, updated.Class)
 	if err != nil {
 		return nil, err
 	}
 
-	if action == nil {
-		return nil, fmt.Errorf("action object with id '%s' does not exist", id)
-	}
-
-	if action.ClassName != updated.Class {
-		return nil, fmt.Errorf("class is immutable, but got '%s' for previous class '%s'",
-			updated.Class, action.ClassName)
-	}
-
 	updated.ID = id
 	err = m.validateAction(ctx, principal, updated)
 	if err != nil {
@@ -102,30 +99,23 @@ func (m *Manager) retrievePreviousAndValidateMergeAction(ctx context.Context, pr
 }
 
 func (m *Manager) mergeActionSchemasAndVectorize(ctx context.Context, className string,
-	old interface{}, new map[string]interface{}) ([]float32, error) {
-	var merged map[string]interface{}
-	if old == nil {
-		merged = new
-	} else {
-		oldMap, ok := old.(map[string]interface{})
-		if !ok {
-			return nil, fmt.Errorf("expected previous schema to be map, but got %#v", old)
-		}
-
-		for key, value := range new {
-			oldMap[key] = value
-		}
-
-		merged = oldMap
+	old interface{}, new map[string]interface{}, vector []float32) ([]float32, error) {
+	merged, err := mergeSchemas(old, new)
+	if err != nil {
+		return nil, err
 	}
 
-	return m.vectorizer.Action(ctx, &models.Action{Class: className, Schema: merged})
+	return m.vectorizer.Action(ctx, &models.Action{Class: className, Schema: merged, Vector: vector})
 }
 
 func (m *Manager) MergeThing(ctx context.Context, principal *models.Principal,
-	id strfmt.UUID, updated *models.Thing) error {
+	id strfmt.UUID, updated *models.Thing) (err error) {
+	var className string
+	defer func() {
+		m.auditObject(principal, "update", kind.Thing, className, id, err)
+	}()
 
-	err := m.authorizer.Authorize(principal, "update", fmt.Sprintf("things/%s", id.String()))
+	className, err = m.authorizeThing(ctx, principal, "update", id)
 	if err != nil {
 		return err
 	}
@@ -134,14 +124,20 @@ func (m *Manager) MergeThing(ctx context.Context, principal *models.Principal,
 	if err != nil {
 		return NewErrInvalidUserInput("invalid merge: %v", err)
 	}
-	primitive, refs := m.splitPrimitiveAndRefs(updated.Schema.(map[string]interface{}),
+	primitive, refs := splitPrimitiveAndRefs(updated.Schema.(map[string]interface{}),
 		updated.Class, id, kind.Thing)
 
-	vector, err := m.mergeThingSchemasAndVectorize(ctx, previous.ClassName, previous.Schema, primitive)
+	vector, err := m.mergeThingSchemasAndVectorize(ctx, previous.ClassName, previous.Schema,
+		primitive, updated.Vector)
 	if err != nil {
 		return NewErrInternal("vectorize merged: %v", err)
 	}
 
+	if vector == nil {
+		// class without vectorizer and no new vector set by the user
+		vector = previous.Vector
+	}
+
 	err = m.vectorRepo.Merge(ctx, MergeDocument{
 		Kind:            kind.Thing,
 		Class:           updated.Class,
@@ -161,55 +157,85 @@ func (m *Manager) MergeThing(ctx context.Context, principal *models.Principal,
 func (m *Manager) retrievePreviousAndValidateMergeThing(ctx context.Context, principal *models.Principal,
 	id strfmt.UUID, updated *models.Thing) (*search.Result, error) {
 
-	if updated.Class == "" {
-		return nil, fmt.Errorf("class is a required (and immutable) field")
+	thing, err := previousForMerge(ctx, m.vectorRepo, kind.Thing, id, updated.Class)
+	if err != nil {
+		return nil, err
 	}
 
-	thing, err := m.vectorRepo.ThingByID(ctx, id, nil, false)
+	updated.ID = id
+	err = m.validateThing(ctx, principal, updated)
 	if err != nil {
 		return nil, err
 	}
 
-	if thing == nil {
-		return nil, fmt.Errorf("thing object with id '%s' does not exist", id)
+	return thing, nil
+}
+
+func (m *Manager) mergeThingSchemasAndVectorize(ctx context.Context, className string,
+	old interface{}, new map[string]interface{}, vector []float32) ([]float32, error) {
+	merged, err := mergeSchemas(old, new)
+	if err != nil {
+		return nil, err
 	}
 
-	if thing.ClassName != updated.Class {
-		return nil, fmt.Errorf("class is immutable, but got '%s' for previous class '%s'",
-			updated.Class, thing.ClassName)
+	return m.vectorizer.Thing(ctx, &models.Thing{Class: className, Schema: merged, Vector: vector})
+}
+
+// previousForMerge retrieves the object a merge is applied to. The class of
+// the merge is required and must match the class of the existing object.
+func previousForMerge(ctx context.Context, repo VectorRepo, k kind.Kind,
+	id strfmt.UUID, className string) (*search.Result, error) {
+	if className == "" {
+		return nil, fmt.Errorf("class is a required (and immutable) field")
 	}
 
-	updated.ID = id
-	err = m.validateThing(ctx, principal, updated)
+	var (
+		previous *search.Result
+		err      error
+	)
+	switch k {
+	case kind.Thing:
+		previous, err = repo.ThingByID(ctx, id, nil, false)
+	case kind.Action:
+		previous, err = repo.ActionByID(ctx, id, nil, false)
+	default:
+		return nil, fmt.Errorf("impossible kind: %v", k)
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	return thing, nil
+	if previous == nil {
+		return nil, fmt.Errorf("%s object with id '%s' does not exist", k.Name(), id)
+	}
+
+	if previous.ClassName != className {
+		return nil, fmt.Errorf("class is immutable, but got '%s' for previous class '%s'",
+			className, previous.ClassName)
+	}
+
+	return previous, nil
 }
 
-func (m *Manager) mergeThingSchemasAndVectorize(ctx context.Context, className string,
-	old interface{}, new map[string]interface{}) ([]float32, error) {
-	var merged map[string]interface{}
+// mergeSchemas sets the new values on the previous schema
+func mergeSchemas(old interface{}, new map[string]interface{}) (map[string]interface{}, error) {
 	if old == nil {
-		merged = new
-	} else {
-		oldMap, ok := old.(map[string]interface{})
-		if !ok {
-			return nil, fmt.Errorf("expected previous schema to be map, but got %#v", old)
-		}
+		return new, nil
+	}
 
-		for key, value := range new {
-			oldMap[key] = value
-		}
+	oldMap, ok := old.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected previous schema to be map, but got %#v", old)
+	}
 
-		merged = oldMap
+	for key, value := range new {
+		oldMap[key] = value
 	}
 
-	return m.vectorizer.Thing(ctx, &models.Thing{Class: className, Schema: merged})
+	return oldMap, nil
 }
 
-func (m *Manager) splitPrimitiveAndRefs(in map[string]interface{}, sourceClass string,
+func splitPrimitiveAndRefs(in map[string]interface{}, sourceClass string,
 	sourceID strfmt.UUID, sourceKind kind.Kind) (map[string]interface{}, BatchReferences) {
 	primitive := map[string]interface{}{}
 	var outRefs BatchReferences
@@ -382,6 +382,39 @@ func Test_MergeThing(t *testing.T) {
 				},
 			},
 		},
+		testCase{
+			id:   "dd59815b-142b-4c54-9b12-482434bd54ca",
+			name: "with a user-provided vector",
+			previous: &models.Thing{
+				Class:  "Zoo",
+				Schema: map[string]interface{}{},
+			},
+			updated: &models.Thing{
+				Class: "Zoo",
+				Schema: map[string]interface{}{
+					"name": "My little pony zoo with extra sparkles",
+				},
+				Vector: []float32{1, 2, 3},
+			},
+			expectedErr: nil,
+			vectorizerCalledWith: &models.Thing{
+				Class: "Zoo",
+				Schema: map[string]interface{}{
+					"name": "My little pony zoo with extra sparkles",
+				},
+				Vector: []float32{1, 2, 3},
+			},
+			expectedOutput: &MergeDocument{
+				UpdateTime: 12345,
+				Kind:       kind.Thing,
+				Class:      "Zoo",
+				ID:         "dd59815b-142b-4c54-9b12-482434bd54ca",
+				Vector:     []float32{1, 2, 3},
+				PrimitiveSchema: map[string]interface{}{
+					"name": "My little pony zoo with extra sparkles",
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,64 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/monitoring"
+)
+
+// observeBatchSize records how many items of each class were part of a
+// single batch request
+func observeBatchSize(operation string, classNames []string) {
+	sizes := map[string]int{}
+	for _, className := range classNames {
+		sizes[className]++
+	}
+
+	for className, size := range sizes {
+		monitoring.GetMetrics().BatchSize.
+			WithLabelValues(className, operation).
+			Observe(float64(size))
+	}
+}
+
+func thingClassNames(things []*models.Thing) []string {
+	out := make([]string, len(things))
+	for i, thing := range things {
+		if thing != nil {
+			out[i] = thing.Class
+		}
+	}
+	return out
+}
+
+func actionClassNames(actions []*models.Action) []string {
+	out := make([]string, len(actions))
+	for i, action := range actions {
+		if action != nil {
+			out[i] = action.Class
+		}
+	}
+	return out
+}
+
+func referenceClassNames(refs BatchReferences) []string {
+	out := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		if ref.From != nil {
+			out = append(out, ref.From.Class.String())
+		}
+	}
+	return out
+}
@@ -15,7 +15,6 @@ package kinds
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/semi-technologies/weaviate/entities/models"
@@ -28,9 +27,13 @@ import (
 // ref, it has a side-effect on the schema: The schema will be updated to
 // include this particular network ref class.
 func (m *Manager) AddActionReference(ctx context.Context, principal *models.Principal,
-	id strfmt.UUID, propertyName string, property *models.SingleRef) error {
+	id strfmt.UUID, propertyName string, property *models.SingleRef) (err error) {
+	var className string
+	defer func() {
+		m.auditObject(principal, "update", kind.Action, className, id, err)
+	}()
 
-	err := m.authorizer.Authorize(principal, "update", fmt.Sprintf("actions/%s", id.String()))
+	className, err = m.authorizeAction(ctx, principal, "update", id)
 	if err != nil {
 		return err
 	}
@@ -83,9 +86,13 @@ func (m *Manager) addActionReferenceToConnectorAndSchema(ctx context.Context, pr
 // ref, it has a side-effect on the schema: The schema will be updated to
 // include this particular network ref class.
 func (m *Manager) AddThingReference(ctx context.Context, principal *models.Principal,
-	id strfmt.UUID, propertyName string, property *models.SingleRef) error {
+	id strfmt.UUID, propertyName string, property *models.SingleRef) (err error) {
+	var className string
+	defer func() {
+		m.auditObject(principal, "update", kind.Thing, className, id, err)
+	}()
 
-	err := m.authorizer.Authorize(principal, "update", fmt.Sprintf("things/%s", id.String()))
+	className, err = m.authorizeThing(ctx, principal, "update", id)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,246 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/crossref"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization"
+)
+
+// CheckDanglingReferences scans all objects with reference properties, or only
+// those of a single class, for references to objects which no longer exist.
+// If repair is set, the dangling references are removed. Network references
+// are skipped, as they can't be verified locally.
+func (m *Manager) CheckDanglingReferences(ctx context.Context, principal *models.Principal,
+	params *models.DanglingReferencesCheck) (*models.DanglingReferencesReport, error) {
+	if params == nil {
+		params = &models.DanglingReferencesCheck{}
+	}
+	repair := params.Repair != nil && *params.Repair
+
+	class := params.Class
+	if class == "" {
+		class = authorization.AllClasses
+	}
+	verb := "list"
+	if repair {
+		verb = "update"
+	}
+	err := m.authorizer.Authorize(principal, verb, authorization.DanglingReferencesResource(class))
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := m.schemaManager.GetSchema(principal)
+	if err != nil {
+		return nil, NewErrInternal("could not get schema: %v", err)
+	}
+
+	classes := classesWithReferences(s)
+	if params.Class != "" {
+		if _, ok := s.GetKindOfClass(schema.ClassName(params.Class)); !ok {
+			return nil, NewErrInvalidUserInput("no class with name '%s'", params.Class)
+		}
+		classes = classesWithReferencesFiltered(classes, params.Class)
+	}
+
+	unlock, err := m.locks.LockConnector()
+	if err != nil {
+		return nil, NewErrInternal("could not aquire lock: %v", err)
+	}
+	defer unlock()
+
+	c := &danglingCheck{
+		repo:   m.vectorRepo,
+		repair: repair,
+		exists: map[strfmt.UUID]bool{},
+		report: &models.DanglingReferencesReport{
+			Repair:             repair,
+			DanglingReferences: []*models.DanglingReference{},
+		},
+	}
+	for _, class := range classes {
+		if err := c.checkClass(ctx, class); err != nil {
+			return nil, NewErrInternal("check dangling references: %v", err)
+		}
+	}
+
+	return c.report, nil
+}
+
+// classesWithReferences lists all classes which have at least one reference
+// property together with those properties
+func classesWithReferences(s schema.Schema) []referencingClass {
+	var out []referencingClass
+	for _, k := range []kind.Kind{kind.Thing, kind.Action} {
+		semanticSchema := s.SemanticSchemaFor(k)
+		if semanticSchema == nil {
+			continue
+		}
+
+		for _, class := range semanticSchema.Classes {
+			var props []string
+			for _, prop := range class.Properties {
+				dt, err := s.FindPropertyDataType(prop.DataType)
+				if err != nil || dt.IsPrimitive() {
+					continue
+				}
+
+				props = append(props, prop.Name)
+			}
+
+			if len(props) > 0 {
+				out = append(out, referencingClass{
+					kind:       k,
+					className:  class.Class,
+					properties: props,
+				})
+			}
+		}
+	}
+
+	return out
+}
+
+func classesWithReferencesFiltered(classes []referencingClass,
+	className string) []referencingClass {
+	var out []referencingClass
+	for _, class := range classes {
+		if class.className == className {
+			out = append(out, class)
+		}
+	}
+
+	return out
+}
+
+type danglingReferencesRepo interface {
+	referencesRepo
+	Exists(ctx context.Context, id strfmt.UUID) (bool, error)
+}
+
+type danglingCheck struct {
+	repo   danglingReferencesRepo
+	repair bool
+	report *models.DanglingReferencesReport
+
+	// exists caches whether a target exists, as many objects usually
+	// reference the same targets
+	exists map[strfmt.UUID]bool
+}
+
+func (c *danglingCheck) checkClass(ctx context.Context, class referencingClass) error {
+	pagination := &filters.Pagination{Limit: referencesPageSize}
+	for {
+		page, err := c.repo.ClassPage(ctx, class.kind, class.className, nil, pagination)
+		if err != nil {
+			return err
+		}
+
+		if len(page) == 0 {
+			return nil
+		}
+		pagination.After = page[len(page)-1].ID
+		c.report.ObjectsScanned += int64(len(page))
+
+		var changed []referencingObject
+		for _, item := range page {
+			dangling, err := c.danglingTargets(ctx, class, item)
+			if err != nil {
+				return err
+			}
+
+			if c.repair && len(dangling) > 0 {
+				removeBeacons(item, class.properties, dangling)
+				changed = append(changed,
+					referencingObject{item: item, properties: class.properties})
+			}
+		}
+
+		if len(changed) > 0 {
+			failed := storeReferencing(ctx, c.repo, class.kind, changed)
+			c.report.ObjectsFailed += failed
+			c.report.ObjectsRepaired += int64(len(changed)) - failed
+		}
+	}
+}
+
+// danglingTargets reports all references of the item to objects which don't
+// exist and returns the ids of those objects
+func (c *danglingCheck) danglingTargets(ctx context.Context, class referencingClass,
+	item search.Result) (map[strfmt.UUID]struct{}, error) {
+	props, ok := item.Schema.(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+
+	dangling := map[strfmt.UUID]struct{}{}
+	for _, prop := range class.properties {
+		refs, ok := props[prop].(models.MultipleRef)
+		if !ok {
+			continue
+		}
+
+		for _, ref := range refs {
+			parsed, err := crossref.Parse(ref.Beacon.String())
+			if err != nil || !parsed.Local {
+				// network refs can't be verified locally
+				continue
+			}
+
+			ok, err := c.targetExists(ctx, parsed.TargetID)
+			if err != nil {
+				return nil, err
+			}
+
+			if ok {
+				continue
+			}
+
+			dangling[parsed.TargetID] = struct{}{}
+			c.report.DanglingReferences = append(c.report.DanglingReferences,
+				&models.DanglingReference{
+					Kind:     class.kind.Name(),
+					Class:    class.className,
+					ID:       item.ID,
+					Property: prop,
+					Beacon:   ref.Beacon,
+				})
+		}
+	}
+
+	return dangling, nil
+}
+
+func (c *danglingCheck) targetExists(ctx context.Context, id strfmt.UUID) (bool, error) {
+	if ok, cached := c.exists[id]; cached {
+		return ok, nil
+	}
+
+	ok, err := c.repo.Exists(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	c.exists[id] = ok
+	return ok, nil
+}
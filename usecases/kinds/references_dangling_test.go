@@ -0,0 +1,135 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CheckDanglingReferences(t *testing.T) {
+	var (
+		vectorRepo *fakeVectorRepo
+		manager    *Manager
+	)
+
+	existingID := strfmt.UUID("8b0d0ba8-ef66-4a8d-a9b6-87d6f2a1c3a1")
+	goneID := strfmt.UUID("8b0d0ba8-ef66-4a8d-a9b6-87d6f2a1c3a2")
+	carID := strfmt.UUID("4f4c3c57-5fd2-4d2f-9c42-0c6d6d9f1a01")
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		vectorRepo.On("ClassPage", kind.Thing, "Car", strfmt.UUID("")).
+			Return([]search.Result{{
+				ID:        carID,
+				ClassName: "Car",
+				Kind:      kind.Thing,
+				Schema: map[string]interface{}{
+					"ownedBy": models.MultipleRef{
+						{Beacon: strfmt.URI("weaviate://localhost/things/" + existingID)},
+						{Beacon: strfmt.URI("weaviate://localhost/things/" + goneID)},
+					},
+				},
+			}}, nil).Once()
+		vectorRepo.On("ClassPage", kind.Thing, "Car", carID).
+			Return([]search.Result{}, nil).Once()
+		vectorRepo.On("Exists", existingID).Return(true, nil).Once()
+		vectorRepo.On("Exists", goneID).Return(false, nil).Once()
+
+		schemaManager := &fakeSchemaManager{
+			GetSchemaResponse: schema.Schema{
+				Things: &models.Schema{
+					Classes: []*models.Class{
+						{Class: "Owner"},
+						{
+							Class: "Car",
+							Properties: []*models.Property{
+								{Name: "name", DataType: []string{"string"}},
+								{Name: "ownedBy", DataType: []string{"Owner"}},
+							},
+						},
+					},
+				},
+				Actions: &models.Schema{},
+			},
+		}
+		logger, _ := test.NewNullLogger()
+		manager = NewManager(&fakeLocks{}, schemaManager, &fakeNetwork{},
+			&config.WeaviateConfig{}, logger, &fakeAuthorizer{}, &fakeVectorizer{}, vectorRepo)
+	}
+
+	ctx := context.Background()
+	expected := []*models.DanglingReference{{
+		Kind:     "thing",
+		Class:    "Car",
+		ID:       carID,
+		Property: "ownedBy",
+		Beacon:   strfmt.URI("weaviate://localhost/things/" + goneID),
+	}}
+
+	t.Run("with a class which doesn't exist", func(t *testing.T) {
+		reset()
+		_, err := manager.CheckDanglingReferences(ctx, nil,
+			&models.DanglingReferencesCheck{Class: "Unknown"})
+		assert.Equal(t, NewErrInvalidUserInput("no class with name 'Unknown'"), err)
+	})
+
+	t.Run("only reporting the dangling references", func(t *testing.T) {
+		reset()
+		res, err := manager.CheckDanglingReferences(ctx, nil, nil)
+		require.Nil(t, err)
+		assert.False(t, res.Repair)
+		assert.Equal(t, int64(1), res.ObjectsScanned)
+		assert.Equal(t, int64(0), res.ObjectsRepaired)
+		assert.Equal(t, expected, res.DanglingReferences)
+		vectorRepo.AssertExpectations(t)
+		vectorRepo.AssertNotCalled(t, "BatchMerge", mock.Anything)
+	})
+
+	t.Run("repairing the dangling references", func(t *testing.T) {
+		reset()
+		repair := true
+		vectorRepo.On("BatchMerge", mock.MatchedBy(func(batch BatchMergeDocuments) bool {
+			if len(batch) != 1 {
+				return false
+			}
+
+			merge := batch[0].Merge
+			refs, _ := merge.PrimitiveSchema["ownedBy"].(models.MultipleRef)
+			return len(merge.PrimitiveSchema) == 1 && merge.Vector == nil && len(refs) == 1 &&
+				refs[0].Beacon == strfmt.URI("weaviate://localhost/things/"+existingID)
+		})).Return(nil).Once()
+
+		res, err := manager.CheckDanglingReferences(ctx, nil,
+			&models.DanglingReferencesCheck{Class: "Car", Repair: &repair})
+		require.Nil(t, err)
+		assert.True(t, res.Repair)
+		assert.Equal(t, int64(1), res.ObjectsScanned)
+		assert.Equal(t, int64(1), res.ObjectsRepaired)
+		assert.Equal(t, int64(0), res.ObjectsFailed)
+		assert.Equal(t, expected, res.DanglingReferences)
+		vectorRepo.AssertExpectations(t)
+	})
+}
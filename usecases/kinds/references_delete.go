@@ -24,13 +24,17 @@ import (
 
 // DeleteActionReference from connected DB
 func (m *Manager) DeleteActionReference(ctx context.Context, principal *models.Principal,
-	id strfmt.UUID, propertyName string, property *models.SingleRef) error {
+	id strfmt.UUID, propertyName string, property *models.SingleRef) (err error) {
+	var className string
+	defer func() {
+		m.auditObject(principal, "update", kind.Action, className, id, err)
+	}()
 
 	if m.config.Config.EsvectorOnly {
 		return fmt.Errorf("kinds.DeleteActionReference not supported yet in esvector-only mode")
 	}
 
-	err := m.authorizer.Authorize(principal, "update", fmt.Sprintf("actions/%s", id.String()))
+	className, err = m.authorizeAction(ctx, principal, "update", id)
 	if err != nil {
 		return err
 	}
@@ -78,9 +82,13 @@ func (m *Manager) deleteActionReferenceFromConnector(ctx context.Context, princi
 
 // DeleteThingReference from connected DB
 func (m *Manager) DeleteThingReference(ctx context.Context, principal *models.Principal,
-	id strfmt.UUID, propertyName string, property *models.SingleRef) error {
+	id strfmt.UUID, propertyName string, property *models.SingleRef) (err error) {
+	var className string
+	defer func() {
+		m.auditObject(principal, "update", kind.Thing, className, id, err)
+	}()
 
-	err := m.authorizer.Authorize(principal, "update", fmt.Sprintf("things/%s", id.String()))
+	className, err = m.authorizeThing(ctx, principal, "update", id)
 	if err != nil {
 		return err
 	}
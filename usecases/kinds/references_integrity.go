@@ -0,0 +1,313 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization"
+	schemaUC "github.com/semi-technologies/weaviate/usecases/schema"
+)
+
+// referenceIntegrity enforces the onDelete policies of the reference
+// properties which can point to deleted objects. Single and batch deletes
+// share it, so that both treat the references the same way.
+type referenceIntegrity struct {
+	refFinder  refFinder
+	repo       referencesRepo
+	authorizer authorizer
+}
+
+// objectAuditFn records a mutation of a single object
+type objectAuditFn func(principal *models.Principal, verb string, k kind.Kind,
+	className string, id strfmt.UUID, err error)
+
+// deletion contains everything which is affected by deleting an object: the
+// object itself, the objects deleted with it through a cascading reference
+// and the objects which merely lose their references to the deleted ones
+type deletion struct {
+	// objects in the order they were found, the object the user asked to
+	// delete comes first
+	objects []deletedObject
+	deleted map[strfmt.UUID]struct{}
+	unset   map[strfmt.UUID]*unsetReferences
+	order   []strfmt.UUID
+}
+
+type deletedObject struct {
+	kind      kind.Kind
+	className string
+	id        strfmt.UUID
+}
+
+type unsetReferences struct {
+	kind       kind.Kind
+	item       search.Result
+	properties []string
+}
+
+func newDeletion() *deletion {
+	return &deletion{
+		deleted: map[strfmt.UUID]struct{}{},
+		unset:   map[strfmt.UUID]*unsetReferences{},
+	}
+}
+
+func (d *deletion) unsetReference(k kind.Kind, item search.Result, propName string) {
+	unset, ok := d.unset[item.ID]
+	if !ok {
+		unset = &unsetReferences{kind: k, item: item}
+		d.unset[item.ID] = unset
+		d.order = append(d.order, item.ID)
+	}
+
+	for _, prop := range unset.properties {
+		if prop == propName {
+			return
+		}
+	}
+	unset.properties = append(unset.properties, propName)
+}
+
+// merge adds the objects and references of the other deletion, which is how
+// the deletions of the objects of a batch are combined
+func (d *deletion) merge(other *deletion) {
+	for _, obj := range other.objects {
+		if _, ok := d.deleted[obj.id]; ok {
+			continue
+		}
+
+		d.deleted[obj.id] = struct{}{}
+		d.objects = append(d.objects, obj)
+	}
+
+	for _, id := range other.order {
+		unset := other.unset[id]
+		for _, prop := range unset.properties {
+			d.unsetReference(unset.kind, unset.item, prop)
+		}
+	}
+}
+
+// referenceIntegrity of the manager, the references are checked against the
+// vector repo
+func (m *Manager) referenceIntegrity() *referenceIntegrity {
+	return &referenceIntegrity{
+		refFinder:  m.refFinder,
+		repo:       m.vectorRepo,
+		authorizer: m.authorizer,
+	}
+}
+
+// deleteWithReferences deletes the object and enforces the onDelete policies
+// of all reference properties which can point to it. Nothing is changed if a
+// policy prevents the deletion or the principal isn't allowed to change an
+// affected object.
+func (m *Manager) deleteWithReferences(ctx context.Context, principal *models.Principal,
+	k kind.Kind, className string, id strfmt.UUID) error {
+	integrity := m.referenceIntegrity()
+	s := m.schemaManager.GetSchemaSkipAuth()
+	d := newDeletion()
+	if err := integrity.plan(ctx, principal, s, d, k, className, id); err != nil {
+		return err
+	}
+
+	// delete the cascaded objects first, so that the object the user asked to
+	// delete only disappears if all others are gone
+	deleted := map[strfmt.UUID]struct{}{}
+	var deleteErr error
+	for i := len(d.objects) - 1; i >= 0; i-- {
+		obj := d.objects[i]
+		deleteErr = deleteObject(ctx, m.vectorRepo, obj)
+		if i > 0 {
+			m.auditObject(principal, "delete", obj.kind, obj.className, obj.id, deleteErr)
+		}
+		if deleteErr != nil {
+			break
+		}
+		deleted[obj.id] = struct{}{}
+	}
+
+	// the references are only removed from the objects which were actually
+	// deleted, so that a failed deletion never leaves dangling references
+	// behind, nor removes references to objects which still exist
+	_, err := integrity.unsetReferences(ctx, principal, d, deleted, m.auditObject)
+	if deleteErr != nil {
+		return deleteErr
+	}
+	if err != nil {
+		return NewErrInternal("%s %s was deleted, but references to it could not be "+
+			"removed: %v", k.Name(), id, err)
+	}
+
+	return nil
+}
+
+// plan adds the object to the deletion and follows all references pointing
+// to it according to their onDelete policy
+func (r *referenceIntegrity) plan(ctx context.Context, principal *models.Principal,
+	s schema.Schema, d *deletion, k kind.Kind, className string, id strfmt.UUID) error {
+	d.deleted[id] = struct{}{}
+	d.objects = append(d.objects, deletedObject{kind: k, className: className, id: id})
+
+	for _, path := range r.refFinder.Find(schema.ClassName(className)) {
+		refKind, ok := s.GetKindOfClass(path.Class)
+		if !ok {
+			// the class was deleted in the meantime
+			continue
+		}
+
+		prop, err := s.GetProperty(refKind, path.Class, path.Property)
+		if err != nil {
+			continue
+		}
+
+		referencing, err := r.referencingObjects(ctx, refKind, path, id)
+		if err != nil {
+			return NewErrInternal("find objects referencing %s: %v", id, err)
+		}
+
+		for _, item := range referencing {
+			if _, ok := d.deleted[item.ID]; ok {
+				continue
+			}
+
+			switch schemaUC.OnDelete(prop) {
+			case models.PropertyOnDeleteRestrict:
+				return NewErrConflict("%s %s is still referenced by %s %s in property '%s'",
+					k.Name(), id, refKind.Name(), item.ID, prop.Name)
+			case models.PropertyOnDeleteCascade:
+				err := r.authorizer.Authorize(principal, "delete",
+					authorization.ObjectResource(refKind, item.ClassName, item.ID))
+				if err != nil {
+					return err
+				}
+
+				err = r.plan(ctx, principal, s, d, refKind, item.ClassName, item.ID)
+				if err != nil {
+					return err
+				}
+			default:
+				err := r.authorizer.Authorize(principal, "update",
+					authorization.ObjectResource(refKind, item.ClassName, item.ID))
+				if err != nil {
+					return err
+				}
+
+				d.unsetReference(refKind, item, prop.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// referencingObjects lists all objects of the class at the root of the path
+// which reference the target through the path's property
+func (r *referenceIntegrity) referencingObjects(ctx context.Context, k kind.Kind,
+	path filters.Path, target strfmt.UUID) ([]search.Result, error) {
+	var out []search.Result
+	pagination := &filters.Pagination{Limit: referencesPageSize}
+	for {
+		// the repos may alter the clause, so it is built for every page
+		filter := &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorEqual,
+			On: &filters.Path{
+				Class:    path.Class,
+				Property: path.Property,
+				Child:    &filters.Path{Class: path.Child.Class, Property: path.Child.Property},
+			},
+			Value: &filters.Value{Value: target.String(), Type: schema.DataTypeString},
+		}}
+
+		page, err := r.repo.ClassPage(ctx, k, string(path.Class), filter, pagination)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(page) == 0 {
+			return out, nil
+		}
+		pagination.After = page[len(page)-1].ID
+		out = append(out, page...)
+	}
+}
+
+// unsetReferences removes the beacons to the deleted objects from all
+// referencing objects of the deletion which weren't deleted themselves. It
+// runs after the deletes, deleted only contains the objects which are gone.
+func (r *referenceIntegrity) unsetReferences(ctx context.Context, principal *models.Principal,
+	d *deletion, deleted map[strfmt.UUID]struct{}, audit objectAuditFn) (referencesRemoval, error) {
+	var res referencesRemoval
+	changed := map[kind.Kind][]referencingObject{}
+	for _, id := range d.order {
+		if _, ok := deleted[id]; ok {
+			continue
+		}
+
+		unset := d.unset[id]
+		if removed := removeBeacons(unset.item, unset.properties, deleted); removed > 0 {
+			res.removed += int64(removed)
+			changed[unset.kind] = append(changed[unset.kind],
+				referencingObject{item: unset.item, properties: unset.properties})
+		}
+	}
+
+	for _, k := range []kind.Kind{kind.Thing, kind.Action} {
+		var err error
+		if failed := storeReferencing(ctx, r.repo, k, changed[k]); failed > 0 {
+			res.failed += failed
+			err = NewErrInternal("could not remove references from %d referencing %ss",
+				failed, k.Name())
+		}
+
+		for _, obj := range changed[k] {
+			audit(principal, "update", k, obj.item.ClassName, obj.item.ID, err)
+		}
+	}
+
+	if res.failed > 0 {
+		return res, NewErrInternal("could not remove references from %d referencing objects",
+			res.failed)
+	}
+
+	return res, nil
+}
+
+// objectDeleter deletes single objects
+type objectDeleter interface {
+	DeleteThing(ctx context.Context, className string, id strfmt.UUID) error
+	DeleteAction(ctx context.Context, className string, id strfmt.UUID) error
+}
+
+func deleteObject(ctx context.Context, repo objectDeleter, obj deletedObject) error {
+	switch obj.kind {
+	case kind.Thing:
+		if err := repo.DeleteThing(ctx, obj.className, obj.id); err != nil {
+			return NewErrInternal("could not delete thing from vector repo: %v", err)
+		}
+	case kind.Action:
+		if err := repo.DeleteAction(ctx, obj.className, obj.id); err != nil {
+			return NewErrInternal("could not delete action from vector repo: %v", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Delete_Thing_OnDelete(t *testing.T) {
+	var (
+		vectorRepo *fakeVectorRepo
+		manager    *Manager
+	)
+
+	ownerID := strfmt.UUID("8b0d0ba8-ef66-4a8d-a9b6-87d6f2a1c3a1")
+	otherID := strfmt.UUID("8b0d0ba8-ef66-4a8d-a9b6-87d6f2a1c3a2")
+	carID := strfmt.UUID("4f4c3c57-5fd2-4d2f-9c42-0c6d6d9f1a01")
+
+	reset := func(onDelete string) {
+		vectorRepo = &fakeVectorRepo{}
+		vectorRepo.On("ThingByID", ownerID, mock.Anything, mock.Anything).Return(&search.Result{
+			ClassName: "Owner",
+		}, nil).Twice() // once for authorization, once for the deletion
+		vectorRepo.On("ClassPage", kind.Thing, "Car", strfmt.UUID("")).
+			Return([]search.Result{{
+				ID:        carID,
+				ClassName: "Car",
+				Kind:      kind.Thing,
+				Vector:    []float32{1, 2},
+				Schema: map[string]interface{}{
+					"ownedBy": models.MultipleRef{
+						{Beacon: strfmt.URI("weaviate://localhost/things/" + ownerID)},
+						{Beacon: strfmt.URI("weaviate://localhost/things/" + otherID)},
+					},
+				},
+			}}, nil).Once()
+		vectorRepo.On("ClassPage", kind.Thing, "Car", carID).
+			Return([]search.Result{}, nil).Once()
+
+		schemaManager := &fakeSchemaManager{
+			GetSchemaResponse: schema.Schema{
+				Things: &models.Schema{
+					Classes: []*models.Class{
+						{Class: "Owner"},
+						{
+							Class: "Car",
+							Properties: []*models.Property{
+								{Name: "ownedBy", DataType: []string{"Owner"}, OnDelete: onDelete},
+							},
+						},
+					},
+				},
+				Actions: &models.Schema{},
+			},
+		}
+		logger, _ := test.NewNullLogger()
+		manager = NewManager(&fakeLocks{}, schemaManager, &fakeNetwork{},
+			&config.WeaviateConfig{}, logger, &fakeAuthorizer{}, &fakeVectorizer{}, vectorRepo)
+	}
+
+	ctx := context.Background()
+
+	t.Run("without a policy the references are removed", func(t *testing.T) {
+		reset("")
+		vectorRepo.On("BatchMerge", mock.MatchedBy(func(batch BatchMergeDocuments) bool {
+			if len(batch) != 1 {
+				return false
+			}
+
+			// only the changed references are merged, the rest of the object,
+			// including its vector, is left as it is stored
+			merge := batch[0].Merge
+			refs, _ := merge.PrimitiveSchema["ownedBy"].(models.MultipleRef)
+			return len(merge.PrimitiveSchema) == 1 && merge.Vector == nil &&
+				merge.ID == carID && len(refs) == 1 &&
+				refs[0].Beacon == strfmt.URI("weaviate://localhost/things/"+otherID)
+		})).Return(nil).Once()
+		vectorRepo.On("DeleteThing", "Owner", ownerID).Return(nil).Once()
+
+		err := manager.DeleteThing(ctx, nil, ownerID)
+		require.Nil(t, err)
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("when the deletion fails the references are kept", func(t *testing.T) {
+		reset("")
+		vectorRepo.On("DeleteThing", "Owner", ownerID).
+			Return(errors.New("disk full")).Once()
+
+		err := manager.DeleteThing(ctx, nil, ownerID)
+		assert.IsType(t, ErrInternal{}, err)
+		vectorRepo.AssertNotCalled(t, "BatchMerge", mock.Anything)
+	})
+
+	t.Run("with policy restrict the deletion is rejected", func(t *testing.T) {
+		reset(models.PropertyOnDeleteRestrict)
+
+		err := manager.DeleteThing(ctx, nil, ownerID)
+		assert.IsType(t, ErrConflict{}, err)
+		vectorRepo.AssertNotCalled(t, "DeleteThing", mock.Anything, mock.Anything)
+		vectorRepo.AssertNotCalled(t, "BatchMerge", mock.Anything)
+	})
+
+	t.Run("with policy cascade the referencing objects are deleted", func(t *testing.T) {
+		reset(models.PropertyOnDeleteCascade)
+		vectorRepo.On("DeleteThing", "Car", carID).Return(nil).Once()
+		vectorRepo.On("DeleteThing", "Owner", ownerID).Return(nil).Once()
+
+		err := manager.DeleteThing(ctx, nil, ownerID)
+		require.Nil(t, err)
+		vectorRepo.AssertExpectations(t)
+		vectorRepo.AssertNotCalled(t, "BatchMerge", mock.Anything)
+	})
+}
@@ -0,0 +1,140 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package kinds
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/crossref"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+)
+
+// referencesPageSize is the number of referencing objects which are read
+// and, if they had to be changed, stored at once
+const referencesPageSize = 100
+
+type referencesRepo interface {
+	ClassPage(ctx context.Context, kind kind.Kind, className string,
+		filter *filters.LocalFilter, pagination *filters.Pagination) ([]search.Result, error)
+	BatchMerge(ctx context.Context, merges BatchMergeDocuments) (BatchMergeDocuments, error)
+}
+
+// referencingClass is a class with the reference properties which can point
+// to a specific class
+type referencingClass struct {
+	kind       kind.Kind
+	className  string
+	properties []string
+}
+
+// referencingObject is a stored object together with its reference properties
+// which might point to removed objects
+type referencingObject struct {
+	item       search.Result
+	properties []string
+}
+
+// referencesRemoval is the outcome of removing references, failed is the
+// number of objects which could not be stored without the references
+type referencesRemoval struct {
+	removed int64
+	failed  int64
+}
+
+// removeBeacons drops all beacons to one of the targets from the properties of
+// the item in place and returns how many were dropped
+func removeBeacons(item search.Result, properties []string,
+	targets map[strfmt.UUID]struct{}) int {
+	props, ok := item.Schema.(map[string]interface{})
+	if !ok {
+		return 0
+	}
+
+	removed := 0
+	for _, prop := range properties {
+		refs, ok := props[prop].(models.MultipleRef)
+		if !ok {
+			continue
+		}
+
+		kept := models.MultipleRef{}
+		for _, ref := range refs {
+			parsed, err := crossref.Parse(ref.Beacon.String())
+			if err == nil && parsed.Local {
+				if _, ok := targets[parsed.TargetID]; ok {
+					removed++
+					continue
+				}
+			}
+
+			kept = append(kept, ref)
+		}
+
+		props[prop] = kept
+	}
+
+	return removed
+}
+
+// storeReferencing merges the changed reference properties into the stored
+// objects and returns how many of them could not be stored. Only those
+// properties are written, so that concurrent changes to the rest of an object,
+// such as its vector, are kept.
+func storeReferencing(ctx context.Context, repo referencesRepo, k kind.Kind,
+	changed []referencingObject) int64 {
+	if len(changed) == 0 {
+		return 0
+	}
+
+	now := unixNow()
+	merges := make(BatchMergeDocuments, len(changed))
+	for i, obj := range changed {
+		props, _ := obj.item.Schema.(map[string]interface{})
+		refProps := map[string]interface{}{}
+		for _, prop := range obj.properties {
+			if refs, ok := props[prop]; ok {
+				refProps[prop] = refs
+			}
+		}
+
+		merges[i] = BatchMergeDocument{
+			OriginalIndex: i,
+			Merge: MergeDocument{
+				Kind:            k,
+				Class:           obj.item.ClassName,
+				ID:              obj.item.ID,
+				PrimitiveSchema: refProps,
+				UpdateTime:      now,
+			},
+		}
+	}
+
+	stored, err := repo.BatchMerge(ctx, merges)
+	if err != nil {
+		return int64(len(changed))
+	}
+
+	var failed int64
+	for _, item := range stored {
+		if item.Err != nil {
+			failed++
+		}
+	}
+
+	return failed
+}
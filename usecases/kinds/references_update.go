@@ -28,13 +28,17 @@ import (
 // ref, it has a side-effect on the schema: The schema will be updated to
 // include this particular network ref class.
 func (m *Manager) UpdateActionReferences(ctx context.Context, principal *models.Principal,
-	id strfmt.UUID, propertyName string, refs models.MultipleRef) error {
+	id strfmt.UUID, propertyName string, refs models.MultipleRef) (err error) {
+	var className string
+	defer func() {
+		m.auditObject(principal, "update", kind.Action, className, id, err)
+	}()
 
 	if m.config.Config.EsvectorOnly {
 		return fmt.Errorf("kinds.UpdateActionReference not supported yet in esvector-only mode")
 	}
 
-	err := m.authorizer.Authorize(principal, "update", fmt.Sprintf("actions/%s", id.String()))
+	className, err = m.authorizeAction(ctx, principal, "update", id)
 	if err != nil {
 		return err
 	}
@@ -93,13 +97,17 @@ func (m *Manager) updateActionReferenceToConnectorAndSchema(ctx context.Context,
 // ref, it has a side-effect on the schema: The schema will be updated to
 // include this particular network ref class.
 func (m *Manager) UpdateThingReferences(ctx context.Context, principal *models.Principal,
-	id strfmt.UUID, propertyName string, refs models.MultipleRef) error {
+	id strfmt.UUID, propertyName string, refs models.MultipleRef) (err error) {
+	var className string
+	defer func() {
+		m.auditObject(principal, "update", kind.Thing, className, id, err)
+	}()
 
 	if m.config.Config.EsvectorOnly {
 		return fmt.Errorf("kinds.UpdateThingReference not supported yet in esvector-only mode")
 	}
 
-	err := m.authorizer.Authorize(principal, "update", fmt.Sprintf("things/%s", id.String()))
+	className, err = m.authorizeThing(ctx, principal, "update", id)
 	if err != nil {
 		return err
 	}
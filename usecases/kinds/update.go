@@ -15,11 +15,11 @@ package kinds
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization"
 )
 
 type updateAndGetRepo interface {
@@ -39,9 +39,13 @@ type updateRepo interface {
 // ref, it has a side-effect on the schema: The schema will be updated to
 // include this particular network ref class.
 func (m *Manager) UpdateAction(ctx context.Context, principal *models.Principal, id strfmt.UUID,
-	class *models.Action) (*models.Action, error) {
+	class *models.Action) (_ *models.Action, err error) {
+	var className string
+	defer func() {
+		m.auditObject(principal, "update", kind.Action, className, id, err)
+	}()
 
-	err := m.authorizer.Authorize(principal, "update", fmt.Sprintf("actions/%s", id.String()))
+	className, err = m.authorizeAction(ctx, principal, "update", id)
 	if err != nil {
 		return nil, err
 	}
@@ -66,6 +70,15 @@ func (m *Manager) updateActionToConnectorAndSchema(ctx context.Context, principa
 		return nil, err
 	}
 
+	if originalAction.ClassName != class.Class {
+		// moving the action to a different class is the same as creating it there
+		err := m.authorizer.Authorize(principal, "create",
+			authorization.ClassResource(kind.Action, class.Class))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	m.logger.
 		WithField("action", "kinds_update_requested").
 		WithField("kind", kind.Action).
@@ -98,9 +111,13 @@ func (m *Manager) updateActionToConnectorAndSchema(ctx context.Context, principa
 // ref, it has a side-effect on the schema: The schema will be updated to
 // include this particular network ref class.
 func (m *Manager) UpdateThing(ctx context.Context, principal *models.Principal,
-	id strfmt.UUID, class *models.Thing) (*models.Thing, error) {
+	id strfmt.UUID, class *models.Thing) (_ *models.Thing, err error) {
+	var className string
+	defer func() {
+		m.auditObject(principal, "update", kind.Thing, className, id, err)
+	}()
 
-	err := m.authorizer.Authorize(principal, "update", fmt.Sprintf("things/%s", id.String()))
+	className, err = m.authorizeThing(ctx, principal, "update", id)
 	if err != nil {
 		return nil, err
 	}
@@ -125,6 +142,15 @@ func (m *Manager) updateThingToConnectorAndSchema(ctx context.Context, principal
 		return nil, err
 	}
 
+	if originalThing.ClassName != class.Class {
+		// moving the thing to a different class is the same as creating it there
+		err := m.authorizer.Authorize(principal, "create",
+			authorization.ClassResource(kind.Thing, class.Class))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	m.logger.
 		WithField("action", "kinds_update_requested").
 		WithField("kind", kind.Thing).
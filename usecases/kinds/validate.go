@@ -17,6 +17,8 @@ import (
 	"context"
 
 	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization"
 )
 
 // ValidateThing without adding it to the database. Can be used in UIs for
@@ -24,7 +26,8 @@ import (
 func (m *Manager) ValidateThing(ctx context.Context, principal *models.Principal,
 	class *models.Thing) error {
 
-	err := m.authorizer.Authorize(principal, "validate", "things")
+	err := m.authorizer.Authorize(principal, "validate",
+		authorization.ClassResource(kind.Thing, class.Class))
 	if err != nil {
 		return err
 	}
@@ -48,7 +51,8 @@ func (m *Manager) ValidateThing(ctx context.Context, principal *models.Principal
 func (m *Manager) ValidateAction(ctx context.Context, principal *models.Principal,
 	class *models.Action) error {
 
-	err := m.authorizer.Authorize(principal, "validate", "actions")
+	err := m.authorizer.Authorize(principal, "validate",
+		authorization.ClassResource(kind.Action, class.Class))
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,112 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Package monitoring provides the prometheus metrics of weaviate. The metrics
+// are process-wide, so that every component can record its observations
+// without having to be wired up individually. They are only exposed if
+// monitoring is enabled.
+package monitoring
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// PrometheusMetrics groups all metrics recorded by weaviate
+type PrometheusMetrics struct {
+	// RESTRequestDuration by swagger operation, method and status code
+	RESTRequestDuration *prometheus.HistogramVec
+	// QueryDuration of the GraphQL Get, Aggregate and Explore resolvers by
+	// class and operation
+	QueryDuration *prometheus.HistogramVec
+	// VectorizerDuration of requests to the contextionary by operation
+	VectorizerDuration *prometheus.HistogramVec
+	// ESVectorDuration of requests to elasticsearch by class and operation
+	ESVectorDuration *prometheus.HistogramVec
+	// BatchSize of batch imports by class and operation
+	BatchSize *prometheus.HistogramVec
+	// ClassificationDuration of classification runs by class, type and status
+	ClassificationDuration *prometheus.HistogramVec
+	// ClassificationObjects counts the classified objects by class, type and
+	// status
+	ClassificationObjects *prometheus.CounterVec
+}
+
+var (
+	metrics     *PrometheusMetrics
+	metricsOnce sync.Once
+)
+
+// GetMetrics returns the process-wide metrics which are registered with the
+// default prometheus registry
+func GetMetrics() *PrometheusMetrics {
+	metricsOnce.Do(func() {
+		metrics = NewPrometheusMetrics(prometheus.DefaultRegisterer)
+	})
+
+	return metrics
+}
+
+// NewPrometheusMetrics creates all metrics and registers them with reg, use
+// GetMetrics unless you need a separate registry, such as in tests.
+func NewPrometheusMetrics(reg prometheus.Registerer) *PrometheusMetrics {
+	m := &PrometheusMetrics{
+		RESTRequestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "weaviate_rest_request_duration_seconds",
+			Help:    "Duration of REST requests",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"operation", "method", "status"}),
+
+		QueryDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "weaviate_query_duration_seconds",
+			Help:    "Duration of GraphQL Get, Aggregate and Explore queries",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"class_name", "operation"}),
+
+		VectorizerDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "weaviate_vectorizer_request_duration_seconds",
+			Help:    "Duration of requests to the contextionary",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"operation"}),
+
+		ESVectorDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "weaviate_esvector_request_duration_seconds",
+			Help:    "Duration of requests to elasticsearch",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"class_name", "operation"}),
+
+		BatchSize: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "weaviate_batch_size",
+			Help:    "Number of items per class in a batch import",
+			Buckets: prometheus.ExponentialBuckets(1, 4, 8),
+		}, []string{"class_name", "operation"}),
+
+		ClassificationDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "weaviate_classification_duration_seconds",
+			Help:    "Duration of classification runs",
+			Buckets: prometheus.ExponentialBuckets(0.1, 4, 10),
+		}, []string{"class_name", "type", "status"}),
+
+		ClassificationObjects: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "weaviate_classification_objects_total",
+			Help: "Number of objects processed by classifications",
+		}, []string{"class_name", "type", "status"}),
+	}
+
+	reg.MustRegister(m.RESTRequestDuration, m.QueryDuration, m.VectorizerDuration,
+		m.ESVectorDuration, m.BatchSize, m.ClassificationDuration,
+		m.ClassificationObjects)
+
+	return m
+}
@@ -0,0 +1,188 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package revectorization
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+)
+
+type fakeSchemaGetter struct {
+	schema schema.Schema
+}
+
+func (f *fakeSchemaGetter) GetSchemaSkipAuth() schema.Schema {
+	return f.schema
+}
+
+func testSchema() schema.Schema {
+	return schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{
+				&models.Class{
+					Class:      "Article",
+					Vectorizer: models.ClassVectorizerContextionary,
+				},
+				&models.Class{
+					Class:      "Secret",
+					Vectorizer: models.ClassVectorizerContextionary,
+				},
+				&models.Class{
+					Class:      "Precomputed",
+					Vectorizer: models.ClassVectorizerNone,
+				},
+			},
+		},
+		Actions: &models.Schema{},
+	}
+}
+
+// denyRevectorizationsOf forbids all access to revectorizations of the class
+type denyRevectorizationsOf struct {
+	class string
+}
+
+func (a *denyRevectorizationsOf) Authorize(principal *models.Principal,
+	verb, resource string) error {
+	if resource == "revectorizations/"+a.class {
+		return fmt.Errorf("forbidden")
+	}
+
+	return nil
+}
+
+// fakeVectorizer derives the vector from the length of the name, objects
+// named "fail" can't be vectorized. If block is set, every call first sends
+// on called and then waits until it can receive from block.
+type fakeVectorizer struct {
+	called chan struct{}
+	block  chan struct{}
+}
+
+func newBlockingVectorizer() *fakeVectorizer {
+	return &fakeVectorizer{
+		called: make(chan struct{}),
+		block:  make(chan struct{}),
+	}
+}
+
+func (f *fakeVectorizer) Thing(ctx context.Context, thing *models.Thing) ([]float32, error) {
+	if f.block != nil {
+		f.called <- struct{}{}
+		<-f.block
+	}
+
+	name, _ := thing.Schema.(map[string]interface{})["name"].(string)
+	if name == "fail" {
+		return nil, fmt.Errorf("no known words")
+	}
+
+	return []float32{float32(len(name)), 1}, nil
+}
+
+func (f *fakeVectorizer) Action(ctx context.Context, action *models.Action) ([]float32, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+type fakeVectorRepo struct {
+	sync.Mutex
+	objects []search.Result
+	merged  map[strfmt.UUID]kinds.MergeDocument
+}
+
+func newFakeVectorRepo(objects ...search.Result) *fakeVectorRepo {
+	sort.Slice(objects, func(a, b int) bool {
+		return objects[a].ID < objects[b].ID
+	})
+
+	return &fakeVectorRepo{
+		objects: objects,
+		merged:  map[strfmt.UUID]kinds.MergeDocument{},
+	}
+}
+
+func (f *fakeVectorRepo) ClassPage(ctx context.Context, k kind.Kind, className string,
+	filter *filters.LocalFilter, pagination *filters.Pagination) ([]search.Result, error) {
+	var out []search.Result
+	for _, obj := range f.objects {
+		if obj.Kind != k || obj.ClassName != className || obj.ID <= pagination.After {
+			continue
+		}
+
+		// the only supported filter is an equality filter on the name
+		if filter != nil && filter.Root.Value.Value != obj.Schema.(map[string]interface{})["name"] {
+			continue
+		}
+
+		// the real repos return a new schema map for every read
+		copied := obj
+		props := map[string]interface{}{}
+		for key, value := range obj.Schema.(map[string]interface{}) {
+			props[key] = value
+		}
+		copied.Schema = props
+
+		out = append(out, copied)
+		if len(out) == pagination.Limit {
+			break
+		}
+	}
+
+	return out, nil
+}
+
+func (f *fakeVectorRepo) BatchMerge(ctx context.Context,
+	merges kinds.BatchMergeDocuments) (kinds.BatchMergeDocuments, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	for _, item := range merges {
+		f.merged[item.Merge.ID] = item.Merge
+	}
+
+	return merges, nil
+}
+
+func (f *fakeVectorRepo) mergedThing(id strfmt.UUID) (kinds.MergeDocument, bool) {
+	f.Lock()
+	defer f.Unlock()
+
+	merge, ok := f.merged[id]
+	return merge, ok
+}
+
+func article(id strfmt.UUID, name string) search.Result {
+	return search.Result{
+		ID:        id,
+		Kind:      kind.Thing,
+		ClassName: "Article",
+		Schema: map[string]interface{}{
+			"name": name,
+		},
+		Vector: []float32{0, 0},
+		Meta: &models.ObjectMeta{
+			Vector: models.C11yVector{0, 0},
+		},
+	}
+}
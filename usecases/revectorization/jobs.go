@@ -0,0 +1,135 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package revectorization
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+)
+
+// startJob registers the revectorization, only one revectorization per class
+// can run at a time, as they would otherwise overwrite each other's vectors
+func (r *Revectorizer) startJob(params models.Revectorization,
+	cancel context.CancelFunc) error {
+	r.jobsLock.Lock()
+	defer r.jobsLock.Unlock()
+
+	for id, job := range r.jobs {
+		if job.status.Class == params.Class &&
+			job.status.Status == models.RevectorizationStatusRunning {
+			return NewErrAlreadyRunning("revectorization '%s' of class '%s' "+
+				"is still running", id, params.Class)
+		}
+	}
+
+	r.jobs[params.ID] = &job{
+		status: *copyRevectorization(params),
+		cancel: cancel,
+	}
+	return nil
+}
+
+// job returns a copy of the current state of the revectorization, it is nil
+// if the revectorization does not exist
+func (r *Revectorizer) job(id strfmt.UUID) *models.Revectorization {
+	r.jobsLock.Lock()
+	defer r.jobsLock.Unlock()
+
+	job, ok := r.jobs[id]
+	if !ok {
+		return nil
+	}
+
+	return copyRevectorization(job.status)
+}
+
+func (r *Revectorizer) allJobs() []*models.Revectorization {
+	r.jobsLock.Lock()
+	defer r.jobsLock.Unlock()
+
+	out := make([]*models.Revectorization, 0, len(r.jobs))
+	for _, job := range r.jobs {
+		out = append(out, copyRevectorization(job.status))
+	}
+
+	return out
+}
+
+// updateJob applies the changes of the run to the state of the
+// revectorization
+func (r *Revectorizer) updateJob(id strfmt.UUID, update func(*models.Revectorization)) {
+	r.jobsLock.Lock()
+	defer r.jobsLock.Unlock()
+
+	if job, ok := r.jobs[id]; ok {
+		update(&job.status)
+	}
+}
+
+// cancelJob returns false if the revectorization is not running
+func (r *Revectorizer) cancelJob(id strfmt.UUID) bool {
+	r.jobsLock.Lock()
+	defer r.jobsLock.Unlock()
+
+	job, ok := r.jobs[id]
+	if !ok || job.status.Status != models.RevectorizationStatusRunning {
+		return false
+	}
+
+	job.cancel()
+	return true
+}
+
+// finishJob applies the final state of the run and releases the resources of
+// its context
+func (r *Revectorizer) finishJob(id strfmt.UUID, update func(*models.Revectorization)) {
+	r.jobsLock.Lock()
+	defer r.jobsLock.Unlock()
+
+	if job, ok := r.jobs[id]; ok {
+		update(&job.status)
+		job.cancel()
+	}
+
+	r.dropOldestFinished()
+}
+
+// dropOldestFinished removes the finished revectorizations which exceed
+// maxFinished, those started first are dropped first. Must be called while
+// holding the jobs lock.
+func (r *Revectorizer) dropOldestFinished() {
+	var finished []*job
+	for _, job := range r.jobs {
+		if job.status.Status != models.RevectorizationStatusRunning {
+			finished = append(finished, job)
+		}
+	}
+
+	if len(finished) <= r.maxFinished {
+		return
+	}
+
+	sort.Slice(finished, func(a, b int) bool {
+		return time.Time(finished[a].status.Meta.Started).
+			Before(time.Time(finished[b].status.Meta.Started))
+	})
+
+	for _, job := range finished[:len(finished)-r.maxFinished] {
+		delete(r.jobs, job.status.ID)
+	}
+}
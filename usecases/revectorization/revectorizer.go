@@ -0,0 +1,248 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+// Package revectorization recomputes the vectors of objects which are already
+// stored, so that changed vectorizer settings of a class, such as
+// vectorizeClassName or the indexing of a property, or an extended
+// contextionary also apply to the objects imported before the change.
+package revectorization
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	uuid "github.com/satori/go.uuid"
+	"github.com/semi-technologies/weaviate/adapters/handlers/rest/filterext"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+	schemaUC "github.com/semi-technologies/weaviate/usecases/schema"
+)
+
+// Revectorizer runs revectorizations in the background. Revectorizations are
+// only kept in memory, a restart ends all running revectorizations, the
+// objects which were already revectorized keep their new vectors.
+type Revectorizer struct {
+	schemaGetter schemaUC.SchemaGetter
+	vectorizer   kinds.Vectorizer
+	vectorRepo   VectorRepo
+	authorizer   authorizer
+	auditor      auditor
+
+	// jobs holds the running revectorizations and the most recent finished
+	// ones started since the last restart
+	jobs     map[strfmt.UUID]*job
+	jobsLock sync.Mutex
+
+	// maxFinished is the number of finished revectorizations which are kept,
+	// older ones are dropped when another one finishes
+	maxFinished int
+
+	// pageSize is the number of objects which are read, vectorized and stored
+	// at once
+	pageSize int
+}
+
+type authorizer interface {
+	Authorize(principal *models.Principal, verb, resource string) error
+}
+
+// VectorRepo reads the objects of a class and merges their new vectors, which
+// leaves everything else about the objects untouched
+type VectorRepo interface {
+	ClassPage(ctx context.Context, kind kind.Kind, className string,
+		filter *filters.LocalFilter, pagination *filters.Pagination) ([]search.Result, error)
+	BatchMerge(ctx context.Context, merges kinds.BatchMergeDocuments) (kinds.BatchMergeDocuments, error)
+}
+
+// job is a revectorization and the cancel func of its run. The status is
+// updated by the run, so it must only be accessed while holding the jobs lock.
+type job struct {
+	status models.Revectorization
+	cancel context.CancelFunc
+}
+
+func New(sg schemaUC.SchemaGetter, vectorizer kinds.Vectorizer, vr VectorRepo,
+	authorizer authorizer) *Revectorizer {
+	return &Revectorizer{
+		schemaGetter: sg,
+		vectorizer:   vectorizer,
+		vectorRepo:   vr,
+		authorizer:   authorizer,
+		jobs:         map[strfmt.UUID]*job{},
+		maxFinished:  100,
+		pageSize:     100,
+	}
+}
+
+// Start a revectorization of all objects of the class, or only of those
+// matching params.Where. The revectorization runs in the background, the
+// returned revectorization is the initial state. Only the vectors are
+// written, objects which are changed while they are being revectorized keep
+// their changes.
+func (r *Revectorizer) Start(ctx context.Context, principal *models.Principal,
+	k kind.Kind, className string, params models.Revectorization) (_ *models.Revectorization, err error) {
+	params.Kind = k.Name()
+	params.Class = className
+	defer func() {
+		r.audit(principal, "create", params, err)
+	}()
+
+	err = r.authorizer.Authorize(principal, "create",
+		authorization.RevectorizationResource(className))
+	if err != nil {
+		return nil, err
+	}
+
+	s := r.schemaGetter.GetSchemaSkipAuth()
+	class := s.GetClass(k, schema.ClassName(className))
+	if class == nil {
+		return nil, NewErrInvalidUserInput("no %s class with name '%s'", k.Name(), className)
+	}
+
+	if schemaUC.Vectorizer(class) == models.ClassVectorizerNone {
+		return nil, NewErrInvalidUserInput("class '%s' has no vectorizer, "+
+			"its vectors can't be recomputed", className)
+	}
+
+	filter, err := filterext.Parse(params.Where)
+	if err != nil {
+		return nil, NewErrInvalidUserInput("field 'where': %v", err)
+	}
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, fmt.Errorf("revectorization: assign id: %v", err)
+	}
+
+	params.ID = strfmt.UUID(id.String())
+	params.Status = models.RevectorizationStatusRunning
+	params.Error = ""
+	params.Meta = &models.RevectorizationMeta{
+		Started: strfmt.DateTime(time.Now()),
+	}
+
+	runCtx, cancel := context.WithCancel(context.Background())
+	if err := r.startJob(params, cancel); err != nil {
+		cancel()
+		return nil, err
+	}
+
+	go r.run(runCtx, params.ID, k, className, filter)
+	return copyRevectorization(params), nil
+}
+
+// List the revectorizations, most recently started first. Revectorizations of
+// classes the principal is not allowed to view are omitted.
+func (r *Revectorizer) List(ctx context.Context,
+	principal *models.Principal) ([]*models.Revectorization, error) {
+	all := r.allJobs()
+
+	allowed := map[string]bool{}
+	out := make([]*models.Revectorization, 0, len(all))
+	for _, revectorization := range all {
+		ok, cached := allowed[revectorization.Class]
+		if !cached {
+			ok = r.authorizer.Authorize(principal, "get",
+				authorization.RevectorizationResource(revectorization.Class)) == nil
+			allowed[revectorization.Class] = ok
+		}
+
+		if ok {
+			out = append(out, revectorization)
+		}
+	}
+
+	sort.SliceStable(out, func(a, b int) bool {
+		return time.Time(out[a].Meta.Started).After(time.Time(out[b].Meta.Started))
+	})
+
+	return out, nil
+}
+
+// Get the current state of a revectorization
+func (r *Revectorizer) Get(ctx context.Context, principal *models.Principal,
+	id strfmt.UUID) (*models.Revectorization, error) {
+	revectorization := r.job(id)
+
+	// an unknown revectorization is authorized against all classes, so that
+	// its absence does not reveal anything the principal could not see anyway
+	className := authorization.AllClasses
+	if revectorization != nil {
+		className = revectorization.Class
+	}
+
+	err := r.authorizer.Authorize(principal, "get",
+		authorization.RevectorizationResource(className))
+	if err != nil {
+		return nil, err
+	}
+
+	if revectorization == nil {
+		return nil, NewErrNotFound("revectorization '%s' does not exist", id)
+	}
+
+	return revectorization, nil
+}
+
+// Cancel a running revectorization. Cancellation is cooperative: the page
+// which is currently being vectorized is still stored, the revectorization is
+// then marked as cancelled.
+func (r *Revectorizer) Cancel(ctx context.Context, principal *models.Principal,
+	id strfmt.UUID) (_ *models.Revectorization, err error) {
+	revectorization := r.job(id)
+	if revectorization == nil {
+		err = r.authorizer.Authorize(principal, "delete",
+			authorization.RevectorizationResource(authorization.AllClasses))
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, NewErrNotFound("revectorization '%s' does not exist", id)
+	}
+
+	defer func() {
+		r.audit(principal, "delete", *revectorization, err)
+	}()
+
+	err = r.authorizer.Authorize(principal, "delete",
+		authorization.RevectorizationResource(revectorization.Class))
+	if err != nil {
+		return nil, err
+	}
+
+	if !r.cancelJob(id) {
+		return nil, NewErrNotRunning("revectorization '%s' is not running, "+
+			"its status is '%s'", id, revectorization.Status)
+	}
+
+	return revectorization, nil
+}
+
+func copyRevectorization(in models.Revectorization) *models.Revectorization {
+	out := in
+	if in.Meta != nil {
+		meta := *in.Meta
+		out.Meta = &meta
+	}
+
+	return &out
+}
@@ -0,0 +1,285 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package revectorization
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Revectorizer_Start(t *testing.T) {
+	type test struct {
+		name          string
+		class         string
+		params        models.Revectorization
+		expectedError error
+	}
+
+	tests := []test{
+		{
+			name:  "a class which doesn't exist",
+			class: "Unknown",
+			expectedError: NewErrInvalidUserInput(
+				"no thing class with name 'Unknown'"),
+		},
+		{
+			name:  "a class without vectorizer",
+			class: "Precomputed",
+			expectedError: NewErrInvalidUserInput("class 'Precomputed' has no " +
+				"vectorizer, its vectors can't be recomputed"),
+		},
+		{
+			name:  "an invalid filter",
+			class: "Article",
+			params: models.Revectorization{
+				Where: &models.WhereFilter{Operator: "Equal"},
+			},
+			expectedError: NewErrInvalidUserInput("field 'where': " +
+				"invalid where filter: got operator 'Equal', but no value<Type> field set"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := New(&fakeSchemaGetter{testSchema()}, &fakeVectorizer{},
+				newFakeVectorRepo(), &denyRevectorizationsOf{})
+			_, err := r.Start(context.Background(), nil, kind.Thing, test.class, test.params)
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+
+	t.Run("a class which is already being revectorized", func(t *testing.T) {
+		vectorizer := newBlockingVectorizer()
+		r := New(&fakeSchemaGetter{testSchema()}, vectorizer,
+			newFakeVectorRepo(article("1", "a")), &denyRevectorizationsOf{})
+
+		first, err := r.Start(context.Background(), nil, kind.Thing, "Article",
+			models.Revectorization{})
+		require.Nil(t, err)
+
+		_, err = r.Start(context.Background(), nil, kind.Thing, "Article",
+			models.Revectorization{})
+		assert.IsType(t, ErrAlreadyRunning{}, err)
+
+		<-vectorizer.called
+		vectorizer.block <- struct{}{}
+		waitForRevectorization(t, r, first.ID)
+	})
+}
+
+func Test_Revectorizer_Run(t *testing.T) {
+	repo := newFakeVectorRepo(
+		article("1", "classified"),
+		article("2", "car"),
+		article("3", "fail"),
+		article("4", "boat"),
+	)
+	r := New(&fakeSchemaGetter{testSchema()}, &fakeVectorizer{}, repo,
+		&denyRevectorizationsOf{})
+	r.pageSize = 2
+
+	started, err := r.Start(context.Background(), nil, kind.Thing, "Article",
+		models.Revectorization{})
+	require.Nil(t, err)
+	assert.Equal(t, models.RevectorizationStatusRunning, started.Status)
+	assert.Equal(t, "thing", started.Kind)
+	assert.Equal(t, "Article", started.Class)
+
+	res := waitForRevectorization(t, r, started.ID)
+
+	t.Run("the run fails with the number of objects which failed", func(t *testing.T) {
+		assert.Equal(t, models.RevectorizationStatusFailed, res.Status)
+		assert.Equal(t, "revectorization failed: 1 objects kept their previous "+
+			"vector, the first error was: vectorize Article/3: no known words", res.Error)
+		assert.Equal(t, int64(4), res.Meta.Count)
+		assert.Equal(t, int64(3), res.Meta.CountSucceeded)
+		assert.Equal(t, int64(1), res.Meta.CountFailed)
+		assert.NotZero(t, res.Meta.Completed)
+	})
+
+	t.Run("all other objects are stored with their new vectors", func(t *testing.T) {
+		for id, expected := range map[strfmt.UUID][]float32{
+			"1": []float32{10, 1},
+			"2": []float32{3, 1},
+			"4": []float32{4, 1},
+		} {
+			stored, ok := repo.mergedThing(id)
+			require.True(t, ok)
+			assert.Equal(t, expected, stored.Vector)
+		}
+
+		_, ok := repo.mergedThing("3")
+		assert.False(t, ok)
+	})
+
+	t.Run("only the vector is written", func(t *testing.T) {
+		merge, _ := repo.mergedThing("1")
+		assert.Equal(t, kinds.MergeDocument{
+			Kind:   kind.Thing,
+			Class:  "Article",
+			ID:     "1",
+			Vector: []float32{10, 1},
+		}, merge)
+	})
+}
+
+func Test_Revectorizer_Filter(t *testing.T) {
+	repo := newFakeVectorRepo(article("1", "car"), article("2", "boat"))
+	r := New(&fakeSchemaGetter{testSchema()}, &fakeVectorizer{}, repo,
+		&denyRevectorizationsOf{})
+
+	name := "boat"
+	started, err := r.Start(context.Background(), nil, kind.Thing, "Article",
+		models.Revectorization{
+			Where: &models.WhereFilter{
+				Operator:    "Equal",
+				Path:        []string{"name"},
+				ValueString: &name,
+			},
+		})
+	require.Nil(t, err)
+
+	res := waitForRevectorization(t, r, started.ID)
+	assert.Equal(t, models.RevectorizationStatusCompleted, res.Status)
+	assert.Equal(t, int64(1), res.Meta.Count)
+
+	_, ok := repo.mergedThing("1")
+	assert.False(t, ok)
+	_, ok = repo.mergedThing("2")
+	assert.True(t, ok)
+}
+
+func Test_Revectorizer_Cancel(t *testing.T) {
+	vectorizer := newBlockingVectorizer()
+	repo := newFakeVectorRepo(article("1", "car"), article("2", "boat"))
+	r := New(&fakeSchemaGetter{testSchema()}, vectorizer, repo,
+		&denyRevectorizationsOf{})
+	r.pageSize = 1
+
+	started, err := r.Start(context.Background(), nil, kind.Thing, "Article",
+		models.Revectorization{})
+	require.Nil(t, err)
+
+	<-vectorizer.called
+	_, err = r.Cancel(context.Background(), nil, started.ID)
+	require.Nil(t, err)
+
+	// the object which is currently being vectorized is still stored
+	vectorizer.block <- struct{}{}
+	res := waitForRevectorization(t, r, started.ID)
+	assert.Equal(t, models.RevectorizationStatusCancelled, res.Status)
+	assert.Equal(t, int64(1), res.Meta.Count)
+
+	_, ok := repo.mergedThing("2")
+	assert.False(t, ok)
+
+	t.Run("cancelling again", func(t *testing.T) {
+		_, err := r.Cancel(context.Background(), nil, started.ID)
+		assert.IsType(t, ErrNotRunning{}, err)
+	})
+
+	t.Run("cancelling an unknown revectorization", func(t *testing.T) {
+		_, err := r.Cancel(context.Background(), nil, "unknown")
+		assert.IsType(t, ErrNotFound{}, err)
+	})
+}
+
+func Test_Revectorizer_List(t *testing.T) {
+	r := New(&fakeSchemaGetter{testSchema()}, &fakeVectorizer{}, newFakeVectorRepo(),
+		&denyRevectorizationsOf{class: "Secret"})
+
+	now := time.Now()
+	for i, status := range []models.Revectorization{
+		{ID: "1", Class: "Article", Status: models.RevectorizationStatusCompleted},
+		{ID: "2", Class: "Secret", Status: models.RevectorizationStatusCompleted},
+		{ID: "3", Class: "Article", Status: models.RevectorizationStatusFailed},
+	} {
+		status.Meta = &models.RevectorizationMeta{
+			Started: strfmt.DateTime(now.Add(time.Duration(i) * time.Second)),
+		}
+		r.jobs[status.ID] = &job{status: status}
+	}
+
+	res, err := r.List(context.Background(), nil)
+	require.Nil(t, err)
+	require.Len(t, res, 2, "forbidden classes are omitted")
+	assert.Equal(t, strfmt.UUID("3"), res[0].ID, "most recent first")
+	assert.Equal(t, strfmt.UUID("1"), res[1].ID)
+
+	t.Run("getting a revectorization of a forbidden class", func(t *testing.T) {
+		_, err := r.Get(context.Background(), nil, "2")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("getting an unknown revectorization", func(t *testing.T) {
+		_, err := r.Get(context.Background(), nil, "unknown")
+		assert.IsType(t, ErrNotFound{}, err)
+	})
+}
+
+func Test_Revectorizer_FinishedJobs(t *testing.T) {
+	r := New(&fakeSchemaGetter{testSchema()}, &fakeVectorizer{}, newFakeVectorRepo(),
+		&denyRevectorizationsOf{})
+	r.maxFinished = 2
+
+	now := time.Now()
+	for i, status := range []models.Revectorization{
+		{ID: "1", Class: "Article", Status: models.RevectorizationStatusCompleted},
+		{ID: "2", Class: "Article", Status: models.RevectorizationStatusFailed},
+		{ID: "3", Class: "Article", Status: models.RevectorizationStatusCancelled},
+		{ID: "4", Class: "Article", Status: models.RevectorizationStatusRunning},
+		{ID: "5", Class: "Secret", Status: models.RevectorizationStatusRunning},
+	} {
+		status.Meta = &models.RevectorizationMeta{
+			Started: strfmt.DateTime(now.Add(time.Duration(i) * time.Second)),
+		}
+		r.jobs[status.ID] = &job{status: status, cancel: func() {}}
+	}
+
+	r.finishJob("4", func(status *models.Revectorization) {
+		status.Status = models.RevectorizationStatusCompleted
+	})
+
+	res, err := r.List(context.Background(), nil)
+	require.Nil(t, err)
+	ids := make([]strfmt.UUID, len(res))
+	for i, revectorization := range res {
+		ids[i] = revectorization.ID
+	}
+	assert.Equal(t, []strfmt.UUID{"5", "4", "3"}, ids,
+		"the oldest finished revectorizations are dropped, running ones are kept")
+}
+
+func waitForRevectorization(t *testing.T, r *Revectorizer,
+	id strfmt.UUID) *models.Revectorization {
+	for i := 0; i < 100; i++ {
+		res, err := r.Get(context.Background(), nil, id)
+		require.Nil(t, err)
+		if res.Status != models.RevectorizationStatusRunning {
+			return res
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	t.Fatalf("revectorization '%s' did not finish", id)
+	return nil
+}
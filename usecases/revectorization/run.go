@@ -0,0 +1,182 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package revectorization
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/kinds"
+)
+
+// itemResult is the outcome of a single object of a page, skipped objects
+// were neither vectorized nor stored as the run was cancelled
+type itemResult struct {
+	err     error
+	skipped bool
+}
+
+func (r *Revectorizer) run(ctx context.Context, id strfmt.UUID, k kind.Kind,
+	className string, filter *filters.LocalFilter) {
+	var (
+		succeeded int64
+		failed    int64
+		firstErr  error
+	)
+
+	finish := func(status string, err error) {
+		r.finishJob(id, func(revectorization *models.Revectorization) {
+			revectorization.Status = status
+			if err != nil {
+				revectorization.Error = fmt.Sprintf("revectorization failed: %v", err)
+			}
+			revectorization.Meta.Completed = strfmt.DateTime(time.Now())
+		})
+	}
+
+	// objects are read in the order of their ids, the cursor makes sure that
+	// objects which could not be vectorized are not loaded again
+	pagination := &filters.Pagination{Limit: r.pageSize}
+	for {
+		if ctx.Err() != nil {
+			finish(models.RevectorizationStatusCancelled, nil)
+			return
+		}
+
+		page, err := r.vectorRepo.ClassPage(ctx, k, className, filter, pagination)
+		if err != nil {
+			if ctx.Err() != nil {
+				finish(models.RevectorizationStatusCancelled, nil)
+				return
+			}
+
+			finish(models.RevectorizationStatusFailed, fmt.Errorf("read objects: %v", err))
+			return
+		}
+
+		if len(page) == 0 {
+			break
+		}
+		pagination.After = page[len(page)-1].ID
+
+		for _, res := range r.revectorizePage(ctx, k, page) {
+			switch {
+			case res.skipped:
+			case res.err != nil:
+				failed++
+				if firstErr == nil {
+					firstErr = res.err
+				}
+			default:
+				succeeded++
+			}
+		}
+
+		r.updateJob(id, func(revectorization *models.Revectorization) {
+			revectorization.Meta.CountSucceeded = succeeded
+			revectorization.Meta.CountFailed = failed
+			revectorization.Meta.Count = succeeded + failed
+		})
+	}
+
+	if failed > 0 {
+		finish(models.RevectorizationStatusFailed, fmt.Errorf("%d objects kept their "+
+			"previous vector, the first error was: %v", failed, firstErr))
+		return
+	}
+
+	finish(models.RevectorizationStatusCompleted, nil)
+}
+
+// revectorizePage recomputes the vectors of the objects of the page and
+// merges the new vectors of all objects which could be vectorized in bulk.
+// Only the vector is written, so that changes to the objects since they were
+// read are kept. A failure of an object doesn't affect the other objects. The
+// results match the objects by index.
+func (r *Revectorizer) revectorizePage(ctx context.Context, k kind.Kind,
+	page []search.Result) []itemResult {
+	results := make([]itemResult, len(page))
+
+	var merges kinds.BatchMergeDocuments
+	for i, item := range page {
+		if ctx.Err() != nil {
+			// cancelled, the objects which are already vectorized are still
+			// stored, so that no work is lost
+			results[i].skipped = true
+			continue
+		}
+
+		var vector []float32
+		var err error
+		switch k {
+		case kind.Thing:
+			vector, err = r.vectorizer.Thing(ctx, item.Thing())
+		case kind.Action:
+			vector, err = r.vectorizer.Action(ctx, item.Action())
+		default:
+			err = fmt.Errorf("impossible kind")
+		}
+		if err != nil {
+			results[i].err = fmt.Errorf("vectorize %s/%s: %v", item.ClassName, item.ID, err)
+			continue
+		}
+
+		merges = append(merges, kinds.BatchMergeDocument{
+			OriginalIndex: i,
+			Merge: kinds.MergeDocument{
+				Kind:   k,
+				Class:  item.ClassName,
+				ID:     item.ID,
+				Vector: vector,
+			},
+		})
+	}
+
+	r.storePage(page, merges, results)
+	return results
+}
+
+// storePage writes the new vectors through the batch merge path of the
+// vector repo, errors of individual objects are set on their result
+func (r *Revectorizer) storePage(page []search.Result, merges kinds.BatchMergeDocuments,
+	results []itemResult) {
+	if len(merges) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	setErr := func(i int, err error) {
+		results[i].err = fmt.Errorf("store %s/%s: %v", page[i].ClassName, page[i].ID, err)
+	}
+
+	stored, err := r.vectorRepo.BatchMerge(ctx, merges)
+	if err != nil {
+		for _, item := range merges {
+			setErr(item.OriginalIndex, err)
+		}
+	}
+	for _, item := range stored {
+		if item.Err != nil {
+			setErr(item.OriginalIndex, item.Err)
+		}
+	}
+}
@@ -19,14 +19,18 @@ import (
 	"strings"
 
 	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/schema/kind"
 )
 
 // AddAction Class to the schema
 func (m *Manager) AddAction(ctx context.Context, principal *models.Principal,
-	class *models.Class) error {
+	class *models.Class) (err error) {
+	defer func() {
+		m.audit(principal, "create", kind.Action, class.Class, err)
+	}()
 
-	err := m.authorizer.Authorize(principal, "create", "schema/actions")
+	err = m.authorizer.Authorize(principal, "create", "schema/actions")
 	if err != nil {
 		return err
 	}
@@ -36,9 +40,12 @@ func (m *Manager) AddAction(ctx context.Context, principal *models.Principal,
 
 // AddThing Class to the schema
 func (m *Manager) AddThing(ctx context.Context, principal *models.Principal,
-	class *models.Class) error {
+	class *models.Class) (err error) {
+	defer func() {
+		m.audit(principal, "create", kind.Thing, class.Class, err)
+	}()
 
-	err := m.authorizer.Authorize(principal, "create", "schema/things")
+	err = m.authorizer.Authorize(principal, "create", "schema/things")
 	if err != nil {
 		return err
 	}
@@ -80,7 +87,21 @@ func (m *Manager) validateCanAddClass(ctx context.Context, principal *models.Pri
 		return err
 	}
 
-	err = m.validateClassNameAndKeywords(ctx, knd, class.Class, class.Keywords, VectorizeClassName(class))
+	if usesContextionary(class) {
+		err = m.validateClassNameAndKeywords(ctx, knd, class.Class, class.Keywords, VectorizeClassName(class))
+	} else {
+		_, err = schema.ValidateClassName(class.Class)
+	}
+	if err != nil {
+		return err
+	}
+
+	err = validateVectorIndexConfig(class.VectorIndexConfig)
+	if err != nil {
+		return err
+	}
+
+	err = m.validateVectorizer(class)
 	if err != nil {
 		return err
 	}
@@ -88,8 +109,12 @@ func (m *Manager) validateCanAddClass(ctx context.Context, principal *models.Pri
 	// Check properties
 	foundNames := map[string]bool{}
 	for _, property := range class.Properties {
-		err = m.validatePropertyNameAndKeywords(ctx, class.Class, property.Name, property.Keywords,
-			property.VectorizePropertyName)
+		if usesContextionary(class) {
+			err = m.validatePropertyNameAndKeywords(ctx, class.Class, property.Name, property.Keywords,
+				property.VectorizePropertyName)
+		} else {
+			_, err = schema.ValidatePropertyName(property.Name)
+		}
 		if err != nil {
 			return err
 		}
@@ -106,18 +131,24 @@ func (m *Manager) validateCanAddClass(ctx context.Context, principal *models.Pri
 			return err
 		}
 
-		_, err = (&schema).FindPropertyDataType(property.DataType)
+		dt, err := (&schema).FindPropertyDataType(property.DataType)
 		if err != nil {
 			return fmt.Errorf("property '%s': invalid dataType: %v", property.Name, err)
 		}
+
+		if err := validateOnDelete(property, dt); err != nil {
+			return err
+		}
 	}
 
 	// The user has the option to no-index select properties, but if they
 	// no-index every prop, there is a chance we don't have enough info to build
 	// vectors. See validation function for details.
-	err = m.validatePropertyIndexState(ctx, class)
-	if err != nil {
-		return err
+	if usesContextionary(class) {
+		err = m.validatePropertyIndexState(ctx, class)
+		if err != nil {
+			return err
+		}
 	}
 
 	// all is fine!
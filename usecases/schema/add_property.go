@@ -24,9 +24,12 @@ import (
 
 // AddActionProperty to an existing Action
 func (m *Manager) AddActionProperty(ctx context.Context, principal *models.Principal,
-	class string, property *models.Property) error {
+	class string, property *models.Property) (err error) {
+	defer func() {
+		m.audit(principal, "update", kind.Action, class, err)
+	}()
 
-	err := m.authorizer.Authorize(principal, "update", "schema/actions")
+	err = m.authorizer.Authorize(principal, "update", "schema/actions")
 	if err != nil {
 		return err
 	}
@@ -36,9 +39,12 @@ func (m *Manager) AddActionProperty(ctx context.Context, principal *models.Princ
 
 // AddThingProperty to an existing Thing
 func (m *Manager) AddThingProperty(ctx context.Context, principal *models.Principal,
-	class string, property *models.Property) error {
+	class string, property *models.Property) (err error) {
+	defer func() {
+		m.audit(principal, "update", kind.Thing, class, err)
+	}()
 
-	err := m.authorizer.Authorize(principal, "update", "schema/things")
+	err = m.authorizer.Authorize(principal, "update", "schema/things")
 	if err != nil {
 		return err
 	}
@@ -92,10 +98,12 @@ func (m *Manager) validateCanAddProperty(ctx context.Context, principal *models.
 		return err
 	}
 
-	err = m.validatePropertyNameAndKeywords(ctx, class.Class, property.Name, property.Keywords,
-		property.VectorizePropertyName)
-	if err != nil {
-		return err
+	if usesContextionary(class) {
+		err = m.validatePropertyNameAndKeywords(ctx, class.Class, property.Name, property.Keywords,
+			property.VectorizePropertyName)
+		if err != nil {
+			return err
+		}
 	}
 
 	// Validate data type of property.
@@ -104,11 +112,15 @@ func (m *Manager) validateCanAddProperty(ctx context.Context, principal *models.
 		return err
 	}
 
-	_, err = (&schema).FindPropertyDataType(property.DataType)
+	dt, err := (&schema).FindPropertyDataType(property.DataType)
 	if err != nil {
 		return fmt.Errorf("Data type of property '%s' is invalid; %v", property.Name, err)
 	}
 
+	if err := validateOnDelete(property, dt); err != nil {
+		return err
+	}
+
 	if err = m.validateNetworkCrossRefs(property.DataType); err != nil {
 		return fmt.Errorf("Data type of property '%s' is invalid; %v", property.Name, err)
 	}
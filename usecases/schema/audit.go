@@ -0,0 +1,42 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"fmt"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/audit"
+)
+
+type auditor interface {
+	Record(events ...audit.Event)
+}
+
+// SetAuditor enables recording an audit event for every schema change
+func (m *Manager) SetAuditor(a auditor) {
+	m.auditor = a
+}
+
+func (m *Manager) audit(principal *models.Principal, verb string, k kind.Kind,
+	className string, err error) {
+	if m.auditor == nil {
+		return
+	}
+
+	resource := fmt.Sprintf("schema/%ss", k.Name())
+	m.auditor.Record(audit.NewEvent(principal, verb, resource, err).
+		WithObject(className, ""))
+}
@@ -20,6 +20,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema/kind"
 	"github.com/sirupsen/logrus/hooks/test"
@@ -45,6 +46,17 @@ func Test_Schema_Authorization(t *testing.T) {
 			expectedVerb:     "list",
 			expectedResource: "schema/*",
 		},
+		testCase{
+			methodName:       "GetReindexes",
+			expectedVerb:     "list",
+			expectedResource: "schema/*",
+		},
+		testCase{
+			methodName:       "GetReindex",
+			additionalArgs:   []interface{}{strfmt.UUID("some-id")},
+			expectedVerb:     "list",
+			expectedResource: "schema/*",
+		},
 
 		testCase{
 			methodName:       "AddThing",
@@ -141,7 +153,8 @@ func Test_Schema_Authorization(t *testing.T) {
 		for _, method := range allExportedMethods(&Manager{}) {
 			switch method {
 			case "TriggerSchemaUpdateCallbacks", "RegisterSchemaUpdateCallback", "UpdateMeta", "GetSchemaSkipAuth",
-				"Indexed", "VectorizeClassName", "VectorizePropertyName":
+				"Indexed", "VectorizeClassName", "VectorizePropertyName", "Vectorizer", "SetAuditor",
+				"SetVectorizers":
 				// don't require auth on methods which are exported because other
 				// packages need to call them for maintenance and other regular jobs,
 				// but aren't user facing
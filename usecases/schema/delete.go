@@ -22,8 +22,11 @@ import (
 )
 
 // DeleteAction Class to the schema
-func (m *Manager) DeleteAction(ctx context.Context, principal *models.Principal, class string) error {
-	err := m.authorizer.Authorize(principal, "delete", "schema/actions")
+func (m *Manager) DeleteAction(ctx context.Context, principal *models.Principal, class string) (err error) {
+	defer func() {
+		m.audit(principal, "delete", kind.Action, class, err)
+	}()
+	err = m.authorizer.Authorize(principal, "delete", "schema/actions")
 	if err != nil {
 		return err
 	}
@@ -32,8 +35,11 @@ func (m *Manager) DeleteAction(ctx context.Context, principal *models.Principal,
 }
 
 // DeleteThing Class to the schema
-func (m *Manager) DeleteThing(ctx context.Context, principal *models.Principal, class string) error {
-	err := m.authorizer.Authorize(principal, "delete", "schema/things")
+func (m *Manager) DeleteThing(ctx context.Context, principal *models.Principal, class string) (err error) {
+	defer func() {
+		m.audit(principal, "delete", kind.Thing, class, err)
+	}()
+	err = m.authorizer.Authorize(principal, "delete", "schema/things")
 	if err != nil {
 		return err
 	}
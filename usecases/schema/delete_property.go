@@ -18,75 +18,76 @@ import (
 	"fmt"
 
 	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
 )
 
 // DeleteActionProperty to an existing Action
 func (m *Manager) DeleteActionProperty(ctx context.Context, principal *models.Principal,
-	class string, property string) error {
+	class string, property string) (err error) {
+	defer func() {
+		m.audit(principal, "update", kind.Action, class, err)
+	}()
 
-	err := m.authorizer.Authorize(principal, "update", "schema/actions")
+	err = m.authorizer.Authorize(principal, "update", "schema/actions")
 	if err != nil {
 		return err
 	}
 
-	return fmt.Errorf("deleting a property is currently not supported, see " +
-		"https://github.com/semi-technologies/weaviate/issues/973 for details.")
-	// return m.deleteClassProperty(ctx, class, property, kind.Action)
+	return m.deleteClassProperty(ctx, class, property, kind.Action)
 }
 
 // DeleteThingProperty to an existing Thing
 func (m *Manager) DeleteThingProperty(ctx context.Context, principal *models.Principal,
-	class string, property string) error {
+	class string, property string) (err error) {
+	defer func() {
+		m.audit(principal, "update", kind.Thing, class, err)
+	}()
 
-	err := m.authorizer.Authorize(principal, "update", "schema/things")
+	err = m.authorizer.Authorize(principal, "update", "schema/things")
 	if err != nil {
 		return err
 	}
 
-	return fmt.Errorf("deleting a property is currently not supported, see " +
-		"https://github.com/semi-technologies/weaviate/issues/973 for details.")
-	// return m.deleteClassProperty(ctx, class, property, kind.Thing)
+	return m.deleteClassProperty(ctx, class, property, kind.Thing)
 }
 
-// TODO: https://github.com/semi-technologies/weaviate/issues/973
-// func (m *Manager) deleteClassProperty(ctx context.Context, className string, propName string, k kind.Kind) error {
-// 	unlock, err := m.locks.LockSchema()
-// 	if err != nil {
-// 		return err
-// 	}
-// 	defer unlock()
-
-// 	err = m.migrator.DropProperty(ctx, k, className, propName)
-// 	if err != nil {
-// 		return fmt.Errorf("could not migrate database schema: %v", err)
-// 	}
-
-// 	semanticSchema := m.state.SchemaFor(k)
-// 	class, err := schema.GetClassByName(semanticSchema, className)
-// 	if err != nil {
-// 		return err
-// 	}
-
-// 	var propIdx = -1
-// 	for idx, prop := range class.Properties {
-// 		if prop.Name == propName {
-// 			propIdx = idx
-// 			break
-// 		}
-// 	}
-
-// 	if propIdx == -1 {
-// 		return fmt.Errorf("could not find property '%s' - it might have already been deleted?", propName)
-// 	}
-
-// 	class.Properties[propIdx] = class.Properties[len(class.Properties)-1]
-// 	class.Properties[len(class.Properties)-1] = nil // to prevent leaking this pointer.
-// 	class.Properties = class.Properties[:len(class.Properties)-1]
-
-// 	err = m.saveSchema(ctx)
-// 	if err != nil {
-// 		return fmt.Errorf("could not persists schema change in configuration: %v", err)
-// 	}
-
-// 	return nil
-// }
+func (m *Manager) deleteClassProperty(ctx context.Context, className string, propName string, k kind.Kind) error {
+	unlock, err := m.locks.LockSchema()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	semanticSchema := m.state.SchemaFor(k)
+	class, err := schema.GetClassByName(semanticSchema, className)
+	if err != nil {
+		return err
+	}
+
+	var propIdx = -1
+	for idx, prop := range class.Properties {
+		if prop.Name == propName {
+			propIdx = idx
+			break
+		}
+	}
+
+	if propIdx == -1 {
+		return fmt.Errorf("could not find property '%s' - it might have already been deleted?", propName)
+	}
+
+	err = m.migrator.DropProperty(ctx, k, className, propName)
+	if err != nil {
+		return fmt.Errorf("could not migrate database schema: %v", err)
+	}
+
+	class.Properties = append(class.Properties[:propIdx], class.Properties[propIdx+1:]...)
+
+	err = m.saveSchema(ctx)
+	if err != nil {
+		return fmt.Errorf("could not persists schema change in configuration: %v", err)
+	}
+
+	return nil
+}
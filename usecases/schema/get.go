@@ -65,6 +65,21 @@ func (m *Manager) Indexed(className, propertyName string) bool {
 	return false
 }
 
+// Vectorizer of the class, classes which don't exist are treated as if they
+// were vectorized by the default vectorizer
+func (m *Manager) Vectorizer(className string) string {
+	s := schema.Schema{
+		Actions: m.state.ActionSchema,
+		Things:  m.state.ThingSchema,
+	}
+	class := s.FindClassByName(schema.ClassName(className))
+	if class == nil {
+		return models.ClassVectorizerContextionary
+	}
+
+	return Vectorizer(class)
+}
+
 func (m *Manager) VectorizeClassName(className string) bool {
 	s := schema.Schema{
 		Actions: m.state.ActionSchema,
@@ -40,6 +40,18 @@ type Manager struct {
 	callbacks        []func(updatedSchema schema.Schema)
 	logger           logrus.FieldLogger
 	authorizer       authorizer
+	auditor          auditor
+	vectorizers      map[string]struct{}
+}
+
+// SetVectorizers sets the vectorizer modules which are configured, classes
+// can only select one of those or none. Until it is called, only the
+// contextionary is available.
+func (m *Manager) SetVectorizers(names []string) {
+	m.vectorizers = make(map[string]struct{}, len(names))
+	for _, name := range names {
+		m.vectorizers[name] = struct{}{}
+	}
 }
 
 type SchemaGetter interface {
@@ -188,6 +200,23 @@ func newSchema() *State {
 	}
 }
 
+// Vectorizer is the only safe way to access this property, as it could
+// otherwise be empty. It is also the single place a default is set
+func Vectorizer(class *models.Class) string {
+	if class.Vectorizer == "" {
+		return models.ClassVectorizerContextionary
+	}
+
+	return class.Vectorizer
+}
+
+// usesContextionary is false if the class is not vectorized by the
+// contextionary, in this case its names and keywords don't need to be
+// contextionary-valid
+func usesContextionary(class *models.Class) bool {
+	return Vectorizer(class) == models.ClassVectorizerContextionary
+}
+
 // VectorizeClassName is the only safe way to access this property, as it could
 // otherwise be nil. It is also the single place a default is set
 func VectorizeClassName(class *models.Class) bool {
@@ -198,3 +227,13 @@ func VectorizeClassName(class *models.Class) bool {
 
 	return *class.VectorizeClassName
 }
+
+// OnDelete is the only safe way to access this property, as it could
+// otherwise be empty. It is also the single place a default is set
+func OnDelete(prop *models.Property) string {
+	if prop.OnDelete == "" {
+		return models.PropertyOnDeleteSetNull
+	}
+
+	return prop.OnDelete
+}
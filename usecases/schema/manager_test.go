@@ -65,6 +65,7 @@ var schemaTests = []struct {
 	{name: "CantAddSameClassTwice", fn: testCantAddSameClassTwice},
 	{name: "CantAddSameClassTwiceDifferentKind", fn: testCantAddSameClassTwiceDifferentKinds},
 	{name: "UpdateClassName", fn: testUpdateClassName},
+	{name: "UpdateClassNameReferences", fn: testUpdateClassNameReferences},
 	{name: "UpdateClassNameCollision", fn: testUpdateClassNameCollision},
 	{name: "AddThingClassWithKeywords", fn: testAddThingClassWithKeywords},
 	{name: "AddThingClassWithInvalidKeywordWeights", fn: testAddThingClassWithInvalidKeywordWeights},
@@ -73,6 +74,11 @@ var schemaTests = []struct {
 	{name: "AddInvalidPropertyDuringCreation", fn: testAddInvalidPropertyDuringCreation},
 	{name: "AddInvalidPropertyWithEmptyDataTypeDuringCreation", fn: testAddInvalidPropertyWithEmptyDataTypeDuringCreation},
 	{name: "AddPropertyDWithInvalidKeywordWeightsDuringCreation", fn: testAddPropertyWithInvalidKeywordWeightsDuringCreation},
+	{name: "AddThingClassWithUnconfiguredVectorizer", fn: testAddThingClassWithUnconfiguredVectorizer},
+	{name: "AddThingClassWithConfiguredVectorizer", fn: testAddThingClassWithConfiguredVectorizer},
+	{name: "UpdateClassVectorizer", fn: testUpdateClassVectorizer},
+	{name: "AddPropertyWithOnDeleteDuringCreation", fn: testAddPropertyWithOnDeleteDuringCreation},
+	{name: "AddPropertyWithInvalidOnDeleteDuringCreation", fn: testAddPropertyWithInvalidOnDeleteDuringCreation},
 	{name: "DropProperty", fn: testDropProperty},
 	{name: "UpdatePropertyName", fn: testUpdatePropertyName},
 	{name: "UpdatePropertyNameCollision", fn: testUpdatePropertyNameCollision},
@@ -215,6 +221,32 @@ func testUpdateClassName(t *testing.T, lsm *Manager) {
 	assert.Equal(t, thingClasses[0], "NewName")
 }
 
+func testUpdateClassNameReferences(t *testing.T, lsm *Manager) {
+	t.Parallel()
+
+	assert.Nil(t, lsm.AddThing(context.Background(), nil,
+		&models.Class{VectorizeClassName: ptBool(true), Class: "InitialName"}))
+	assert.Nil(t, lsm.AddAction(context.Background(), nil,
+		&models.Class{
+			VectorizeClassName: ptBool(true),
+			Class:              "Referencing",
+			Properties: []*models.Property{{
+				Name:     "target",
+				DataType: []string{"InitialName"},
+			}},
+		}))
+
+	updated := models.Class{
+		Class: "NewName",
+	}
+	assert.Nil(t, lsm.UpdateThing(context.Background(), nil, "InitialName", &updated))
+
+	actionClasses := testGetClasses(lsm, kind.Action)
+	require.Len(t, actionClasses, 1)
+	require.Len(t, actionClasses[0].Properties, 1)
+	assert.Equal(t, []string{"NewName"}, actionClasses[0].Properties[0].DataType)
+}
+
 func testUpdateClassNameCollision(t *testing.T, lsm *Manager) {
 	t.Parallel()
 
@@ -407,6 +439,120 @@ func testAddInvalidPropertyWithEmptyDataTypeDuringCreation(t *testing.T, lsm *Ma
 	assert.NotNil(t, err)
 }
 
+func testAddThingClassWithUnconfiguredVectorizer(t *testing.T, lsm *Manager) {
+	t.Parallel()
+
+	err := lsm.AddThing(context.Background(), nil, &models.Class{
+		Class:      "Car",
+		Vectorizer: models.ClassVectorizerText2vecHTTP,
+	})
+	assert.Equal(t, "class 'Car': vectorizer 'text2vec-http' is not configured", err.Error())
+	assert.Len(t, testGetClasses(lsm, kind.Thing), 0)
+}
+
+func testAddThingClassWithConfiguredVectorizer(t *testing.T, lsm *Manager) {
+	t.Parallel()
+
+	lsm.SetVectorizers([]string{models.ClassVectorizerContextionary,
+		models.ClassVectorizerText2vecHTTP})
+
+	err := lsm.AddThing(context.Background(), nil, &models.Class{
+		Class:      "Car",
+		Vectorizer: models.ClassVectorizerText2vecHTTP,
+	})
+	require.Nil(t, err)
+
+	err = lsm.AddThing(context.Background(), nil, &models.Class{
+		Class:      "Bike",
+		Vectorizer: models.ClassVectorizerNone,
+	})
+	require.Nil(t, err)
+	assert.Len(t, testGetClasses(lsm, kind.Thing), 2)
+}
+
+func testUpdateClassVectorizer(t *testing.T, lsm *Manager) {
+	t.Parallel()
+
+	err := lsm.AddThing(context.Background(), nil, &models.Class{
+		Class: "Car",
+	})
+	require.Nil(t, err)
+
+	err = lsm.UpdateThing(context.Background(), nil, "Car", &models.Class{
+		Class:      "Car",
+		Vectorizer: models.ClassVectorizerText2vecHTTP,
+	})
+	assert.NotNil(t, err, "vectorizer is not configured")
+
+	err = lsm.UpdateThing(context.Background(), nil, "Car", &models.Class{
+		Class:      "Car",
+		Vectorizer: models.ClassVectorizerNone,
+	})
+	assert.NotNil(t, err, "vectorizer can't be changed")
+
+	err = lsm.UpdateThing(context.Background(), nil, "Car", &models.Class{
+		Class:      "Car",
+		Vectorizer: models.ClassVectorizerContextionary,
+	})
+	assert.Nil(t, err)
+}
+
+func testAddPropertyWithOnDeleteDuringCreation(t *testing.T, lsm *Manager) {
+	t.Parallel()
+
+	err := lsm.AddThing(context.Background(), nil, &models.Class{
+		Class: "Owner",
+	})
+	require.Nil(t, err)
+
+	err = lsm.AddThing(context.Background(), nil, &models.Class{
+		Class: "Car",
+		Properties: []*models.Property{{
+			Name:     "ownedBy",
+			DataType: []string{"Owner"},
+			OnDelete: models.PropertyOnDeleteCascade,
+		}},
+	})
+	require.Nil(t, err)
+
+	thingClasses := testGetClasses(lsm, kind.Thing)
+	require.Len(t, thingClasses, 2)
+	for _, class := range thingClasses {
+		if class.Class != "Car" {
+			continue
+		}
+
+		require.Len(t, class.Properties, 1)
+		assert.Equal(t, models.PropertyOnDeleteCascade, class.Properties[0].OnDelete)
+	}
+}
+
+func testAddPropertyWithInvalidOnDeleteDuringCreation(t *testing.T, lsm *Manager) {
+	t.Parallel()
+
+	// onDelete on a primitive property
+	err := lsm.AddThing(context.Background(), nil, &models.Class{
+		Class: "Car",
+		Properties: []*models.Property{{
+			Name:     "color",
+			DataType: []string{"string"},
+			OnDelete: models.PropertyOnDeleteRestrict,
+		}},
+	})
+	assert.NotNil(t, err)
+
+	// unknown onDelete policy
+	err = lsm.AddThing(context.Background(), nil, &models.Class{
+		Class: "Car",
+		Properties: []*models.Property{{
+			Name:     "ownedBy",
+			DataType: []string{"Car"},
+			OnDelete: "explode",
+		}},
+	})
+	assert.NotNil(t, err)
+}
+
 func testAddPropertyWithInvalidKeywordWeightsDuringCreation(t *testing.T, lsm *Manager) {
 	t.Parallel()
 
@@ -466,11 +612,6 @@ func testAddPropertyWithInvalidKeywordWeightsDuringCreation(t *testing.T, lsm *M
 }
 
 func testDropProperty(t *testing.T, lsm *Manager) {
-	// TODO: https://github.com/semi-technologies/weaviate/issues/973
-	// Remove skip
-
-	t.Skip()
-
 	t.Parallel()
 
 	var properties []*models.Property = []*models.Property{
@@ -488,7 +629,8 @@ func testDropProperty(t *testing.T, lsm *Manager) {
 	assert.Len(t, thingClasses[0].Properties, 1)
 
 	// Now drop the property
-	lsm.DeleteThingProperty(context.Background(), nil, "Car", "color")
+	err = lsm.DeleteThingProperty(context.Background(), nil, "Car", "color")
+	assert.Nil(t, err)
 
 	thingClasses = testGetClasses(lsm, kind.Thing)
 	require.Len(t, thingClasses, 1)
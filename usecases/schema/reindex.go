@@ -0,0 +1,63 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+)
+
+// reindexer is implemented by migrators which can't migrate existing objects
+// in place, but copy them to a new index in the background, such as esvector
+// on renames and dropped properties
+type reindexer interface {
+	Reindexes() []*models.SchemaReindex
+	Reindex(id strfmt.UUID) *models.SchemaReindex
+}
+
+// GetReindexes lists the reindex jobs started by schema changes. The list is
+// always empty if the migrator migrates in place.
+func (m *Manager) GetReindexes(ctx context.Context,
+	principal *models.Principal) ([]*models.SchemaReindex, error) {
+	err := m.authorizer.Authorize(principal, "list", "schema/*")
+	if err != nil {
+		return nil, err
+	}
+
+	r, ok := m.migrator.(reindexer)
+	if !ok {
+		return []*models.SchemaReindex{}, nil
+	}
+
+	return r.Reindexes(), nil
+}
+
+// GetReindex returns the status of a single reindex job, nil if it doesn't
+// exist
+func (m *Manager) GetReindex(ctx context.Context, principal *models.Principal,
+	id strfmt.UUID) (*models.SchemaReindex, error) {
+	err := m.authorizer.Authorize(principal, "list", "schema/*")
+	if err != nil {
+		return nil, err
+	}
+
+	r, ok := m.migrator.(reindexer)
+	if !ok {
+		return nil, nil
+	}
+
+	return r.Reindex(id), nil
+}
@@ -0,0 +1,154 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// reindexingMigrator behaves like a migrator which copies objects into a new
+// index on every rename, it can be set to fail the migration
+type reindexingMigrator struct {
+	NilMigrator
+	jobs []*models.SchemaReindex
+	err  error
+}
+
+func (m *reindexingMigrator) UpdateClass(ctx context.Context, k kind.Kind,
+	className string, newClassName *string, newKeywords *models.Keywords) error {
+	if m.err != nil {
+		return m.err
+	}
+
+	if newClassName != nil {
+		m.jobs = append(m.jobs, &models.SchemaReindex{
+			ID:        strfmt.UUID("0a2a4ad4-0c4a-4a3b-9b4b-4d9b0d8b0f44"),
+			Operation: models.SchemaReindexOperationRenameClass,
+			From:      className,
+			To:        *newClassName,
+			Status:    models.SchemaReindexStatusRunning,
+		})
+	}
+
+	return nil
+}
+
+func (m *reindexingMigrator) UpdateProperty(ctx context.Context, k kind.Kind,
+	className string, propName string, newName *string, newKeywords *models.Keywords) error {
+	return m.err
+}
+
+func (m *reindexingMigrator) Reindexes() []*models.SchemaReindex {
+	return m.jobs
+}
+
+func (m *reindexingMigrator) Reindex(id strfmt.UUID) *models.SchemaReindex {
+	for _, job := range m.jobs {
+		if job.ID == id {
+			return job
+		}
+	}
+
+	return nil
+}
+
+func newReindexingSchemaManager(t *testing.T, migrator *reindexingMigrator) *Manager {
+	logger, _ := test.NewNullLogger()
+	sm, err := NewManager(migrator, newFakeRepo(), newFakeLocks(), nil,
+		logger, &fakeC11y{}, &fakeAuthorizer{}, &fakeStopwordDetector{})
+	require.Nil(t, err)
+
+	err = sm.AddThing(context.Background(), nil, &models.Class{
+		Class:              "InitialName",
+		VectorizeClassName: ptBool(true),
+		Properties: []*models.Property{{
+			Name:     "color",
+			DataType: []string{"string"},
+		}},
+	})
+	require.Nil(t, err)
+
+	return sm
+}
+
+func Test_Reindexes(t *testing.T) {
+	t.Run("with a migrator which doesn't reindex", func(t *testing.T) {
+		sm := newSchemaManager()
+
+		jobs, err := sm.GetReindexes(context.Background(), nil)
+		require.Nil(t, err)
+		assert.Len(t, jobs, 0)
+
+		job, err := sm.GetReindex(context.Background(), nil, "0a2a4ad4-0c4a-4a3b-9b4b-4d9b0d8b0f44")
+		require.Nil(t, err)
+		assert.Nil(t, job)
+	})
+
+	t.Run("with a migrator which reindexes on renames", func(t *testing.T) {
+		sm := newReindexingSchemaManager(t, &reindexingMigrator{})
+
+		err := sm.UpdateThing(context.Background(), nil, "InitialName",
+			&models.Class{Class: "NewName"})
+		require.Nil(t, err)
+
+		jobs, err := sm.GetReindexes(context.Background(), nil)
+		require.Nil(t, err)
+		require.Len(t, jobs, 1)
+		assert.Equal(t, "InitialName", jobs[0].From)
+		assert.Equal(t, "NewName", jobs[0].To)
+
+		job, err := sm.GetReindex(context.Background(), nil, jobs[0].ID)
+		require.Nil(t, err)
+		assert.Equal(t, jobs[0], job)
+
+		job, err = sm.GetReindex(context.Background(), nil, "6a1f1a45-4e3b-4bd2-a9bc-c5c6a1f0a0f1")
+		require.Nil(t, err)
+		assert.Nil(t, job)
+	})
+}
+
+func Test_Renames_FailedMigration(t *testing.T) {
+	migrator := &reindexingMigrator{err: errors.New("class is being reindexed")}
+
+	t.Run("class rename is reverted", func(t *testing.T) {
+		sm := newReindexingSchemaManager(t, migrator)
+
+		err := sm.UpdateThing(context.Background(), nil, "InitialName",
+			&models.Class{Class: "NewName"})
+		assert.Equal(t, errors.New("could not migrate database schema: "+
+			"class is being reindexed"), err)
+		assert.Equal(t, []string{"InitialName"}, testGetClassNames(sm, kind.Thing))
+	})
+
+	t.Run("property rename is reverted", func(t *testing.T) {
+		sm := newReindexingSchemaManager(t, migrator)
+
+		err := sm.UpdateThingProperty(context.Background(), nil, "InitialName",
+			"color", &models.Property{Name: "smell"})
+		assert.Equal(t, errors.New("could not migrate database schema: "+
+			"class is being reindexed"), err)
+		classes := testGetClasses(sm, kind.Thing)
+		require.Len(t, classes, 1)
+		assert.Equal(t, "color", classes[0].Properties[0].Name)
+	})
+}
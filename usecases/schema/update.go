@@ -15,6 +15,7 @@ package schema
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
@@ -23,9 +24,12 @@ import (
 
 // UpdateAction which exists
 func (m *Manager) UpdateAction(ctx context.Context, principal *models.Principal,
-	name string, class *models.Class) error {
+	name string, class *models.Class) (err error) {
+	defer func() {
+		m.audit(principal, "update", kind.Action, name, err)
+	}()
 
-	err := m.authorizer.Authorize(principal, "update", "schema/actions")
+	err = m.authorizer.Authorize(principal, "update", "schema/actions")
 	if err != nil {
 		return err
 	}
@@ -35,9 +39,12 @@ func (m *Manager) UpdateAction(ctx context.Context, principal *models.Principal,
 
 // UpdateThing which exists
 func (m *Manager) UpdateThing(ctx context.Context, principal *models.Principal,
-	name string, class *models.Class) error {
+	name string, class *models.Class) (err error) {
+	defer func() {
+		m.audit(principal, "update", kind.Thing, name, err)
+	}()
 
-	err := m.authorizer.Authorize(principal, "update", "schema/things")
+	err = m.authorizer.Authorize(principal, "update", "schema/things")
 	if err != nil {
 		return err
 	}
@@ -68,6 +75,8 @@ func (m *Manager) updateClass(ctx context.Context, className string,
 		newKeywords = &class.Keywords
 	}
 
+	newVectorizer := class.Vectorizer
+
 	semanticSchema := m.state.SchemaFor(k)
 
 	class, err = schema.GetClassByName(semanticSchema, className)
@@ -75,6 +84,17 @@ func (m *Manager) updateClass(ctx context.Context, className string,
 		return err
 	}
 
+	if newVectorizer != "" {
+		if err := m.validateVectorizer(&models.Class{Class: className, Vectorizer: newVectorizer}); err != nil {
+			return err
+		}
+
+		if newVectorizer != Vectorizer(class) {
+			return fmt.Errorf("class '%s': the vectorizer can't be changed, the class "+
+				"uses '%s'", className, Vectorizer(class))
+		}
+	}
+
 	classNameAfterUpdate := className
 	keywordsAfterUpdate := class.Keywords
 
@@ -92,20 +112,55 @@ func (m *Manager) updateClass(ctx context.Context, className string,
 	}
 
 	// Validate name / keywords in contextionary
-	if err = m.validateClassNameAndKeywords(ctx, k, classNameAfterUpdate, keywordsAfterUpdate,
-		VectorizeClassName(class)); err != nil {
+	if usesContextionary(class) {
+		err = m.validateClassNameAndKeywords(ctx, k, classNameAfterUpdate, keywordsAfterUpdate,
+			VectorizeClassName(class))
+	} else {
+		_, err = schema.ValidateClassName(classNameAfterUpdate)
+	}
+	if err != nil {
 		return err
 	}
 
-	// Validated! Now apply the changes.
+	// Validated! Now apply the changes. The migrator is called before the
+	// schema is persisted, so that it can build the new index from the updated
+	// schema, but the changes can still be reverted if the migration fails.
+	keywordsBeforeUpdate := class.Keywords
 	class.Class = classNameAfterUpdate
 	class.Keywords = keywordsAfterUpdate
+	if newName != nil {
+		m.renameClassInReferences(className, *newName)
+	}
 
-	err = m.saveSchema(ctx)
-
+	err = m.migrator.UpdateClass(ctx, k, className, newName, newKeywords)
 	if err != nil {
-		return nil
+		class.Class = className
+		class.Keywords = keywordsBeforeUpdate
+		if newName != nil {
+			m.renameClassInReferences(*newName, className)
+		}
+		return fmt.Errorf("could not migrate database schema: %v", err)
 	}
 
-	return m.migrator.UpdateClass(ctx, k, className, newName, newKeywords)
+	return m.saveSchema(ctx)
+}
+
+// renameClassInReferences points all reference properties which point to the
+// renamed class to its new name
+func (m *Manager) renameClassInReferences(oldName, newName string) {
+	for _, semanticSchema := range []*models.Schema{m.state.ThingSchema, m.state.ActionSchema} {
+		if semanticSchema == nil {
+			continue
+		}
+
+		for _, class := range semanticSchema.Classes {
+			for _, prop := range class.Properties {
+				for i, dataType := range prop.DataType {
+					if dataType == oldName {
+						prop.DataType[i] = newName
+					}
+				}
+			}
+		}
+	}
 }
@@ -24,9 +24,12 @@ import (
 
 // UpdateActionProperty of an existing Action Property
 func (m *Manager) UpdateActionProperty(ctx context.Context, principal *models.Principal,
-	class string, name string, property *models.Property) error {
+	class string, name string, property *models.Property) (err error) {
+	defer func() {
+		m.audit(principal, "update", kind.Action, class, err)
+	}()
 
-	err := m.authorizer.Authorize(principal, "update", "schema/actions")
+	err = m.authorizer.Authorize(principal, "update", "schema/actions")
 	if err != nil {
 		return err
 	}
@@ -36,9 +39,12 @@ func (m *Manager) UpdateActionProperty(ctx context.Context, principal *models.Pr
 
 // UpdateThingProperty of an existing Thing Property
 func (m *Manager) UpdateThingProperty(ctx context.Context, principal *models.Principal,
-	class string, name string, property *models.Property) error {
+	class string, name string, property *models.Property) (err error) {
+	defer func() {
+		m.audit(principal, "update", kind.Thing, class, err)
+	}()
 
-	err := m.authorizer.Authorize(principal, "update", "schema/things")
+	err = m.authorizer.Authorize(principal, "update", "schema/things")
 	if err != nil {
 		return err
 	}
@@ -94,30 +100,62 @@ func (m *Manager) updateClassProperty(ctx context.Context, className string, nam
 		keywordsAfterUpdate = *newKeywords
 	}
 
+	onDeleteAfterUpdate := prop.OnDelete
+	if property.OnDelete != "" {
+		// like keywords, an onDelete policy can't be unset, only changed
+		dt, err := (&schema.Schema{
+			Actions: m.state.ActionSchema,
+			Things:  m.state.ThingSchema,
+		}).FindPropertyDataType(prop.DataType)
+		if err != nil {
+			return err
+		}
+
+		if err := validateOnDelete(property, dt); err != nil {
+			return err
+		}
+
+		onDeleteAfterUpdate = property.OnDelete
+	}
+
 	// Validate name / keywords in contextionary
-	err = m.validatePropertyNameAndKeywords(ctx, className, propNameAfterUpdate, keywordsAfterUpdate,
-		prop.VectorizePropertyName)
+	if usesContextionary(class) {
+		err = m.validatePropertyNameAndKeywords(ctx, className, propNameAfterUpdate, keywordsAfterUpdate,
+			prop.VectorizePropertyName)
+	} else {
+		_, err = schema.ValidatePropertyName(propNameAfterUpdate)
+	}
 	if err != nil {
 		return err
 	}
 
-	// Validated! Now apply the changes.
+	// Validated! Now apply the changes. As with classes, the migrator sees the
+	// updated schema, but the changes are only persisted if it succeeds.
+	keywordsBeforeUpdate := prop.Keywords
+	onDeleteBeforeUpdate := prop.OnDelete
 	prop.Name = propNameAfterUpdate
 	prop.Keywords = keywordsAfterUpdate
+	prop.OnDelete = onDeleteAfterUpdate
 
-	err = m.saveSchema(ctx)
+	err = m.migrator.UpdateProperty(ctx, k, className, name, newName, newKeywords)
 	if err != nil {
-		return nil
+		prop.Name = name
+		prop.Keywords = keywordsBeforeUpdate
+		prop.OnDelete = onDeleteBeforeUpdate
+		return fmt.Errorf("could not migrate database schema: %v", err)
 	}
 
-	return m.migrator.UpdateProperty(ctx, k, className, name, newName, newKeywords)
+	return m.saveSchema(ctx)
 }
 
 // UpdatePropertyAddDataType adds another data type to a property. Warning: It does not lock on its own, assumes that it is called from when a schema lock is already held!
 func (m *Manager) UpdatePropertyAddDataType(ctx context.Context, principal *models.Principal,
-	kind kind.Kind, className string, propName string, newDataType string) error {
+	kind kind.Kind, className string, propName string, newDataType string) (err error) {
+	defer func() {
+		m.audit(principal, "update", kind, className, err)
+	}()
 
-	err := m.authorizer.Authorize(principal, "update", fmt.Sprintf("schema/%ss", kind.Name()))
+	err = m.authorizer.Authorize(principal, "update", fmt.Sprintf("schema/%ss", kind.Name()))
 	if err != nil {
 		return err
 	}
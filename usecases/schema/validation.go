@@ -285,3 +285,67 @@ func (m *Manager) validatePropertyIndexState(ctx context.Context, class *models.
 		"contextionary-valid text/string property which is not excluded from indexing.")
 
 }
+
+// validateVectorIndexConfig only checks the values for sanity, unset (zero)
+// values are valid as the database falls back to its defaults
+func validateVectorIndexConfig(cfg *models.VectorIndexConfig) error {
+	if cfg == nil {
+		return nil
+	}
+
+	if cfg.MaxConnections < 0 || cfg.MaxConnections == 1 {
+		return fmt.Errorf("invalid vectorIndexConfig: maxConnections must be at least 2, got %d",
+			cfg.MaxConnections)
+	}
+
+	if cfg.EfConstruction < 0 {
+		return fmt.Errorf("invalid vectorIndexConfig: efConstruction must not be negative, got %d",
+			cfg.EfConstruction)
+	}
+
+	if cfg.Ef < 0 {
+		return fmt.Errorf("invalid vectorIndexConfig: ef must not be negative, got %d", cfg.Ef)
+	}
+
+	return nil
+}
+
+// validateOnDelete makes sure an onDelete policy is only set on reference
+// properties, as there is nothing to restrict, cascade or unset otherwise
+func validateOnDelete(property *models.Property, dt schema.PropertyDataType) error {
+	switch property.OnDelete {
+	case "":
+		return nil
+	case models.PropertyOnDeleteRestrict, models.PropertyOnDeleteCascade,
+		models.PropertyOnDeleteSetNull:
+	default:
+		return fmt.Errorf("property '%s': invalid onDelete '%s', must be one of "+
+			"restrict, cascade, setNull", property.Name, property.OnDelete)
+	}
+
+	if !dt.IsReference() {
+		return fmt.Errorf("property '%s': onDelete is only supported on reference properties",
+			property.Name)
+	}
+
+	return nil
+}
+
+// validateVectorizer makes sure the class selects a vectorizer which is
+// configured, as otherwise every import into the class would fail
+func (m *Manager) validateVectorizer(class *models.Class) error {
+	name := Vectorizer(class)
+	if name == models.ClassVectorizerNone {
+		return nil
+	}
+
+	if m.vectorizers == nil {
+		if name == models.ClassVectorizerContextionary {
+			return nil
+		}
+	} else if _, ok := m.vectorizers[name]; ok {
+		return nil
+	}
+
+	return fmt.Errorf("class '%s': vectorizer '%s' is not configured", class.Class, name)
+}
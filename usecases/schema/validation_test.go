@@ -1004,3 +1004,89 @@ func ptFalse() *bool {
 	f := false
 	return &f
 }
+
+func Test_Validation_VectorIndexConfig(t *testing.T) {
+	type test struct {
+		name  string
+		cfg   *models.VectorIndexConfig
+		valid bool
+	}
+
+	tests := []test{
+		{name: "not set", cfg: nil, valid: true},
+		{name: "all defaults", cfg: &models.VectorIndexConfig{}, valid: true},
+		{
+			name:  "all set",
+			cfg:   &models.VectorIndexConfig{MaxConnections: 16, EfConstruction: 64, Ef: 32},
+			valid: true,
+		},
+		{name: "too few connections", cfg: &models.VectorIndexConfig{MaxConnections: 1}, valid: false},
+		{name: "negative efConstruction", cfg: &models.VectorIndexConfig{EfConstruction: -1}, valid: false},
+		{name: "negative ef", cfg: &models.VectorIndexConfig{Ef: -1}, valid: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			class := &models.Class{
+				Class:             "ValidName",
+				VectorIndexConfig: test.cfg,
+				Properties: []*models.Property{
+					{
+						DataType: []string{"string"},
+						Name:     "name",
+					},
+				},
+			}
+
+			m := newSchemaManager()
+			err := m.AddThing(context.Background(), nil, class)
+			if test.valid {
+				assert.Nil(t, err)
+			} else {
+				assert.NotNil(t, err)
+			}
+		})
+	}
+}
+
+func Test_Validation_WithoutVectorizer(t *testing.T) {
+	t.Run("with names unknown to the contextionary", func(t *testing.T) {
+		class := &models.Class{
+			Class:      "Carrot",
+			Vectorizer: models.ClassVectorizerNone,
+			Properties: []*models.Property{
+				{
+					DataType: []string{"string"},
+					Name:     "carrot",
+					Index:    ptFalse(),
+				},
+			},
+		}
+
+		m := newSchemaManager()
+		err := m.AddThing(context.Background(), nil, class)
+		require.Nil(t, err)
+		assert.Equal(t, models.ClassVectorizerNone, m.Vectorizer("Carrot"))
+
+		err = m.AddThingProperty(context.Background(), nil, "Carrot", &models.Property{
+			DataType: []string{"int"},
+			Name:     "theCarrot",
+		})
+		assert.Nil(t, err)
+	})
+
+	t.Run("with an invalid class name", func(t *testing.T) {
+		class := &models.Class{
+			Class:      "invalid-name",
+			Vectorizer: models.ClassVectorizerNone,
+		}
+
+		m := newSchemaManager()
+		err := m.AddThing(context.Background(), nil, class)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("the default vectorizer is the contextionary", func(t *testing.T) {
+		assert.Equal(t, models.ClassVectorizerContextionary, Vectorizer(&models.Class{}))
+	})
+}
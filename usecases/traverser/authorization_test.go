@@ -20,10 +20,15 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
 	"github.com/semi-technologies/weaviate/usecases/config"
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -42,30 +47,26 @@ func Test_Traverser_Authorization(t *testing.T) {
 	tests := []testCase{
 		testCase{
 			methodName:       "GetClass",
-			additionalArgs:   []interface{}{GetParams{}},
+			additionalArgs:   []interface{}{GetParams{Kind: kind.Thing, ClassName: "Foo"}},
 			expectedVerb:     "get",
-			expectedResource: "traversal/*",
+			expectedResource: "traversal/things/Foo",
 		},
 
 		testCase{
 			methodName:       "Aggregate",
-			additionalArgs:   []interface{}{&AggregateParams{}},
+			additionalArgs:   []interface{}{&AggregateParams{Kind: kind.Action, ClassName: "Foo"}},
 			expectedVerb:     "get",
-			expectedResource: "traversal/*",
-		},
-
-		testCase{
-			methodName:       "Explore",
-			additionalArgs:   []interface{}{ExploreParams{}},
-			expectedVerb:     "get",
-			expectedResource: "traversal/*",
+			expectedResource: "traversal/actions/Foo",
 		},
 	}
 
+	// Explore spans all classes, so unauthorized results are dropped instead
+	// of failing the whole request, see below
+	testedMethods := []string{"Explore"}
+
 	t.Run("verify that a test for every public method exists", func(t *testing.T) {
-		testedMethods := make([]string, len(tests), len(tests))
-		for i, test := range tests {
-			testedMethods[i] = test.methodName
+		for _, test := range tests {
+			testedMethods = append(testedMethods, test.methodName)
 		}
 
 		for _, method := range allExportedMethods(&Traverser{}) {
@@ -97,6 +98,182 @@ func Test_Traverser_Authorization(t *testing.T) {
 				authorizer.calls[0], "correct paramteres must have been used on authorizer")
 		}
 	})
+
+	t.Run("get authorizes classes reached through references", func(t *testing.T) {
+		principal := &models.Principal{}
+		logger, _ := test.NewNullLogger()
+		authorizer := &authDenier{}
+		schemaGetter := &fakeSchemaGetter{schema: schema.Schema{
+			Things:  &models.Schema{Classes: []*models.Class{{Class: "Foo"}}},
+			Actions: &models.Schema{Classes: []*models.Class{{Class: "Bar"}}},
+		}}
+		params := GetParams{
+			Kind:      kind.Thing,
+			ClassName: "Foo",
+			Properties: SelectProperties{{
+				Name: "ofBar",
+				Refs: []SelectClass{{ClassName: "Bar"}},
+			}},
+		}
+
+		manager := NewTraverser(&config.WeaviateConfig{}, &fakeLocks{}, logger,
+			&allowOnly{authDenier: authorizer, resource: "traversal/things/Foo"},
+			&fakeVectorizer{}, &fakeVectorRepo{}, &fakeExplorer{}, schemaGetter)
+
+		_, err := manager.GetClass(context.Background(), principal, params)
+		assert.Equal(t, errors.New("just a test fake"), err)
+		assert.Equal(t, []authorizeCall{
+			{principal, "get", "traversal/things/Foo"},
+			{principal, "get", "traversal/actions/Bar"},
+		}, authorizer.calls)
+	})
+
+	refSchema := schema.Schema{
+		Things: &models.Schema{Classes: []*models.Class{
+			{Class: "Article"},
+			{Class: "Person"},
+		}},
+		Actions: &models.Schema{},
+	}
+
+	// salaryOfAuthor filters articles on a property of their authors, nested
+	// in an operand
+	salaryOfAuthor := &filters.LocalFilter{Root: &filters.Clause{
+		Operator: filters.OperatorAnd,
+		Operands: []filters.Clause{{
+			Operator: filters.OperatorGreaterThan,
+			On: &filters.Path{
+				Class:    "Article",
+				Property: "writtenBy",
+				Child:    &filters.Path{Class: "Person", Property: "salary"},
+			},
+			Value: &filters.Value{Value: 100000, Type: schema.DataTypeInt},
+		}},
+	}}
+
+	t.Run("get authorizes classes reached through the where filter", func(t *testing.T) {
+		principal := &models.Principal{}
+		logger, _ := test.NewNullLogger()
+		authorizer := &authDenier{}
+		vectorRepo := &fakeVectorRepo{}
+		manager := NewTraverser(&config.WeaviateConfig{}, &fakeLocks{}, logger,
+			&allowOnly{authDenier: authorizer, resource: "traversal/things/Article"},
+			&fakeVectorizer{}, vectorRepo, &fakeExplorer{}, &fakeSchemaGetter{schema: refSchema})
+
+		_, err := manager.GetClass(context.Background(), principal, GetParams{
+			Kind:      kind.Thing,
+			ClassName: "Article",
+			Filters:   salaryOfAuthor,
+		})
+		assert.Equal(t, errors.New("just a test fake"), err)
+		assert.Equal(t, []authorizeCall{
+			{principal, "get", "traversal/things/Article"},
+			{principal, "get", "traversal/things/Person"},
+		}, authorizer.calls)
+		vectorRepo.AssertNotCalled(t, "ClassSearch", mock.Anything)
+	})
+
+	t.Run("aggregate authorizes classes reached through the filter and grouping", func(t *testing.T) {
+		principal := &models.Principal{}
+		logger, _ := test.NewNullLogger()
+
+		for _, params := range []*AggregateParams{
+			{Kind: kind.Thing, ClassName: "Article", Filters: salaryOfAuthor},
+			{Kind: kind.Thing, ClassName: "Article", GroupBy: &filters.Path{
+				Class:    "Article",
+				Property: "writtenBy",
+				Child:    &filters.Path{Class: "Person", Property: "salary"},
+			}},
+		} {
+			authorizer := &authDenier{}
+			manager := NewTraverser(&config.WeaviateConfig{}, &fakeLocks{}, logger,
+				&allowOnly{authDenier: authorizer, resource: "traversal/things/Article"},
+				&fakeVectorizer{}, &fakeVectorRepo{}, &fakeExplorer{},
+				&fakeSchemaGetter{schema: refSchema})
+
+			_, err := manager.Aggregate(context.Background(), principal, params)
+			assert.Equal(t, errors.New("just a test fake"), err)
+			assert.Equal(t, []authorizeCall{
+				{principal, "get", "traversal/things/Article"},
+				{principal, "get", "traversal/things/Person"},
+			}, authorizer.calls)
+		}
+	})
+
+	exploreSchema := schema.Schema{
+		Things: &models.Schema{
+			Classes: []*models.Class{{Class: "Foo"}},
+		},
+		Actions: &models.Schema{
+			Classes: []*models.Class{{Class: "Bar"}},
+		},
+	}
+
+	t.Run("explore drops results of unauthorized classes", func(t *testing.T) {
+		principal := &models.Principal{}
+		logger, _ := test.NewNullLogger()
+		authorizer := &authDenier{}
+		explorer := &fakeExplorer{
+			conceptsResults: []search.Result{
+				{Kind: kind.Thing, ClassName: "Foo", ID: "1"},
+				{Kind: kind.Thing, ClassName: "Foo", ID: "2"},
+				{Kind: kind.Action, ClassName: "Bar", ID: "3"},
+			},
+		}
+
+		manager := NewTraverser(&config.WeaviateConfig{}, &fakeLocks{}, logger,
+			&allowOnly{authDenier: authorizer, resource: "traversal/things/Foo"},
+			&fakeVectorizer{}, &fakeVectorRepo{}, explorer, &fakeSchemaGetter{exploreSchema})
+
+		res, err := manager.Explore(context.Background(), principal, ExploreParams{})
+		require.Nil(t, err)
+		assert.Len(t, res, 2)
+		assert.Equal(t, []authorizeCall{
+			// up front, at least one class must be readable
+			{principal, "get", "traversal/*"},
+			{principal, "get", "traversal/things/Foo"},
+			// every class of the results is authorized exactly once
+			{principal, "get", "traversal/things/Foo"},
+			{principal, "get", "traversal/actions/Bar"},
+		}, authorizer.calls)
+	})
+
+	t.Run("explore without any readable class doesn't search", func(t *testing.T) {
+		principal := &models.Principal{}
+		logger, _ := test.NewNullLogger()
+		authorizer := &authDenier{}
+		explorer := &fakeExplorer{}
+
+		manager := NewTraverser(&config.WeaviateConfig{}, &fakeLocks{}, logger, authorizer,
+			&fakeVectorizer{}, &fakeVectorRepo{}, explorer, &fakeSchemaGetter{exploreSchema})
+
+		_, err := manager.Explore(context.Background(), principal, ExploreParams{})
+		assert.Equal(t, errors.New("just a test fake"), err)
+		assert.False(t, explorer.conceptsCalled)
+	})
+
+	t.Run("explore near an object of an unreadable class doesn't search", func(t *testing.T) {
+		principal := &models.Principal{}
+		logger, _ := test.NewNullLogger()
+		authorizer := &authDenier{}
+		explorer := &fakeExplorer{
+			objects: map[string]*search.Result{
+				"source": {Kind: kind.Action, ClassName: "Bar", ID: "source"},
+			},
+		}
+
+		manager := NewTraverser(&config.WeaviateConfig{}, &fakeLocks{}, logger,
+			&allowOnly{authDenier: authorizer, resource: "traversal/things/Foo"},
+			&fakeVectorizer{}, &fakeVectorRepo{}, explorer, &fakeSchemaGetter{exploreSchema})
+
+		_, err := manager.Explore(context.Background(), principal, ExploreParams{
+			NearObject: &NearObjectParams{ID: "source"},
+		})
+		assert.Equal(t, errors.New("just a test fake"), err)
+		assert.False(t, explorer.conceptsCalled)
+		assert.Equal(t, authorizeCall{principal, "get", "traversal/actions/Bar"},
+			authorizer.calls[len(authorizer.calls)-1])
+	})
 }
 
 type authorizeCall struct {
@@ -114,6 +291,20 @@ func (a *authDenier) Authorize(principal *models.Principal, verb, resource strin
 	return errors.New("just a test fake")
 }
 
+// allowOnly allows a single resource and denies everything else
+type allowOnly struct {
+	*authDenier
+	resource string
+}
+
+func (a *allowOnly) Authorize(principal *models.Principal, verb, resource string) error {
+	err := a.authDenier.Authorize(principal, verb, resource)
+	if resource == a.resource {
+		return nil
+	}
+	return err
+}
+
 // inspired by https://stackoverflow.com/a/33008200
 func callFuncByName(manager interface{}, funcName string, params ...interface{}) (out []reflect.Value, err error) {
 	managerValue := reflect.ValueOf(manager)
@@ -0,0 +1,211 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package traverser
+
+import (
+	"context"
+
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization"
+)
+
+// authorizeGetParams makes sure the principal may read the root class as well
+// as every class that is reached through the selected reference properties or
+// the paths of the where filter.
+func (t *Traverser) authorizeGetParams(principal *models.Principal,
+	params GetParams) error {
+	root := authorization.TraversalResource(params.Kind, params.ClassName)
+	err := t.authorizer.Authorize(principal, "get", root)
+	if err != nil {
+		return err
+	}
+
+	if err := t.authorizeRefClasses(principal, params.Properties); err != nil {
+		return err
+	}
+
+	return t.authorizePaths(principal, root, filterPaths(params.Filters))
+}
+
+// authorizePaths makes sure the principal may read every class which is
+// reached through one of the paths, such as those of a where filter or a
+// groupBy. Otherwise filtering on a property of an unreadable class would
+// reveal its values one match at a time. The root class is already
+// authorized.
+func (t *Traverser) authorizePaths(principal *models.Principal, root string,
+	paths []*filters.Path) error {
+	s := t.schemaGetter.GetSchemaSkipAuth()
+	authorized := map[string]bool{root: true}
+	for _, path := range paths {
+		for hop := path; hop != nil; hop = hop.Child {
+			k, ok := s.GetKindOfClass(hop.Class)
+			if !ok {
+				// not a local class, nothing to authorize on this peer
+				continue
+			}
+
+			resource := authorization.TraversalResource(k, hop.Class.String())
+			if authorized[resource] {
+				continue
+			}
+
+			if err := t.authorizer.Authorize(principal, "get", resource); err != nil {
+				return err
+			}
+			authorized[resource] = true
+		}
+	}
+
+	return nil
+}
+
+// filterPaths lists the paths of all clauses of the filter, including those
+// of nested operands
+func filterPaths(filter *filters.LocalFilter) []*filters.Path {
+	if filter == nil || filter.Root == nil {
+		return nil
+	}
+
+	return clausePaths(*filter.Root, nil)
+}
+
+func clausePaths(clause filters.Clause, paths []*filters.Path) []*filters.Path {
+	if clause.On != nil {
+		paths = append(paths, clause.On)
+	}
+
+	for _, operand := range clause.Operands {
+		paths = clausePaths(operand, paths)
+	}
+
+	return paths
+}
+
+func (t *Traverser) authorizeRefClasses(principal *models.Principal,
+	props SelectProperties) error {
+	s := t.schemaGetter.GetSchemaSkipAuth()
+	for _, prop := range props {
+		for _, ref := range prop.Refs {
+			k, ok := s.GetKindOfClass(schema.ClassName(ref.ClassName))
+			if !ok {
+				// not a local class, such as a network ref, nothing to
+				// authorize on this peer
+				continue
+			}
+
+			err := t.authorizer.Authorize(principal, "get",
+				authorization.TraversalResource(k, ref.ClassName))
+			if err != nil {
+				return err
+			}
+
+			if err := t.authorizeRefClasses(principal, ref.RefProperties); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// filterReadable drops all results of classes the principal may not read. The
+// decision is cached per class, so the authorizer is called at most once per
+// class.
+func (t *Traverser) filterReadable(principal *models.Principal,
+	results []search.Result) []search.Result {
+	allowed := map[string]bool{}
+	out := make([]search.Result, 0, len(results))
+	for _, res := range results {
+		resource := authorization.TraversalResource(res.Kind, res.ClassName)
+		ok, cached := allowed[resource]
+		if !cached {
+			ok = t.authorizer.Authorize(principal, "get", resource) == nil
+			allowed[resource] = ok
+		}
+
+		if ok {
+			out = append(out, res)
+		}
+	}
+
+	return out
+}
+
+// authorizeExplore makes sure the principal may read at least one class
+// before anything is vectorized or searched. Explore spans all classes, the
+// results of classes the principal may not read are dropped afterwards.
+func (t *Traverser) authorizeExplore(principal *models.Principal) error {
+	err := t.authorizer.Authorize(principal, "get", "traversal/*")
+	if err == nil {
+		return nil
+	}
+
+	s := t.schemaGetter.GetSchemaSkipAuth()
+	for _, k := range []kind.Kind{kind.Thing, kind.Action} {
+		semanticSchema := s.SemanticSchemaFor(k)
+		if semanticSchema == nil {
+			continue
+		}
+
+		for _, class := range semanticSchema.Classes {
+			resource := authorization.TraversalResource(k, class.Class)
+			if t.authorizer.Authorize(principal, "get", resource) == nil {
+				return nil
+			}
+		}
+	}
+
+	return err
+}
+
+// authorizeSourceObjects makes sure the principal may read the objects whose
+// vectors are used for the search, as the nearObject source or as the target
+// of a movement. Otherwise the vector of an unreadable object would leak
+// into the results.
+func (t *Traverser) authorizeSourceObjects(ctx context.Context,
+	principal *models.Principal, nearObject *NearObjectParams,
+	explore *ExploreParams) error {
+	var sources []ObjectMove
+	if nearObject != nil {
+		sources = append(sources, ObjectMove{ID: nearObject.ID, Beacon: nearObject.Beacon})
+	}
+
+	if explore != nil {
+		if explore.NearObject != nil && explore.NearObject != nearObject {
+			sources = append(sources, ObjectMove{ID: explore.NearObject.ID,
+				Beacon: explore.NearObject.Beacon})
+		}
+		sources = append(sources, explore.MoveTo.Objects...)
+		sources = append(sources, explore.MoveAwayFrom.Objects...)
+	}
+
+	for _, source := range sources {
+		res, err := t.explorer.FindObject(ctx, source.ID, source.Beacon)
+		if err != nil {
+			return err
+		}
+
+		err = t.authorizer.Authorize(principal, "get",
+			authorization.TraversalResource(res.Kind, res.ClassName))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -40,8 +40,13 @@ type distancer func(a, b []float32) (float32, error)
 type vectorClassSearch interface {
 	ClassSearch(ctx context.Context, params GetParams) ([]search.Result, error)
 	VectorClassSearch(ctx context.Context, params GetParams) ([]search.Result, error)
+	KeywordClassSearch(ctx context.Context, params GetParams) ([]search.Result, error)
 	VectorSearch(ctx context.Context, vector []float32, limit int,
 		filters *filters.LocalFilter) ([]search.Result, error)
+	ThingByID(ctx context.Context, id strfmt.UUID, props SelectProperties,
+		meta bool) (*search.Result, error)
+	ActionByID(ctx context.Context, id strfmt.UUID, props SelectProperties,
+		meta bool) (*search.Result, error)
 }
 
 type explorerRepo interface {
@@ -60,32 +65,120 @@ func (e *Explorer) GetClass(ctx context.Context,
 	params GetParams) ([]interface{}, error) {
 
 	if params.Pagination == nil {
-		params.Pagination = &filters.Pagination{
-			Limit: 100,
-		}
+		params.Pagination = &filters.Pagination{}
+	}
+
+	if params.Pagination.Limit == 0 {
+		params.Pagination.Limit = 100
+	}
+
+	if err := params.Pagination.Validate(); err != nil {
+		return nil, fmt.Errorf("explorer: get class: %v", err)
+	}
+
+	if params.rankedSearches() > 1 {
+		return nil, fmt.Errorf("explorer: get class: only one of explore, " +
+			"nearVector, nearObject, bm25 and hybrid can be set")
+	}
+
+	if params.rankedSearches() > 0 && params.Pagination.After != "" {
+		// ranked results are ordered by certainty or relevance, not by id, so
+		// there is no meaningful position to continue from
+		return nil, fmt.Errorf("explorer: get class: a cursor (after) cannot " +
+			"be combined with explore, nearVector, nearObject, bm25 or hybrid, " +
+			"use offset instead")
+	}
+
+	if len(params.Sort) > 0 && params.rankedSearches() > 0 {
+		return nil, fmt.Errorf("explorer: get class: sort cannot be combined with " +
+			"explore, nearVector, nearObject, bm25 or hybrid, their results are " +
+			"ordered by certainty or relevance")
+	}
+
+	if len(params.Sort) > 0 && params.Pagination.After != "" {
+		// the cursor is the id of the last result, which only marks a position if
+		// results are ordered by id
+		return nil, fmt.Errorf("explorer: get class: sort cannot be combined with " +
+			"a cursor (after), use offset instead")
 	}
 
 	if params.Explore != nil {
 		return e.getClassExploration(ctx, params)
 	}
 
+	if params.NearVector != nil {
+		return e.getClassVectorSearch(ctx, params, params.NearVector.Vector,
+			params.NearVector.Certainty, "")
+	}
+
+	if params.NearObject != nil {
+		return e.getClassNearObject(ctx, params)
+	}
+
+	if params.KeywordRanking != nil {
+		return e.getClassKeywordRanking(ctx, params)
+	}
+
+	if params.Hybrid != nil {
+		return e.getClassHybrid(ctx, params)
+	}
+
 	return e.getClassList(ctx, params)
 }
 
 func (e *Explorer) getClassExploration(ctx context.Context,
 	params GetParams) ([]interface{}, error) {
-	searchVector, err := e.vectorFromExploreParams(ctx, params.Explore)
+	if err := params.Explore.validate(); err != nil {
+		return nil, fmt.Errorf("explorer: get class: explore: %v", err)
+	}
+
+	searchVector, err := e.vectorFromExploreParams(ctx, params.ClassName, params.Explore)
 	if err != nil {
 		return nil, fmt.Errorf("explorer: get class: vectorize params: %v", err)
 	}
 
+	return e.getClassVectorSearch(ctx, params, searchVector, params.Explore.certainty(),
+		nearObjectID(params.Explore.NearObject))
+}
+
+func (e *Explorer) getClassNearObject(ctx context.Context,
+	params GetParams) ([]interface{}, error) {
+	searchVector, err := e.vectorFromObject(ctx, params.NearObject.ID,
+		params.NearObject.Beacon)
+	if err != nil {
+		return nil, fmt.Errorf("explorer: get class: near object: %v", err)
+	}
+
+	return e.getClassVectorSearch(ctx, params, searchVector, params.NearObject.Certainty,
+		nearObjectID(params.NearObject))
+}
+
+// getClassVectorSearch searches the class by the vector. If exclude is set
+// the object with this id is never part of the results, this is used to
+// exclude the source object of a nearObject search.
+func (e *Explorer) getClassVectorSearch(ctx context.Context,
+	params GetParams, searchVector []float32, requiredCertainty float64,
+	exclude strfmt.UUID) ([]interface{}, error) {
 	params.SearchVector = searchVector
 
+	pagination := params.Pagination
+	if exclude != "" {
+		// request one more, as the source object will be removed, the offset
+		// can only be applied after the removal
+		params.Pagination = &filters.Pagination{
+			Limit: pagination.Offset + pagination.Limit + 1,
+		}
+	}
+
 	res, err := e.search.VectorClassSearch(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("explorer: get class: vector search: %v", err)
 	}
 
+	if exclude != "" {
+		res = page(withoutObject(res, exclude), pagination.Offset, pagination.Limit)
+	}
+
 	if params.Group != nil {
 		grouped, err := grouper.New(e.logger).Group(res, params.Group.Strategy, params.Group.Force)
 		if err != nil {
@@ -95,7 +188,7 @@ func (e *Explorer) getClassExploration(ctx context.Context,
 		res = grouped
 	}
 
-	return e.searchResultsToGetResponse(ctx, res, params.Explore.Certainty, searchVector)
+	return e.searchResultsToGetResponse(ctx, res, requiredCertainty, searchVector)
 }
 
 func (e *Explorer) getClassList(ctx context.Context,
@@ -147,16 +240,38 @@ func (e *Explorer) Concepts(ctx context.Context,
 		return nil, fmt.Errorf("explorer: network exploration currently not supported")
 	}
 
-	vector, err := e.vectorFromExploreParams(ctx, &params)
+	if err := params.validate(); err != nil {
+		return nil, fmt.Errorf("explorer: %v", err)
+	}
+
+	requiredCertainty := params.certainty()
+
+	// the exploration spans all classes, so the concepts are vectorized with
+	// the default vectorizer
+	vector, err := e.vectorFromExploreParams(ctx, "", &params)
 	if err != nil {
 		return nil, fmt.Errorf("vectorize params: %v", err)
 	}
 
-	res, err := e.search.VectorSearch(ctx, vector, params.Limit, nil)
+	// the vector search has no notion of an offset, so request enough results
+	// to skip the offset locally
+	exclude := nearObjectID(params.NearObject)
+	limit := params.Offset + params.Limit
+	if exclude != "" {
+		// request one more, as the source object will be removed
+		limit++
+	}
+
+	res, err := e.search.VectorSearch(ctx, vector, limit, nil)
 	if err != nil {
 		return nil, fmt.Errorf("vector search: %v", err)
 	}
 
+	if exclude != "" {
+		res = withoutObject(res, exclude)
+	}
+	res = page(res, params.Offset, params.Limit)
+
 	results := []search.Result{}
 	for _, item := range res {
 		item.Beacon = beacon(item)
@@ -165,7 +280,7 @@ func (e *Explorer) Concepts(ctx context.Context,
 			return nil, fmt.Errorf("res %s: %v", item.Beacon, err)
 		}
 		item.Certainty = 1 - dist
-		if item.Certainty >= float32(params.Certainty) {
+		if item.Certainty >= float32(requiredCertainty) {
 			results = append(results, item)
 		}
 	}
@@ -173,16 +288,31 @@ func (e *Explorer) Concepts(ctx context.Context,
 	return results, nil
 }
 
-func (e *Explorer) vectorFromExploreParams(ctx context.Context,
+// vectorFromExploreParams vectorizes the concepts with the vectorizer of the
+// class, an empty class name selects the default vectorizer
+func (e *Explorer) vectorFromExploreParams(ctx context.Context, className string,
 	params *ExploreParams) ([]float32, error) {
-
-	vector, err := e.vectorizer.Corpi(ctx, params.Values)
-	if err != nil {
-		return nil, fmt.Errorf("vectorize keywords: %v", err)
+	var vector []float32
+	switch {
+	case params.NearVector != nil:
+		// the user brought their own vector, it can still be moved below
+		vector = params.NearVector.Vector
+	case params.NearObject != nil:
+		v, err := e.vectorFromObject(ctx, params.NearObject.ID, params.NearObject.Beacon)
+		if err != nil {
+			return nil, fmt.Errorf("near object: %v", err)
+		}
+		vector = v
+	default:
+		v, err := e.vectorizer.Corpi(ctx, className, params.Values)
+		if err != nil {
+			return nil, fmt.Errorf("vectorize keywords: %v", err)
+		}
+		vector = v
 	}
 
-	if params.MoveTo.Force > 0 && len(params.MoveTo.Values) > 0 {
-		moveToVector, err := e.vectorizer.Corpi(ctx, params.MoveTo.Values)
+	if params.MoveTo.Force > 0 && params.MoveTo.hasTargets() {
+		moveToVector, err := e.vectorFromMovement(ctx, className, params.MoveTo)
 		if err != nil {
 			return nil, fmt.Errorf("vectorize move to: %v", err)
 		}
@@ -194,8 +324,8 @@ func (e *Explorer) vectorFromExploreParams(ctx context.Context,
 		vector = afterMoveTo
 	}
 
-	if params.MoveAwayFrom.Force > 0 && len(params.MoveAwayFrom.Values) > 0 {
-		moveAwayVector, err := e.vectorizer.Corpi(ctx, params.MoveAwayFrom.Values)
+	if params.MoveAwayFrom.Force > 0 && params.MoveAwayFrom.hasTargets() {
+		moveAwayVector, err := e.vectorFromMovement(ctx, className, params.MoveAwayFrom)
 		if err != nil {
 			return nil, fmt.Errorf("vectorize move away from: %v", err)
 		}
@@ -0,0 +1,172 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package traverser
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/traverser/grouper"
+)
+
+// scoreField is the key under which the relevance score of a bm25 or hybrid
+// search is added to the properties of each result
+const scoreField = "_score"
+
+func (e *Explorer) getClassKeywordRanking(ctx context.Context,
+	params GetParams) ([]interface{}, error) {
+	if params.KeywordRanking.Query == "" {
+		return nil, fmt.Errorf("explorer: get class: bm25: query cannot be empty")
+	}
+
+	res, err := e.search.KeywordClassSearch(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("explorer: get class: keyword search: %v", err)
+	}
+
+	return e.rankedResultsToGetResponse(res, params.Group)
+}
+
+// getClassHybrid runs a vector and a keyword search for the same query and
+// fuses both rankings. As the results of one search are not necessarily part
+// of the other one, both searches request the full window up to offset+limit
+// and the page is only cut out after the fusion.
+func (e *Explorer) getClassHybrid(ctx context.Context,
+	params GetParams) ([]interface{}, error) {
+	hybrid := params.Hybrid
+	if hybrid.Query == "" {
+		return nil, fmt.Errorf("explorer: get class: hybrid: query cannot be empty")
+	}
+
+	if err := hybrid.validate(); err != nil {
+		return nil, fmt.Errorf("explorer: get class: hybrid: %v", err)
+	}
+
+	vector, err := e.vectorizer.Corpi(ctx, params.ClassName, []string{hybrid.Query})
+	if err != nil {
+		return nil, fmt.Errorf("explorer: get class: hybrid: vectorize query: %v", err)
+	}
+
+	window := &filters.Pagination{
+		Limit: params.Pagination.Offset + params.Pagination.Limit,
+	}
+
+	vectorParams := params
+	vectorParams.Hybrid = nil
+	vectorParams.Pagination = window
+	vectorParams.SearchVector = vector
+	vectorRes, err := e.search.VectorClassSearch(ctx, vectorParams)
+	if err != nil {
+		return nil, fmt.Errorf("explorer: get class: hybrid: vector search: %v", err)
+	}
+
+	keywordParams := params
+	keywordParams.Hybrid = nil
+	keywordParams.Pagination = window
+	keywordParams.KeywordRanking = &KeywordRankingParams{
+		Query:      hybrid.Query,
+		Properties: hybrid.Properties,
+	}
+	keywordRes, err := e.search.KeywordClassSearch(ctx, keywordParams)
+	if err != nil {
+		return nil, fmt.Errorf("explorer: get class: hybrid: keyword search: %v", err)
+	}
+
+	fused, err := e.fuseHybrid(vectorRes, keywordRes, vector, hybrid.Alpha)
+	if err != nil {
+		return nil, fmt.Errorf("explorer: get class: hybrid: %v", err)
+	}
+
+	return e.rankedResultsToGetResponse(page(fused, params.Pagination.Offset,
+		params.Pagination.Limit), params.Group)
+}
+
+// fuseHybrid combines the vector certainty with the BM25 score. BM25 scores
+// are unbounded, so they are normalized by the highest score to be in the
+// same range as the certainty. A result missing from one of the searches
+// scores 0 for that part.
+func (e *Explorer) fuseHybrid(vectorRes, keywordRes []search.Result,
+	vector []float32, alpha float64) ([]search.Result, error) {
+	var maxKeywordScore float32
+	for _, res := range keywordRes {
+		if res.Score > maxKeywordScore {
+			maxKeywordScore = res.Score
+		}
+	}
+
+	var out []search.Result
+	positions := map[string]int{}
+	for _, res := range vectorRes {
+		dist, err := e.distancer(res.Vector, vector)
+		if err != nil {
+			return nil, fmt.Errorf("calculate distance: %v", err)
+		}
+
+		res.Score = float32(alpha) * (1 - dist)
+		positions[res.ID.String()] = len(out)
+		out = append(out, res)
+	}
+
+	for _, res := range keywordRes {
+		var normalized float32
+		if maxKeywordScore > 0 {
+			normalized = res.Score / maxKeywordScore
+		}
+		score := float32(1-alpha) * normalized
+
+		if pos, ok := positions[res.ID.String()]; ok {
+			out[pos].Score += score
+			continue
+		}
+
+		res.Score = score
+		out = append(out, res)
+	}
+
+	sort.SliceStable(out, func(a, b int) bool {
+		return out[a].Score > out[b].Score
+	})
+
+	return out, nil
+}
+
+// rankedResultsToGetResponse adds the relevance score to the properties of
+// each result, the results are already in order of their relevance
+func (e *Explorer) rankedResultsToGetResponse(input []search.Result,
+	group *GroupParams) ([]interface{}, error) {
+	if group != nil {
+		grouped, err := grouper.New(e.logger).Group(input, group.Strategy, group.Force)
+		if err != nil {
+			return nil, fmt.Errorf("grouper: %v", err)
+		}
+
+		input = grouped
+	}
+
+	output := make([]interface{}, 0, len(input))
+	for _, res := range input {
+		props, ok := res.Schema.(map[string]interface{})
+		if !ok {
+			props = map[string]interface{}{}
+		}
+
+		props[scoreField] = float64(res.Score)
+		output = append(output, props)
+	}
+
+	return output, nil
+}
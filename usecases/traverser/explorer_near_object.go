@@ -0,0 +1,202 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package traverser
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/schema/crossref"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/entities/search"
+)
+
+// vectorFromObject loads the stored vector of an existing object which is
+// referenced by either its id or its beacon. As an id alone does not contain
+// the kind, both kinds are tried.
+func (e *Explorer) vectorFromObject(ctx context.Context,
+	id, beacon string) ([]float32, error) {
+	res, err := e.FindObject(ctx, id, beacon)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res.Vector) == 0 {
+		return nil, fmt.Errorf("%s object with id '%s' has no vector",
+			res.Kind.Name(), res.ID)
+	}
+
+	return res.Vector, nil
+}
+
+// FindObject referenced by either its id or its beacon
+func (e *Explorer) FindObject(ctx context.Context,
+	id, beacon string) (*search.Result, error) {
+	if id != "" && beacon != "" {
+		return nil, fmt.Errorf("id and beacon cannot be combined")
+	}
+
+	if beacon != "" {
+		ref, err := crossref.Parse(beacon)
+		if err != nil {
+			return nil, fmt.Errorf("invalid beacon: %v", err)
+		}
+
+		if !ref.Local {
+			return nil, fmt.Errorf("only local beacons are supported, but got '%s'", beacon)
+		}
+
+		return e.objectByKindAndID(ctx, ref.Kind, ref.TargetID)
+	}
+
+	if id == "" {
+		return nil, fmt.Errorf("either id or beacon must be set")
+	}
+
+	res, err := e.search.ThingByID(ctx, strfmt.UUID(id), nil, false)
+	if err != nil {
+		return nil, fmt.Errorf("find thing: %v", err)
+	}
+
+	if res != nil {
+		return res, nil
+	}
+
+	return e.objectByKindAndID(ctx, kind.Action, strfmt.UUID(id))
+}
+
+func (e *Explorer) objectByKindAndID(ctx context.Context, k kind.Kind,
+	id strfmt.UUID) (*search.Result, error) {
+	var res *search.Result
+	var err error
+
+	switch k {
+	case kind.Thing:
+		res, err = e.search.ThingByID(ctx, id, nil, false)
+	case kind.Action:
+		res, err = e.search.ActionByID(ctx, id, nil, false)
+	default:
+		return nil, fmt.Errorf("impossible kind: %v", k)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("find %s: %v", k.Name(), err)
+	}
+
+	if res == nil {
+		return nil, fmt.Errorf("no object with id '%s'", id)
+	}
+
+	return res, nil
+}
+
+// vectorFromMovement combines the vectors of the concepts and all referenced
+// objects of a movement into a single target vector
+func (e *Explorer) vectorFromMovement(ctx context.Context, className string,
+	move ExploreMove) ([]float32, error) {
+	var vectors [][]float32
+
+	if len(move.Values) > 0 {
+		vector, err := e.vectorizer.Corpi(ctx, className, move.Values)
+		if err != nil {
+			return nil, fmt.Errorf("vectorize concepts: %v", err)
+		}
+
+		vectors = append(vectors, vector)
+	}
+
+	for i, obj := range move.Objects {
+		vector, err := e.vectorFromObject(ctx, obj.ID, obj.Beacon)
+		if err != nil {
+			return nil, fmt.Errorf("object at position %d: %v", i, err)
+		}
+
+		vectors = append(vectors, vector)
+	}
+
+	return meanVector(vectors)
+}
+
+func (m ExploreMove) hasTargets() bool {
+	return len(m.Values) > 0 || len(m.Objects) > 0
+}
+
+func meanVector(vectors [][]float32) ([]float32, error) {
+	if len(vectors) == 1 {
+		return vectors[0], nil
+	}
+
+	mean := make([]float32, len(vectors[0]))
+	for _, vector := range vectors {
+		if len(vector) != len(mean) {
+			return nil, fmt.Errorf("vectors have different lengths: %d and %d",
+				len(mean), len(vector))
+		}
+
+		for i := range vector {
+			mean[i] += vector[i] / float32(len(vectors))
+		}
+	}
+
+	return mean, nil
+}
+
+// nearObjectID is the id of the source object of a nearObject search, it is
+// empty if the params aren't set or the beacon is invalid. In the latter case
+// the search itself fails.
+func nearObjectID(params *NearObjectParams) strfmt.UUID {
+	if params == nil {
+		return ""
+	}
+
+	if params.ID != "" {
+		return strfmt.UUID(params.ID)
+	}
+
+	ref, err := crossref.Parse(params.Beacon)
+	if err != nil {
+		return ""
+	}
+
+	return ref.TargetID
+}
+
+// withoutObject removes the object with the specified id from the results
+func withoutObject(in []search.Result, id strfmt.UUID) []search.Result {
+	out := make([]search.Result, 0, len(in))
+	for _, res := range in {
+		if res.ID == id {
+			continue
+		}
+
+		out = append(out, res)
+	}
+
+	return out
+}
+
+// page skips the first offset results and limits the remaining ones. It is
+// used where the underlying search cannot apply the offset itself.
+func page(in []search.Result, offset, limit int) []search.Result {
+	if offset >= len(in) {
+		return []search.Result{}
+	}
+
+	out := in[offset:]
+	if limit > 0 && len(out) > limit {
+		out = out[:limit]
+	}
+
+	return out
+}
@@ -17,7 +17,9 @@ import (
 	"context"
 	"testing"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/schema/kind"
 	"github.com/semi-technologies/weaviate/entities/search"
 	"github.com/sirupsen/logrus/hooks/test"
@@ -71,6 +73,10 @@ func Test_Explorer_GetClass(t *testing.T) {
 			search.AssertExpectations(t)
 		})
 
+		t.Run("concepts must be vectorized by the vectorizer of the class", func(t *testing.T) {
+			assert.Equal(t, []string{"BestClass"}, vectorizer.calledWithClassNames)
+		})
+
 		t.Run("response must contain concepts", func(t *testing.T) {
 			require.Len(t, res, 2)
 			assert.Equal(t,
@@ -130,6 +136,470 @@ func Test_Explorer_GetClass(t *testing.T) {
 		})
 	})
 
+	t.Run("when a nearVector param is set", func(t *testing.T) {
+		params := GetParams{
+			Kind:      kind.Thing,
+			ClassName: "BestClass",
+			NearVector: &NearVectorParams{
+				Vector: []float32{0.8, 0.2, 0.7},
+			},
+			Pagination: &filters.Pagination{Limit: 100},
+			Filters:    nil,
+		}
+
+		searchResults := []search.Result{
+			{
+				Kind: kind.Thing,
+				ID:   "id1",
+				Schema: map[string]interface{}{
+					"name": "Foo",
+				},
+			},
+		}
+
+		search := &fakeVectorSearcher{}
+		vectorizer := &fakeVectorizer{}
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(search, vectorizer, newFakeDistancer(), log)
+		expectedParamsToSearch := params
+		expectedParamsToSearch.SearchVector = []float32{0.8, 0.2, 0.7}
+		search.
+			On("VectorClassSearch", expectedParamsToSearch).
+			Return(searchResults, nil)
+
+		res, err := explorer.GetClass(context.Background(), params)
+
+		t.Run("vector search must be called with the user's vector", func(t *testing.T) {
+			assert.Nil(t, err)
+			search.AssertExpectations(t)
+		})
+
+		t.Run("response must contain concepts", func(t *testing.T) {
+			require.Len(t, res, 1)
+			assert.Equal(t,
+				map[string]interface{}{
+					"name": "Foo",
+				}, res[0])
+		})
+	})
+
+	t.Run("when both an explore and a nearVector param are set", func(t *testing.T) {
+		params := GetParams{
+			Kind:      kind.Thing,
+			ClassName: "BestClass",
+			Explore: &ExploreParams{
+				Values: []string{"foo"},
+			},
+			NearVector: &NearVectorParams{
+				Vector: []float32{0.8, 0.2, 0.7},
+			},
+		}
+
+		search := &fakeVectorSearcher{}
+		vectorizer := &fakeVectorizer{}
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(search, vectorizer, newFakeDistancer(), log)
+
+		_, err := explorer.GetClass(context.Background(), params)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("when a nearObject param is set with an id", func(t *testing.T) {
+		params := GetParams{
+			Kind:      kind.Thing,
+			ClassName: "BestClass",
+			NearObject: &NearObjectParams{
+				ID: "e9c12c22-766f-4bde-b140-d4cf8fd6e041",
+			},
+			Pagination: &filters.Pagination{Limit: 1},
+		}
+
+		searchResults := []search.Result{
+			{
+				Kind: kind.Thing,
+				ID:   "e9c12c22-766f-4bde-b140-d4cf8fd6e041",
+				Schema: map[string]interface{}{
+					"name": "Source",
+				},
+			},
+			{
+				Kind: kind.Thing,
+				ID:   "id1",
+				Schema: map[string]interface{}{
+					"name": "Foo",
+				},
+			},
+		}
+
+		searcher := &fakeVectorSearcher{}
+		vectorizer := &fakeVectorizer{}
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(searcher, vectorizer, newFakeDistancer(), log)
+		searcher.
+			On("ThingByID", strfmt.UUID("e9c12c22-766f-4bde-b140-d4cf8fd6e041")).
+			Return(&search.Result{Kind: kind.Thing, Vector: []float32{0.5, 0.5, 0.5}}, nil)
+		expectedParamsToSearch := params
+		expectedParamsToSearch.SearchVector = []float32{0.5, 0.5, 0.5}
+		expectedParamsToSearch.Pagination = &filters.Pagination{Limit: 2}
+		searcher.
+			On("VectorClassSearch", expectedParamsToSearch).
+			Return(searchResults, nil)
+
+		res, err := explorer.GetClass(context.Background(), params)
+
+		t.Run("vector search must be called with the object's vector", func(t *testing.T) {
+			assert.Nil(t, err)
+			searcher.AssertExpectations(t)
+		})
+
+		t.Run("response must not contain the source object", func(t *testing.T) {
+			require.Len(t, res, 1)
+			assert.Equal(t,
+				map[string]interface{}{
+					"name": "Foo",
+				}, res[0])
+		})
+	})
+
+	t.Run("when a nearObject param is set with a beacon", func(t *testing.T) {
+		params := GetParams{
+			Kind:      kind.Thing,
+			ClassName: "BestClass",
+			NearObject: &NearObjectParams{
+				Beacon: "weaviate://localhost/actions/e9c12c22-766f-4bde-b140-d4cf8fd6e041",
+			},
+			Pagination: &filters.Pagination{Limit: 100},
+		}
+
+		searcher := &fakeVectorSearcher{}
+		vectorizer := &fakeVectorizer{}
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(searcher, vectorizer, newFakeDistancer(), log)
+		searcher.
+			On("ActionByID", strfmt.UUID("e9c12c22-766f-4bde-b140-d4cf8fd6e041")).
+			Return(&search.Result{Kind: kind.Action, Vector: []float32{0.5, 0.5, 0.5}}, nil)
+		expectedParamsToSearch := params
+		expectedParamsToSearch.SearchVector = []float32{0.5, 0.5, 0.5}
+		expectedParamsToSearch.Pagination = &filters.Pagination{Limit: 101}
+		searcher.
+			On("VectorClassSearch", expectedParamsToSearch).
+			Return([]search.Result{}, nil)
+
+		_, err := explorer.GetClass(context.Background(), params)
+		assert.Nil(t, err)
+		searcher.AssertExpectations(t)
+	})
+
+	t.Run("when the nearObject does not exist", func(t *testing.T) {
+		params := GetParams{
+			Kind:      kind.Thing,
+			ClassName: "BestClass",
+			NearObject: &NearObjectParams{
+				ID: "e9c12c22-766f-4bde-b140-d4cf8fd6e041",
+			},
+		}
+
+		searcher := &fakeVectorSearcher{}
+		vectorizer := &fakeVectorizer{}
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(searcher, vectorizer, newFakeDistancer(), log)
+		searcher.
+			On("ThingByID", strfmt.UUID("e9c12c22-766f-4bde-b140-d4cf8fd6e041")).
+			Return((*search.Result)(nil), nil)
+		searcher.
+			On("ActionByID", strfmt.UUID("e9c12c22-766f-4bde-b140-d4cf8fd6e041")).
+			Return((*search.Result)(nil), nil)
+
+		_, err := explorer.GetClass(context.Background(), params)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("when a nearObject param is set with an offset", func(t *testing.T) {
+		params := GetParams{
+			Kind:      kind.Thing,
+			ClassName: "BestClass",
+			NearObject: &NearObjectParams{
+				ID: "e9c12c22-766f-4bde-b140-d4cf8fd6e041",
+			},
+			Pagination: &filters.Pagination{Offset: 1, Limit: 1},
+		}
+
+		searchResults := []search.Result{
+			{
+				Kind:   kind.Thing,
+				ID:     "e9c12c22-766f-4bde-b140-d4cf8fd6e041",
+				Schema: map[string]interface{}{"name": "Source"},
+			},
+			{
+				Kind:   kind.Thing,
+				ID:     "id1",
+				Schema: map[string]interface{}{"name": "Foo"},
+			},
+			{
+				Kind:   kind.Thing,
+				ID:     "id2",
+				Schema: map[string]interface{}{"name": "Bar"},
+			},
+		}
+
+		searcher := &fakeVectorSearcher{}
+		vectorizer := &fakeVectorizer{}
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(searcher, vectorizer, newFakeDistancer(), log)
+		searcher.
+			On("ThingByID", strfmt.UUID("e9c12c22-766f-4bde-b140-d4cf8fd6e041")).
+			Return(&search.Result{Kind: kind.Thing, Vector: []float32{0.5, 0.5, 0.5}}, nil)
+		expectedParamsToSearch := params
+		expectedParamsToSearch.SearchVector = []float32{0.5, 0.5, 0.5}
+		expectedParamsToSearch.Pagination = &filters.Pagination{Limit: 3}
+		searcher.
+			On("VectorClassSearch", expectedParamsToSearch).
+			Return(searchResults, nil)
+
+		res, err := explorer.GetClass(context.Background(), params)
+
+		t.Run("the offset is applied after removing the source object", func(t *testing.T) {
+			require.Nil(t, err)
+			searcher.AssertExpectations(t)
+			require.Len(t, res, 1)
+			assert.Equal(t, map[string]interface{}{"name": "Bar"}, res[0])
+		})
+	})
+
+	t.Run("when a cursor is combined with a vector search", func(t *testing.T) {
+		params := GetParams{
+			Kind:      kind.Thing,
+			ClassName: "BestClass",
+			NearVector: &NearVectorParams{
+				Vector: []float32{0.8, 0.2, 0.7},
+			},
+			Pagination: &filters.Pagination{
+				Limit: 10,
+				After: "e9c12c22-766f-4bde-b140-d4cf8fd6e041",
+			},
+		}
+
+		searcher := &fakeVectorSearcher{}
+		vectorizer := &fakeVectorizer{}
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(searcher, vectorizer, newFakeDistancer(), log)
+
+		_, err := explorer.GetClass(context.Background(), params)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("when a cursor is used without a vector search", func(t *testing.T) {
+		params := GetParams{
+			Kind:      kind.Thing,
+			ClassName: "BestClass",
+			Pagination: &filters.Pagination{
+				Limit: 10,
+				After: "e9c12c22-766f-4bde-b140-d4cf8fd6e041",
+			},
+		}
+
+		searcher := &fakeVectorSearcher{}
+		vectorizer := &fakeVectorizer{}
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(searcher, vectorizer, newFakeDistancer(), log)
+		searcher.
+			On("ClassSearch", params).
+			Return([]search.Result{}, nil)
+
+		_, err := explorer.GetClass(context.Background(), params)
+		assert.Nil(t, err)
+		searcher.AssertExpectations(t)
+	})
+
+	t.Run("when sort is combined with a vector search", func(t *testing.T) {
+		params := GetParams{
+			Kind:      kind.Thing,
+			ClassName: "BestClass",
+			NearVector: &NearVectorParams{
+				Vector: []float32{0.8, 0.2, 0.7},
+			},
+			Sort: []filters.Sort{{Path: []string{"name"}, Order: filters.SortOrderAsc}},
+		}
+
+		searcher := &fakeVectorSearcher{}
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(searcher, &fakeVectorizer{}, newFakeDistancer(), log)
+
+		_, err := explorer.GetClass(context.Background(), params)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("when sort is combined with a cursor", func(t *testing.T) {
+		params := GetParams{
+			Kind:      kind.Thing,
+			ClassName: "BestClass",
+			Pagination: &filters.Pagination{
+				Limit: 10,
+				After: "e9c12c22-766f-4bde-b140-d4cf8fd6e041",
+			},
+			Sort: []filters.Sort{{Path: []string{"name"}, Order: filters.SortOrderAsc}},
+		}
+
+		searcher := &fakeVectorSearcher{}
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(searcher, &fakeVectorizer{}, newFakeDistancer(), log)
+
+		_, err := explorer.GetClass(context.Background(), params)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("when sort is combined with where and an offset", func(t *testing.T) {
+		params := GetParams{
+			Kind:      kind.Thing,
+			ClassName: "BestClass",
+			Filters: &filters.LocalFilter{Root: &filters.Clause{
+				Operator: filters.OperatorEqual,
+				On:       &filters.Path{Class: "BestClass", Property: "name"},
+				Value:    &filters.Value{Value: "Foo", Type: schema.DataTypeString},
+			}},
+			Pagination: &filters.Pagination{Limit: 10, Offset: 10},
+			Sort:       []filters.Sort{{Path: []string{"name"}, Order: filters.SortOrderDesc}},
+		}
+
+		searcher := &fakeVectorSearcher{}
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(searcher, &fakeVectorizer{}, newFakeDistancer(), log)
+		searcher.
+			On("ClassSearch", params).
+			Return([]search.Result{}, nil)
+
+		_, err := explorer.GetClass(context.Background(), params)
+		assert.Nil(t, err)
+		searcher.AssertExpectations(t)
+	})
+
+	t.Run("when a bm25 param is set", func(t *testing.T) {
+		params := GetParams{
+			Kind:      kind.Thing,
+			ClassName: "BestClass",
+			KeywordRanking: &KeywordRankingParams{
+				Query:      "heavy hammer",
+				Properties: []string{"name"},
+			},
+			Pagination: &filters.Pagination{Limit: 100},
+		}
+
+		searchResults := []search.Result{
+			{Kind: kind.Thing, ID: "id1", Score: 2.5, Schema: map[string]interface{}{"name": "Foo"}},
+			{Kind: kind.Thing, ID: "id2", Score: 0.7, Schema: map[string]interface{}{"name": "Bar"}},
+		}
+
+		searcher := &fakeVectorSearcher{}
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(searcher, &fakeVectorizer{}, newFakeDistancer(), log)
+		searcher.
+			On("KeywordClassSearch", params).
+			Return(searchResults, nil)
+
+		res, err := explorer.GetClass(context.Background(), params)
+
+		t.Run("keyword search must be called with right params", func(t *testing.T) {
+			assert.Nil(t, err)
+			searcher.AssertExpectations(t)
+		})
+
+		t.Run("response must contain the scores", func(t *testing.T) {
+			assert.Equal(t, []interface{}{
+				map[string]interface{}{"name": "Foo", "_score": float64(float32(2.5))},
+				map[string]interface{}{"name": "Bar", "_score": float64(float32(0.7))},
+			}, res)
+		})
+	})
+
+	t.Run("when a hybrid param is set", func(t *testing.T) {
+		params := GetParams{
+			Kind:      kind.Thing,
+			ClassName: "BestClass",
+			Hybrid: &HybridParams{
+				Query:      "heavy hammer",
+				Alpha:      0.5,
+				Properties: []string{"name"},
+			},
+			Pagination: &filters.Pagination{Limit: 2},
+		}
+
+		searcher := &fakeVectorSearcher{}
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(searcher, &fakeVectorizer{}, newFakeDistancer(), log)
+
+		expectedVectorParams := params
+		expectedVectorParams.Hybrid = nil
+		expectedVectorParams.SearchVector = []float32{1, 2, 3}
+		expectedVectorParams.Pagination = &filters.Pagination{Limit: 2}
+		searcher.
+			On("VectorClassSearch", expectedVectorParams).
+			Return([]search.Result{
+				{Kind: kind.Thing, ID: "id1", Schema: map[string]interface{}{"name": "A"}},
+				{Kind: kind.Thing, ID: "id2", Schema: map[string]interface{}{"name": "B"}},
+			}, nil)
+
+		expectedKeywordParams := params
+		expectedKeywordParams.Hybrid = nil
+		expectedKeywordParams.KeywordRanking = &KeywordRankingParams{
+			Query:      "heavy hammer",
+			Properties: []string{"name"},
+		}
+		expectedKeywordParams.Pagination = &filters.Pagination{Limit: 2}
+		searcher.
+			On("KeywordClassSearch", expectedKeywordParams).
+			Return([]search.Result{
+				{Kind: kind.Thing, ID: "id2", Score: 4, Schema: map[string]interface{}{"name": "B"}},
+				{Kind: kind.Thing, ID: "id3", Score: 2, Schema: map[string]interface{}{"name": "C"}},
+			}, nil)
+
+		res, err := explorer.GetClass(context.Background(), params)
+
+		t.Run("both searches must be called", func(t *testing.T) {
+			assert.Nil(t, err)
+			searcher.AssertExpectations(t)
+		})
+
+		t.Run("results must be ranked by the fused score", func(t *testing.T) {
+			// the fake distancer always returns 0.5, so every vector result has a
+			// certainty of 0.5
+			assert.Equal(t, []interface{}{
+				map[string]interface{}{"name": "B", "_score": float64(0.75)},
+				map[string]interface{}{"name": "A", "_score": float64(0.25)},
+			}, res)
+		})
+	})
+
+	t.Run("when a hybrid param has an invalid alpha", func(t *testing.T) {
+		params := GetParams{
+			Kind:      kind.Thing,
+			ClassName: "BestClass",
+			Hybrid:    &HybridParams{Query: "heavy hammer", Alpha: 1.5},
+		}
+
+		searcher := &fakeVectorSearcher{}
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(searcher, &fakeVectorizer{}, newFakeDistancer(), log)
+
+		_, err := explorer.GetClass(context.Background(), params)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("when bm25 is combined with a vector search", func(t *testing.T) {
+		params := GetParams{
+			Kind:           kind.Thing,
+			ClassName:      "BestClass",
+			KeywordRanking: &KeywordRankingParams{Query: "heavy hammer"},
+			NearVector:     &NearVectorParams{Vector: []float32{0.8, 0.2, 0.7}},
+		}
+
+		searcher := &fakeVectorSearcher{}
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(searcher, &fakeVectorizer{}, newFakeDistancer(), log)
+
+		_, err := explorer.GetClass(context.Background(), params)
+		assert.NotNil(t, err)
+	})
+
 	t.Run("when no explore param is set", func(t *testing.T) {
 		params := GetParams{
 			Kind:       kind.Thing,
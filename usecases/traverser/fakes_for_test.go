@@ -15,6 +15,7 @@ package traverser
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/semi-technologies/weaviate/entities/aggregation"
@@ -80,7 +81,9 @@ func (f *fakeLocks) LockSchema() (func() error, error) {
 	return func() error { return nil }, nil
 }
 
-type fakeVectorizer struct{}
+type fakeVectorizer struct {
+	calledWithClassNames []string
+}
 
 func (f *fakeVectorizer) Thing(ctx context.Context, thing *models.Thing) ([]float32, error) {
 	panic("not implemented")
@@ -90,7 +93,8 @@ func (f *fakeVectorizer) Action(ctx context.Context, thing *models.Action) ([]fl
 	panic("not implemented")
 }
 
-func (f *fakeVectorizer) Corpi(ctx context.Context, corpi []string) ([]float32, error) {
+func (f *fakeVectorizer) Corpi(ctx context.Context, className string, corpi []string) ([]float32, error) {
+	f.calledWithClassNames = append(f.calledWithClassNames, className)
 	return []float32{1, 2, 3}, nil
 }
 
@@ -140,12 +144,30 @@ func (f *fakeVectorSearcher) VectorClassSearch(ctx context.Context,
 	return args.Get(0).([]search.Result), args.Error(1)
 }
 
+func (f *fakeVectorSearcher) KeywordClassSearch(ctx context.Context,
+	params GetParams) ([]search.Result, error) {
+	args := f.Called(params)
+	return args.Get(0).([]search.Result), args.Error(1)
+}
+
 func (f *fakeVectorSearcher) ClassSearch(ctx context.Context,
 	params GetParams) ([]search.Result, error) {
 	args := f.Called(params)
 	return args.Get(0).([]search.Result), args.Error(1)
 }
 
+func (f *fakeVectorSearcher) ThingByID(ctx context.Context, id strfmt.UUID,
+	props SelectProperties, meta bool) (*search.Result, error) {
+	args := f.Called(id)
+	return args.Get(0).(*search.Result), args.Error(1)
+}
+
+func (f *fakeVectorSearcher) ActionByID(ctx context.Context, id strfmt.UUID,
+	props SelectProperties, meta bool) (*search.Result, error) {
+	args := f.Called(id)
+	return args.Get(0).(*search.Result), args.Error(1)
+}
+
 type fakeNetwork struct {
 	peerURI string
 }
@@ -225,14 +247,30 @@ func (f *fakeVectorRepo) GetAction(ctx context.Context, uuid strfmt.UUID,
 	return args.Error(1)
 }
 
-type fakeExplorer struct{}
+type fakeExplorer struct {
+	calledWithGetParams GetParams
+	conceptsResults     []search.Result
+	conceptsCalled      bool
+	objects             map[string]*search.Result
+}
 
 func (f *fakeExplorer) GetClass(ctx context.Context, p GetParams) ([]interface{}, error) {
+	f.calledWithGetParams = p
 	return nil, nil
 }
 
 func (f *fakeExplorer) Concepts(ctx context.Context, p ExploreParams) ([]search.Result, error) {
-	return nil, nil
+	f.conceptsCalled = true
+	return f.conceptsResults, nil
+}
+
+func (f *fakeExplorer) FindObject(ctx context.Context, id, beacon string) (*search.Result, error) {
+	res, ok := f.objects[id]
+	if !ok {
+		return nil, fmt.Errorf("no object with id '%s'", id)
+	}
+
+	return res, nil
 }
 
 type fakeSchemaGetter struct {
@@ -15,6 +15,7 @@ package traverser
 
 import (
 	"context"
+	"time"
 
 	"github.com/semi-technologies/weaviate/entities/aggregation"
 	"github.com/semi-technologies/weaviate/entities/filters"
@@ -22,6 +23,7 @@ import (
 	"github.com/semi-technologies/weaviate/entities/schema/kind"
 	"github.com/semi-technologies/weaviate/entities/search"
 	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/semi-technologies/weaviate/usecases/monitoring"
 	"github.com/semi-technologies/weaviate/usecases/schema"
 	"github.com/sirupsen/logrus"
 )
@@ -48,7 +50,7 @@ type Traverser struct {
 }
 
 type CorpiVectorizer interface {
-	Corpi(ctx context.Context, corpi []string) ([]float32, error)
+	Corpi(ctx context.Context, className string, corpi []string) ([]float32, error)
 	MoveTo(source []float32, target []float32, weight float32) ([]float32, error)
 	MoveAwayFrom(source []float32, target []float32, weight float32) ([]float32, error)
 }
@@ -62,6 +64,7 @@ type VectorSearcher interface {
 type explorer interface {
 	GetClass(ctx context.Context, params GetParams) ([]interface{}, error)
 	Concepts(ctx context.Context, params ExploreParams) ([]search.Result, error)
+	FindObject(ctx context.Context, id, beacon string) (*search.Result, error)
 }
 
 // NewTraverser to traverse the knowledge graph
@@ -112,3 +115,8 @@ type SearchResults struct {
 func (r SearchResults) Len() int {
 	return len(r.Results)
 }
+
+func observeQuery(className, operation string, start time.Time) {
+	monitoring.GetMetrics().QueryDuration.WithLabelValues(className, operation).
+		Observe(time.Since(start).Seconds())
+}
@@ -18,22 +18,36 @@ import (
 	"crypto/md5"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization"
 )
 
 // Aggregate resolves meta queries
 func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 	params *AggregateParams) (interface{}, error) {
+	defer observeQuery(params.ClassName.String(), "aggregate", time.Now())
 
-	err := t.authorizer.Authorize(principal, "get", "traversal/*")
+	root := authorization.TraversalResource(params.Kind, params.ClassName.String())
+	err := t.authorizer.Authorize(principal, "get", root)
 	if err != nil {
 		return nil, err
 	}
 
+	// aggregations over the classes reached through the filter or the
+	// grouping would reveal the values of those classes as well
+	paths := filterPaths(params.Filters)
+	if params.GroupBy != nil {
+		paths = append(paths, params.GroupBy)
+	}
+	if err := t.authorizePaths(principal, root, paths); err != nil {
+		return nil, err
+	}
+
 	unlock, err := t.locks.LockConnector()
 	if err != nil {
 		return nil, fmt.Errorf("could not acquire lock: %v", err)
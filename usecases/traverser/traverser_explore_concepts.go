@@ -15,6 +15,8 @@ package traverser
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/search"
@@ -23,31 +25,97 @@ import (
 // Explore through unstructured search terms
 func (t *Traverser) Explore(ctx context.Context,
 	principal *models.Principal, params ExploreParams) ([]search.Result, error) {
+	// explore spans all classes
+	defer observeQuery("*", "explore", time.Now())
 
 	if params.Limit == 0 {
 		params.Limit = 20
 	}
 
-	err := t.authorizer.Authorize(principal, "get", "traversal/*")
+	err := t.authorizeExplore(principal)
 	if err != nil {
 		return nil, err
 	}
 
-	return t.explorer.Concepts(ctx, params)
+	err = t.authorizeSourceObjects(ctx, principal, params.NearObject, &params)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := t.explorer.Concepts(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// explore spans all classes, so instead of rejecting the whole request,
+	// results from classes the principal may not read are dropped
+	return t.filterReadable(principal, res), nil
 }
 
 // ExploreParams to do a vector based explore search
 type ExploreParams struct {
 	Values       []string
+	NearVector   *NearVectorParams
+	NearObject   *NearObjectParams
 	Limit        int
+	Offset       int
 	MoveTo       ExploreMove
 	MoveAwayFrom ExploreMove
 	Certainty    float64
 	Network      bool
 }
 
-// ExploreMove moves an existing Search Vector closer (or further away from) a specific other search term
+func (p ExploreParams) validate() error {
+	count := 0
+	if len(p.Values) > 0 {
+		count++
+	}
+	if p.NearVector != nil {
+		count++
+	}
+	if p.NearObject != nil {
+		count++
+	}
+
+	if count == 0 {
+		return fmt.Errorf("one of concepts, nearVector or nearObject must be set")
+	}
+
+	if count > 1 {
+		return fmt.Errorf("only one of concepts, nearVector and nearObject can be set")
+	}
+
+	if p.Offset < 0 {
+		return fmt.Errorf("offset cannot be negative")
+	}
+
+	return nil
+}
+
+// certainty of the explore params, a certainty set on nearVector or
+// nearObject takes precedence
+func (p ExploreParams) certainty() float64 {
+	if p.NearVector != nil && p.NearVector.Certainty > 0 {
+		return p.NearVector.Certainty
+	}
+
+	if p.NearObject != nil && p.NearObject.Certainty > 0 {
+		return p.NearObject.Certainty
+	}
+
+	return p.Certainty
+}
+
+// ExploreMove moves an existing Search Vector closer (or further away from) a
+// specific other search term and/or the vectors of existing objects
 type ExploreMove struct {
-	Values []string
-	Force  float32
+	Values  []string
+	Force   float32
+	Objects []ObjectMove
+}
+
+// ObjectMove references an existing object by id or beacon
+type ObjectMove struct {
+	ID     string
+	Beacon string
 }
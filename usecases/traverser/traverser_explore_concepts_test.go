@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/semi-technologies/weaviate/entities/schema/kind"
 	"github.com/semi-technologies/weaviate/entities/search"
 	"github.com/semi-technologies/weaviate/usecases/config"
@@ -48,6 +49,130 @@ func Test_ExploreConcepts(t *testing.T) {
 		assert.Equal(t, fmt.Errorf(
 			"explorer: network exploration currently not supported"), err)
 	})
+	t.Run("with a nearVector instead of concepts", func(t *testing.T) {
+		authorizer := &fakeAuthorizer{}
+		locks := &fakeLocks{}
+		logger, _ := test.NewNullLogger()
+		vectorizer := &fakeVectorizer{}
+		vectorSearcher := &fakeVectorSearcher{}
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(vectorSearcher, vectorizer, newFakeDistancer(), log)
+		schemaGetter := &fakeSchemaGetter{}
+		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
+			vectorizer, vectorSearcher, explorer, schemaGetter)
+		params := ExploreParams{
+			NearVector: &NearVectorParams{
+				Vector:    []float32{7, 8, 9},
+				Certainty: 0.4,
+			},
+		}
+		vectorSearcher.results = []search.Result{
+			search.Result{
+				ClassName: "BestClass",
+				Kind:      kind.Thing,
+				ID:        "123-456-789",
+			},
+		}
+
+		res, err := traverser.Explore(context.Background(), nil, params)
+		require.Nil(t, err)
+		assert.Equal(t, []search.Result{
+			search.Result{
+				ClassName: "BestClass",
+				Kind:      kind.Thing,
+				ID:        "123-456-789",
+				Beacon:    "weaviate://localhost/things/123-456-789",
+				Certainty: 0.5,
+			},
+		}, res)
+
+		assert.Equal(t, []float32{7, 8, 9}, vectorSearcher.calledWithVector)
+	})
+
+	t.Run("with both concepts and a nearVector", func(t *testing.T) {
+		authorizer := &fakeAuthorizer{}
+		locks := &fakeLocks{}
+		logger, _ := test.NewNullLogger()
+		vectorizer := &fakeVectorizer{}
+		vectorSearcher := &fakeVectorSearcher{}
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(vectorSearcher, vectorizer, newFakeDistancer(), log)
+		schemaGetter := &fakeSchemaGetter{}
+		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
+			vectorizer, vectorSearcher, explorer, schemaGetter)
+		params := ExploreParams{
+			Values: []string{"a search term"},
+			NearVector: &NearVectorParams{
+				Vector: []float32{7, 8, 9},
+			},
+		}
+
+		_, err := traverser.Explore(context.Background(), nil, params)
+		assert.Equal(t, fmt.Errorf(
+			"explorer: only one of concepts, nearVector and nearObject can be set"), err)
+	})
+
+	t.Run("with a nearObject and a movement to another object", func(t *testing.T) {
+		authorizer := &fakeAuthorizer{}
+		locks := &fakeLocks{}
+		logger, _ := test.NewNullLogger()
+		vectorizer := &fakeVectorizer{}
+		vectorSearcher := &fakeVectorSearcher{}
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(vectorSearcher, vectorizer, newFakeDistancer(), log)
+		schemaGetter := &fakeSchemaGetter{}
+		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
+			vectorizer, vectorSearcher, explorer, schemaGetter)
+		params := ExploreParams{
+			NearObject: &NearObjectParams{
+				ID: "e9c12c22-766f-4bde-b140-d4cf8fd6e041",
+			},
+			MoveTo: ExploreMove{
+				Force: 0.5,
+				Objects: []ObjectMove{{
+					Beacon: "weaviate://localhost/actions/1e6b7d0a-4fd2-4d0b-9a0a-e9c6e4e1e69d",
+				}},
+			},
+			Limit: 1,
+		}
+		vectorSearcher.
+			On("ThingByID", strfmt.UUID("e9c12c22-766f-4bde-b140-d4cf8fd6e041")).
+			Return(&search.Result{Kind: kind.Thing, Vector: []float32{1, 1, 1}}, nil)
+		vectorSearcher.
+			On("ActionByID", strfmt.UUID("1e6b7d0a-4fd2-4d0b-9a0a-e9c6e4e1e69d")).
+			Return(&search.Result{Kind: kind.Action, Vector: []float32{3, 3, 3}}, nil)
+		vectorSearcher.results = []search.Result{
+			search.Result{
+				ClassName: "BestClass",
+				Kind:      kind.Thing,
+				ID:        "e9c12c22-766f-4bde-b140-d4cf8fd6e041",
+			},
+			search.Result{
+				ClassName: "BestClass",
+				Kind:      kind.Thing,
+				ID:        "123-456-789",
+			},
+		}
+
+		res, err := traverser.Explore(context.Background(), nil, params)
+		require.Nil(t, err)
+		assert.Equal(t, []search.Result{
+			search.Result{
+				ClassName: "BestClass",
+				Kind:      kind.Thing,
+				ID:        "123-456-789",
+				Beacon:    "weaviate://localhost/things/123-456-789",
+				Certainty: 0.5,
+			},
+		}, res, "the source object is excluded")
+
+		// see fakeVectorizer.MoveTo
+		assert.Equal(t, []float32{2, 2, 2}, vectorSearcher.calledWithVector)
+		assert.Equal(t, 2, vectorSearcher.calledWithLimit,
+			"one more result is requested to make up for the source object")
+		vectorSearcher.AssertExpectations(t)
+	})
+
 	t.Run("with no movements set", func(t *testing.T) {
 
 		authorizer := &fakeAuthorizer{}
@@ -100,6 +225,71 @@ func Test_ExploreConcepts(t *testing.T) {
 			"uses the default limit if not explicitly set")
 	})
 
+	t.Run("with an offset set", func(t *testing.T) {
+		authorizer := &fakeAuthorizer{}
+		locks := &fakeLocks{}
+		logger, _ := test.NewNullLogger()
+		vectorizer := &fakeVectorizer{}
+		vectorSearcher := &fakeVectorSearcher{}
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(vectorSearcher, vectorizer, newFakeDistancer(), log)
+		schemaGetter := &fakeSchemaGetter{}
+		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
+			vectorizer, vectorSearcher, explorer, schemaGetter)
+		params := ExploreParams{
+			Values: []string{"a search term", "another"},
+			Limit:  1,
+			Offset: 1,
+		}
+		vectorSearcher.results = []search.Result{
+			search.Result{
+				ClassName: "BestClass",
+				Kind:      kind.Thing,
+				ID:        "123-456-789",
+			},
+			search.Result{
+				ClassName: "AnAction",
+				Kind:      kind.Action,
+				ID:        "987-654-321",
+			},
+		}
+
+		res, err := traverser.Explore(context.Background(), nil, params)
+		require.Nil(t, err)
+		assert.Equal(t, []search.Result{
+			search.Result{
+				ClassName: "AnAction",
+				Kind:      kind.Action,
+				ID:        "987-654-321",
+				Beacon:    "weaviate://localhost/actions/987-654-321",
+				Certainty: 0.5,
+			},
+		}, res)
+
+		assert.Equal(t, 2, vectorSearcher.calledWithLimit,
+			"requests enough results to skip the offset")
+	})
+
+	t.Run("with a negative offset", func(t *testing.T) {
+		authorizer := &fakeAuthorizer{}
+		locks := &fakeLocks{}
+		logger, _ := test.NewNullLogger()
+		vectorizer := &fakeVectorizer{}
+		vectorSearcher := &fakeVectorSearcher{}
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(vectorSearcher, vectorizer, newFakeDistancer(), log)
+		schemaGetter := &fakeSchemaGetter{}
+		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
+			vectorizer, vectorSearcher, explorer, schemaGetter)
+		params := ExploreParams{
+			Values: []string{"a search term"},
+			Offset: -1,
+		}
+
+		_, err := traverser.Explore(context.Background(), nil, params)
+		assert.NotNil(t, err)
+	})
+
 	t.Run("with minimum certainty set to 0.6", func(t *testing.T) {
 
 		authorizer := &fakeAuthorizer{}
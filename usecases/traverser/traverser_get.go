@@ -16,13 +16,23 @@ package traverser
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
 )
 
 func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 	params GetParams) (interface{}, error) {
-	err := t.authorizer.Authorize(principal, "get", "traversal/*")
+	defer observeQuery(params.ClassName, "get", time.Now())
+
+	err := t.authorizeGetParams(principal, params)
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.authorizeSourceObjects(ctx, principal, params.NearObject, params.Explore)
 	if err != nil {
 		return nil, err
 	}
@@ -33,5 +43,93 @@ func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 	}
 	defer unlock()
 
+	if err := t.validateSort(params); err != nil {
+		return nil, fmt.Errorf("invalid sort: %v", err)
+	}
+
+	if params.KeywordRanking != nil {
+		props, err := t.keywordRankingProperties(params, params.KeywordRanking.Properties)
+		if err != nil {
+			return nil, fmt.Errorf("invalid bm25: %v", err)
+		}
+		keywordRanking := *params.KeywordRanking
+		keywordRanking.Properties = props
+		params.KeywordRanking = &keywordRanking
+	}
+
+	if params.Hybrid != nil {
+		props, err := t.keywordRankingProperties(params, params.Hybrid.Properties)
+		if err != nil {
+			return nil, fmt.Errorf("invalid hybrid: %v", err)
+		}
+		hybrid := *params.Hybrid
+		hybrid.Properties = props
+		params.Hybrid = &hybrid
+	}
+
 	return t.explorer.GetClass(ctx, params)
 }
+
+func (t *Traverser) validateSort(params GetParams) error {
+	if len(params.Sort) == 0 {
+		return nil
+	}
+
+	s := t.schemaGetter.GetSchemaSkipAuth()
+	class := s.GetClass(params.Kind, schema.ClassName(params.ClassName))
+	if class == nil {
+		return fmt.Errorf("class %q not found in schema", params.ClassName)
+	}
+
+	return filters.ValidateSort(params.Sort, []*models.Class{class})
+}
+
+// keywordRankingProperties validates that the properties of a bm25 or hybrid
+// search are indexed text or string properties of the class. If none are
+// set, all indexed text and string properties are used.
+func (t *Traverser) keywordRankingProperties(params GetParams,
+	requested []string) ([]string, error) {
+	s := t.schemaGetter.GetSchemaSkipAuth()
+	class := s.GetClass(params.Kind, schema.ClassName(params.ClassName))
+	if class == nil {
+		return nil, fmt.Errorf("class %q not found in schema", params.ClassName)
+	}
+
+	searchable := map[string]bool{}
+	var all []string
+	for _, prop := range class.Properties {
+		if prop.Index != nil && *prop.Index == false {
+			continue
+		}
+
+		if len(prop.DataType) != 1 {
+			continue
+		}
+
+		dt := schema.DataType(prop.DataType[0])
+		if dt != schema.DataTypeText && dt != schema.DataTypeString {
+			continue
+		}
+
+		searchable[prop.Name] = true
+		all = append(all, prop.Name)
+	}
+
+	if len(requested) == 0 {
+		if len(all) == 0 {
+			return nil, fmt.Errorf("class %q has no indexed text or string properties",
+				params.ClassName)
+		}
+
+		return all, nil
+	}
+
+	for _, prop := range requested {
+		if !searchable[prop] {
+			return nil, fmt.Errorf("property %q is not an indexed text or string "+
+				"property of class %q", prop, params.ClassName)
+		}
+	}
+
+	return requested, nil
+}
@@ -23,14 +23,81 @@ import (
 )
 
 type GetParams struct {
-	Kind         kind.Kind
-	Filters      *filters.LocalFilter
-	ClassName    string
-	Pagination   *filters.Pagination
-	Properties   SelectProperties
-	Explore      *ExploreParams
-	SearchVector []float32
-	Group        *GroupParams
+	Kind           kind.Kind
+	Filters        *filters.LocalFilter
+	ClassName      string
+	Pagination     *filters.Pagination
+	Sort           []filters.Sort
+	Properties     SelectProperties
+	Explore        *ExploreParams
+	NearVector     *NearVectorParams
+	NearObject     *NearObjectParams
+	KeywordRanking *KeywordRankingParams
+	Hybrid         *HybridParams
+	SearchVector   []float32
+	Group          *GroupParams
+}
+
+// rankedSearches counts how many of the mutually exclusive search params are
+// set which order the results by certainty or relevance
+func (p GetParams) rankedSearches() int {
+	count := 0
+	if p.Explore != nil {
+		count++
+	}
+	if p.NearVector != nil {
+		count++
+	}
+	if p.NearObject != nil {
+		count++
+	}
+	if p.KeywordRanking != nil {
+		count++
+	}
+	if p.Hybrid != nil {
+		count++
+	}
+	return count
+}
+
+// NearVectorParams to search with a vector provided by the user instead of
+// vectorizing search terms
+type NearVectorParams struct {
+	Vector    []float32
+	Certainty float64
+}
+
+// NearObjectParams to search with the stored vector of an existing object,
+// which is referenced either by its id or by its beacon
+type NearObjectParams struct {
+	ID        string
+	Beacon    string
+	Certainty float64
+}
+
+// KeywordRankingParams to rank the results by their BM25 relevance for the
+// query in the text and string properties. If no properties are set, all
+// indexed text and string properties of the class are searched.
+type KeywordRankingParams struct {
+	Query      string
+	Properties []string
+}
+
+// HybridParams to rank the results by a fusion of the BM25 relevance and the
+// vector certainty of the query. An alpha of 1 is a pure vector search, an
+// alpha of 0 a pure keyword search.
+type HybridParams struct {
+	Query      string
+	Alpha      float64
+	Properties []string
+}
+
+func (p HybridParams) validate() error {
+	if p.Alpha < 0 || p.Alpha > 1 {
+		return fmt.Errorf("alpha must be between 0 and 1, got %v", p.Alpha)
+	}
+
+	return nil
 }
 
 type SelectProperty struct {
@@ -0,0 +1,125 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2019 SeMI Holding B.V. (registered @ Dutch Chamber of Commerce no 75221632). All rights reserved.
+//  LICENSE WEAVIATE OPEN SOURCE: https://www.semi.technology/playbook/playbook/contract-weaviate-OSS.html
+//  LICENSE WEAVIATE ENTERPRISE: https://www.semi.technology/playbook/contract-weaviate-enterprise.html
+//  CONCEPT: Bob van Luijt (@bobvanluijt)
+//  CONTACT: hello@semi.technology
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema/kind"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Traverser_GetClass_Sort(t *testing.T) {
+	tests := []struct {
+		name        string
+		sort        []filters.Sort
+		expectedErr bool
+	}{
+		{
+			name: "by a number property",
+			sort: []filters.Sort{{Path: []string{"number"}, Order: filters.SortOrderDesc}},
+		},
+		{
+			name: "by the update time and a string property",
+			sort: []filters.Sort{
+				{Path: []string{"_updated"}, Order: filters.SortOrderDesc},
+				{Path: []string{"label"}, Order: filters.SortOrderAsc},
+			},
+		},
+		{
+			name:        "by a reference property",
+			sort:        []filters.Sort{{Path: []string{"a ref"}, Order: filters.SortOrderAsc}},
+			expectedErr: true,
+		},
+		{
+			name:        "by a property of another class",
+			sort:        []filters.Sort{{Path: []string{"price"}, Order: filters.SortOrderAsc}},
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			traverser := newTraverserForGetTest()
+			params := GetParams{
+				Kind:      kind.Thing,
+				ClassName: "MyClass",
+				Sort:      test.sort,
+			}
+
+			_, err := traverser.GetClass(context.Background(), &models.Principal{}, params)
+			assert.Equal(t, test.expectedErr, err != nil)
+		})
+	}
+}
+
+func Test_Traverser_GetClass_KeywordRanking(t *testing.T) {
+	t.Run("without properties", func(t *testing.T) {
+		explorer := &fakeExplorer{}
+		traverser := newTraverserForGetTestWithExplorer(explorer)
+		params := GetParams{
+			Kind:           kind.Thing,
+			ClassName:      "MyClass",
+			KeywordRanking: &KeywordRankingParams{Query: "foo"},
+		}
+
+		_, err := traverser.GetClass(context.Background(), &models.Principal{}, params)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"label"}, explorer.calledWithGetParams.KeywordRanking.Properties,
+			"all indexed text and string properties are searched")
+	})
+
+	t.Run("with a non-text property", func(t *testing.T) {
+		traverser := newTraverserForGetTest()
+		params := GetParams{
+			Kind:      kind.Thing,
+			ClassName: "MyClass",
+			KeywordRanking: &KeywordRankingParams{
+				Query:      "foo",
+				Properties: []string{"number"},
+			},
+		}
+
+		_, err := traverser.GetClass(context.Background(), &models.Principal{}, params)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("hybrid on a class without text properties", func(t *testing.T) {
+		traverser := newTraverserForGetTest()
+		params := GetParams{
+			Kind:      kind.Thing,
+			ClassName: "AnotherClass",
+			Hybrid:    &HybridParams{Query: "foo", Alpha: 0.5},
+		}
+
+		_, err := traverser.GetClass(context.Background(), &models.Principal{}, params)
+		assert.NotNil(t, err)
+	})
+}
+
+func newTraverserForGetTest() *Traverser {
+	return newTraverserForGetTestWithExplorer(&fakeExplorer{})
+}
+
+func newTraverserForGetTestWithExplorer(explorer explorer) *Traverser {
+	logger, _ := test.NewNullLogger()
+	return NewTraverser(&config.WeaviateConfig{}, &fakeLocks{}, logger, &fakeAuthorizer{},
+		&fakeVectorizer{}, &fakeVectorRepo{}, explorer,
+		&fakeSchemaGetter{aggregateTestSchema})
+}
@@ -13,7 +13,17 @@
 
 package vectorizer
 
-import "context"
+import (
+	"context"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+)
+
+// withContextionary registers the client as the only module, like a setup in
+// which no further vectorizers are configured
+func withContextionary(client Module) map[string]Module {
+	return map[string]Module{models.ClassVectorizerContextionary: client}
+}
 
 type fakeClient struct {
 	lastInput []string
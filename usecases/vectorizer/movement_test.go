@@ -86,7 +86,7 @@ func TestMoveVectorToAnother(t *testing.T) {
 			t.Run(test.name, func(t *testing.T) {
 				client := &fakeClient{}
 				indexer := &propertyIndexer{}
-				v := New(client, indexer)
+				v := New(withContextionary(client), indexer)
 				res, err := v.MoveTo(test.source, test.target, test.weight)
 				assert.Equal(t, test.expectedError, err)
 				assert.Equal(t, test.expectedResult, res)
@@ -159,7 +159,7 @@ func TestMoveVectorToAnother(t *testing.T) {
 			t.Run(test.name, func(t *testing.T) {
 				client := &fakeClient{}
 				indexer := &propertyIndexer{}
-				v := New(client, indexer)
+				v := New(withContextionary(client), indexer)
 				res, err := v.MoveAwayFrom(test.source, test.target, test.weight)
 				assert.Equal(t, test.expectedError, err)
 				assert.Equal(t, test.expectedResult, res)
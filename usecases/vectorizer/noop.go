@@ -24,7 +24,7 @@ import (
 type NoOpVectorizer struct{}
 
 // Corpi is not implemented in the NoOpVectorizer
-func (n *NoOpVectorizer) Corpi(ctx context.Context, corpi []string) ([]float32, error) {
+func (n *NoOpVectorizer) Corpi(ctx context.Context, className string, corpi []string) ([]float32, error) {
 	return []float32{}, nil
 }
 
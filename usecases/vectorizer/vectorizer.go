@@ -22,9 +22,10 @@ import (
 	"github.com/semi-technologies/weaviate/entities/models"
 )
 
-// Vectorizer turns things and actions into vectors
+// Vectorizer turns things and actions into vectors. Each class selects the
+// module which computes its vectors through its vectorizer setting.
 type Vectorizer struct {
-	client     client
+	modules    map[string]Module
 	indexCheck IndexCheck
 }
 
@@ -40,7 +41,10 @@ func NewErrNoUsableWordsf(pattern string, args ...interface{}) ErrNoUsableWords
 	return ErrNoUsableWords{Err: fmt.Errorf(pattern, args...)}
 }
 
-type client interface {
+// Module builds a vector for a corpus. Every vectorizer of a class, apart
+// from "none", is backed by a module, such as the contextionary or an HTTP
+// endpoint. Modules which don't support overrides can ignore them.
+type Module interface {
 	VectorForCorpi(ctx context.Context, corpi []string,
 		overrides map[string]string) ([]float32, error)
 }
@@ -50,38 +54,89 @@ type IndexCheck interface {
 	Indexed(className, property string) bool
 	VectorizeClassName(className string) bool
 	VectorizePropertyName(className, propertyName string) bool
+	Vectorizer(className string) string
 }
 
-// New from c11y client
-func New(client client, indexCheck IndexCheck) *Vectorizer {
-	return &Vectorizer{client, indexCheck}
+// New from the configured modules by vectorizer name, vectorizers of classes
+// without a module can't be used
+func New(modules map[string]Module, indexCheck IndexCheck) *Vectorizer {
+	return &Vectorizer{modules, indexCheck}
 }
 
 func (v *Vectorizer) SetIndexChecker(ic IndexCheck) {
 	v.indexCheck = ic
 }
 
-// Thing object to vector
+// Thing object to vector. A vector set by the user takes precedence over the
+// class' vectorizer. Things of classes without a vectorizer and without a
+// user-provided vector have no vector.
 func (v *Vectorizer) Thing(ctx context.Context, object *models.Thing) ([]float32, error) {
+	if len(object.Vector) > 0 {
+		return object.Vector, nil
+	}
+
+	module, err := v.module(object.Class)
+	if err != nil {
+		return nil, err
+	}
+
+	if module == nil {
+		return nil, nil
+	}
+
 	var overrides map[string]string
 	if object.VectorWeights != nil {
 		overrides = object.VectorWeights.(map[string]string)
 	}
 
-	return v.object(ctx, object.Class, object.Schema, overrides)
+	return v.object(ctx, module, object.Class, object.Schema, overrides)
 }
 
-// Action object to vector
+// Action object to vector, see Thing for the precedence rules
 func (v *Vectorizer) Action(ctx context.Context, object *models.Action) ([]float32, error) {
+	if len(object.Vector) > 0 {
+		return object.Vector, nil
+	}
+
+	module, err := v.module(object.Class)
+	if err != nil {
+		return nil, err
+	}
+
+	if module == nil {
+		return nil, nil
+	}
+
 	var overrides map[string]string
 	if object.VectorWeights != nil {
 		overrides = object.VectorWeights.(map[string]string)
 	}
 
-	return v.object(ctx, object.Class, object.Schema, overrides)
+	return v.object(ctx, module, object.Class, object.Schema, overrides)
 }
 
-func (v *Vectorizer) object(ctx context.Context, className string,
+// module of the class, it is nil if the class has no vectorizer. An empty
+// class name selects the default vectorizer.
+func (v *Vectorizer) module(className string) (Module, error) {
+	name := models.ClassVectorizerContextionary
+	if className != "" {
+		name = v.indexCheck.Vectorizer(className)
+	}
+
+	if name == models.ClassVectorizerNone {
+		return nil, nil
+	}
+
+	module, ok := v.modules[name]
+	if !ok {
+		return nil, fmt.Errorf("vectorizer '%s' of class '%s' is not configured",
+			name, className)
+	}
+
+	return module, nil
+}
+
+func (v *Vectorizer) object(ctx context.Context, module Module, className string,
 	schema interface{}, overrides map[string]string) ([]float32, error) {
 	var corpi []string
 
@@ -113,7 +168,7 @@ func (v *Vectorizer) object(ctx context.Context, className string,
 		corpi = append(corpi, camelCaseToLower(className))
 	}
 
-	vector, err := v.client.VectorForCorpi(ctx, []string{strings.Join(corpi, " ")}, overrides)
+	vector, err := module.VectorForCorpi(ctx, []string{strings.Join(corpi, " ")}, overrides)
 	if err != nil {
 		switch err.(type) {
 		case ErrNoUsableWords:
@@ -148,14 +203,26 @@ func (v *Vectorizer) object(ctx context.Context, className string,
 }
 
 // Corpi takes any list of strings and builds a common vector for all of them
-func (v *Vectorizer) Corpi(ctx context.Context, corpi []string,
+// with the vectorizer of the class, so that the vector can be compared with
+// the vectors of the class. An empty class name selects the default
+// vectorizer.
+func (v *Vectorizer) Corpi(ctx context.Context, className string, corpi []string,
 ) ([]float32, error) {
+	module, err := v.module(className)
+	if err != nil {
+		return nil, err
+	}
+
+	if module == nil {
+		return nil, fmt.Errorf("class '%s' has no vectorizer, search it with "+
+			"nearVector or nearObject instead", className)
+	}
 
 	for i, corpus := range corpi {
 		corpi[i] = camelCaseToLower(corpus)
 	}
 
-	vector, err := v.client.VectorForCorpi(ctx, corpi, nil)
+	vector, err := module.VectorForCorpi(ctx, corpi, nil)
 	if err != nil {
 		return nil, fmt.Errorf("vectorizing corpus '%+v': %v", corpi, err)
 	}
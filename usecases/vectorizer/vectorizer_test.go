@@ -147,9 +147,9 @@ func TestVectorizingThings(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			client := &fakeClient{}
-			indexer := &propertyIndexer{test.noindex, test.excludedClass, test.excludedProperty}
+			indexer := &propertyIndexer{test.noindex, test.excludedClass, test.excludedProperty, "", ""}
 
-			v := New(client, indexer)
+			v := New(withContextionary(client), indexer)
 
 			res, err := v.Thing(context.Background(), test.input)
 
@@ -167,6 +167,8 @@ type propertyIndexer struct {
 	noIndex          string
 	excludedClass    string
 	excludedProperty string
+	noVectorizer     string // to simulate a class with vectorizer "none"
+	httpVectorizer   string // to simulate a class with vectorizer "text2vec-http"
 }
 
 func (p *propertyIndexer) Indexed(className, property string) bool {
@@ -185,6 +187,18 @@ func (p *propertyIndexer) VectorizePropertyName(class, prop string) bool {
 	return p.excludedProperty != prop
 }
 
+func (p *propertyIndexer) Vectorizer(class string) string {
+	if p.noVectorizer == class {
+		return models.ClassVectorizerNone
+	}
+
+	if p.httpVectorizer == class {
+		return models.ClassVectorizerText2vecHTTP
+	}
+
+	return models.ClassVectorizerContextionary
+}
+
 func TestVectorizingActions(t *testing.T) {
 	type testCase struct {
 		name               string
@@ -242,8 +256,8 @@ func TestVectorizingActions(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			client := &fakeClient{}
-			indexer := &propertyIndexer{test.noindex, test.excludedClass, test.excludedProperty}
-			v := New(client, indexer)
+			indexer := &propertyIndexer{test.noindex, test.excludedClass, test.excludedProperty, "", ""}
+			v := New(withContextionary(client), indexer)
 
 			res, err := v.Action(context.Background(), test.input)
 
@@ -292,10 +306,10 @@ func TestVectorizingSearchTerms(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			client := &fakeClient{}
-			indexer := &propertyIndexer{test.noindex, "", ""}
-			v := New(client, indexer)
+			indexer := &propertyIndexer{test.noindex, "", "", "", ""}
+			v := New(withContextionary(client), indexer)
 
-			res, err := v.Corpi(context.Background(), test.input)
+			res, err := v.Corpi(context.Background(), "Car", test.input)
 
 			require.Nil(t, err)
 			assert.Equal(t, []float32{0, 1, 2, 3}, res)
@@ -303,3 +317,110 @@ func TestVectorizingSearchTerms(t *testing.T) {
 		})
 	}
 }
+
+func TestVectorizingWithUserProvidedVectors(t *testing.T) {
+	t.Run("a user-provided vector takes precedence", func(t *testing.T) {
+		client := &fakeClient{}
+		v := New(withContextionary(client), &propertyIndexer{})
+
+		res, err := v.Thing(context.Background(), &models.Thing{
+			Class:  "Car",
+			Vector: []float32{7, 8, 9},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []float32{7, 8, 9}, res)
+
+		res, err = v.Action(context.Background(), &models.Action{
+			Class:  "Drive",
+			Vector: []float32{9, 8, 7},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []float32{9, 8, 7}, res)
+		assert.Nil(t, client.lastInput, "contextionary should not have been called")
+	})
+
+	t.Run("a class without vectorizer and without a vector", func(t *testing.T) {
+		client := &fakeClient{}
+		v := New(withContextionary(client), &propertyIndexer{noVectorizer: "Car"})
+
+		res, err := v.Thing(context.Background(), &models.Thing{
+			Class:  "Car",
+			Schema: map[string]interface{}{"brand": "best brand"},
+		})
+		require.Nil(t, err)
+		assert.Nil(t, res)
+		assert.Nil(t, client.lastInput, "contextionary should not have been called")
+	})
+
+	t.Run("a class without vectorizer with a vector", func(t *testing.T) {
+		client := &fakeClient{}
+		v := New(withContextionary(client), &propertyIndexer{noVectorizer: "Car"})
+
+		res, err := v.Thing(context.Background(), &models.Thing{
+			Class:  "Car",
+			Vector: []float32{1, 2, 3},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []float32{1, 2, 3}, res)
+	})
+}
+
+func TestVectorizingWithModules(t *testing.T) {
+	t.Run("a class is vectorized by the module it selects", func(t *testing.T) {
+		c11y := &fakeClient{}
+		http := &fakeClient{}
+		v := New(map[string]Module{
+			models.ClassVectorizerContextionary: c11y,
+			models.ClassVectorizerText2vecHTTP:  http,
+		}, &propertyIndexer{httpVectorizer: "Car"})
+
+		_, err := v.Thing(context.Background(), &models.Thing{
+			Class:  "Car",
+			Schema: map[string]interface{}{"brand": "best brand"},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []string{"car brand best brand"}, http.lastInput)
+		assert.Nil(t, c11y.lastInput, "contextionary should not have been called")
+
+		_, err = v.Action(context.Background(), &models.Action{
+			Class:  "Drive",
+			Schema: map[string]interface{}{"speed": "fast"},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []string{"drive speed fast"}, c11y.lastInput)
+	})
+
+	t.Run("search terms are vectorized by the module of the class", func(t *testing.T) {
+		c11y := &fakeClient{}
+		http := &fakeClient{}
+		v := New(map[string]Module{
+			models.ClassVectorizerContextionary: c11y,
+			models.ClassVectorizerText2vecHTTP:  http,
+		}, &propertyIndexer{httpVectorizer: "Car"})
+
+		_, err := v.Corpi(context.Background(), "Car", []string{"fast car"})
+		require.Nil(t, err)
+		assert.Equal(t, []string{"fast car"}, http.lastInput)
+		assert.Nil(t, c11y.lastInput, "contextionary should not have been called")
+
+		_, err = v.Corpi(context.Background(), "", []string{"slow car"})
+		require.Nil(t, err)
+		assert.Equal(t, []string{"slow car"}, c11y.lastInput,
+			"no class should fall back to the default vectorizer")
+	})
+
+	t.Run("a class whose module is not configured", func(t *testing.T) {
+		v := New(withContextionary(&fakeClient{}), &propertyIndexer{httpVectorizer: "Car"})
+
+		_, err := v.Thing(context.Background(), &models.Thing{Class: "Car"})
+		assert.Equal(t, "vectorizer 'text2vec-http' of class 'Car' is not configured",
+			err.Error())
+	})
+
+	t.Run("search terms for a class without vectorizer", func(t *testing.T) {
+		v := New(withContextionary(&fakeClient{}), &propertyIndexer{noVectorizer: "Car"})
+
+		_, err := v.Corpi(context.Background(), "Car", []string{"fast car"})
+		assert.NotNil(t, err)
+	})
+}